@@ -1 +1,160 @@
 package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/audit_postgres_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/audit_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/ldap_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/migration_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/notebook_postgres_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/notebook_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/oidc_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/preference_postgres_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/preference_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/saved_query_postgres_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/saved_query_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_redis_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/stored_audit_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/stored_notebook_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/stored_preference_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/stored_saved_query_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/stored_session_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/stored_transaction_repository"
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/transaction_repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SessionBackends holds the repositories whose state lives in the configured session store
+type SessionBackends struct {
+	Sessions     repository.SessionRepository
+	Transactions repository.TransactionRepository
+	// Store is the embedded store behind the file backend, nil otherwise; close it on shutdown
+	Store repository.SessionStore
+}
+
+// NewSessionBackends returns the session and transaction repositories selected by config. The file
+// backend keeps both in one embedded store so they survive restarts without external infrastructure;
+// Redis shares sessions between instances while transactions stay in memory.
+func NewSessionBackends(db *sql.DB, config domain.SessionStoreConfig) (*SessionBackends, error) {
+	switch config.Backend {
+	case "", domain.SessionStoreMemory:
+		return &SessionBackends{
+			Sessions:     session_repository.NewSessionRepository(db),
+			Transactions: transaction_repository.NewTransactionRepository(db),
+		}, nil
+	case domain.SessionStoreRedis:
+		if config.RedisAddr == "" {
+			return nil, fmt.Errorf("redis session store requires an address")
+		}
+		return &SessionBackends{
+			Sessions:     session_redis_repository.NewSessionRedisRepository(config),
+			Transactions: transaction_repository.NewTransactionRepository(db),
+		}, nil
+	case domain.SessionStoreFile:
+		store, err := session_store_repository.NewSessionStoreRepository(config.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		return &SessionBackends{
+			Sessions:     stored_session_repository.NewStoredSessionRepository(store),
+			Transactions: stored_transaction_repository.NewStoredTransactionRepository(store),
+			Store:        store,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown session store backend: %s", config.Backend)
+	}
+}
+
+// AppDataBackends holds the repositories for lumen-pg's own data
+type AppDataBackends struct {
+	SavedQueries repository.SavedQueryRepository
+	Notebooks    repository.NotebookRepository
+	Audit        repository.AuditRepository
+	Preferences  repository.PreferenceRepository
+	// Store is the embedded store behind the file backend, nil otherwise; close it on shutdown
+	Store repository.SessionStore
+}
+
+// NewAppDataBackends returns the saved query, notebook, audit and preference repositories selected by config. The
+// PostgreSQL backend migrates lumen-pg's schema first, so it needs a role allowed to create it; the file
+// backend writes nothing to the database and suits read-only targets, keeping app data in the same kind
+// of embedded file store as the file session backend rather than in SQLite or bbolt.
+func NewAppDataBackends(ctx context.Context, db *sql.DB, config domain.AppDataConfig) (*AppDataBackends, error) {
+	switch config.Backend {
+	case "", domain.AppDataMemory:
+		return &AppDataBackends{
+			SavedQueries: saved_query_repository.NewSavedQueryRepository(),
+			Notebooks:    notebook_repository.NewNotebookRepository(),
+			Audit:        audit_repository.NewAuditRepository(),
+			Preferences:  preference_repository.NewPreferenceRepository(),
+		}, nil
+	case domain.AppDataPostgres:
+		if _, err := MigrateAppSchema(ctx, db); err != nil {
+			return nil, err
+		}
+		return &AppDataBackends{
+			SavedQueries: saved_query_postgres_repository.NewSavedQueryPostgresRepository(db),
+			Notebooks:    notebook_postgres_repository.NewNotebookPostgresRepository(db),
+			Audit:        audit_postgres_repository.NewAuditPostgresRepository(db),
+			Preferences:  preference_postgres_repository.NewPreferencePostgresRepository(db),
+		}, nil
+	case domain.AppDataFile:
+		if config.FilePath == "" {
+			return nil, fmt.Errorf("file app data backend requires a path")
+		}
+		store, err := session_store_repository.NewSessionStoreRepository(config.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		return &AppDataBackends{
+			SavedQueries: stored_saved_query_repository.NewStoredSavedQueryRepository(store),
+			Notebooks:    stored_notebook_repository.NewStoredNotebookRepository(store),
+			Audit:        stored_audit_repository.NewStoredAuditRepository(store),
+			Preferences:  stored_preference_repository.NewStoredPreferenceRepository(store),
+			Store:        store,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown app data backend: %s", config.Backend)
+	}
+}
+
+// NewOIDCRepository returns the single sign-on provider client, or nil when SSO is not configured so the
+// authentication usecase offers password logins only
+func NewOIDCRepository(config domain.SSOConfig) (repository.OIDCRepository, error) {
+	if config.IssuerURL == "" {
+		return nil, nil
+	}
+	if config.ClientID == "" || config.RedirectURL == "" || config.ServiceUser == "" {
+		return nil, fmt.Errorf("single sign-on requires a client ID, redirect URL and service user")
+	}
+	return oidc_repository.NewOIDCRepository(config, nil), nil
+}
+
+// NewLDAPAuthenticator returns the LDAP authenticator when backend selects LDAP for password logins, or
+// nil for the default backend where logins are PostgreSQL credentials
+func NewLDAPAuthenticator(backend string, config domain.LDAPConfig) (repository.LDAPAuthenticator, error) {
+	switch backend {
+	case "", domain.AuthBackendPostgres:
+		return nil, nil
+	case domain.AuthBackendLDAP:
+		if config.URL == "" || config.BaseDN == "" || config.ServiceUser == "" {
+			return nil, fmt.Errorf("LDAP authentication requires a URL, base DN and service user")
+		}
+		return ldap_repository.NewLDAPAuthenticator(config), nil
+	default:
+		return nil, fmt.Errorf("unknown authentication backend: %s", backend)
+	}
+}
+
+// MigrateAppSchema brings lumen-pg's own tables up to this build's version before anything uses them,
+// returning the versions it applied. Boot should stop on error: a database migrated by a newer build
+// holds tables this one does not know.
+func MigrateAppSchema(ctx context.Context, db *sql.DB) ([]int, error) {
+	return migration_repository.NewMigrationRepository(db).Migrate(ctx)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+// RunTransactionReaper warns about and rolls back idle transactions every TransactionReapInterval
+// until ctx is done, logging each pass that rolled something back or failed. It blocks, so run it in
+// its own goroutine next to the servers.
+func RunTransactionReaper(ctx context.Context, transactionUC usecase.TransactionUseCase, loggerRepo repository.LoggerRepository) {
+	ticker := time.NewTicker(domain.TransactionReapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		reaped, err := transactionUC.ReapIdleTransactions(ctx)
+		if err != nil {
+			loggerRepo.LogError(ctx, "idle transaction reaper failed", err, map[string]interface{}{"rolled_back": reaped})
+			continue
+		}
+		if reaped > 0 {
+			loggerRepo.LogTransactionEvent(ctx, "", domain.TransactionEventTimeout, map[string]interface{}{
+				"rolled_back":  reaped,
+				"idle_timeout": domain.TransactionIdleTimeout.String(),
+			})
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// Servers holds the HTTP listeners Lumen answers on
+type Servers struct {
+	// Main serves the application, over TLS when TLSConfig asks for it
+	Main *http.Server
+	// Redirect sends plain HTTP requests to HTTPS and answers ACME challenges; nil when Main serves
+	// plain HTTP or the redirect is disabled
+	Redirect *http.Server
+}
+
+// NewServers returns the listeners serving handler on addr. With a certificate or autocert domains in
+// config, Main serves HTTPS, on DefaultHTTPSAddr when addr is empty, so sessions get Secure cookies
+// without a reverse proxy; a second listener redirects plain HTTP to it.
+func NewServers(handler http.Handler, addr string, config domain.TLSConfig) (*Servers, error) {
+	useFiles := config.CertFile != "" || config.KeyFile != ""
+	useAutocert := len(config.AutocertDomains) > 0
+
+	switch {
+	case useFiles && useAutocert:
+		return nil, fmt.Errorf("TLS takes either a certificate file or autocert domains, not both")
+	case useFiles && (config.CertFile == "" || config.KeyFile == ""):
+		return nil, fmt.Errorf("TLS requires both a certificate file and a key file")
+	case !useFiles && !useAutocert:
+		return &Servers{Main: &http.Server{Addr: addr, Handler: handler}}, nil
+	}
+
+	if addr == "" {
+		addr = domain.DefaultHTTPSAddr
+	}
+	servers := &Servers{Main: &http.Server{Addr: addr, Handler: handler}}
+	redirect := NewHTTPSRedirectHandler(addr)
+
+	if useFiles {
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		servers.Main.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		}
+	} else {
+		cacheDir := config.AutocertCacheDir
+		if cacheDir == "" {
+			cacheDir = domain.DefaultAutocertCacheDir
+		}
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(config.AutocertDomains...),
+			Cache:      autocert.DirCache(cacheDir),
+			Email:      config.AutocertEmail,
+		}
+		if config.AutocertDirectoryURL != "" {
+			manager.Client = &acme.Client{DirectoryURL: config.AutocertDirectoryURL}
+		}
+		servers.Main.TLSConfig = manager.TLSConfig()
+		servers.Main.TLSConfig.MinVersion = tls.VersionTLS12
+
+		// The ACME provider validates domains over plain HTTP, so challenges are answered before redirecting
+		redirect = manager.HTTPHandler(redirect)
+	}
+
+	if !config.DisableRedirect {
+		redirectAddr := config.RedirectAddr
+		if redirectAddr == "" {
+			redirectAddr = domain.DefaultHTTPRedirectAddr
+		}
+		servers.Redirect = &http.Server{Addr: redirectAddr, Handler: redirect}
+	}
+	return servers, nil
+}
+
+// ListenAndServe serves until a listener fails or is shut down, returning the first error
+func (s *Servers) ListenAndServe() error {
+	errs := make(chan error, 2)
+	if s.Redirect != nil {
+		go func() { errs <- s.Redirect.ListenAndServe() }()
+	}
+	go func() {
+		if s.Main.TLSConfig != nil {
+			errs <- s.Main.ListenAndServeTLS("", "")
+			return
+		}
+		errs <- s.Main.ListenAndServe()
+	}()
+
+	err := <-errs
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown stops both listeners, waiting for in-flight requests until ctx is done
+func (s *Servers) Shutdown(ctx context.Context) error {
+	var redirectErr error
+	if s.Redirect != nil {
+		redirectErr = s.Redirect.Shutdown(ctx)
+	}
+	return errors.Join(s.Main.Shutdown(ctx), redirectErr)
+}
+
+// NewHTTPSRedirectHandler permanently redirects every request to the same URL over HTTPS on the port
+// of httpsAddr; 308 keeps the method and body of form posts
+func NewHTTPSRedirectHandler(httpsAddr string) http.Handler {
+	_, port, err := net.SplitHostPort(httpsAddr)
+	if err != nil || port == "443" {
+		port = ""
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		} else {
+			host = strings.Trim(host, "[]")
+		}
+		if port != "" {
+			host = net.JoinHostPort(host, port)
+		} else if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
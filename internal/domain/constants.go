@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Error types
 type ErrorType string
@@ -43,10 +46,14 @@ var (
 	ErrInvalidConnectionStr = &ApplicationError{Type: ErrTypeValidation, Message: "invalid connection string format", Code: 400}
 	ErrInvalidQuery         = &ApplicationError{Type: ErrTypeValidation, Message: "invalid SQL query", Code: 400}
 	ErrInvalidWhereClause   = &ApplicationError{Type: ErrTypeValidation, Message: "invalid WHERE clause", Code: 400}
+	ErrChangeReasonRequired = &ApplicationError{Type: ErrTypeValidation, Message: "a change reason is required to commit", Code: 400}
 
 	// Connection errors
-	ErrConnectionFailed = &ApplicationError{Type: ErrTypeConnection, Message: "failed to connect to database", Code: 503}
-	ErrNoAccessibleDB   = &ApplicationError{Type: ErrTypeConnection, Message: "user has no accessible databases", Code: 403}
+	ErrConnectionFailed    = &ApplicationError{Type: ErrTypeConnection, Message: "failed to connect to database", Code: 503}
+	ErrNoAccessibleDB      = &ApplicationError{Type: ErrTypeConnection, Message: "user has no accessible databases", Code: 403}
+	ErrDatabaseUnavailable = &ApplicationError{Type: ErrTypeConnection, Message: "database unavailable, try again later", Code: 503}
+	ErrServerBusy          = &ApplicationError{Type: ErrTypeConnection, Message: "server busy: the database's connection limit is reached, try again shortly", Code: 503}
+	ErrCopyUnavailable     = &ApplicationError{Type: ErrTypeConnection, Message: "COPY is not available on this connection", Code: 503}
 
 	// Authentication errors
 	ErrInvalidCredentials    = &ApplicationError{Type: ErrTypeAuthentication, Message: "invalid username or password", Code: 401}
@@ -57,6 +64,7 @@ var (
 	ErrUnauthorized            = &ApplicationError{Type: ErrTypeAuthorization, Message: "unauthorized access", Code: 403}
 	ErrInsufficientPermissions = &ApplicationError{Type: ErrTypeAuthorization, Message: "insufficient permissions", Code: 403}
 	ErrTableAccessDenied       = &ApplicationError{Type: ErrTypeAuthorization, Message: "access denied to table", Code: 403}
+	ErrRoleChangeRefused       = &ApplicationError{Type: ErrTypeAuthorization, Message: "statements changing the session's role are not allowed for role-mapped logins", Code: 403}
 
 	// Session errors
 	ErrInvalidSession   = &ApplicationError{Type: ErrTypeSession, Message: "invalid session", Code: 401}
@@ -70,25 +78,43 @@ var (
 	ErrTransactionExpired      = &ApplicationError{Type: ErrTypeTransaction, Message: "transaction expired", Code: 408}
 	ErrCommitFailed            = &ApplicationError{Type: ErrTypeTransaction, Message: "failed to commit transaction", Code: 500}
 	ErrRollbackFailed          = &ApplicationError{Type: ErrTypeTransaction, Message: "failed to rollback transaction", Code: 500}
+	ErrCommitPendingApproval   = &ApplicationError{Type: ErrTypeTransaction, Message: "commit is pending approval", Code: 202}
+	ErrApprovalNotPending      = &ApplicationError{Type: ErrTypeTransaction, Message: "transaction is not pending approval", Code: 409}
+	ErrSelfApproval            = &ApplicationError{Type: ErrTypeAuthorization, Message: "a commit cannot be approved by its author", Code: 403}
+	ErrNotApprover             = &ApplicationError{Type: ErrTypeAuthorization, Message: "user is not allowed to approve commits", Code: 403}
 
 	// Database/Query errors
 	ErrQueryFailed      = &ApplicationError{Type: ErrTypeDatabase, Message: "query execution failed", Code: 500}
 	ErrTableNotFound    = &ApplicationError{Type: ErrTypeDatabase, Message: "table not found", Code: 404}
 	ErrSchemaNotFound   = &ApplicationError{Type: ErrTypeDatabase, Message: "schema not found", Code: 404}
 	ErrDatabaseNotFound = &ApplicationError{Type: ErrTypeDatabase, Message: "database not found", Code: 404}
+	ErrBackendNotFound  = &ApplicationError{Type: ErrTypeDatabase, Message: "backend not found", Code: 404}
+	ErrQueryCancelled   = &ApplicationError{Type: ErrTypeQuery, Message: "query was cancelled", Code: 400}
 
 	// Security errors
 	ErrCookieTampering      = &ApplicationError{Type: ErrTypeSecurity, Message: "cookie tampering detected", Code: 400}
 	ErrSQLInjectionDetected = &ApplicationError{Type: ErrTypeSecurity, Message: "potential SQL injection detected", Code: 400}
 
 	// Not found errors
-	ErrNotFound = &ApplicationError{Type: ErrTypeNotFound, Message: "resource not found", Code: 404}
+	ErrNotFound              = &ApplicationError{Type: ErrTypeNotFound, Message: "resource not found", Code: 404}
+	ErrSavedQueryNotFound    = &ApplicationError{Type: ErrTypeNotFound, Message: "saved query not found", Code: 404}
+	ErrStoreKeyNotFound      = &ApplicationError{Type: ErrTypeNotFound, Message: "stored key not found", Code: 404}
+	ErrResultNotFound        = &ApplicationError{Type: ErrTypeNotFound, Message: "result set not found or expired", Code: 404}
+	ErrFunctionNotFound      = &ApplicationError{Type: ErrTypeNotFound, Message: "function not found", Code: 404}
+	ErrDeletedRowsNotFound   = &ApplicationError{Type: ErrTypeNotFound, Message: "deleted rows not found or no longer kept", Code: 404}
+	ErrEditorShareNotFound   = &ApplicationError{Type: ErrTypeNotFound, Message: "shared editor session not found or expired", Code: 404}
+	ErrNotebookNotFound      = &ApplicationError{Type: ErrTypeNotFound, Message: "query notebook not found", Code: 404}
+	ErrTableSnapshotNotFound = &ApplicationError{Type: ErrTypeNotFound, Message: "table snapshot not found or expired", Code: 404}
+	ErrTableViewNotFound     = &ApplicationError{Type: ErrTypeNotFound, Message: "saved table view not found", Code: 404}
+	ErrDashboardTileNotFound = &ApplicationError{Type: ErrTypeNotFound, Message: "dashboard tile not found", Code: 404}
 
 	// Conflict errors
-	ErrConflict = &ApplicationError{Type: ErrTypeConflict, Message: "resource conflict", Code: 409}
+	ErrConflict   = &ApplicationError{Type: ErrTypeConflict, Message: "resource conflict", Code: 409}
+	ErrRoleExists = &ApplicationError{Type: ErrTypeConflict, Message: "a role with this name already exists", Code: 409}
 
 	// Internal errors
-	ErrInternal = &ApplicationError{Type: ErrTypeInternal, Message: "internal server error", Code: 500}
+	ErrInternal        = &ApplicationError{Type: ErrTypeInternal, Message: "internal server error", Code: 500}
+	ErrAppSchemaTooNew = &ApplicationError{Type: ErrTypeInternal, Message: "lumen-pg schema is newer than this build supports", Code: 500}
 )
 
 // Constants for configuration and limits
@@ -97,11 +123,54 @@ const (
 	QueryResultHardLimit    = 1000
 	QueryResultPageSize     = 50
 	QueryResultDisplayLimit = 1000
+	ChartMaxPoints          = 500
+	ExportFlushRows         = 500
+	ExportDefaultRowLimit   = 100000
+	// ReportMaxRows caps the rows of a printed report, below the export limit since every row is laid
+	// out on paper; larger results must be narrowed first
+	ReportMaxRows = 2000
+	// ReportRowsPerPage is how many rows each printed page of a report holds
+	ReportRowsPerPage = 40
+
+	// CSV import
+	ImportPreviewRows    = 20
+	ImportMaxErrors      = 100
+	ImportMaxUploadBytes = 32 << 20
+	// ImportValidationMaxErrors caps the errors a validation pass lists; every row is still checked
+	ImportValidationMaxErrors = 5000
+
+	// Workspace export
+	WorkspaceFormatVersion  = 1
+	WorkspaceMaxUploadBytes = 5 << 20
+
+	// Query notebooks
+	NotebookFormatVersion = 1
+	// NotebookResultMaxRows caps the rows saved with a cell's result, keeping documents small
+	NotebookResultMaxRows = 100
+
+	// CellPreviewMaxBytes is the largest text or binary cell shown in the grid as is; larger cells,
+	// and binary ones that are not text, show their size and hash with a download instead
+	CellPreviewMaxBytes = 1024
 
 	// Pagination
 	CursorPaginationDefaultLimit = 50
 	CursorPaginationMaxLimit     = 50
 
+	// Data exploration
+	GroupByDefaultLimit     = 100
+	HistogramDefaultBuckets = 10
+	HistogramMaxBuckets     = 100
+	DuplicateSampleSize     = 5
+	DataQualitySampleLimit  = 10000
+	OrphanedRowsPreviewSize = 50
+	ForeignKeyLookupLimit   = 20
+
+	// History companion tables
+	HistoryTableSuffix     = "_history"
+	HistoryPeriodColumn    = "sys_period"
+	HistoryValidFromColumn = "valid_from"
+	HistoryValidToColumn   = "valid_to"
+
 	// Session
 	SessionTokenLength       = 32
 	SessionExpirationTime    = 24 * 60 * 60     // 24 hours in seconds
@@ -109,17 +178,150 @@ const (
 	IdentityCookieExpiration = 7 * 24 * 60 * 60 // 7 days in seconds
 
 	// Transaction
-	TransactionTimeout = 60 * 60 // 1 hour in seconds
+	TransactionTimeout   = 60 * 60      // 1 hour in seconds
+	ApprovalHoldDuration = 24 * 60 * 60 // 24 hours in seconds
+	BulkDeleteMaxRows    = 1000
+	// SnapshotMaxAgeSeconds is how long a frozen view may hold its snapshot open, since the open
+	// transaction keeps vacuum from reclaiming rows changed after it
+	SnapshotMaxAgeSeconds = 30 * 60
+	// LongHeldLockSeconds is how long a table lock may be held before starting a transaction warns of it
+	LongHeldLockSeconds = 5 * 60
+	// MaintenanceGracePeriod is how long transactions started before maintenance mode may still commit
+	MaintenanceGracePeriod = 10 * 60 // 10 minutes in seconds
+
+	// Live refresh
+	// LiveRefreshMinInterval is the shortest interval a watched result may be re-run at, so a tab left
+	// open on a queue table cannot hammer the database
+	LiveRefreshMinInterval = 5 // seconds
+	// LiveRefreshMaxInterval caps the interval so a watch still reports a result now and then
+	LiveRefreshMaxInterval = 60 * 60 // 1 hour in seconds
+	// RowWatchMaxRows caps the rows a watch filter may match, since every check diffs all of them
+	RowWatchMaxRows = 1000
+
+	// Audit
+	AuditDefaultLimit = 100
+	AuditExportLimit  = 10000
 
 	// Database
 	DefaultPostgresPort = "5432"
 	DefaultSchema       = "public"
+	DefaultSearchPath   = `"$user", public`
+
+	// Session settings, bounded as PostgreSQL bounds work_mem and statement_timeout
+	SessionWorkMemMinKB               = 64
+	SessionWorkMemMaxKB               = 2147483647
+	SessionStatementTimeoutMax        = 2147483647 * time.Millisecond
+	SessionApplicationNameSuffixLimit = 32
 
 	// Encryption
 	EncryptionKeyLength = 32
 	NounceLength        = 12
 )
 
+// DefaultMaintenanceMessage is shown when maintenance mode refuses a write and no message was set
+const DefaultMaintenanceMessage = "Lumen is in maintenance mode; changes are paused for now. Browsing still works."
+
+// Sources of request rejections, recorded with the rule that matched so false-positive-prone rules
+// can be tuned from data. The rate limiter and CSRF check are interfaces without an implementation, so
+// the SQL validators are the only source so far.
+const (
+	RejectionSourceSQLValidator = "sql_validator"
+)
+
+// RejectionRuleQuerySignature names a query refused by the injection signature check without matching
+// any named WHERE clause rule
+const RejectionRuleQuerySignature = "query_signature"
+
+// RejectionMetricsName is the expvar map counting rejections by "<source>:<rule>:<route>", served with
+// the other expvar metrics at /debug/vars
+const RejectionMetricsName = "lumen_pg_rejections"
+
+// RejectionStatsDefaultWindow is how far back rejection statistics look when no start is given
+const RejectionStatsDefaultWindow = 7 * 24 * time.Hour
+
+// Password policy for roles created from the admin panel, when AppConfig.PasswordPolicy leaves a rule unset
+const (
+	// DefaultPasswordMinLength is the fewest characters a new role's password may have
+	DefaultPasswordMinLength = 12
+	// DefaultPasswordCharacterClasses is how many of lowercase letters, uppercase letters, digits and
+	// symbols a new role's password must mix
+	DefaultPasswordCharacterClasses = 3
+)
+
+// Environment banner
+const (
+	// EnvironmentNameMaxLength caps the environment tag shown in the banner and page titles
+	EnvironmentNameMaxLength = 32
+	// DefaultEnvironmentColor is the banner color of environments without a well-known default
+	DefaultEnvironmentColor = "#546e7a"
+)
+
+// EnvironmentColors are the banner colors of well-known environment names when none is configured
+var EnvironmentColors = map[string]string{
+	"PRODUCTION":  "#c62828",
+	"STAGING":     "#ef6c00",
+	"TEST":        "#6a1b9a",
+	"DEVELOPMENT": "#2e7d32",
+}
+
+// Keyboard shortcut actions
+const (
+	ShortcutRunQuery            = "run_query"
+	ShortcutFormatQuery         = "format_query"
+	ShortcutSaveQuery           = "save_query"
+	ShortcutStartTransaction    = "start_transaction"
+	ShortcutCommitTransaction   = "commit_transaction"
+	ShortcutRollbackTransaction = "rollback_transaction"
+	ShortcutRefreshData         = "refresh_data"
+	ShortcutFocusFilter         = "focus_filter"
+	ShortcutOpenQueryEditor     = "open_query_editor"
+)
+
+// ShortcutPreferencePrefix prefixes the preference keys holding a user's remapped shortcuts, followed
+// by the action
+const ShortcutPreferencePrefix = "shortcut."
+
+// TableViewPreferencePrefix prefixes the preference keys holding a user's saved views of a table,
+// followed by the path-escaped database, schema and table joined with "/"
+const TableViewPreferencePrefix = "table_views."
+
+// TableViewNameMaxLength caps the length of a saved table view's name
+const TableViewNameMaxLength = 100
+
+// Dashboard tile kinds, the summary of a saved query's result a tile shows
+const (
+	DashboardTileRowCount = "row_count"
+	DashboardTileScalar   = "scalar"
+)
+
+// DashboardTilesPreferenceKey is the preference holding a user's dashboard tiles, encoded as JSON
+const DashboardTilesPreferenceKey = "dashboard_tiles"
+
+const (
+	// DashboardTileDefaultRefresh is the refresh interval of a tile pinned without one
+	DashboardTileDefaultRefresh = 60 // seconds
+	// DashboardMaxTiles caps the tiles on a dashboard, since each one re-runs its query while it is open
+	DashboardMaxTiles = 24
+	// DashboardTileTitleMaxLength caps the length of a tile's title
+	DashboardTileTitleMaxLength = 100
+	// DashboardTileQueryTimeout bounds one refresh of a tile, so a slow query cannot pile up behind
+	// its own refresh interval
+	DashboardTileQueryTimeout = 30 * time.Second
+)
+
+// DefaultShortcuts are the shortcuts every user starts with, in the order they are listed
+var DefaultShortcuts = []KeyboardShortcut{
+	{Action: ShortcutRunQuery, Description: "Run the query", Keys: "Ctrl+Enter"},
+	{Action: ShortcutFormatQuery, Description: "Format the query", Keys: "Ctrl+Shift+F"},
+	{Action: ShortcutSaveQuery, Description: "Save the query", Keys: "Ctrl+S"},
+	{Action: ShortcutStartTransaction, Description: "Start a transaction", Keys: "Ctrl+Shift+B"},
+	{Action: ShortcutCommitTransaction, Description: "Commit the transaction", Keys: "Ctrl+Shift+Enter"},
+	{Action: ShortcutRollbackTransaction, Description: "Roll back the transaction", Keys: "Ctrl+Shift+Z"},
+	{Action: ShortcutRefreshData, Description: "Refresh the table data", Keys: "F5"},
+	{Action: ShortcutFocusFilter, Description: "Focus the filter box", Keys: "/"},
+	{Action: ShortcutOpenQueryEditor, Description: "Open the query editor", Keys: "Ctrl+E"},
+}
+
 // Cookie names
 const (
 	CookieSessionID = "session_id"
@@ -137,6 +339,53 @@ const (
 	StatusError    = "error"
 )
 
+// Transaction status
+const (
+	TransactionStatusPendingApproval = "pending_approval"
+)
+
+// Transaction heartbeat and idle reaping. An open transaction whose page has sent no heartbeat for
+// TransactionIdleTimeout is rolled back; TransactionIdleWarning before that its user is warned.
+const (
+	TransactionHeartbeatInterval = 30 * time.Second
+	TransactionIdleTimeout       = 15 * time.Minute
+	TransactionIdleWarning       = 2 * time.Minute
+	// TransactionReapInterval is how often the reaper looks for idle transactions
+	TransactionReapInterval = 30 * time.Second
+	// TransactionWatchInterval is how often a transaction event stream checks the user's transaction
+	TransactionWatchInterval = 5 * time.Second
+)
+
+// Shared editor sessions
+const (
+	// EditorShareTTL is how long an invite to watch a user's query editor stays valid
+	EditorShareTTL = 30 * time.Minute
+	// EditorShareWatchInterval is how often a shared editor stream checks for a new run
+	EditorShareWatchInterval = time.Second
+)
+
+// Transaction event types pushed to a user's transaction event stream
+const (
+	TransactionEventIdleWarning = "idle_warning"
+	TransactionEventTimeout     = "transaction_timeout"
+)
+
+// Template variable types
+const (
+	TemplateVariableText      = "text"
+	TemplateVariableInt       = "int"
+	TemplateVariableFloat     = "float"
+	TemplateVariableBool      = "bool"
+	TemplateVariableDate      = "date"
+	TemplateVariableTimestamp = "timestamp"
+)
+
+// Chart types
+const (
+	ChartTypeBar  = "bar"
+	ChartTypeLine = "line"
+)
+
 // Sort directions
 const (
 	SortDirectionASC  = "ASC"
@@ -160,3 +409,420 @@ const (
 	ContextKeyUser        = "user"
 	ContextKeySession     = "session"
 )
+
+// Audit actions
+const (
+	AuditActionCommit                  = "commit"
+	AuditActionImport                  = "import"
+	AuditActionRevokeSession           = "revoke_session"
+	AuditActionSaveConnectionProfile   = "save_connection_profile"
+	AuditActionDeleteConnectionProfile = "delete_connection_profile"
+	AuditActionImportProfiles          = "import_connection_profiles"
+	AuditActionMaintenance             = "maintenance"
+	AuditActionTerminateBackend        = "terminate_backend"
+	AuditActionSessionSettings         = "session_settings"
+	AuditActionCreateIndex             = "create_index"
+	AuditActionDropIndex               = "drop_index"
+	AuditActionAddConstraint           = "add_constraint"
+	AuditActionDropConstraint          = "drop_constraint"
+	AuditActionLogin                   = "login"
+	AuditActionQuery                   = "query"
+	AuditActionExport                  = "export"
+	AuditActionMaskedRead              = "masked_read"
+	AuditActionSetSequence             = "set_sequence"
+	AuditActionRestartSequence         = "restart_sequence"
+	AuditActionEnableTrigger           = "enable_trigger"
+	AuditActionDisableTrigger          = "disable_trigger"
+	AuditActionRestoreRows             = "restore_rows"
+	AuditActionSetEnvironment          = "set_environment"
+	AuditActionDisableShortcuts        = "disable_shortcuts"
+	AuditActionCreateTable             = "create_table"
+	AuditActionAlterTable              = "alter_table"
+	AuditActionCreateDatabase          = "create_database"
+	AuditActionCreateSchema            = "create_schema"
+	AuditActionTransactionTimeout      = "transaction_timeout"
+	AuditActionSetComment              = "set_comment"
+	AuditActionWatchSharedEditor       = "watch_shared_editor"
+	AuditActionCreateRole              = "create_role"
+	AuditActionRequestRejected         = "request_rejected"
+)
+
+// Audit log export formats
+const (
+	AuditExportCSV  = "csv"
+	AuditExportJSON = "json"
+)
+
+// EncryptedValueMask is shown in place of encrypted column values for roles that may not decrypt them
+const EncryptedValueMask = "[encrypted]"
+
+// ExportRuleWildcard matches any role or any table in an ExportRule
+const ExportRuleWildcard = "*"
+
+// PostgresDocsBaseURL is where the links to the PostgreSQL documentation for database errors start
+const PostgresDocsBaseURL = "https://www.postgresql.org/docs/current/"
+
+// DefaultMaskedRowLimit is how many rows one request may read from a table whose encrypted columns
+// stay masked for the user, when AppConfig.MaskedRowLimit is unset
+const DefaultMaskedRowLimit = 100
+
+// Sources of a column's allowed cell values
+const (
+	CellValueSourceEnum  = "enum"
+	CellValueSourceCheck = "check"
+)
+
+// Query notebook cell kinds
+const (
+	NotebookCellSQL      = "sql"
+	NotebookCellMarkdown = "markdown"
+)
+
+// Structured JSONB cell edit operations
+const (
+	JSONPatchOpSet    = "set"
+	JSONPatchOpRemove = "remove"
+)
+
+// Table export formats
+const (
+	ExportFormatJSON   = "json"
+	ExportFormatNDJSON = "ndjson"
+	ExportFormatXLSX   = "xlsx"
+)
+
+// ExportFormatReport is the format printed reports are audited under, alongside the export formats
+const ExportFormatReport = "report"
+
+// Transaction buffer export formats
+const (
+	TransactionExportSQL       = "sql"
+	TransactionExportJSONPatch = "json-patch"
+)
+
+// Per-session connection pool defaults used when ConnectionPoolConfig leaves a field at zero
+const (
+	DefaultPoolMaxConns          = 4
+	DefaultPoolMaxIdleTime       = 5 * time.Minute
+	DefaultPoolHealthCheckPeriod = time.Minute
+	DefaultPoolQueueTimeout      = 5 * time.Second
+)
+
+// Connection tagging: lumen-pg's connections set application_name to "<prefix>/<user>/<feature>", so
+// activity in pg_stat_activity can be traced to a user and the part of lumen-pg that ran it
+const (
+	// DefaultApplicationNamePrefix is the prefix used until another is set
+	DefaultApplicationNamePrefix = "lumen-pg"
+
+	ApplicationFeatureSession = "session"
+	// ApplicationFeatureShared tags the shared connection serving requests outside any session
+	ApplicationFeatureShared      = "shared"
+	ApplicationFeatureQueryEditor = "query-editor"
+	ApplicationFeatureExport      = "export"
+	ApplicationFeatureImport      = "import"
+)
+
+// Session store backends
+const (
+	SessionStoreMemory = "memory"
+	SessionStoreRedis  = "redis"
+	SessionStoreFile   = "file"
+)
+
+// App data backends
+const (
+	AppDataMemory   = "memory"
+	AppDataPostgres = "postgres"
+	AppDataFile     = "file"
+)
+
+// Deleted row restore
+const (
+	// DefaultDeletedRowRetention is used when AppConfig.DeletedRowRetention is zero
+	DefaultDeletedRowRetention = 7 * 24 * time.Hour
+)
+
+// Slow query log
+const (
+	// DefaultSlowQueryThreshold is used when AppConfig.SlowQueryThreshold is zero
+	DefaultSlowQueryThreshold = time.Second
+	// SlowQueryLogSize is how many slow queries the logger keeps; older ones are dropped
+	SlowQueryLogSize = 200
+)
+
+// Slow operation log and index advisor
+const (
+	// SlowOperationFilter marks a grid filter that took longer than the slow filter threshold
+	SlowOperationFilter = "filter"
+	// DefaultSlowFilterThreshold is used when AppConfig.SlowFilterThreshold is zero
+	DefaultSlowFilterThreshold = time.Second
+	// SlowOperationLogSize is how many slow operations are kept; older ones are dropped
+	SlowOperationLogSize = 500
+	// IndexAdvisorMinRows is the estimated table size below which a sequential scan is not worth an index
+	IndexAdvisorMinRows = 10000
+	// IndexAdvisorMaxSelectivity is the largest estimated share of a table's rows a filter may keep for
+	// an index on its columns to be suggested
+	IndexAdvisorMaxSelectivity = 0.05
+)
+
+// Stored function kinds
+const (
+	FunctionKindFunction  = "function"
+	FunctionKindProcedure = "procedure"
+)
+
+// Stored function parameter modes
+const (
+	FunctionParameterModeIn       = "IN"
+	FunctionParameterModeOut      = "OUT"
+	FunctionParameterModeInOut    = "INOUT"
+	FunctionParameterModeVariadic = "VARIADIC"
+	FunctionParameterModeTable    = "TABLE"
+)
+
+// Cached editor results
+const (
+	// ResultCacheRows is how many rows of an editor result are kept for re-sorting and filtering,
+	// the same window the editor lets users page through
+	ResultCacheRows = 1000
+	// ResultCachePerUser is how many results are kept per user; older ones are dropped
+	ResultCachePerUser = 5
+	// ResultCacheTTL is how long a result is kept after its query ran
+	ResultCacheTTL = 30 * time.Minute
+)
+
+// Table snapshots
+const (
+	// TableSnapshotMaxRows is the most rows a table may have to be snapshotted or compared, since
+	// both copies of it are held in memory
+	TableSnapshotMaxRows = 1000
+	// TableSnapshotPerUser is how many snapshots are kept per user; older ones are dropped
+	TableSnapshotPerUser = 10
+	// TableSnapshotTTL is how long a snapshot is kept after it was taken, long enough for a batch
+	// job run overnight
+	TableSnapshotTTL = 24 * time.Hour
+)
+
+// Row watch modes
+const (
+	// RowWatchModeNotify wakes the watch from a trigger that NOTIFYs on every write to the table
+	RowWatchModeNotify = "notify"
+	// RowWatchModePoll re-checks the filter on an interval; used when the trigger cannot be installed
+	RowWatchModePoll = "poll"
+)
+
+// Row change operations reported by a row watch
+const (
+	RowChangeInsert = "INSERT"
+	RowChangeUpdate = "UPDATE"
+	RowChangeDelete = "DELETE"
+)
+
+// Row watch notification plumbing
+const (
+	// RowWatchChannelPrefix starts the name of each watch's NOTIFY channel, trigger and trigger function
+	RowWatchChannelPrefix = "lumen_watch_"
+	// NotificationCheckInterval is how often a LISTEN connection is polled for delivered notifications
+	NotificationCheckInterval = time.Second
+	// RowWatchSettleDelay gathers a burst of notifications into one check of the filter
+	RowWatchSettleDelay = 250 * time.Millisecond
+	// RowWatchLockClass is the first key of the advisory lock a listener holds on its channel; the
+	// second is the channel's hashtext
+	RowWatchLockClass = 0x6c77
+)
+
+// DefaultSessionKeyPrefix namespaces session keys in Redis
+const DefaultSessionKeyPrefix = "lumen:"
+
+// Insert conflict modes
+const (
+	ConflictModeError  = "error"
+	ConflictModeSkip   = "skip"
+	ConflictModeUpdate = "update"
+)
+
+// DefaultConnectionProfile is the server sessions connect to when no connection profiles are configured
+var DefaultConnectionProfile = ConnectionProfile{
+	Name:    "default",
+	Host:    "localhost",
+	Port:    5432,
+	SSLMode: "disable",
+}
+
+// ConnectionSSLModes lists the sslmode values a connection profile may use
+var ConnectionSSLModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// Connection profile export, carrying profiles from one instance to another
+const (
+	ConnectionProfileExportVersion  = 1
+	ConnectionProfileMaxUploadBytes = 1 << 20
+)
+
+// Secret-provider references name a secret as "<provider>:<name>" instead of holding it: "env:NAME"
+// reads an environment variable and "file:/path" a file's contents
+const (
+	SecretProviderEnv  = "env"
+	SecretProviderFile = "file"
+)
+
+// SecretProviders lists the providers a secret reference may use
+var SecretProviders = []string{SecretProviderEnv, SecretProviderFile}
+
+// lumen-pg's own persistence
+const (
+	// AppSchema is the schema holding lumen-pg's own tables, kept apart from the schemas users browse
+	AppSchema = "lumen_pg"
+	// AppSchemaLockKey is the advisory lock key held while migrating, so instances starting together
+	// apply each migration once
+	AppSchemaLockKey = 0x6c756d656e
+)
+
+// HTTPS serving
+const (
+	DefaultHTTPSAddr        = ":443"
+	DefaultHTTPRedirectAddr = ":80"
+	DefaultAutocertCacheDir = "lumen-pg-certs"
+)
+
+// Password authentication backends
+const (
+	AuthBackendPostgres = "postgres"
+	AuthBackendLDAP     = "ldap"
+)
+
+// DefaultLDAPUserAttribute is the LDAP attribute matched against the login name
+const DefaultLDAPUserAttribute = "uid"
+
+// SessionActivityResolution is how stale a session's LastActivityAt may get before a request refreshes
+// it, so every request does not rewrite the session
+const SessionActivityResolution = time.Minute
+
+// IndexMethods lists the index access methods indexes may be created with
+var IndexMethods = []string{"btree", "hash", "gist", "spgist", "gin", "brin"}
+
+// Constraint types of TableConstraint and ConstraintDefinition
+const (
+	ConstraintTypePrimaryKey = "primary_key"
+	ConstraintTypeUnique     = "unique"
+	ConstraintTypeCheck      = "check"
+	ConstraintTypeForeignKey = "foreign_key"
+)
+
+// Column operations of a ColumnChange made in the table designer
+const (
+	ColumnOperationAdd            = "add_column"
+	ColumnOperationRename         = "rename_column"
+	ColumnOperationDrop           = "drop_column"
+	ColumnOperationSetType        = "set_type"
+	ColumnOperationSetDefault     = "set_default"
+	ColumnOperationDropDefault    = "drop_default"
+	ColumnOperationSetNotNull     = "set_not_null"
+	ColumnOperationDropNotNull    = "drop_not_null"
+	ColumnOperationSetPrimaryKey  = "set_primary_key"
+	ColumnOperationDropPrimaryKey = "drop_primary_key"
+)
+
+// Kinds of ColumnDependent, the objects a column rename or drop reaches
+const (
+	ColumnDependentView             = "view"
+	ColumnDependentMaterializedView = "materialized_view"
+	ColumnDependentIndex            = "index"
+	ColumnDependentConstraint       = "constraint"
+	ColumnDependentTrigger          = "trigger"
+	ColumnDependentFunction         = "function"
+)
+
+// Relation types of ERDRelationship, read from the referencing table's side
+const (
+	RelationTypeOneToMany = "one-to-many"
+	RelationTypeManyToOne = "many-to-one"
+	RelationTypeOneToOne  = "one-to-one"
+)
+
+// Trigger timings of TableTrigger
+const (
+	TriggerTimingBefore    = "BEFORE"
+	TriggerTimingAfter     = "AFTER"
+	TriggerTimingInsteadOf = "INSTEAD OF"
+)
+
+// ForeignKeyActions lists the referential actions a foreign key may take on delete or update
+var ForeignKeyActions = []string{"NO ACTION", "RESTRICT", "CASCADE", "SET NULL", "SET DEFAULT"}
+
+// MaxIdentifierLength is the longest identifier PostgreSQL keeps; longer names are truncated
+const MaxIdentifierLength = 63
+
+// AccountActivityLimit is how many of each kind of activity the account activity page shows
+const AccountActivityLimit = 25
+
+// LoginLocationHistory is how many of a user's recent logins a new login's location is compared with
+const LoginLocationHistory = 50
+
+// Operators of FilterPredicate
+const (
+	FilterOperatorEqual        = "eq"
+	FilterOperatorNotEqual     = "neq"
+	FilterOperatorLess         = "lt"
+	FilterOperatorLessEqual    = "lte"
+	FilterOperatorGreater      = "gt"
+	FilterOperatorGreaterEqual = "gte"
+	FilterOperatorLike         = "like"
+	FilterOperatorILike        = "ilike"
+	FilterOperatorIn           = "in"
+	FilterOperatorIsNull       = "is_null"
+	FilterOperatorIsNotNull    = "is_not_null"
+)
+
+// Groups of FilterPredicate, joining its predicates
+const (
+	FilterGroupAnd = "and"
+	FilterGroupOr  = "or"
+)
+
+// FilterMaxPredicates caps the comparisons a structured filter may compile into
+const FilterMaxPredicates = 100
+
+const (
+	// TableSearchMaxRows caps the matching rows a table search returns
+	TableSearchMaxRows = 200
+	// TableSearchMaxTermLength caps the length of a table search term
+	TableSearchMaxTermLength = 200
+	// TableSearchTimeout bounds a table search, since matching every text column cannot use an index
+	TableSearchTimeout = 10 * time.Second
+)
+
+// Kinds of ObjectSearchMatch
+const (
+	ObjectKindTable    = "table"
+	ObjectKindView     = "view"
+	ObjectKindColumn   = "column"
+	ObjectKindFunction = "function"
+)
+
+// Ranks of ObjectSearchMatch, from the closest match of the term to the loosest
+const (
+	// ObjectMatchExact is a name equal to the term, ignoring case
+	ObjectMatchExact = iota
+	// ObjectMatchPrefix is a name starting with the term
+	ObjectMatchPrefix
+	// ObjectMatchWord is a name with a word, after an underscore or dot, starting with the term
+	ObjectMatchWord
+	// ObjectMatchContains is a name containing the term anywhere
+	ObjectMatchContains
+)
+
+// ObjectSearchMaxResults caps the matches an object search returns
+const ObjectSearchMaxResults = 50
+
+// Stages of RoleMetadata discovery still in progress
+const (
+	MetadataStageSchemas = "schemas"
+	MetadataStageTables  = "tables"
+)
+
+// MetadataQueryTimeout bounds each catalog query of role metadata discovery, so a database with
+// many objects leaves its lists partial instead of stalling login
+const MetadataQueryTimeout = 5 * time.Second
+
+// TableSizeBatchLimit caps the tables whose size estimates one data explorer request reads
+const TableSizeBatchLimit = 200
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // QueryRequest represents a request to execute a query
 type QueryRequest struct {
 	Query  string
@@ -67,6 +69,8 @@ type ERDRelationship struct {
 	ToTable      string
 	ToColumn     string
 	RelationType string // "one-to-many", "many-to-one", "one-to-one"
+	// Optional reports that the referencing column is nullable, so a row may reference nothing
+	Optional bool
 }
 
 // ERDData represents complete ERD data
@@ -78,6 +82,18 @@ type ERDData struct {
 	PanY          int
 }
 
+// SchemaGraph represents a schema's tables and the foreign keys between them for a diagram; with a
+// Root it holds only the tables within Depth references of the root, or its whole connected part when
+// Depth is 0
+type SchemaGraph struct {
+	Database      string
+	Schema        string
+	Root          string
+	Depth         int
+	Tables        []ERDTable
+	Relationships []ERDRelationship
+}
+
 // HeaderData represents data for the application header
 type HeaderData struct {
 	Username          string
@@ -107,6 +123,8 @@ type PermissionSet struct {
 	CanDelete  bool
 	CanConnect bool
 	CanUsage   bool
+	// CanExport reports whether the user may export the table's data: SELECT plus the export policy
+	CanExport bool
 }
 
 // TableInfo represents information about a table
@@ -237,3 +255,698 @@ type ChildTableInfo struct {
 	ForeignKeyColumn string
 	RowCount         int64
 }
+
+// ColumnAggregate represents aggregate values computed over a single numeric column
+type ColumnAggregate struct {
+	Column string
+	Count  int64
+	Sum    *float64
+	Avg    *float64
+	Min    *float64
+	Max    *float64
+}
+
+// GroupByParams represents parameters for a group-by pivot preview
+type GroupByParams struct {
+	Database        string
+	Schema          string
+	Table           string
+	GroupColumn     string
+	AggregateColumn string // optional column to aggregate per group
+	AggregateFunc   string // "SUM", "AVG", "MIN", "MAX" or "COUNT"
+	WhereClause     string
+	Limit           int
+}
+
+// GroupByBucket represents a single group in a group-by pivot preview
+type GroupByBucket struct {
+	Value           interface{}
+	Count           int64
+	Aggregate       *float64
+	DrillDownFilter string // WHERE clause selecting the rows of this group
+}
+
+// GroupByResult represents the summarized result of a group-by pivot preview
+type GroupByResult struct {
+	GroupColumn     string
+	AggregateColumn string
+	AggregateFunc   string
+	Groups          []GroupByBucket
+}
+
+// HistogramParams represents parameters for a column histogram
+type HistogramParams struct {
+	Database    string
+	Schema      string
+	Table       string
+	Column      string
+	WhereClause string
+	Buckets     int
+	IsTemporal  bool // set for date/timestamp columns, bounds are then returned as time.Time
+}
+
+// HistogramBucket represents a single equal-width bucket of a histogram
+type HistogramBucket struct {
+	LowerBound interface{}
+	UpperBound interface{}
+	Count      int64
+}
+
+// HistogramResult represents the distribution of a column's values
+type HistogramResult struct {
+	Column     string
+	IsTemporal bool
+	Buckets    []HistogramBucket
+}
+
+// DuplicateParams represents parameters for a duplicate row report
+type DuplicateParams struct {
+	Database    string
+	Schema      string
+	Table       string
+	Columns     []string
+	PrimaryKeys []string // used to collect sample rows of each group
+	WhereClause string
+	Limit       int
+	SampleSize  int
+}
+
+// DuplicateGroup represents a set of rows sharing identical values
+type DuplicateGroup struct {
+	Values            map[string]interface{}
+	Count             int64
+	SamplePrimaryKeys []map[string]interface{}
+}
+
+// DuplicateReport represents the duplicate groups found in a table
+type DuplicateReport struct {
+	Columns []string
+	Groups  []DuplicateGroup
+}
+
+// ColumnQualityStats represents NULL and empty-value statistics of a column
+type ColumnQualityStats struct {
+	Column       string
+	DataType     string
+	NullCount    int64
+	EmptyCount   int64
+	NullPercent  float64
+	EmptyPercent float64
+}
+
+// DataQualityReport represents per-column data quality statistics of a table
+type DataQualityReport struct {
+	Table       string
+	SampledRows int64
+	Columns     []ColumnQualityStats
+}
+
+// IndexUsageStats represents an index's scan counters from pg_stat_user_indexes
+type IndexUsageStats struct {
+	Name          string
+	Scans         int64
+	TuplesRead    int64
+	TuplesFetched int64
+	SizeBytes     int64
+	// Unique is set for unique and primary key indexes, which enforce constraints however rarely scanned
+	Unique bool
+}
+
+// TableSizeEstimate is the planner's row estimate and on-disk size of a table, cheap enough to read
+// for every table listed in the data explorer
+type TableSizeEstimate struct {
+	Schema string
+	Table  string
+	// EstimatedRows is nil until the table has been vacuumed or analyzed
+	EstimatedRows  *int64
+	TotalSizeBytes int64
+}
+
+// TableStatistics represents a table's activity counters from pg_stat_user_tables with an estimate of
+// its bloat. The repository fills the counters and the estimate inputs; the percentages, the estimate
+// and the unused indexes are derived from them.
+type TableStatistics struct {
+	Table                     string
+	LiveTuples                int64
+	DeadTuples                int64
+	ModificationsSinceAnalyze int64
+	SeqScans                  int64
+	SeqTuplesRead             int64
+	IndexScans                int64
+	IndexTuplesFetched        int64
+	LastVacuum                *time.Time
+	LastAutovacuum            *time.Time
+	LastAnalyze               *time.Time
+	LastAutoanalyze           *time.Time
+	VacuumCount               int64
+	AutovacuumCount           int64
+	AnalyzeCount              int64
+	AutoanalyzeCount          int64
+	TableSizeBytes            int64
+	IndexesSizeBytes          int64
+	TotalSizeBytes            int64
+	Indexes                   []IndexUsageStats
+
+	// Pages and EstimatedRows are pg_class.relpages and reltuples; EstimatedRows is negative until the
+	// table is first vacuumed or analyzed
+	Pages         int64
+	EstimatedRows float64
+	// RowWidth is the average row width pg_stats reports, nil until the table is analyzed
+	RowWidth   *int64
+	FillFactor int
+	BlockSize  int64
+
+	DeadTuplePercent float64
+	// IndexScanPercent is the share of scans that used an index, nil before the table is scanned
+	IndexScanPercent *float64
+	// EstimatedBloatBytes and EstimatedBloatPercent are nil when the table has no statistics to estimate from
+	EstimatedBloatBytes   *int64
+	EstimatedBloatPercent *float64
+	UnusedIndexes         []string
+}
+
+// TableIndex represents an index of a table with its definition, size and usage
+type TableIndex struct {
+	Name       string
+	Definition string
+	Method     string
+	Unique     bool
+	Primary    bool
+	// Valid is false while a concurrent build is running or after one failed
+	Valid bool
+	// Constraint names the primary key, unique or exclusion constraint the index backs; such an index
+	// goes with its constraint rather than with DROP INDEX
+	Constraint string
+	SizeBytes  int64
+	Scans      int64
+}
+
+// TableIndexList represents the indexes of a table as a user sees them
+type TableIndexList struct {
+	Indexes []TableIndex
+	// CanManage reports whether the user owns the table, as creating and dropping its indexes requires
+	CanManage bool
+}
+
+// IndexDefinition represents an index to create on a table
+type IndexDefinition struct {
+	Database string
+	Schema   string
+	Table    string
+	// Name is left to PostgreSQL when empty
+	Name    string
+	Columns []string
+	// Method is the access method, btree when empty
+	Method       string
+	Unique       bool
+	Concurrently bool
+}
+
+// IndexDrop represents an index to drop from a table
+type IndexDrop struct {
+	Database     string
+	Schema       string
+	Table        string
+	Name         string
+	Concurrently bool
+}
+
+// TableConstraint represents a primary key, unique, check or foreign key constraint of a table
+type TableConstraint struct {
+	Name string
+	// Type is one of the ConstraintType constants
+	Type    string
+	Columns []string
+	// Definition is pg_get_constraintdef output such as "FOREIGN KEY (customer_id) REFERENCES customers(id)"
+	Definition string
+	// ReferencedSchema, ReferencedTable and ReferencedColumns name what a foreign key references
+	ReferencedSchema  string
+	ReferencedTable   string
+	ReferencedColumns []string
+	// Validated is false for a constraint added NOT VALID whose existing rows have not been checked
+	Validated bool
+}
+
+// TableConstraintList represents the constraints of a table as a user sees them
+type TableConstraintList struct {
+	Constraints []TableConstraint
+	// CanManage reports whether the user owns the table, as adding and dropping its constraints requires
+	CanManage bool
+}
+
+// ConstraintDefinition represents a constraint to add to a table
+type ConstraintDefinition struct {
+	Database string
+	Schema   string
+	Table    string
+	// Name is left to PostgreSQL when empty
+	Name string
+	// Type is one of the ConstraintType constants
+	Type string
+	// Columns are the key columns of a primary key, unique or foreign key constraint
+	Columns []string
+	// Expression is the boolean expression of a check constraint
+	Expression string
+	// ReferencedSchema defaults to the table's schema and ReferencedColumns to the referenced table's
+	// primary key
+	ReferencedSchema  string
+	ReferencedTable   string
+	ReferencedColumns []string
+	// OnDelete and OnUpdate are ForeignKeyActions; empty keeps PostgreSQL's NO ACTION
+	OnDelete string
+	OnUpdate string
+}
+
+// ConstraintDrop represents a constraint to drop from a table
+type ConstraintDrop struct {
+	Database string
+	Schema   string
+	Table    string
+	Name     string
+}
+
+// ColumnDesign describes a column of a table created in the table designer
+type ColumnDesign struct {
+	Name     string
+	DataType string
+	// Default is an SQL expression such as now() or 'draft'; empty leaves the column without one
+	Default string
+	NotNull bool
+}
+
+// TableCreation represents a table to create in the table designer
+type TableCreation struct {
+	Database string
+	Schema   string
+	Table    string
+	Columns  []ColumnDesign
+	// PrimaryKey lists the primary key columns in order; empty creates the table without one
+	PrimaryKey []string
+}
+
+// ColumnChange is one change the table designer makes to a table
+type ColumnChange struct {
+	// Operation is one of the ColumnOperation constants
+	Operation string
+	// Column is the column changed, or the column added by ColumnOperationAdd
+	Column string
+	// NewName is the column's name after ColumnOperationRename
+	NewName string
+	// DataType is the type of an added column or the new type of ColumnOperationSetType
+	DataType string
+	// Using converts existing values for ColumnOperationSetType, such as amount::numeric; empty
+	// leaves the conversion to PostgreSQL's assignment cast
+	Using string
+	// Default is the SQL expression of ColumnOperationSetDefault or of an added column
+	Default string
+	// NotNull makes an added column NOT NULL
+	NotNull bool
+	// Columns are the new primary key columns of ColumnOperationSetPrimaryKey, in order
+	Columns []string
+}
+
+// TableAlteration represents changes to a table's columns made in the table designer, applied in order
+type TableAlteration struct {
+	Database string
+	Schema   string
+	Table    string
+	Changes  []ColumnChange
+}
+
+// DatabaseCreation represents a database to create from the data explorer
+type DatabaseCreation struct {
+	Name string
+	// Owner is the role owning the database; empty leaves it to the creating user
+	Owner string
+	// Encoding is a character set name such as UTF8; empty takes the template's encoding
+	Encoding string
+	// Template is the database copied; empty copies template1
+	Template string
+}
+
+// SchemaCreation represents a schema to create in a database from the data explorer
+type SchemaCreation struct {
+	Database string
+	Name     string
+	// Owner is the role owning the schema; empty leaves it to the creating user
+	Owner string
+}
+
+// TableTrigger represents a user-defined trigger of a table
+type TableTrigger struct {
+	Name string
+	// Timing is one of the TriggerTiming constants
+	Timing string
+	// Events lists the firing events among INSERT, UPDATE, DELETE and TRUNCATE, in that order
+	Events     []string
+	ForEachRow bool
+	// FunctionSchema and FunctionName name the trigger function
+	FunctionSchema string
+	FunctionName   string
+	// Definition is pg_get_triggerdef output such as "CREATE TRIGGER audit AFTER UPDATE ON ..."
+	Definition string
+	Enabled    bool
+}
+
+// TableTriggerList represents the triggers of a table as a user sees them
+type TableTriggerList struct {
+	Triggers []TableTrigger
+	// CanManage reports whether the user owns the table, as enabling and disabling its triggers requires
+	CanManage bool
+}
+
+// TableColumnDefinition represents a column as CREATE TABLE declares it
+type TableColumnDefinition struct {
+	Name string
+	// Type is format_type output such as "character varying(255)" or "numeric(10,2)"
+	Type    string
+	NotNull bool
+	// Default is the default expression, empty when there is none or the column is generated
+	Default string
+	// Identity is "ALWAYS" or "BY DEFAULT" for identity columns
+	Identity string
+	// Generated is the expression of a stored generated column
+	Generated string
+	// Collation is the quoted name of a collation other than the type's default
+	Collation string
+	Comment   string
+}
+
+// TableDefinition represents a table's columns and comment as read from the catalog
+type TableDefinition struct {
+	Columns []TableColumnDefinition
+	Comment string
+}
+
+// ColumnComment represents a column's pg_description comment
+type ColumnComment struct {
+	Name string
+	// Type is format_type output such as "character varying(255)"
+	Type    string
+	Comment string
+}
+
+// TableComments represents the comments on a table and its columns, in column order
+type TableComments struct {
+	Comment string
+	Columns []ColumnComment
+	// CanEdit reports whether the user owns the table, as COMMENT ON requires
+	CanEdit bool
+}
+
+// CommentChange represents a new comment on a table, or on one of its columns when Column is set;
+// an empty Comment removes the comment
+type CommentChange struct {
+	Database string
+	Schema   string
+	Table    string
+	Column   string
+	Comment  string
+}
+
+// TableDDL represents the reconstructed SQL that recreates a table's structure: CREATE TABLE with its
+// columns and constraints, then its other indexes and its comments
+type TableDDL struct {
+	Database  string
+	Schema    string
+	Table     string
+	Statement string
+}
+
+// TriggerToggle represents a trigger of a table to enable or disable
+type TriggerToggle struct {
+	Database string
+	Schema   string
+	Table    string
+	Name     string
+	Enable   bool
+}
+
+// Sequence represents a sequence with its settings, its position and the column owning it, as a
+// user sees it
+type Sequence struct {
+	Schema   string
+	Name     string
+	DataType string
+	// LastValue is nil until nextval or setval is first called on the sequence
+	LastValue  *int64
+	StartValue int64
+	Increment  int64
+	MinValue   int64
+	MaxValue   int64
+	Cycle      bool
+	// OwnedByTable and OwnedByColumn name the column the sequence is owned by, such as a serial or
+	// identity column, and are empty for a free-standing sequence
+	OwnedByTable  string
+	OwnedByColumn string
+	// CanSet reports whether the user holds UPDATE on the sequence, as setval requires
+	CanSet bool
+	// CanRestart reports whether the user owns the sequence, as ALTER SEQUENCE ... RESTART requires
+	CanRestart bool
+}
+
+// SequencePermissions represents a role's privileges on a sequence
+type SequencePermissions struct {
+	CanSelect bool
+	CanUsage  bool
+	CanUpdate bool
+	IsOwner   bool
+}
+
+// SequenceValue represents a value to move a sequence to with setval
+type SequenceValue struct {
+	Database string
+	Schema   string
+	Name     string
+	Value    int64
+	// IsCalled makes the next nextval return the value after Value rather than Value itself
+	IsCalled bool
+}
+
+// SequenceRestart represents a sequence to restart with ALTER SEQUENCE ... RESTART
+type SequenceRestart struct {
+	Database string
+	Schema   string
+	Name     string
+	// Value is where the sequence restarts; nil restarts it at its start value
+	Value *int64
+}
+
+// StoredFunction represents a function or procedure with its signature and language
+type StoredFunction struct {
+	Schema string
+	Name   string
+	// Kind is FunctionKindFunction or FunctionKindProcedure
+	Kind string
+	// Arguments is the identity signature telling overloads apart, as pg_get_function_identity_arguments prints it
+	Arguments string
+	// Result is the declared return type, empty for procedures
+	Result     string
+	Language   string
+	Parameters []FunctionParameter
+	// CanExecute reports whether the user holds EXECUTE on the routine
+	CanExecute bool
+}
+
+// FunctionParameter represents one parameter of a function or procedure, in declaration order
+type FunctionParameter struct {
+	// Name is empty for unnamed parameters
+	Name     string
+	DataType string
+	// Mode is one of the FunctionParameterMode constants
+	Mode       string
+	HasDefault bool
+}
+
+// FunctionArgument represents the value given for one input parameter of a routine call
+type FunctionArgument struct {
+	Value string
+	// Null passes NULL instead of Value
+	Null bool
+	// Default leaves the parameter to its default; only trailing parameters with defaults may
+	Default bool
+}
+
+// FunctionCall represents a call of a function or procedure, one argument per input parameter
+type FunctionCall struct {
+	Database  string
+	Schema    string
+	Name      string
+	Arguments string
+	Values    []FunctionArgument
+}
+
+// FunctionCallResult represents the statement a routine call ran as and what it returned
+type FunctionCallResult struct {
+	Statement string
+	Result    *QueryResult
+}
+
+// SchemaChange represents the statement generated for a change to a table's structure or to a
+// sequence; it runs only once the statement has been confirmed
+type SchemaChange struct {
+	Statement string
+	Applied   bool
+	// Impact lists the objects referencing the columns a table designer change renames or drops, so
+	// the preview shows what the change would break before it is confirmed
+	Impact []ColumnDependent
+}
+
+// ColumnDependent is an object referencing a table column, which renaming or dropping the column affects
+type ColumnDependent struct {
+	// Kind is one of the ColumnDependent constants
+	Kind   string
+	Schema string
+	Name   string
+	// Column is the referenced column
+	Column string
+	// Definition is the object's SQL, such as a view's query or a function's body
+	Definition string
+	// Inferred reports an object found by the column's name appearing in its source rather than by a
+	// dependency PostgreSQL records; function bodies are only checked this way
+	Inferred bool
+}
+
+// OrphanCheckParams represents parameters for checking a single reference for orphaned values
+type OrphanCheckParams struct {
+	Database  string
+	Schema    string
+	Table     string
+	Reference ForeignKeyMetadata
+	Limit     int
+}
+
+// OrphanedReference represents the rows of a reference pointing to missing parent rows
+type OrphanedReference struct {
+	Reference   ForeignKeyMetadata
+	OrphanCount int64
+	Rows        *QueryResult
+}
+
+// ReferentialIntegrityReport represents the orphaned values found in a table
+type ReferentialIntegrityReport struct {
+	Table      string
+	References []OrphanedReference
+}
+
+// ForeignKeyLookupParams represents a type-ahead search of a referenced table by its key and display
+// columns
+type ForeignKeyLookupParams struct {
+	Database       string
+	Schema         string
+	Table          string
+	KeyColumn      string
+	DisplayColumns []string
+	Term           string
+	Limit          int
+}
+
+// ForeignKeyLookup represents the parent rows a foreign key cell may be set to
+type ForeignKeyLookup struct {
+	Column         string
+	Reference      ForeignKeyMetadata
+	DisplayColumns []string
+	Matches        []ForeignKeyMatch
+}
+
+// ForeignKeyMatch is a parent row's key with its display columns joined into a label
+type ForeignKeyMatch struct {
+	Value interface{}
+	Label string
+}
+
+// JoinCondition represents an equality condition between a column of each joined table
+type JoinCondition struct {
+	LeftColumn  string
+	RightColumn string
+}
+
+// JoinViewParams represents parameters for browsing a two-table join
+// Result columns are aliased as "<table>.<column>", which WhereClause and OrderBy refer to
+type JoinViewParams struct {
+	Database     string
+	LeftSchema   string
+	LeftTable    string
+	LeftColumns  []string
+	RightSchema  string
+	RightTable   string
+	RightColumns []string
+	JoinType     string // "INNER" or "LEFT"
+	Conditions   []JoinCondition
+	WhereClause  string
+	OrderBy      string
+	OrderDir     string
+	Offset       int
+	Limit        int
+}
+
+// HistoryTableConfig describes the history companion of a table
+// With PeriodColumn set it follows the system versioning pattern: current rows stay in the
+// source table and past versions move to the history table, both carrying a tstzrange period.
+// Otherwise the history table holds every version with ValidFrom/ValidTo columns (NULL ValidTo is current).
+type HistoryTableConfig struct {
+	Database        string
+	Schema          string
+	Table           string
+	HistorySchema   string
+	HistoryTable    string
+	Columns         []string // columns shared by both tables
+	PrimaryKeys     []string
+	PeriodColumn    string
+	ValidFromColumn string
+	ValidToColumn   string
+}
+
+// AuditFilter represents criteria for listing audit entries
+type AuditFilter struct {
+	Username string
+	Action   string
+	Database string
+	Schema   string
+	Table    string
+	// Since and Until bound the entries' creation time; zero leaves that end open
+	Since time.Time
+	Until time.Time
+	Limit int
+}
+
+// RejectionStat counts the requests one rule of a rejection source refused on one route
+type RejectionStat struct {
+	Source   string
+	Rule     string
+	Method   string
+	Route    string
+	Count    int
+	LastSeen time.Time
+}
+
+// AccountActivity represents a user's own recent logins, queries and exports, newest first; query
+// statements are kept with their constants replaced by placeholders
+type AccountActivity struct {
+	Logins  []AuditEntry
+	Queries []AuditEntry
+	Exports []AuditEntry
+}
+
+// ResultView represents how to sort, filter and page a cached editor result
+type ResultView struct {
+	// SortColumn orders the rows by one of the result's columns; empty keeps the query's order
+	SortColumn string
+	SortDir    string
+	// Filter keeps rows with any value containing it, ignoring case
+	Filter string
+	Offset int
+	Limit  int
+}
+
+// RowRestore represents the compensating INSERT generated to put back the rows a commit deleted; it
+// runs only once the statement has been confirmed
+type RowRestore struct {
+	EntryID   string
+	Database  string
+	Schema    string
+	Table     string
+	Statement string
+	Rows      int
+	Applied   bool
+}
@@ -1,6 +1,17 @@
 package domain
 
-import "time"
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // DatabaseMetadata represents metadata about a database
 type DatabaseMetadata struct {
@@ -12,6 +23,10 @@ type DatabaseMetadata struct {
 type SchemaMetadata struct {
 	Name   string
 	Tables []TableMetadata
+	// Views are the schema's views and materialized views, with their columns
+	Views []TableMetadata
+	// Functions are the names of the schema's functions and procedures
+	Functions []string
 }
 
 // TableMetadata represents metadata about a table
@@ -22,12 +37,51 @@ type TableMetadata struct {
 	ForeignKeys []ForeignKeyMetadata
 }
 
+// FindTable returns the metadata of the named table in schema, or ErrTableNotFound
+func (m *DatabaseMetadata) FindTable(schema, table string) (*TableMetadata, error) {
+	for _, schemaMetadata := range m.Schemas {
+		if schemaMetadata.Name == schema {
+			for i := range schemaMetadata.Tables {
+				if schemaMetadata.Tables[i].Name == table {
+					return &schemaMetadata.Tables[i], nil
+				}
+			}
+		}
+	}
+	return nil, ErrTableNotFound
+}
+
+// FindColumn returns the metadata of the named column, or nil if the table has none by that name
+func (t *TableMetadata) FindColumn(column string) *ColumnMetadata {
+	for i := range t.Columns {
+		if t.Columns[i].Name == column {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
 // ColumnMetadata represents metadata about a column
 type ColumnMetadata struct {
 	Name       string
 	DataType   string
 	IsNullable bool
 	IsPrimary  bool
+	// HasDefault reports whether the column has a default value or is an identity column
+	HasDefault bool
+	// NumericPrecision and NumericScale are the declared numeric(p,s) limits; zero for other types
+	// and for numeric columns declared without a precision
+	NumericPrecision int
+	NumericScale     int
+	// EnumValues are the labels of an enum column's type in their declared order; nil for other types
+	EnumValues []string
+}
+
+// CheckConstraint is a table CHECK constraint; Definition is pg_get_constraintdef output such as
+// "CHECK ((price >= (0)::numeric))"
+type CheckConstraint struct {
+	Name       string
+	Definition string
 }
 
 // ForeignKeyMetadata represents metadata about a foreign key relationship
@@ -45,6 +99,12 @@ type RoleMetadata struct {
 	AccessibleDatabases []string
 	AccessibleSchemas   []string
 	AccessibleTables    []AccessibleTable
+	// Loading is the discovery stage still filling the lists, MetadataStageSchemas or
+	// MetadataStageTables; empty once discovery is done
+	Loading string
+	// Incomplete lists the databases and "database.schema" pairs that could not be listed within
+	// MetadataQueryTimeout, so their schemas or tables are missing
+	Incomplete []string
 }
 
 // AccessibleTable represents a table accessible by a role
@@ -73,6 +133,103 @@ type Session struct {
 	Username  string
 	CreatedAt time.Time
 	ExpiresAt time.Time
+	// SearchPath overrides the server's search_path for editor queries; empty keeps the default
+	SearchPath []string
+	// EncryptedPassword is the user's database password encrypted by the EncryptionRepository, kept so
+	// the session can reconnect after a server restart
+	EncryptedPassword string
+	// Identity is the single sign-on identity the session was mapped from; empty for password logins
+	Identity string
+	// LastActivityAt is when the session was last used, refreshed at most every SessionActivityResolution
+	LastActivityAt time.Time
+	// ClientIP is the address the session logged in from
+	ClientIP string
+	// Profile names the connection profile whose server the session is connected to
+	Profile string
+	// Database is the database the session logged in to, which its connection pool is opened on
+	Database string
+	// Settings are applied to every connection the session's editor queries check out
+	Settings SessionSettings
+}
+
+// SessionSettings are the GUC presets of a session; zero values keep the server defaults
+type SessionSettings struct {
+	// WorkMem sets work_mem, such as "64MB"; a bare number is in kilobytes
+	WorkMem          string
+	StatementTimeout time.Duration
+	// ApplicationNameSuffix is appended to application_name so the session's backends stand out in
+	// pg_stat_activity
+	ApplicationNameSuffix string
+}
+
+// ParseSessionSettings reads session settings from form values. As in postgresql.conf, a bare
+// statement_timeout is in milliseconds; one with a unit such as "30s" is read as a Go duration.
+func ParseSessionSettings(workMem, statementTimeout, applicationNameSuffix string) (SessionSettings, error) {
+	settings := SessionSettings{WorkMem: workMem, ApplicationNameSuffix: applicationNameSuffix}
+
+	if value := strings.TrimSpace(statementTimeout); value != "" {
+		if milliseconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			settings.StatementTimeout = time.Duration(milliseconds) * time.Millisecond
+		} else if timeout, err := time.ParseDuration(value); err == nil {
+			settings.StatementTimeout = timeout
+		} else {
+			return settings, ValidationError{Field: "statement_timeout", Message: fmt.Sprintf("invalid statement_timeout: %s", value)}
+		}
+	}
+
+	return settings, nil
+}
+
+// workMemPattern matches a work_mem value in the units PostgreSQL accepts for it
+var workMemPattern = regexp.MustCompile(`^([0-9]+)\s*(kB|MB|GB|TB)?$`)
+
+var workMemUnitsKB = map[string]int64{"": 1, "kB": 1, "MB": 1 << 10, "GB": 1 << 20, "TB": 1 << 30}
+
+// Normalize checks the settings against the bounds PostgreSQL would enforce, so a bad preset is
+// refused when saved rather than failing every query that checks out a connection
+func (s SessionSettings) Normalize() (SessionSettings, error) {
+	s.WorkMem = strings.TrimSpace(s.WorkMem)
+	if s.WorkMem != "" {
+		match := workMemPattern.FindStringSubmatch(s.WorkMem)
+		if match == nil {
+			return s, ValidationError{Field: "work_mem", Message: fmt.Sprintf("invalid work_mem: %s", s.WorkMem)}
+		}
+		outOfRange := ValidationError{Field: "work_mem", Message: fmt.Sprintf("work_mem must be between %dkB and %dkB", SessionWorkMemMinKB, SessionWorkMemMaxKB)}
+		amount, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || amount > SessionWorkMemMaxKB {
+			return s, outOfRange
+		}
+		if kilobytes := amount * workMemUnitsKB[match[2]]; kilobytes < SessionWorkMemMinKB || kilobytes > SessionWorkMemMaxKB {
+			return s, outOfRange
+		}
+		s.WorkMem = match[1] + match[2]
+	}
+
+	if s.StatementTimeout < 0 || s.StatementTimeout > SessionStatementTimeoutMax {
+		return s, ValidationError{Field: "statement_timeout", Message: "statement_timeout is out of range"}
+	}
+
+	s.ApplicationNameSuffix = strings.TrimSpace(s.ApplicationNameSuffix)
+	if len(s.ApplicationNameSuffix) > SessionApplicationNameSuffixLimit {
+		return s, ValidationError{Field: "application_name", Message: fmt.Sprintf("application_name suffix cannot exceed %d characters", SessionApplicationNameSuffixLimit)}
+	}
+	// PostgreSQL replaces anything but printable ASCII in application_name with question marks
+	for _, r := range s.ApplicationNameSuffix {
+		if r < 0x20 || r > 0x7e {
+			return s, ValidationError{Field: "application_name", Message: "application_name suffix must be printable ASCII"}
+		}
+	}
+
+	return s, nil
+}
+
+// Summary is the JSON view of the settings
+func (s SessionSettings) Summary() map[string]interface{} {
+	return map[string]interface{}{
+		"work_mem":                s.WorkMem,
+		"statement_timeout_ms":    s.StatementTimeout.Milliseconds(),
+		"application_name_suffix": s.ApplicationNameSuffix,
+	}
 }
 
 // QueryResult represents the result of a SQL query execution
@@ -82,17 +239,117 @@ type QueryResult struct {
 	RowCount   int64
 	TotalCount int64
 	Error      string
+	// ResultID identifies the cached rows the result can be re-sorted and filtered from, when kept
+	ResultID string
+	// SpatialColumns are the PostGIS geometry and geography columns of table data; Spatial holds each
+	// row's non-NULL values of them rendered by PostGIS, while Rows keep the raw values for editing
+	SpatialColumns []SpatialColumn
+	Spatial        []map[string]SpatialValue
+}
+
+// SpatialColumn is a table column of the PostGIS geometry or geography type
+type SpatialColumn struct {
+	Name string
+	// Type is "geometry" or "geography"
+	Type string
+}
+
+// SpatialValue is a geometry or geography value as ST_AsGeoJSON and ST_AsText render it
+type SpatialValue struct {
+	GeoJSON string
+	WKT     string
+}
+
+// CachedResult is the first ResultCacheRows rows of an editor query, kept so they can be re-sorted
+// and filtered without running the query again
+type CachedResult struct {
+	ID       string
+	Username string
+	Query    string
+	Columns  []string
+	Rows     []map[string]interface{}
+	CachedAt time.Time
+}
+
+// QueryPlanNode represents one node of an EXPLAIN plan tree. Actual* and buffer fields are only
+// set when the plan was produced with ANALYZE
+type QueryPlanNode struct {
+	NodeType          string
+	RelationName      string
+	Alias             string
+	StartupCost       float64
+	TotalCost         float64
+	PlanRows          float64
+	PlanWidth         int
+	ActualStartupTime *float64
+	ActualTotalTime   *float64
+	ActualRows        *float64
+	ActualLoops       *float64
+	SharedHitBlocks   *int64
+	SharedReadBlocks  *int64
+	// Details holds the remaining node properties, such as filters and join conditions
+	Details  map[string]interface{}
+	Children []QueryPlanNode
+}
+
+// QueryPlan represents the result of EXPLAIN for a single statement
+type QueryPlan struct {
+	Root          QueryPlanNode
+	Analyzed      bool
+	PlanningTime  *float64
+	ExecutionTime *float64
+}
+
+// ChartSeries represents one numeric column of a chart; NULL values stay nil
+type ChartSeries struct {
+	Name   string
+	Values []*float64
+}
+
+// ChartData represents a query result reshaped for bar and line charts
+type ChartData struct {
+	LabelColumn   string
+	Labels        []string
+	Series        []ChartSeries
+	SuggestedType string
 }
 
 // TransactionState represents an active transaction
 type TransactionState struct {
 	ID        string
 	Username  string
+	Database  string
+	Schema    string
+	Table     string
 	StartedAt time.Time
 	ExpiresAt time.Time
 	Edits     map[int]RowEdit
 	Deletes   []int
-	Inserts   []RowInsert
+	// KeyDeletes lists rows buffered for deletion by primary key, keyed by column name
+	KeyDeletes []map[string]interface{}
+	// BulkUpdates lists filter-based column updates applied as single UPDATE statements on commit
+	BulkUpdates []BulkUpdate
+	Inserts     []RowInsert
+	// Status is empty while the transaction is editable, or TransactionStatusPendingApproval once a
+	// commit on a sensitive table is waiting for a second user
+	Status       string
+	CommitReason string
+	// LastHeartbeatAt is when the user's page last reported activity; zero counts from StartedAt
+	LastHeartbeatAt time.Time
+	// IdleWarnedAt is when the user was warned the idle transaction is about to be rolled back; a
+	// heartbeat clears it
+	IdleWarnedAt time.Time
+}
+
+// TransactionEvent is pushed to a user's transaction event stream: a warning that the idle
+// transaction is about to be rolled back, or word that it was
+type TransactionEvent struct {
+	// Type is one of the TransactionEvent constants
+	Type          string
+	TransactionID string
+	At            time.Time
+	// RemainingSeconds is how long an idle warning leaves before the rollback
+	RemainingSeconds int64
 }
 
 // RowEdit represents a buffered cell edit in a transaction
@@ -101,11 +358,515 @@ type RowEdit struct {
 	ColumnName string
 	OldValue   interface{}
 	NewValue   interface{}
+	// History holds the values buffered for this cell before NewValue, oldest first, so an edit can be
+	// stepped back without rolling back the transaction
+	History []interface{}
+	// JSONPatches are the structured changes of a JSONB cell NewValue was built from, applied in order
+	// to OldValue; nil when the cell was set as a whole value
+	JSONPatches []JSONPatchOp
+}
+
+// JSONPatchOp is one structured change to a JSONB cell, compiled to jsonb_set or #- on export
+type JSONPatchOp struct {
+	// Op is JSONPatchOpSet or JSONPatchOpRemove
+	Op string
+	// Path names the object keys and array indexes leading to the changed element from the top
+	// of the document
+	Path []string
+	// Value is the JSON text set at Path; unused by remove
+	Value string
+}
+
+// JSONCellDetail is one JSON cell pretty-printed for reading outside the grid
+type JSONCellDetail struct {
+	Column   string
+	DataType string
+	// Value is the document indented by two spaces; empty when IsNull
+	Value  string
+	IsNull bool
+}
+
+// SpatialCellDetail is one geometry or geography cell, rendered for previewing on a map
+type SpatialCellDetail struct {
+	Column string
+	// DataType is "geometry" or "geography"
+	DataType string
+	GeoJSON  string
+	WKT      string
+	// Raw is the value as stored, the hex-encoded EWKB the cell is edited as
+	Raw    string
+	IsNull bool
+}
+
+// RowDetail is one row read by its primary key with the metadata of each column, for showing a wide
+// table's record vertically
+type RowDetail struct {
+	Database string
+	Schema   string
+	Table    string
+	Columns  []RowDetailColumn
+}
+
+// RowDetailColumn is one column of a RowDetail, in table column order
+type RowDetailColumn struct {
+	Name string
+	// DataType is format_type output such as "character varying(255)"
+	DataType   string
+	IsNullable bool
+	IsPrimary  bool
+	// Default is the default expression, empty when there is none
+	Default string
+	// ForeignKey is the column the value references; nil when the column references none
+	ForeignKey *ForeignKeyMetadata
+	Comment    string
+	// Value is the cell as read, with byte values as text; nil for NULL
+	Value interface{}
+}
+
+// CellContent is the full value of one cell, read to download rather than to show in the grid
+type CellContent struct {
+	Column   string
+	DataType string
+	// ContentType is detected from the leading bytes of binary values; other values are plain text
+	ContentType string
+	Size        int64
+	// SHA256 is the hex digest of Content
+	SHA256  string
+	Content []byte
+	IsNull  bool
+}
+
+// TableSnapshot is a copy of a small table's rows, kept so the live table can be compared against it
+type TableSnapshot struct {
+	ID          string
+	Username    string
+	Database    string
+	Schema      string
+	Table       string
+	PrimaryKeys []string
+	Columns     []string
+	Rows        []map[string]interface{}
+	TakenAt     time.Time
+}
+
+// TableSnapshotDiff reports how the rows of a table changed since a snapshot, matched by primary key
+type TableSnapshotDiff struct {
+	SnapshotID  string
+	Database    string
+	Schema      string
+	Table       string
+	PrimaryKeys []string
+	TakenAt     time.Time
+	ComparedAt  time.Time
+	// Inserted holds the live rows whose key was not in the snapshot
+	Inserted []map[string]interface{}
+	Updated  []RowDifference
+	// Deleted holds the snapshot rows whose key is no longer in the table
+	Deleted   []map[string]interface{}
+	Unchanged int
+}
+
+// RowDifference is one row whose values changed since a snapshot
+type RowDifference struct {
+	Key map[string]interface{}
+	// Changed lists the columns whose values differ, in column order
+	Changed []string
+	Before  map[string]interface{}
+	After   map[string]interface{}
 }
 
 // RowInsert represents a new row to be inserted
 type RowInsert struct {
 	Values map[string]interface{}
+	// OnConflict says what happens when the row violates a unique constraint; the zero value fails
+	OnConflict ConflictAction
+}
+
+// NewRowDefaults is what the new-row form shows before the user types anything
+type NewRowDefaults struct {
+	// Values holds values generated for the row, such as uuid primary keys without a default
+	Values map[string]interface{}
+	// Placeholders previews, per column, what the server fills in when the field is left blank
+	Placeholders map[string]ColumnDefaultPreview
+}
+
+// CellValueOptions are the values a cell may be edited to, for a dropdown in place of free text
+type CellValueOptions struct {
+	Column string
+	// AllowedValues is empty when the column takes any value of its type
+	AllowedValues []string
+	// Source is CellValueSourceEnum or CellValueSourceCheck
+	Source string
+}
+
+// ColumnDefaultPreview is the evaluated default of a column
+type ColumnDefaultPreview struct {
+	// Expression is the column's default as declared, e.g. "now()" or "nextval('orders_id_seq'::regclass)"
+	Expression string
+	// Value is the default evaluated now; for sequences it is the next value hint
+	Value string
+	// Approximate marks values that may differ at insert time, such as sequence hints and clock functions
+	Approximate bool
+}
+
+// ConflictAction is compiled into the ON CONFLICT clause of an insert
+type ConflictAction struct {
+	// Mode is ConflictModeError, ConflictModeSkip or ConflictModeUpdate; empty means ConflictModeError
+	Mode string
+	// Target lists the columns of the unique constraint to match; required for ConflictModeUpdate
+	Target []string
+	// UpdateColumns lists the columns overwritten with the new row's values for ConflictModeUpdate
+	UpdateColumns []string
+}
+
+// BulkDeleteParams selects rows to delete by primary key, or every row matching the main view's filter
+type BulkDeleteParams struct {
+	Database string
+	Schema   string
+	Table    string
+	// Keys lists primary key tuples keyed by column name
+	Keys        []map[string]interface{}
+	MatchFilter bool
+	WhereClause string
+}
+
+// BulkUpdate sets one column to a value on every row matching a filter
+type BulkUpdate struct {
+	Database    string
+	Schema      string
+	Table       string
+	Column      string
+	Value       interface{}
+	WhereClause string
+	// ExpectedCount is the confirmed number of matching rows; the UPDATE is rolled back if it changes
+	ExpectedCount int64
+}
+
+// BulkUpdateResult reports the rows matched by a bulk update and whether it was buffered
+type BulkUpdateResult struct {
+	MatchingRows int64
+	Buffered     bool
+}
+
+// TableLock is a table lock held or awaited by another session
+type TableLock struct {
+	PID      int
+	Username string
+	// Mode is the pg_locks mode, such as AccessExclusiveLock
+	Mode    string
+	Granted bool
+	// HeldSince is when the session's current transaction started
+	HeldSince time.Time
+	// State, Query, ApplicationName and ClientAddr describe the session; they are left empty for users
+	// who may only see their own sessions' activity
+	State           string
+	Query           string
+	ApplicationName string
+	ClientAddr      string
+}
+
+// BackendActivity is one server backend as pg_stat_activity reports it
+type BackendActivity struct {
+	PID             int
+	Database        string
+	Username        string
+	ApplicationName string
+	ClientAddr      string
+	// State is active, idle, idle in transaction and so on
+	State string
+	Query string
+	// QueryStart is when the current or last query started; zero for backends that never ran one
+	QueryStart time.Time
+	// Duration is how long the current or last query has been running, as of when the activity was read
+	Duration time.Duration
+	// WaitEventType and WaitEvent name what an active backend is waiting on, if anything
+	WaitEventType string
+	WaitEvent     string
+}
+
+// ActivitySnapshot is the server's activity as one user may see it
+type ActivitySnapshot struct {
+	TakenAt  time.Time
+	Backends []BackendActivity
+	// CanTerminate is set for superusers, who may end any listed backend
+	CanTerminate bool
+}
+
+// LockWait is one backend waiting on a lock another backend holds
+type LockWait struct {
+	Blocked  BackendActivity
+	Blocking BackendActivity
+	// LockType is the pg_locks type of the ungranted lock, such as relation, tuple or transactionid
+	LockType string
+	// Mode is the lock mode the blocked backend asked for
+	Mode string
+	// Relation names the locked table when the lock is on one
+	Relation string
+}
+
+// BlockingChain is a backend holding locks others wait on, followed by everything waiting behind it
+type BlockingChain struct {
+	Database string
+	// Root blocks others without itself waiting on a lock
+	Root BackendActivity
+	// Waiters lists the blocked backends in the order they sit in the chain
+	Waiters []BlockedBackend
+}
+
+// BlockedBackend is a backend in a blocking chain, with the backend it waits on
+type BlockedBackend struct {
+	Backend BackendActivity
+	// BlockedBy is the PID of the backend holding the lock
+	BlockedBy int
+	// Depth counts the backends between this one and the chain's root, starting at 1
+	Depth    int
+	LockType string
+	Mode     string
+	Relation string
+}
+
+// LockReport lists the blocking chains one user may see
+type LockReport struct {
+	TakenAt time.Time
+	Chains  []BlockingChain
+	// CanTerminate is set for superusers, who may end a chain's root backend
+	CanTerminate bool
+}
+
+// DatabaseStats is one database's cumulative counters from pg_stat_database
+type DatabaseStats struct {
+	Name         string
+	Connections  int
+	XactCommit   int64
+	XactRollback int64
+	BlocksRead   int64
+	BlocksHit    int64
+	Deadlocks    int64
+	// SizeBytes is the database's size on disk; nil when the reader may not connect to it
+	SizeBytes *int64
+}
+
+// BackgroundWriterStats is the cumulative pg_stat_bgwriter counters kept by every supported server version
+type BackgroundWriterStats struct {
+	BuffersClean    int64
+	MaxWrittenClean int64
+	BuffersAlloc    int64
+	StatsReset      time.Time
+}
+
+// ServerStats is one sample of the server's cumulative statistics
+type ServerStats struct {
+	// TakenAt is the server's clock when sampled, so rates do not depend on the clocks agreeing
+	TakenAt          time.Time
+	MaxConnections   int
+	Databases        []DatabaseStats
+	BackgroundWriter BackgroundWriterStats
+}
+
+// DatabaseDashboardRow is a database's statistics with the rates derived from them
+type DatabaseDashboardRow struct {
+	Stats DatabaseStats
+	// TransactionsPerSecond is commits and rollbacks per second since the previous sample; nil without one
+	TransactionsPerSecond *float64
+	// CacheHitRatio is the share of block reads served from shared buffers; nil before any block is read
+	CacheHitRatio *float64
+}
+
+// ServerDashboard is the server statistics dashboard, with totals over every database
+type ServerDashboard struct {
+	TakenAt               time.Time
+	Databases             []DatabaseDashboardRow
+	TotalConnections      int
+	MaxConnections        int
+	TotalSizeBytes        int64
+	TransactionsPerSecond *float64
+	CacheHitRatio         *float64
+	BackgroundWriter      BackgroundWriterStats
+}
+
+// SlowOperation is an operation that ran longer than its threshold, kept for admins to review
+type SlowOperation struct {
+	ID       string
+	Username string
+	// Operation is what was slow, such as SlowOperationFilter
+	Operation   string
+	Database    string
+	Schema      string
+	Table       string
+	WhereClause string
+	Duration    time.Duration
+	// Suggestions are non-binding index ideas drawn from the operation's plan
+	Suggestions []IndexSuggestion
+	RecordedAt  time.Time
+}
+
+// SlowQuery is an editor query that ran past the slow-query threshold, kept by the logger
+type SlowQuery struct {
+	ID       string
+	Username string
+	// Statement is the query with its constants replaced by $n placeholders, so runs of one statement
+	// with different values read alike
+	Statement string
+	Duration  time.Duration
+	// Error is why the query failed; empty when it succeeded
+	Error string
+	// Plan is the statement's estimated plan; nil when it could not be explained
+	Plan       *QueryPlan
+	RecordedAt time.Time
+}
+
+// IndexSuggestion is an index that might help a slow filter, found where the plan sequentially scans
+// a large table to keep few of its rows
+type IndexSuggestion struct {
+	Schema  string
+	Table   string
+	Columns []string
+	// Statement is a CREATE INDEX statement for the suggestion, for an admin to weigh before running
+	Statement string
+	// Reason says what in the plan prompted the suggestion
+	Reason string
+}
+
+// LiveResultUpdate is one refresh of a grid or query result watched on an interval
+type LiveResultUpdate struct {
+	// Sequence counts refreshes from 1
+	Sequence    int
+	RefreshedAt time.Time
+	// Interval is the refresh interval in effect, after the server's bounds are applied
+	Interval time.Duration
+	Result   *QueryResult
+	// Error reports a refresh that failed; the watch carries on and retries at the next interval
+	Error string
+}
+
+// ParseLiveInterval reads a refresh interval in seconds, defaulting to LiveRefreshMinInterval; watches
+// hold it to the server's bounds with LiveRefreshInterval
+func ParseLiveInterval(value string) (time.Duration, error) {
+	if value == "" {
+		return time.Duration(LiveRefreshMinInterval) * time.Second, nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("invalid refresh interval: %s", value)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// LiveRefreshInterval holds a requested refresh interval within the server's bounds
+func LiveRefreshInterval(interval time.Duration) time.Duration {
+	if min := time.Duration(LiveRefreshMinInterval) * time.Second; interval < min {
+		return min
+	}
+	if max := time.Duration(LiveRefreshMaxInterval) * time.Second; interval > max {
+		return max
+	}
+	return interval
+}
+
+// Event names the server-sent event reporting the refresh and builds its payload: "result" with the
+// rows, or "refresh-error" when the refresh failed and the watch will retry
+func (u *LiveResultUpdate) Event() (string, map[string]interface{}) {
+	payload := map[string]interface{}{
+		"sequence":         u.Sequence,
+		"refreshed_at":     u.RefreshedAt.UTC().Format(time.RFC3339),
+		"interval_seconds": int(u.Interval / time.Second),
+	}
+	if u.Error != "" {
+		payload["error"] = u.Error
+		return "refresh-error", payload
+	}
+	payload["columns"] = u.Result.Columns
+	payload["rows"] = u.Result.Rows
+	payload["row_count"] = u.Result.RowCount
+	payload["total_count"] = u.Result.TotalCount
+	return "result", payload
+}
+
+// WriteServerSentEvent writes payload as JSON in one server-sent event frame; the caller flushes it
+func WriteServerSentEvent(w io.Writer, id int, event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", id, event, data)
+	return err
+}
+
+// EditorShare is a temporary invite letting other users watch a user's query editor read-only
+type EditorShare struct {
+	Token     string
+	Owner     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// EditorShareUpdate is the latest run in a shared query editor, pushed to the users watching it
+type EditorShareUpdate struct {
+	// Sequence counts the owner's runs since the editor was shared, from 1
+	Sequence int
+	Owner    string
+	Query    string
+	RanAt    time.Time
+	Result   *QueryResult
+	// Error reports a run that failed
+	Error string
+}
+
+// RowWatchParams selects the rows a watch reports changes to
+type RowWatchParams struct {
+	Database    string
+	Schema      string
+	Table       string
+	WhereClause string
+	// Mode is RowWatchModeNotify or RowWatchModePoll; empty asks for notify
+	Mode string
+	// Interval is how often poll mode re-checks the filter, held within the live refresh bounds
+	Interval time.Duration
+}
+
+// RowChange is one matching row inserted, updated or deleted since the previous check. A row that
+// starts or stops matching the filter is reported as inserted or deleted.
+type RowChange struct {
+	// Operation is RowChangeInsert, RowChangeUpdate or RowChangeDelete
+	Operation string
+	// Key holds the primary key values, or the whole row for tables without a primary key
+	Key map[string]interface{}
+	// Before is the row as last seen; nil for inserts
+	Before map[string]interface{}
+	// After is the row as it is now; nil for deletes
+	After map[string]interface{}
+}
+
+// RowWatchEvent is one report of a row watch. The first event carries no changes and says how the
+// watch runs; later events are only sent when matching rows changed or a check failed.
+type RowWatchEvent struct {
+	// Sequence counts events from 1
+	Sequence  int
+	CheckedAt time.Time
+	// Mode is the mode in effect, which is poll when notify was asked for but the trigger could not be installed
+	Mode string
+	// Notice explains a fallback to poll mode
+	Notice       string
+	MatchingRows int
+	Changes      []RowChange
+	// Error reports a check that failed; the watch carries on
+	Error string
+}
+
+// TransactionExport is a transaction's buffered change set rendered for someone else to review and apply
+type TransactionExport struct {
+	// Format is TransactionExportSQL or TransactionExportJSONPatch
+	Format      string
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+// BulkDeleteResult reports the rows buffered by a bulk delete
+type BulkDeleteResult struct {
+	Buffered int
+	// PendingDeletes is the exact number of rows the commit will delete by primary key
+	PendingDeletes int
 }
 
 // QueryParams represents parameters for executing a query
@@ -116,6 +877,14 @@ type QueryParams struct {
 	WhereClause string
 	OrderBy     string
 	OrderDir    string // ASC or DESC
+	// TrackingKey registers the executing backend so the query can be cancelled, usually the username
+	TrackingKey string
+	// SearchPath sets the schemas used to resolve unqualified names while the query runs
+	SearchPath []string
+	// Settings are the session's GUC presets, applied while the query runs
+	Settings SessionSettings
+	// Args binds $n placeholders in Query
+	Args []interface{}
 }
 
 // TableDataParams represents parameters for loading table data
@@ -124,11 +893,72 @@ type TableDataParams struct {
 	Schema      string
 	Table       string
 	WhereClause string
-	OrderBy     string
-	OrderDir    string
-	Offset      int
-	Limit       int
-	Cursor      string
+	// WhereArgs binds $n placeholders in WhereClause, numbered before any the repository adds
+	WhereArgs []interface{}
+	OrderBy   string
+	OrderDir  string
+	Offset    int
+	Limit     int
+	Cursor    string
+	// EncryptedColumns are stored as pgp_sym_encrypt ciphertext; with EncryptionKey set they are
+	// decrypted on read, otherwise they are returned as stored
+	EncryptedColumns []string
+	EncryptionKey    string
+	// SnapshotKey reads through the frozen snapshot open under this key, if any, instead of the live table
+	SnapshotKey string
+}
+
+// ObjectSearchMatch is a table, view, column or function whose name matched an object search
+type ObjectSearchMatch struct {
+	// Kind is ObjectKindTable, ObjectKindView, ObjectKindColumn or ObjectKindFunction
+	Kind     string
+	Database string
+	Schema   string
+	// Table is the table or view a column belongs to; empty for other kinds
+	Table string
+	Name  string
+	// Rank orders the matches, lowest first: ObjectMatchExact, ObjectMatchPrefix, ObjectMatchWord,
+	// then ObjectMatchContains
+	Rank int
+}
+
+// TableSearchResult is the rows of a table with a text column containing a search term
+type TableSearchResult struct {
+	Columns []string
+	Rows    []map[string]interface{}
+	// MatchedColumns lists, for each row, the columns whose value contains the term
+	MatchedColumns [][]string
+	// SearchedColumns are the text columns the term was matched against
+	SearchedColumns []string
+	// TotalMatches counts every matching row, of which at most TableSearchMaxRows are returned
+	TotalMatches int64
+}
+
+// FilterPredicate is a structured filter condition compiled into a parameterized WHERE clause: a
+// comparison of Column with Value, or an AND/OR group of predicates when Group is set
+type FilterPredicate struct {
+	Column string
+	// Operator is one of the FilterOperator constants
+	Operator string
+	// Value is compared with Column; a list for FilterOperatorIn, unused for the null checks
+	Value interface{}
+	// Group is FilterGroupAnd or FilterGroupOr, joining Predicates instead of comparing Column
+	Group      string
+	Predicates []FilterPredicate
+}
+
+// SnapshotStatus describes a session's frozen view, a repeatable-read snapshot pages are read from
+type SnapshotStatus struct {
+	Active  bool
+	TakenAt time.Time
+	// Expired reports that the snapshot was released for outliving SnapshotMaxAgeSeconds
+	Expired bool
+}
+
+// EncryptedValue wraps a value written to an encrypted column so it is stored via pgp_sym_encrypt
+type EncryptedValue struct {
+	Value interface{}
+	Key   string
 }
 
 // ForeignKeyInfo represents information about a foreign key relationship
@@ -152,6 +982,8 @@ type ChildTableReference struct {
 type LoginRequest struct {
 	Username string
 	Password string
+	// Profile names the connection profile to log in to; empty uses the first configured profile
+	Profile string
 }
 
 // LoginResponse represents the result of a login attempt
@@ -179,6 +1011,571 @@ type ConnectionString struct {
 	SSLMode  string
 }
 
+// GeoLocation is the coarse location a client address maps to in the GeoIP database
+type GeoLocation struct {
+	CountryCode string
+	Country     string
+	Region      string
+	City        string
+}
+
+// LoginEvent is a login as it is audited, located when a GeoIP database is configured
+type LoginEvent struct {
+	Username string
+	ClientIP string
+	// Location is nil when no GeoIP database is configured or it does not cover the address
+	Location *GeoLocation
+	// NewLocation flags a login from a country and region none of the user's recent logins came from
+	NewLocation bool
+}
+
+// AuditEntry represents a change or other recorded action, such as a login or query, taken through the
+// application
+type AuditEntry struct {
+	ID       string
+	Username string
+	Action   string
+	Database string
+	Schema   string
+	Table    string
+	Reason   string
+	// Target names the object changed when it is not a table, such as a revoked session or a
+	// connection profile
+	Target string
+	// AffectedRows identifies the changed rows: buffered row indexes with the old and new values for
+	// edits, row indexes or keys for deletions, and the inserted values for insertions
+	AffectedRows []map[string]interface{}
+	// Before and After hold the changed object's settings around the action, for changes that are not
+	// to table rows
+	Before map[string]interface{}
+	After  map[string]interface{}
+	// ApprovedBy names the second user who approved a commit on a sensitive table
+	ApprovedBy string
+	CreatedAt  time.Time
+}
+
+// DeletedRows holds the rows a commit deleted by primary key as they were just before the commit,
+// kept under the commit's audit entry until ExpiresAt so the delete can be undone
+type DeletedRows struct {
+	EntryID   string
+	Database  string
+	Schema    string
+	Table     string
+	Rows      []map[string]interface{}
+	ExpiresAt time.Time
+}
+
+// AuditExport is a filtered slice of the audit log rendered for download
+type AuditExport struct {
+	// Format is AuditExportCSV or AuditExportJSON
+	Format      string
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+// SavedQuery represents a named query kept in a user's library
+type SavedQuery struct {
+	ID       string
+	Username string
+	Name     string
+	Query    string
+	Tags     []string
+	// Variables lists the typed {{name:type}} placeholders declared in the query, in order of first use
+	Variables []TemplateVariable
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// QueryNotebook is a user's document of ordered SQL cells and markdown notes
+type QueryNotebook struct {
+	ID        string
+	Username  string
+	Title     string
+	Cells     []NotebookCell
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotebookCell is one cell of a query notebook
+type NotebookCell struct {
+	ID string
+	// Kind is NotebookCellSQL or NotebookCellMarkdown
+	Kind string
+	// Source is the cell's SQL statement or markdown note
+	Source string
+	// Result is the saved result of the SQL cell's latest successful run, kept to NotebookResultMaxRows
+	Result *QueryResult
+	// Error reports why the cell's latest run failed
+	Error string
+	// RanAt is when the cell last ran; zero for cells never run
+	RanAt time.Time
+}
+
+// NotebookExport is a notebook written as a single file
+type NotebookExport struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// WorkspaceImport reports what importing a workspace file added to the user's library
+type WorkspaceImport struct {
+	// Imported counts the saved queries added, Renamed those among them given a new name because the
+	// library already held a different query under theirs
+	Imported int
+	Renamed  int
+	// Skipped counts the saved queries already in the library with the same name and text
+	Skipped int
+	// SearchPath is the exported search_path preference, left for the caller to apply to the session
+	SearchPath []string
+}
+
+// TemplateVariable represents a typed placeholder in a saved query template
+type TemplateVariable struct {
+	Name string
+	Type string
+}
+
+// AppConfig represents runtime-adjustable application settings
+type AppConfig struct {
+	RequireChangeReason bool
+	// SensitiveTables lists "schema.table" names whose commits need a second user's approval
+	SensitiveTables []string
+	// Approvers lists the usernames allowed to approve commits on sensitive tables
+	Approvers []string
+	// EncryptedColumns lists "schema.table.column" names stored encrypted with pgcrypto
+	EncryptedColumns []string
+	// ColumnEncryptionKey is the per-deployment key used for encrypted columns
+	ColumnEncryptionKey string
+	// MaskedRowLimit caps the rows one request may read from a table whose encrypted columns stay
+	// masked for the user; zero uses DefaultMaskedRowLimit
+	MaskedRowLimit int
+	// ExportRowLimit caps the rows of a table export; zero uses ExportDefaultRowLimit
+	ExportRowLimit int
+	// ConnectionProfiles lists the servers offered at login, the first being the default; empty offers
+	// only DefaultConnectionProfile
+	ConnectionProfiles []ConnectionProfile
+	// MaintenanceMode refuses new transactions and writes from everyone but superusers
+	MaintenanceMode bool
+	// MaintenanceMessage is shown to users whose writes are refused; empty uses DefaultMaintenanceMessage
+	MaintenanceMessage string
+	// MaintenanceSince is when maintenance mode was last turned on
+	MaintenanceSince time.Time
+	// SlowFilterThreshold is how long a grid filter may run before it is logged as slow with index
+	// suggestions; zero uses DefaultSlowFilterThreshold
+	SlowFilterThreshold time.Duration
+	// SlowQueryThreshold is how long an editor query may run before it is kept in the slow-query log;
+	// zero uses DefaultSlowQueryThreshold
+	SlowQueryThreshold time.Duration
+	// GeoIPDatabasePath is a local GeoIP database logins are located with; empty records logins
+	// without a location
+	GeoIPDatabasePath string
+	// DeletedRowRetention is how long the rows a commit deleted are kept for restoring; zero uses
+	// DefaultDeletedRowRetention
+	DeletedRowRetention time.Duration
+	// EnvironmentName tags every page with the environment the instance serves, such as PRODUCTION;
+	// empty shows no banner
+	EnvironmentName string
+	// EnvironmentColor is the banner's #rrggbb color; empty uses the well-known name's color from
+	// EnvironmentColors, or DefaultEnvironmentColor
+	EnvironmentColor string
+	// DisabledShortcuts lists the shortcut actions no user may bind, such as commit_transaction where
+	// a stray keypress is too costly
+	DisabledShortcuts []string
+	// ExportRules decide, apart from SELECT, whether a role may export a table's data; see ExportAllowed
+	ExportRules []ExportRule
+	// LookupDisplayColumns maps "schema.table" to the columns a foreign key lookup shows and searches
+	// for its rows; tables left out use a name-like column
+	LookupDisplayColumns map[string][]string
+	// PasswordPolicy is what the passwords of roles created from the admin panel must meet
+	PasswordPolicy PasswordPolicy
+}
+
+// PasswordPolicy constrains the passwords of roles created from the admin panel; rules left at zero
+// use their defaults
+type PasswordPolicy struct {
+	// MinLength is the fewest characters a password may have; zero uses DefaultPasswordMinLength
+	MinLength int
+	// MinCharacterClasses is how many of lowercase letters, uppercase letters, digits and symbols a
+	// password must mix; zero uses DefaultPasswordCharacterClasses
+	MinCharacterClasses int
+	// AllowCommonPasswords turns off the check against commonly used passwords and the role's own name
+	AllowCommonPasswords bool
+}
+
+// NewRole is a login role created from the admin panel
+type NewRole struct {
+	Name     string
+	Password string
+	// CreateDB lets the role create databases
+	CreateDB bool
+}
+
+// PasswordCheck reports how a password measures up to the password policy
+type PasswordCheck struct {
+	// Violations describe each rule the password breaks; empty when it meets the policy
+	Violations []string
+}
+
+// ExportRule allows or denies a role exporting a table's data. Role and Table may be
+// ExportRuleWildcard; Table is "schema.table".
+type ExportRule struct {
+	Role  string
+	Table string
+	Allow bool
+}
+
+// ExportAllowed reports whether the policy lets role export table, given as "schema.table"; empty checks
+// only the rules naming no table. The most specific matching rule decides, a rule naming the role before
+// one naming the table; without one, export follows SELECT and is allowed.
+func (c *AppConfig) ExportAllowed(role, table string) bool {
+	candidates := [][2]string{
+		{role, table},
+		{role, ExportRuleWildcard},
+		{ExportRuleWildcard, table},
+		{ExportRuleWildcard, ExportRuleWildcard},
+	}
+	for _, candidate := range candidates {
+		if candidate[1] == "" {
+			continue
+		}
+		for _, rule := range c.ExportRules {
+			if rule.Role == candidate[0] && rule.Table == candidate[1] {
+				return rule.Allow
+			}
+		}
+	}
+	return true
+}
+
+// QueryExportAllowed reports whether the policy lets role export query results. A query can read any
+// table, so it is refused whenever role may not export one of the tables the rules name.
+func (c *AppConfig) QueryExportAllowed(role string) bool {
+	if !c.ExportAllowed(role, "") {
+		return false
+	}
+	for _, rule := range c.ExportRules {
+		if rule.Table != ExportRuleWildcard && !c.ExportAllowed(role, rule.Table) {
+			return false
+		}
+	}
+	return true
+}
+
+// EncryptedColumnsOf returns the configured encrypted columns of a table
+func (c *AppConfig) EncryptedColumnsOf(schema, table string) []string {
+	if schema == "" {
+		schema = "public"
+	}
+	prefix := schema + "." + table + "."
+
+	var columns []string
+	for _, name := range c.EncryptedColumns {
+		if column, ok := strings.CutPrefix(name, prefix); ok && column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// MaintenanceRefusal returns the error refusing a write while maintenance mode is on, or nil when the
+// write may go ahead. A write belonging to a transaction started before maintenance was turned on may
+// finish during the grace period; pass a zero startedAt for anything else. isSuperuser is asked only
+// when the write would be refused, since superusers are never locked out.
+func (c *AppConfig) MaintenanceRefusal(startedAt time.Time, isSuperuser func() (bool, error)) error {
+	if !c.MaintenanceMode {
+		return nil
+	}
+
+	graceEndsAt := c.MaintenanceSince.Add(time.Duration(MaintenanceGracePeriod) * time.Second)
+	if !startedAt.IsZero() && startedAt.Before(c.MaintenanceSince) && time.Now().Before(graceEndsAt) {
+		return nil
+	}
+	if superuser, err := isSuperuser(); err == nil && superuser {
+		return nil
+	}
+
+	message := c.MaintenanceMessage
+	if message == "" {
+		message = DefaultMaintenanceMessage
+	}
+	return ValidationError{Field: "maintenance", Message: message}
+}
+
+// MaintenanceStatus reports whether the instance is locked down for maintenance
+type MaintenanceStatus struct {
+	Enabled bool
+	Message string
+	Since   time.Time
+	// GraceEndsAt is when transactions started before maintenance can no longer commit
+	GraceEndsAt time.Time
+}
+
+// SavedTableView is a named filter, sort and column visibility a user saved for a table
+type SavedTableView struct {
+	Name        string
+	Database    string
+	Schema      string
+	Table       string
+	WhereClause string
+	OrderBy     string
+	OrderDir    string
+	// HiddenColumns are left out of the grid
+	HiddenColumns []string
+	// IsDefault marks the one view applied when the user selects the table
+	IsDefault bool
+}
+
+// ResultReport is a query result or filtered table laid out for printing, with what it shows, when
+// and for whom
+type ResultReport struct {
+	Title string
+	// Source is the query text, or the database-qualified table
+	Source string
+	// Filter and Sort describe the WHERE clause and order applied, empty when none was
+	Filter      string
+	Sort        string
+	GeneratedBy string
+	GeneratedAt time.Time
+	Columns     []string
+	// Rows holds each row's values as text in column order, with SQL NULL as nil so a text value
+	// reading "NULL" stays distinguishable from it
+	Rows [][]*string
+}
+
+// DashboardTile pins the latest result of one of a user's saved queries to their home dashboard
+type DashboardTile struct {
+	ID           string
+	SavedQueryID string
+	Title        string
+	// Kind is DashboardTileRowCount or DashboardTileScalar
+	Kind string
+	// RefreshSeconds is how often the dashboard re-runs the query while open
+	RefreshSeconds int
+	CreatedAt      time.Time
+}
+
+// DashboardTileResult is a tile's summary of its query's latest result
+type DashboardTileResult struct {
+	TileID   string
+	RowCount int
+	// Value is the single value a scalar tile's query returned, nil for row count tiles
+	Value       interface{}
+	RefreshedAt time.Time
+}
+
+// KeyboardShortcut binds a UI action to a key combination such as Ctrl+Shift+Enter
+type KeyboardShortcut struct {
+	Action      string
+	Description string
+	// Keys is the user's binding, empty when the action is disabled
+	Keys string
+	// DefaultKeys is the binding the user gets without remapping the action
+	DefaultKeys string
+	// Customized is set when the user remapped the action
+	Customized bool
+	// Disabled is set when an admin turned the action's shortcut off for everyone
+	Disabled bool
+}
+
+// EnvironmentBanner is the colored banner naming the environment the instance serves, so production
+// is not mistaken for staging
+type EnvironmentBanner struct {
+	// Name is empty when no environment is configured and no banner is shown
+	Name  string
+	Color string
+}
+
+// ConnectionProfile is a PostgreSQL server users may choose to log in to
+type ConnectionProfile struct {
+	Name string
+	Host string
+	Port int
+	// SSLMode is the libpq sslmode used to connect; empty uses disable
+	SSLMode string
+	// ServicePasswordRef is a secret-provider reference, such as "env:NAME", to the SSO and LDAP service
+	// user's password on this server; empty uses the configured ServicePassword. Profiles only ever hold
+	// the reference, so they can be exported without secrets.
+	ServicePasswordRef string
+}
+
+// ConnectionProfileImport reports what importing connection profiles changed
+type ConnectionProfileImport struct {
+	Added    []string
+	Replaced []string
+	// UnresolvedSecrets lists the secret references this instance cannot resolve yet, such as
+	// environment variables still to be set
+	UnresolvedSecrets []string
+}
+
+// ConnectionPoolConfig bounds the connection pool kept open for each logged-in session
+type ConnectionPoolConfig struct {
+	// MaxConns caps the open connections of one session's pool
+	MaxConns int
+	// MaxIdleTime closes connections, and whole pools, left unused for longer
+	MaxIdleTime time.Duration
+	// HealthCheckPeriod is how long a pool is trusted before it is pinged again on reuse
+	HealthCheckPeriod time.Duration
+	// DatabaseMaxConns caps, per target database name, the connections lumen-pg holds open to it at
+	// once across all sessions and login probes; databases left out are not capped
+	DatabaseMaxConns map[string]int
+	// QueueTimeout is how long opening a connection waits for a capped database to free one before
+	// failing with ErrServerBusy
+	QueueTimeout time.Duration
+}
+
+// SessionStoreConfig selects where sessions are kept
+type SessionStoreConfig struct {
+	// Backend is SessionStoreMemory, SessionStoreRedis or SessionStoreFile; empty keeps sessions in memory
+	Backend       string
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+	// KeyPrefix namespaces the Redis keys; empty uses DefaultSessionKeyPrefix
+	KeyPrefix string
+	// FilePath is where SessionStoreFile keeps sessions and transaction buffers
+	FilePath string
+}
+
+// UserBucket names the SessionStore bucket holding one user's records of a kind, such as "notebooks"
+func UserBucket(kind, username string) string {
+	return kind + "/" + username
+}
+
+// TLSConfig makes Lumen serve HTTPS itself, without a reverse proxy in front. Giving CertFile and KeyFile
+// serves that certificate; giving AutocertDomains instead obtains and renews certificates over ACME.
+// Neither serves plain HTTP.
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// AutocertDomains are the host names certificates are requested for; requests for other hosts fail
+	// the TLS handshake
+	AutocertDomains []string
+	// AutocertEmail is given to the ACME provider for expiry and problem notices
+	AutocertEmail string
+	// AutocertCacheDir keeps issued certificates and the account key across restarts; empty uses
+	// DefaultAutocertCacheDir
+	AutocertCacheDir string
+	// AutocertDirectoryURL is the ACME directory; empty uses Let's Encrypt
+	AutocertDirectoryURL string
+	// RedirectAddr is the plain HTTP listener that redirects to HTTPS and answers ACME http-01
+	// challenges; empty uses DefaultHTTPRedirectAddr
+	RedirectAddr string
+	// DisableRedirect serves HTTPS only, leaving plain HTTP unanswered
+	DisableRedirect bool
+}
+
+// AppDataConfig selects where lumen-pg keeps its own data: saved queries, preferences and the audit log
+type AppDataConfig struct {
+	// Backend is AppDataMemory, AppDataPostgres or AppDataFile; empty keeps app data in memory.
+	// AppDataFile writes nothing to the database, so it suits read-only targets. It is not an SQLite or
+	// bbolt database but the append-only JSON-lines file SessionStoreFile uses, which needs no cgo or
+	// third-party module; only one process may have the file open at a time.
+	Backend string
+	// FilePath is where AppDataFile keeps app data
+	FilePath string
+}
+
+// SessionTimeoutConfig bounds how long a session may sit unused
+type SessionTimeoutConfig struct {
+	// IdleTimeout destroys a session, rolling back its user's open transaction, once it has seen no
+	// activity for this long; the session cookie is reissued with this lifetime on each request.
+	// Zero keeps sessions until they expire.
+	IdleTimeout time.Duration
+}
+
+// SSOConfig enables OIDC single sign-on. Signed-in identities are mapped to PostgreSQL roles through
+// RoleMappings, and their sessions connect as ServiceUser assuming the mapped role, so ServiceUser must
+// be a member of every mapped role. Statements that could switch away from the role are refused on those
+// sessions' connections, and the role is set again whenever a connection is reused.
+type SSOConfig struct {
+	// IssuerURL is the OIDC provider whose discovery document lists its endpoints and signing keys
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is lumen-pg's /login/sso/callback address as registered with the provider
+	RedirectURL string
+	// Scopes are requested in addition to openid
+	Scopes []string
+	// RoleMappings is the role-mapping table; the first entry matching the identity wins
+	RoleMappings    []SSORoleMapping
+	ServiceUser     string
+	ServicePassword string
+}
+
+// SSORoleMapping maps identities whose Claim equals, or for list claims contains, Value to Role
+type SSORoleMapping struct {
+	Claim string
+	Value string
+	Role  string
+}
+
+// LDAPConfig configures the LDAP password backend. Users are looked up under BaseDN by UserAttribute,
+// authenticated by binding as their entry, mapped to a PostgreSQL role through RoleMappings matched
+// against the entry's attributes, and connected as ServiceUser assuming that role, held to it the way
+// SSOConfig's sessions are.
+type LDAPConfig struct {
+	// URL is ldap://host:389, upgraded with StartTLS before any bind, or ldaps://host:636
+	URL string
+	// CACertFile is a PEM bundle of the CAs trusted for the directory's certificate; empty trusts the
+	// system roots
+	CACertFile string
+	// AllowPlaintext skips StartTLS on an ldap:// URL, sending bind passwords unencrypted; only for a
+	// directory reached over a trusted network
+	AllowPlaintext bool
+	// BindDN and BindPassword are the account used to search for users; empty searches anonymously
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	// UserAttribute holds the login name; empty uses DefaultLDAPUserAttribute
+	UserAttribute string
+	// RoleMappings is the role-mapping table; Claim names an entry attribute such as memberOf
+	RoleMappings    []SSORoleMapping
+	ServiceUser     string
+	ServicePassword string
+}
+
+// LDAPEntry is a directory entry found for a login; attribute names are lower-cased
+type LDAPEntry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// OIDCIdentity is the verified identity carried by a provider's ID token
+type OIDCIdentity struct {
+	Subject string
+	Email   string
+	Claims  map[string]interface{}
+}
+
+// SSOAuthRequest is a started single sign-on login; State and Nonce must be presented again on callback
+type SSOAuthRequest struct {
+	URL   string
+	State string
+	Nonce string
+}
+
+// DatabaseError is an error reported by PostgreSQL, kept with its SQLSTATE so the UI can point to
+// the documentation for it
+type DatabaseError struct {
+	SQLState string
+	// DocsKey is the condition name of the SQLSTATE, such as "unique_violation"
+	DocsKey string
+	DocsURL string
+	Err     error
+}
+
+// Error implements the error interface for DatabaseError
+func (e *DatabaseError) Error() string {
+	return "query execution failed: " + e.Err.Error()
+}
+
+// Unwrap returns the driver error
+func (e *DatabaseError) Unwrap() error {
+	return e.Err
+}
+
 // ValidationError represents a validation error
 type ValidationError struct {
 	Field   string
@@ -192,3 +1589,189 @@ func (ve ValidationError) Error() string {
 	}
 	return ve.Message
 }
+
+// ImportParams describes a CSV import into a table
+type ImportParams struct {
+	Database string
+	Schema   string
+	Table    string
+	// Mapping maps CSV header names to table columns; headers left out load into the column of the same
+	// name and headers mapped to "" are skipped
+	Mapping map[string]string
+}
+
+// ImportColumnMapping describes where a CSV column is loaded; TableColumn is empty for skipped columns
+type ImportColumnMapping struct {
+	CSVColumn   string
+	TableColumn string
+	DataType    string
+}
+
+// ImportRowError reports a CSV value that cannot be coerced to its column type
+type ImportRowError struct {
+	Line    int
+	Column  string
+	Message string
+}
+
+// ImportPreview shows how a CSV file maps onto a table before it is loaded
+type ImportPreview struct {
+	Columns []ImportColumnMapping
+	// Rows holds the first rows coerced to their column types, in mapped column order
+	Rows     [][]interface{}
+	RowCount int
+	Errors   []ImportRowError
+}
+
+// ImportValidation reports every value of a CSV file that would not load into the table, found without
+// writing anything
+type ImportValidation struct {
+	Columns []ImportColumnMapping
+	// MissingColumns are required table columns without a default that no CSV column maps to, so no
+	// row can load until the mapping covers them
+	MissingColumns  []string
+	RowCount        int
+	InvalidRowCount int
+	ErrorCount      int
+	// Rows lists the invalid rows in file order with their errors, holding at most
+	// ImportValidationMaxErrors errors in all; Truncated is set when more were found
+	Rows      []ImportRowReport
+	Truncated bool
+}
+
+// ImportRowReport lists the problems of one CSV row
+type ImportRowReport struct {
+	Line int
+	// Message is set when the row as a whole is unusable, such as a wrong number of fields
+	Message string
+	// Columns maps each table column whose value does not fit to the reason
+	Columns map[string]string
+}
+
+// ImportResult reports a completed CSV import
+type ImportResult struct {
+	RowsImported int64
+}
+
+// RequestOrigin is the route a request came in on, carried in its context so a rejection made deep in
+// a usecase can say which route it refused
+type RequestOrigin struct {
+	Method     string
+	Route      string
+	RemoteAddr string
+}
+
+// requestOriginKey is the context key of the RequestOrigin
+type requestOriginKey struct{}
+
+// WithRequestOrigin returns a context carrying the request's origin
+func WithRequestOrigin(ctx context.Context, origin RequestOrigin) context.Context {
+	return context.WithValue(ctx, requestOriginKey{}, origin)
+}
+
+// RequestOriginFromContext returns the origin a context carries; the zero value when it carries none
+func RequestOriginFromContext(ctx context.Context) RequestOrigin {
+	origin, _ := ctx.Value(requestOriginKey{}).(RequestOrigin)
+	return origin
+}
+
+// sessionConnectionKey is the context key of the session's connection pool
+type sessionConnectionKey struct{}
+
+// WithSessionConnection returns a context whose database queries run on the session's connection pool,
+// opened with the session's own credentials on its connection profile's server
+func WithSessionConnection(ctx context.Context, db *sql.DB) context.Context {
+	return context.WithValue(ctx, sessionConnectionKey{}, db)
+}
+
+// SessionConnection returns the session connection pool a context carries, or fallback when it
+// carries none, as for background work outside any session
+func SessionConnection(ctx context.Context, fallback *sql.DB) *sql.DB {
+	if db, ok := ctx.Value(sessionConnectionKey{}).(*sql.DB); ok && db != nil {
+		return db
+	}
+	return fallback
+}
+
+// rejectionMetrics counts every rejection RejectionAuditEntry is built for, keyed by source, rule and
+// route, so operators can watch rule hit rates without querying the audit log
+var rejectionMetrics = expvar.NewMap(RejectionMetricsName)
+
+// RejectionAuditEntry is the audit entry of a request refused by an SQL validator, naming the rule that
+// matched and the route from the context; username is empty when the request was refused before its
+// session was known. Building it counts the rejection in the RejectionMetricsName expvar map.
+func RejectionAuditEntry(ctx context.Context, username, source, rule string) *AuditEntry {
+	origin := RequestOriginFromContext(ctx)
+	rejectionMetrics.Add(source+":"+rule+":"+origin.Route, 1)
+	return &AuditEntry{
+		Username: username,
+		Action:   AuditActionRequestRejected,
+		Target:   source + ":" + rule,
+		After: map[string]interface{}{
+			"source":      source,
+			"rule":        rule,
+			"method":      origin.Method,
+			"route":       origin.Route,
+			"remote_addr": origin.RemoteAddr,
+		},
+	}
+}
+
+// unsafeWhereRules are the SQL injection patterns a WHERE clause is refused for, each named so its
+// rejections can be counted and false-positive-prone rules tuned
+var unsafeWhereRules = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"or_string_tautology", regexp.MustCompile(`(?i)'\s*OR\s*'`)},          // ' OR '
+	{"or_numeric_tautology", regexp.MustCompile(`(?i)'\s*OR\s*1\s*=\s*1`)}, // ' OR 1=1
+	{"line_comment", regexp.MustCompile(`(?i)--`)},                         // SQL comments
+	{"block_comment", regexp.MustCompile(`(?i)/\*.*\*/`)},                  // Multi-line comments
+	{"stacked_drop", regexp.MustCompile(`(?i);\s*DROP`)},                   // DROP statements
+	{"stacked_delete", regexp.MustCompile(`(?i);\s*DELETE`)},               // DELETE statements
+	{"union_select", regexp.MustCompile(`(?i)UNION\s+SELECT`)},             // UNION SELECT
+	{"extended_procedure", regexp.MustCompile(`(?i)xp_`)},                  // Extended stored procedures
+	{"system_procedure", regexp.MustCompile(`(?i)sp_`)},                    // System stored procedures
+	{"exec_call", regexp.MustCompile(`(?i)exec\s*\(`)},                     // EXEC function
+	{"execute_call", regexp.MustCompile(`(?i)execute\s*\(`)},               // EXECUTE function
+}
+
+// roleChangePattern matches statements switching the role or session user a connection runs as, and
+// anonymous code blocks that could do so out of sight
+var roleChangePattern = regexp.MustCompile(`(?i)\b(SET\s+(SESSION\s+|LOCAL\s+)?ROLE\b|RESET\s+(ROLE|ALL|SESSION\s+AUTHORIZATION)\b|SESSION\s+AUTHORIZATION\b|DISCARD\s+ALL\b|DO\s*(\$|'|LANGUAGE\b))`)
+
+var (
+	setConfigCall        = regexp.MustCompile(`(?i)"?\bset_config"?\s*\(`)
+	setConfigLiteralName = regexp.MustCompile(`(?i)^\s*'([a-z_][a-z0-9_.]*)'\s*,`)
+)
+
+// ChangesSessionRole reports whether a statement could switch the role its connection runs as: SET or
+// RESET ROLE, SESSION AUTHORIZATION, DISCARD ALL, a DO block, or a set_config call whose setting is
+// role, session_authorization or anything but a plain literal
+func ChangesSessionRole(statement string) bool {
+	if roleChangePattern.MatchString(statement) {
+		return true
+	}
+	for _, call := range setConfigCall.FindAllStringIndex(statement, -1) {
+		name := setConfigLiteralName.FindStringSubmatch(statement[call[1]:])
+		if name == nil {
+			return true
+		}
+		switch strings.ToLower(name[1]) {
+		case "role", "session_authorization":
+			return true
+		}
+	}
+	return false
+}
+
+// UnsafeWhereRule names the SQL injection rule a WHERE clause fragment matches, reporting false when
+// it matches none
+func UnsafeWhereRule(whereClause string) (string, bool) {
+	for _, rule := range unsafeWhereRules {
+		if rule.pattern.MatchString(whereClause) {
+			return rule.name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"encoding/json"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleAuditLog(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	filter, err := parseAuditFilter(r)
+	if err != nil {
+		http.Error(w, html.EscapeString(err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	entries, err := h.auditUC.ListEntries(r.Context(), session.Username, filter)
+	if err != nil {
+		writeAdminError(w, err, "loading the audit log")
+		return
+	}
+
+	response := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, auditEntrySummary(entry))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// auditEntrySummary is the listed view of an audit entry
+func auditEntrySummary(entry domain.AuditEntry) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            entry.ID,
+		"created_at":    entry.CreatedAt.UTC().Format(time.RFC3339),
+		"username":      entry.Username,
+		"action":        entry.Action,
+		"database":      entry.Database,
+		"schema":        entry.Schema,
+		"table":         entry.Table,
+		"target":        entry.Target,
+		"reason":        entry.Reason,
+		"approved_by":   entry.ApprovedBy,
+		"affected_rows": entry.AffectedRows,
+		"before":        entry.Before,
+		"after":         entry.After,
+	}
+}
@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleAuditPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	filter, err := parseAuditFilter(r)
+	if err != nil {
+		http.Error(w, html.EscapeString(err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	entries, err := h.auditUC.ListEntries(r.Context(), session.Username, filter)
+	if err != nil {
+		writeAdminError(w, err, "loading the audit log")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderAuditPage(w, r.URL.Query(), entries)
+}
+
+func (h *AdminHandlerImplementation) renderAuditPage(w http.ResponseWriter, query url.Values, entries []domain.AuditEntry) {
+	var page strings.Builder
+
+	page.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Audit Log</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		form { margin-bottom: 16px; }
+		form input { margin-right: 8px; }
+		table { border-collapse: collapse; width: 100%; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; vertical-align: top; }
+		th { background: #f0f0f0; }
+		pre { margin: 0; white-space: pre-wrap; font-size: 12px; }
+		.restore-deleted { margin-top: 6px; }
+		tr.new-location td { background: #fff3cd; }
+	</style>
+</head>
+<body>
+	<h1>Audit Log</h1>
+	<form id="audit-filter" method="GET" action="/admin/audit">`)
+
+	for _, field := range []struct{ name, label, kind string }{
+		{"username", "User", "text"},
+		{"action", "Action", "text"},
+		{"database", "Database", "text"},
+		{"schema", "Schema", "text"},
+		{"table", "Table", "text"},
+		{"since", "From", "date"},
+		{"until", "To", "date"},
+	} {
+		page.WriteString(fmt.Sprintf(`
+		<label>%s <input type="%s" name="%s" value="%s"></label>`,
+			field.label, field.kind, field.name, html.EscapeString(query.Get(field.name))))
+	}
+
+	// Export links carry the filter so the file matches what is on screen
+	exportQuery := url.Values{}
+	for key, values := range query {
+		if key != "format" {
+			exportQuery[key] = values
+		}
+	}
+	page.WriteString(`
+		<button type="submit">Filter</button>
+	</form>
+	<p>`)
+	for _, format := range []string{domain.AuditExportCSV, domain.AuditExportJSON} {
+		exportQuery.Set("format", format)
+		page.WriteString(fmt.Sprintf(`<a class="export-audit" href="/api/admin/audit/export?%s">Export %s</a> `,
+			html.EscapeString(exportQuery.Encode()), strings.ToUpper(format)))
+	}
+	page.WriteString(`</p>
+	<table id="audit-table">
+		<thead>
+			<tr><th>Time</th><th>User</th><th>Action</th><th>Object</th><th>Reason</th><th>Changes</th></tr>
+		</thead>
+		<tbody>`)
+
+	for _, entry := range entries {
+		rowClass, action := "", entry.Action
+		if isNewLocationLogin(entry) {
+			rowClass, action = ` class="new-location"`, entry.Action+" (new location)"
+		}
+		restore := ""
+		if deletesByKey(entry) {
+			restore = fmt.Sprintf(`<button type="button" class="restore-deleted" data-entry-id="%s">Restore deleted rows</button>`,
+				html.EscapeString(entry.ID))
+		}
+		page.WriteString(fmt.Sprintf(`
+			<tr data-audit-id="%s"%s><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td><pre>%s</pre>%s</td></tr>`,
+			html.EscapeString(entry.ID),
+			rowClass,
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			html.EscapeString(entry.Username),
+			html.EscapeString(action),
+			html.EscapeString(auditObject(entry)),
+			html.EscapeString(entry.Reason),
+			html.EscapeString(auditChanges(entry)),
+			restore,
+		))
+	}
+
+	page.WriteString(`
+		</tbody>
+	</table>
+	<script>
+		// Restoring previews the compensating INSERT first and runs it only once the statement is confirmed
+		function restoreDeleted(entryId, confirmStatement) {
+			const body = new URLSearchParams({entry_id: entryId});
+			if (confirmStatement) body.set('confirm', confirmStatement);
+			return fetch('/api/transaction/restore-deleted', {method: 'POST', body: body}).then(response => {
+				if (!response.ok) return response.text().then(text => { throw new Error(text); });
+				return response.json();
+			});
+		}
+		document.querySelectorAll('.restore-deleted').forEach(button => {
+			button.addEventListener('click', () => {
+				const entryId = button.dataset.entryId;
+				restoreDeleted(entryId, '').then(restore => {
+					if (!confirm('Restore ' + restore.rows + ' row(s) into ' + restore.schema + '.' + restore.table + '?\n\n' + restore.statement)) return;
+					return restoreDeleted(entryId, restore.statement).then(() => {
+						button.disabled = true;
+						button.textContent = 'Rows restored';
+					});
+				}).catch(err => alert(err.message));
+			});
+		});
+	</script>
+</body>
+</html>`)
+
+	w.Write([]byte(page.String()))
+}
+
+// auditObject names what an entry changed: a table, or another object such as a session
+func auditObject(entry domain.AuditEntry) string {
+	if entry.Table != "" {
+		object := entry.Table
+		if entry.Schema != "" {
+			object = entry.Schema + "." + object
+		}
+		if entry.Database != "" {
+			object = entry.Database + ": " + object
+		}
+		return object
+	}
+	if entry.Target != "" {
+		return entry.Target
+	}
+	return "-"
+}
+
+// deletesByKey reports whether an entry is a commit that deleted rows by primary key, the rows kept
+// for restoring
+func deletesByKey(entry domain.AuditEntry) bool {
+	if entry.Action != domain.AuditActionCommit {
+		return false
+	}
+	for _, row := range entry.AffectedRows {
+		if _, ok := row["primary_key"]; ok && row["operation"] == "delete" {
+			return true
+		}
+	}
+	return false
+}
+
+// isNewLocationLogin reports whether an entry is a login flagged as coming from a new location
+func isNewLocationLogin(entry domain.AuditEntry) bool {
+	newLocation, _ := entry.After["new_location"].(bool)
+	return entry.Action == domain.AuditActionLogin && newLocation
+}
+
+// auditChanges renders an entry's affected rows and before and after values as indented JSON
+func auditChanges(entry domain.AuditEntry) string {
+	changes := map[string]interface{}{}
+	if len(entry.AffectedRows) > 0 {
+		changes["rows"] = entry.AffectedRows
+	}
+	if entry.Before != nil {
+		changes["before"] = entry.Before
+	}
+	if entry.After != nil {
+		changes["after"] = entry.After
+	}
+	if entry.ApprovedBy != "" {
+		changes["approved_by"] = entry.ApprovedBy
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	encoded, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
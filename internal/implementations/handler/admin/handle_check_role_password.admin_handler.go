@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *AdminHandlerImplementation) HandleCheckRolePassword(w http.ResponseWriter, r *http.Request) {
+	// The password is posted rather than put in the URL, which access logs keep
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	check, err := h.sessionAdminUC.CheckRolePassword(r.Context(), session.Username, r.FormValue("name"), r.FormValue("password"))
+	if err != nil {
+		writeAdminError(w, err, "checking password")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"valid":      len(check.Violations) == 0,
+		"violations": check.Violations,
+	})
+}
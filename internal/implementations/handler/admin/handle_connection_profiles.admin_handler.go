@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleConnectionProfiles(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// POST adds or replaces a profile, then the profiles are listed either way
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		profile := domain.ConnectionProfile{
+			Name:               r.FormValue("name"),
+			Host:               r.FormValue("host"),
+			SSLMode:            r.FormValue("sslmode"),
+			ServicePasswordRef: r.FormValue("service_password_ref"),
+		}
+		if portStr := strings.TrimSpace(r.FormValue("port")); portStr != "" {
+			profile.Port, err = strconv.Atoi(portStr)
+			if err != nil {
+				http.Error(w, "Invalid port", http.StatusBadRequest)
+				return
+			}
+		}
+
+		if err := h.sessionAdminUC.SaveConnectionProfile(r.Context(), session.Username, profile); err != nil {
+			writeAdminError(w, err, "saving connection profile")
+			return
+		}
+	}
+
+	profiles, err := h.authUC.ListConnectionProfiles(r.Context())
+	if err != nil {
+		writeAdminError(w, err, "listing connection profiles")
+		return
+	}
+
+	response := make([]map[string]interface{}, 0, len(profiles))
+	for i, profile := range profiles {
+		response = append(response, map[string]interface{}{
+			"name":                 profile.Name,
+			"host":                 profile.Host,
+			"port":                 profile.Port,
+			"sslmode":              profile.SSLMode,
+			"service_password_ref": profile.ServicePasswordRef,
+			"default":              i == 0,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleCreateRole(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	role := domain.NewRole{
+		Name:     r.FormValue("name"),
+		Password: r.FormValue("password"),
+		CreateDB: r.FormValue("createdb") == "true",
+	}
+	if role.Name == "" || role.Password == "" {
+		http.Error(w, "Missing name or password parameter", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.sessionAdminUC.CreateRole(r.Context(), session.Username, role); err != nil {
+		writeAdminError(w, err, "creating role")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"created":  role.Name,
+		"createdb": role.CreateDB,
+	})
+}
@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+func (h *AdminHandlerImplementation) HandleDisabledShortcuts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Actions come as repeated fields or one comma-separated field; none enables every shortcut
+	var actions []string
+	for _, value := range r.Form["actions"] {
+		actions = append(actions, strings.Split(value, ",")...)
+	}
+
+	disabled, err := h.sessionAdminUC.SetDisabledShortcuts(r.Context(), session.Username, actions)
+	if err != nil {
+		writeAdminError(w, err, "disabling shortcuts")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"disabled": disabled,
+	})
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleEnvironment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var banner *domain.EnvironmentBanner
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		banner, err = h.sessionAdminUC.SetEnvironmentBanner(r.Context(), session.Username, r.FormValue("name"), r.FormValue("color"))
+		if err != nil {
+			writeAdminError(w, err, "setting the environment banner")
+			return
+		}
+	} else {
+		banner, err = h.sessionAdminUC.GetEnvironmentBanner(r.Context())
+		if err != nil {
+			writeAdminError(w, err, "loading the environment banner")
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"name":  banner.Name,
+		"color": banner.Color,
+	})
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"net/http"
+)
+
+func (h *AdminHandlerImplementation) HandleExportConnectionProfiles(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	content, err := h.sessionAdminUC.ExportConnectionProfiles(r.Context(), session.Username)
+	if err != nil {
+		writeAdminError(w, err, "exporting connection profiles")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="lumen-pg-connection-profiles.json"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
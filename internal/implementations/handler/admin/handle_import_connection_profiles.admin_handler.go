@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleImportConnectionProfiles(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse the multipart upload
+	r.Body = http.MaxBytesReader(w, r.Body, domain.ConnectionProfileMaxUploadBytes)
+	if err := r.ParseMultipartForm(domain.ConnectionProfileMaxUploadBytes); err != nil {
+		http.Error(w, "Error parsing upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Error reading upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.sessionAdminUC.ImportConnectionProfiles(r.Context(), session.Username, content)
+	if err != nil {
+		writeAdminError(w, err, "importing connection profiles")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"added":              result.Added,
+		"replaced":           result.Replaced,
+		"unresolved_secrets": result.UnresolvedSecrets,
+	})
+}
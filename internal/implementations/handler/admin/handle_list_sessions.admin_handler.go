@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleListSessions(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sessions, err := h.sessionAdminUC.ListSessions(r.Context(), session.Username)
+	if err != nil {
+		writeAdminError(w, err, "listing sessions")
+		return
+	}
+
+	response := make([]map[string]interface{}, 0, len(sessions))
+	for _, listed := range sessions {
+		response = append(response, sessionSummary(listed, cookie.Value))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// sessionSummary is the listed view of a session, flagging the one the admin is using
+func sessionSummary(session *domain.Session, currentID string) map[string]interface{} {
+	summary := map[string]interface{}{
+		"id":               session.ID,
+		"username":         session.Username,
+		"identity":         session.Identity,
+		"client_ip":        session.ClientIP,
+		"profile":          session.Profile,
+		"created_at":       session.CreatedAt.Format(time.RFC3339),
+		"last_activity_at": nil,
+		"expires_at":       session.ExpiresAt.Format(time.RFC3339),
+		"current":          session.ID == currentID,
+		"settings":         session.Settings.Summary(),
+	}
+	if !session.LastActivityAt.IsZero() {
+		summary["last_activity_at"] = session.LastActivityAt.Format(time.RFC3339)
+	}
+	return summary
+}
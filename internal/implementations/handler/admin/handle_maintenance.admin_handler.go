@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleMaintenance(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var status *domain.MaintenanceStatus
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		enabled, err := strconv.ParseBool(r.FormValue("enabled"))
+		if err != nil {
+			http.Error(w, "Invalid enabled parameter", http.StatusBadRequest)
+			return
+		}
+
+		status, err = h.sessionAdminUC.SetMaintenanceMode(r.Context(), session.Username, enabled, r.FormValue("message"))
+		if err != nil {
+			writeAdminError(w, err, "setting maintenance mode")
+			return
+		}
+	} else {
+		status, err = h.sessionAdminUC.GetMaintenanceStatus(r.Context(), session.Username)
+		if err != nil {
+			writeAdminError(w, err, "loading maintenance status")
+			return
+		}
+	}
+
+	response := map[string]interface{}{
+		"enabled": status.Enabled,
+	}
+	if status.Enabled {
+		response["message"] = status.Message
+		response["since"] = status.Since.UTC().Format(time.RFC3339)
+		response["grace_ends_at"] = status.GraceEndsAt.UTC().Format(time.RFC3339)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+func (h *AdminHandlerImplementation) HandleRejectionStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	since, err := parseAuditTime(r.URL.Query(), "since", false)
+	if err != nil {
+		writeAdminError(w, err, "loading rejections")
+		return
+	}
+
+	stats, err := h.sessionAdminUC.GetRejectionStats(r.Context(), session.Username, since)
+	if err != nil {
+		writeAdminError(w, err, "loading rejections")
+		return
+	}
+
+	rejections := make([]map[string]interface{}, len(stats))
+	for i, stat := range stats {
+		rejections[i] = map[string]interface{}{
+			"source":    stat.Source,
+			"rule":      stat.Rule,
+			"method":    stat.Method,
+			"route":     stat.Route,
+			"count":     stat.Count,
+			"last_seen": stat.LastSeen.UTC().Format(time.RFC3339),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"rejections": rejections,
+	})
+}
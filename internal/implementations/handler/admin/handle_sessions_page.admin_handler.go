@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *AdminHandlerImplementation) HandleSessionsPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	sessions, err := h.sessionAdminUC.ListSessions(r.Context(), session.Username)
+	if err != nil {
+		writeAdminError(w, err, "listing sessions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderSessionsPage(w, sessions, cookie.Value)
+}
+
+func (h *AdminHandlerImplementation) renderSessionsPage(w http.ResponseWriter, sessions []*domain.Session, currentID string) {
+	var page strings.Builder
+
+	page.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Active Sessions</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; }
+		th { background: #f0f0f0; }
+		.current { font-weight: bold; }
+	</style>
+</head>
+<body>
+	<h1>Active Sessions</h1>
+	<table id="sessions-table">
+		<thead>
+			<tr><th>Username</th><th>Server</th><th>Created</th><th>Last Activity</th><th>Client IP</th><th>Settings</th><th></th></tr>
+		</thead>
+		<tbody>`)
+
+	for _, listed := range sessions {
+		lastActivity := "-"
+		if !listed.LastActivityAt.IsZero() {
+			lastActivity = listed.LastActivityAt.Format(time.RFC3339)
+		}
+		clientIP := listed.ClientIP
+		if clientIP == "" {
+			clientIP = "-"
+		}
+		profile := listed.Profile
+		if profile == "" {
+			profile = "-"
+		}
+		username := listed.Username
+		if listed.Identity != "" && listed.Identity != listed.Username {
+			username = listed.Identity + " (" + listed.Username + ")"
+		}
+		class := ""
+		if listed.ID == currentID {
+			class = ` class="current"`
+		}
+		statementTimeout := ""
+		if listed.Settings.StatementTimeout > 0 {
+			statementTimeout = listed.Settings.StatementTimeout.String()
+		}
+		page.WriteString(fmt.Sprintf(`
+			<tr%s data-session-id="%s"><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td><form class="session-settings" onsubmit="saveSettings(event, this)"><input name="work_mem" placeholder="work_mem" value="%s"><input name="statement_timeout" placeholder="statement_timeout" value="%s"><input name="application_name_suffix" placeholder="application_name suffix" value="%s"><button type="submit">Save</button></form></td><td><button class="revoke-session" onclick="revokeSession(this)">Revoke</button></td></tr>`,
+			class,
+			html.EscapeString(listed.ID),
+			html.EscapeString(username),
+			html.EscapeString(profile),
+			listed.CreatedAt.Format(time.RFC3339),
+			lastActivity,
+			html.EscapeString(clientIP),
+			html.EscapeString(listed.Settings.WorkMem),
+			html.EscapeString(statementTimeout),
+			html.EscapeString(listed.Settings.ApplicationNameSuffix),
+		))
+	}
+
+	page.WriteString(`
+		</tbody>
+	</table>
+	<script>
+		function revokeSession(button) {
+			const row = button.closest('tr');
+			const body = new URLSearchParams({ session_id: row.dataset.sessionId });
+			fetch('/api/admin/sessions/revoke', { method: 'POST', body: body })
+				.then(response => {
+					if (!response.ok) {
+						return response.text().then(text => { throw new Error(text); });
+					}
+					row.remove();
+				})
+				.catch(err => alert('Failed to revoke session: ' + err.message));
+		}
+
+		function saveSettings(event, form) {
+			event.preventDefault();
+			const body = new URLSearchParams(new FormData(form));
+			body.set('session_id', form.closest('tr').dataset.sessionId);
+			fetch('/api/admin/sessions/settings', { method: 'POST', body: body })
+				.then(response => {
+					if (!response.ok) {
+						return response.text().then(text => { throw new Error(text); });
+					}
+				})
+				.catch(err => alert('Failed to save session settings: ' + err.message));
+		}
+	</script>
+</body>
+</html>`)
+
+	w.Write([]byte(page.String()))
+}
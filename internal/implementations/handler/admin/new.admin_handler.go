@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type AdminHandlerImplementation struct {
+	sessionAdminUC usecase.SessionAdminUseCase
+	authUC         usecase.AuthenticationUseCase
+	auditUC        usecase.AuditUseCase
+}
+
+func NewAdminHandlerImplementation(
+	sessionAdminUC usecase.SessionAdminUseCase,
+	authUC usecase.AuthenticationUseCase,
+	auditUC usecase.AuditUseCase,
+) handler.AdminHandler {
+	return &AdminHandlerImplementation{
+		sessionAdminUC: sessionAdminUC,
+		authUC:         authUC,
+		auditUC:        auditUC,
+	}
+}
@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// parseAuditFilter reads the audit log filter from the query string
+func parseAuditFilter(r *http.Request) (domain.AuditFilter, error) {
+	query := r.URL.Query()
+	filter := domain.AuditFilter{
+		Username: strings.TrimSpace(query.Get("username")),
+		Action:   strings.TrimSpace(query.Get("action")),
+		Database: strings.TrimSpace(query.Get("database")),
+		Schema:   strings.TrimSpace(query.Get("schema")),
+		Table:    strings.TrimSpace(query.Get("table")),
+	}
+
+	var err error
+	if filter.Since, err = parseAuditTime(query, "since", false); err != nil {
+		return filter, err
+	}
+	if filter.Until, err = parseAuditTime(query, "until", true); err != nil {
+		return filter, err
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return filter, domain.ValidationError{Field: "limit", Message: fmt.Sprintf("invalid limit: %s", value)}
+		}
+		filter.Limit = limit
+	}
+	return filter, nil
+}
+
+// parseAuditTime accepts RFC 3339 timestamps as well as datetime-local and date input values; a bare
+// date ending the range covers that whole day
+func parseAuditTime(query url.Values, field string, endOfDay bool) (time.Time, error) {
+	value := strings.TrimSpace(query.Get(field))
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		if endOfDay {
+			return t.Add(24*time.Hour - time.Nanosecond), nil
+		}
+		return t, nil
+	}
+	return time.Time{}, domain.ValidationError{Field: field, Message: fmt.Sprintf("invalid %s time: %s", field, value)}
+}
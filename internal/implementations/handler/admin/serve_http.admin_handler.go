@@ -0,0 +1,44 @@
+package admin
+
+import "net/http"
+
+func (h *AdminHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/admin/sessions":
+		h.HandleSessionsPage(w, r)
+	case "/api/admin/sessions":
+		h.HandleListSessions(w, r)
+	case "/api/admin/sessions/revoke":
+		h.HandleRevokeSession(w, r)
+	case "/api/admin/sessions/settings":
+		h.HandleSessionSettings(w, r)
+	case "/api/admin/profiles":
+		h.HandleConnectionProfiles(w, r)
+	case "/api/admin/profiles/delete":
+		h.HandleDeleteConnectionProfile(w, r)
+	case "/api/admin/profiles/export":
+		h.HandleExportConnectionProfiles(w, r)
+	case "/api/admin/profiles/import":
+		h.HandleImportConnectionProfiles(w, r)
+	case "/api/admin/maintenance":
+		h.HandleMaintenance(w, r)
+	case "/api/admin/environment":
+		h.HandleEnvironment(w, r)
+	case "/api/admin/shortcuts":
+		h.HandleDisabledShortcuts(w, r)
+	case "/api/admin/roles":
+		h.HandleCreateRole(w, r)
+	case "/api/admin/roles/check-password":
+		h.HandleCheckRolePassword(w, r)
+	case "/api/admin/rejections":
+		h.HandleRejectionStats(w, r)
+	case "/admin/audit":
+		h.HandleAuditPage(w, r)
+	case "/api/admin/audit":
+		h.HandleAuditLog(w, r)
+	case "/api/admin/audit/export":
+		h.HandleExportAuditLog(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,22 @@
+package admin_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/admin"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestAdminHandler(t *testing.T) {
+	constructor := func(
+		sessionAdminUC usecase.SessionAdminUseCase,
+		authUC usecase.AuthenticationUseCase,
+		auditUC usecase.AuditUseCase,
+	) handler.AdminHandler {
+		return admin.NewAdminHandlerImplementation(sessionAdminUC, authUC, auditUC)
+	}
+
+	handlerTestRunner.AdminHandlerRunner(t, constructor)
+}
@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeAdminError maps a session admin usecase error onto its HTTP status
+func writeAdminError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrSessionNotFound):
+		http.Error(w, "Session not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrRoleExists):
+		http.Error(w, "A role with this name already exists", http.StatusConflict)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
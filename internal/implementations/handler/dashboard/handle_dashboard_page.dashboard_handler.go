@@ -0,0 +1,141 @@
+package dashboard
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *DashboardHandlerImplementation) HandleDashboardPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	tiles, err := h.dashboardUC.ListDashboardTiles(r.Context(), session.Username)
+	if err != nil {
+		writeDashboardError(w, err, "listing dashboard tiles")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderDashboardPage(w, tiles)
+}
+
+func (h *DashboardHandlerImplementation) renderDashboardPage(w http.ResponseWriter, tiles []domain.DashboardTile) {
+	var page strings.Builder
+
+	page.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Dashboard</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		.tiles { display: flex; flex-wrap: wrap; gap: 12px; margin-bottom: 20px; }
+		.tile { border: 1px solid #ccc; padding: 10px 16px; min-width: 180px; }
+		.tile .value { font-size: 1.6em; margin: 6px 0; }
+		.tile .refreshed { color: #666; font-size: 0.85em; }
+		.tile.failed .value { color: #b00; font-size: 1em; }
+	</style>
+</head>
+<body>
+	<h1>Dashboard</h1>
+	<div class="tiles" id="tiles">`)
+
+	if len(tiles) == 0 {
+		page.WriteString(`
+		<p id="no-tiles">Nothing pinned yet. Pin a saved query's row count or single value below.</p>`)
+	}
+	for _, tile := range tiles {
+		page.WriteString(fmt.Sprintf(`
+		<div class="tile" data-tile-id="%s" data-kind="%s" data-refresh-seconds="%d">
+			%s
+			<div class="value">-</div>
+			<div class="refreshed">Every %d seconds</div>
+			<button type="button" class="unpin">Unpin</button>
+		</div>`,
+			html.EscapeString(tile.ID),
+			html.EscapeString(tile.Kind),
+			tile.RefreshSeconds,
+			html.EscapeString(tile.Title),
+			tile.RefreshSeconds,
+		))
+	}
+
+	page.WriteString(fmt.Sprintf(`
+	</div>
+	<form id="pin-form">
+		<h2>Pin a saved query</h2>
+		<label>Saved query ID <input name="saved_query_id" required></label>
+		<label>Title <input name="title" maxlength="%d"></label>
+		<label>Show
+			<select name="kind">
+				<option value="%s">Row count</option>
+				<option value="%s">Single value</option>
+			</select>
+		</label>
+		<label>Refresh every <input name="refresh_seconds" type="number" min="%d" max="%d" value="%d"> seconds</label>
+		<button type="submit">Pin</button>
+		<span id="pin-status"></span>
+	</form>
+	<script>
+		function refreshTile(tile) {
+			const value = tile.querySelector('.value');
+			const refreshed = tile.querySelector('.refreshed');
+			fetch('/api/dashboard/refresh?id=' + encodeURIComponent(tile.dataset.tileId))
+				.then(response => response.ok ? response.json() : response.text().then(text => Promise.reject(new Error(text.trim()))))
+				.then(result => {
+					tile.classList.remove('failed');
+					value.textContent = tile.dataset.kind === '%s'
+						? (result.value === null ? 'NULL' : String(result.value))
+						: result.row_count + (result.row_count === 1 ? ' row' : ' rows');
+					refreshed.textContent = 'As of ' + new Date(result.refreshed_at).toLocaleTimeString();
+				})
+				.catch(err => {
+					tile.classList.add('failed');
+					value.textContent = err.message;
+				});
+		}
+
+		document.querySelectorAll('.tile').forEach(tile => {
+			refreshTile(tile);
+			setInterval(() => refreshTile(tile), Number(tile.dataset.refreshSeconds) * 1000);
+			tile.querySelector('.unpin').addEventListener('click', () => {
+				fetch('/api/dashboard/unpin', { method: 'POST', body: new URLSearchParams({ id: tile.dataset.tileId }) })
+					.then(response => { if (response.ok) { location.reload(); } });
+			});
+		});
+
+		document.getElementById('pin-form').addEventListener('submit', event => {
+			event.preventDefault();
+			const status = document.getElementById('pin-status');
+			fetch('/api/dashboard/pin', { method: 'POST', body: new URLSearchParams(new FormData(event.target)) })
+				.then(response => response.ok ? location.reload() : response.text().then(text => { status.textContent = 'Not pinned: ' + text.trim(); }));
+		});
+	</script>
+</body>
+</html>`,
+		domain.DashboardTileTitleMaxLength,
+		domain.DashboardTileRowCount,
+		domain.DashboardTileScalar,
+		domain.LiveRefreshMinInterval,
+		domain.LiveRefreshMaxInterval,
+		domain.DashboardTileDefaultRefresh,
+		domain.DashboardTileScalar,
+	))
+
+	w.Write([]byte(page.String()))
+}
@@ -0,0 +1,37 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *DashboardHandlerImplementation) HandleListDashboardTiles(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tiles, err := h.dashboardUC.ListDashboardTiles(r.Context(), session.Username)
+	if err != nil {
+		writeDashboardError(w, err, "listing dashboard tiles")
+		return
+	}
+
+	response := make([]map[string]interface{}, len(tiles))
+	for i, tile := range tiles {
+		response[i] = dashboardTileJSON(tile)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"tiles": response})
+}
@@ -0,0 +1,61 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *DashboardHandlerImplementation) HandlePinQueryResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// An empty interval leaves the default to the usecase
+	refreshSeconds := 0
+	if value := r.FormValue("refresh_seconds"); value != "" {
+		refreshSeconds, err = strconv.Atoi(value)
+		if err != nil {
+			http.Error(w, "Invalid refresh interval", http.StatusBadRequest)
+			return
+		}
+	}
+
+	tile, err := h.dashboardUC.PinQueryResult(r.Context(), session.Username, domain.DashboardTile{
+		SavedQueryID:   r.FormValue("saved_query_id"),
+		Title:          r.FormValue("title"),
+		Kind:           r.FormValue("kind"),
+		RefreshSeconds: refreshSeconds,
+	})
+	if err != nil {
+		writeDashboardError(w, err, "pinning query result")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dashboardTileJSON(*tile))
+}
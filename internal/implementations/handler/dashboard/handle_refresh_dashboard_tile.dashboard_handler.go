@@ -0,0 +1,44 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+func (h *DashboardHandlerImplementation) HandleRefreshDashboardTile(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.dashboardUC.RefreshDashboardTile(r.Context(), session.Username, id)
+	if err != nil {
+		writeDashboardError(w, err, "refreshing dashboard tile")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"tile_id":      result.TileID,
+		"row_count":    result.RowCount,
+		"value":        result.Value,
+		"refreshed_at": result.RefreshedAt.Format(time.RFC3339),
+	})
+}
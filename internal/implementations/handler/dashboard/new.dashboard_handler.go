@@ -0,0 +1,21 @@
+package dashboard
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type DashboardHandlerImplementation struct {
+	dashboardUC usecase.DashboardUseCase
+	authUC      usecase.AuthenticationUseCase
+}
+
+func NewDashboardHandlerImplementation(
+	dashboardUC usecase.DashboardUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.DashboardHandler {
+	return &DashboardHandlerImplementation{
+		dashboardUC: dashboardUC,
+		authUC:      authUC,
+	}
+}
@@ -0,0 +1,20 @@
+package dashboard
+
+import "net/http"
+
+func (h *DashboardHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/dashboard":
+		h.HandleDashboardPage(w, r)
+	case "/api/dashboard/tiles":
+		h.HandleListDashboardTiles(w, r)
+	case "/api/dashboard/pin":
+		h.HandlePinQueryResult(w, r)
+	case "/api/dashboard/unpin":
+		h.HandleUnpinDashboardTile(w, r)
+	case "/api/dashboard/refresh":
+		h.HandleRefreshDashboardTile(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,11 @@
+package dashboard
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestDashboardHandler(t *testing.T) {
+	testRunner.DashboardHandlerRunner(t, NewDashboardHandlerImplementation)
+}
@@ -0,0 +1,37 @@
+package dashboard
+
+import (
+	"errors"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeDashboardError maps a dashboard usecase error onto its HTTP status
+func writeDashboardError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrDashboardTileNotFound), errors.Is(err, domain.ErrSavedQueryNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// dashboardTileJSON is the JSON form of a dashboard tile
+func dashboardTileJSON(tile domain.DashboardTile) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              tile.ID,
+		"saved_query_id":  tile.SavedQueryID,
+		"title":           tile.Title,
+		"kind":            tile.Kind,
+		"refresh_seconds": tile.RefreshSeconds,
+		"created_at":      tile.CreatedAt.Format(time.RFC3339),
+	}
+}
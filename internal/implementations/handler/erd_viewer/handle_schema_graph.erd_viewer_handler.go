@@ -0,0 +1,101 @@
+package erd_viewer
+
+import (
+	"encoding/json"
+	"errors"
+	"html"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *ERDViewerHandlerImplementation) HandleSchemaGraph(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get query parameters; root and depth are optional
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	root := r.URL.Query().Get("root")
+
+	if database == "" || schema == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	depth := 0
+	if raw := r.URL.Query().Get("depth"); raw != "" {
+		depth, err = strconv.Atoi(raw)
+		if err != nil {
+			http.Error(w, "Invalid depth", http.StatusBadRequest)
+			return
+		}
+	}
+
+	graph, err := h.erdUC.GetSchemaGraph(r.Context(), session.Username, database, schema, root, depth)
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrTableNotFound):
+			http.Error(w, "Root table not found", http.StatusNotFound)
+		default:
+			http.Error(w, "Error building schema graph: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	tables := make([]map[string]interface{}, 0, len(graph.Tables))
+	for _, table := range graph.Tables {
+		columns := make([]map[string]interface{}, 0, len(table.Columns))
+		for _, column := range table.Columns {
+			columns = append(columns, map[string]interface{}{
+				"name":        column.Name,
+				"data_type":   column.DataType,
+				"is_nullable": column.IsNullable,
+				"is_primary":  column.IsPrimary,
+			})
+		}
+		tables = append(tables, map[string]interface{}{
+			"name":         table.Name,
+			"primary_keys": table.PrimaryKeys,
+			"columns":      columns,
+		})
+	}
+
+	edges := make([]map[string]interface{}, 0, len(graph.Relationships))
+	for _, relationship := range graph.Relationships {
+		edges = append(edges, map[string]interface{}{
+			"from_table":  relationship.FromTable,
+			"from_column": relationship.FromColumn,
+			"to_table":    relationship.ToTable,
+			"to_column":   relationship.ToColumn,
+			"cardinality": relationship.RelationType,
+			"optional":    relationship.Optional,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"database": graph.Database,
+		"schema":   graph.Schema,
+		"root":     graph.Root,
+		"depth":    graph.Depth,
+		"tables":   tables,
+		"edges":    edges,
+	})
+}
@@ -14,6 +14,8 @@ func (h *ERDViewerHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *htt
 		h.HandleERDPan(w, r)
 	case "/erd/table":
 		h.HandleTableClickInERD(w, r)
+	case "/api/schema/graph":
+		h.HandleSchemaGraph(w, r)
 	default:
 		http.NotFound(w, r)
 	}
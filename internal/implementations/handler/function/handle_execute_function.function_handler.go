@@ -0,0 +1,101 @@
+package function
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *FunctionHandlerImplementation) HandleExecuteFunction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	call := domain.FunctionCall{
+		Database:  r.FormValue("database"),
+		Schema:    r.FormValue("schema"),
+		Name:      r.FormValue("name"),
+		Arguments: r.FormValue("arguments"),
+	}
+	if call.Database == "" || call.Schema == "" || call.Name == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Each value[] pairs with a kind[] of value, null or default
+	values := r.Form["value"]
+	kinds := r.Form["kind"]
+	if len(kinds) != len(values) {
+		http.Error(w, "Each value needs a kind", http.StatusBadRequest)
+		return
+	}
+	for i, value := range values {
+		argument := domain.FunctionArgument{Value: value}
+		switch kinds[i] {
+		case "null":
+			argument.Null = true
+		case "default":
+			argument.Default = true
+		}
+		call.Values = append(call.Values, argument)
+	}
+
+	offset, _ := strconv.Atoi(r.FormValue("offset"))
+
+	called, err := h.functionUC.ExecuteFunction(r.Context(), session.Username, call, domain.QueryParams{
+		Offset:     offset,
+		Limit:      50,
+		SearchPath: session.SearchPath,
+		Settings:   session.Settings,
+	})
+	if err != nil {
+		writeFunctionError(w, err, "executing function")
+		return
+	}
+
+	result := called.Result
+	if result == nil {
+		result = &domain.QueryResult{}
+	}
+	columns := result.Columns
+	if columns == nil {
+		columns = []string{}
+	}
+	rows := result.Rows
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement":   called.Statement,
+		"columns":     columns,
+		"rows":        rows,
+		"row_count":   result.RowCount,
+		"total_count": result.TotalCount,
+	})
+}
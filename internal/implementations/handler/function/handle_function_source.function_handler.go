@@ -0,0 +1,45 @@
+package function
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *FunctionHandlerImplementation) HandleFunctionSource(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters; arguments is the identity signature and may be empty for routines without any
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	name := r.URL.Query().Get("name")
+	arguments := r.URL.Query().Get("arguments")
+
+	if database == "" || schema == "" || name == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	definition, err := h.functionUC.GetFunctionSource(r.Context(), session.Username, database, schema, name, arguments)
+	if err != nil {
+		writeFunctionError(w, err, "loading function source")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"definition": definition,
+	})
+}
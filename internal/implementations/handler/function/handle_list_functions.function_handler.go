@@ -0,0 +1,65 @@
+package function
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *FunctionHandlerImplementation) HandleListFunctions(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+
+	if database == "" || schema == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.functionUC.ListFunctions(r.Context(), session.Username, database, schema)
+	if err != nil {
+		writeFunctionError(w, err, "listing functions")
+		return
+	}
+
+	functions := make([]map[string]interface{}, 0, len(list))
+	for _, function := range list {
+		parameters := make([]map[string]interface{}, 0, len(function.Parameters))
+		for _, parameter := range function.Parameters {
+			parameters = append(parameters, map[string]interface{}{
+				"name":        parameter.Name,
+				"data_type":   parameter.DataType,
+				"mode":        parameter.Mode,
+				"has_default": parameter.HasDefault,
+			})
+		}
+		functions = append(functions, map[string]interface{}{
+			"name":        function.Name,
+			"kind":        function.Kind,
+			"arguments":   function.Arguments,
+			"result":      function.Result,
+			"language":    function.Language,
+			"parameters":  parameters,
+			"can_execute": function.CanExecute,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"functions": functions,
+	})
+}
@@ -0,0 +1,21 @@
+package function
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type FunctionHandlerImplementation struct {
+	functionUC usecase.FunctionUseCase
+	authUC     usecase.AuthenticationUseCase
+}
+
+func NewFunctionHandlerImplementation(
+	functionUC usecase.FunctionUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.FunctionHandler {
+	return &FunctionHandlerImplementation{
+		functionUC: functionUC,
+		authUC:     authUC,
+	}
+}
@@ -0,0 +1,16 @@
+package function
+
+import "net/http"
+
+func (h *FunctionHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/functions":
+		h.HandleListFunctions(w, r)
+	case "/api/functions/source":
+		h.HandleFunctionSource(w, r)
+	case "/api/functions/execute":
+		h.HandleExecuteFunction(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
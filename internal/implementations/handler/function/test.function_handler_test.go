@@ -0,0 +1,21 @@
+package function_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/function"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestFunctionHandler(t *testing.T) {
+	constructor := func(
+		functionUC usecase.FunctionUseCase,
+		authUC usecase.AuthenticationUseCase,
+	) handler.FunctionHandler {
+		return function.NewFunctionHandlerImplementation(functionUC, authUC)
+	}
+
+	handlerTestRunner.FunctionHandlerRunner(t, constructor)
+}
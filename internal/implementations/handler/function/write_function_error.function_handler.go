@@ -0,0 +1,26 @@
+package function
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeFunctionError maps a function usecase error onto its HTTP status
+func writeFunctionError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrFunctionNotFound):
+		http.Error(w, "Function not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrQueryCancelled):
+		http.Error(w, "Query cancelled", http.StatusBadRequest)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
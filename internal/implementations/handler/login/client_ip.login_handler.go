@@ -0,0 +1,15 @@
+package login
+
+import (
+	"net"
+	"net/http"
+)
+
+// clientIP returns the address the request came from; forwarding headers are not trusted
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
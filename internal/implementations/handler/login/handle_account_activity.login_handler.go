@@ -0,0 +1,155 @@
+package login
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *LoginHandlerImplementation) HandleAccountActivity(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Users only ever see their own activity
+	activity, err := h.auditUC.ListAccountActivity(r.Context(), session.Username)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error loading account activity: "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var page strings.Builder
+	page.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Account Activity</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%; margin-bottom: 24px; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; vertical-align: top; }
+		th { background: #f0f0f0; }
+		code { white-space: pre-wrap; font-size: 12px; }
+		tr.new-location td { background: #fff3cd; }
+	</style>
+</head>
+<body>
+	<h1>Account Activity</h1>
+	<p>Recent activity under <strong>` + html.EscapeString(session.Username) + `</strong>. Query constants are
+	replaced by $n placeholders. If you do not recognise something here, change your password and tell an administrator.</p>
+
+	<h2>Logins</h2>
+	<table id="account-logins">
+		<thead><tr><th>Time</th><th>Address</th><th>Location</th></tr></thead>
+		<tbody>`)
+	for _, entry := range activity.Logins {
+		rowClass, location := "", loginLocation(entry)
+		if newLocation, _ := entry.After["new_location"].(bool); newLocation {
+			rowClass, location = ` class="new-location"`, location+" (new location)"
+		}
+		page.WriteString(fmt.Sprintf(`
+			<tr%s><td>%s</td><td>%s</td><td>%s</td></tr>`,
+			rowClass,
+			activityTime(entry),
+			html.EscapeString(entry.Target),
+			html.EscapeString(location),
+		))
+	}
+	page.WriteString(`
+		</tbody>
+	</table>
+
+	<h2>Queries</h2>
+	<table id="account-queries">
+		<thead><tr><th>Time</th><th>Statement</th><th>Outcome</th></tr></thead>
+		<tbody>`)
+	for _, entry := range activity.Queries {
+		outcome := "failed"
+		if succeeded, _ := entry.After["succeeded"].(bool); succeeded {
+			outcome = "succeeded"
+		}
+		page.WriteString(fmt.Sprintf(`
+			<tr><td>%s</td><td><code>%s</code></td><td>%s</td></tr>`,
+			activityTime(entry),
+			html.EscapeString(activityString(entry, "statement")),
+			outcome,
+		))
+	}
+	page.WriteString(`
+		</tbody>
+	</table>
+
+	<h2>Exports</h2>
+	<table id="account-exports">
+		<thead><tr><th>Time</th><th>Source</th><th>Format</th><th>Rows</th></tr></thead>
+		<tbody>`)
+	for _, entry := range activity.Exports {
+		page.WriteString(fmt.Sprintf(`
+			<tr><td>%s</td><td>%s</td><td>%s</td><td>%v</td></tr>`,
+			activityTime(entry),
+			exportSource(entry),
+			html.EscapeString(activityString(entry, "format")),
+			entry.After["rows"],
+		))
+	}
+	page.WriteString(`
+		</tbody>
+	</table>
+</body>
+</html>`)
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(page.String()))
+}
+
+func activityTime(entry domain.AuditEntry) string {
+	return entry.CreatedAt.UTC().Format(time.RFC3339)
+}
+
+// activityString reads a string the entry recorded after the action, empty when there is none
+func activityString(entry domain.AuditEntry, key string) string {
+	value, _ := entry.After[key].(string)
+	return value
+}
+
+// loginLocation joins the city, region and country a login was located in, or reports it unknown
+func loginLocation(entry domain.AuditEntry) string {
+	parts := make([]string, 0, 3)
+	for _, key := range []string{"city", "region", "country"} {
+		if value := activityString(entry, key); value != "" {
+			parts = append(parts, value)
+		}
+	}
+	if len(parts) == 0 {
+		return "unknown"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// exportSource renders what an export read: a table, or the sanitized editor query; the result is HTML
+func exportSource(entry domain.AuditEntry) string {
+	if entry.Table != "" {
+		return html.EscapeString(entry.Database + ": " + entry.Schema + "." + entry.Table)
+	}
+	return "<code>" + html.EscapeString(activityString(entry, "statement")) + "</code>"
+}
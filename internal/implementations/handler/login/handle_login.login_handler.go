@@ -1,6 +1,8 @@
 package login
 
 import (
+	"errors"
+	"html"
 	"net/http"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
@@ -16,11 +18,13 @@ func (h *LoginHandlerImplementation) HandleLogin(w http.ResponseWriter, r *http.
 	// Get credentials
 	username := r.FormValue("username")
 	password := r.FormValue("password")
+	profile := r.FormValue("profile")
 
 	// Validate login form
 	validationErrors, err := h.authUC.ValidateLoginForm(r.Context(), domain.LoginRequest{
 		Username: username,
 		Password: password,
+		Profile:  profile,
 	})
 	if err != nil {
 		http.Error(w, "Validation error: "+err.Error(), http.StatusInternalServerError)
@@ -39,8 +43,32 @@ func (h *LoginHandlerImplementation) HandleLogin(w http.ResponseWriter, r *http.
 	}
 
 	// Probe connection
-	success, err := h.authUC.ProbeConnection(r.Context(), username, password)
+	success, err := h.authUC.ProbeConnection(r.Context(), profile, username, password)
 	if err != nil {
+		// An unknown connection profile is a bad form rather than bad credentials
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Field == "profile" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("<div class='error'>" + html.EscapeString(validationErr.Message) + "</div>"))
+			return
+		}
+		// lumen-pg's own connection cap for the database is reached; the user may simply retry
+		if errors.Is(err, domain.ErrServerBusy) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<div class='error'>The server is busy, please try again in a moment</div>"))
+			return
+		}
+		// A server that is down or out of connections is not the user's mistake
+		if errors.Is(err, domain.ErrDatabaseUnavailable) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<div class='error'>The database is unavailable right now, please try again later</div>"))
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidCredentials) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("<div class='error'>Invalid credentials</div>"))
+			return
+		}
 		w.WriteHeader(http.StatusUnauthorized)
 		w.Write([]byte("<div class='error'>" + err.Error() + "</div>"))
 		return
@@ -51,8 +79,24 @@ func (h *LoginHandlerImplementation) HandleLogin(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Resources are discovered for the PostgreSQL role the login acts as
+	role, err := h.authUC.ResolveLoginRole(r.Context(), username, password)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("<div class='error'>" + err.Error() + "</div>"))
+		return
+	}
+
+	// Discovery runs on the selected profile's server with the login's credentials
+	discoverCtx, err := h.authUC.BindLoginConnection(r.Context(), profile, username, password)
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("<div class='error'>The database is unavailable right now, please try again later</div>"))
+		return
+	}
+
 	// Get user accessible resources
-	resources, err := h.authUC.GetUserAccessibleResources(r.Context(), username)
+	resources, err := h.authUC.GetUserAccessibleResources(discoverCtx, role)
 	if err != nil {
 		http.Error(w, "Error getting accessible resources: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -66,31 +110,42 @@ func (h *LoginHandlerImplementation) HandleLogin(w http.ResponseWriter, r *http.
 	}
 
 	// Get first accessible database, schema, table
-	database, err := h.authUC.GetFirstAccessibleDatabase(r.Context(), username)
+	database, err := h.authUC.GetFirstAccessibleDatabase(discoverCtx, role)
 	if err != nil {
 		http.Error(w, "Error getting first database: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	schema, err := h.authUC.GetFirstAccessibleSchema(r.Context(), username, database)
-	if err != nil {
+	// While discovery is still running or timed out the first schema or table may not be listed yet;
+	// the session then opens without one rather than holding up login
+	partial := resources.Loading != "" || len(resources.Incomplete) > 0
+
+	schema, err := h.authUC.GetFirstAccessibleSchema(discoverCtx, role, database)
+	if err != nil && !partial {
 		http.Error(w, "Error getting first schema: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	table, err := h.authUC.GetFirstAccessibleTable(r.Context(), username, database, schema)
-	if err != nil {
-		http.Error(w, "Error getting first table: "+err.Error(), http.StatusInternalServerError)
-		return
+	var table string
+	if schema != "" {
+		table, err = h.authUC.GetFirstAccessibleTable(discoverCtx, role, database, schema)
+		if err != nil && !partial {
+			http.Error(w, "Error getting first table: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
 	// Create session
-	session, err := h.authUC.CreateSession(r.Context(), username, password, database, schema, table)
+	session, err := h.authUC.CreateSession(r.Context(), profile, username, password, database, schema, table)
 	if err != nil {
 		http.Error(w, "Error creating session: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	// Record where the session logged in from; the login stands even if this fails
+	_ = h.authUC.TouchSession(r.Context(), session.ID, clientIP(r))
+	_, _ = h.auditUC.RecordLogin(r.Context(), session.Username, clientIP(r))
+
 	// Set session cookie
 	http.SetCookie(w, &http.Cookie{
 		Name:     "session_id",
@@ -98,7 +153,7 @@ func (h *LoginHandlerImplementation) HandleLogin(w http.ResponseWriter, r *http.
 		Path:     "/",
 		MaxAge:   3600, // 1 hour
 		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
+		Secure:   r.TLS != nil, // Served over HTTPS, the cookie never travels in plain text
 		SameSite: http.SameSiteStrictMode,
 	})
 
@@ -1,10 +1,17 @@
 package login
 
 import (
+	"html"
 	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (h *LoginHandlerImplementation) HandleLoginPage(w http.ResponseWriter, r *http.Request) {
+	// The server selector is left out when the profiles cannot be read; logins then use the default
+	profiles, _ := h.authUC.ListConnectionProfiles(r.Context())
+
 	// Render login page HTML
 	w.Header().Set("Content-Type", "text/html")
 	w.WriteHeader(http.StatusOK)
@@ -43,7 +50,8 @@ func (h *LoginHandlerImplementation) HandleLoginPage(w http.ResponseWriter, r *h
 			font-weight: bold;
 		}
 		input[type="text"],
-		input[type="password"] {
+		input[type="password"],
+		select {
 			width: 100%;
 			padding: 10px;
 			border: 1px solid #ddd;
@@ -72,7 +80,7 @@ func (h *LoginHandlerImplementation) HandleLoginPage(w http.ResponseWriter, r *h
 <body>
 	<div class="login-container">
 		<h1>Lumen PG</h1>
-		<form method="POST" action="/login">
+		<form method="POST" action="/login">` + renderProfileSelector(profiles) + `
 			<div class="form-group">
 				<label for="username">Username:</label>
 				<input type="text" id="username" name="username" required>
@@ -87,3 +95,27 @@ func (h *LoginHandlerImplementation) HandleLoginPage(w http.ResponseWriter, r *h
 </body>
 </html>`))
 }
+
+// renderProfileSelector renders the server choice of the login form, omitted when there is only one server
+func renderProfileSelector(profiles []domain.ConnectionProfile) string {
+	if len(profiles) < 2 {
+		return ""
+	}
+
+	options := ""
+	for _, profile := range profiles {
+		port := profile.Port
+		if port == 0 {
+			port = domain.DefaultConnectionProfile.Port
+		}
+		label := profile.Name + " (" + profile.Host + ":" + strconv.Itoa(port) + ")"
+		options += `
+					<option value="` + html.EscapeString(profile.Name) + `">` + html.EscapeString(label) + `</option>`
+	}
+	return `
+			<div class="form-group">
+				<label for="profile">Server:</label>
+				<select id="profile" name="profile">` + options + `
+				</select>
+			</div>`
+}
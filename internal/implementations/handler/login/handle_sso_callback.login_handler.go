@@ -0,0 +1,69 @@
+package login
+
+import (
+	"crypto/subtle"
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *LoginHandlerImplementation) HandleSSOCallback(w http.ResponseWriter, r *http.Request) {
+	// The state and nonce cookies are single use
+	for _, name := range []string{"sso_state", "sso_nonce"} {
+		http.SetCookie(w, &http.Cookie{Name: name, Value: "", Path: "/login/sso", MaxAge: -1})
+	}
+
+	query := r.URL.Query()
+	if providerErr := query.Get("error"); providerErr != "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("<div class='error'>Single sign-on was declined: " + html.EscapeString(providerErr) + "</div>"))
+		return
+	}
+
+	stateCookie, err := r.Cookie("sso_state")
+	if err != nil || query.Get("state") == "" || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(query.Get("state"))) != 1 {
+		http.Error(w, "Single sign-on state mismatch", http.StatusBadRequest)
+		return
+	}
+	nonceCookie, err := r.Cookie("sso_nonce")
+	if err != nil {
+		http.Error(w, "Single sign-on state mismatch", http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.authUC.CompleteSSOLogin(r.Context(), query.Get("code"), nonceCookie.Value)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			w.WriteHeader(status)
+			w.Write([]byte("<div class='error'>" + html.EscapeString(validationErr.Message) + "</div>"))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("<div class='error'>Single sign-on failed</div>"))
+		return
+	}
+
+	// Record where the session logged in from; the login stands even if this fails
+	_ = h.authUC.TouchSession(r.Context(), session.ID, clientIP(r))
+	_, _ = h.auditUC.RecordLogin(r.Context(), session.Username, clientIP(r))
+
+	// Set session cookie; Lax because this response ends a redirect chain started by the provider
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_id",
+		Value:    session.ID,
+		Path:     "/",
+		MaxAge:   3600, // 1 hour
+		HttpOnly: true,
+		Secure:   r.TLS != nil, // Served over HTTPS, the cookie never travels in plain text
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, "/main", http.StatusFound)
+}
@@ -0,0 +1,40 @@
+package login
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// ssoCookieMaxAge is how long, in seconds, a user has to finish signing in at the provider
+const ssoCookieMaxAge = 600
+
+func (h *LoginHandlerImplementation) HandleSSOLogin(w http.ResponseWriter, r *http.Request) {
+	request, err := h.authUC.BeginSSOLogin(r.Context())
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Error starting single sign-on: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The state and nonce travel in short-lived cookies so the callback can prove it answers this login.
+	// Lax lets them accompany the provider's top-level redirect back to us.
+	for name, value := range map[string]string{"sso_state": request.State, "sso_nonce": request.Nonce} {
+		http.SetCookie(w, &http.Cookie{
+			Name:     name,
+			Value:    value,
+			Path:     "/login/sso",
+			MaxAge:   ssoCookieMaxAge,
+			HttpOnly: true,
+			Secure:   r.TLS != nil, // Served over HTTPS, the cookie never travels in plain text
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	http.Redirect(w, r, request.URL, http.StatusFound)
+}
@@ -9,16 +9,19 @@ type LoginHandlerImplementation struct {
 	authUC  usecase.AuthenticationUseCase
 	setupUC usecase.SetupUseCase
 	rbacUC  usecase.RBACUseCase
+	auditUC usecase.AuditUseCase
 }
 
 func NewLoginHandlerImplementation(
 	authUC usecase.AuthenticationUseCase,
 	setupUC usecase.SetupUseCase,
 	rbacUC usecase.RBACUseCase,
+	auditUC usecase.AuditUseCase,
 ) handler.LoginHandler {
 	return &LoginHandlerImplementation{
 		authUC:  authUC,
 		setupUC: setupUC,
 		rbacUC:  rbacUC,
+		auditUC: auditUC,
 	}
 }
@@ -12,8 +12,14 @@ func (h *LoginHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Re
 		} else {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
+	case "/login/sso":
+		h.HandleSSOLogin(w, r)
+	case "/login/sso/callback":
+		h.HandleSSOCallback(w, r)
 	case "/logout":
 		h.HandleLogout(w, r)
+	case "/account/activity":
+		h.HandleAccountActivity(w, r)
 	default:
 		http.NotFound(w, r)
 	}
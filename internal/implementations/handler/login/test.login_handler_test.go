@@ -14,8 +14,9 @@ func TestLoginHandler(t *testing.T) {
 		authUC usecase.AuthenticationUseCase,
 		setupUC usecase.SetupUseCase,
 		rbacUC usecase.RBACUseCase,
+		auditUC usecase.AuditUseCase,
 	) handler.LoginHandler {
-		return login.NewLoginHandlerImplementation(authUC, setupUC, rbacUC)
+		return login.NewLoginHandlerImplementation(authUC, setupUC, rbacUC, auditUC)
 	}
 
 	handlerTestRunner.AuthenticationHandlerRunner(t, constructor)
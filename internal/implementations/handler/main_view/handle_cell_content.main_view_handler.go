@@ -0,0 +1,90 @@
+package main_view
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleCellContent(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters, primary key values are given as "pk.<column>" fields
+	query := r.URL.Query()
+	database := query.Get("database")
+	schema := query.Get("schema")
+	table := query.Get("table")
+	column := query.Get("column")
+
+	pkValues := make(map[string]interface{})
+	for key := range query {
+		if pkColumn, ok := strings.CutPrefix(key, "pk."); ok && pkColumn != "" {
+			pkValues[pkColumn] = query.Get(key)
+		}
+	}
+
+	if database == "" || schema == "" || table == "" || column == "" || len(pkValues) == 0 {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	content, err := h.dataViewUC.GetCellContent(r.Context(), session.Username, database, schema, table, column, pkValues)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error loading cell: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if content.IsNull {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// The cell is served as a download of its detected type, never rendered by the browser as a page
+	w.Header().Set("Content-Type", content.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(content.Size, 10))
+	w.Header().Set("Content-Disposition", `attachment; filename="`+cellContentFilename(table, column, content.ContentType)+`"`)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", `"`+content.SHA256+`"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(content.Content)
+}
+
+// cellContentFilename names a downloaded cell after its table and column, with an extension for its type
+func cellContentFilename(table, column, contentType string) string {
+	name := strings.NewReplacer(`"`, "_", `\`, "_", "/", "_").Replace(table + "_" + column)
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/plain":
+		return name + ".txt"
+	case "application/octet-stream":
+		return name + ".bin"
+	}
+	if extensions, err := mime.ExtensionsByType(mediaType); err == nil && len(extensions) > 0 {
+		return name + extensions[0]
+	}
+	return name + ".bin"
+}
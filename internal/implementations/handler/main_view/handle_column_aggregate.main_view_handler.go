@@ -0,0 +1,60 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleColumnAggregate(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+	column := r.FormValue("column")
+	whereClause := r.FormValue("where")
+
+	if database == "" || schema == "" || table == "" || column == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Compute aggregates under the current filter
+	aggregate, err := h.dataViewUC.GetColumnAggregate(r.Context(), session.Username, database, schema, table, column, whereClause)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error computing aggregate: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(aggregate)
+}
@@ -0,0 +1,62 @@
+package main_view
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+func (h *MainViewHandlerImplementation) HandleCompareTableSnapshot(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	diff, err := h.dataViewUC.CompareTableSnapshot(r.Context(), session.Username, id)
+	if err != nil {
+		writeTableSnapshotError(w, "Error comparing snapshot: ", err)
+		return
+	}
+
+	updated := make([]map[string]interface{}, len(diff.Updated))
+	for i, row := range diff.Updated {
+		updated[i] = map[string]interface{}{
+			"key":     row.Key,
+			"changed": row.Changed,
+			"before":  row.Before,
+			"after":   row.After,
+		}
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"snapshot_id":  diff.SnapshotID,
+		"database":     diff.Database,
+		"schema":       diff.Schema,
+		"table":        diff.Table,
+		"primary_keys": diff.PrimaryKeys,
+		"taken_at":     diff.TakenAt.UTC().Format(time.RFC3339),
+		"compared_at":  diff.ComparedAt.UTC().Format(time.RFC3339),
+		"inserted":     diff.Inserted,
+		"updated":      updated,
+		"deleted":      diff.Deleted,
+		"unchanged":    diff.Unchanged,
+	})
+}
@@ -0,0 +1,64 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleDataQuality(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	sampleLimit := 0
+	if sampleStr := r.FormValue("sample"); sampleStr != "" {
+		sampleLimit, _ = strconv.Atoi(sampleStr)
+	}
+
+	// Compute the report
+	report, err := h.dataViewUC.GetDataQualityReport(r.Context(), session.Username, database, schema, table, sampleLimit)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error computing data quality report: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,45 @@
+package main_view
+
+import (
+	"net/http"
+)
+
+func (h *MainViewHandlerImplementation) HandleDeleteTableSnapshot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := r.FormValue("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dataViewUC.DeleteTableSnapshot(r.Context(), session.Username, id); err != nil {
+		writeTableSnapshotError(w, "Error deleting snapshot: ", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
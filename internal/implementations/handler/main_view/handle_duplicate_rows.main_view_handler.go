@@ -0,0 +1,72 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleDuplicateRows(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters, columns are comma separated
+	params := domain.DuplicateParams{
+		Database:    r.FormValue("database"),
+		Schema:      r.FormValue("schema"),
+		Table:       r.FormValue("table"),
+		WhereClause: r.FormValue("where"),
+	}
+	for _, column := range strings.Split(r.FormValue("columns"), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			params.Columns = append(params.Columns, column)
+		}
+	}
+
+	if params.Database == "" || params.Schema == "" || params.Table == "" || len(params.Columns) == 0 {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	if limitStr := r.FormValue("limit"); limitStr != "" {
+		params.Limit, _ = strconv.Atoi(limitStr)
+	}
+
+	// Find duplicate groups
+	report, err := h.dataViewUC.FindDuplicateRows(r.Context(), session.Username, params)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error finding duplicate rows: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,112 @@
+package main_view
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// exportContentTypes maps each table export format to its response content type
+var exportContentTypes = map[string]string{
+	domain.ExportFormatJSON:   "application/json",
+	domain.ExportFormatNDJSON: "application/x-ndjson",
+	domain.ExportFormatXLSX:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+func (h *MainViewHandlerImplementation) HandleExportTable(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	format := r.FormValue("format")
+	if format == "" {
+		format = domain.ExportFormatJSON
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		http.Error(w, "Unsupported export format", http.StatusBadRequest)
+		return
+	}
+
+	out := &exportResponseWriter{
+		w:           w,
+		contentType: contentType,
+		filename:    fmt.Sprintf("%s.%s", table, format),
+	}
+	err = h.dataViewUC.ExportTableData(r.Context(), session.Username, domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: r.FormValue("where"),
+		OrderBy:     r.FormValue("orderBy"),
+		OrderDir:    r.FormValue("orderDir"),
+	}, format, out)
+	if err != nil && !out.started {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error exporting table: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Errors after the first chunk can only cut the download short
+	if !out.started {
+		out.start()
+	}
+}
+
+// exportResponseWriter sends the download headers on the first write
+type exportResponseWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportResponseWriter) start() {
+	e.w.Header().Set("Content-Type", e.contentType)
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.w.WriteHeader(http.StatusOK)
+	e.started = true
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.start()
+	}
+	return e.w.Write(p)
+}
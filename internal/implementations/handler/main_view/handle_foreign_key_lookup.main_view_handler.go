@@ -0,0 +1,72 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleForeignKeyLookup(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+	column := r.URL.Query().Get("column")
+	term := r.URL.Query().Get("q")
+
+	if database == "" || schema == "" || table == "" || column == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	lookup, err := h.dataViewUC.LookupForeignKeyValues(r.Context(), session.Username, database, schema, table, column, term)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error looking up referenced rows: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	matches := make([]map[string]interface{}, 0, len(lookup.Matches))
+	for _, match := range lookup.Matches {
+		matches = append(matches, map[string]interface{}{
+			"value": match.Value,
+			"label": match.Label,
+		})
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"column":            lookup.Column,
+		"referenced_schema": lookup.Reference.ReferencedSchema,
+		"referenced_table":  lookup.Reference.ReferencedTable,
+		"referenced_column": lookup.Reference.ReferencedColumn,
+		"display_columns":   lookup.DisplayColumns,
+		"matches":           matches,
+	})
+}
@@ -0,0 +1,64 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleFreezeView(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Pages of this session are read from the snapshot until it is released
+	status, err := h.dataViewUC.FreezeView(r.Context(), session.ID)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error freezing view: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSnapshotStatus(w, status)
+}
+
+// writeSnapshotStatus writes a frozen view's status with its age for the snapshot indicator
+func writeSnapshotStatus(w http.ResponseWriter, status *domain.SnapshotStatus) {
+	response := map[string]interface{}{
+		"active":  status.Active,
+		"expired": status.Expired,
+	}
+	if !status.TakenAt.IsZero() {
+		response["taken_at"] = status.TakenAt.UTC().Format(time.RFC3339)
+		response["max_age_seconds"] = domain.SnapshotMaxAgeSeconds
+	}
+	if status.Active {
+		response["age_seconds"] = int64(time.Since(status.TakenAt).Seconds())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,69 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleGroupBy(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	params := domain.GroupByParams{
+		Database:        r.FormValue("database"),
+		Schema:          r.FormValue("schema"),
+		Table:           r.FormValue("table"),
+		GroupColumn:     r.FormValue("column"),
+		AggregateColumn: r.FormValue("aggregate_column"),
+		AggregateFunc:   r.FormValue("aggregate"),
+		WhereClause:     r.FormValue("where"),
+	}
+
+	if params.Database == "" || params.Schema == "" || params.Table == "" || params.GroupColumn == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	if limitStr := r.FormValue("limit"); limitStr != "" {
+		params.Limit, _ = strconv.Atoi(limitStr)
+	}
+
+	// Summarize rows per group
+	result, err := h.dataViewUC.GroupTableData(r.Context(), session.Username, params)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error grouping table data: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,99 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleImportTable(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse the multipart upload
+	r.Body = http.MaxBytesReader(w, r.Body, domain.ImportMaxUploadBytes)
+	if err := r.ParseMultipartForm(domain.ImportMaxUploadBytes); err != nil {
+		http.Error(w, "Error parsing upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+
+	file, _, err := r.FormFile("file")
+	if database == "" || schema == "" || table == "" || err != nil {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	// Column mapping arrives as map.<csv column>=<table column>
+	params := domain.ImportParams{
+		Database: database,
+		Schema:   schema,
+		Table:    table,
+		Mapping:  make(map[string]string),
+	}
+	for key, values := range r.MultipartForm.Value {
+		if name, ok := strings.CutPrefix(key, "map."); ok && len(values) > 0 {
+			params.Mapping[name] = values[0]
+		}
+	}
+
+	// Validation checks every row without writing, so users fix the file before importing it
+	var response interface{}
+	switch r.FormValue("mode") {
+	case "import":
+		response, err = h.importUC.ImportCSV(r.Context(), session.Username, params, file)
+	case "validate":
+		response, err = h.importUC.ValidateCSVImport(r.Context(), session.Username, params, file)
+	default:
+		response, err = h.importUC.PreviewCSVImport(r.Context(), session.Username, params, file)
+	}
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			switch validationErr.Field {
+			case "permission":
+				status = http.StatusForbidden
+			case "maintenance":
+				status = http.StatusServiceUnavailable
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		if errors.Is(err, domain.ErrQueryCancelled) {
+			http.Error(w, "Import was cancelled", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error importing CSV: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
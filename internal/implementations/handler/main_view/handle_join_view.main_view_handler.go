@@ -0,0 +1,120 @@
+package main_view
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleJoinView(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	params := domain.JoinViewParams{
+		Database:    r.FormValue("database"),
+		LeftSchema:  r.FormValue("left_schema"),
+		LeftTable:   r.FormValue("left_table"),
+		RightSchema: r.FormValue("right_schema"),
+		RightTable:  r.FormValue("right_table"),
+		JoinType:    r.FormValue("join_type"),
+		WhereClause: r.FormValue("where"),
+		OrderBy:     r.FormValue("order_by"),
+		OrderDir:    r.FormValue("order_dir"),
+	}
+
+	if params.Database == "" || params.LeftSchema == "" || params.LeftTable == "" || params.RightSchema == "" || params.RightTable == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Manual conditions are given as "left_column=right_column"
+	for _, on := range r.Form["on"] {
+		left, right, ok := strings.Cut(on, "=")
+		if !ok || strings.TrimSpace(left) == "" || strings.TrimSpace(right) == "" {
+			http.Error(w, "Invalid join condition: "+on, http.StatusBadRequest)
+			return
+		}
+		params.Conditions = append(params.Conditions, domain.JoinCondition{
+			LeftColumn:  strings.TrimSpace(left),
+			RightColumn: strings.TrimSpace(right),
+		})
+	}
+
+	if offsetStr := r.FormValue("offset"); offsetStr != "" {
+		params.Offset, _ = strconv.Atoi(offsetStr)
+	}
+
+	result, err := h.dataViewUC.LoadJoinedTableData(r.Context(), session.Username, params)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("<div class='error'>" + html.EscapeString(validationErr.Message) + "</div>"))
+			return
+		}
+		http.Error(w, "Error loading joined data: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Render the joined rows read-only
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+
+	page := `<table class="joined-results read-only">
+		<thead>
+			<tr>`
+
+	for _, col := range result.Columns {
+		page += `<th>` + html.EscapeString(col) + `</th>`
+	}
+
+	page += `</tr>
+		</thead>
+		<tbody>`
+
+	for _, row := range result.Rows {
+		page += `<tr>`
+		for _, col := range result.Columns {
+			value := row[col]
+			var valueStr string
+			if value == nil {
+				valueStr = "NULL"
+			} else if raw, ok := value.([]byte); ok {
+				valueStr = string(raw)
+			} else {
+				valueStr = fmt.Sprintf("%v", value)
+			}
+			page += `<td>` + html.EscapeString(valueStr) + `</td>`
+		}
+		page += `</tr>`
+	}
+
+	page += `</tbody>
+	</table>`
+	page += fmt.Sprintf(`<div class="pagination-info">Showing %d of %d rows</div>`, result.RowCount, result.TotalCount)
+
+	w.Write([]byte(page))
+}
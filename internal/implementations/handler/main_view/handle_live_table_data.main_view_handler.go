@@ -0,0 +1,111 @@
+package main_view
+
+import (
+	"errors"
+	"html"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleLiveTableData(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	params := domain.TableDataParams{
+		Database:    query.Get("database"),
+		Schema:      query.Get("schema"),
+		Table:       query.Get("table"),
+		WhereClause: query.Get("where"),
+		OrderBy:     query.Get("order_by"),
+		OrderDir:    query.Get("order_dir"),
+		Limit:       50,
+	}
+	if params.Database == "" || params.Schema == "" || params.Table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		params.Limit = limit
+	}
+
+	interval, err := domain.ParseLiveInterval(query.Get("interval"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// The stream opens with the first result, so a refused table still gets a plain error response
+	streaming := false
+	err = h.dataViewUC.WatchTableData(r.Context(), session.Username, params, interval, func(update *domain.LiveResultUpdate) error {
+		if !streaming {
+			streaming = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+		}
+		event, payload := update.Event()
+		if update.Error == "" && len(update.Result.SpatialColumns) > 0 {
+			payload["spatial"] = spatialPayload(update.Result)
+		}
+		if err := domain.WriteServerSentEvent(w, update.Sequence, event, payload); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil && !streaming {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+			return
+		}
+		http.Error(w, "Error loading table data: "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// spatialPayload lists each row's geometry and geography values as GeoJSON and WKT, the rows keeping
+// the raw values
+func spatialPayload(result *domain.QueryResult) []map[string]interface{} {
+	rows := make([]map[string]interface{}, len(result.Spatial))
+	for i, values := range result.Spatial {
+		row := make(map[string]interface{}, len(values))
+		for column, value := range values {
+			row[column] = map[string]interface{}{
+				"geojson": spatialGeoJSON(value.GeoJSON),
+				"wkt":     value.WKT,
+			}
+		}
+		rows[i] = row
+	}
+	return rows
+}
@@ -1,7 +1,13 @@
 package main_view
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"html/template"
 	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
@@ -31,7 +37,7 @@ func (h *MainViewHandlerImplementation) HandleMainViewPage(w http.ResponseWriter
 	// Load first accessible table by default
 	if len(resources.AccessibleTables) == 0 {
 		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("<div>No accessible tables</div>"))
+		w.Write([]byte(metadataLoadingNotice(resources) + "<div>No accessible tables</div>"))
 		return
 	}
 
@@ -40,11 +46,12 @@ func (h *MainViewHandlerImplementation) HandleMainViewPage(w http.ResponseWriter
 
 	// Load table data
 	tableData, err := h.dataViewUC.LoadTableData(r.Context(), session.Username, domain.TableDataParams{
-		Database: firstTable.Database,
-		Schema:   firstTable.Schema,
-		Table:    firstTable.Name,
-		Offset:   0,
-		Limit:    50,
+		Database:    firstTable.Database,
+		Schema:      firstTable.Schema,
+		Table:       firstTable.Name,
+		Offset:      0,
+		Limit:       50,
+		SnapshotKey: session.ID,
 	})
 	if err != nil {
 		http.Error(w, "Error loading table data: "+err.Error(), http.StatusInternalServerError)
@@ -71,31 +78,115 @@ func (h *MainViewHandlerImplementation) HandleMainViewPage(w http.ResponseWriter
 		.schema-item { margin-left: 10px; margin-bottom: 5px; }
 		.table-item { margin-left: 20px; margin-bottom: 3px; cursor: pointer; }
 		.table-item:hover { background: #e9ecef; }
+		.table-size { color: #6c757d; font-size: 0.8em; }
+		#object-search { width: 100%; box-sizing: border-box; }
+		#object-search-results { list-style: none; padding: 0; margin: 4px 0 10px; }
+		#object-search-results li { padding: 2px 4px; cursor: pointer; }
+		#object-search-results li:hover { background: #e9ecef; }
+		.object-kind { color: #6c757d; font-size: 0.8em; margin-right: 4px; }
+		.tabs { margin-bottom: 10px; }
+		.tabs button.active { font-weight: bold; }
+		.stats-summary td:first-child { font-weight: bold; width: 240px; }
+		.metadata-loading { background: #e7f1ff; border: 1px solid #b6d4fe; padding: 6px; margin-bottom: 10px; font-size: 0.9em; }
+		.idle-warning { background: #fff3cd; border: 1px solid #ffe69c; padding: 8px; margin-bottom: 10px; }
+		#table-grid td.search-match { background: #fff3cd; }
 	</style>
 </head>
 <body>
 	<div class="container">
 		<div class="sidebar">
-			<h3>Databases</h3>
+			<input type="search" id="object-search" placeholder="Jump to table, view, column or function" autocomplete="off">
+			<ul id="object-search-results"></ul>
+			<h3>Databases</h3>` + metadataLoadingNotice(resources) + `
 			<div class="database-list">`
 
-	// Render accessible databases
+	// Render accessible databases with their tables; row estimates and sizes are fetched after the page loads
 	for _, db := range resources.AccessibleDatabases {
-		html += `<div class="database-item"><strong>` + db + `</strong></div>`
+		html += `<div class="database-item"><strong>` + db + `</strong>`
+		for _, table := range resources.AccessibleTables {
+			if table.Database != db {
+				continue
+			}
+			html += `<div class="table-item" data-database="` + template.HTMLEscapeString(table.Database) +
+				`" data-schema="` + template.HTMLEscapeString(table.Schema) + `" data-table="` + template.HTMLEscapeString(table.Name) + `">` +
+				template.HTMLEscapeString(table.Schema+"."+table.Name) + ` <span class="table-size"></span></div>`
+		}
+		html += `</div>`
 	}
 
 	html += `
 			</div>
+			<details>
+				<summary>New database</summary>
+				<form class="create-object" data-path="/api/databases/create">
+					<input type="text" name="name" placeholder="Name" required>
+					<input type="text" name="owner" placeholder="Owner (optional)">
+					<input type="text" name="encoding" placeholder="Encoding, e.g. UTF8 (optional)">
+					<input type="text" name="template" placeholder="Template (optional)">
+					<button type="submit">Preview</button>
+				</form>
+			</details>
+			<details>
+				<summary>New schema</summary>
+				<form class="create-object" data-path="/api/schemas/create">
+					<input type="text" name="database" value="` + template.HTMLEscapeString(firstTable.Database) + `" placeholder="Database" required>
+					<input type="text" name="name" placeholder="Name" required>
+					<input type="text" name="owner" placeholder="Owner (optional)">
+					<button type="submit">Preview</button>
+				</form>
+			</details>
+			<div id="create-object-preview" hidden>
+				<pre id="create-object-statement"></pre>
+				<button type="button" id="create-object-run">Run</button>
+				<button type="button" id="create-object-cancel">Cancel</button>
+			</div>
+			<p id="create-object-status"></p>
+			<h3>Functions</h3>
+			<p id="functions-status"></p>
+			<div id="function-list"></div>
 		</div>
 		<div class="main-content">
+			<div id="transaction-idle-warning" class="idle-warning" hidden>
+				<span id="transaction-idle-message"></span>
+				<button type="button" id="transaction-keep-open">Keep working</button>
+			</div>
 			<h2>Table: ` + firstTable.Name + `</h2>
-			<table>
+			<div id="object-jump-view"></div>
+			<div class="tabs">
+				<button type="button" class="active" data-tab="data-tab">Data</button>
+				<button type="button" data-tab="stats-tab">Stats</button>
+				<button type="button" data-tab="indexes-tab">Indexes</button>
+				<button type="button" data-tab="structure-tab">Structure</button>
+				<button type="button" data-tab="triggers-tab">Triggers</button>
+				<button type="button" data-tab="sql-tab">SQL</button>
+			</div>
+			<div id="data-tab">
+			<div class="auto-refresh" data-database="` + template.HTMLEscapeString(firstTable.Database) + `" data-schema="` + template.HTMLEscapeString(firstTable.Schema) + `" data-table="` + template.HTMLEscapeString(firstTable.Name) + `">
+				<label>Auto-refresh
+					<select id="auto-refresh">
+						<option value="0">Off</option>
+						<option value="` + itoa(domain.LiveRefreshMinInterval) + `">Every ` + itoa(domain.LiveRefreshMinInterval) + `s</option>
+						<option value="15">Every 15s</option>
+						<option value="30">Every 30s</option>
+						<option value="60">Every minute</option>
+					</select>
+				</label>
+				<span id="live-status"></span>
+				<button type="button" id="watch-rows">Watch rows</button>
+				<ul id="watch-log"></ul>
+			</div>
+			<form id="table-search">
+				<input type="search" name="q" placeholder="Search everything" maxlength="` + itoa(domain.TableSearchMaxTermLength) + `">
+				<button type="submit">Search</button>
+				<span id="search-status"></span>
+			</form>
+			<table id="table-grid">
 				<thead>
 					<tr>`
 
 	// Render column headers
 	for _, col := range tableData.Columns {
-		html += `<th>` + col + `</th>`
+		html += `<th data-column="` + template.HTMLEscapeString(col) + `">` + col + `</th>`
 	}
 
 	html += `
@@ -104,13 +195,15 @@ func (h *MainViewHandlerImplementation) HandleMainViewPage(w http.ResponseWriter
 				<tbody>`
 
 	// Render rows
-	for _, row := range tableData.Rows {
+	for i, row := range tableData.Rows {
 		html += `<tr>`
 		for _, col := range tableData.Columns {
 			value := row[col]
 			var valueStr string
 			if value == nil {
 				valueStr = "NULL"
+			} else if spatial, ok := formatSpatialValue(tableData, i, col); ok {
+				valueStr = spatial
 			} else {
 				valueStr = formatValue(value)
 			}
@@ -122,18 +215,1203 @@ func (h *MainViewHandlerImplementation) HandleMainViewPage(w http.ResponseWriter
 	html += `
 				</tbody>
 			</table>
+			</div>
+			<div id="stats-tab" hidden>
+				<p id="stats-status"></p>
+				<table class="stats-summary"><tbody id="stats-summary"></tbody></table>
+				<h3>Indexes</h3>
+				<table>
+					<thead>
+						<tr><th>Index</th><th>Scans</th><th>Tuples read</th><th>Tuples fetched</th><th>Size</th><th></th></tr>
+					</thead>
+					<tbody id="stats-indexes"></tbody>
+				</table>
+			</div>
+			<div id="indexes-tab" hidden>
+				<p id="indexes-status"></p>
+				<table>
+					<thead>
+						<tr><th>Index</th><th>Definition</th><th>Size</th><th>Scans</th><th></th></tr>
+					</thead>
+					<tbody id="index-list"></tbody>
+				</table>
+				<form id="create-index" hidden>
+					<h3>Create index</h3>
+					<label>Columns, in order <input type="text" name="columns" placeholder="column, column"></label>
+					<label>Name <input type="text" name="name" placeholder="generated when empty"></label>
+					<label>Method
+						<select name="method">` + indexMethodOptions() + `</select>
+					</label>
+					<label><input type="checkbox" name="unique"> Unique</label>
+					<label><input type="checkbox" name="concurrently"> Concurrently</label>
+					<button type="submit">Preview</button>
+				</form>
+				<div id="index-preview" hidden>
+					<pre id="index-statement"></pre>
+					<button type="button" id="index-run">Run</button>
+					<button type="button" id="index-cancel">Cancel</button>
+				</div>
+			</div>
+			<div id="structure-tab" hidden>
+				<h3>Comments</h3>
+				<p id="comments-status"></p>
+				<table>
+					<thead>
+						<tr><th>Object</th><th>Type</th><th>Comment</th><th></th></tr>
+					</thead>
+					<tbody id="comment-list"></tbody>
+				</table>
+				<div id="comment-preview" hidden>
+					<pre id="comment-statement"></pre>
+					<button type="button" id="comment-run">Run</button>
+					<button type="button" id="comment-cancel">Cancel</button>
+				</div>
+				<p id="constraints-status"></p>
+				<table>
+					<thead>
+						<tr><th>Constraint</th><th>Type</th><th>Definition</th><th></th></tr>
+					</thead>
+					<tbody id="constraint-list"></tbody>
+				</table>
+				<form id="add-constraint" hidden>
+					<h3>Add constraint</h3>
+					<label>Type
+						<select name="type">
+							<option value="` + domain.ConstraintTypePrimaryKey + `">Primary key</option>
+							<option value="` + domain.ConstraintTypeUnique + `">Unique</option>
+							<option value="` + domain.ConstraintTypeCheck + `">Check</option>
+							<option value="` + domain.ConstraintTypeForeignKey + `">Foreign key</option>
+						</select>
+					</label>
+					<label>Name <input type="text" name="name" placeholder="generated when empty"></label>
+					<label>Columns, in order <input type="text" name="columns" placeholder="column, column"></label>
+					<label>Check expression <input type="text" name="expression" placeholder="price >= 0"></label>
+					<fieldset>
+						<legend>Foreign key</legend>
+						<label>References schema <input type="text" name="referenced_schema" placeholder="same schema when empty"></label>
+						<label>table <input type="text" name="referenced_table"></label>
+						<label>columns <input type="text" name="referenced_columns" placeholder="primary key when empty"></label>
+						<label>On delete <select name="on_delete">` + foreignKeyActionOptions() + `</select></label>
+						<label>On update <select name="on_update">` + foreignKeyActionOptions() + `</select></label>
+					</fieldset>
+					<button type="submit">Preview</button>
+				</form>
+				<div id="constraint-preview" hidden>
+					<pre id="constraint-statement"></pre>
+					<button type="button" id="constraint-run">Run</button>
+					<button type="button" id="constraint-cancel">Cancel</button>
+				</div>
+				<form id="table-designer" hidden>
+					<h3>Design columns</h3>
+					<label>Change
+						<select name="operation">
+							<option value="` + domain.ColumnOperationAdd + `">Add column</option>
+							<option value="` + domain.ColumnOperationRename + `">Rename column</option>
+							<option value="` + domain.ColumnOperationDrop + `">Drop column</option>
+							<option value="` + domain.ColumnOperationSetType + `">Change type</option>
+							<option value="` + domain.ColumnOperationSetDefault + `">Set default</option>
+							<option value="` + domain.ColumnOperationDropDefault + `">Drop default</option>
+							<option value="` + domain.ColumnOperationSetNotNull + `">Make NOT NULL</option>
+							<option value="` + domain.ColumnOperationDropNotNull + `">Allow NULL</option>
+							<option value="` + domain.ColumnOperationSetPrimaryKey + `">Set primary key</option>
+							<option value="` + domain.ColumnOperationDropPrimaryKey + `">Drop primary key</option>
+						</select>
+					</label>
+					<label>Column <input type="text" name="column"></label>
+					<label>New name <input type="text" name="new_name"></label>
+					<label>Type <input type="text" name="data_type" placeholder="integer, text, numeric(12,2)"></label>
+					<label>Using <input type="text" name="using" placeholder="amount::numeric"></label>
+					<label>Default <input type="text" name="default" placeholder="now()"></label>
+					<label><input type="checkbox" name="not_null"> NOT NULL</label>
+					<label>Primary key columns, in order <input type="text" name="columns" placeholder="column, column"></label>
+					<button type="button" id="designer-add">Add change</button>
+					<ol id="designer-changes"></ol>
+					<button type="submit">Preview</button>
+				</form>
+				<div id="designer-preview" hidden>
+					<pre id="designer-statement"></pre>
+					<button type="button" id="designer-run">Run</button>
+					<button type="button" id="designer-cancel">Cancel</button>
+				</div>
+			</div>
+			<div id="triggers-tab" hidden>
+				<p id="triggers-status"></p>
+				<table>
+					<thead>
+						<tr><th>Trigger</th><th>Timing</th><th>Events</th><th>Function</th><th>State</th><th></th></tr>
+					</thead>
+					<tbody id="trigger-list"></tbody>
+				</table>
+				<div id="trigger-preview" hidden>
+					<pre id="trigger-statement"></pre>
+					<button type="button" id="trigger-run">Run</button>
+					<button type="button" id="trigger-cancel">Cancel</button>
+				</div>
+			</div>
+			<div id="sql-tab" hidden>
+				<p id="ddl-status"></p>
+				<button type="button" id="ddl-copy">Copy</button>
+				<a id="ddl-download" href="#">Download .sql</a>
+				<pre id="ddl-statement"></pre>
+			</div>
+			<div id="function-panel" hidden>
+				<h3 id="function-title"></h3>
+				<p id="function-meta"></p>
+				<pre id="function-source"></pre>
+				<form id="execute-function" hidden>
+					<div id="function-arguments"></div>
+					<button type="submit">Execute</button>
+				</form>
+				<p id="function-status"></p>
+				<pre id="function-statement"></pre>
+				<table>
+					<thead><tr id="function-result-head"></tr></thead>
+					<tbody id="function-result-rows"></tbody>
+				</table>
+			</div>
 		</div>
 	</div>
+	<script>
+		let liveSource = null;
+		const refreshPanel = document.querySelector('.auto-refresh');
+		const refreshSelect = document.getElementById('auto-refresh');
+		const liveStatus = document.getElementById('live-status');
+
+		function stopLiveRefresh(message) {
+			if (liveSource) {
+				liveSource.close();
+				liveSource = null;
+			}
+			refreshSelect.value = '0';
+			liveStatus.textContent = message;
+		}
+
+		function renderLiveRows(update) {
+			const body = document.querySelector('#table-grid tbody');
+			const rows = (update.rows || []).map(row => {
+				const tr = document.createElement('tr');
+				update.columns.forEach(column => {
+					const td = document.createElement('td');
+					td.textContent = row[column] === null ? 'NULL' : String(row[column]);
+					tr.appendChild(td);
+				});
+				return tr;
+			});
+			body.replaceChildren(...rows);
+		}
+
+		// Searching shows the matching rows in the grid, marking the cells the term was found in
+		const searchForm = document.getElementById('table-search');
+		const searchStatus = document.getElementById('search-status');
+
+		searchForm.addEventListener('submit', event => {
+			event.preventDefault();
+			const term = searchForm.elements.q.value.trim();
+			if (!term) {
+				searchStatus.textContent = '';
+				return;
+			}
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+				q: term,
+			});
+			searchStatus.textContent = 'Searching...';
+			fetch('/api/table/search?' + params)
+				.then(response => response.ok ? response.json() : response.text().then(text => Promise.reject(new Error(text.trim()))))
+				.then(found => {
+					renderLiveRows(found);
+					document.querySelectorAll('#table-grid tbody tr').forEach((tr, i) => {
+						found.matched_columns[i].forEach(column => {
+							tr.cells[found.columns.indexOf(column)].classList.add('search-match');
+						});
+					});
+					searchStatus.textContent = (found.truncated
+						? 'First ' + found.rows.length + ' of ' + found.total_matches + ' matching rows'
+						: found.total_matches + ' matching rows') + ' in ' + found.searched_columns.join(', ');
+				})
+				.catch(err => { searchStatus.textContent = 'Search failed: ' + err.message; });
+		});
+
+		refreshSelect.addEventListener('change', () => {
+			const seconds = Number(refreshSelect.value);
+			if (liveSource) {
+				liveSource.close();
+				liveSource = null;
+			}
+			if (!seconds) {
+				liveStatus.textContent = '';
+				return;
+			}
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+				interval: seconds,
+			});
+			liveSource = new EventSource('/api/table/live?' + params);
+			liveSource.addEventListener('result', event => {
+				const update = JSON.parse(event.data);
+				renderLiveRows(update);
+				liveStatus.textContent = 'Refreshed ' + update.refreshed_at + ', every ' + update.interval_seconds + 's';
+			});
+			liveSource.addEventListener('refresh-error', event => {
+				liveStatus.textContent = 'Refresh failed, retrying: ' + JSON.parse(event.data).error;
+			});
+			// A dropped push channel pauses auto-refresh instead of letting the browser reconnect on its own
+			liveSource.onerror = () => stopLiveRefresh('Auto-refresh paused: connection lost');
+		});
+
+		let watchSource = null;
+		const watchButton = document.getElementById('watch-rows');
+		const watchLog = document.getElementById('watch-log');
+
+		function logWatch(message) {
+			const item = document.createElement('li');
+			item.textContent = new Date().toLocaleTimeString() + ' ' + message;
+			watchLog.prepend(item);
+		}
+
+		function stopWatch(message) {
+			if (watchSource) {
+				watchSource.close();
+				watchSource = null;
+			}
+			watchButton.textContent = 'Watch rows';
+			if (message) {
+				logWatch(message);
+			}
+		}
+
+		// Watching installs a change trigger for as long as the stream is open; closing it removes the trigger
+		watchButton.addEventListener('click', () => {
+			if (watchSource) {
+				stopWatch('Stopped watching');
+				return;
+			}
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			watchSource = new EventSource('/api/table/watch?' + params);
+			watchButton.textContent = 'Stop watching';
+			watchSource.addEventListener('watching', event => {
+				const update = JSON.parse(event.data);
+				logWatch('Watching ' + update.matching_rows + ' rows (' + update.mode + ')' + (update.notice ? ': ' + update.notice : ''));
+			});
+			watchSource.addEventListener('changes', event => {
+				JSON.parse(event.data).changes.forEach(change => {
+					logWatch(change.operation + ' ' + JSON.stringify(change.key) + (change.after ? ' ' + JSON.stringify(change.after) : ''));
+				});
+			});
+			watchSource.addEventListener('watch-error', event => {
+				logWatch('Check failed, retrying: ' + JSON.parse(event.data).error);
+			});
+			watchSource.onerror = () => stopWatch('Watch stopped: connection lost');
+		});
+
+		const tabButtons = document.querySelectorAll('.tabs button');
+		const statsStatus = document.getElementById('stats-status');
+
+		function formatBytes(bytes) {
+			const units = ['B', 'kB', 'MB', 'GB', 'TB'];
+			let value = bytes;
+			let unit = 0;
+			while (value >= 1024 && unit < units.length - 1) {
+				value /= 1024;
+				unit++;
+			}
+			return (unit ? value.toFixed(1) : value) + ' ' + units[unit];
+		}
+
+		function formatPercent(percent) {
+			return percent === null ? 'n/a' : percent.toFixed(1) + '%';
+		}
+
+		function statsRow(label, value) {
+			const tr = document.createElement('tr');
+			[label, value].forEach(text => {
+				const td = document.createElement('td');
+				td.textContent = text;
+				tr.appendChild(td);
+			});
+			return tr;
+		}
+
+		function renderStats(stats) {
+			const lastRun = (manual, automatic) => [manual, automatic].filter(Boolean).sort().pop() || 'never';
+			document.getElementById('stats-summary').replaceChildren(
+				statsRow('Live tuples', stats.live_tuples),
+				statsRow('Dead tuples', stats.dead_tuples + ' (' + formatPercent(stats.dead_tuple_percent) + ')'),
+				statsRow('Modified since analyze', stats.modifications_since_analyze),
+				statsRow('Last vacuum', lastRun(stats.last_vacuum, stats.last_autovacuum) + ' (' + stats.vacuum_count + ' manual, ' + stats.autovacuum_count + ' auto)'),
+				statsRow('Last analyze', lastRun(stats.last_analyze, stats.last_autoanalyze) + ' (' + stats.analyze_count + ' manual, ' + stats.autoanalyze_count + ' auto)'),
+				statsRow('Sequential scans', stats.seq_scans + ' reading ' + stats.seq_tuples_read + ' tuples'),
+				statsRow('Index scans', stats.index_scans + ' (' + formatPercent(stats.index_scan_percent) + ' of scans)'),
+				statsRow('Size', formatBytes(stats.table_size_bytes) + ' table, ' + formatBytes(stats.indexes_size_bytes) + ' indexes'),
+				statsRow('Estimated bloat', stats.estimated_bloat_bytes === null
+					? 'unknown until the table is analyzed'
+					: formatBytes(stats.estimated_bloat_bytes) + ' (' + formatPercent(stats.estimated_bloat_percent) + ')'),
+			);
+			const unused = new Set(stats.unused_indexes || []);
+			document.getElementById('stats-indexes').replaceChildren(...stats.indexes.map(index => {
+				const tr = document.createElement('tr');
+				[index.name, index.scans, index.tuples_read, index.tuples_fetched, formatBytes(index.size_bytes),
+					unused.has(index.name) ? 'unused' : ''].forEach(text => {
+					const td = document.createElement('td');
+					td.textContent = text;
+					tr.appendChild(td);
+				});
+				return tr;
+			}));
+		}
+
+		function loadStats() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			statsStatus.textContent = 'Loading statistics...';
+			fetch('/api/table/stats?' + params)
+				.then(response => response.ok ? response.json() : response.text().then(text => Promise.reject(new Error(text))))
+				.then(stats => {
+					renderStats(stats);
+					statsStatus.textContent = '';
+				})
+				.catch(err => { statsStatus.textContent = 'Could not load statistics: ' + err.message; });
+		}
+
+		// The Stats tab reads the statistics views each time it is opened, so they reflect the latest activity
+		tabButtons.forEach(button => button.addEventListener('click', () => {
+			tabButtons.forEach(other => {
+				other.classList.toggle('active', other === button);
+				document.getElementById(other.dataset.tab).hidden = other !== button;
+			});
+			if (button.dataset.tab === 'stats-tab') {
+				loadStats();
+			}
+			if (button.dataset.tab === 'indexes-tab') {
+				loadIndexes();
+			}
+			if (button.dataset.tab === 'structure-tab') {
+				loadComments();
+				loadConstraints();
+			}
+			if (button.dataset.tab === 'triggers-tab') {
+				loadTriggers();
+			}
+			if (button.dataset.tab === 'sql-tab') {
+				loadDDL();
+			}
+		}));
+
+		const indexesStatus = document.getElementById('indexes-status');
+		const createIndexForm = document.getElementById('create-index');
+		const indexPreview = document.getElementById('index-preview');
+		let pendingIndexChange = null;
+
+		function readResponse(response) {
+			return response.ok ? response.json() : response.text().then(text => Promise.reject(new Error(text)));
+		}
+
+		function indexRequest(path, fields) {
+			const body = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			fields.forEach((value, name) => body.append(name, value));
+			return fetch(path, { method: 'POST', body: body }).then(readResponse);
+		}
+
+		function loadIndexes() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			indexesStatus.textContent = 'Loading indexes...';
+			fetch('/api/table/indexes?' + params)
+				.then(readResponse)
+				.then(list => {
+					createIndexForm.hidden = !list.can_manage;
+					document.getElementById('index-list').replaceChildren(...list.indexes.map(index => {
+						const tr = document.createElement('tr');
+						[index.name + (index.valid ? '' : ' (invalid)'), index.definition, formatBytes(index.size_bytes), index.scans].forEach(text => {
+							const td = document.createElement('td');
+							td.textContent = text;
+							tr.appendChild(td);
+						});
+						const action = document.createElement('td');
+						if (index.constraint) {
+							action.textContent = 'backs constraint ' + index.constraint;
+						} else if (list.can_manage) {
+							const drop = document.createElement('button');
+							drop.type = 'button';
+							drop.textContent = 'Drop';
+							drop.addEventListener('click', () => previewIndexChange('/api/table/indexes/drop', new URLSearchParams({ name: index.name })));
+							action.appendChild(drop);
+						}
+						tr.appendChild(action);
+						return tr;
+					}));
+					indexesStatus.textContent = list.can_manage ? '' : 'Only the table owner can create or drop indexes.';
+				})
+				.catch(err => { indexesStatus.textContent = 'Could not load indexes: ' + err.message; });
+		}
+
+		// Index changes are previewed first; Run sends the previewed statement back as the confirmation
+		function previewIndexChange(path, fields) {
+			indexRequest(path, fields)
+				.then(change => {
+					pendingIndexChange = { path: path, fields: fields, statement: change.statement };
+					document.getElementById('index-statement').textContent = change.statement;
+					indexPreview.hidden = false;
+				})
+				.catch(err => { indexesStatus.textContent = err.message; });
+		}
+
+		createIndexForm.addEventListener('submit', event => {
+			event.preventDefault();
+			const fields = new URLSearchParams();
+			createIndexForm.elements.columns.value.split(',').map(column => column.trim()).filter(Boolean)
+				.forEach(column => fields.append('column', column));
+			fields.set('name', createIndexForm.elements.name.value);
+			fields.set('method', createIndexForm.elements.method.value);
+			fields.set('unique', String(createIndexForm.elements.unique.checked));
+			fields.set('concurrently', String(createIndexForm.elements.concurrently.checked));
+			previewIndexChange('/api/table/indexes/create', fields);
+		});
+
+		document.getElementById('index-run').addEventListener('click', () => {
+			if (!pendingIndexChange) {
+				return;
+			}
+			const fields = new URLSearchParams(pendingIndexChange.fields);
+			fields.set('confirm', pendingIndexChange.statement);
+			indexesStatus.textContent = 'Running ' + pendingIndexChange.statement + '...';
+			indexRequest(pendingIndexChange.path, fields)
+				.then(() => {
+					pendingIndexChange = null;
+					indexPreview.hidden = true;
+					loadIndexes();
+				})
+				.catch(err => { indexesStatus.textContent = err.message; });
+		});
+
+		document.getElementById('index-cancel').addEventListener('click', () => {
+			pendingIndexChange = null;
+			indexPreview.hidden = true;
+		});
+
+		const constraintsStatus = document.getElementById('constraints-status');
+		const addConstraintForm = document.getElementById('add-constraint');
+		const constraintPreview = document.getElementById('constraint-preview');
+		const constraintTypeLabels = {
+			primary_key: 'primary key',
+			unique: 'unique',
+			check: 'check',
+			foreign_key: 'foreign key',
+		};
+		let pendingConstraintChange = null;
+
+		function loadConstraints() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			constraintsStatus.textContent = 'Loading constraints...';
+			fetch('/api/table/constraints?' + params)
+				.then(readResponse)
+				.then(list => {
+					addConstraintForm.hidden = !list.can_manage;
+					tableDesigner.hidden = !list.can_manage;
+					document.getElementById('constraint-list').replaceChildren(...list.constraints.map(constraint => {
+						const tr = document.createElement('tr');
+						[constraint.name + (constraint.validated ? '' : ' (not validated)'), constraintTypeLabels[constraint.type], constraint.definition].forEach(text => {
+							const td = document.createElement('td');
+							td.textContent = text;
+							tr.appendChild(td);
+						});
+						const action = document.createElement('td');
+						if (list.can_manage) {
+							const drop = document.createElement('button');
+							drop.type = 'button';
+							drop.textContent = 'Drop';
+							drop.addEventListener('click', () => previewConstraintChange('/api/table/constraints/drop', new URLSearchParams({ name: constraint.name })));
+							action.appendChild(drop);
+						}
+						tr.appendChild(action);
+						return tr;
+					}));
+					constraintsStatus.textContent = list.can_manage ? '' : 'Only the table owner can add or drop constraints.';
+				})
+				.catch(err => { constraintsStatus.textContent = 'Could not load constraints: ' + err.message; });
+		}
+
+		// Constraint changes are previewed the same way as index changes
+		function previewConstraintChange(path, fields) {
+			indexRequest(path, fields)
+				.then(change => {
+					pendingConstraintChange = { path: path, fields: fields, statement: change.statement };
+					document.getElementById('constraint-statement').textContent = change.statement;
+					constraintPreview.hidden = false;
+				})
+				.catch(err => { constraintsStatus.textContent = err.message; });
+		}
+
+		function appendList(fields, name, value) {
+			value.split(',').map(item => item.trim()).filter(Boolean).forEach(item => fields.append(name, item));
+		}
+
+		addConstraintForm.addEventListener('submit', event => {
+			event.preventDefault();
+			const elements = addConstraintForm.elements;
+			const fields = new URLSearchParams({ type: elements.type.value, name: elements.name.value });
+			if (elements.type.value === 'check') {
+				fields.set('expression', elements.expression.value);
+			} else {
+				appendList(fields, 'column', elements.columns.value);
+			}
+			if (elements.type.value === 'foreign_key') {
+				fields.set('referenced_schema', elements.referenced_schema.value);
+				fields.set('referenced_table', elements.referenced_table.value);
+				appendList(fields, 'referenced_column', elements.referenced_columns.value);
+				fields.set('on_delete', elements.on_delete.value);
+				fields.set('on_update', elements.on_update.value);
+			}
+			previewConstraintChange('/api/table/constraints/add', fields);
+		});
+
+		document.getElementById('constraint-run').addEventListener('click', () => {
+			if (!pendingConstraintChange) {
+				return;
+			}
+			const fields = new URLSearchParams(pendingConstraintChange.fields);
+			fields.set('confirm', pendingConstraintChange.statement);
+			constraintsStatus.textContent = 'Running ' + pendingConstraintChange.statement + '...';
+			indexRequest(pendingConstraintChange.path, fields)
+				.then(() => {
+					pendingConstraintChange = null;
+					constraintPreview.hidden = true;
+					loadConstraints();
+				})
+				.catch(err => { constraintsStatus.textContent = err.message; });
+		});
+
+		document.getElementById('constraint-cancel').addEventListener('click', () => {
+			pendingConstraintChange = null;
+			constraintPreview.hidden = true;
+		});
+
+		// The table designer collects column changes, previews the ALTER TABLE statements they generate
+		// and runs them together once confirmed
+		const tableDesigner = document.getElementById('table-designer');
+		const designerPreview = document.getElementById('designer-preview');
+		let designerChanges = [];
+		let pendingDesignerStatement = null;
+
+		function renderDesignerChanges() {
+			document.getElementById('designer-changes').replaceChildren(...designerChanges.map((change, i) => {
+				const li = document.createElement('li');
+				li.textContent = change.operation.replace(/_/g, ' ') + ' ' + (change.column || change.columns.join(', ')) + ' ';
+				const remove = document.createElement('button');
+				remove.type = 'button';
+				remove.textContent = 'Remove';
+				remove.addEventListener('click', () => {
+					designerChanges.splice(i, 1);
+					renderDesignerChanges();
+				});
+				li.appendChild(remove);
+				return li;
+			}));
+		}
+
+		function designerRequest(path, confirm) {
+			return fetch(path, {
+				method: 'POST',
+				headers: { 'Content-Type': 'application/json' },
+				body: JSON.stringify({
+					database: refreshPanel.dataset.database,
+					schema: refreshPanel.dataset.schema,
+					table: refreshPanel.dataset.table,
+					changes: designerChanges,
+					confirm: confirm,
+				}),
+			}).then(readResponse);
+		}
+
+		document.getElementById('designer-add').addEventListener('click', () => {
+			const elements = tableDesigner.elements;
+			designerChanges.push({
+				operation: elements.operation.value,
+				column: elements.column.value.trim(),
+				new_name: elements.new_name.value.trim(),
+				data_type: elements.data_type.value.trim(),
+				using: elements.using.value.trim(),
+				default: elements.default.value.trim(),
+				not_null: elements.not_null.checked,
+				columns: elements.columns.value.split(',').map(item => item.trim()).filter(Boolean),
+			});
+			renderDesignerChanges();
+		});
+
+		tableDesigner.addEventListener('submit', event => {
+			event.preventDefault();
+			designerRequest('/api/table/alter', '')
+				.then(change => {
+					pendingDesignerStatement = change.statement;
+					document.getElementById('designer-statement').textContent = change.statement;
+					designerPreview.hidden = false;
+				})
+				.catch(err => { constraintsStatus.textContent = err.message; });
+		});
+
+		document.getElementById('designer-run').addEventListener('click', () => {
+			if (!pendingDesignerStatement) {
+				return;
+			}
+			constraintsStatus.textContent = 'Running ' + pendingDesignerStatement + '...';
+			designerRequest('/api/table/alter', pendingDesignerStatement)
+				.then(() => {
+					pendingDesignerStatement = null;
+					designerChanges = [];
+					renderDesignerChanges();
+					designerPreview.hidden = true;
+					loadConstraints();
+				})
+				.catch(err => { constraintsStatus.textContent = err.message; });
+		});
+
+		document.getElementById('designer-cancel').addEventListener('click', () => {
+			pendingDesignerStatement = null;
+			designerPreview.hidden = true;
+		});
+
+		const createObjectStatus = document.getElementById('create-object-status');
+		const createObjectPreview = document.getElementById('create-object-preview');
+		let pendingCreateObject = null;
+
+		function createObjectRequest(form, confirm) {
+			const body = new URLSearchParams(new FormData(form));
+			body.set('confirm', confirm);
+			return fetch(form.dataset.path, { method: 'POST', body: body }).then(readResponse);
+		}
+
+		document.querySelectorAll('form.create-object').forEach(form => {
+			form.addEventListener('submit', event => {
+				event.preventDefault();
+				createObjectRequest(form, '')
+					.then(change => {
+						pendingCreateObject = { form: form, statement: change.statement };
+						document.getElementById('create-object-statement').textContent = change.statement;
+						createObjectPreview.hidden = false;
+						createObjectStatus.textContent = '';
+					})
+					.catch(err => { createObjectStatus.textContent = err.message; });
+			});
+		});
+
+		document.getElementById('create-object-run').addEventListener('click', () => {
+			if (!pendingCreateObject) {
+				return;
+			}
+			createObjectStatus.textContent = 'Running ' + pendingCreateObject.statement + '...';
+			createObjectRequest(pendingCreateObject.form, pendingCreateObject.statement)
+				// The sidebar is rendered from the refreshed cache, so reloading shows the new object
+				.then(() => { window.location.reload(); })
+				.catch(err => { createObjectStatus.textContent = err.message; });
+		});
+
+		document.getElementById('create-object-cancel').addEventListener('click', () => {
+			pendingCreateObject = null;
+			createObjectPreview.hidden = true;
+		});
+
+		const commentsStatus = document.getElementById('comments-status');
+		const commentPreview = document.getElementById('comment-preview');
+		let pendingComment = null;
+
+		// Column comments are often the only documentation of a table, so they also label the grid headers
+		function loadComments() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			commentsStatus.textContent = 'Loading comments...';
+			fetch('/api/table/comments?' + params)
+				.then(readResponse)
+				.then(comments => {
+					document.querySelectorAll('#table-grid th[data-column]').forEach(th => {
+						const column = comments.columns.find(item => item.name === th.dataset.column);
+						th.title = column && column.comment ? column.comment : '';
+					});
+					const objects = [{ name: 'Table ' + refreshPanel.dataset.table, column: '', type: '', comment: comments.comment }]
+						.concat(comments.columns.map(column => ({ name: column.name, column: column.name, type: column.type, comment: column.comment })));
+					document.getElementById('comment-list').replaceChildren(...objects.map(object => {
+						const tr = document.createElement('tr');
+						[object.name, object.type].forEach(text => {
+							const td = document.createElement('td');
+							td.textContent = text;
+							tr.appendChild(td);
+						});
+						const commentCell = document.createElement('td');
+						const action = document.createElement('td');
+						if (comments.can_edit) {
+							const input = document.createElement('input');
+							input.type = 'text';
+							input.value = object.comment;
+							commentCell.appendChild(input);
+							const preview = document.createElement('button');
+							preview.type = 'button';
+							preview.textContent = 'Preview';
+							preview.addEventListener('click', () => previewComment(new URLSearchParams({ column: object.column, comment: input.value })));
+							action.appendChild(preview);
+						} else {
+							commentCell.textContent = object.comment;
+						}
+						tr.appendChild(commentCell);
+						tr.appendChild(action);
+						return tr;
+					}));
+					commentsStatus.textContent = comments.can_edit ? '' : 'Only the table owner can edit comments.';
+				})
+				.catch(err => { commentsStatus.textContent = 'Could not load comments: ' + err.message; });
+		}
+
+		function previewComment(fields) {
+			indexRequest('/api/table/comments/set', fields)
+				.then(change => {
+					pendingComment = { fields: fields, statement: change.statement };
+					document.getElementById('comment-statement').textContent = change.statement;
+					commentPreview.hidden = false;
+				})
+				.catch(err => { commentsStatus.textContent = err.message; });
+		}
+
+		document.getElementById('comment-run').addEventListener('click', () => {
+			if (!pendingComment) {
+				return;
+			}
+			const fields = new URLSearchParams(pendingComment.fields);
+			fields.set('confirm', pendingComment.statement);
+			commentsStatus.textContent = 'Running ' + pendingComment.statement + '...';
+			indexRequest('/api/table/comments/set', fields)
+				.then(() => {
+					pendingComment = null;
+					commentPreview.hidden = true;
+					loadComments();
+				})
+				.catch(err => { commentsStatus.textContent = err.message; });
+		});
+
+		document.getElementById('comment-cancel').addEventListener('click', () => {
+			pendingComment = null;
+			commentPreview.hidden = true;
+		});
+
+		loadComments();
+
+		const triggersStatus = document.getElementById('triggers-status');
+		const triggerPreview = document.getElementById('trigger-preview');
+		let pendingTriggerChange = null;
+
+		function loadTriggers() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			triggersStatus.textContent = 'Loading triggers...';
+			fetch('/api/table/triggers?' + params)
+				.then(readResponse)
+				.then(list => {
+					document.getElementById('trigger-list').replaceChildren(...list.triggers.map(trigger => {
+						const tr = document.createElement('tr');
+						[
+							trigger.name,
+							trigger.timing + (trigger.for_each_row ? ' each row' : ' each statement'),
+							trigger.events.join(' OR '),
+							trigger.function_schema + '.' + trigger.function_name + '()',
+							trigger.enabled ? 'enabled' : 'disabled',
+						].forEach(text => {
+							const td = document.createElement('td');
+							td.textContent = text;
+							tr.appendChild(td);
+						});
+						tr.title = trigger.definition;
+						const action = document.createElement('td');
+						if (list.can_manage) {
+							const toggle = document.createElement('button');
+							toggle.type = 'button';
+							toggle.textContent = trigger.enabled ? 'Disable' : 'Enable';
+							const path = trigger.enabled ? '/api/table/triggers/disable' : '/api/table/triggers/enable';
+							toggle.addEventListener('click', () => previewTriggerChange(path, new URLSearchParams({ name: trigger.name })));
+							action.appendChild(toggle);
+						}
+						tr.appendChild(action);
+						return tr;
+					}));
+					triggersStatus.textContent = list.triggers.length ? (list.can_manage ? '' : 'Only the table owner can enable or disable triggers.') : 'This table has no triggers.';
+				})
+				.catch(err => { triggersStatus.textContent = 'Could not load triggers: ' + err.message; });
+		}
+
+		// Trigger changes are previewed the same way as index changes
+		function previewTriggerChange(path, fields) {
+			indexRequest(path, fields)
+				.then(change => {
+					pendingTriggerChange = { path: path, fields: fields, statement: change.statement };
+					document.getElementById('trigger-statement').textContent = change.statement;
+					triggerPreview.hidden = false;
+				})
+				.catch(err => { triggersStatus.textContent = err.message; });
+		}
+
+		document.getElementById('trigger-run').addEventListener('click', () => {
+			if (!pendingTriggerChange) {
+				return;
+			}
+			const fields = new URLSearchParams(pendingTriggerChange.fields);
+			fields.set('confirm', pendingTriggerChange.statement);
+			triggersStatus.textContent = 'Running ' + pendingTriggerChange.statement + '...';
+			indexRequest(pendingTriggerChange.path, fields)
+				.then(() => {
+					pendingTriggerChange = null;
+					triggerPreview.hidden = true;
+					loadTriggers();
+				})
+				.catch(err => { triggersStatus.textContent = err.message; });
+		});
+
+		document.getElementById('trigger-cancel').addEventListener('click', () => {
+			pendingTriggerChange = null;
+			triggerPreview.hidden = true;
+		});
+
+		// The SQL tab shows the CREATE TABLE script that recreates the table elsewhere
+		const ddlStatus = document.getElementById('ddl-status');
+		const ddlStatement = document.getElementById('ddl-statement');
+
+		function loadDDL() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				table: refreshPanel.dataset.table,
+			});
+			document.getElementById('ddl-download').href = '/api/table/ddl?' + params + '&format=sql';
+			ddlStatus.textContent = 'Loading table definition...';
+			fetch('/api/table/ddl?' + params)
+				.then(readResponse)
+				.then(ddl => {
+					ddlStatement.textContent = ddl.statement;
+					ddlStatus.textContent = '';
+				})
+				.catch(err => { ddlStatus.textContent = 'Could not load table definition: ' + err.message; });
+		}
+
+		document.getElementById('ddl-copy').addEventListener('click', () => {
+			navigator.clipboard.writeText(ddlStatement.textContent)
+				.then(() => { ddlStatus.textContent = 'Copied to clipboard.'; })
+				.catch(err => { ddlStatus.textContent = 'Could not copy: ' + err.message; });
+		});
+
+		const functionsStatus = document.getElementById('functions-status');
+		const functionPanel = document.getElementById('function-panel');
+		const executeFunctionForm = document.getElementById('execute-function');
+		const functionStatus = document.getElementById('function-status');
+		let selectedFunction = null;
+
+		function loadFunctions() {
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+			});
+			fetch('/api/functions?' + params)
+				.then(readResponse)
+				.then(list => {
+					document.getElementById('function-list').replaceChildren(...list.functions.map(fn => {
+						const item = document.createElement('div');
+						item.className = 'table-item';
+						item.textContent = fn.name + '(' + fn.arguments + ')';
+						item.title = fn.kind + (fn.result ? ' returning ' + fn.result : '') + ', ' + fn.language;
+						item.addEventListener('click', () => openFunction(fn));
+						return item;
+					}));
+					functionsStatus.textContent = list.functions.length ? '' : 'No functions in this schema.';
+				})
+				.catch(err => { functionsStatus.textContent = 'Could not load functions: ' + err.message; });
+		}
+
+		// Output parameters are not passed, so only inputs get a value field and a value/NULL/default choice
+		function functionArgumentField(parameter) {
+			const label = document.createElement('label');
+			label.textContent = (parameter.name || parameter.mode.toLowerCase()) + ' ' + parameter.data_type + ' ';
+			const value = document.createElement('input');
+			value.type = 'text';
+			value.name = 'value';
+			const kind = document.createElement('select');
+			kind.name = 'kind';
+			['value', 'null'].concat(parameter.has_default ? ['default'] : []).forEach(option => {
+				kind.appendChild(new Option(option, option));
+			});
+			kind.addEventListener('change', () => { value.disabled = kind.value !== 'value'; });
+			label.append(value, kind);
+			const row = document.createElement('div');
+			row.appendChild(label);
+			return row;
+		}
+
+		function openFunction(fn) {
+			selectedFunction = fn;
+			functionPanel.hidden = false;
+			document.getElementById('function-title').textContent = refreshPanel.dataset.schema + '.' + fn.name;
+			document.getElementById('function-meta').textContent = fn.kind + '(' + fn.arguments + ')' + (fn.result ? ' returns ' + fn.result : '') + ', language ' + fn.language;
+			document.getElementById('function-source').textContent = 'Loading source...';
+			document.getElementById('function-statement').textContent = '';
+			document.getElementById('function-result-head').replaceChildren();
+			document.getElementById('function-result-rows').replaceChildren();
+			functionStatus.textContent = fn.can_execute ? '' : 'You do not have EXECUTE permission on this ' + fn.kind + '.';
+			executeFunctionForm.hidden = !fn.can_execute;
+			document.getElementById('function-arguments').replaceChildren(...fn.parameters
+				.filter(parameter => parameter.mode !== 'OUT' && parameter.mode !== 'TABLE')
+				.map(functionArgumentField));
+
+			const params = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				name: fn.name,
+				arguments: fn.arguments,
+			});
+			fetch('/api/functions/source?' + params)
+				.then(readResponse)
+				.then(source => { document.getElementById('function-source').textContent = source.definition; })
+				.catch(err => { document.getElementById('function-source').textContent = 'Could not load source: ' + err.message; });
+		}
+
+		executeFunctionForm.addEventListener('submit', event => {
+			event.preventDefault();
+			if (!selectedFunction) {
+				return;
+			}
+			const body = new URLSearchParams({
+				database: refreshPanel.dataset.database,
+				schema: refreshPanel.dataset.schema,
+				name: selectedFunction.name,
+				arguments: selectedFunction.arguments,
+			});
+			// Disabled value inputs are skipped by FormData, so pair each kind with its value explicitly
+			executeFunctionForm.querySelectorAll('#function-arguments label').forEach(label => {
+				body.append('value', label.querySelector('input').value);
+				body.append('kind', label.querySelector('select').value);
+			});
+			functionStatus.textContent = 'Executing...';
+			fetch('/api/functions/execute', { method: 'POST', body: body })
+				.then(readResponse)
+				.then(result => {
+					document.getElementById('function-statement').textContent = result.statement;
+					document.getElementById('function-result-head').replaceChildren(...result.columns.map(column => {
+						const th = document.createElement('th');
+						th.textContent = column;
+						return th;
+					}));
+					document.getElementById('function-result-rows').replaceChildren(...result.rows.map(row => {
+						const tr = document.createElement('tr');
+						result.columns.forEach(column => {
+							const td = document.createElement('td');
+							td.textContent = row[column] === null ? 'NULL' : row[column];
+							tr.appendChild(td);
+						});
+						return tr;
+					}));
+					functionStatus.textContent = result.total_count + ' row(s)';
+				})
+				.catch(err => { functionStatus.textContent = err.message; });
+		});
+
+		loadFunctions();
+
+		// An open transaction is rolled back once its page reports no activity, so only pages in use
+		// send heartbeats
+		const idleWarning = document.getElementById('transaction-idle-warning');
+		const idleMessage = document.getElementById('transaction-idle-message');
+		const keepOpen = document.getElementById('transaction-keep-open');
+		let activeSinceHeartbeat = false;
+		['keydown', 'mousedown', 'scroll'].forEach(name => {
+			document.addEventListener(name, () => { activeSinceHeartbeat = true; }, { passive: true });
+		});
+
+		function sendHeartbeat() {
+			activeSinceHeartbeat = false;
+			fetch('/api/transaction/heartbeat', { method: 'POST' })
+				.then(response => {
+					if (response.ok) {
+						idleWarning.hidden = true;
+					}
+				});
+		}
+
+		setInterval(() => {
+			if (activeSinceHeartbeat) {
+				sendHeartbeat();
+			}
+		}, ` + strconv.FormatInt(domain.TransactionHeartbeatInterval.Milliseconds(), 10) + `);
+		keepOpen.addEventListener('click', sendHeartbeat);
+
+		const transactionEvents = new EventSource('/api/transaction/events');
+		transactionEvents.addEventListener('idle_warning', event => {
+			const warning = JSON.parse(event.data);
+			idleMessage.textContent = 'Your transaction has been idle and will be rolled back in ' +
+				Math.max(1, Math.ceil(warning.remaining_seconds / 60)) + ' minute(s).';
+			keepOpen.hidden = false;
+			idleWarning.hidden = false;
+		});
+		transactionEvents.addEventListener('transaction_timeout', () => {
+			idleMessage.textContent = 'Your transaction was rolled back after being idle; its buffered changes were discarded.';
+			keepOpen.hidden = true;
+			idleWarning.hidden = false;
+		});
+
+		// Row estimates and sizes are attached to the explorer's tables in batches per schema once the
+		// tree is shown, so a large catalog never holds up the page
+		function formatRows(rows) {
+			if (rows === null) {
+				return 'rows unknown';
+			}
+			const units = ['', 'k', 'M', 'B'];
+			let value = rows;
+			let unit = 0;
+			while (value >= 1000 && unit < units.length - 1) {
+				value /= 1000;
+				unit++;
+			}
+			return '~' + (unit ? value.toFixed(1) : value) + units[unit] + ' rows';
+		}
+
+		// Object search looks names up in the cached metadata as the user types; picking a table, view or
+		// column shows that relation's data
+		const objectSearch = document.getElementById('object-search');
+		const objectSearchResults = document.getElementById('object-search-results');
+		let objectSearchTimer = null;
+
+		function jumpToRelation(match) {
+			const params = new URLSearchParams({
+				database: match.database,
+				schema: match.schema,
+				table: match.kind === 'column' ? match.table : match.name,
+			});
+			fetch('/main/select-table?' + params)
+				.then(response => response.text())
+				.then(fragment => {
+					const target = document.getElementById('object-jump-view');
+					target.innerHTML = fragment;
+					target.scrollIntoView();
+				});
+		}
+
+		function renderObjectMatches(list) {
+			objectSearchResults.replaceChildren(...list.matches.map(match => {
+				const item = document.createElement('li');
+				const kind = document.createElement('span');
+				kind.className = 'object-kind';
+				kind.textContent = match.kind;
+				item.appendChild(kind);
+				const relation = match.schema + '.' + (match.kind === 'column' ? match.table + '.' : '');
+				item.appendChild(document.createTextNode(relation + match.name + (match.kind === 'function' ? '()' : '')));
+				item.title = match.database;
+				if (match.kind !== 'function') {
+					item.addEventListener('click', () => jumpToRelation(match));
+				}
+				return item;
+			}));
+		}
+
+		objectSearch.addEventListener('input', () => {
+			clearTimeout(objectSearchTimer);
+			const term = objectSearch.value.trim();
+			if (!term) {
+				objectSearchResults.replaceChildren();
+				return;
+			}
+			objectSearchTimer = setTimeout(() => {
+				fetch('/api/search?' + new URLSearchParams({ q: term }))
+					.then(readResponse)
+					.then(renderObjectMatches)
+					.catch(() => { objectSearchResults.replaceChildren(); });
+			}, 200);
+		});
+
+		const sizeBatches = new Map();
+		document.querySelectorAll('.table-item[data-table]').forEach(item => {
+			const key = item.dataset.database + '\u0000' + item.dataset.schema;
+			if (!sizeBatches.has(key)) {
+				sizeBatches.set(key, []);
+			}
+			sizeBatches.get(key).push(item);
+		});
+		sizeBatches.forEach(items => {
+			for (let start = 0; start < items.length; start += ` + strconv.Itoa(domain.TableSizeBatchLimit) + `) {
+				const batch = items.slice(start, start + ` + strconv.Itoa(domain.TableSizeBatchLimit) + `);
+				const params = new URLSearchParams({ database: batch[0].dataset.database, schema: batch[0].dataset.schema });
+				batch.forEach(item => params.append('table', item.dataset.table));
+				fetch('/api/table/sizes?' + params)
+					.then(readResponse)
+					.then(sizes => {
+						sizes.tables.forEach(size => {
+							const item = batch.find(candidate => candidate.dataset.table === size.table);
+							if (!item) {
+								return;
+							}
+							const detail = formatRows(size.estimated_rows) + ', ' + formatBytes(size.total_size_bytes);
+							item.querySelector('.table-size').textContent = detail;
+							item.title = size.schema + '.' + size.table + ': ' + detail + ' (estimate)';
+						});
+					})
+					.catch(() => {});
+			}
+		});
+	</script>
 </body>
 </html>`
 
 	w.Write([]byte(html))
 }
 
+// indexMethodOptions renders the index access methods as select options, btree first
+func indexMethodOptions() string {
+	options := ""
+	for _, method := range domain.IndexMethods {
+		options += `<option value="` + method + `">` + method + `</option>`
+	}
+	return options
+}
+
+// foreignKeyActionOptions renders the referential actions as select options, NO ACTION first
+func foreignKeyActionOptions() string {
+	options := ""
+	for _, action := range domain.ForeignKeyActions {
+		options += `<option value="` + action + `">` + action + `</option>`
+	}
+	return options
+}
+
 func formatValue(value interface{}) string {
 	switch v := value.(type) {
 	case string:
+		if len(v) > domain.CellPreviewMaxBytes {
+			return describeCellContent("text", []byte(v))
+		}
 		return v
+	case []byte:
+		if len(v) > domain.CellPreviewMaxBytes || !utf8.Valid(v) {
+			return describeCellContent("binary", v)
+		}
+		return string(v)
 	case int, int8, int16, int32, int64:
 		return stringifyInt(v)
 	case float32, float64:
@@ -148,6 +1426,44 @@ func formatValue(value interface{}) string {
 	}
 }
 
+// formatSpatialValue shows a geometry or geography cell as its WKT, carrying the GeoJSON for map
+// previews; the raw value stays in the row for editing
+func formatSpatialValue(result *domain.QueryResult, row int, column string) (string, bool) {
+	if row >= len(result.Spatial) {
+		return "", false
+	}
+	value, ok := result.Spatial[row][column]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(`<span class="spatial-value" data-geojson="%s">%s</span>`,
+		template.HTMLEscapeString(value.GeoJSON), template.HTMLEscapeString(value.WKT)), true
+}
+
+// describeCellContent stands in for a cell too large or too binary to show, giving its size and the
+// start of its SHA-256 so values can be told apart; the full value is downloaded from /api/table/cell-content
+func describeCellContent(kind string, content []byte) string {
+	digest := sha256.Sum256(content)
+	size := int64(len(content))
+	return fmt.Sprintf(`<span class="cell-content" data-size="%d" data-sha256="%x">%s, %s, sha256 %x…</span>`,
+		size, digest, kind, formatCellSize(size), digest[:4])
+}
+
+// formatCellSize renders a byte count in binary units, such as "8.0 KiB"
+func formatCellSize(size int64) string {
+	units := []string{"B", "KiB", "MiB", "GiB"}
+	value := float64(size)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%d B", size)
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
+
 func stringifyInt(v interface{}) string {
 	switch val := v.(type) {
 	case int:
@@ -193,3 +1509,25 @@ func itoa(n int) string {
 
 	return string(result)
 }
+
+// metadataLoadingNotice tells the user the explorer is still being filled in, or that part of it
+// could not be listed in time; empty once discovery is complete
+func metadataLoadingNotice(resources *domain.RoleMetadata) string {
+	var notice string
+	switch resources.Loading {
+	case domain.MetadataStageSchemas:
+		notice = "Databases loaded, schemas loading…"
+	case domain.MetadataStageTables:
+		notice = "Schemas loaded, tables loading…"
+	}
+	if len(resources.Incomplete) > 0 {
+		if notice != "" {
+			notice += " "
+		}
+		notice += "Not listed in time: " + strings.Join(resources.Incomplete, ", ")
+	}
+	if notice == "" {
+		return ""
+	}
+	return `<div class="metadata-loading">` + template.HTMLEscapeString(notice) + `</div>`
+}
@@ -0,0 +1,52 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleObjectSearch(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	matches, err := h.dataViewUC.SearchObjects(r.Context(), session.Username, r.URL.Query().Get("q"))
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error searching objects: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	results := make([]map[string]interface{}, len(matches))
+	for i, match := range matches {
+		results[i] = map[string]interface{}{
+			"kind":     match.Kind,
+			"database": match.Database,
+			"schema":   match.Schema,
+			"table":    match.Table,
+			"name":     match.Name,
+			"rank":     match.Rank,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"matches": results})
+}
@@ -46,11 +46,12 @@ func (h *MainViewHandlerImplementation) HandlePaginationNext(w http.ResponseWrit
 	} else {
 		// Fallback to offset-based pagination
 		tableData, err = h.dataViewUC.LoadTableData(r.Context(), session.Username, domain.TableDataParams{
-			Database: database,
-			Schema:   schema,
-			Table:    table,
-			Offset:   0,
-			Limit:    50,
+			Database:    database,
+			Schema:      schema,
+			Table:       table,
+			Offset:      0,
+			Limit:       50,
+			SnapshotKey: session.ID,
 		})
 	}
 	if err != nil {
@@ -72,13 +73,15 @@ func (h *MainViewHandlerImplementation) HandlePaginationNext(w http.ResponseWrit
 	html += `</tr></thead><tbody>`
 
 	// Render rows
-	for _, row := range tableData.Rows {
+	for i, row := range tableData.Rows {
 		html += `<tr>`
 		for _, col := range tableData.Columns {
 			value := row[col]
 			var valueStr string
 			if value == nil {
 				valueStr = "NULL"
+			} else if spatial, ok := formatSpatialValue(tableData, i, col); ok {
+				valueStr = spatial
 			} else {
 				valueStr = formatValue(value)
 			}
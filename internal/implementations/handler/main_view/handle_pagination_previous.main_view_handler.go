@@ -53,11 +53,12 @@ func (h *MainViewHandlerImplementation) HandlePaginationPrevious(w http.Response
 
 	// Load table data with new offset
 	tableData, err := h.dataViewUC.LoadTableData(r.Context(), session.Username, domain.TableDataParams{
-		Database: database,
-		Schema:   schema,
-		Table:    table,
-		Offset:   newOffset,
-		Limit:    50,
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		Offset:      newOffset,
+		Limit:       50,
+		SnapshotKey: session.ID,
 	})
 	if err != nil {
 		http.Error(w, "Error loading table data: "+err.Error(), http.StatusInternalServerError)
@@ -78,13 +79,15 @@ func (h *MainViewHandlerImplementation) HandlePaginationPrevious(w http.Response
 	html += `</tr></thead><tbody>`
 
 	// Render rows
-	for _, row := range tableData.Rows {
+	for i, row := range tableData.Rows {
 		html += `<tr>`
 		for _, col := range tableData.Columns {
 			value := row[col]
 			var valueStr string
 			if value == nil {
 				valueStr = "NULL"
+			} else if spatial, ok := formatSpatialValue(tableData, i, col); ok {
+				valueStr = spatial
 			} else {
 				valueStr = formatValue(value)
 			}
@@ -0,0 +1,130 @@
+package main_view
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleReferentialIntegrity(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+	format := r.FormValue("format")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// A manual reference covers columns without a declared constraint
+	var references []domain.ForeignKeyMetadata
+	if column := r.FormValue("column"); column != "" {
+		references = append(references, domain.ForeignKeyMetadata{
+			ColumnName:       column,
+			ReferencedSchema: r.FormValue("ref_schema"),
+			ReferencedTable:  r.FormValue("ref_table"),
+			ReferencedColumn: r.FormValue("ref_column"),
+		})
+	}
+
+	limit := 0
+	if limitStr := r.FormValue("limit"); limitStr != "" {
+		limit, _ = strconv.Atoi(limitStr)
+	}
+	if format == "csv" {
+		canExport, err := h.rbacUC.CheckExportPermission(r.Context(), session.Username, database, schema, table)
+		if err != nil {
+			http.Error(w, "Error checking permissions: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !canExport {
+			http.Error(w, "Export of this table is not allowed", http.StatusForbidden)
+			return
+		}
+		limit = domain.QueryResultHardLimit
+	}
+
+	report, err := h.dataViewUC.CheckReferentialIntegrity(r.Context(), session.Username, database, schema, table, references, limit)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error checking referential integrity: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if format == "csv" {
+		h.writeOrphanedRowsCSV(w, report)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
+
+// writeOrphanedRowsCSV exports the offending rows, prefixed with the reference they violate
+func (h *MainViewHandlerImplementation) writeOrphanedRowsCSV(w http.ResponseWriter, report *domain.ReferentialIntegrityReport) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", report.Table+"_orphans.csv"))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	headerWritten := false
+	for _, reference := range report.References {
+		if reference.Rows == nil {
+			continue
+		}
+		if !headerWritten {
+			writer.Write(append([]string{"reference"}, reference.Rows.Columns...))
+			headerWritten = true
+		}
+
+		label := fmt.Sprintf("%s -> %s.%s", reference.Reference.ColumnName, reference.Reference.ReferencedTable, reference.Reference.ReferencedColumn)
+		for _, row := range reference.Rows.Rows {
+			record := []string{label}
+			for _, col := range reference.Rows.Columns {
+				value := row[col]
+				if value == nil {
+					record = append(record, "")
+				} else if raw, ok := value.([]byte); ok {
+					record = append(record, string(raw))
+				} else {
+					record = append(record, fmt.Sprintf("%v", value))
+				}
+			}
+			writer.Write(record)
+		}
+	}
+	writer.Flush()
+}
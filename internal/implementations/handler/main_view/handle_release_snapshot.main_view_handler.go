@@ -0,0 +1,35 @@
+package main_view
+
+import (
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleReleaseSnapshot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.dataViewUC.ReleaseSnapshot(r.Context(), session.ID); err != nil {
+		http.Error(w, "Error releasing snapshot: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSnapshotStatus(w, &domain.SnapshotStatus{})
+}
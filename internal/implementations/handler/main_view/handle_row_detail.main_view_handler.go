@@ -0,0 +1,95 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleRowDetail(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters, primary key values are given as "pk.<column>" fields
+	query := r.URL.Query()
+	database := query.Get("database")
+	schema := query.Get("schema")
+	table := query.Get("table")
+
+	pkValues := make(map[string]interface{})
+	for key := range query {
+		if pkColumn, ok := strings.CutPrefix(key, "pk."); ok && pkColumn != "" {
+			pkValues[pkColumn] = query.Get(key)
+		}
+	}
+
+	if database == "" || schema == "" || table == "" || len(pkValues) == 0 {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	detail, err := h.dataViewUC.GetRowDetail(r.Context(), session.Username, database, schema, table, pkValues)
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+		case errors.Is(err, domain.ErrTableNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, "Error loading row: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	columns := make([]map[string]interface{}, len(detail.Columns))
+	for i, column := range detail.Columns {
+		var foreignKey interface{}
+		if column.ForeignKey != nil {
+			foreignKey = map[string]interface{}{
+				"schema": column.ForeignKey.ReferencedSchema,
+				"table":  column.ForeignKey.ReferencedTable,
+				"column": column.ForeignKey.ReferencedColumn,
+			}
+		}
+		columns[i] = map[string]interface{}{
+			"name":        column.Name,
+			"data_type":   column.DataType,
+			"nullable":    column.IsNullable,
+			"primary_key": column.IsPrimary,
+			"default":     column.Default,
+			"foreign_key": foreignKey,
+			"comment":     column.Comment,
+			"value":       column.Value,
+			"is_null":     column.Value == nil,
+		}
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"database": detail.Database,
+		"schema":   detail.Schema,
+		"table":    detail.Table,
+		"columns":  columns,
+	})
+}
@@ -0,0 +1,29 @@
+package main_view
+
+import (
+	"net/http"
+)
+
+func (h *MainViewHandlerImplementation) HandleSnapshotStatus(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	status, err := h.dataViewUC.GetSnapshotStatus(r.Context(), session.ID)
+	if err != nil {
+		http.Error(w, "Error reading snapshot: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSnapshotStatus(w, status)
+}
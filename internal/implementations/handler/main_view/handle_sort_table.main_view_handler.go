@@ -68,13 +68,15 @@ func (h *MainViewHandlerImplementation) HandleSortTable(w http.ResponseWriter, r
 	html += `</tr></thead><tbody>`
 
 	// Render rows
-	for _, row := range result.Rows {
+	for i, row := range result.Rows {
 		html += `<tr>`
 		for _, col := range result.Columns {
 			value := row[col]
 			var valueStr string
 			if value == nil {
 				valueStr = "NULL"
+			} else if spatial, ok := formatSpatialValue(result, i, col); ok {
+				valueStr = spatial
 			} else {
 				valueStr = formatValue(value)
 			}
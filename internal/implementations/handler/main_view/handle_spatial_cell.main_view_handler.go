@@ -0,0 +1,80 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleSpatialCell(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters, primary key values are given as "pk.<column>" fields
+	query := r.URL.Query()
+	database := query.Get("database")
+	schema := query.Get("schema")
+	table := query.Get("table")
+	column := query.Get("column")
+
+	pkValues := make(map[string]interface{})
+	for key := range query {
+		if pkColumn, ok := strings.CutPrefix(key, "pk."); ok && pkColumn != "" {
+			pkValues[pkColumn] = query.Get(key)
+		}
+	}
+
+	if database == "" || schema == "" || table == "" || column == "" || len(pkValues) == 0 {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	detail, err := h.dataViewUC.GetSpatialCell(r.Context(), session.Username, database, schema, table, column, pkValues)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error loading cell: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"column":    detail.Column,
+		"data_type": detail.DataType,
+		"geojson":   spatialGeoJSON(detail.GeoJSON),
+		"wkt":       detail.WKT,
+		"raw":       detail.Raw,
+		"is_null":   detail.IsNull,
+	})
+}
+
+// spatialGeoJSON embeds a GeoJSON geometry as an object rather than a string, and as null when there is none
+func spatialGeoJSON(geoJSON string) interface{} {
+	if geoJSON == "" {
+		return nil
+	}
+	return json.RawMessage(geoJSON)
+}
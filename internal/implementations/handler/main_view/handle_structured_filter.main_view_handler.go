@@ -0,0 +1,104 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// structuredFilterRequest is the JSON body of a filter built from predicates instead of a WHERE clause
+type structuredFilterRequest struct {
+	Database string                 `json:"database"`
+	Schema   string                 `json:"schema"`
+	Table    string                 `json:"table"`
+	Offset   int                    `json:"offset"`
+	Filter   filterPredicateRequest `json:"filter"`
+}
+
+// filterPredicateRequest is a comparison, or an "and"/"or" group of predicates when group is set
+type filterPredicateRequest struct {
+	Column     string                   `json:"column"`
+	Operator   string                   `json:"operator"`
+	Value      interface{}              `json:"value"`
+	Group      string                   `json:"group"`
+	Predicates []filterPredicateRequest `json:"predicates"`
+}
+
+func (p filterPredicateRequest) predicate() domain.FilterPredicate {
+	predicate := domain.FilterPredicate{
+		Column:   p.Column,
+		Operator: p.Operator,
+		Value:    p.Value,
+		Group:    p.Group,
+	}
+	for _, child := range p.Predicates {
+		predicate.Predicates = append(predicate.Predicates, child.predicate())
+	}
+	return predicate
+}
+
+func (h *MainViewHandlerImplementation) HandleStructuredFilter(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request structuredFilterRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if request.Database == "" || request.Schema == "" || request.Table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.dataViewUC.FilterTableDataByPredicates(r.Context(), session.Username, request.Database, request.Schema, request.Table,
+		request.Filter.predicate(), request.Offset, domain.QueryResultPageSize)
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			status := http.StatusBadRequest
+			if validationErr.Field == "table" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+		case errors.Is(err, domain.ErrTableNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, "Error filtering table data: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	payload := map[string]interface{}{
+		"columns":     result.Columns,
+		"rows":        result.Rows,
+		"row_count":   result.RowCount,
+		"total_count": result.TotalCount,
+	}
+	if len(result.SpatialColumns) > 0 {
+		payload["spatial"] = spatialPayload(result)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(payload)
+}
@@ -0,0 +1,63 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleTableSearch(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	database := query.Get("database")
+	schema := query.Get("schema")
+	table := query.Get("table")
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.dataViewUC.SearchTableData(r.Context(), session.Username, database, schema, table, query.Get("q"))
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			status := http.StatusBadRequest
+			if validationErr.Field == "table" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+		case errors.Is(err, domain.ErrTableNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, "Error searching table data: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"columns":          result.Columns,
+		"rows":             result.Rows,
+		"matched_columns":  result.MatchedColumns,
+		"searched_columns": result.SearchedColumns,
+		"total_matches":    result.TotalMatches,
+		"truncated":        result.TotalMatches > int64(len(result.Rows)),
+	})
+}
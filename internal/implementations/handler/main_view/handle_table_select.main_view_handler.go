@@ -1,6 +1,7 @@
 package main_view
 
 import (
+	"html/template"
 	"net/http"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
@@ -50,14 +51,30 @@ func (h *MainViewHandlerImplementation) HandleTableSelect(w http.ResponseWriter,
 		return
 	}
 
+	params := domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		Offset:      0,
+		Limit:       50,
+		SnapshotKey: session.ID,
+	}
+
+	// The user's default view of the table sets its filter, sort and hidden columns; a view that
+	// cannot be read, or whose filter no longer validates, leaves the table unfiltered
+	view := h.defaultTableView(r, session.Username, database, schema, table)
+	columnHidden := make(map[string]bool)
+	if view != nil {
+		params.WhereClause = view.WhereClause
+		params.OrderBy = view.OrderBy
+		params.OrderDir = view.OrderDir
+		for _, column := range view.HiddenColumns {
+			columnHidden[column] = true
+		}
+	}
+
 	// Load table data
-	tableData, err := h.dataViewUC.LoadTableData(r.Context(), session.Username, domain.TableDataParams{
-		Database: database,
-		Schema:   schema,
-		Table:    table,
-		Offset:   0,
-		Limit:    50,
-	})
+	tableData, err := h.dataViewUC.LoadTableData(r.Context(), session.Username, params)
 	if err != nil {
 		http.Error(w, "Error loading table data: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -68,13 +85,26 @@ func (h *MainViewHandlerImplementation) HandleTableSelect(w http.ResponseWriter,
 	w.WriteHeader(http.StatusOK)
 
 	html := `<div class="table-data">
-		<h2>Table: ` + table + `</h2>
+		<h2>Table: ` + table + `</h2>`
+	if view != nil {
+		html += `
+		<div class="table-view" data-view="` + template.HTMLEscapeString(view.Name) + `">View: ` + template.HTMLEscapeString(view.Name) + `</div>`
+	}
+
+	var columns []string
+	for _, col := range tableData.Columns {
+		if !columnHidden[col] {
+			columns = append(columns, col)
+		}
+	}
+
+	html += `
 		<table>
 			<thead>
 				<tr>`
 
 	// Render column headers
-	for _, col := range tableData.Columns {
+	for _, col := range columns {
 		html += `<th>` + col + `</th>`
 	}
 
@@ -84,13 +114,15 @@ func (h *MainViewHandlerImplementation) HandleTableSelect(w http.ResponseWriter,
 			<tbody>`
 
 	// Render rows
-	for _, row := range tableData.Rows {
+	for i, row := range tableData.Rows {
 		html += `<tr>`
-		for _, col := range tableData.Columns {
+		for _, col := range columns {
 			value := row[col]
 			var valueStr string
 			if value == nil {
 				valueStr = "NULL"
+			} else if spatial, ok := formatSpatialValue(tableData, i, col); ok {
+				valueStr = spatial
 			} else {
 				valueStr = formatValue(value)
 			}
@@ -106,3 +138,17 @@ func (h *MainViewHandlerImplementation) HandleTableSelect(w http.ResponseWriter,
 
 	w.Write([]byte(html))
 }
+
+// defaultTableView returns the user's default view of the table when it can be applied as is
+func (h *MainViewHandlerImplementation) defaultTableView(r *http.Request, username, database, schema, table string) *domain.SavedTableView {
+	view, err := h.tableViewUC.GetDefaultTableView(r.Context(), username, database, schema, table)
+	if err != nil || view == nil {
+		return nil
+	}
+	if view.WhereClause != "" {
+		if valid, err := h.dataViewUC.ValidateWhereClause(r.Context(), view.WhereClause); err != nil || !valid {
+			return nil
+		}
+	}
+	return view
+}
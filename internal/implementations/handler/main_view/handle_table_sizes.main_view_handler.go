@@ -0,0 +1,62 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleTableSizes(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters, the tables to size are repeated "table" fields
+	query := r.URL.Query()
+	database := query.Get("database")
+	schema := query.Get("schema")
+	tables := query["table"]
+
+	if database == "" || schema == "" || len(tables) == 0 {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	estimates, err := h.dataViewUC.GetTableSizeEstimates(r.Context(), session.Username, database, schema, tables)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error reading table sizes: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]map[string]interface{}, len(estimates))
+	for i, estimate := range estimates {
+		items[i] = map[string]interface{}{
+			"schema":           estimate.Schema,
+			"table":            estimate.Table,
+			"estimated_rows":   estimate.EstimatedRows,
+			"total_size_bytes": estimate.TotalSizeBytes,
+		}
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"tables": items})
+}
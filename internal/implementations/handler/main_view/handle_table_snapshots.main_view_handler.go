@@ -0,0 +1,100 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// HandleTableSnapshots lists the user's table snapshots on GET and snapshots a table on POST
+func (h *MainViewHandlerImplementation) HandleTableSnapshots(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		snapshots, err := h.dataViewUC.ListTableSnapshots(r.Context(), session.Username)
+		if err != nil {
+			writeTableSnapshotError(w, "Error listing snapshots: ", err)
+			return
+		}
+
+		response := make([]map[string]interface{}, len(snapshots))
+		for i, snapshot := range snapshots {
+			response[i] = tableSnapshotSummary(&snapshot)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	snapshot, err := h.dataViewUC.TakeTableSnapshot(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeTableSnapshotError(w, "Error taking snapshot: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(tableSnapshotSummary(snapshot))
+}
+
+// tableSnapshotSummary describes a snapshot without its rows
+func tableSnapshotSummary(snapshot *domain.TableSnapshot) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           snapshot.ID,
+		"database":     snapshot.Database,
+		"schema":       snapshot.Schema,
+		"table":        snapshot.Table,
+		"primary_keys": snapshot.PrimaryKeys,
+		"row_count":    len(snapshot.Rows),
+		"taken_at":     snapshot.TakenAt.UTC().Format(time.RFC3339),
+		"expires_at":   snapshot.TakenAt.Add(domain.TableSnapshotTTL).UTC().Format(time.RFC3339),
+	}
+}
+
+// writeTableSnapshotError maps table snapshot errors to HTTP responses
+func writeTableSnapshotError(w http.ResponseWriter, prefix string, err error) {
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		status := http.StatusBadRequest
+		if validationErr.Field == "permission" {
+			status = http.StatusForbidden
+		}
+		http.Error(w, validationErr.Message, status)
+		return
+	}
+	if errors.Is(err, domain.ErrTableSnapshotNotFound) {
+		http.Error(w, "Snapshot not found or expired", http.StatusNotFound)
+		return
+	}
+	http.Error(w, prefix+err.Error(), http.StatusInternalServerError)
+}
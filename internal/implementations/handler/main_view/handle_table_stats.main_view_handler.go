@@ -0,0 +1,104 @@
+package main_view
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleTableStats(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	database := r.FormValue("database")
+	schema := r.FormValue("schema")
+	table := r.FormValue("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	stats, err := h.dataViewUC.GetTableStatistics(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error reading table statistics: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	indexes := make([]map[string]interface{}, 0, len(stats.Indexes))
+	for _, index := range stats.Indexes {
+		indexes = append(indexes, map[string]interface{}{
+			"name":           index.Name,
+			"scans":          index.Scans,
+			"tuples_read":    index.TuplesRead,
+			"tuples_fetched": index.TuplesFetched,
+			"size_bytes":     index.SizeBytes,
+			"unique":         index.Unique,
+		})
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"table":                       stats.Table,
+		"live_tuples":                 stats.LiveTuples,
+		"dead_tuples":                 stats.DeadTuples,
+		"dead_tuple_percent":          stats.DeadTuplePercent,
+		"modifications_since_analyze": stats.ModificationsSinceAnalyze,
+		"seq_scans":                   stats.SeqScans,
+		"seq_tuples_read":             stats.SeqTuplesRead,
+		"index_scans":                 stats.IndexScans,
+		"index_tuples_fetched":        stats.IndexTuplesFetched,
+		"index_scan_percent":          stats.IndexScanPercent,
+		"last_vacuum":                 formatStatsTime(stats.LastVacuum),
+		"last_autovacuum":             formatStatsTime(stats.LastAutovacuum),
+		"last_analyze":                formatStatsTime(stats.LastAnalyze),
+		"last_autoanalyze":            formatStatsTime(stats.LastAutoanalyze),
+		"vacuum_count":                stats.VacuumCount,
+		"autovacuum_count":            stats.AutovacuumCount,
+		"analyze_count":               stats.AnalyzeCount,
+		"autoanalyze_count":           stats.AutoanalyzeCount,
+		"table_size_bytes":            stats.TableSizeBytes,
+		"indexes_size_bytes":          stats.IndexesSizeBytes,
+		"total_size_bytes":            stats.TotalSizeBytes,
+		"estimated_bloat_bytes":       stats.EstimatedBloatBytes,
+		"estimated_bloat_percent":     stats.EstimatedBloatPercent,
+		"indexes":                     indexes,
+		"unused_indexes":              stats.UnusedIndexes,
+	})
+}
+
+// formatStatsTime formats a vacuum or analyze time, nil when it never ran
+func formatStatsTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return value.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,119 @@
+package main_view
+
+import (
+	"errors"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MainViewHandlerImplementation) HandleWatchRows(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	params := domain.RowWatchParams{
+		Database:    query.Get("database"),
+		Schema:      query.Get("schema"),
+		Table:       query.Get("table"),
+		WhereClause: query.Get("where"),
+		Mode:        query.Get("mode"),
+	}
+	if params.Database == "" || params.Schema == "" || params.Table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	params.Interval, err = domain.ParseLiveInterval(query.Get("interval"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// The stream opens with the first event, so a refused watch still gets a plain error response
+	streaming := false
+	err = h.dataViewUC.WatchRows(r.Context(), session.Username, params, func(event *domain.RowWatchEvent) error {
+		if !streaming {
+			streaming = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+		}
+		return writeRowWatchEvent(w, flusher, event)
+	})
+	if err != nil && !streaming {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "table" || validationErr.Field == "permission" || validationErr.Field == "" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, html.EscapeString(validationErr.Message), status)
+			return
+		}
+		http.Error(w, "Error watching rows: "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// writeRowWatchEvent sends one watch report as a server-sent event: "watching" when the watch starts
+// or changes mode, "changes" with the changed rows, or "watch-error" when a check failed
+func writeRowWatchEvent(w http.ResponseWriter, flusher http.Flusher, event *domain.RowWatchEvent) error {
+	name := "changes"
+	payload := map[string]interface{}{
+		"sequence":      event.Sequence,
+		"checked_at":    event.CheckedAt.UTC().Format(time.RFC3339),
+		"mode":          event.Mode,
+		"matching_rows": event.MatchingRows,
+	}
+	switch {
+	case event.Error != "":
+		name = "watch-error"
+		payload["error"] = event.Error
+	case len(event.Changes) == 0:
+		name = "watching"
+		if event.Notice != "" {
+			payload["notice"] = event.Notice
+		}
+	default:
+		changes := make([]map[string]interface{}, len(event.Changes))
+		for i, change := range event.Changes {
+			changes[i] = map[string]interface{}{
+				"operation": change.Operation,
+				"key":       change.Key,
+				"before":    change.Before,
+				"after":     change.After,
+			}
+		}
+		payload["changes"] = changes
+	}
+
+	if err := domain.WriteServerSentEvent(w, event.Sequence, name, payload); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
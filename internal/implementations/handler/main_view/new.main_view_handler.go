@@ -9,16 +9,23 @@ type MainViewHandlerImplementation struct {
 	dataViewUC usecase.DataViewUseCase
 	authUC     usecase.AuthenticationUseCase
 	rbacUC     usecase.RBACUseCase
+	importUC   usecase.ImportUseCase
+	// tableViewUC holds the saved view applied when a table is selected
+	tableViewUC usecase.TableViewUseCase
 }
 
 func NewMainViewHandlerImplementation(
 	dataViewUC usecase.DataViewUseCase,
 	authUC usecase.AuthenticationUseCase,
 	rbacUC usecase.RBACUseCase,
+	importUC usecase.ImportUseCase,
+	tableViewUC usecase.TableViewUseCase,
 ) handler.MainViewHandler {
 	return &MainViewHandlerImplementation{
-		dataViewUC: dataViewUC,
-		authUC:     authUC,
-		rbacUC:     rbacUC,
+		dataViewUC:  dataViewUC,
+		authUC:      authUC,
+		rbacUC:      rbacUC,
+		importUC:    importUC,
+		tableViewUC: tableViewUC,
 	}
 }
@@ -1,8 +1,19 @@
 package main_view
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
 
 func (h *MainViewHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Filters refused deep in the data view are recorded against the route they came in on
+	r = r.WithContext(domain.WithRequestOrigin(r.Context(), domain.RequestOrigin{
+		Method:     r.Method,
+		Route:      r.URL.Path,
+		RemoteAddr: r.RemoteAddr,
+	}))
+
 	switch r.URL.Path {
 	case "/main":
 		h.HandleMainViewPage(w, r)
@@ -10,14 +21,74 @@ func (h *MainViewHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http
 		h.HandleTableSelect(w, r)
 	case "/main/load-data":
 		h.HandleLoadTableData(w, r)
+	case "/api/table/live":
+		h.HandleLiveTableData(w, r)
+	case "/api/table/watch":
+		h.HandleWatchRows(w, r)
 	case "/main/filter":
 		h.HandleFilterTable(w, r)
+	case "/api/table/filter":
+		h.HandleStructuredFilter(w, r)
+	case "/api/table/search":
+		h.HandleTableSearch(w, r)
+	case "/api/search":
+		h.HandleObjectSearch(w, r)
 	case "/main/sort":
 		h.HandleSortTable(w, r)
 	case "/main/pagination/next":
 		h.HandlePaginationNext(w, r)
 	case "/main/pagination/previous":
 		h.HandlePaginationPrevious(w, r)
+	case "/api/view/freeze":
+		h.HandleFreezeView(w, r)
+	case "/api/view/snapshot":
+		h.HandleSnapshotStatus(w, r)
+	case "/api/view/release":
+		h.HandleReleaseSnapshot(w, r)
+	case "/main/export":
+		h.HandleExportTable(w, r)
+	case "/api/table/aggregate":
+		h.HandleColumnAggregate(w, r)
+	case "/api/table/group-by":
+		h.HandleGroupBy(w, r)
+	case "/api/table/histogram":
+		h.HandleHistogram(w, r)
+	case "/api/table/duplicates":
+		h.HandleDuplicateRows(w, r)
+	case "/api/table/quality":
+		h.HandleDataQuality(w, r)
+	case "/api/table/sizes":
+		h.HandleTableSizes(w, r)
+	case "/api/table/stats":
+		h.HandleTableStats(w, r)
+	case "/api/table/orphans":
+		h.HandleReferentialIntegrity(w, r)
+	case "/api/table/fk-lookup":
+		h.HandleForeignKeyLookup(w, r)
+	case "/api/table/json-cell":
+		h.HandleJSONCell(w, r)
+	case "/api/table/cell-content":
+		h.HandleCellContent(w, r)
+	case "/api/table/spatial-cell":
+		h.HandleSpatialCell(w, r)
+	case "/api/table/row":
+		h.HandleRowDetail(w, r)
+	case "/api/table/snapshots":
+		h.HandleTableSnapshots(w, r)
+	case "/api/table/snapshots/compare":
+		h.HandleCompareTableSnapshot(w, r)
+	case "/api/table/snapshots/delete":
+		h.HandleDeleteTableSnapshot(w, r)
+	case "/api/table/join/suggest":
+		h.HandleSuggestJoin(w, r)
+	case "/main/join":
+		h.HandleJoinView(w, r)
+	case "/api/table/import":
+		h.HandleImportTable(w, r)
+	case "/api/table/as-of":
+		h.HandleTableAsOf(w, r)
+	case "/api/table/row-history":
+		h.HandleRowTimeline(w, r)
 	default:
 		http.NotFound(w, r)
 	}
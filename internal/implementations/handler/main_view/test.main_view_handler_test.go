@@ -14,8 +14,10 @@ func TestMainViewHandler(t *testing.T) {
 		dataViewUC usecase.DataViewUseCase,
 		authUC usecase.AuthenticationUseCase,
 		rbacUC usecase.RBACUseCase,
+		importUC usecase.ImportUseCase,
+		tableViewUC usecase.TableViewUseCase,
 	) handler.MainViewHandler {
-		return main_view.NewMainViewHandlerImplementation(dataViewUC, authUC, rbacUC)
+		return main_view.NewMainViewHandlerImplementation(dataViewUC, authUC, rbacUC, importUC, tableViewUC)
 	}
 
 	handlerTestRunner.MainViewHandlerRunner(t, constructor)
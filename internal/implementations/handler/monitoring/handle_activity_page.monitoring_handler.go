@@ -0,0 +1,161 @@
+package monitoring
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleActivityPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	snapshot, err := h.monitoringUC.GetActivity(r.Context(), session.Username)
+	if err != nil {
+		writeMonitoringError(w, err, "reading activity")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderActivityPage(w, snapshot)
+}
+
+func (h *MonitoringHandlerImplementation) renderActivityPage(w http.ResponseWriter, snapshot *domain.ActivitySnapshot) {
+	var page strings.Builder
+
+	page.WriteString(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Server Activity</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%%; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; vertical-align: top; }
+		th { background: #f0f0f0; }
+		td.query { font-family: monospace; white-space: pre-wrap; max-width: 480px; }
+		.waiting { color: #b35900; }
+	</style>
+</head>
+<body>
+	<h1>Server Activity</h1>
+	<p>As of <span id="taken-at">%s</span>, refreshed every %d seconds</p>
+	<table id="activity-table" data-can-terminate="%t">
+		<thead>
+			<tr><th>PID</th><th>Database</th><th>User</th><th>Client</th><th>State</th><th>Duration</th><th>Waiting On</th><th>Query</th><th></th></tr>
+		</thead>
+		<tbody>`, snapshot.TakenAt.Format(time.RFC3339), domain.LiveRefreshMinInterval, snapshot.CanTerminate))
+
+	for _, backend := range snapshot.Backends {
+		waiting := "-"
+		if backend.WaitEventType != "" {
+			waiting = backend.WaitEventType + ": " + backend.WaitEvent
+		}
+		client := backend.ClientAddr
+		if backend.ApplicationName != "" {
+			client = strings.TrimSpace(backend.ApplicationName + " " + client)
+		}
+		terminate := ""
+		if snapshot.CanTerminate {
+			terminate = `<button class="terminate-backend" onclick="terminateBackend(this)">Terminate</button>`
+		}
+		page.WriteString(fmt.Sprintf(`
+			<tr data-pid="%d"><td>%d</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td class="waiting">%s</td><td class="query">%s</td><td>%s</td></tr>`,
+			backend.PID,
+			backend.PID,
+			html.EscapeString(backend.Database),
+			html.EscapeString(backend.Username),
+			html.EscapeString(client),
+			html.EscapeString(backend.State),
+			backend.Duration.Round(time.Second).String(),
+			html.EscapeString(waiting),
+			html.EscapeString(backend.Query),
+			terminate,
+		))
+	}
+
+	page.WriteString(fmt.Sprintf(`
+		</tbody>
+	</table>
+	<script>
+		const table = document.getElementById('activity-table');
+
+		function cell(row, text, className) {
+			const td = row.insertCell();
+			td.textContent = text;
+			if (className) {
+				td.className = className;
+			}
+		}
+
+		function renderActivity(activity) {
+			document.getElementById('taken-at').textContent = activity.taken_at;
+			const body = table.tBodies[0];
+			body.replaceChildren();
+			activity.backends.forEach(backend => {
+				const row = body.insertRow();
+				row.dataset.pid = backend.pid;
+				cell(row, backend.pid);
+				cell(row, backend.database);
+				cell(row, backend.username);
+				cell(row, (backend.application_name + ' ' + backend.client_addr).trim());
+				cell(row, backend.state);
+				cell(row, Math.round(backend.duration_seconds) + 's');
+				cell(row, backend.wait_event_type ? backend.wait_event_type + ': ' + backend.wait_event : '-', 'waiting');
+				cell(row, backend.query, 'query');
+				const actions = row.insertCell();
+				if (activity.can_terminate) {
+					const button = document.createElement('button');
+					button.className = 'terminate-backend';
+					button.textContent = 'Terminate';
+					button.onclick = () => terminateBackend(button);
+					actions.appendChild(button);
+				}
+			});
+		}
+
+		function refreshActivity() {
+			fetch('/api/admin/activity')
+				.then(response => response.ok ? response.json() : Promise.reject(new Error(response.statusText)))
+				.then(renderActivity)
+				.catch(() => {});
+		}
+
+		function terminateBackend(button) {
+			const row = button.closest('tr');
+			if (!confirm('Terminate backend ' + row.dataset.pid + '? Its open transaction will be rolled back.')) {
+				return;
+			}
+			const body = new URLSearchParams({ pid: row.dataset.pid });
+			fetch('/api/admin/activity/terminate', { method: 'POST', body: body })
+				.then(response => {
+					if (!response.ok) {
+						return response.text().then(text => { throw new Error(text); });
+					}
+					row.remove();
+				})
+				.catch(err => alert('Failed to terminate backend: ' + err.message));
+		}
+
+		setInterval(refreshActivity, %d * 1000);
+	</script>
+</body>
+</html>`, domain.LiveRefreshMinInterval))
+
+	w.Write([]byte(page.String()))
+}
@@ -0,0 +1,65 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleListActivity(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	snapshot, err := h.monitoringUC.GetActivity(r.Context(), session.Username)
+	if err != nil {
+		writeMonitoringError(w, err, "reading activity")
+		return
+	}
+
+	backends := make([]map[string]interface{}, 0, len(snapshot.Backends))
+	for _, backend := range snapshot.Backends {
+		backends = append(backends, backendSummary(backend))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"taken_at":      snapshot.TakenAt.Format(time.RFC3339),
+		"can_terminate": snapshot.CanTerminate,
+		"backends":      backends,
+	})
+}
+
+// backendSummary is the listed view of a backend
+func backendSummary(backend domain.BackendActivity) map[string]interface{} {
+	summary := map[string]interface{}{
+		"pid":              backend.PID,
+		"database":         backend.Database,
+		"username":         backend.Username,
+		"application_name": backend.ApplicationName,
+		"client_addr":      backend.ClientAddr,
+		"state":            backend.State,
+		"query":            backend.Query,
+		"query_start":      nil,
+		"duration_seconds": backend.Duration.Seconds(),
+		"wait_event_type":  backend.WaitEventType,
+		"wait_event":       backend.WaitEvent,
+	}
+	if !backend.QueryStart.IsZero() {
+		summary["query_start"] = backend.QueryStart.Format(time.RFC3339)
+	}
+	return summary
+}
@@ -0,0 +1,65 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleListLocks(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	report, err := h.locksUC.GetBlockingChains(r.Context(), session.Username)
+	if err != nil {
+		writeMonitoringError(w, err, "reading locks")
+		return
+	}
+
+	chains := make([]map[string]interface{}, 0, len(report.Chains))
+	for _, chain := range report.Chains {
+		chains = append(chains, blockingChainSummary(chain))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"taken_at":      report.TakenAt.Format(time.RFC3339),
+		"can_terminate": report.CanTerminate,
+		"chains":        chains,
+	})
+}
+
+// blockingChainSummary is the listed view of a blocking chain
+func blockingChainSummary(chain domain.BlockingChain) map[string]interface{} {
+	waiters := make([]map[string]interface{}, 0, len(chain.Waiters))
+	for _, waiter := range chain.Waiters {
+		waiters = append(waiters, map[string]interface{}{
+			"backend":    backendSummary(waiter.Backend),
+			"blocked_by": waiter.BlockedBy,
+			"depth":      waiter.Depth,
+			"lock_type":  waiter.LockType,
+			"mode":       waiter.Mode,
+			"relation":   waiter.Relation,
+		})
+	}
+
+	return map[string]interface{}{
+		"database": chain.Database,
+		"root":     backendSummary(chain.Root),
+		"waiters":  waiters,
+	}
+}
@@ -0,0 +1,90 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleListSlowOperations(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	limit, err := parseSlowOperationLimit(r)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow operations")
+		return
+	}
+
+	operations, err := h.monitoringUC.ListSlowOperations(r.Context(), session.Username, limit)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow operations")
+		return
+	}
+
+	summaries := make([]map[string]interface{}, 0, len(operations))
+	for _, operation := range operations {
+		summaries = append(summaries, slowOperationSummary(operation))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"operations": summaries,
+	})
+}
+
+// parseSlowOperationLimit reads the optional limit parameter; zero lists every kept operation
+func parseSlowOperationLimit(r *http.Request) (int, error) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return 0, domain.ValidationError{Field: "limit", Message: fmt.Sprintf("invalid limit: %s", value)}
+	}
+	return limit, nil
+}
+
+// slowOperationSummary is the listed view of a slow operation
+func slowOperationSummary(operation domain.SlowOperation) map[string]interface{} {
+	suggestions := make([]map[string]interface{}, 0, len(operation.Suggestions))
+	for _, suggestion := range operation.Suggestions {
+		suggestions = append(suggestions, map[string]interface{}{
+			"schema":    suggestion.Schema,
+			"table":     suggestion.Table,
+			"columns":   suggestion.Columns,
+			"statement": suggestion.Statement,
+			"reason":    suggestion.Reason,
+		})
+	}
+
+	return map[string]interface{}{
+		"id":               operation.ID,
+		"username":         operation.Username,
+		"operation":        operation.Operation,
+		"database":         operation.Database,
+		"schema":           operation.Schema,
+		"table":            operation.Table,
+		"where_clause":     operation.WhereClause,
+		"duration_seconds": operation.Duration.Seconds(),
+		"suggestions":      suggestions,
+		"recorded_at":      operation.RecordedAt.Format(time.RFC3339),
+	}
+}
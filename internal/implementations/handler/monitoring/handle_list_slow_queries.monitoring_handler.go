@@ -0,0 +1,61 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleListSlowQueries(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	limit, err := parseSlowOperationLimit(r)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow queries")
+		return
+	}
+
+	queries, err := h.monitoringUC.ListSlowQueries(r.Context(), session.Username, limit)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow queries")
+		return
+	}
+
+	summaries := make([]map[string]interface{}, 0, len(queries))
+	for _, query := range queries {
+		summaries = append(summaries, slowQuerySummary(query))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"queries": summaries,
+	})
+}
+
+// slowQuerySummary is the listed view of a slow query; plan is null when it could not be explained
+func slowQuerySummary(query domain.SlowQuery) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               query.ID,
+		"username":         query.Username,
+		"statement":        query.Statement,
+		"duration_seconds": query.Duration.Seconds(),
+		"error":            query.Error,
+		"plan":             query.Plan,
+		"recorded_at":      query.RecordedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,155 @@
+package monitoring
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleLocksPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	report, err := h.locksUC.GetBlockingChains(r.Context(), session.Username)
+	if err != nil {
+		writeMonitoringError(w, err, "reading locks")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderLocksPage(w, report)
+}
+
+func (h *MonitoringHandlerImplementation) renderLocksPage(w http.ResponseWriter, report *domain.LockReport) {
+	var page strings.Builder
+
+	page.WriteString(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Locks</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%%; margin-bottom: 24px; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; vertical-align: top; }
+		th { background: #f0f0f0; }
+		td.query { font-family: monospace; white-space: pre-wrap; max-width: 480px; }
+		tr.holder { background: #fff3e0; }
+	</style>
+</head>
+<body>
+	<h1>Locks</h1>
+	<p>As of <span id="taken-at">%s</span>, refreshed every %d seconds. Each table starts with the backend holding the lock; the backends below it wait, directly or behind another waiter.</p>
+	<div id="chains" data-can-terminate="%t">`, report.TakenAt.Format(time.RFC3339), domain.LiveRefreshMinInterval, report.CanTerminate))
+
+	if len(report.Chains) == 0 {
+		page.WriteString(`
+		<p class="no-chains">No backend is waiting on a lock</p>`)
+	}
+
+	for _, chain := range report.Chains {
+		terminate := ""
+		if report.CanTerminate {
+			terminate = `<button class="terminate-backend" onclick="terminateBackend(this)">Terminate</button>`
+		}
+		page.WriteString(fmt.Sprintf(`
+		<h2>%s</h2>
+		<table class="chain">
+			<thead>
+				<tr><th>PID</th><th>User</th><th>State</th><th>Duration</th><th>Waits On</th><th>Lock</th><th>Query</th><th></th></tr>
+			</thead>
+			<tbody>
+				<tr class="holder" data-pid="%d"><td>%d</td><td>%s</td><td>%s</td><td>%s</td><td>-</td><td>holds</td><td class="query">%s</td><td>%s</td></tr>`,
+			html.EscapeString(chain.Database),
+			chain.Root.PID,
+			chain.Root.PID,
+			html.EscapeString(chain.Root.Username),
+			html.EscapeString(chain.Root.State),
+			chain.Root.Duration.Round(time.Second).String(),
+			html.EscapeString(chain.Root.Query),
+			terminate,
+		))
+
+		for _, waiter := range chain.Waiters {
+			page.WriteString(fmt.Sprintf(`
+				<tr data-pid="%d"><td style="padding-left: %dpx">%d</td><td>%s</td><td>%s</td><td>%s</td><td>%d</td><td>%s</td><td class="query">%s</td><td></td></tr>`,
+				waiter.Backend.PID,
+				10+waiter.Depth*16,
+				waiter.Backend.PID,
+				html.EscapeString(waiter.Backend.Username),
+				html.EscapeString(waiter.Backend.State),
+				waiter.Backend.Duration.Round(time.Second).String(),
+				waiter.BlockedBy,
+				html.EscapeString(lockDescription(waiter)),
+				html.EscapeString(waiter.Backend.Query),
+			))
+		}
+
+		page.WriteString(`
+			</tbody>
+		</table>`)
+	}
+
+	page.WriteString(fmt.Sprintf(`
+	</div>
+	<script>
+		function refreshLocks() {
+			fetch('/admin/locks')
+				.then(response => response.ok ? response.text() : Promise.reject(new Error(response.statusText)))
+				.then(text => {
+					const fresh = new DOMParser().parseFromString(text, 'text/html');
+					document.getElementById('taken-at').replaceWith(fresh.getElementById('taken-at'));
+					document.getElementById('chains').replaceWith(fresh.getElementById('chains'));
+				})
+				.catch(() => {});
+		}
+
+		function terminateBackend(button) {
+			const pid = button.closest('tr').dataset.pid;
+			if (!confirm('Terminate backend ' + pid + '? Its open transaction will be rolled back, releasing its locks.')) {
+				return;
+			}
+			const body = new URLSearchParams({ pid: pid });
+			fetch('/api/admin/activity/terminate', { method: 'POST', body: body })
+				.then(response => {
+					if (!response.ok) {
+						return response.text().then(text => { throw new Error(text); });
+					}
+					refreshLocks();
+				})
+				.catch(err => alert('Failed to terminate backend: ' + err.message));
+		}
+
+		setInterval(refreshLocks, %d * 1000);
+	</script>
+</body>
+</html>`, domain.LiveRefreshMinInterval))
+
+	w.Write([]byte(page.String()))
+}
+
+// lockDescription says what a waiter asked for, such as "RowExclusiveLock on orders"
+func lockDescription(waiter domain.BlockedBackend) string {
+	description := waiter.Mode
+	if waiter.Relation != "" {
+		description += " on " + waiter.Relation
+	} else if waiter.LockType != "" {
+		description += " on " + waiter.LockType
+	}
+	return strings.TrimSpace(description)
+}
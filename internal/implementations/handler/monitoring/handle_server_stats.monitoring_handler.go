@@ -0,0 +1,72 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleServerStats(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	dashboard, err := h.monitoringUC.GetServerDashboard(r.Context(), session.Username)
+	if err != nil {
+		writeMonitoringError(w, err, "reading server statistics")
+		return
+	}
+
+	databases := make([]map[string]interface{}, 0, len(dashboard.Databases))
+	for _, row := range dashboard.Databases {
+		databases = append(databases, map[string]interface{}{
+			"name":                    row.Stats.Name,
+			"connections":             row.Stats.Connections,
+			"xact_commit":             row.Stats.XactCommit,
+			"xact_rollback":           row.Stats.XactRollback,
+			"deadlocks":               row.Stats.Deadlocks,
+			"size_bytes":              row.Stats.SizeBytes,
+			"transactions_per_second": row.TransactionsPerSecond,
+			"cache_hit_ratio":         row.CacheHitRatio,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"taken_at":                dashboard.TakenAt.Format(time.RFC3339),
+		"total_connections":       dashboard.TotalConnections,
+		"max_connections":         dashboard.MaxConnections,
+		"total_size_bytes":        dashboard.TotalSizeBytes,
+		"transactions_per_second": dashboard.TransactionsPerSecond,
+		"cache_hit_ratio":         dashboard.CacheHitRatio,
+		"background_writer":       backgroundWriterSummary(dashboard.BackgroundWriter),
+		"databases":               databases,
+	})
+}
+
+// backgroundWriterSummary is the listed view of the background writer counters
+func backgroundWriterSummary(stats domain.BackgroundWriterStats) map[string]interface{} {
+	summary := map[string]interface{}{
+		"buffers_clean":    stats.BuffersClean,
+		"maxwritten_clean": stats.MaxWrittenClean,
+		"buffers_alloc":    stats.BuffersAlloc,
+		"stats_reset":      nil,
+	}
+	if !stats.StatsReset.IsZero() {
+		summary["stats_reset"] = stats.StatsReset.Format(time.RFC3339)
+	}
+	return summary
+}
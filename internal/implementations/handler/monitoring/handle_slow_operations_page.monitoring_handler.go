@@ -0,0 +1,109 @@
+package monitoring
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleSlowOperationsPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	limit, err := parseSlowOperationLimit(r)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow operations")
+		return
+	}
+
+	operations, err := h.monitoringUC.ListSlowOperations(r.Context(), session.Username, limit)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow operations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderSlowOperationsPage(w, operations)
+}
+
+func (h *MonitoringHandlerImplementation) renderSlowOperationsPage(w http.ResponseWriter, operations []domain.SlowOperation) {
+	var page strings.Builder
+
+	page.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Slow Operations</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; vertical-align: top; }
+		th { background: #f0f0f0; }
+		td.filter, code { font-family: monospace; white-space: pre-wrap; }
+		.suggestion { margin-bottom: 6px; }
+		.reason { color: #666; font-size: 0.9em; }
+	</style>
+</head>
+<body>
+	<h1>Slow Operations</h1>
+	<p>Index suggestions are drawn from the query plan and are not binding; weigh each against the table's writes before creating it.</p>
+	<table id="slow-operations-table">
+		<thead>
+			<tr><th>Recorded</th><th>User</th><th>Database</th><th>Table</th><th>Filter</th><th>Duration</th><th>Suggestions</th></tr>
+		</thead>
+		<tbody>`)
+
+	if len(operations) == 0 {
+		page.WriteString(`
+			<tr><td colspan="7">No slow operations recorded</td></tr>`)
+	}
+
+	for _, operation := range operations {
+		var suggestions strings.Builder
+		for _, suggestion := range operation.Suggestions {
+			suggestions.WriteString(fmt.Sprintf(`<div class="suggestion">An index on (%s) might help: <code>%s</code><div class="reason">%s</div></div>`,
+				html.EscapeString(strings.Join(suggestion.Columns, ", ")),
+				html.EscapeString(suggestion.Statement),
+				html.EscapeString(suggestion.Reason),
+			))
+		}
+		if len(operation.Suggestions) == 0 {
+			suggestions.WriteString("-")
+		}
+
+		page.WriteString(fmt.Sprintf(`
+			<tr data-id="%s"><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td class="filter">%s</td><td>%s</td><td>%s</td></tr>`,
+			html.EscapeString(operation.ID),
+			operation.RecordedAt.Format(time.RFC3339),
+			html.EscapeString(operation.Username),
+			html.EscapeString(operation.Database),
+			html.EscapeString(operation.Schema+"."+operation.Table),
+			html.EscapeString(operation.WhereClause),
+			operation.Duration.Round(time.Millisecond).String(),
+			suggestions.String(),
+		))
+	}
+
+	page.WriteString(`
+		</tbody>
+	</table>
+</body>
+</html>`)
+
+	w.Write([]byte(page.String()))
+}
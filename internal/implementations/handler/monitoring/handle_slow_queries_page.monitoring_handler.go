@@ -0,0 +1,128 @@
+package monitoring
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleSlowQueriesPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	limit, err := parseSlowOperationLimit(r)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow queries")
+		return
+	}
+
+	queries, err := h.monitoringUC.ListSlowQueries(r.Context(), session.Username, limit)
+	if err != nil {
+		writeMonitoringError(w, err, "reading slow queries")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderSlowQueriesPage(w, queries)
+}
+
+func (h *MonitoringHandlerImplementation) renderSlowQueriesPage(w http.ResponseWriter, queries []domain.SlowQuery) {
+	var page strings.Builder
+
+	page.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Slow Queries</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; vertical-align: top; }
+		th { background: #f0f0f0; }
+		td.statement, pre { font-family: monospace; white-space: pre-wrap; margin: 0; }
+		.error { color: #b00; }
+	</style>
+</head>
+<body>
+	<h1>Slow Queries</h1>
+	<p>Constants are shown as $n placeholders. Plans are estimates taken after the query ran and may differ from the run that was slow.</p>
+	<table id="slow-queries-table">
+		<thead>
+			<tr><th>Recorded</th><th>User</th><th>Statement</th><th>Duration</th><th>Plan</th></tr>
+		</thead>
+		<tbody>`)
+
+	if len(queries) == 0 {
+		page.WriteString(`
+			<tr><td colspan="5">No slow queries recorded</td></tr>`)
+	}
+
+	for _, query := range queries {
+		statement := html.EscapeString(query.Statement)
+		if query.Error != "" {
+			statement += fmt.Sprintf(`<div class="error">%s</div>`, html.EscapeString(query.Error))
+		}
+
+		plan := "-"
+		if query.Plan != nil {
+			var tree strings.Builder
+			writePlanTree(&tree, query.Plan.Root, 0)
+			plan = "<pre>" + html.EscapeString(tree.String()) + "</pre>"
+		}
+
+		page.WriteString(fmt.Sprintf(`
+			<tr data-id="%s"><td>%s</td><td>%s</td><td class="statement">%s</td><td>%s</td><td>%s</td></tr>`,
+			html.EscapeString(query.ID),
+			query.RecordedAt.Format(time.RFC3339),
+			html.EscapeString(query.Username),
+			statement,
+			query.Duration.Round(time.Millisecond).String(),
+			plan,
+		))
+	}
+
+	page.WriteString(`
+		</tbody>
+	</table>
+</body>
+</html>`)
+
+	w.Write([]byte(page.String()))
+}
+
+// writePlanTree writes a plan node and the nodes below it as indented lines in the style of EXPLAIN's
+// text output, such as "Seq Scan on orders  (cost=0.00..35.50 rows=2550 width=4)"
+func writePlanTree(tree *strings.Builder, node domain.QueryPlanNode, depth int) {
+	if depth > 0 {
+		tree.WriteString(strings.Repeat("  ", depth-1) + "-> ")
+	}
+	tree.WriteString(node.NodeType)
+	if node.RelationName != "" {
+		tree.WriteString(" on " + node.RelationName)
+		if node.Alias != "" && node.Alias != node.RelationName {
+			tree.WriteString(" " + node.Alias)
+		}
+	}
+	tree.WriteString(fmt.Sprintf("  (cost=%.2f..%.2f rows=%.0f width=%d)\n", node.StartupCost, node.TotalCost, node.PlanRows, node.PlanWidth))
+	if filter, ok := node.Details["Filter"].(string); ok {
+		tree.WriteString(strings.Repeat("  ", depth+1) + "Filter: " + filter + "\n")
+	}
+	for _, child := range node.Children {
+		writePlanTree(tree, child, depth+1)
+	}
+}
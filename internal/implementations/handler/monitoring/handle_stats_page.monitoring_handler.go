@@ -0,0 +1,209 @@
+package monitoring
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *MonitoringHandlerImplementation) HandleStatsPage(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Redirect(w, r, "/login", http.StatusFound)
+		return
+	}
+
+	dashboard, err := h.monitoringUC.GetServerDashboard(r.Context(), session.Username)
+	if err != nil {
+		writeMonitoringError(w, err, "reading server statistics")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	h.renderStatsPage(w, dashboard)
+}
+
+func (h *MonitoringHandlerImplementation) renderStatsPage(w http.ResponseWriter, dashboard *domain.ServerDashboard) {
+	var page strings.Builder
+
+	page.WriteString(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Server Statistics</title>
+	<style>
+		body { font-family: Arial, sans-serif; margin: 0; padding: 20px; }
+		table { border-collapse: collapse; width: 100%%; }
+		th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; }
+		th { background: #f0f0f0; }
+		td.number { text-align: right; }
+		.tiles { display: flex; gap: 12px; margin-bottom: 20px; }
+		.tile { border: 1px solid #ccc; padding: 10px 16px; min-width: 160px; }
+		.tile .value { font-size: 1.6em; }
+	</style>
+</head>
+<body>
+	<h1>Server Statistics</h1>
+	<p>As of <span id="taken-at">%s</span>, refreshed every %d seconds. Transaction rates are measured since the previous refresh.</p>
+	<div class="tiles">
+		<div class="tile">Transactions/sec<div class="value" id="transactions-per-second">%s</div></div>
+		<div class="tile">Cache hit ratio<div class="value" id="cache-hit-ratio">%s</div></div>
+		<div class="tile">Connections<div class="value" id="connections">%d / %d</div></div>
+		<div class="tile">Disk usage<div class="value" id="total-size">%s</div></div>
+	</div>
+	<p id="background-writer">%s</p>
+	<table id="stats-table">
+		<thead>
+			<tr><th>Database</th><th>Connections</th><th>Transactions/sec</th><th>Commits</th><th>Rollbacks</th><th>Deadlocks</th><th>Cache Hit Ratio</th><th>Size</th></tr>
+		</thead>
+		<tbody>`,
+		dashboard.TakenAt.Format(time.RFC3339),
+		domain.LiveRefreshMinInterval,
+		formatRate(dashboard.TransactionsPerSecond),
+		formatRatio(dashboard.CacheHitRatio),
+		dashboard.TotalConnections,
+		dashboard.MaxConnections,
+		formatSize(&dashboard.TotalSizeBytes),
+		html.EscapeString(backgroundWriterDescription(dashboard.BackgroundWriter)),
+	))
+
+	for _, row := range dashboard.Databases {
+		page.WriteString(fmt.Sprintf(`
+			<tr><td>%s</td><td class="number">%d</td><td class="number">%s</td><td class="number">%d</td><td class="number">%d</td><td class="number">%d</td><td class="number">%s</td><td class="number">%s</td></tr>`,
+			html.EscapeString(row.Stats.Name),
+			row.Stats.Connections,
+			formatRate(row.TransactionsPerSecond),
+			row.Stats.XactCommit,
+			row.Stats.XactRollback,
+			row.Stats.Deadlocks,
+			formatRatio(row.CacheHitRatio),
+			formatSize(row.Stats.SizeBytes),
+		))
+	}
+
+	page.WriteString(fmt.Sprintf(`
+		</tbody>
+	</table>
+	<script>
+		const table = document.getElementById('stats-table');
+
+		function formatRate(rate) {
+			return rate === null ? '-' : rate.toFixed(1);
+		}
+
+		function formatRatio(ratio) {
+			return ratio === null ? '-' : (ratio * 100).toFixed(2) + '%%';
+		}
+
+		function formatSize(bytes) {
+			if (bytes === null) {
+				return '-';
+			}
+			const units = ['B', 'KiB', 'MiB', 'GiB', 'TiB'];
+			let unit = 0;
+			while (bytes >= 1024 && unit < units.length - 1) {
+				bytes /= 1024;
+				unit++;
+			}
+			return (unit === 0 ? bytes : bytes.toFixed(1)) + ' ' + units[unit];
+		}
+
+		function cell(row, text) {
+			const td = row.insertCell();
+			td.textContent = text;
+			return td;
+		}
+
+		function renderStats(stats) {
+			document.getElementById('taken-at').textContent = stats.taken_at;
+			document.getElementById('transactions-per-second').textContent = formatRate(stats.transactions_per_second);
+			document.getElementById('cache-hit-ratio').textContent = formatRatio(stats.cache_hit_ratio);
+			document.getElementById('connections').textContent = stats.total_connections + ' / ' + stats.max_connections;
+			document.getElementById('total-size').textContent = formatSize(stats.total_size_bytes);
+			const body = table.tBodies[0];
+			body.replaceChildren();
+			stats.databases.forEach(database => {
+				const row = body.insertRow();
+				cell(row, database.name);
+				[
+					database.connections,
+					formatRate(database.transactions_per_second),
+					database.xact_commit,
+					database.xact_rollback,
+					database.deadlocks,
+					formatRatio(database.cache_hit_ratio),
+					formatSize(database.size_bytes),
+				].forEach(value => { cell(row, value).className = 'number'; });
+			});
+		}
+
+		function refreshStats() {
+			fetch('/api/admin/stats')
+				.then(response => response.ok ? response.json() : Promise.reject(new Error(response.statusText)))
+				.then(renderStats)
+				.catch(() => {});
+		}
+
+		setInterval(refreshStats, %d * 1000);
+	</script>
+</body>
+</html>`, domain.LiveRefreshMinInterval))
+
+	w.Write([]byte(page.String()))
+}
+
+// formatRate renders a per-second rate, or "-" when it could not be measured
+func formatRate(rate *float64) string {
+	if rate == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", *rate)
+}
+
+// formatRatio renders a ratio as a percentage, or "-" when there is none
+func formatRatio(ratio *float64) string {
+	if ratio == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", *ratio*100)
+}
+
+// formatSize renders a byte count in binary units, such as "8.0 MiB", or "-" when unknown
+func formatSize(bytes *int64) string {
+	if bytes == nil {
+		return "-"
+	}
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	size := float64(*bytes)
+	unit := 0
+	for size >= 1024 && unit < len(units)-1 {
+		size /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%d B", *bytes)
+	}
+	return fmt.Sprintf("%.1f %s", size, units[unit])
+}
+
+// backgroundWriterDescription summarizes the background writer's counters since they were last reset
+func backgroundWriterDescription(stats domain.BackgroundWriterStats) string {
+	description := fmt.Sprintf("Background writer: %d buffers cleaned, stopped %d times for writing too many, %d buffers allocated",
+		stats.BuffersClean, stats.MaxWrittenClean, stats.BuffersAlloc)
+	if !stats.StatsReset.IsZero() {
+		description += " since " + stats.StatsReset.Format(time.RFC3339)
+	}
+	return description
+}
@@ -0,0 +1,51 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+func (h *MonitoringHandlerImplementation) HandleTerminateBackend(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.FormValue("pid"))
+	if err != nil || pid <= 0 {
+		http.Error(w, "Invalid pid parameter", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.monitoringUC.TerminateBackend(r.Context(), session.Username, pid); err != nil {
+		writeMonitoringError(w, err, "terminating backend")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"terminated": pid,
+	})
+}
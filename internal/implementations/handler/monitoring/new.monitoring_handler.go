@@ -0,0 +1,24 @@
+package monitoring
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type MonitoringHandlerImplementation struct {
+	monitoringUC usecase.MonitoringUseCase
+	locksUC      usecase.LocksUseCase
+	authUC       usecase.AuthenticationUseCase
+}
+
+func NewMonitoringHandlerImplementation(
+	monitoringUC usecase.MonitoringUseCase,
+	locksUC usecase.LocksUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.MonitoringHandler {
+	return &MonitoringHandlerImplementation{
+		monitoringUC: monitoringUC,
+		locksUC:      locksUC,
+		authUC:       authUC,
+	}
+}
@@ -0,0 +1,32 @@
+package monitoring
+
+import "net/http"
+
+func (h *MonitoringHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/admin/activity":
+		h.HandleActivityPage(w, r)
+	case "/api/admin/activity":
+		h.HandleListActivity(w, r)
+	case "/api/admin/activity/terminate":
+		h.HandleTerminateBackend(w, r)
+	case "/admin/stats":
+		h.HandleStatsPage(w, r)
+	case "/api/admin/stats":
+		h.HandleServerStats(w, r)
+	case "/admin/locks":
+		h.HandleLocksPage(w, r)
+	case "/api/admin/locks":
+		h.HandleListLocks(w, r)
+	case "/admin/slow-operations":
+		h.HandleSlowOperationsPage(w, r)
+	case "/api/admin/slow-operations":
+		h.HandleListSlowOperations(w, r)
+	case "/admin/slow-queries":
+		h.HandleSlowQueriesPage(w, r)
+	case "/api/admin/slow-queries":
+		h.HandleListSlowQueries(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,22 @@
+package monitoring_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/monitoring"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestMonitoringHandler(t *testing.T) {
+	constructor := func(
+		monitoringUC usecase.MonitoringUseCase,
+		locksUC usecase.LocksUseCase,
+		authUC usecase.AuthenticationUseCase,
+	) handler.MonitoringHandler {
+		return monitoring.NewMonitoringHandlerImplementation(monitoringUC, locksUC, authUC)
+	}
+
+	handlerTestRunner.MonitoringHandlerRunner(t, constructor)
+}
@@ -0,0 +1,24 @@
+package monitoring
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeMonitoringError maps a monitoring usecase error onto its HTTP status
+func writeMonitoringError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrBackendNotFound):
+		http.Error(w, "Backend not found", http.StatusNotFound)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,38 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleCancelQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Cancel whatever query the user is currently running
+	cancelled, err := h.queryUC.CancelQuery(r.Context(), session.Username)
+	if err != nil {
+		http.Error(w, "Error cancelling query: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]bool{"cancelled": cancelled})
+}
@@ -0,0 +1,74 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// notebookRequest is the JSON body creating or updating a notebook
+type notebookRequest struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Cells []struct {
+		ID     string `json:"id"`
+		Kind   string `json:"kind"`
+		Source string `json:"source"`
+	} `json:"cells"`
+}
+
+func (r notebookRequest) notebookCells() []domain.NotebookCell {
+	cells := make([]domain.NotebookCell, len(r.Cells))
+	for i, cell := range r.Cells {
+		cells[i] = domain.NotebookCell{ID: cell.ID, Kind: cell.Kind, Source: cell.Source}
+	}
+	return cells
+}
+
+func (h *QueryEditorHandlerImplementation) HandleCreateNotebook(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request notebookRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	notebook, err := h.notebookUC.CreateNotebook(r.Context(), session.Username, request.Title, request.notebookCells())
+	if err != nil {
+		writeNotebookError(w, "Error creating notebook: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(notebook)
+}
+
+// writeNotebookError maps query notebook errors to HTTP responses
+func writeNotebookError(w http.ResponseWriter, prefix string, err error) {
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		http.Error(w, validationErr.Message, http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, domain.ErrNotebookNotFound) {
+		http.Error(w, "Notebook not found", http.StatusNotFound)
+		return
+	}
+	http.Error(w, prefix+err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,64 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleCreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	saved, err := h.savedQueryUC.SaveQuery(r.Context(), session.Username, r.FormValue("name"), r.FormValue("query"), parseTags(r.FormValue("tags")))
+	if err != nil {
+		writeSavedQueryError(w, "Error saving query: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(saved)
+}
+
+// parseTags splits a comma-separated tag list
+func parseTags(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// writeSavedQueryError maps saved query errors to HTTP responses
+func writeSavedQueryError(w http.ResponseWriter, prefix string, err error) {
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		http.Error(w, validationErr.Message, http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, domain.ErrSavedQueryNotFound) {
+		http.Error(w, "Saved query not found", http.StatusNotFound)
+		return
+	}
+	http.Error(w, prefix+err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,40 @@
+package query_editor
+
+import (
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleDeleteNotebook(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := r.FormValue("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.notebookUC.DeleteNotebook(r.Context(), session.Username, id); err != nil {
+		writeNotebookError(w, "Error deleting notebook: ", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -55,7 +55,7 @@ func (h *QueryEditorHandlerImplementation) HandleExecuteMultipleQueries(w http.R
 		}
 
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte("<div class='error'>" + err.Error() + "</div>"))
+		w.Write([]byte(queryErrorHTML(err)))
 		return
 	}
 
@@ -2,7 +2,9 @@ package query_editor
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"net/http"
 	"strconv"
 	"strings"
@@ -50,10 +52,17 @@ func (h *QueryEditorHandlerImplementation) HandleExecuteQuery(w http.ResponseWri
 
 	// Execute query with pagination
 	result, err := h.queryUC.ExecuteQueryWithPagination(r.Context(), session.Username, domain.QueryParams{
-		Query:  query,
-		Offset: offset,
-		Limit:  limit,
+		Query:      query,
+		Offset:     offset,
+		Limit:      limit,
+		SearchPath: session.SearchPath,
+		Settings:   session.Settings,
 	})
+	if errors.Is(err, domain.ErrQueryCancelled) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("<div class='warning'>Query cancelled</div>"))
+		return
+	}
 	if err != nil {
 		// Check for validation errors
 		if validationErr, ok := err.(domain.ValidationError); ok {
@@ -70,43 +79,63 @@ func (h *QueryEditorHandlerImplementation) HandleExecuteQuery(w http.ResponseWri
 		}
 
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte("<div class='error'>" + err.Error() + "</div>"))
+		w.Write([]byte(queryErrorHTML(err)))
 		return
 	}
 
 	// Render result
-	h.renderQueryResult(w, result)
+	h.renderQueryResult(w, result, domain.ResultView{})
+}
+
+// queryErrorHTML renders a failed query, with a link to the documentation for the SQLSTATE when
+// PostgreSQL reported one
+func queryErrorHTML(err error) string {
+	var dbErr *domain.DatabaseError
+	if !errors.As(err, &dbErr) {
+		return "<div class='error'>" + err.Error() + "</div>"
+	}
+	return fmt.Sprintf("<div class='error' data-sqlstate='%s'>%s <a class='docs-link' href='%s' target='_blank' rel='noopener' title='%s'>Learn more (SQLSTATE %s)</a></div>",
+		dbErr.SQLState, err.Error(), html.EscapeString(dbErr.DocsURL), dbErr.DocsKey, dbErr.SQLState)
 }
 
-func (h *QueryEditorHandlerImplementation) renderQueryResult(w http.ResponseWriter, result *domain.QueryResult) {
+// renderQueryResult renders a result page; a kept result also gets a quick filter and sortable
+// headers reflecting view
+func (h *QueryEditorHandlerImplementation) renderQueryResult(w http.ResponseWriter, result *domain.QueryResult, view domain.ResultView) {
 	w.Header().Set("Content-Type", "text/html")
 	w.WriteHeader(http.StatusOK)
 
-	var html strings.Builder
+	var page strings.Builder
 
 	// Show pagination info if applicable
 	if result.TotalCount > 1000 {
-		html.WriteString(fmt.Sprintf("<div class='pagination-info'>Data size: %d rows (only first 1000 are accessible)</div>", result.TotalCount))
+		page.WriteString(fmt.Sprintf("<div class='pagination-info'>Data size: %d rows (only first 1000 are accessible)</div>", result.TotalCount))
 	} else if result.TotalCount > 0 {
-		html.WriteString(fmt.Sprintf("<div class='pagination-info'>Data size: %d rows</div>", result.TotalCount))
+		page.WriteString(fmt.Sprintf("<div class='pagination-info'>Data size: %d rows</div>", result.TotalCount))
 	}
 
 	// Check for hard limit
 	if result.RowCount == 0 && result.TotalCount > 1000 {
-		html.WriteString("<div class='warning'>hard limit of 1000 rows reached</div>")
+		page.WriteString("<div class='warning'>hard limit of 1000 rows reached</div>")
 	}
 
 	// Render table if there are columns
 	if len(result.Columns) > 0 {
-		html.WriteString("<table class='query-results'><thead><tr>")
+		if result.ResultID != "" {
+			page.WriteString(resultFilterForm(result.ResultID, view))
+		}
+		page.WriteString("<table class='query-results'><thead><tr>")
 		for _, col := range result.Columns {
-			html.WriteString("<th>" + col + "</th>")
+			if result.ResultID != "" {
+				page.WriteString("<th>" + sortHeaderLink(result.ResultID, col, view) + "</th>")
+				continue
+			}
+			page.WriteString("<th>" + col + "</th>")
 		}
-		html.WriteString("</tr></thead><tbody>")
+		page.WriteString("</tr></thead><tbody>")
 
 		// Render rows
 		for _, row := range result.Rows {
-			html.WriteString("<tr>")
+			page.WriteString("<tr>")
 			for _, col := range result.Columns {
 				value := row[col]
 				var valueStr string
@@ -133,26 +162,26 @@ func (h *QueryEditorHandlerImplementation) renderQueryResult(w http.ResponseWrit
 						}
 					}
 				}
-				html.WriteString("<td>" + valueStr + "</td>")
+				page.WriteString("<td>" + valueStr + "</td>")
 			}
-			html.WriteString("</tr>")
+			page.WriteString("</tr>")
 		}
 
-		html.WriteString("</tbody></table>")
+		page.WriteString("</tbody></table>")
 
 		// Add pagination controls if needed
 		if result.TotalCount > result.RowCount {
-			html.WriteString("<div class='pagination'>")
-			html.WriteString(fmt.Sprintf("<span>Showing %d of %d rows</span>", result.RowCount, result.TotalCount))
-			html.WriteString("</div>")
+			page.WriteString("<div class='pagination'>")
+			page.WriteString(fmt.Sprintf("<span>Showing %d of %d rows</span>", result.RowCount, result.TotalCount))
+			page.WriteString("</div>")
 		}
 	} else if result.RowCount > 0 {
 		// DML query (INSERT, UPDATE, DELETE)
-		html.WriteString(fmt.Sprintf("<div class='success'>Query executed successfully. %d row(s) affected.</div>", result.RowCount))
+		page.WriteString(fmt.Sprintf("<div class='success'>Query executed successfully. %d row(s) affected.</div>", result.RowCount))
 	} else {
 		// DDL query or no results
-		html.WriteString("<div class='success'>Query executed successfully.</div>")
+		page.WriteString("<div class='success'>Query executed successfully.</div>")
 	}
 
-	w.Write([]byte(html.String()))
+	w.Write([]byte(page.String()))
 }
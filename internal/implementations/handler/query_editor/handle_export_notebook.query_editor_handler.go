@@ -0,0 +1,38 @@
+package query_editor
+
+import (
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleExportNotebook(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.notebookUC.ExportNotebook(r.Context(), session.Username, id)
+	if err != nil {
+		writeNotebookError(w, "Error exporting notebook: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+export.Filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(export.Content)
+}
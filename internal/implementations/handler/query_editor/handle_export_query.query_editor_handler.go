@@ -0,0 +1,98 @@
+package query_editor
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleExportQuery(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	format := r.FormValue("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" {
+		http.Error(w, "Unsupported export format", http.StatusBadRequest)
+		return
+	}
+
+	query := r.FormValue("query")
+	if strings.TrimSpace(query) == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	out := &streamingCSVWriter{w: w}
+	err = h.queryUC.ExportQueryCSV(r.Context(), session.Username, domain.QueryParams{
+		Query:       query,
+		WhereClause: r.FormValue("where"),
+		OrderBy:     r.FormValue("orderBy"),
+		OrderDir:    r.FormValue("orderDir"),
+		SearchPath:  session.SearchPath,
+		Settings:    session.Settings,
+	}, out)
+	if err != nil && !out.started {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error exporting query: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Errors after the first chunk can only cut the download short
+	if !out.started {
+		out.start()
+	}
+}
+
+// streamingCSVWriter sends CSV headers on the first write and flushes each chunk to the client
+type streamingCSVWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (s *streamingCSVWriter) start() {
+	s.w.Header().Set("Content-Type", "text/csv")
+	s.w.Header().Set("Content-Disposition", `attachment; filename="query_export.csv"`)
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+}
+
+func (s *streamingCSVWriter) Write(p []byte) (int, error) {
+	if !s.started {
+		s.start()
+	}
+	n, err := s.w.Write(p)
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
@@ -0,0 +1,32 @@
+package query_editor
+
+import (
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleExportWorkspace(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	content, err := h.savedQueryUC.ExportWorkspace(r.Context(), session.Username, session.SearchPath)
+	if err != nil {
+		writeSavedQueryError(w, "Error exporting workspace: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="lumen-pg-workspace.json"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
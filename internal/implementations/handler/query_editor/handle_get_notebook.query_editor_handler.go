@@ -0,0 +1,38 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleGetNotebook(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	notebook, err := h.notebookUC.GetNotebook(r.Context(), session.Username, id)
+	if err != nil {
+		writeNotebookError(w, "Error loading notebook: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(notebook)
+}
@@ -0,0 +1,83 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleImportWorkspace(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse the multipart upload
+	r.Body = http.MaxBytesReader(w, r.Body, domain.WorkspaceMaxUploadBytes)
+	if err := r.ParseMultipartForm(domain.WorkspaceMaxUploadBytes); err != nil {
+		http.Error(w, "Error parsing upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Error reading upload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.savedQueryUC.ImportWorkspace(r.Context(), session.Username, content)
+	if err != nil {
+		writeSavedQueryError(w, "Error importing workspace: ", err)
+		return
+	}
+
+	response := map[string]interface{}{
+		"imported":    result.Imported,
+		"renamed":     result.Renamed,
+		"skipped":     result.Skipped,
+		"search_path": session.SearchPath,
+	}
+
+	// The saved queries stay imported when the search path names schemas this server lacks
+	if len(result.SearchPath) > 0 {
+		updated, err := h.authUC.SetSessionSearchPath(r.Context(), cookie.Value, result.SearchPath)
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			response["search_path_error"] = validationErr.Message
+		case err != nil:
+			http.Error(w, "Error setting search path: "+err.Error(), http.StatusInternalServerError)
+			return
+		default:
+			response["search_path"] = updated.SearchPath
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,32 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleListNotebooks(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	notebooks, err := h.notebookUC.ListNotebooks(r.Context(), session.Username)
+	if err != nil {
+		writeNotebookError(w, "Error listing notebooks: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(notebooks)
+}
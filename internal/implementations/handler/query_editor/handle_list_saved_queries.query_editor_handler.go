@@ -0,0 +1,33 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleListSavedQueries(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// List the user's saved queries, optionally narrowed to a tag
+	queries, err := h.savedQueryUC.ListSavedQueries(r.Context(), session.Username, r.URL.Query().Get("tag"))
+	if err != nil {
+		http.Error(w, "Error listing saved queries: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(queries)
+}
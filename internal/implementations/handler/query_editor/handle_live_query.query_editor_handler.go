@@ -0,0 +1,73 @@
+package query_editor
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleLiveQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		http.Error(w, "Query cannot be empty", http.StatusBadRequest)
+		return
+	}
+
+	interval, err := domain.ParseLiveInterval(r.URL.Query().Get("interval"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// The stream opens with the first result, so a refused query still gets a plain error response
+	streaming := false
+	err = h.queryUC.WatchQuery(r.Context(), session.Username, query, interval, func(update *domain.LiveResultUpdate) error {
+		if !streaming {
+			streaming = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+		}
+		event, payload := update.Event()
+		if err := domain.WriteServerSentEvent(w, update.Sequence, event, payload); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil && !streaming {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Field == "permission" {
+			http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+			return
+		}
+		http.Error(w, html.EscapeString(err.Error()), http.StatusBadRequest)
+	}
+}
@@ -1,7 +1,12 @@
 package query_editor
 
 import (
+	"html"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (h *QueryEditorHandlerImplementation) HandleQueryEditorPage(w http.ResponseWriter, r *http.Request) {
@@ -13,12 +18,25 @@ func (h *QueryEditorHandlerImplementation) HandleQueryEditorPage(w http.Response
 	}
 
 	// Validate session
-	_, err = h.authUC.ValidateSession(r.Context(), cookie.Value)
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
 	if err != nil {
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
+	// Show the search_path unqualified names resolve against
+	searchPath := domain.DefaultSearchPath
+	if len(session.SearchPath) > 0 {
+		searchPath = strings.Join(session.SearchPath, ", ")
+	}
+
+	// Show the settings queries check out connections with, blank fields keeping the server default
+	settings := session.Settings
+	statementTimeout := ""
+	if settings.StatementTimeout > 0 {
+		statementTimeout = settings.StatementTimeout.String()
+	}
+
 	// Return query editor page HTML
 	w.Header().Set("Content-Type", "text/html")
 	w.WriteHeader(http.StatusOK)
@@ -35,14 +53,111 @@ func (h *QueryEditorHandlerImplementation) HandleQueryEditorPage(w http.Response
 <body>
 	<div class="query-editor-container">
 		<h1>SQL Query Editor</h1>
+		<div class="session-info">
+			<div class="search-path">search_path: <code>` + html.EscapeString(searchPath) + `</code></div>
+			<form id="session-settings" class="session-settings">
+				<label>work_mem <input name="work_mem" placeholder="server default" value="` + html.EscapeString(settings.WorkMem) + `"></label>
+				<label>statement_timeout <input name="statement_timeout" placeholder="server default" value="` + html.EscapeString(statementTimeout) + `"></label>
+				<label>application_name suffix <input name="application_name_suffix" maxlength="` + strconv.Itoa(domain.SessionApplicationNameSuffixLimit) + `" value="` + html.EscapeString(settings.ApplicationNameSuffix) + `"></label>
+				<button type="submit">Save settings</button>
+				<span id="session-settings-status"></span>
+			</form>
+		</div>
 		<form method="POST" action="/api/query/execute">
 			<textarea name="query" class="query-editor syntax-highlight sql" placeholder="Enter your SQL query here..."></textarea>
 			<button type="submit">Execute</button>
 		</form>
+		<div class="auto-refresh">
+			<label>Auto-refresh
+				<select id="auto-refresh">
+					<option value="0">Off</option>
+					<option value="` + strconv.Itoa(domain.LiveRefreshMinInterval) + `">Every ` + strconv.Itoa(domain.LiveRefreshMinInterval) + `s</option>
+					<option value="15">Every 15s</option>
+					<option value="30">Every 30s</option>
+					<option value="60">Every minute</option>
+				</select>
+			</label>
+			<span id="live-status"></span>
+		</div>
 		<div class="results-panel" id="results">
 			<!-- Query results will be displayed here -->
 		</div>
 	</div>
+	<script>
+		let liveSource = null;
+		const refreshSelect = document.getElementById('auto-refresh');
+		const liveStatus = document.getElementById('live-status');
+
+		function stopLiveRefresh(message) {
+			if (liveSource) {
+				liveSource.close();
+				liveSource = null;
+			}
+			refreshSelect.value = '0';
+			liveStatus.textContent = message;
+		}
+
+		function renderLiveResult(update) {
+			const table = document.createElement('table');
+			const header = table.createTHead().insertRow();
+			update.columns.forEach(column => {
+				const th = document.createElement('th');
+				th.textContent = column;
+				header.appendChild(th);
+			});
+			const body = table.createTBody();
+			(update.rows || []).forEach(row => {
+				const tr = body.insertRow();
+				update.columns.forEach(column => { tr.insertCell().textContent = row[column] === null ? 'NULL' : String(row[column]); });
+			});
+			document.getElementById('results').replaceChildren(table);
+		}
+
+		refreshSelect.addEventListener('change', () => {
+			const seconds = Number(refreshSelect.value);
+			if (liveSource) {
+				liveSource.close();
+				liveSource = null;
+			}
+			if (!seconds) {
+				liveStatus.textContent = '';
+				return;
+			}
+			const query = document.querySelector('textarea[name="query"]').value;
+			liveSource = new EventSource('/api/query/live?' + new URLSearchParams({ query: query, interval: seconds }));
+			refreshSelect.value = String(seconds);
+			liveSource.addEventListener('result', event => {
+				const update = JSON.parse(event.data);
+				renderLiveResult(update);
+				liveStatus.textContent = 'Refreshed ' + update.refreshed_at + ', every ' + update.interval_seconds + 's';
+			});
+			liveSource.addEventListener('refresh-error', event => {
+				liveStatus.textContent = 'Refresh failed, retrying: ' + JSON.parse(event.data).error;
+			});
+			// A dropped push channel pauses auto-refresh instead of letting the browser reconnect on its own
+			liveSource.onerror = () => stopLiveRefresh('Auto-refresh paused: connection lost');
+		});
+
+		const settingsForm = document.getElementById('session-settings');
+		const settingsStatus = document.getElementById('session-settings-status');
+		settingsForm.addEventListener('submit', event => {
+			event.preventDefault();
+			fetch('/api/query/session-settings', { method: 'POST', body: new URLSearchParams(new FormData(settingsForm)) })
+				.then(response => {
+					if (!response.ok) {
+						return response.text().then(text => { throw new Error(text); });
+					}
+					return response.json();
+				})
+				.then(saved => {
+					settingsForm.elements.work_mem.value = saved.work_mem;
+					settingsForm.elements.statement_timeout.value = saved.statement_timeout_ms ? saved.statement_timeout_ms + 'ms' : '';
+					settingsForm.elements.application_name_suffix.value = saved.application_name_suffix;
+					settingsStatus.textContent = 'Saved; applies to the next query';
+				})
+				.catch(err => { settingsStatus.textContent = 'Not saved: ' + err.message; });
+		});
+	</script>
 </body>
 </html>`))
 }
@@ -0,0 +1,57 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleRunNotebook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := r.FormValue("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// A cell_id runs that one cell, otherwise every SQL cell runs in order
+	var notebook *domain.QueryNotebook
+	if cellID := r.FormValue("cell_id"); cellID != "" {
+		notebook, err = h.notebookUC.RunCell(r.Context(), session.Username, id, cellID)
+	} else {
+		notebook, err = h.notebookUC.RunAllCells(r.Context(), session.Username, id)
+	}
+	if err != nil {
+		writeNotebookError(w, "Error running notebook: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(notebook)
+}
@@ -0,0 +1,73 @@
+package query_editor
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleRunSavedQuery(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := r.FormValue("id")
+	if id == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Template variables arrive as var.<name> fields
+	values := make(map[string]string)
+	for key := range r.Form {
+		if name, ok := strings.CutPrefix(key, "var."); ok {
+			values[name] = r.FormValue(key)
+		}
+	}
+
+	query, args, err := h.savedQueryUC.BindTemplate(r.Context(), session.Username, id, values)
+	if err != nil {
+		writeSavedQueryError(w, "Error loading saved query: ", err)
+		return
+	}
+
+	offset := 0
+	if offsetStr := r.FormValue("offset"); offsetStr != "" {
+		offset, _ = strconv.Atoi(offsetStr)
+	}
+
+	// Re-run through the regular query path so the same permission checks apply
+	result, err := h.queryUC.ExecuteQueryWithPagination(r.Context(), session.Username, domain.QueryParams{
+		Query:      query,
+		Args:       args,
+		Offset:     offset,
+		Limit:      50,
+		SearchPath: session.SearchPath,
+		Settings:   session.Settings,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(queryErrorHTML(err)))
+		return
+	}
+
+	h.renderQueryResult(w, result, domain.ResultView{})
+}
@@ -0,0 +1,63 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleSearchPath(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		// Parse form data
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// An empty list restores the server default
+		var schemas []string
+		if value := strings.TrimSpace(r.FormValue("schemas")); value != "" {
+			schemas = strings.Split(value, ",")
+		}
+
+		session, err = h.authUC.SetSessionSearchPath(r.Context(), cookie.Value, schemas)
+		if err != nil {
+			var validationErr domain.ValidationError
+			if errors.As(err, &validationErr) {
+				http.Error(w, validationErr.Message, http.StatusForbidden)
+				return
+			}
+			http.Error(w, "Error setting search path: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	effective := domain.DefaultSearchPath
+	if len(session.SearchPath) > 0 {
+		effective = strings.Join(session.SearchPath, ", ")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"search_path": session.SearchPath,
+		"effective":   effective,
+	})
+}
@@ -0,0 +1,53 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleSessionSettings(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		// Parse form data
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Blank fields restore the server defaults
+		settings, err := domain.ParseSessionSettings(r.FormValue("work_mem"), r.FormValue("statement_timeout"), r.FormValue("application_name_suffix"))
+		if err == nil {
+			session, err = h.authUC.SetSessionSettings(r.Context(), cookie.Value, settings)
+		}
+		if err != nil {
+			var validationErr domain.ValidationError
+			if errors.As(err, &validationErr) {
+				http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+				return
+			}
+			http.Error(w, "Error saving session settings: "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(session.Settings.Summary())
+}
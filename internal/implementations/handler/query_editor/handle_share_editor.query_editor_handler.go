@@ -0,0 +1,53 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleShareEditor(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		if err := h.queryUC.StopSharingEditor(r.Context(), session.Username); err != nil {
+			http.Error(w, "Error stopping the shared session: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	share, err := h.queryUC.ShareEditor(r.Context(), session.Username)
+	if err != nil {
+		http.Error(w, "Error sharing the editor: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"token":      share.Token,
+		"expires_at": share.ExpiresAt.UTC().Format(time.RFC3339),
+		"watch_url":  "/api/query/shared?token=" + url.QueryEscape(share.Token),
+	})
+}
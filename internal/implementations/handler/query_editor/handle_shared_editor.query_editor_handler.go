@@ -0,0 +1,91 @@
+package query_editor
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleSharedEditor(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// The stream opens with the editor's current state, so an unknown invite still gets a plain error response
+	streaming := false
+	err = h.queryUC.WatchSharedEditor(r.Context(), session.Username, token, func(update *domain.EditorShareUpdate) error {
+		if !streaming {
+			streaming = true
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+		}
+		return writeSharedRun(w, flusher, update)
+	})
+	if err != nil && !streaming {
+		if errors.Is(err, domain.ErrEditorShareNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Error watching the shared editor: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// writeSharedRun sends one run of the shared editor as a server-sent event: "run" with the query and
+// its rows or error, or "waiting" before the owner has run anything
+func writeSharedRun(w http.ResponseWriter, flusher http.Flusher, update *domain.EditorShareUpdate) error {
+	event := "run"
+	payload := map[string]interface{}{
+		"owner":    update.Owner,
+		"sequence": update.Sequence,
+	}
+	switch {
+	case update.Sequence == 0:
+		event = "waiting"
+	case update.Error != "":
+		payload["query"] = update.Query
+		payload["ran_at"] = update.RanAt.UTC().Format(time.RFC3339)
+		payload["error"] = update.Error
+	default:
+		payload["query"] = update.Query
+		payload["ran_at"] = update.RanAt.UTC().Format(time.RFC3339)
+		payload["columns"] = update.Result.Columns
+		payload["rows"] = update.Result.Rows
+		payload["row_count"] = update.Result.RowCount
+	}
+
+	if err := domain.WriteServerSentEvent(w, update.Sequence, event, payload); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
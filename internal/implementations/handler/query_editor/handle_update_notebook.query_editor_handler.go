@@ -0,0 +1,42 @@
+package query_editor
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleUpdateNotebook(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request notebookRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if request.ID == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	notebook, err := h.notebookUC.UpdateNotebook(r.Context(), session.Username, request.ID, request.Title, request.notebookCells())
+	if err != nil {
+		writeNotebookError(w, "Error updating notebook: ", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(notebook)
+}
@@ -0,0 +1,98 @@
+package query_editor
+
+import (
+	"errors"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *QueryEditorHandlerImplementation) HandleViewResult(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resultID := r.URL.Query().Get("result_id")
+	if resultID == "" {
+		http.Error(w, "Missing result_id parameter", http.StatusBadRequest)
+		return
+	}
+
+	view := domain.ResultView{
+		SortColumn: r.URL.Query().Get("sort"),
+		SortDir:    domain.SortDirectionASC,
+		Filter:     r.URL.Query().Get("filter"),
+		Limit:      50,
+	}
+	if strings.EqualFold(r.URL.Query().Get("dir"), domain.SortDirectionDESC) {
+		view.SortDir = domain.SortDirectionDESC
+	}
+	if offset := r.URL.Query().Get("offset"); offset != "" {
+		view.Offset, _ = strconv.Atoi(offset)
+	}
+
+	// Re-sort and filter the rows kept from the run instead of running the query again
+	result, err := h.queryUC.ViewResult(r.Context(), session.Username, resultID, view)
+	if errors.Is(err, domain.ErrResultNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("<div class='error'>This result has expired; run the query again</div>"))
+		return
+	}
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("<div class='error'>" + html.EscapeString(validationErr.Message) + "</div>"))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("<div class='error'>" + html.EscapeString(err.Error()) + "</div>"))
+		return
+	}
+
+	h.renderQueryResult(w, result, view)
+}
+
+// resultFilterForm renders the quick filter of a kept result, keeping its current sort
+func resultFilterForm(resultID string, view domain.ResultView) string {
+	return "<form method='GET' action='/api/query/result' class='result-filter'>" +
+		"<input type='hidden' name='result_id' value='" + html.EscapeString(resultID) + "'>" +
+		"<input type='hidden' name='sort' value='" + html.EscapeString(view.SortColumn) + "'>" +
+		"<input type='hidden' name='dir' value='" + html.EscapeString(view.SortDir) + "'>" +
+		"<input name='filter' placeholder='Filter rows' value='" + html.EscapeString(view.Filter) + "'>" +
+		"<button type='submit'>Filter</button></form>"
+}
+
+// sortHeaderLink renders a column header that sorts a kept result by the column, reversing the
+// direction when it is already sorted by it
+func sortHeaderLink(resultID, column string, view domain.ResultView) string {
+	dir := domain.SortDirectionASC
+	label := html.EscapeString(column)
+	if view.SortColumn == column {
+		if view.SortDir == domain.SortDirectionDESC {
+			label += " ▼"
+		} else {
+			dir = domain.SortDirectionDESC
+			label += " ▲"
+		}
+	}
+	query := url.Values{"result_id": {resultID}, "sort": {column}, "dir": {dir}}
+	if view.Filter != "" {
+		query.Set("filter", view.Filter)
+	}
+	return "<a class='sort-header' href='/api/query/result?" + html.EscapeString(query.Encode()) + "'>" + label + "</a>"
+}
@@ -6,16 +6,22 @@ import (
 )
 
 type QueryEditorHandlerImplementation struct {
-	queryUC usecase.QueryUseCase
-	authUC  usecase.AuthenticationUseCase
+	queryUC      usecase.QueryUseCase
+	authUC       usecase.AuthenticationUseCase
+	savedQueryUC usecase.SavedQueryUseCase
+	notebookUC   usecase.QueryNotebookUseCase
 }
 
 func NewQueryEditorHandlerImplementation(
 	queryUC usecase.QueryUseCase,
 	authUC usecase.AuthenticationUseCase,
+	savedQueryUC usecase.SavedQueryUseCase,
+	notebookUC usecase.QueryNotebookUseCase,
 ) handler.QueryEditorHandler {
 	return &QueryEditorHandlerImplementation{
-		queryUC: queryUC,
-		authUC:  authUC,
+		queryUC:      queryUC,
+		authUC:       authUC,
+		savedQueryUC: savedQueryUC,
+		notebookUC:   notebookUC,
 	}
 }
@@ -8,8 +8,60 @@ func (h *QueryEditorHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *h
 		h.HandleQueryEditorPage(w, r)
 	case "/api/query/execute":
 		h.HandleExecuteQuery(w, r)
+	case "/api/query/result":
+		h.HandleViewResult(w, r)
 	case "/api/query/execute-multiple":
 		h.HandleExecuteMultipleQueries(w, r)
+	case "/api/query/live":
+		h.HandleLiveQuery(w, r)
+	case "/api/query/share":
+		h.HandleShareEditor(w, r)
+	case "/api/query/shared":
+		h.HandleSharedEditor(w, r)
+	case "/api/query/search-path":
+		h.HandleSearchPath(w, r)
+	case "/api/query/session-settings":
+		h.HandleSessionSettings(w, r)
+	case "/api/query/export":
+		h.HandleExportQuery(w, r)
+	case "/api/query/cancel":
+		h.HandleCancelQuery(w, r)
+	case "/api/query/explain":
+		h.HandleExplainQuery(w, r)
+	case "/api/query/chart":
+		h.HandleQueryChart(w, r)
+	case "/api/queries":
+		if r.Method == http.MethodPost {
+			h.HandleCreateSavedQuery(w, r)
+		} else {
+			h.HandleListSavedQueries(w, r)
+		}
+	case "/api/queries/update":
+		h.HandleUpdateSavedQuery(w, r)
+	case "/api/queries/delete":
+		h.HandleDeleteSavedQuery(w, r)
+	case "/api/queries/run":
+		h.HandleRunSavedQuery(w, r)
+	case "/api/notebooks":
+		if r.Method == http.MethodPost {
+			h.HandleCreateNotebook(w, r)
+		} else {
+			h.HandleListNotebooks(w, r)
+		}
+	case "/api/notebooks/get":
+		h.HandleGetNotebook(w, r)
+	case "/api/notebooks/update":
+		h.HandleUpdateNotebook(w, r)
+	case "/api/notebooks/delete":
+		h.HandleDeleteNotebook(w, r)
+	case "/api/notebooks/run":
+		h.HandleRunNotebook(w, r)
+	case "/api/notebooks/export":
+		h.HandleExportNotebook(w, r)
+	case "/api/workspace/export":
+		h.HandleExportWorkspace(w, r)
+	case "/api/workspace/import":
+		h.HandleImportWorkspace(w, r)
 	default:
 		http.NotFound(w, r)
 	}
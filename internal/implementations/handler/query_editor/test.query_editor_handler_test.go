@@ -13,8 +13,10 @@ func TestQueryEditorHandler(t *testing.T) {
 	constructor := func(
 		queryUC usecase.QueryUseCase,
 		authUC usecase.AuthenticationUseCase,
+		savedQueryUC usecase.SavedQueryUseCase,
+		notebookUC usecase.QueryNotebookUseCase,
 	) handler.QueryEditorHandler {
-		return query_editor.NewQueryEditorHandlerImplementation(queryUC, authUC)
+		return query_editor.NewQueryEditorHandlerImplementation(queryUC, authUC, savedQueryUC, notebookUC)
 	}
 
 	handlerTestRunner.QueryEditorHandlerRunner(t, constructor)
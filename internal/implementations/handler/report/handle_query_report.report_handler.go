@@ -0,0 +1,53 @@
+package report
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *ReportHandlerImplementation) HandleQueryReport(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data; long queries are posted, short ones may be linked
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := r.FormValue("query")
+	if strings.TrimSpace(query) == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.queryUC.BuildQueryReport(r.Context(), session.Username, domain.QueryParams{
+		Query:       query,
+		WhereClause: r.FormValue("where"),
+		OrderBy:     r.FormValue("orderBy"),
+		OrderDir:    r.FormValue("orderDir"),
+		SearchPath:  session.SearchPath,
+		Settings:    session.Settings,
+	}, r.FormValue("title"))
+	if err != nil {
+		writeReportError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	renderReport(w, report)
+}
@@ -0,0 +1,24 @@
+package report
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type ReportHandlerImplementation struct {
+	queryUC    usecase.QueryUseCase
+	dataViewUC usecase.DataViewUseCase
+	authUC     usecase.AuthenticationUseCase
+}
+
+func NewReportHandlerImplementation(
+	queryUC usecase.QueryUseCase,
+	dataViewUC usecase.DataViewUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.ReportHandler {
+	return &ReportHandlerImplementation{
+		queryUC:    queryUC,
+		dataViewUC: dataViewUC,
+		authUC:     authUC,
+	}
+}
@@ -0,0 +1,120 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// renderReport writes the report as print-ready HTML, ReportRowsPerPage rows to a printed page with the
+// column headings and a page footer repeated on each; browsers save it as PDF through printing
+func renderReport(w http.ResponseWriter, report *domain.ResultReport) {
+	var page strings.Builder
+
+	title := html.EscapeString(report.Title)
+	generated := fmt.Sprintf("Generated %s by %s",
+		report.GeneratedAt.Format(time.RFC3339), html.EscapeString(report.GeneratedBy))
+
+	page.WriteString(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+	<title>%s</title>
+	<style>
+		@page { size: A4 landscape; margin: 12mm; }
+		body { font-family: Arial, sans-serif; font-size: 10pt; margin: 20px; }
+		h1 { font-size: 16pt; margin: 0 0 6px; }
+		dl { display: grid; grid-template-columns: max-content auto; gap: 2px 12px; margin: 0 0 12px; }
+		dt { font-weight: bold; }
+		dd { margin: 0; white-space: pre-wrap; }
+		table { border-collapse: collapse; width: 100%%; }
+		th, td { border: 1px solid #999; padding: 3px 6px; text-align: left; vertical-align: top; }
+		th { background: #eee; }
+		td.null { color: #888; font-style: italic; }
+		.report-page { page-break-after: always; break-after: page; margin-bottom: 24px; }
+		.report-page:last-child { page-break-after: auto; break-after: auto; }
+		.page-footer { display: flex; justify-content: space-between; color: #555; font-size: 8pt; margin-top: 4px; }
+		@media print {
+			body { margin: 0; }
+			.no-print { display: none; }
+		}
+	</style>
+</head>
+<body>
+	<p class="no-print"><button type="button" onclick="window.print()">Print or save as PDF</button></p>
+	<h1>%s</h1>
+	<dl>
+		<dt>Source</dt><dd>%s</dd>
+		<dt>Filter</dt><dd>%s</dd>
+		<dt>Sort</dt><dd>%s</dd>
+		<dt>Rows</dt><dd>%d</dd>
+		<dt>Generated</dt><dd>%s</dd>
+		<dt>Requested by</dt><dd>%s</dd>
+	</dl>`,
+		title,
+		title,
+		html.EscapeString(report.Source),
+		html.EscapeString(describeOrNone(report.Filter)),
+		html.EscapeString(describeOrNone(report.Sort)),
+		len(report.Rows),
+		report.GeneratedAt.Format(time.RFC3339),
+		html.EscapeString(report.GeneratedBy),
+	))
+
+	var heading strings.Builder
+	for _, column := range report.Columns {
+		heading.WriteString("<th>" + html.EscapeString(column) + "</th>")
+	}
+
+	pages := (len(report.Rows) + domain.ReportRowsPerPage - 1) / domain.ReportRowsPerPage
+	if pages == 0 {
+		pages = 1
+	}
+	for number := 1; number <= pages; number++ {
+		start := (number - 1) * domain.ReportRowsPerPage
+		end := min(start+domain.ReportRowsPerPage, len(report.Rows))
+
+		page.WriteString(fmt.Sprintf(`
+	<section class="report-page">
+		<table>
+			<thead><tr>%s</tr></thead>
+			<tbody>`, heading.String()))
+		if len(report.Rows) == 0 {
+			page.WriteString(fmt.Sprintf(`
+				<tr><td colspan="%d">No rows</td></tr>`, max(len(report.Columns), 1)))
+		}
+		for _, row := range report.Rows[start:end] {
+			page.WriteString("\n\t\t\t\t<tr>")
+			for _, value := range row {
+				if value == nil {
+					page.WriteString(`<td class="null">NULL</td>`)
+				} else {
+					page.WriteString("<td>" + html.EscapeString(*value) + "</td>")
+				}
+			}
+			page.WriteString("</tr>")
+		}
+		page.WriteString(fmt.Sprintf(`
+			</tbody>
+		</table>
+		<div class="page-footer"><span>%s &middot; %s</span><span>Page %d of %d</span></div>
+	</section>`, title, generated, number, pages))
+	}
+
+	page.WriteString(`
+</body>
+</html>`)
+
+	w.Write([]byte(page.String()))
+}
+
+// describeOrNone shows "None" for a filter or sort that was not applied
+func describeOrNone(description string) string {
+	if description == "" {
+		return "None"
+	}
+	return description
+}
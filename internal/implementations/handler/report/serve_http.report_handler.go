@@ -0,0 +1,14 @@
+package report
+
+import "net/http"
+
+func (h *ReportHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/report/query":
+		h.HandleQueryReport(w, r)
+	case "/report/table":
+		h.HandleTableReport(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,11 @@
+package report
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestReportHandler(t *testing.T) {
+	testRunner.ReportHandlerRunner(t, NewReportHandlerImplementation)
+}
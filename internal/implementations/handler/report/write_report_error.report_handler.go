@@ -0,0 +1,22 @@
+package report
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeReportError maps a report usecase error onto its HTTP status
+func writeReportError(w http.ResponseWriter, err error) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	default:
+		http.Error(w, "Error building report: "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
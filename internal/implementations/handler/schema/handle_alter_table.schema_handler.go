@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// alterTableRequest is the JSON body of a table designer ALTER TABLE request
+type alterTableRequest struct {
+	Database string `json:"database"`
+	Schema   string `json:"schema"`
+	Table    string `json:"table"`
+	Changes  []struct {
+		Operation string   `json:"operation"`
+		Column    string   `json:"column"`
+		NewName   string   `json:"new_name"`
+		DataType  string   `json:"data_type"`
+		Using     string   `json:"using"`
+		Default   string   `json:"default"`
+		NotNull   bool     `json:"not_null"`
+		Columns   []string `json:"columns"`
+	} `json:"changes"`
+	Confirm string `json:"confirm"`
+}
+
+func (h *SchemaHandlerImplementation) HandleAlterTable(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request alterTableRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if request.Database == "" || request.Schema == "" || request.Table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	alteration := domain.TableAlteration{
+		Database: request.Database,
+		Schema:   request.Schema,
+		Table:    request.Table,
+	}
+	for _, change := range request.Changes {
+		alteration.Changes = append(alteration.Changes, domain.ColumnChange{
+			Operation: change.Operation,
+			Column:    change.Column,
+			NewName:   change.NewName,
+			DataType:  change.DataType,
+			Using:     change.Using,
+			Default:   change.Default,
+			NotNull:   change.NotNull,
+			Columns:   change.Columns,
+		})
+	}
+
+	// Without confirm the request only previews the generated statements
+	change, err := h.schemaUC.AlterTable(r.Context(), session.Username, alteration, request.Confirm)
+	if err != nil {
+		writeSchemaError(w, err, "altering table")
+		return
+	}
+
+	// Objects referencing renamed or dropped columns are listed for review before confirming
+	impact := make([]map[string]interface{}, len(change.Impact))
+	for i, dependent := range change.Impact {
+		impact[i] = map[string]interface{}{
+			"kind":       dependent.Kind,
+			"schema":     dependent.Schema,
+			"name":       dependent.Name,
+			"column":     dependent.Column,
+			"definition": dependent.Definition,
+			"inferred":   dependent.Inferred,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+		"impact":    impact,
+	})
+}
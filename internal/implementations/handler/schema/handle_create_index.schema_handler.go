@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *SchemaHandlerImplementation) HandleCreateIndex(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	definition := domain.IndexDefinition{
+		Database:     r.FormValue("database"),
+		Schema:       r.FormValue("schema"),
+		Table:        r.FormValue("table"),
+		Name:         r.FormValue("name"),
+		Columns:      r.Form["column"],
+		Method:       r.FormValue("method"),
+		Unique:       r.FormValue("unique") == "true",
+		Concurrently: r.FormValue("concurrently") == "true",
+	}
+	if definition.Database == "" || definition.Schema == "" || definition.Table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Without confirm the request only previews the generated statement
+	change, err := h.schemaUC.CreateIndex(r.Context(), session.Username, definition, r.FormValue("confirm"))
+	if err != nil {
+		writeSchemaError(w, err, "creating index")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+	})
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *SchemaHandlerImplementation) HandleCreateSchema(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	creation := domain.SchemaCreation{
+		Database: r.FormValue("database"),
+		Name:     r.FormValue("name"),
+		Owner:    r.FormValue("owner"),
+	}
+	if creation.Database == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Without confirm the request only previews the generated statement
+	change, err := h.schemaUC.CreateSchema(r.Context(), session.Username, creation, r.FormValue("confirm"))
+	if err != nil {
+		writeSchemaError(w, err, "creating schema")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+	})
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// createTableRequest is the JSON body of a table designer CREATE TABLE request
+type createTableRequest struct {
+	Database string `json:"database"`
+	Schema   string `json:"schema"`
+	Table    string `json:"table"`
+	Columns  []struct {
+		Name     string `json:"name"`
+		DataType string `json:"data_type"`
+		Default  string `json:"default"`
+		NotNull  bool   `json:"not_null"`
+	} `json:"columns"`
+	PrimaryKey []string `json:"primary_key"`
+	Confirm    string   `json:"confirm"`
+}
+
+func (h *SchemaHandlerImplementation) HandleCreateTable(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request createTableRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if request.Database == "" || request.Schema == "" || request.Table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	creation := domain.TableCreation{
+		Database:   request.Database,
+		Schema:     request.Schema,
+		Table:      request.Table,
+		PrimaryKey: request.PrimaryKey,
+	}
+	for _, column := range request.Columns {
+		creation.Columns = append(creation.Columns, domain.ColumnDesign{
+			Name:     column.Name,
+			DataType: column.DataType,
+			Default:  column.Default,
+			NotNull:  column.NotNull,
+		})
+	}
+
+	// Without confirm the request only previews the generated statement
+	change, err := h.schemaUC.CreateTable(r.Context(), session.Username, creation, request.Confirm)
+	if err != nil {
+		writeSchemaError(w, err, "creating table")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+	})
+}
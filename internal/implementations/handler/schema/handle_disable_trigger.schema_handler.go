@@ -0,0 +1,7 @@
+package schema
+
+import "net/http"
+
+func (h *SchemaHandlerImplementation) HandleDisableTrigger(w http.ResponseWriter, r *http.Request) {
+	h.handleTriggerToggle(w, r, false)
+}
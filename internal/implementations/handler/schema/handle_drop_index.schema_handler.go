@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *SchemaHandlerImplementation) HandleDropIndex(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	drop := domain.IndexDrop{
+		Database:     r.FormValue("database"),
+		Schema:       r.FormValue("schema"),
+		Table:        r.FormValue("table"),
+		Name:         r.FormValue("name"),
+		Concurrently: r.FormValue("concurrently") == "true",
+	}
+	if drop.Database == "" || drop.Schema == "" || drop.Table == "" || drop.Name == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Without confirm the request only previews the generated statement
+	change, err := h.schemaUC.DropIndex(r.Context(), session.Username, drop, r.FormValue("confirm"))
+	if err != nil {
+		writeSchemaError(w, err, "dropping index")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+	})
+}
@@ -0,0 +1,7 @@
+package schema
+
+import "net/http"
+
+func (h *SchemaHandlerImplementation) HandleEnableTrigger(w http.ResponseWriter, r *http.Request) {
+	h.handleTriggerToggle(w, r, true)
+}
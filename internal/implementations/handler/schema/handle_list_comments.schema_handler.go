@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *SchemaHandlerImplementation) HandleListComments(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	comments, err := h.schemaUC.ListComments(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeSchemaError(w, err, "listing comments")
+		return
+	}
+
+	columns := make([]map[string]interface{}, 0, len(comments.Columns))
+	for _, column := range comments.Columns {
+		columns = append(columns, map[string]interface{}{
+			"name":    column.Name,
+			"type":    column.Type,
+			"comment": column.Comment,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"comment":  comments.Comment,
+		"columns":  columns,
+		"can_edit": comments.CanEdit,
+	})
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *SchemaHandlerImplementation) HandleListConstraints(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.schemaUC.ListConstraints(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeSchemaError(w, err, "listing constraints")
+		return
+	}
+
+	constraints := make([]map[string]interface{}, 0, len(list.Constraints))
+	for _, constraint := range list.Constraints {
+		constraints = append(constraints, map[string]interface{}{
+			"name":               constraint.Name,
+			"type":               constraint.Type,
+			"columns":            constraint.Columns,
+			"definition":         constraint.Definition,
+			"referenced_schema":  constraint.ReferencedSchema,
+			"referenced_table":   constraint.ReferencedTable,
+			"referenced_columns": constraint.ReferencedColumns,
+			"validated":          constraint.Validated,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"constraints": constraints,
+		"can_manage":  list.CanManage,
+	})
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *SchemaHandlerImplementation) HandleListIndexes(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.schemaUC.ListIndexes(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeSchemaError(w, err, "listing indexes")
+		return
+	}
+
+	indexes := make([]map[string]interface{}, 0, len(list.Indexes))
+	for _, index := range list.Indexes {
+		indexes = append(indexes, map[string]interface{}{
+			"name":       index.Name,
+			"definition": index.Definition,
+			"method":     index.Method,
+			"unique":     index.Unique,
+			"primary":    index.Primary,
+			"valid":      index.Valid,
+			"constraint": index.Constraint,
+			"size_bytes": index.SizeBytes,
+			"scans":      index.Scans,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"indexes":    indexes,
+		"can_manage": list.CanManage,
+	})
+}
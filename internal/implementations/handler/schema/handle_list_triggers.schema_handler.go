@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *SchemaHandlerImplementation) HandleListTriggers(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.schemaUC.ListTriggers(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeSchemaError(w, err, "listing triggers")
+		return
+	}
+
+	triggers := make([]map[string]interface{}, 0, len(list.Triggers))
+	for _, trigger := range list.Triggers {
+		triggers = append(triggers, map[string]interface{}{
+			"name":            trigger.Name,
+			"timing":          trigger.Timing,
+			"events":          trigger.Events,
+			"for_each_row":    trigger.ForEachRow,
+			"function_schema": trigger.FunctionSchema,
+			"function_name":   trigger.FunctionName,
+			"definition":      trigger.Definition,
+			"enabled":         trigger.Enabled,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"triggers":   triggers,
+		"can_manage": list.CanManage,
+	})
+}
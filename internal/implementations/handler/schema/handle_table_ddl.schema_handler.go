@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (h *SchemaHandlerImplementation) HandleTableDDL(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	ddl, err := h.schemaUC.GetTableDDL(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeSchemaError(w, err, "reconstructing the table definition")
+		return
+	}
+
+	// format=sql downloads the script to run elsewhere
+	if r.URL.Query().Get("format") == "sql" {
+		w.Header().Set("Content-Type", "application/sql")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", table+".sql"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(ddl.Statement))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"database":  ddl.Database,
+		"schema":    ddl.Schema,
+		"table":     ddl.Table,
+		"statement": ddl.Statement,
+	})
+}
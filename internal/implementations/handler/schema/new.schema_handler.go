@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SchemaHandlerImplementation struct {
+	schemaUC usecase.SchemaUseCase
+	authUC   usecase.AuthenticationUseCase
+}
+
+func NewSchemaHandlerImplementation(
+	schemaUC usecase.SchemaUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.SchemaHandler {
+	return &SchemaHandlerImplementation{
+		schemaUC: schemaUC,
+		authUC:   authUC,
+	}
+}
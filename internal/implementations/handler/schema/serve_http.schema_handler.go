@@ -0,0 +1,42 @@
+package schema
+
+import "net/http"
+
+func (h *SchemaHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/table/indexes":
+		h.HandleListIndexes(w, r)
+	case "/api/table/indexes/create":
+		h.HandleCreateIndex(w, r)
+	case "/api/table/indexes/drop":
+		h.HandleDropIndex(w, r)
+	case "/api/table/constraints":
+		h.HandleListConstraints(w, r)
+	case "/api/table/constraints/add":
+		h.HandleAddConstraint(w, r)
+	case "/api/table/constraints/drop":
+		h.HandleDropConstraint(w, r)
+	case "/api/table/triggers":
+		h.HandleListTriggers(w, r)
+	case "/api/table/triggers/enable":
+		h.HandleEnableTrigger(w, r)
+	case "/api/table/triggers/disable":
+		h.HandleDisableTrigger(w, r)
+	case "/api/table/ddl":
+		h.HandleTableDDL(w, r)
+	case "/api/table/create":
+		h.HandleCreateTable(w, r)
+	case "/api/table/alter":
+		h.HandleAlterTable(w, r)
+	case "/api/databases/create":
+		h.HandleCreateDatabase(w, r)
+	case "/api/schemas/create":
+		h.HandleCreateSchema(w, r)
+	case "/api/table/comments":
+		h.HandleListComments(w, r)
+	case "/api/table/comments/set":
+		h.HandleSetComment(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,21 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/schema"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestSchemaHandler(t *testing.T) {
+	constructor := func(
+		schemaUC usecase.SchemaUseCase,
+		authUC usecase.AuthenticationUseCase,
+	) handler.SchemaHandler {
+		return schema.NewSchemaHandlerImplementation(schemaUC, authUC)
+	}
+
+	handlerTestRunner.SchemaHandlerRunner(t, constructor)
+}
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeSchemaError maps a schema usecase error onto its HTTP status
+func writeSchemaError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrTableNotFound):
+		http.Error(w, "Table not found", http.StatusNotFound)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
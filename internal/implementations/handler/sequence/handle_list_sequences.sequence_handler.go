@@ -0,0 +1,65 @@
+package sequence
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *SequenceHandlerImplementation) HandleListSequences(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+
+	if database == "" || schema == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	list, err := h.sequenceUC.ListSequences(r.Context(), session.Username, database, schema)
+	if err != nil {
+		writeSequenceError(w, err, "listing sequences")
+		return
+	}
+
+	sequences := make([]map[string]interface{}, 0, len(list))
+	for _, sequence := range list {
+		var lastValue interface{}
+		if sequence.LastValue != nil {
+			lastValue = *sequence.LastValue
+		}
+		sequences = append(sequences, map[string]interface{}{
+			"name":            sequence.Name,
+			"data_type":       sequence.DataType,
+			"last_value":      lastValue,
+			"start_value":     sequence.StartValue,
+			"increment":       sequence.Increment,
+			"min_value":       sequence.MinValue,
+			"max_value":       sequence.MaxValue,
+			"cycle":           sequence.Cycle,
+			"owned_by_table":  sequence.OwnedByTable,
+			"owned_by_column": sequence.OwnedByColumn,
+			"can_set":         sequence.CanSet,
+			"can_restart":     sequence.CanRestart,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sequences": sequences,
+	})
+}
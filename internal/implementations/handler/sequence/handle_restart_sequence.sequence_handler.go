@@ -0,0 +1,69 @@
+package sequence
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *SequenceHandlerImplementation) HandleRestartSequence(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	restart := domain.SequenceRestart{
+		Database: r.FormValue("database"),
+		Schema:   r.FormValue("schema"),
+		Name:     r.FormValue("name"),
+	}
+	if restart.Database == "" || restart.Schema == "" || restart.Name == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	// Without a value the sequence restarts at its start value
+	if raw := r.FormValue("value"); raw != "" {
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid value", http.StatusBadRequest)
+			return
+		}
+		restart.Value = &value
+	}
+
+	// Without confirm the request only previews the generated statement
+	change, err := h.sequenceUC.RestartSequence(r.Context(), session.Username, restart, r.FormValue("confirm"))
+	if err != nil {
+		writeSequenceError(w, err, "restarting sequence")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+	})
+}
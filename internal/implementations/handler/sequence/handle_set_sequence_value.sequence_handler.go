@@ -0,0 +1,66 @@
+package sequence
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *SequenceHandlerImplementation) HandleSetSequenceValue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	value := domain.SequenceValue{
+		Database: r.FormValue("database"),
+		Schema:   r.FormValue("schema"),
+		Name:     r.FormValue("name"),
+		IsCalled: r.FormValue("is_called") != "false",
+	}
+	if value.Database == "" || value.Schema == "" || value.Name == "" || r.FormValue("value") == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	value.Value, err = strconv.ParseInt(r.FormValue("value"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid value", http.StatusBadRequest)
+		return
+	}
+
+	// Without confirm the request only previews the generated statement
+	change, err := h.sequenceUC.SetSequenceValue(r.Context(), session.Username, value, r.FormValue("confirm"))
+	if err != nil {
+		writeSequenceError(w, err, "setting sequence value")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"statement": change.Statement,
+		"applied":   change.Applied,
+	})
+}
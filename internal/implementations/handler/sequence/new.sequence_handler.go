@@ -0,0 +1,21 @@
+package sequence
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SequenceHandlerImplementation struct {
+	sequenceUC usecase.SequenceUseCase
+	authUC     usecase.AuthenticationUseCase
+}
+
+func NewSequenceHandlerImplementation(
+	sequenceUC usecase.SequenceUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.SequenceHandler {
+	return &SequenceHandlerImplementation{
+		sequenceUC: sequenceUC,
+		authUC:     authUC,
+	}
+}
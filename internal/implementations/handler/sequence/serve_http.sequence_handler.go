@@ -0,0 +1,16 @@
+package sequence
+
+import "net/http"
+
+func (h *SequenceHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/sequences":
+		h.HandleListSequences(w, r)
+	case "/api/sequences/setval":
+		h.HandleSetSequenceValue(w, r)
+	case "/api/sequences/restart":
+		h.HandleRestartSequence(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
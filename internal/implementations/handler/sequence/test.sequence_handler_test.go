@@ -0,0 +1,21 @@
+package sequence_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/sequence"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestSequenceHandler(t *testing.T) {
+	constructor := func(
+		sequenceUC usecase.SequenceUseCase,
+		authUC usecase.AuthenticationUseCase,
+	) handler.SequenceHandler {
+		return sequence.NewSequenceHandlerImplementation(sequenceUC, authUC)
+	}
+
+	handlerTestRunner.SequenceHandlerRunner(t, constructor)
+}
@@ -0,0 +1,39 @@
+package shortcut
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *ShortcutHandlerImplementation) HandleListShortcuts(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	list, err := h.shortcutUC.ListShortcuts(r.Context(), session.Username)
+	if err != nil {
+		writeShortcutError(w, err, "listing shortcuts")
+		return
+	}
+
+	shortcuts := make([]map[string]interface{}, 0, len(list))
+	for _, shortcut := range list {
+		shortcuts = append(shortcuts, shortcutJSON(shortcut))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"shortcuts": shortcuts,
+	})
+}
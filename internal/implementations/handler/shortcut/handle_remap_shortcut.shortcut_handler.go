@@ -0,0 +1,50 @@
+package shortcut
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *ShortcutHandlerImplementation) HandleRemapShortcut(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	action := r.FormValue("action")
+	keys := r.FormValue("keys")
+	if action == "" || keys == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	shortcut, err := h.shortcutUC.RemapShortcut(r.Context(), session.Username, action, keys)
+	if err != nil {
+		writeShortcutError(w, err, "remapping shortcut")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(shortcutJSON(*shortcut))
+}
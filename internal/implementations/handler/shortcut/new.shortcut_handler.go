@@ -0,0 +1,21 @@
+package shortcut
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type ShortcutHandlerImplementation struct {
+	shortcutUC usecase.ShortcutUseCase
+	authUC     usecase.AuthenticationUseCase
+}
+
+func NewShortcutHandlerImplementation(
+	shortcutUC usecase.ShortcutUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.ShortcutHandler {
+	return &ShortcutHandlerImplementation{
+		shortcutUC: shortcutUC,
+		authUC:     authUC,
+	}
+}
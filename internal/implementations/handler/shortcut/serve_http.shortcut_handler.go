@@ -0,0 +1,16 @@
+package shortcut
+
+import "net/http"
+
+func (h *ShortcutHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/shortcuts":
+		h.HandleListShortcuts(w, r)
+	case "/api/shortcuts/remap":
+		h.HandleRemapShortcut(w, r)
+	case "/api/shortcuts/reset":
+		h.HandleResetShortcut(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,21 @@
+package shortcut_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/shortcut"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestShortcutHandler(t *testing.T) {
+	constructor := func(
+		shortcutUC usecase.ShortcutUseCase,
+		authUC usecase.AuthenticationUseCase,
+	) handler.ShortcutHandler {
+		return shortcut.NewShortcutHandlerImplementation(shortcutUC, authUC)
+	}
+
+	handlerTestRunner.ShortcutHandlerRunner(t, constructor)
+}
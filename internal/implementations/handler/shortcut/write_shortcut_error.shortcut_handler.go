@@ -0,0 +1,36 @@
+package shortcut
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeShortcutError maps a shortcut usecase error onto its HTTP status
+func writeShortcutError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr) && validationErr.Field == "keys":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusConflict)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// shortcutJSON is the JSON form of a keyboard shortcut
+func shortcutJSON(shortcut domain.KeyboardShortcut) map[string]interface{} {
+	return map[string]interface{}{
+		"action":       shortcut.Action,
+		"description":  shortcut.Description,
+		"keys":         shortcut.Keys,
+		"default_keys": shortcut.DefaultKeys,
+		"customized":   shortcut.Customized,
+		"disabled":     shortcut.Disabled,
+	}
+}
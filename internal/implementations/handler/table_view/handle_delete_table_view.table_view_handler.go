@@ -0,0 +1,44 @@
+package table_view
+
+import "net/http"
+
+func (h *TableViewHandlerImplementation) HandleDeleteTableView(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := r.FormValue("name")
+	if name == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = h.tableViewUC.DeleteTableView(r.Context(), session.Username, r.FormValue("database"), r.FormValue("schema"), r.FormValue("table"), name)
+	if err != nil {
+		writeTableViewError(w, err, "deleting view")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
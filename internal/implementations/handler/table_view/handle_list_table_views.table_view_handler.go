@@ -0,0 +1,46 @@
+package table_view
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *TableViewHandlerImplementation) HandleListTableViews(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	database := query.Get("database")
+	schema := query.Get("schema")
+	table := query.Get("table")
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	views, err := h.tableViewUC.ListTableViews(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		writeTableViewError(w, err, "listing views")
+		return
+	}
+
+	items := make([]map[string]interface{}, len(views))
+	for i, view := range views {
+		items[i] = tableViewJSON(view)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{"views": items})
+}
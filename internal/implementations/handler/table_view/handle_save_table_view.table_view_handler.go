@@ -0,0 +1,56 @@
+package table_view
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TableViewHandlerImplementation) HandleSaveTableView(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Hidden columns are repeated "hidden" fields
+	view, err := h.tableViewUC.SaveTableView(r.Context(), session.Username, domain.SavedTableView{
+		Name:          r.FormValue("name"),
+		Database:      r.FormValue("database"),
+		Schema:        r.FormValue("schema"),
+		Table:         r.FormValue("table"),
+		WhereClause:   r.FormValue("where"),
+		OrderBy:       r.FormValue("order_by"),
+		OrderDir:      r.FormValue("order_dir"),
+		HiddenColumns: r.Form["hidden"],
+		IsDefault:     r.FormValue("default") == "true",
+	})
+	if err != nil {
+		writeTableViewError(w, err, "saving view")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(tableViewJSON(*view))
+}
@@ -0,0 +1,21 @@
+package table_view
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type TableViewHandlerImplementation struct {
+	tableViewUC usecase.TableViewUseCase
+	authUC      usecase.AuthenticationUseCase
+}
+
+func NewTableViewHandlerImplementation(
+	tableViewUC usecase.TableViewUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.TableViewHandler {
+	return &TableViewHandlerImplementation{
+		tableViewUC: tableViewUC,
+		authUC:      authUC,
+	}
+}
@@ -0,0 +1,18 @@
+package table_view
+
+import "net/http"
+
+func (h *TableViewHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/table-views":
+		h.HandleListTableViews(w, r)
+	case "/api/table-views/save":
+		h.HandleSaveTableView(w, r)
+	case "/api/table-views/delete":
+		h.HandleDeleteTableView(w, r)
+	case "/api/table-views/default":
+		h.HandleSetDefaultTableView(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,21 @@
+package table_view_test
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/handler/table_view"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	handlerTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/handler"
+)
+
+func TestTableViewHandler(t *testing.T) {
+	constructor := func(
+		tableViewUC usecase.TableViewUseCase,
+		authUC usecase.AuthenticationUseCase,
+	) handler.TableViewHandler {
+		return table_view.NewTableViewHandlerImplementation(tableViewUC, authUC)
+	}
+
+	handlerTestRunner.TableViewHandlerRunner(t, constructor)
+}
@@ -0,0 +1,43 @@
+package table_view
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeTableViewError maps a table view usecase error onto its HTTP status
+func writeTableViewError(w http.ResponseWriter, err error, action string) {
+	var validationErr domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && validationErr.Field == "permission":
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusForbidden)
+	case errors.As(err, &validationErr):
+		http.Error(w, html.EscapeString(validationErr.Message), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrTableViewNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, "Error "+action+": "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// tableViewJSON is the JSON form of a saved table view
+func tableViewJSON(view domain.SavedTableView) map[string]interface{} {
+	hidden := view.HiddenColumns
+	if hidden == nil {
+		hidden = []string{}
+	}
+	return map[string]interface{}{
+		"name":           view.Name,
+		"database":       view.Database,
+		"schema":         view.Schema,
+		"table":          view.Table,
+		"where":          view.WhereClause,
+		"order_by":       view.OrderBy,
+		"order_dir":      view.OrderDir,
+		"hidden_columns": hidden,
+		"default":        view.IsDefault,
+	}
+}
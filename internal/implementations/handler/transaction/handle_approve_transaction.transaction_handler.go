@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleApproveTransaction(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	username := r.FormValue("username")
+	if username == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Approve the held commit as the current user
+	err = h.transactionUC.ApproveTransaction(r.Context(), session.Username, username)
+	if errors.Is(err, domain.ErrNotApprover) || errors.Is(err, domain.ErrSelfApproval) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("<div class='error'>You are not allowed to approve this commit</div>"))
+		return
+	}
+	if errors.Is(err, domain.ErrApprovalNotPending) || errors.Is(err, domain.ErrNoActiveTransaction) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte("<div class='error'>No commit is pending approval for this user</div>"))
+		return
+	}
+	if writeMaintenanceNotice(w, err) {
+		return
+	}
+	if err != nil {
+		http.Error(w, "Error approving transaction: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return success response
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("<div class='success'>Transaction approved and committed successfully</div>"))
+}
@@ -0,0 +1,66 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleCellValueOptions(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+	column := r.URL.Query().Get("column")
+
+	if database == "" || schema == "" || table == "" || column == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	options, err := h.transactionUC.CellValueOptions(r.Context(), session.Username, database, schema, table, column)
+	if errors.Is(err, domain.ErrNoActiveTransaction) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		http.Error(w, validationErr.Message, http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Error getting allowed values: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// An empty list means the cell takes free text
+	allowed := options.AllowedValues
+	if allowed == nil {
+		allowed = []string{}
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"column":         options.Column,
+		"allowed_values": allowed,
+		"source":         options.Source,
+	})
+}
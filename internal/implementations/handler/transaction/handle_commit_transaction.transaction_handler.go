@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"errors"
 	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (h *TransactionHandlerImplementation) HandleCommitTransaction(w http.ResponseWriter, r *http.Request) {
@@ -45,8 +48,27 @@ func (h *TransactionHandlerImplementation) HandleCommitTransaction(w http.Respon
 		return
 	}
 
-	// Commit transaction
-	err = h.transactionUC.CommitTransaction(r.Context(), session.Username)
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Commit transaction with the optional change reason
+	err = h.transactionUC.CommitTransaction(r.Context(), session.Username, r.FormValue("reason"))
+	if errors.Is(err, domain.ErrChangeReasonRequired) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("<div class='error'>A change reason is required to commit</div>"))
+		return
+	}
+	if errors.Is(err, domain.ErrCommitPendingApproval) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("<div class='warning'>Commit is pending approval by a second user</div>"))
+		return
+	}
+	if writeMaintenanceNotice(w, err) {
+		return
+	}
 	if err != nil {
 		http.Error(w, "Error committing transaction: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -0,0 +1,81 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleDeleteRows(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	params := domain.BulkDeleteParams{
+		Database:    r.FormValue("database"),
+		Schema:      r.FormValue("schema"),
+		Table:       r.FormValue("table"),
+		MatchFilter: r.FormValue("all") == "true",
+		WhereClause: r.FormValue("where"),
+	}
+	keys := r.FormValue("keys")
+
+	if params.Database == "" || params.Schema == "" || params.Table == "" || (!params.MatchFilter && keys == "") {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Selected rows arrive as a JSON array of primary key objects
+	if !params.MatchFilter {
+		decoder := json.NewDecoder(strings.NewReader(keys))
+		decoder.UseNumber()
+		if err := decoder.Decode(&params.Keys); err != nil {
+			http.Error(w, "Invalid keys: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := h.transactionUC.DeleteRows(r.Context(), session.Username, params)
+	if errors.Is(err, domain.ErrNoActiveTransaction) || errors.Is(err, domain.ErrCommitPendingApproval) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error deleting rows: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -1,7 +1,11 @@
 package transaction
 
 import (
+	"errors"
+	"html"
 	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (h *TransactionHandlerImplementation) HandleEditCell(w http.ResponseWriter, r *http.Request) {
@@ -62,6 +66,12 @@ func (h *TransactionHandlerImplementation) HandleEditCell(w http.ResponseWriter,
 	// Edit cell
 	err = h.transactionUC.EditCell(r.Context(), session.Username, database, schema, table, rowIndex, column, value)
 	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("<div class='error'>" + html.EscapeString(validationErr.Message) + "</div>"))
+			return
+		}
 		http.Error(w, "Error editing cell: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -0,0 +1,83 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// editJSONCellRequest is the JSON body of a structured JSONB cell edit
+type editJSONCellRequest struct {
+	Database string `json:"database"`
+	Schema   string `json:"schema"`
+	Table    string `json:"table"`
+	RowIndex int    `json:"row_index"`
+	Column   string `json:"column"`
+	// Current is the cell's value as read, used when the cell has no buffered edit yet
+	Current string `json:"current"`
+	Ops     []struct {
+		Op    string          `json:"op"`
+		Path  []string        `json:"path"`
+		Value json.RawMessage `json:"value"`
+	} `json:"ops"`
+}
+
+func (h *TransactionHandlerImplementation) HandleEditJSONCell(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request editJSONCellRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if request.Database == "" || request.Schema == "" || request.Table == "" || request.Column == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	ops := make([]domain.JSONPatchOp, len(request.Ops))
+	for i, op := range request.Ops {
+		ops[i] = domain.JSONPatchOp{Op: op.Op, Path: op.Path, Value: string(op.Value)}
+	}
+
+	edit, err := h.transactionUC.EditJSONCell(r.Context(), session.Username, request.Database, request.Schema, request.Table, request.RowIndex, request.Column, request.Current, ops)
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+		case errors.Is(err, domain.ErrNoActiveTransaction), errors.Is(err, domain.ErrCommitPendingApproval):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, "Error editing cell: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"row_index": edit.RowIndex,
+		"column":    edit.ColumnName,
+		"value":     edit.NewValue,
+	})
+}
@@ -0,0 +1,49 @@
+package transaction
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleExportTransaction(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = domain.TransactionExportSQL
+	}
+
+	export, err := h.transactionUC.ExportTransaction(r.Context(), session.Username, format)
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+		case errors.Is(err, domain.ErrNoActiveTransaction):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, "Error exporting transaction: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	w.WriteHeader(http.StatusOK)
+	w.Write(export.Content)
+}
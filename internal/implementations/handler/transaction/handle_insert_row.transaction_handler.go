@@ -1,7 +1,11 @@
 package transaction
 
 import (
+	"errors"
 	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (h *TransactionHandlerImplementation) HandleInsertRow(w http.ResponseWriter, r *http.Request) {
@@ -48,17 +52,40 @@ func (h *TransactionHandlerImplementation) HandleInsertRow(w http.ResponseWriter
 		return
 	}
 
-	// Build row data from form values
+	// Conflict handling comes from its own fields; the rest of the body is the row
+	onConflict := domain.ConflictAction{
+		Mode:          r.PostForm.Get("on_conflict"),
+		Target:        splitColumnList(r.PostForm.Get("conflict_target")),
+		UpdateColumns: splitColumnList(r.PostForm.Get("conflict_update")),
+	}
+
+	// Build row data from the posted form values
 	rowData := make(map[string]interface{})
-	for key, values := range r.Form {
+	for key, values := range r.PostForm {
+		if key == "on_conflict" || key == "conflict_target" || key == "conflict_update" {
+			continue
+		}
 		if len(values) > 0 {
 			rowData[key] = values[0]
 		}
 	}
 
 	// Insert row
-	err = h.transactionUC.InsertRow(r.Context(), session.Username, database, schema, table, rowData)
+	if onConflict.Mode == "" {
+		err = h.transactionUC.InsertRow(r.Context(), session.Username, database, schema, table, rowData)
+	} else {
+		err = h.transactionUC.UpsertRow(r.Context(), session.Username, database, schema, table, rowData, onConflict)
+	}
 	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
 		http.Error(w, "Error inserting row: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -67,3 +94,14 @@ func (h *TransactionHandlerImplementation) HandleInsertRow(w http.ResponseWriter
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("<div class='success inserted'>New row added to buffer</div>"))
 }
+
+// splitColumnList splits a comma-separated list of column names, dropping blanks
+func splitColumnList(list string) []string {
+	var columns []string
+	for _, column := range strings.Split(list, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
@@ -0,0 +1,65 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleRestoreCell(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	column := r.FormValue("column")
+	rowIndex, err := strconv.Atoi(r.FormValue("row_index"))
+	if column == "" || err != nil {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	edit, err := h.transactionUC.RestoreCellValue(r.Context(), session.Username, rowIndex, column)
+	if err != nil {
+		var validationErr domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			http.Error(w, validationErr.Message, http.StatusBadRequest)
+		case errors.Is(err, domain.ErrNoActiveTransaction), errors.Is(err, domain.ErrCommitPendingApproval):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, "Error restoring cell: "+err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// A nil edit means the cell is back to its original value
+	response := map[string]interface{}{
+		"row_index": rowIndex,
+		"column":    column,
+		"original":  edit == nil,
+		"edit":      edit,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,81 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleRestoreDeletedRows(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entryID := r.FormValue("entry_id")
+	if entryID == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Without confirm the request only previews the compensating INSERT
+	restore, err := h.transactionUC.RestoreDeletedRows(r.Context(), session.Username, entryID, r.FormValue("confirm"))
+	if errors.Is(err, domain.ErrDeletedRowsNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			switch validationErr.Field {
+			case "permission":
+				status = http.StatusForbidden
+			case "confirm", "entry_id":
+				status = http.StatusConflict
+			case "maintenance":
+				status = http.StatusServiceUnavailable
+			}
+			http.Error(w, html.EscapeString(validationErr.Message), status)
+			return
+		}
+		http.Error(w, "Error restoring rows: "+html.EscapeString(err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"entry_id":  restore.EntryID,
+		"database":  restore.Database,
+		"schema":    restore.Schema,
+		"table":     restore.Table,
+		"statement": restore.Statement,
+		"rows":      restore.Rows,
+		"applied":   restore.Applied,
+	})
+}
@@ -1,7 +1,13 @@
 package transaction
 
 import (
+	"fmt"
+	"html"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (h *TransactionHandlerImplementation) HandleStartTransaction(w http.ResponseWriter, r *http.Request) {
@@ -55,8 +61,17 @@ func (h *TransactionHandlerImplementation) HandleStartTransaction(w http.Respons
 		return
 	}
 
+	// Warn about locks the commit may block behind; the check is advisory and never stops the start
+	locks, err := h.transactionUC.CheckTableLocks(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		locks = nil
+	}
+
 	// Start transaction
 	txnState, err := h.transactionUC.StartTransaction(r.Context(), session.Username, database, schema, table)
+	if writeMaintenanceNotice(w, err) {
+		return
+	}
 	if err != nil {
 		http.Error(w, "Error starting transaction: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -68,5 +83,37 @@ func (h *TransactionHandlerImplementation) HandleStartTransaction(w http.Respons
 		<span class='indicator'>Transaction Active</span>
 		<span class='timer' data-seconds='60'>60s</span>
 		<span class='txn-id' style='display:none'>` + txnState.ID + `</span>
-	</div>`))
+	</div>` + renderLockWarning(locks)))
+}
+
+// renderLockWarning lists the sessions holding locks a commit may block behind, or renders nothing
+func renderLockWarning(locks []domain.TableLock) string {
+	if len(locks) == 0 {
+		return ""
+	}
+
+	var warning strings.Builder
+	warning.WriteString(`
+	<div class='lock-warning'>
+		<p>Other sessions hold locks on this table; your commit may block until they finish.</p>
+		<ul>`)
+	for _, lock := range locks {
+		status := "holds"
+		if !lock.Granted {
+			status = "awaits"
+		}
+		warning.WriteString(fmt.Sprintf(`
+			<li data-pid='%d'>%s %s %s`, lock.PID, html.EscapeString(lock.Username), status, html.EscapeString(lock.Mode)))
+		if !lock.HeldSince.IsZero() {
+			warning.WriteString(" for " + time.Since(lock.HeldSince).Round(time.Second).String())
+		}
+		if lock.Query != "" {
+			warning.WriteString(`<code class='lock-query'>` + html.EscapeString(lock.Query) + `</code>`)
+		}
+		warning.WriteString(`</li>`)
+	}
+	warning.WriteString(`
+		</ul>
+	</div>`)
+	return warning.String()
 }
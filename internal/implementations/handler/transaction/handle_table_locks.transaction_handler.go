@@ -0,0 +1,68 @@
+package transaction
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+func (h *TransactionHandlerImplementation) HandleTableLocks(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Get query parameters
+	database := r.URL.Query().Get("database")
+	schema := r.URL.Query().Get("schema")
+	table := r.URL.Query().Get("table")
+
+	if database == "" || schema == "" || table == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	locks, err := h.transactionUC.CheckTableLocks(r.Context(), session.Username, database, schema, table)
+	if err != nil {
+		http.Error(w, "Error checking table locks: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	blocking := make([]map[string]interface{}, 0, len(locks))
+	for _, lock := range locks {
+		entry := map[string]interface{}{
+			"pid":      lock.PID,
+			"username": lock.Username,
+			"mode":     lock.Mode,
+			"granted":  lock.Granted,
+		}
+		if !lock.HeldSince.IsZero() {
+			entry["held_since"] = lock.HeldSince.Format(time.RFC3339)
+		}
+		if lock.Query != "" || lock.ClientAddr != "" {
+			entry["state"] = lock.State
+			entry["query"] = lock.Query
+			entry["application_name"] = lock.ApplicationName
+			entry["client_addr"] = lock.ClientAddr
+		}
+		blocking = append(blocking, entry)
+	}
+
+	response := map[string]interface{}{
+		"may_block": len(blocking) > 0,
+		"locks":     blocking,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,64 @@
+package transaction
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleTransactionEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// Events are rare, so the stream opens at once rather than with the first one
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	sequence := 0
+	h.transactionUC.WatchTransaction(r.Context(), session.Username, func(event *domain.TransactionEvent) error {
+		sequence++
+		return writeTransactionEvent(w, flusher, sequence, event)
+	})
+}
+
+// writeTransactionEvent sends one transaction event as a server-sent event named after its type
+func writeTransactionEvent(w http.ResponseWriter, flusher http.Flusher, sequence int, event *domain.TransactionEvent) error {
+	payload := map[string]interface{}{
+		"transaction_id": event.TransactionID,
+		"at":             event.At.UTC().Format(time.RFC3339),
+	}
+	if event.Type == domain.TransactionEventIdleWarning {
+		payload["remaining_seconds"] = event.RemainingSeconds
+	}
+
+	if err := domain.WriteServerSentEvent(w, sequence, event.Type, payload); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
@@ -0,0 +1,88 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (h *TransactionHandlerImplementation) HandleUpdateRows(w http.ResponseWriter, r *http.Request) {
+	// Get session from cookie
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Validate session
+	session, err := h.authUC.ValidateSession(r.Context(), cookie.Value)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Parse form data
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Get parameters
+	update := domain.BulkUpdate{
+		Database:    r.FormValue("database"),
+		Schema:      r.FormValue("schema"),
+		Table:       r.FormValue("table"),
+		Column:      r.FormValue("column"),
+		Value:       r.FormValue("value"),
+		WhereClause: r.FormValue("where"),
+	}
+	if r.FormValue("null") == "true" {
+		update.Value = nil
+	}
+
+	if update.Database == "" || update.Schema == "" || update.Table == "" || update.Column == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	// Without a confirmed count the request only previews the affected rows
+	var result *domain.BulkUpdateResult
+	if confirm := r.FormValue("confirm"); confirm != "" {
+		update.ExpectedCount, err = strconv.ParseInt(confirm, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid confirm: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		result, err = h.transactionUC.UpdateRowsByFilter(r.Context(), session.Username, update)
+	} else {
+		result, err = h.transactionUC.PreviewBulkUpdate(r.Context(), session.Username, update)
+	}
+	if errors.Is(err, domain.ErrNoActiveTransaction) || errors.Is(err, domain.ErrCommitPendingApproval) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			status := http.StatusBadRequest
+			if validationErr.Field == "permission" {
+				status = http.StatusForbidden
+			}
+			if validationErr.Field == "confirm" {
+				status = http.StatusConflict
+			}
+			http.Error(w, validationErr.Message, status)
+			return
+		}
+		http.Error(w, "Error updating rows: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Return JSON response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -6,18 +6,46 @@ func (h *TransactionHandlerImplementation) ServeHTTP(w http.ResponseWriter, r *h
 	switch r.URL.Path {
 	case "/transaction/start":
 		h.HandleStartTransaction(w, r)
+	case "/api/transaction/locks":
+		h.HandleTableLocks(w, r)
 	case "/transaction/edit-cell":
-		h.HandleEditCell(w, r)
+		if r.Method == http.MethodGet {
+			h.HandleCellValueOptions(w, r)
+		} else {
+			h.HandleEditCell(w, r)
+		}
+	case "/api/transaction/edit-json":
+		h.HandleEditJSONCell(w, r)
+	case "/transaction/restore-cell":
+		h.HandleRestoreCell(w, r)
 	case "/transaction/delete-row":
 		h.HandleDeleteRow(w, r)
+	case "/api/transaction/delete-rows":
+		h.HandleDeleteRows(w, r)
+	case "/api/transaction/update-rows":
+		h.HandleUpdateRows(w, r)
 	case "/transaction/insert-row":
-		h.HandleInsertRow(w, r)
+		if r.Method == http.MethodGet {
+			h.HandleNewRowDefaults(w, r)
+		} else {
+			h.HandleInsertRow(w, r)
+		}
 	case "/transaction/commit":
 		h.HandleCommitTransaction(w, r)
+	case "/transaction/approve":
+		h.HandleApproveTransaction(w, r)
 	case "/transaction/rollback":
 		h.HandleRollbackTransaction(w, r)
 	case "/transaction/status":
 		h.HandleGetTransactionStatus(w, r)
+	case "/transaction/export":
+		h.HandleExportTransaction(w, r)
+	case "/api/transaction/restore-deleted":
+		h.HandleRestoreDeletedRows(w, r)
+	case "/api/transaction/heartbeat":
+		h.HandleTransactionHeartbeat(w, r)
+	case "/api/transaction/events":
+		h.HandleTransactionEvents(w, r)
 	default:
 		http.NotFound(w, r)
 	}
@@ -0,0 +1,22 @@
+package transaction
+
+import (
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeMaintenanceNotice renders the maintenance message when err is a maintenance mode refusal, and
+// reports whether it did
+func writeMaintenanceNotice(w http.ResponseWriter, err error) bool {
+	var validationErr domain.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "maintenance" {
+		return false
+	}
+
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write([]byte("<div class='maintenance'>" + html.EscapeString(validationErr.Message) + "</div>"))
+	return true
+}
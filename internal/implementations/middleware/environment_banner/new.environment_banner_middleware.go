@@ -0,0 +1,18 @@
+package environment_banner
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/middleware"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type EnvironmentBannerMiddlewareImplementation struct {
+	sessionAdminUC usecase.SessionAdminUseCase
+}
+
+func NewEnvironmentBannerMiddlewareImplementation(
+	sessionAdminUC usecase.SessionAdminUseCase,
+) middleware.EnvironmentBannerMiddleware {
+	return &EnvironmentBannerMiddlewareImplementation{
+		sessionAdminUC: sessionAdminUC,
+	}
+}
@@ -0,0 +1,114 @@
+package environment_banner
+
+import (
+	"bytes"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *EnvironmentBannerMiddlewareImplementation) RenderBanner(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The banner is a safeguard, so a failure to read it never fails the page
+		banner, err := m.sessionAdminUC.GetEnvironmentBanner(r.Context())
+		if err != nil || banner == nil || banner.Name == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		writer := &bannerWriter{ResponseWriter: w}
+		next.ServeHTTP(writer, r)
+		writer.finish(banner)
+	})
+}
+
+// bannerWriter holds back HTML responses so the banner can be added once the page is complete, and
+// passes every other response straight through
+type bannerWriter struct {
+	http.ResponseWriter
+	decided   bool
+	buffering bool
+	status    int
+	body      bytes.Buffer
+}
+
+// decide buffers the response when the handler is writing HTML
+func (b *bannerWriter) decide(status int) {
+	if b.decided {
+		return
+	}
+	b.decided = true
+	b.status = status
+	b.buffering = strings.HasPrefix(b.Header().Get("Content-Type"), "text/html")
+	if !b.buffering {
+		b.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (b *bannerWriter) WriteHeader(status int) {
+	b.decide(status)
+}
+
+func (b *bannerWriter) Write(data []byte) (int, error) {
+	b.decide(http.StatusOK)
+	if b.buffering {
+		return b.body.Write(data)
+	}
+	return b.ResponseWriter.Write(data)
+}
+
+// Flush keeps streamed responses streaming; buffered pages are written whole at the end
+func (b *bannerWriter) Flush() {
+	if b.buffering {
+		return
+	}
+	if flusher, ok := b.ResponseWriter.(http.Flusher); ok {
+		b.decide(http.StatusOK)
+		flusher.Flush()
+	}
+}
+
+// finish writes a buffered page with the banner added
+func (b *bannerWriter) finish(banner *domain.EnvironmentBanner) {
+	if !b.buffering {
+		return
+	}
+
+	page := withBanner(b.body.String(), banner)
+	b.Header().Del("Content-Length")
+	b.ResponseWriter.WriteHeader(b.status)
+	b.ResponseWriter.Write([]byte(page))
+}
+
+// withBanner prefixes the page title with the environment name and opens the body with the banner;
+// fragments without a body element are left as they are
+func withBanner(page string, banner *domain.EnvironmentBanner) string {
+	bodyStart := strings.Index(page, "<body")
+	if bodyStart < 0 {
+		return page
+	}
+	bodyEnd := strings.Index(page[bodyStart:], ">")
+	if bodyEnd < 0 {
+		return page
+	}
+	bodyEnd += bodyStart + 1
+
+	name := html.EscapeString(banner.Name)
+	bar := `
+	<div id="environment-banner" style="position: sticky; top: 0; z-index: 1000; background: ` + html.EscapeString(banner.Color) +
+		`; color: #fff; text-align: center; font-weight: bold; letter-spacing: 2px; padding: 4px 0;">` + name + `</div>`
+	page = page[:bodyEnd] + bar + page[bodyEnd:]
+
+	if titleStart := strings.Index(page[:bodyStart], "<title>"); titleStart >= 0 {
+		titleStart += len("<title>")
+		page = page[:titleStart] + "[" + name + "] " + page[titleStart:]
+	}
+	return page
+}
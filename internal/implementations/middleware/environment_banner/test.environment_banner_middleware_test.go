@@ -0,0 +1,11 @@
+package environment_banner
+
+import (
+	"testing"
+
+	middlewareTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/middleware"
+)
+
+func TestEnvironmentBannerMiddleware(t *testing.T) {
+	middlewareTestRunner.EnvironmentBannerMiddlewareRunner(t, NewEnvironmentBannerMiddlewareImplementation)
+}
@@ -0,0 +1,22 @@
+package session_activity
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/middleware"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SessionActivityMiddlewareImplementation struct {
+	authUC        usecase.AuthenticationUseCase
+	timeoutConfig domain.SessionTimeoutConfig
+}
+
+func NewSessionActivityMiddlewareImplementation(
+	authUC usecase.AuthenticationUseCase,
+	timeoutConfig domain.SessionTimeoutConfig,
+) middleware.SessionActivityMiddleware {
+	return &SessionActivityMiddlewareImplementation{
+		authUC:        authUC,
+		timeoutConfig: timeoutConfig,
+	}
+}
@@ -0,0 +1,11 @@
+package session_activity
+
+import (
+	"testing"
+
+	middlewareTestRunner "github.com/kamil5b/lumen-pg/internal/testrunners/middleware"
+)
+
+func TestSessionActivityMiddleware(t *testing.T) {
+	middlewareTestRunner.SessionActivityMiddlewareRunner(t, NewSessionActivityMiddlewareImplementation)
+}
@@ -0,0 +1,53 @@
+package session_activity
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *SessionActivityMiddlewareImplementation) TrackActivity(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(domain.CookieSessionID)
+		if err != nil || cookie.Value == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Validating the session records the activity, or destroys the session once it has idled out;
+		// handlers still validate it themselves and answer unauthenticated requests as before
+		session, err := m.authUC.ValidateSession(r.Context(), cookie.Value)
+		switch {
+		case err == nil:
+			// The request's queries run on the session's own connection pool, as its user on its server
+			ctx, err := m.authUC.BindSessionConnection(r.Context(), session)
+			if err != nil {
+				http.Error(w, "Database connection unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			r = r.WithContext(ctx)
+
+			if m.timeoutConfig.IdleTimeout > 0 {
+				http.SetCookie(w, &http.Cookie{
+					Name:     domain.CookieSessionID,
+					Value:    cookie.Value,
+					Path:     "/",
+					MaxAge:   int(m.timeoutConfig.IdleTimeout.Seconds()),
+					HttpOnly: true,
+					Secure:   r.TLS != nil,
+					SameSite: http.SameSiteStrictMode,
+				})
+			}
+		case errors.Is(err, domain.ErrSessionExpired):
+			http.SetCookie(w, &http.Cookie{
+				Name:   domain.CookieSessionID,
+				Value:  "",
+				Path:   "/",
+				MaxAge: -1,
+			})
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
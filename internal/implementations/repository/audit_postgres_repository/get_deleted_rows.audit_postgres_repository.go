@@ -0,0 +1,34 @@
+package audit_postgres_repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditPostgresRepositoryImplementation) GetDeletedRows(ctx context.Context, entryID string) (*domain.DeletedRows, error) {
+	rows := domain.DeletedRows{EntryID: entryID}
+	var encoded []byte
+	err := a.db.QueryRowContext(ctx,
+		"SELECT database_name, schema_name, table_name, deleted_rows, expires_at FROM "+deletedRowsTable+" WHERE entry_id = $1 AND expires_at > now()",
+		entryID).Scan(&rows.Database, &rows.Schema, &rows.Table, &encoded, &rows.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDeletedRowsNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted rows: %w", err)
+	}
+
+	// Numbers stay json.Number so bigint keys survive the round trip exactly
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&rows.Rows); err != nil {
+		return nil, fmt.Errorf("failed to decode deleted rows: %w", err)
+	}
+	return &rows, nil
+}
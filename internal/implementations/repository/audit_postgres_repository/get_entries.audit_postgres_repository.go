@@ -0,0 +1,88 @@
+package audit_postgres_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditPostgresRepositoryImplementation) GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = domain.AuditDefaultLimit
+	}
+
+	var conditions []string
+	var args []interface{}
+	for _, condition := range []struct {
+		column string
+		value  string
+	}{
+		{"username", filter.Username},
+		{"action", filter.Action},
+		{"database_name", filter.Database},
+		{"schema_name", filter.Schema},
+		{"table_name", filter.Table},
+	} {
+		if condition.value == "" {
+			continue
+		}
+		args = append(args, condition.value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", condition.column, len(args)))
+	}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if !filter.Until.IsZero() {
+		args = append(args, filter.Until)
+		conditions = append(conditions, fmt.Sprintf("created_at <= $%d", len(args)))
+	}
+
+	query := "SELECT id, username, action, database_name, schema_name, table_name, target, reason, affected_rows, before_values, after_values, approved_by, created_at FROM " + auditLogTable
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args))
+
+	rows, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.AuditEntry, 0)
+	for rows.Next() {
+		var entry domain.AuditEntry
+		var affectedRows, before, after []byte
+		if err := rows.Scan(&entry.ID, &entry.Username, &entry.Action, &entry.Database, &entry.Schema, &entry.Table, &entry.Target,
+			&entry.Reason, &affectedRows, &before, &after, &entry.ApprovedBy, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read audit entry: %w", err)
+		}
+		if err := json.Unmarshal(affectedRows, &entry.AffectedRows); err != nil {
+			return nil, fmt.Errorf("failed to decode affected rows: %w", err)
+		}
+		if len(entry.AffectedRows) == 0 {
+			entry.AffectedRows = nil
+		}
+		if before != nil {
+			if err := json.Unmarshal(before, &entry.Before); err != nil {
+				return nil, fmt.Errorf("failed to decode audit values: %w", err)
+			}
+		}
+		if after != nil {
+			if err := json.Unmarshal(after, &entry.After); err != nil {
+				return nil, fmt.Errorf("failed to decode audit values: %w", err)
+			}
+		}
+		result = append(result, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+	return result, nil
+}
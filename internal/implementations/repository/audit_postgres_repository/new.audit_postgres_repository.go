@@ -0,0 +1,26 @@
+package audit_postgres_repository
+
+import (
+	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// auditLogTable is created by the app schema migrations
+const auditLogTable = domain.AppSchema + ".audit_log"
+
+// deletedRowsTable keeps the rows commits deleted until they expire
+const deletedRowsTable = domain.AppSchema + ".audit_deleted_rows"
+
+// AuditPostgresRepositoryImplementation keeps the audit trail in lumen-pg's own schema, where it
+// survives restarts and can be queried with SQL
+type AuditPostgresRepositoryImplementation struct {
+	db *sql.DB
+}
+
+func NewAuditPostgresRepository(db *sql.DB) repository.AuditRepository {
+	return &AuditPostgresRepositoryImplementation{
+		db: db,
+	}
+}
@@ -0,0 +1,41 @@
+package audit_postgres_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditPostgresRepositoryImplementation) RecordDeletedRows(ctx context.Context, rows *domain.DeletedRows) error {
+	if rows == nil {
+		return errors.New("deleted rows cannot be nil")
+	}
+	if rows.EntryID == "" {
+		return errors.New("deleted rows need an audit entry ID")
+	}
+
+	deleted := rows.Rows
+	if deleted == nil {
+		deleted = []map[string]interface{}{}
+	}
+	encoded, err := json.Marshal(deleted)
+	if err != nil {
+		return fmt.Errorf("failed to encode deleted rows: %w", err)
+	}
+
+	// Drop whatever has expired so the table only grows with the retention window
+	if _, err := a.db.ExecContext(ctx, "DELETE FROM "+deletedRowsTable+" WHERE expires_at <= now()"); err != nil {
+		return fmt.Errorf("failed to remove expired deleted rows: %w", err)
+	}
+
+	_, err = a.db.ExecContext(ctx,
+		"INSERT INTO "+deletedRowsTable+" (entry_id, database_name, schema_name, table_name, deleted_rows, expires_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		rows.EntryID, rows.Database, rows.Schema, rows.Table, encoded, rows.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to record deleted rows: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package audit_postgres_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditPostgresRepositoryImplementation) RecordEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	if entry == nil {
+		return errors.New("audit entry cannot be nil")
+	}
+
+	if entry.ID == "" {
+		entry.ID = "audit_" + uuid.New().String()
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now().Truncate(time.Microsecond)
+	}
+
+	affectedRows := entry.AffectedRows
+	if affectedRows == nil {
+		affectedRows = []map[string]interface{}{}
+	}
+	encoded, err := json.Marshal(affectedRows)
+	if err != nil {
+		return fmt.Errorf("failed to encode affected rows: %w", err)
+	}
+
+	before, err := encodeValues(entry.Before)
+	if err != nil {
+		return err
+	}
+	after, err := encodeValues(entry.After)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.db.ExecContext(ctx,
+		"INSERT INTO "+auditLogTable+" (id, username, action, database_name, schema_name, table_name, target, reason, affected_rows, before_values, after_values, approved_by, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+		entry.ID, entry.Username, entry.Action, entry.Database, entry.Schema, entry.Table, entry.Target, entry.Reason, encoded, before, after, entry.ApprovedBy, entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+	return nil
+}
+
+// encodeValues writes before or after values as jsonb, leaving the column NULL when there are none
+func encodeValues(values map[string]interface{}) ([]byte, error) {
+	if values == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit values: %w", err)
+	}
+	return encoded, nil
+}
@@ -0,0 +1,12 @@
+package audit_postgres_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/migration_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestAuditPostgresRepository(t *testing.T) {
+	testRunner.AuditPostgresRepositoryRunner(t, migration_repository.NewMigrationRepository, NewAuditPostgresRepository)
+}
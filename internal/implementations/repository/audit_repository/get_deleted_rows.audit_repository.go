@@ -0,0 +1,19 @@
+package audit_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditRepositoryImplementation) GetDeletedRows(ctx context.Context, entryID string) (*domain.DeletedRows, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	rows, ok := a.deleted[entryID]
+	if !ok || !rows.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrDeletedRowsNotFound
+	}
+	return &rows, nil
+}
@@ -0,0 +1,47 @@
+package audit_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditRepositoryImplementation) GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = domain.AuditDefaultLimit
+	}
+
+	// Entries are appended in order, so walk backwards for newest first
+	result := make([]domain.AuditEntry, 0)
+	for i := len(a.entries) - 1; i >= 0 && len(result) < limit; i-- {
+		entry := a.entries[i]
+		if filter.Username != "" && entry.Username != filter.Username {
+			continue
+		}
+		if filter.Action != "" && entry.Action != filter.Action {
+			continue
+		}
+		if filter.Database != "" && entry.Database != filter.Database {
+			continue
+		}
+		if filter.Schema != "" && entry.Schema != filter.Schema {
+			continue
+		}
+		if filter.Table != "" && entry.Table != filter.Table {
+			continue
+		}
+		if !filter.Since.IsZero() && entry.CreatedAt.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && entry.CreatedAt.After(filter.Until) {
+			continue
+		}
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
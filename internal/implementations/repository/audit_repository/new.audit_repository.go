@@ -0,0 +1,21 @@
+package audit_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type AuditRepositoryImplementation struct {
+	mu      sync.RWMutex
+	entries []domain.AuditEntry
+	deleted map[string]domain.DeletedRows
+}
+
+func NewAuditRepository() repository.AuditRepository {
+	return &AuditRepositoryImplementation{
+		entries: make([]domain.AuditEntry, 0),
+		deleted: make(map[string]domain.DeletedRows),
+	}
+}
@@ -0,0 +1,32 @@
+package audit_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditRepositoryImplementation) RecordDeletedRows(ctx context.Context, rows *domain.DeletedRows) error {
+	if rows == nil {
+		return errors.New("deleted rows cannot be nil")
+	}
+	if rows.EntryID == "" {
+		return errors.New("deleted rows need an audit entry ID")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Drop whatever has expired so the map only grows with the retention window
+	now := time.Now()
+	for id, kept := range a.deleted {
+		if !kept.ExpiresAt.After(now) {
+			delete(a.deleted, id)
+		}
+	}
+
+	a.deleted[rows.EntryID] = *rows
+	return nil
+}
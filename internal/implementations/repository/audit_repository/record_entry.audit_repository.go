@@ -0,0 +1,29 @@
+package audit_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *AuditRepositoryImplementation) RecordEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	if entry == nil {
+		return errors.New("audit entry cannot be nil")
+	}
+
+	if entry.ID == "" {
+		entry.ID = "audit_" + uuid.New().String()
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.entries = append(a.entries, *entry)
+	return nil
+}
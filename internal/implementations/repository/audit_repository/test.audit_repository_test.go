@@ -0,0 +1,11 @@
+package audit_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestAuditRepository(t *testing.T) {
+	testRunner.AuditRepositoryRunner(t, NewAuditRepository)
+}
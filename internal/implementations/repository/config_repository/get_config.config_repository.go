@@ -0,0 +1,19 @@
+package config_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *ConfigRepositoryImplementation) GetConfig(ctx context.Context) (*domain.AppConfig, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Hand out a copy so callers cannot mutate the stored settings
+	config := c.config
+	config.SensitiveTables = append([]string(nil), c.config.SensitiveTables...)
+	config.Approvers = append([]string(nil), c.config.Approvers...)
+	config.ConnectionProfiles = append([]domain.ConnectionProfile(nil), c.config.ConnectionProfiles...)
+	return &config, nil
+}
@@ -0,0 +1,19 @@
+package config_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type ConfigRepositoryImplementation struct {
+	mu     sync.RWMutex
+	config domain.AppConfig
+}
+
+func NewConfigRepository(config domain.AppConfig) repository.ConfigRepository {
+	return &ConfigRepositoryImplementation{
+		config: config,
+	}
+}
@@ -0,0 +1,11 @@
+package config_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestConfigRepository(t *testing.T) {
+	testRunner.ConfigRepositoryRunner(t, NewConfigRepository)
+}
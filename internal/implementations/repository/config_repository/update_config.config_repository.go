@@ -0,0 +1,23 @@
+package config_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *ConfigRepositoryImplementation) UpdateConfig(ctx context.Context, config *domain.AppConfig) error {
+	if config == nil {
+		return errors.New("config cannot be nil")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.config = *config
+	c.config.SensitiveTables = append([]string(nil), config.SensitiveTables...)
+	c.config.Approvers = append([]string(nil), config.Approvers...)
+	c.config.ConnectionProfiles = append([]domain.ConnectionProfile(nil), config.ConnectionProfiles...)
+	return nil
+}
@@ -3,9 +3,17 @@ package database_repository
 import (
 	"context"
 	"database/sql"
-	"errors"
+	"fmt"
 )
 
 func (d *DatabaseRepositoryImplementation) BeginTransaction(ctx context.Context) (*sql.Tx, error) {
-	return nil, errors.New("not implemented")
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	tx, err := d.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
 }
@@ -0,0 +1,27 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (d *DatabaseRepositoryImplementation) CancelTrackedQuery(ctx context.Context, key string) (bool, error) {
+	if d.conn(ctx) == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	d.runningMu.Lock()
+	pid, ok := d.runningQueries[key]
+	d.runningMu.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	var cancelled bool
+	if err := d.conn(ctx).QueryRowContext(ctx, "SELECT pg_cancel_backend($1)", pid).Scan(&cancelled); err != nil {
+		return false, fmt.Errorf("failed to cancel query: %w", err)
+	}
+
+	return cancelled, nil
+}
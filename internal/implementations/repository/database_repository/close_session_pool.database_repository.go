@@ -0,0 +1,18 @@
+package database_repository
+
+func (d *DatabaseRepositoryImplementation) CloseSessionPool(key string) error {
+	// A frozen view holds a connection of its own until released
+	if err := d.ReleaseSnapshot(key); err != nil {
+		return err
+	}
+
+	d.poolsMu.Lock()
+	pool, ok := d.pools[key]
+	delete(d.pools, key)
+	d.poolsMu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return pool.db.Close()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	_ "github.com/lib/pq"
 )
 
@@ -16,5 +17,21 @@ func (d *DatabaseRepositoryImplementation) Connect(ctx context.Context, connStri
 		return err
 	}
 
+	// The shared connection serves requests outside any session, so it holds slots of the target
+	// database's cap like the session pools do
+	d.poolsMu.Lock()
+	tagged := d.taggedConnStringLocked(connString, domain.ApplicationFeatureShared)
+	d.sharedConnString = connString
+	d.poolsMu.Unlock()
+
+	db, err := d.openLimited(tagged)
+	if err != nil {
+		return err
+	}
+	if d.connLimits.capped(connStringDatabase(connString)) {
+		db.SetMaxIdleConns(0)
+	}
+	d.db = db
+
 	return nil
 }
@@ -0,0 +1,264 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// connLimiter hands out a slot per connection opened to a capped database, queueing callers until one
+// is free or the queue timeout passes
+type connLimiter struct {
+	mu           sync.Mutex
+	slots        map[string]chan struct{}
+	queueTimeout time.Duration
+}
+
+func newConnLimiter() *connLimiter {
+	return &connLimiter{slots: make(map[string]chan struct{}), queueTimeout: domain.DefaultPoolQueueTimeout}
+}
+
+// configure replaces the caps; connections opened under the old caps give their slots back to them
+func (l *connLimiter) configure(caps map[string]int, queueTimeout time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.slots = make(map[string]chan struct{}, len(caps))
+	for database, limit := range caps {
+		if limit > 0 {
+			l.slots[database] = make(chan struct{}, limit)
+		}
+	}
+	l.queueTimeout = queueTimeout
+}
+
+// capped reports whether connections to database are limited
+func (l *connLimiter) capped(database string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.slots[database]
+	return ok
+}
+
+// acquire waits for a connection slot for database, returning the function that frees it
+func (l *connLimiter) acquire(ctx context.Context, database string) (func(), error) {
+	l.mu.Lock()
+	slots, ok := l.slots[database]
+	queueTimeout := l.queueTimeout
+	l.mu.Unlock()
+	if !ok {
+		return func() {}, nil
+	}
+
+	timer := time.NewTimer(queueTimeout)
+	defer timer.Stop()
+	select {
+	case slots <- struct{}{}:
+		var once sync.Once
+		return func() { once.Do(func() { <-slots }) }, nil
+	case <-timer.C:
+		return nil, domain.ErrServerBusy
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// openLimited opens a database handle whose connections each hold a slot of the target database's cap.
+// When the connection string switches to a role, as the role-mapped SSO and LDAP logins' do, statements
+// that could leave it are refused and the role is set again each time a connection is reused.
+func (d *DatabaseRepositoryImplementation) openLimited(connString string) (*sql.DB, error) {
+	connector, err := pq.NewConnector(connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database connection: %w", err)
+	}
+	return sql.OpenDB(&limitedConnector{
+		base:     connector,
+		limits:   d.connLimits,
+		database: connStringDatabase(connString),
+		role:     connStringRole(connString),
+	}), nil
+}
+
+// connStringDatabase returns the database a URL or key/value connection string targets
+func connStringDatabase(connString string) string {
+	if parsed, err := url.Parse(connString); err == nil && (parsed.Scheme == "postgres" || parsed.Scheme == "postgresql") {
+		return strings.TrimPrefix(parsed.Path, "/")
+	}
+	for _, field := range strings.Fields(connString) {
+		if value, ok := strings.CutPrefix(field, "dbname="); ok {
+			return strings.Trim(value, "'")
+		}
+	}
+	return ""
+}
+
+// connStringRole returns the role a URL connection string switches to with an options "-c role=" or
+// "--role=" argument, its backslash escapes undone; empty when it sets none
+func connStringRole(connString string) string {
+	parsed, err := url.Parse(connString)
+	if err != nil || (parsed.Scheme != "postgres" && parsed.Scheme != "postgresql") {
+		return ""
+	}
+
+	var args []string
+	var current strings.Builder
+	escaped := false
+	for _, r := range parsed.Query().Get("options") {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ' ':
+			if current.Len() > 0 {
+				args = append(args, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		args = append(args, current.String())
+	}
+
+	role := ""
+	for i, arg := range args {
+		setting, ok := strings.CutPrefix(arg, "--")
+		if !ok && arg == "-c" && i+1 < len(args) {
+			setting = args[i+1]
+		} else if !ok {
+			setting, _ = strings.CutPrefix(arg, "-c")
+		}
+		if value, ok := strings.CutPrefix(setting, "role="); ok {
+			role = value
+		}
+	}
+	return role
+}
+
+type limitedConnector struct {
+	base     driver.Connector
+	limits   *connLimiter
+	database string
+	// role is the role the connection string switches to, or empty
+	role string
+}
+
+func (c *limitedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	release, err := c.limits.acquire(ctx, c.database)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := c.base.Connect(ctx)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &limitedConn{Conn: conn, release: release, role: c.role}, nil
+}
+
+func (c *limitedConnector) Driver() driver.Driver {
+	return c.base.Driver()
+}
+
+// limitedConn frees its slot when closed, passing the optional driver interfaces through to pq; with a
+// role it refuses statements that could switch away from it
+type limitedConn struct {
+	driver.Conn
+	release func()
+	role    string
+}
+
+// checkRole refuses a statement that could leave the connection's role
+func (c *limitedConn) checkRole(query string) error {
+	if c.role != "" && domain.ChangesSessionRole(query) {
+		return domain.ErrRoleChangeRefused
+	}
+	return nil
+}
+
+func (c *limitedConn) Close() error {
+	defer c.release()
+	return c.Conn.Close()
+}
+
+func (c *limitedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.checkRole(query); err != nil {
+		return nil, err
+	}
+	if queryer, ok := c.Conn.(driver.QueryerContext); ok {
+		return queryer.QueryContext(ctx, query, args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c *limitedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.checkRole(query); err != nil {
+		return nil, err
+	}
+	if execer, ok := c.Conn.(driver.ExecerContext); ok {
+		return execer.ExecContext(ctx, query, args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c *limitedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.checkRole(query); err != nil {
+		return nil, err
+	}
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *limitedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c *limitedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// ResetSession runs before a used connection is handed out again; a role-locked connection sets its
+// role again there, so a change that slipped past checkRole lasts no longer than the statement making it
+func (c *limitedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		if err := resetter.ResetSession(ctx); err != nil {
+			return err
+		}
+	}
+	if c.role == "" {
+		return nil
+	}
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return driver.ErrBadConn
+	}
+	if _, err := execer.ExecContext(ctx, "SET ROLE "+pq.QuoteIdentifier(c.role), nil); err != nil {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+func (c *limitedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) CopyQueryCSV(ctx context.Context, params domain.QueryParams, w io.Writer) (int64, error) {
+	// pq cannot read a COPY TO response, so the statement runs on a pgconn connection opened with the
+	// credentials of the pool the request would otherwise query
+	connString := d.contextConnString(ctx)
+	if connString == "" {
+		return 0, domain.ErrCopyUnavailable
+	}
+	statement := "COPY (" + exportQuery(params) + ") TO STDOUT WITH (FORMAT csv, HEADER true)"
+	if connStringRole(connString) != "" && domain.ChangesSessionRole(statement) {
+		return 0, domain.ErrRoleChangeRefused
+	}
+
+	d.poolsMu.Lock()
+	tagged := d.taggedConnStringLocked(connString, domain.ApplicationFeatureExport)
+	d.poolsMu.Unlock()
+
+	// The connection holds a slot of the database's cap like the pooled ones do
+	releaseSlot, err := d.connLimits.acquire(ctx, connStringDatabase(connString))
+	if err != nil {
+		return 0, err
+	}
+	defer releaseSlot()
+
+	conn, err := pgconn.Connect(ctx, tagged)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", domain.ErrCopyUnavailable, err)
+	}
+	defer conn.Close(context.Background())
+
+	if err := applyCopySettings(ctx, conn, params.SearchPath, params.Settings); err != nil {
+		return 0, err
+	}
+
+	if params.TrackingKey != "" {
+		pid := int(conn.PID())
+		d.runningMu.Lock()
+		d.runningQueries[params.TrackingKey] = pid
+		d.runningMu.Unlock()
+		defer func() {
+			d.runningMu.Lock()
+			if d.runningQueries[params.TrackingKey] == pid {
+				delete(d.runningQueries, params.TrackingKey)
+			}
+			d.runningMu.Unlock()
+		}()
+	}
+
+	tag, err := conn.CopyTo(ctx, w, statement)
+	if err != nil {
+		return 0, queryError(err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// contextConnString is the connection string of the session pool bound to ctx, or of the shared
+// connection outside a session; empty when neither is known
+func (d *DatabaseRepositoryImplementation) contextConnString(ctx context.Context) string {
+	d.poolsMu.Lock()
+	defer d.poolsMu.Unlock()
+
+	if db := domain.SessionConnection(ctx, nil); db != nil {
+		for _, pool := range d.pools {
+			if pool.db == db {
+				return pool.connString
+			}
+		}
+		return ""
+	}
+	return d.sharedConnString
+}
+
+// applyCopySettings sets the search_path and session presets pinConnection would on a pooled
+// connection; the COPY connection is closed afterwards, so nothing is reset
+func applyCopySettings(ctx context.Context, conn *pgconn.PgConn, searchPath []string, settings domain.SessionSettings) error {
+	setConfig := func(name, value string) error {
+		result := conn.ExecParams(ctx, "SELECT set_config($1, $2, false)", [][]byte{[]byte(name), []byte(value)}, nil, nil, nil).Read()
+		if result.Err != nil {
+			return fmt.Errorf("failed to set %s: %w", name, result.Err)
+		}
+		return nil
+	}
+
+	if len(searchPath) > 0 {
+		quoted := make([]string, len(searchPath))
+		for i, schema := range searchPath {
+			quoted[i] = pq.QuoteIdentifier(schema)
+		}
+		if err := setConfig("search_path", strings.Join(quoted, ", ")); err != nil {
+			return err
+		}
+	}
+	if settings.WorkMem != "" {
+		if err := setConfig("work_mem", settings.WorkMem); err != nil {
+			return err
+		}
+	}
+	if settings.StatementTimeout > 0 {
+		if err := setConfig("statement_timeout", strconv.FormatInt(settings.StatementTimeout.Milliseconds(), 10)); err != nil {
+			return err
+		}
+	}
+	if settings.ApplicationNameSuffix != "" {
+		if err := setConfig("application_name", conn.ParameterStatus("application_name")+" "+settings.ApplicationNameSuffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
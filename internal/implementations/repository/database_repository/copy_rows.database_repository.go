@@ -0,0 +1,54 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) CopyRows(ctx context.Context, key, schema, table string, columns []string, rows [][]interface{}) (int64, error) {
+	conn, release, err := d.pinConnection(ctx, key, domain.ApplicationFeatureImport, nil, domain.SessionSettings{})
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	copyStatement := pq.CopyIn(table, columns...)
+	if schema != "" {
+		copyStatement = pq.CopyInSchema(schema, table, columns...)
+	}
+	stmt, err := tx.PrepareContext(ctx, copyStatement)
+	if err != nil {
+		return 0, queryError(err)
+	}
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			stmt.Close()
+			return 0, queryError(err)
+		}
+	}
+
+	// An empty Exec flushes the buffered rows and reports COPY errors
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		stmt.Close()
+		return 0, queryError(err)
+	}
+	if err := stmt.Close(); err != nil {
+		return 0, queryError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, queryError(err)
+	}
+
+	return int64(len(rows)), nil
+}
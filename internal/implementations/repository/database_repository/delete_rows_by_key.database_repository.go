@@ -0,0 +1,62 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) DeleteRowsByKey(ctx context.Context, tx *sql.Tx, database, schema, table string, keys []map[string]interface{}) ([]map[string]interface{}, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("transaction is nil")
+	}
+
+	deleted := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if len(key) == 0 {
+			return nil, fmt.Errorf("no primary key values to delete by")
+		}
+
+		columns := sortedKeys(key)
+		conditions := make([]string, len(columns))
+		args := make([]interface{}, len(columns))
+		for i, column := range columns {
+			conditions[i] = fmt.Sprintf("%s = $%d", pq.QuoteIdentifier(column), i+1)
+			args[i] = key[column]
+		}
+
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s RETURNING *", qualifiedTableName(schema, table), strings.Join(conditions, " AND "))
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, queryError(err)
+		}
+		result, err := scanQueryResult(rows)
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		// Each key was buffered for exactly one row; a row gone or changed since must not pass silently
+		if result.RowCount != 1 {
+			return nil, domain.ValidationError{
+				Field:   "keys",
+				Message: fmt.Sprintf("primary key %s matches %d rows instead of 1; nothing was deleted", formatKey(key, columns), result.RowCount),
+			}
+		}
+		deleted = append(deleted, result.Rows[0])
+	}
+	return deleted, nil
+}
+
+// formatKey writes a primary key tuple as column=value pairs for error messages
+func formatKey(key map[string]interface{}, columns []string) string {
+	parts := make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = fmt.Sprintf("%s=%v", column, key[column])
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
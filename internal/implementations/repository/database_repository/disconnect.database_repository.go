@@ -6,5 +6,14 @@ import (
 )
 
 func (d *DatabaseRepositoryImplementation) Disconnect(ctx context.Context) error {
-	return errors.New("not implemented")
+	d.poolsMu.Lock()
+	pools := d.pools
+	d.pools = make(map[string]*sessionPool)
+	d.poolsMu.Unlock()
+
+	var errs []error
+	for _, pool := range pools {
+		errs = append(errs, pool.db.Close())
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,39 @@
+package database_repository
+
+import "github.com/lib/pq"
+
+// errorDocsPages are the documentation pages for SQLSTATEs that have one of their own
+var errorDocsPages = map[pq.ErrorCode]string{
+	"42501": "ddl-priv.html",
+	"42601": "sql-syntax.html",
+	"40001": "transaction-iso.html",
+	"40P01": "explicit-locking.html#LOCKING-DEADLOCKS",
+	"55P03": "explicit-locking.html",
+	"25P02": "tutorial-transactions.html",
+	"3D000": "manage-ag-overview.html",
+	"3F000": "ddl-schemas.html",
+}
+
+// errorClassDocsPages are the documentation pages for each SQLSTATE class
+var errorClassDocsPages = map[pq.ErrorClass]string{
+	"08": "libpq-connect.html",
+	"22": "datatype.html",
+	"23": "ddl-constraints.html",
+	"25": "sql-begin.html",
+	"28": "client-authentication.html",
+	"42": "sql-commands.html",
+	"53": "runtime-config-resource.html",
+	"54": "limits.html",
+	"57": "runtime-config-client.html",
+}
+
+// errorDocsPage returns the documentation page for code, falling back to the SQLSTATE appendix
+func errorDocsPage(code pq.ErrorCode) string {
+	if page, ok := errorDocsPages[code]; ok {
+		return page
+	}
+	if page, ok := errorClassDocsPages[code.Class()]; ok {
+		return page
+	}
+	return "errcodes-appendix.html"
+}
@@ -2,22 +2,28 @@ package database_repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (d *DatabaseRepositoryImplementation) ExecuteQuery(ctx context.Context, query string, args ...interface{}) (*domain.QueryResult, error) {
-	if d.db == nil {
+	if d.conn(ctx) == nil {
 		return nil, fmt.Errorf("database connection is not established")
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query execution failed: %w", err)
+		return nil, queryError(err)
 	}
 	defer rows.Close()
 
+	return scanQueryResult(rows)
+}
+
+// scanQueryResult reads all rows into a query result keyed by column name
+func scanQueryResult(rows *sql.Rows) (*domain.QueryResult, error) {
 	columns, err := rows.Columns()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get columns: %w", err)
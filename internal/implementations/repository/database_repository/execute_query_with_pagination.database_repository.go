@@ -2,11 +2,34 @@ package database_repository
 
 import (
 	"context"
-	"errors"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (d *DatabaseRepositoryImplementation) ExecuteQueryWithPagination(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
-	return nil, errors.New("not implemented")
+	var result *domain.QueryResult
+	var err error
+	if params.TrackingKey != "" || len(params.SearchPath) > 0 || params.Settings != (domain.SessionSettings{}) {
+		result, err = d.executePinnedQuery(ctx, params.TrackingKey, domain.ApplicationFeatureQueryEditor, params.SearchPath, params.Settings, params.Query, params.Args...)
+	} else {
+		result, err = d.ExecuteQuery(ctx, params.Query, params.Args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Page through the fetched rows, keeping the full size as the total count
+	result.TotalCount = int64(len(result.Rows))
+	offset := params.Offset
+	if offset < 0 || offset > len(result.Rows) {
+		offset = len(result.Rows)
+	}
+	end := len(result.Rows)
+	if params.Limit > 0 && offset+params.Limit < end {
+		end = offset + params.Limit
+	}
+	result.Rows = result.Rows[offset:end]
+	result.RowCount = int64(len(result.Rows))
+
+	return result, nil
 }
@@ -0,0 +1,136 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// queryCanceledCode is the SQLSTATE reported when pg_cancel_backend interrupts a statement
+const queryCanceledCode = "57014"
+
+func (d *DatabaseRepositoryImplementation) ExecuteTrackedQuery(ctx context.Context, key, query string, args ...interface{}) (*domain.QueryResult, error) {
+	return d.executePinnedQuery(ctx, key, domain.ApplicationFeatureQueryEditor, nil, domain.SessionSettings{}, query, args...)
+}
+
+// executePinnedQuery runs a query on a single pooled connection, registering its backend PID under
+// key when one is given and applying searchPath and settings for the duration of the query
+func (d *DatabaseRepositoryImplementation) executePinnedQuery(ctx context.Context, key, feature string, searchPath []string, settings domain.SessionSettings, query string, args ...interface{}) (*domain.QueryResult, error) {
+	conn, release, err := d.pinConnection(ctx, key, feature, searchPath, settings)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(err)
+	}
+	defer rows.Close()
+
+	return scanQueryResult(rows)
+}
+
+// pinConnection takes a single pooled connection so the recorded PID, search_path and session
+// settings belong to the query run on it; release undoes them and returns the connection to the pool.
+// The key is the username running the query, and with it the connection is tagged as feature's.
+func (d *DatabaseRepositoryImplementation) pinConnection(ctx context.Context, key, feature string, searchPath []string, settings domain.SessionSettings) (*sql.Conn, func(), error) {
+	if d.conn(ctx) == nil {
+		return nil, nil, fmt.Errorf("database connection is not established")
+	}
+
+	conn, err := d.conn(ctx).Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var changed []string
+	if len(searchPath) > 0 {
+		quoted := make([]string, len(searchPath))
+		for i, schema := range searchPath {
+			quoted[i] = pq.QuoteIdentifier(schema)
+		}
+		if _, err := conn.ExecContext(ctx, "SELECT set_config('search_path', $1, false)", strings.Join(quoted, ", ")); err != nil {
+			conn.Close()
+			return nil, nil, fmt.Errorf("failed to set search_path: %w", err)
+		}
+		changed = append(changed, "search_path")
+	}
+
+	if key != "" {
+		if _, err := conn.ExecContext(ctx, "SELECT set_config('application_name', $1, false)", d.applicationName(key, feature)); err != nil {
+			resetSettings(conn, changed)
+			conn.Close()
+			return nil, nil, fmt.Errorf("failed to set application_name: %w", err)
+		}
+		changed = append(changed, "application_name")
+	}
+
+	applied, err := applySessionSettings(ctx, conn, settings)
+	changed = append(changed, applied...)
+	if err != nil {
+		resetSettings(conn, changed)
+		conn.Close()
+		return nil, nil, err
+	}
+
+	pid := 0
+	if key != "" {
+		if err := conn.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid); err != nil {
+			resetSettings(conn, changed)
+			conn.Close()
+			return nil, nil, fmt.Errorf("failed to get backend pid: %w", err)
+		}
+
+		d.runningMu.Lock()
+		d.runningQueries[key] = pid
+		d.runningMu.Unlock()
+	}
+
+	release := func() {
+		if key != "" {
+			d.runningMu.Lock()
+			if d.runningQueries[key] == pid {
+				delete(d.runningQueries, key)
+			}
+			d.runningMu.Unlock()
+		}
+		// Restore the defaults before the connection goes back to the pool
+		resetSettings(conn, changed)
+		conn.Close()
+	}
+
+	return conn, release, nil
+}
+
+// queryError reports statements interrupted by pg_cancel_backend as cancelled, and other PostgreSQL
+// errors, from pq or from the pgconn connections COPY TO runs on, with the documentation for their
+// SQLSTATE
+func queryError(err error) error {
+	var code pq.ErrorCode
+	var pqErr *pq.Error
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &pqErr):
+		code = pqErr.Code
+	case errors.As(err, &pgErr):
+		code = pq.ErrorCode(pgErr.Code)
+	default:
+		return fmt.Errorf("query execution failed: %w", err)
+	}
+	if code == queryCanceledCode {
+		return domain.ErrQueryCancelled
+	}
+	return &domain.DatabaseError{
+		SQLState: string(code),
+		DocsKey:  code.Name(),
+		DocsURL:  domain.PostgresDocsBaseURL + errorDocsPage(code),
+		Err:      err,
+	}
+}
@@ -0,0 +1,122 @@
+package database_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) ExplainQuery(ctx context.Context, query string, analyze bool) (*domain.QueryPlan, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	options := "FORMAT JSON"
+	if analyze {
+		options = "ANALYZE, BUFFERS, FORMAT JSON"
+	}
+	statement := fmt.Sprintf("EXPLAIN (%s) %s", options, strings.TrimRight(strings.TrimSpace(query), ";"))
+
+	// ANALYZE really executes the statement, so always roll back to keep writes from persisting
+	tx, err := d.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var raw []byte
+	if err := tx.QueryRowContext(ctx, statement).Scan(&raw); err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+
+	var output []map[string]interface{}
+	if err := json.Unmarshal(raw, &output); err != nil {
+		return nil, fmt.Errorf("failed to parse query plan: %w", err)
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("empty query plan")
+	}
+
+	root, ok := output[0]["Plan"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("query plan has no root node")
+	}
+
+	return &domain.QueryPlan{
+		Root:          parsePlanNode(root),
+		Analyzed:      analyze,
+		PlanningTime:  planFloat(output[0], "Planning Time"),
+		ExecutionTime: planFloat(output[0], "Execution Time"),
+	}, nil
+}
+
+// parsePlanNode converts a JSON plan node into a plan tree node, keeping unknown properties as details
+func parsePlanNode(raw map[string]interface{}) domain.QueryPlanNode {
+	node := domain.QueryPlanNode{
+		Details:  make(map[string]interface{}),
+		Children: make([]domain.QueryPlanNode, 0),
+	}
+
+	for key, value := range raw {
+		switch key {
+		case "Node Type":
+			node.NodeType, _ = value.(string)
+		case "Relation Name":
+			node.RelationName, _ = value.(string)
+		case "Alias":
+			node.Alias, _ = value.(string)
+		case "Startup Cost":
+			node.StartupCost, _ = value.(float64)
+		case "Total Cost":
+			node.TotalCost, _ = value.(float64)
+		case "Plan Rows":
+			node.PlanRows, _ = value.(float64)
+		case "Plan Width":
+			width, _ := value.(float64)
+			node.PlanWidth = int(width)
+		case "Actual Startup Time":
+			node.ActualStartupTime = planFloat(raw, key)
+		case "Actual Total Time":
+			node.ActualTotalTime = planFloat(raw, key)
+		case "Actual Rows":
+			node.ActualRows = planFloat(raw, key)
+		case "Actual Loops":
+			node.ActualLoops = planFloat(raw, key)
+		case "Shared Hit Blocks":
+			node.SharedHitBlocks = planInt(raw, key)
+		case "Shared Read Blocks":
+			node.SharedReadBlocks = planInt(raw, key)
+		case "Plans":
+			children, _ := value.([]interface{})
+			for _, child := range children {
+				if childNode, ok := child.(map[string]interface{}); ok {
+					node.Children = append(node.Children, parsePlanNode(childNode))
+				}
+			}
+		default:
+			node.Details[key] = value
+		}
+	}
+
+	return node
+}
+
+func planFloat(raw map[string]interface{}, key string) *float64 {
+	value, ok := raw[key].(float64)
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func planInt(raw map[string]interface{}, key string) *int64 {
+	value, ok := raw[key].(float64)
+	if !ok {
+		return nil
+	}
+	result := int64(value)
+	return &result
+}
@@ -0,0 +1,101 @@
+package database_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) FindDuplicateRows(ctx context.Context, params domain.DuplicateParams) ([]domain.DuplicateGroup, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if len(params.Columns) == 0 {
+		return nil, fmt.Errorf("at least one column is required")
+	}
+
+	quotedColumns := make([]string, len(params.Columns))
+	for i, column := range params.Columns {
+		quotedColumns[i] = pq.QuoteIdentifier(column)
+	}
+
+	// Collect a sample of primary keys per group as JSON objects
+	sampleExpr := "NULL::text[]"
+	if len(params.PrimaryKeys) > 0 && params.SampleSize > 0 {
+		pairs := make([]string, len(params.PrimaryKeys))
+		quotedKeys := make([]string, len(params.PrimaryKeys))
+		for i, key := range params.PrimaryKeys {
+			pairs[i] = pq.QuoteLiteral(key) + ", " + pq.QuoteIdentifier(key)
+			quotedKeys[i] = pq.QuoteIdentifier(key)
+		}
+		sampleExpr = fmt.Sprintf(
+			"(array_agg(json_build_object(%s)::text ORDER BY %s))[1:%d]",
+			strings.Join(pairs, ", "), strings.Join(quotedKeys, ", "), params.SampleSize,
+		)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s, COUNT(*), %s FROM %s",
+		strings.Join(quotedColumns, ", "), sampleExpr, qualifiedTableName(params.Schema, params.Table),
+	)
+	if params.WhereClause != "" {
+		query += " WHERE " + params.WhereClause
+	}
+	query += fmt.Sprintf(" GROUP BY %s HAVING COUNT(*) > 1 ORDER BY COUNT(*) DESC", strings.Join(quotedColumns, ", "))
+	if params.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", params.Limit)
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("duplicate detection query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []domain.DuplicateGroup
+	for rows.Next() {
+		values := make([]interface{}, len(params.Columns))
+		var count int64
+		var samples []string
+
+		dest := make([]interface{}, 0, len(params.Columns)+2)
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		dest = append(dest, &count, pq.Array(&samples))
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		group := domain.DuplicateGroup{
+			Values: make(map[string]interface{}, len(params.Columns)),
+			Count:  count,
+		}
+		for i, column := range params.Columns {
+			if raw, ok := values[i].([]byte); ok {
+				values[i] = string(raw)
+			}
+			group.Values[column] = values[i]
+		}
+		for _, sample := range samples {
+			var pk map[string]interface{}
+			if err := json.Unmarshal([]byte(sample), &pk); err != nil {
+				return nil, fmt.Errorf("failed to decode sample primary key: %w", err)
+			}
+			group.SamplePrimaryKeys = append(group.SamplePrimaryKeys, pk)
+		}
+
+		groups = append(groups, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return groups, nil
+}
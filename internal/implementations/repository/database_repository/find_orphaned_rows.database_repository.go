@@ -0,0 +1,42 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) FindOrphanedRows(ctx context.Context, params domain.OrphanCheckParams) (*domain.OrphanedReference, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	column := pq.QuoteIdentifier(params.Reference.ColumnName)
+	from := fmt.Sprintf(
+		" FROM %s AS child WHERE child.%s IS NOT NULL AND NOT EXISTS (SELECT 1 FROM %s AS parent WHERE parent.%s = child.%s)",
+		qualifiedTableName(params.Schema, params.Table),
+		column,
+		qualifiedTableName(params.Reference.ReferencedSchema, params.Reference.ReferencedTable),
+		pq.QuoteIdentifier(params.Reference.ReferencedColumn),
+		column,
+	)
+
+	var count int64
+	if err := d.conn(ctx).QueryRowContext(ctx, "SELECT COUNT(*)"+from).Scan(&count); err != nil {
+		return nil, fmt.Errorf("failed to count orphaned rows: %w", err)
+	}
+
+	rows, err := d.ExecuteQuery(ctx, fmt.Sprintf("SELECT child.*%s LIMIT %d", from, params.Limit))
+	if err != nil {
+		return nil, err
+	}
+	rows.TotalCount = count
+
+	return &domain.OrphanedReference{
+		Reference:   params.Reference,
+		OrphanCount: count,
+		Rows:        rows,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (d *DatabaseRepositoryImplementation) GenerateUUID(ctx context.Context) (string, error) {
+	if d.conn(ctx) == nil {
+		return "", fmt.Errorf("database connection is not established")
+	}
+
+	var id string
+	if err := d.conn(ctx).QueryRowContext(ctx, "SELECT gen_random_uuid()::text").Scan(&id); err != nil {
+		return "", fmt.Errorf("failed to generate uuid: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,43 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) GetCheckConstraints(ctx context.Context, database, schema, table string) ([]domain.CheckConstraint, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT con.conname, pg_get_constraintdef(con.oid)
+		FROM pg_constraint con
+		JOIN pg_class rel ON rel.oid = con.conrelid
+		JOIN pg_namespace nsp ON nsp.oid = rel.relnamespace
+		WHERE con.contype = 'c' AND nsp.nspname = $1 AND rel.relname = $2
+		ORDER BY con.conname`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get check constraints: %w", err)
+	}
+	defer rows.Close()
+
+	var constraints []domain.CheckConstraint
+	for rows.Next() {
+		var constraint domain.CheckConstraint
+		if err := rows.Scan(&constraint.Name, &constraint.Definition); err != nil {
+			return nil, fmt.Errorf("failed to scan check constraint: %w", err)
+		}
+		constraints = append(constraints, constraint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return constraints, nil
+}
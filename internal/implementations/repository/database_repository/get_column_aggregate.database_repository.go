@@ -0,0 +1,47 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetColumnAggregate(ctx context.Context, database, schema, table, column, whereClause string) (*domain.ColumnAggregate, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	query := fmt.Sprintf(
+		"SELECT COUNT(%[1]s), SUM(%[1]s)::float8, AVG(%[1]s)::float8, MIN(%[1]s)::float8, MAX(%[1]s)::float8 FROM %[2]s",
+		pq.QuoteIdentifier(column), qualifiedTableName(schema, table),
+	)
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	var count int64
+	var sum, avg, min, max sql.NullFloat64
+	if err := d.conn(ctx).QueryRowContext(ctx, query).Scan(&count, &sum, &avg, &min, &max); err != nil {
+		return nil, fmt.Errorf("failed to compute column aggregate: %w", err)
+	}
+
+	return &domain.ColumnAggregate{
+		Column: column,
+		Count:  count,
+		Sum:    nullFloatPointer(sum),
+		Avg:    nullFloatPointer(avg),
+		Min:    nullFloatPointer(min),
+		Max:    nullFloatPointer(max),
+	}, nil
+}
+
+// nullFloatPointer converts a nullable float into a pointer, nil when the value is NULL
+func nullFloatPointer(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
@@ -0,0 +1,115 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) GetColumnDependents(ctx context.Context, database, schema, table, column string) ([]domain.ColumnDependent, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// Views reach the column through their rewrite rule, the rest directly; a foreign key of another
+	// table referencing the column is recorded the same way
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		WITH target AS (
+			SELECT c.oid AS relid, a.attnum
+			FROM pg_class c
+			JOIN pg_namespace n ON n.oid = c.relnamespace
+			JOIN pg_attribute a ON a.attrelid = c.oid
+			WHERE n.nspname = $1 AND c.relname = $2 AND a.attname = $3 AND NOT a.attisdropped
+		),
+		dependency AS (
+			SELECT d.classid, d.objid, t.relid
+			FROM target t
+			JOIN pg_depend d ON d.refclassid = 'pg_class'::regclass AND d.refobjid = t.relid AND d.refobjsubid = t.attnum
+		)
+		SELECT CASE v.relkind WHEN 'm' THEN $4 ELSE $5 END, vn.nspname, v.relname, pg_get_viewdef(v.oid, true)
+		FROM dependency d
+		JOIN pg_rewrite r ON d.classid = 'pg_rewrite'::regclass AND r.oid = d.objid
+		JOIN pg_class v ON v.oid = r.ev_class AND v.oid <> d.relid
+		JOIN pg_namespace vn ON vn.oid = v.relnamespace
+		UNION
+		SELECT $6, n.nspname, i.relname, pg_get_indexdef(i.oid)
+		FROM dependency d
+		JOIN pg_class i ON d.classid = 'pg_class'::regclass AND i.oid = d.objid AND i.relkind IN ('i', 'I')
+		JOIN pg_namespace n ON n.oid = i.relnamespace
+		UNION
+		SELECT $7, n.nspname, con.conname, pg_get_constraintdef(con.oid)
+		FROM dependency d
+		JOIN pg_constraint con ON d.classid = 'pg_constraint'::regclass AND con.oid = d.objid
+		JOIN pg_namespace n ON n.oid = con.connamespace
+		UNION
+		SELECT $8, n.nspname, tg.tgname, pg_get_triggerdef(tg.oid)
+		FROM dependency d
+		JOIN pg_trigger tg ON d.classid = 'pg_trigger'::regclass AND tg.oid = d.objid AND NOT tg.tgisinternal
+		JOIN pg_class rel ON rel.oid = tg.tgrelid
+		JOIN pg_namespace n ON n.oid = rel.relnamespace
+		ORDER BY 1, 2, 3`,
+		schema, table, column,
+		domain.ColumnDependentMaterializedView, domain.ColumnDependentView, domain.ColumnDependentIndex,
+		domain.ColumnDependentConstraint, domain.ColumnDependentTrigger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column dependents: %w", err)
+	}
+	defer rows.Close()
+
+	var dependents []domain.ColumnDependent
+	for rows.Next() {
+		dependent := domain.ColumnDependent{Column: column}
+		if err := rows.Scan(&dependent.Kind, &dependent.Schema, &dependent.Name, &dependent.Definition); err != nil {
+			return nil, fmt.Errorf("failed to scan column dependent: %w", err)
+		}
+		dependents = append(dependents, dependent)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	functions, err := d.functionsMentioningColumn(ctx, table, column)
+	if err != nil {
+		return nil, err
+	}
+	return append(dependents, functions...), nil
+}
+
+// functionsMentioningColumn finds the functions and procedures whose source names both the table and
+// the column as whole words. PostgreSQL records no dependencies for function bodies, so these are
+// the functions likely to fail once the column is renamed or dropped.
+func (d *DatabaseRepositoryImplementation) functionsMentioningColumn(ctx context.Context, table, column string) ([]domain.ColumnDependent, error) {
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT n.nspname, p.proname || '(' || pg_get_function_identity_arguments(p.oid) || ')', p.prosrc
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		JOIN pg_language l ON l.oid = p.prolang
+		WHERE n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_%'
+			AND l.lanname NOT IN ('c', 'internal')
+			AND strpos(lower(p.prosrc), lower($1)) > 0 AND strpos(lower(p.prosrc), lower($2)) > 0
+		ORDER BY 1, 2`, table, column)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search function sources: %w", err)
+	}
+	defer rows.Close()
+
+	tableWord := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(table) + `\b`)
+	columnWord := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(column) + `\b`)
+
+	var functions []domain.ColumnDependent
+	for rows.Next() {
+		function := domain.ColumnDependent{Kind: domain.ColumnDependentFunction, Column: column, Inferred: true}
+		if err := rows.Scan(&function.Schema, &function.Name, &function.Definition); err != nil {
+			return nil, fmt.Errorf("failed to scan function: %w", err)
+		}
+		if tableWord.MatchString(function.Definition) && columnWord.MatchString(function.Definition) {
+			functions = append(functions, function)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return functions, nil
+}
@@ -0,0 +1,103 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetColumnHistogram(ctx context.Context, params domain.HistogramParams) ([]domain.HistogramBucket, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if params.Buckets <= 0 {
+		return nil, fmt.Errorf("bucket count must be positive")
+	}
+
+	// Temporal columns are bucketed on their epoch seconds
+	valueExpr := pq.QuoteIdentifier(params.Column) + "::float8"
+	if params.IsTemporal {
+		valueExpr = fmt.Sprintf("EXTRACT(EPOCH FROM %s)::float8", pq.QuoteIdentifier(params.Column))
+	}
+
+	filter := fmt.Sprintf(" WHERE %s IS NOT NULL", pq.QuoteIdentifier(params.Column))
+	if params.WhereClause != "" {
+		filter += " AND (" + params.WhereClause + ")"
+	}
+	from := " FROM " + qualifiedTableName(params.Schema, params.Table) + filter
+
+	// Find the value range first
+	var lower, upper sql.NullFloat64
+	if err := d.conn(ctx).QueryRowContext(ctx, "SELECT MIN("+valueExpr+"), MAX("+valueExpr+")"+from).Scan(&lower, &upper); err != nil {
+		return nil, fmt.Errorf("failed to compute histogram range: %w", err)
+	}
+	if !lower.Valid || !upper.Valid {
+		return []domain.HistogramBucket{}, nil
+	}
+
+	counts := make([]int64, params.Buckets)
+	if lower.Float64 == upper.Float64 {
+		// A single distinct value lands entirely in the first bucket
+		if err := d.conn(ctx).QueryRowContext(ctx, "SELECT COUNT(*)"+from).Scan(&counts[0]); err != nil {
+			return nil, fmt.Errorf("failed to compute histogram: %w", err)
+		}
+	} else {
+		// width_bucket puts the maximum in bucket n+1, so clamp it into the last bucket
+		query := fmt.Sprintf(
+			"SELECT LEAST(width_bucket(%s, $1, $2, $3), $3), COUNT(*)%s GROUP BY 1 ORDER BY 1",
+			valueExpr, from,
+		)
+		rows, err := d.conn(ctx).QueryContext(ctx, query, lower.Float64, upper.Float64, params.Buckets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute histogram: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var bucket int
+			var count int64
+			if err := rows.Scan(&bucket, &count); err != nil {
+				return nil, fmt.Errorf("failed to scan row: %w", err)
+			}
+			if bucket >= 1 && bucket <= params.Buckets {
+				counts[bucket-1] = count
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("rows iteration error: %w", err)
+		}
+	}
+
+	width := (upper.Float64 - lower.Float64) / float64(params.Buckets)
+	buckets := make([]domain.HistogramBucket, params.Buckets)
+	for i := range buckets {
+		lowerBound := lower.Float64 + float64(i)*width
+		upperBound := lower.Float64 + float64(i+1)*width
+		if i == params.Buckets-1 {
+			upperBound = upper.Float64
+		}
+
+		buckets[i] = domain.HistogramBucket{
+			LowerBound: histogramBound(lowerBound, params.IsTemporal),
+			UpperBound: histogramBound(upperBound, params.IsTemporal),
+			Count:      counts[i],
+		}
+	}
+
+	return buckets, nil
+}
+
+// histogramBound converts a bucket bound back to the column's domain
+func histogramBound(value float64, isTemporal bool) interface{} {
+	if !isTemporal {
+		return value
+	}
+	seconds, fraction := math.Modf(value)
+	return time.Unix(int64(seconds), int64(fraction*float64(time.Second))).UTC()
+}
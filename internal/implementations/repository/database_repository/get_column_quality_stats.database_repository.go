@@ -0,0 +1,63 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetColumnQualityStats(ctx context.Context, database, schema, table string, columns []string, sampleLimit int) (*domain.DataQualityReport, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("at least one column is required")
+	}
+
+	// Every column is compared as text, so non-text columns never count as empty
+	selects := []string{"COUNT(*)"}
+	for _, column := range columns {
+		quoted := pq.QuoteIdentifier(column)
+		selects = append(selects,
+			fmt.Sprintf("COUNT(*) FILTER (WHERE %s IS NULL)", quoted),
+			fmt.Sprintf("COUNT(*) FILTER (WHERE %s::text = '')", quoted),
+		)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM (SELECT * FROM %s LIMIT %d) AS sample",
+		strings.Join(selects, ", "), qualifiedTableName(schema, table), sampleLimit,
+	)
+
+	counts := make([]int64, len(selects))
+	dest := make([]interface{}, len(selects))
+	for i := range counts {
+		dest[i] = &counts[i]
+	}
+	if err := d.conn(ctx).QueryRowContext(ctx, query).Scan(dest...); err != nil {
+		return nil, fmt.Errorf("failed to compute column quality stats: %w", err)
+	}
+
+	report := &domain.DataQualityReport{
+		Table:       table,
+		SampledRows: counts[0],
+		Columns:     make([]domain.ColumnQualityStats, len(columns)),
+	}
+	for i, column := range columns {
+		stats := domain.ColumnQualityStats{
+			Column:     column,
+			NullCount:  counts[1+2*i],
+			EmptyCount: counts[2+2*i],
+		}
+		if report.SampledRows > 0 {
+			stats.NullPercent = float64(stats.NullCount) * 100 / float64(report.SampledRows)
+			stats.EmptyPercent = float64(stats.EmptyCount) * 100 / float64(report.SampledRows)
+		}
+		report.Columns[i] = stats
+	}
+
+	return report, nil
+}
@@ -1,9 +1,18 @@
 package database_repository
 
 import (
+	"context"
 	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (d *DatabaseRepositoryImplementation) GetConnection() *sql.DB {
 	return d.db
 }
+
+// conn is the handle queries for ctx run on: the session's own pool when the request is bound to one,
+// otherwise the shared connection
+func (d *DatabaseRepositoryImplementation) conn(ctx context.Context) *sql.DB {
+	return domain.SessionConnection(ctx, d.db)
+}
@@ -0,0 +1,31 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) GetFunctionDefinition(ctx context.Context, database, schema, name, arguments string) (string, error) {
+	if d.conn(ctx) == nil {
+		return "", fmt.Errorf("database connection is not established")
+	}
+
+	var definition string
+	err := d.conn(ctx).QueryRowContext(ctx, `
+		SELECT pg_get_functiondef(p.oid)
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		WHERE n.nspname = $1 AND p.proname = $2 AND pg_get_function_identity_arguments(p.oid) = $3
+			AND p.prokind IN ('f', 'p')`, schema, name, arguments).Scan(&definition)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrFunctionNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get function definition: %w", err)
+	}
+	return definition, nil
+}
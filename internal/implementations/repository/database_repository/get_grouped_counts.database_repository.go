@@ -0,0 +1,71 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetGroupedCounts(ctx context.Context, params domain.GroupByParams) ([]domain.GroupByBucket, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	aggregateExpr := "NULL::float8"
+	if params.AggregateColumn != "" {
+		aggregateFunc := strings.ToUpper(params.AggregateFunc)
+		switch aggregateFunc {
+		case "SUM", "AVG", "MIN", "MAX", "COUNT":
+		default:
+			return nil, fmt.Errorf("unsupported aggregate function: %s", params.AggregateFunc)
+		}
+		aggregateExpr = fmt.Sprintf("%s(%s)::float8", aggregateFunc, pq.QuoteIdentifier(params.AggregateColumn))
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s, COUNT(*), %s FROM %s",
+		pq.QuoteIdentifier(params.GroupColumn), aggregateExpr, qualifiedTableName(params.Schema, params.Table),
+	)
+	if params.WhereClause != "" {
+		query += " WHERE " + params.WhereClause
+	}
+	query += " GROUP BY 1 ORDER BY 2 DESC, 1"
+	if params.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", params.Limit)
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("group by query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []domain.GroupByBucket
+	for rows.Next() {
+		var value interface{}
+		var count int64
+		var aggregate sql.NullFloat64
+		if err := rows.Scan(&value, &count, &aggregate); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		if raw, ok := value.([]byte); ok {
+			value = string(raw)
+		}
+
+		buckets = append(buckets, domain.GroupByBucket{
+			Value:     value,
+			Count:     count,
+			Aggregate: nullFloatPointer(aggregate),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return buckets, nil
+}
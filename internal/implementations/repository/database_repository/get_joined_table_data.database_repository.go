@@ -0,0 +1,76 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetJoinedTableData(ctx context.Context, params domain.JoinViewParams) (*domain.QueryResult, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if len(params.Conditions) == 0 {
+		return nil, fmt.Errorf("at least one join condition is required")
+	}
+
+	joinType := "INNER JOIN"
+	if strings.EqualFold(params.JoinType, "LEFT") {
+		joinType = "LEFT JOIN"
+	}
+
+	// Alias every column as "<table>.<column>" so both sides can share column names
+	var projections []string
+	for _, column := range params.LeftColumns {
+		projections = append(projections, fmt.Sprintf("l.%s AS %s", pq.QuoteIdentifier(column), pq.QuoteIdentifier(params.LeftTable+"."+column)))
+	}
+	for _, column := range params.RightColumns {
+		projections = append(projections, fmt.Sprintf("r.%s AS %s", pq.QuoteIdentifier(column), pq.QuoteIdentifier(params.RightTable+"."+column)))
+	}
+	if len(projections) == 0 {
+		projections = []string{"l.*", "r.*"}
+	}
+
+	conditions := make([]string, len(params.Conditions))
+	for i, condition := range params.Conditions {
+		conditions[i] = fmt.Sprintf("l.%s = r.%s", pq.QuoteIdentifier(condition.LeftColumn), pq.QuoteIdentifier(condition.RightColumn))
+	}
+
+	from := fmt.Sprintf(
+		" FROM (SELECT %s FROM %s AS l %s %s AS r ON %s) AS joined",
+		strings.Join(projections, ", "),
+		qualifiedTableName(params.LeftSchema, params.LeftTable),
+		joinType,
+		qualifiedTableName(params.RightSchema, params.RightTable),
+		strings.Join(conditions, " AND "),
+	)
+	if params.WhereClause != "" {
+		from += " WHERE " + params.WhereClause
+	}
+
+	var total int64
+	if err := d.conn(ctx).QueryRowContext(ctx, "SELECT COUNT(*)"+from).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count joined rows: %w", err)
+	}
+
+	query := "SELECT *" + from
+	if params.OrderBy != "" {
+		direction := "ASC"
+		if strings.EqualFold(params.OrderDir, "DESC") {
+			direction = "DESC"
+		}
+		query += fmt.Sprintf(" ORDER BY %s %s", pq.QuoteIdentifier(params.OrderBy), direction)
+	}
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", params.Limit, params.Offset)
+
+	result, err := d.ExecuteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result.TotalCount = total
+
+	return result, nil
+}
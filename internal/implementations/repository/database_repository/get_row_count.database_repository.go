@@ -2,9 +2,23 @@ package database_repository
 
 import (
 	"context"
-	"errors"
+	"fmt"
 )
 
 func (d *DatabaseRepositoryImplementation) GetRowCount(ctx context.Context, database, schema, table, whereClause string) (int64, error) {
-	return 0, errors.New("not implemented")
+	if d.conn(ctx) == nil {
+		return 0, fmt.Errorf("database connection is not established")
+	}
+
+	query := "SELECT COUNT(*) FROM " + qualifiedTableName(schema, table)
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	var count int64
+	if err := d.conn(ctx).QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	return count, nil
 }
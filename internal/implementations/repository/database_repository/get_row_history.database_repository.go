@@ -0,0 +1,55 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetRowHistory(ctx context.Context, history domain.HistoryTableConfig, pkValues map[string]interface{}) (*domain.QueryResult, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if len(pkValues) == 0 {
+		return nil, fmt.Errorf("primary key values are required")
+	}
+
+	// Build the row predicate in a stable column order
+	keys := make([]string, 0, len(pkValues))
+	for key := range pkValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	conditions := make([]string, len(keys))
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		conditions[i] = fmt.Sprintf("%s = $%d", pq.QuoteIdentifier(key), i+1)
+		args[i] = pkValues[key]
+	}
+	predicate := strings.Join(conditions, " AND ")
+
+	source := qualifiedTableName(history.Schema, history.Table)
+	historyTable := qualifiedTableName(history.HistorySchema, history.HistoryTable)
+
+	var query string
+	if history.PeriodColumn != "" {
+		columns := quoteIdentifiers(history.Columns)
+		query = fmt.Sprintf(
+			"SELECT * FROM (SELECT %[1]s FROM %[2]s WHERE %[3]s UNION ALL SELECT %[1]s FROM %[4]s WHERE %[3]s) AS versions ORDER BY lower(%[5]s)",
+			columns, historyTable, predicate, source, pq.QuoteIdentifier(history.PeriodColumn),
+		)
+	} else {
+		columns := quoteIdentifiers(append(append([]string{}, history.Columns...), history.ValidFromColumn, history.ValidToColumn))
+		query = fmt.Sprintf(
+			"SELECT %s FROM %s WHERE %s ORDER BY %s",
+			columns, historyTable, predicate, pq.QuoteIdentifier(history.ValidFromColumn),
+		)
+	}
+
+	return d.ExecuteQuery(ctx, query, args...)
+}
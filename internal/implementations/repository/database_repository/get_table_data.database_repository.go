@@ -2,11 +2,138 @@ package database_repository
 
 import (
 	"context"
-	"errors"
+	"database/sql"
+	"fmt"
+	"strings"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
 )
 
 func (d *DatabaseRepositoryImplementation) GetTableData(ctx context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
-	return nil, errors.New("not implemented")
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	projection, args, err := d.tableProjection(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Geometry and geography columns are read as GeoJSON and WKT too, alongside their raw values
+	spatial, err := d.spatialColumns(ctx, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	projection += spatialProjection(spatial)
+
+	from := " FROM " + qualifiedTableName(params.Schema, params.Table)
+	if params.WhereClause != "" {
+		from += " WHERE " + params.WhereClause
+	}
+
+	// A frozen view reads the count and the page from its snapshot so pages stay consistent
+	var reader tableReader = d.conn(ctx)
+	if frozen := d.lockSnapshot(params.SnapshotKey); frozen != nil {
+		defer frozen.mu.Unlock()
+		reader = frozen.tx
+	}
+
+	// The filter's values bind the first placeholders, the projection's follow them
+	queryArgs := append(append([]interface{}{}, params.WhereArgs...), args...)
+	result, err := readTableData(ctx, reader, from, projection, queryArgs, params)
+	if err != nil {
+		return nil, err
+	}
+	splitSpatialValues(result, spatial)
+	return result, nil
+}
+
+// tableReader runs queries on the live database or on a snapshot transaction
+type tableReader interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// readTableData counts the rows matching the params and reads the requested page
+func readTableData(ctx context.Context, reader tableReader, from, projection string, args []interface{}, params domain.TableDataParams) (*domain.QueryResult, error) {
+	var total int64
+	if err := reader.QueryRowContext(ctx, "SELECT COUNT(*)"+from, params.WhereArgs...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	query := "SELECT " + projection + from
+	if params.OrderBy != "" {
+		direction := "ASC"
+		if strings.EqualFold(params.OrderDir, "DESC") {
+			direction = "DESC"
+		}
+		query += fmt.Sprintf(" ORDER BY %s %s", pq.QuoteIdentifier(params.OrderBy), direction)
+	}
+	if params.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", params.Limit)
+	}
+	if params.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", params.Offset)
+	}
+
+	rows, err := reader.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(err)
+	}
+	defer rows.Close()
+
+	result, err := scanQueryResult(rows)
+	if err != nil {
+		return nil, err
+	}
+	result.TotalCount = total
+
+	return result, nil
+}
+
+// tableProjection lists the table's columns, decrypting encrypted ones with pgp_sym_decrypt when a key is given
+func (d *DatabaseRepositoryImplementation) tableProjection(ctx context.Context, params domain.TableDataParams) (string, []interface{}, error) {
+	if len(params.EncryptedColumns) == 0 || params.EncryptionKey == "" {
+		return "*", nil, nil
+	}
+
+	schema := params.Schema
+	if schema == "" {
+		schema = "public"
+	}
+	rows, err := d.conn(ctx).QueryContext(ctx,
+		"SELECT column_name FROM information_schema.columns WHERE table_schema = $1 AND table_name = $2 ORDER BY ordinal_position",
+		schema, params.Table)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list columns: %w", err)
+	}
+	defer rows.Close()
+
+	encrypted := make(map[string]bool, len(params.EncryptedColumns))
+	for _, column := range params.EncryptedColumns {
+		encrypted[column] = true
+	}
+
+	var projections []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return "", nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+		quoted := pq.QuoteIdentifier(column)
+		if encrypted[column] {
+			projections = append(projections, fmt.Sprintf("pgp_sym_decrypt(%s::bytea, $%d) AS %s", quoted, len(params.WhereArgs)+1, quoted))
+		} else {
+			projections = append(projections, quoted)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	if len(projections) == 0 {
+		return "", nil, fmt.Errorf("table %s not found", qualifiedTableName(params.Schema, params.Table))
+	}
+
+	return strings.Join(projections, ", "), []interface{}{params.EncryptionKey}, nil
 }
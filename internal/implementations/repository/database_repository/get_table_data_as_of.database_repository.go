@@ -0,0 +1,56 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetTableDataAsOf(ctx context.Context, history domain.HistoryTableConfig, asOf time.Time, offset, limit int) (*domain.QueryResult, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	columns := quoteIdentifiers(history.Columns)
+	source := qualifiedTableName(history.Schema, history.Table)
+	historyTable := qualifiedTableName(history.HistorySchema, history.HistoryTable)
+
+	var versions string
+	if history.PeriodColumn != "" {
+		// Current rows live in the source table, past versions in the history table
+		period := pq.QuoteIdentifier(history.PeriodColumn)
+		versions = fmt.Sprintf(
+			"SELECT %[1]s FROM %[2]s WHERE %[3]s @> $1::timestamptz UNION ALL SELECT %[1]s FROM %[4]s WHERE %[3]s @> $1::timestamptz",
+			columns, source, period, historyTable,
+		)
+	} else {
+		validFrom := pq.QuoteIdentifier(history.ValidFromColumn)
+		validTo := pq.QuoteIdentifier(history.ValidToColumn)
+		versions = fmt.Sprintf(
+			"SELECT %[1]s FROM %[2]s WHERE %[3]s <= $1 AND (%[4]s IS NULL OR %[4]s > $1)",
+			columns, historyTable, validFrom, validTo,
+		)
+	}
+
+	var total int64
+	if err := d.conn(ctx).QueryRowContext(ctx, "SELECT COUNT(*) FROM ("+versions+") AS as_of", asOf).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count rows as of %s: %w", asOf.Format(time.RFC3339), err)
+	}
+
+	query := "SELECT * FROM (" + versions + ") AS as_of"
+	if len(history.PrimaryKeys) > 0 {
+		query += " ORDER BY " + quoteIdentifiers(history.PrimaryKeys)
+	}
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	result, err := d.ExecuteQuery(ctx, query, asOf)
+	if err != nil {
+		return nil, err
+	}
+	result.TotalCount = total
+
+	return result, nil
+}
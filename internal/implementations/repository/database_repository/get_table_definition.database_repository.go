@@ -0,0 +1,70 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// identityKinds maps pg_attribute.attidentity onto the clause CREATE TABLE declares it with
+var identityKinds = map[string]string{
+	"a": "ALWAYS",
+	"d": "BY DEFAULT",
+}
+
+func (d *DatabaseRepositoryImplementation) GetTableDefinition(ctx context.Context, database, schema, table string) (*domain.TableDefinition, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	var oid int64
+	definition := &domain.TableDefinition{}
+	err := d.conn(ctx).QueryRowContext(ctx, `
+		SELECT c.oid, COALESCE(obj_description(c.oid, 'pg_class'), '')
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')`, schema, table).Scan(&oid, &definition.Comment)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrTableNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read table definition: %w", err)
+	}
+
+	// Defaults and generation expressions share pg_attrdef; attgenerated tells them apart
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull,
+			CASE WHEN a.attgenerated = '' THEN COALESCE(pg_get_expr(ad.adbin, ad.adrelid), '') ELSE '' END,
+			a.attidentity::text,
+			CASE WHEN a.attgenerated <> '' THEN COALESCE(pg_get_expr(ad.adbin, ad.adrelid), '') ELSE '' END,
+			CASE WHEN a.attcollation <> 0 AND a.attcollation <> t.typcollation THEN quote_ident(co.collname) ELSE '' END,
+			COALESCE(col_description(a.attrelid, a.attnum), '')
+		FROM pg_attribute a
+		JOIN pg_type t ON t.oid = a.atttypid
+		LEFT JOIN pg_attrdef ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum
+		LEFT JOIN pg_collation co ON co.oid = a.attcollation
+		WHERE a.attrelid = $1 AND a.attnum > 0 AND NOT a.attisdropped
+		ORDER BY a.attnum`, oid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read table columns: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var column domain.TableColumnDefinition
+		var identity string
+		if err := rows.Scan(&column.Name, &column.Type, &column.NotNull, &column.Default, &identity,
+			&column.Generated, &column.Collation, &column.Comment); err != nil {
+			return nil, fmt.Errorf("failed to scan table column: %w", err)
+		}
+		column.Identity = identityKinds[identity]
+		definition.Columns = append(definition.Columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read table columns: %w", err)
+	}
+	return definition, nil
+}
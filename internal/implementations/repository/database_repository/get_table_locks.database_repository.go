@@ -0,0 +1,49 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) GetTableLocks(ctx context.Context, database, schema, table string) ([]domain.TableLock, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT l.pid, COALESCE(a.usename, ''), l.mode, l.granted, COALESCE(a.xact_start, a.backend_start),
+			COALESCE(a.state, ''), COALESCE(a.query, ''), COALESCE(a.application_name, ''), COALESCE(host(a.client_addr), '')
+		FROM pg_locks l
+		JOIN pg_class rel ON rel.oid = l.relation
+		JOIN pg_namespace nsp ON nsp.oid = rel.relnamespace
+		LEFT JOIN pg_stat_activity a ON a.pid = l.pid
+		WHERE l.locktype = 'relation' AND nsp.nspname = $1 AND rel.relname = $2 AND l.pid <> pg_backend_pid()
+		ORDER BY COALESCE(a.xact_start, a.backend_start) NULLS LAST, l.pid`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table locks: %w", err)
+	}
+	defer rows.Close()
+
+	var locks []domain.TableLock
+	for rows.Next() {
+		var lock domain.TableLock
+		var heldSince sql.NullTime
+		if err := rows.Scan(&lock.PID, &lock.Username, &lock.Mode, &lock.Granted, &heldSince,
+			&lock.State, &lock.Query, &lock.ApplicationName, &lock.ClientAddr); err != nil {
+			return nil, fmt.Errorf("failed to scan table lock: %w", err)
+		}
+		lock.HeldSince = heldSince.Time
+		locks = append(locks, lock)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return locks, nil
+}
@@ -2,11 +2,71 @@ package database_repository
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
 )
 
 func (d *DatabaseRepositoryImplementation) GetTableMetadata(ctx context.Context, database, schema, table string) (*domain.TableMetadata, error) {
-	return nil, errors.New("not implemented")
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT c.column_name, c.data_type, c.is_nullable = 'YES',
+			c.column_default IS NOT NULL OR c.is_identity = 'YES',
+			CASE WHEN c.data_type = 'numeric' THEN COALESCE(c.numeric_precision, 0) ELSE 0 END,
+			CASE WHEN c.data_type = 'numeric' THEN COALESCE(c.numeric_scale, 0) ELSE 0 END,
+			EXISTS (
+				SELECT 1
+				FROM information_schema.table_constraints tc
+				JOIN information_schema.key_column_usage kcu
+					ON kcu.constraint_name = tc.constraint_name AND kcu.table_schema = tc.table_schema
+				WHERE tc.constraint_type = 'PRIMARY KEY'
+					AND tc.table_schema = c.table_schema AND tc.table_name = c.table_name
+					AND kcu.column_name = c.column_name
+			),
+			ARRAY(
+				SELECT e.enumlabel
+				FROM pg_catalog.pg_enum e
+				JOIN pg_catalog.pg_type t ON t.oid = e.enumtypid
+				JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+				WHERE c.data_type = 'USER-DEFINED' AND n.nspname = c.udt_schema AND t.typname = c.udt_name
+				ORDER BY e.enumsortorder
+			)
+		FROM information_schema.columns c
+		WHERE c.table_schema = $1 AND c.table_name = $2
+		ORDER BY c.ordinal_position`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+	defer rows.Close()
+
+	metadata := &domain.TableMetadata{Name: table}
+	for rows.Next() {
+		var column domain.ColumnMetadata
+		if err := rows.Scan(&column.Name, &column.DataType, &column.IsNullable, &column.HasDefault,
+			&column.NumericPrecision, &column.NumericScale, &column.IsPrimary, pq.Array(&column.EnumValues)); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+		if len(column.EnumValues) == 0 {
+			column.EnumValues = nil
+		}
+		metadata.Columns = append(metadata.Columns, column)
+		if column.IsPrimary {
+			metadata.PrimaryKeys = append(metadata.PrimaryKeys, column.Name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	if len(metadata.Columns) == 0 {
+		return nil, domain.ErrTableNotFound
+	}
+
+	return metadata, nil
 }
@@ -0,0 +1,45 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) GetTableSizeEstimates(ctx context.Context, database, schema string, tables []string) ([]domain.TableSizeEstimate, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	// reltuples is -1 for a table never vacuumed or analyzed since PostgreSQL 14, and 0 before it
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT c.relname, CASE WHEN c.reltuples < 0 THEN NULL ELSE c.reltuples::bigint END,
+			pg_total_relation_size(c.oid)
+		FROM unnest($2::text[]) WITH ORDINALITY AS t(name, position)
+		JOIN pg_class c ON c.relname = t.name
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relkind IN ('r', 'm', 'p', 'f')
+		ORDER BY t.position`, schema, pq.Array(tables))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read table sizes: %w", err)
+	}
+	defer rows.Close()
+
+	estimates := []domain.TableSizeEstimate{}
+	for rows.Next() {
+		estimate := domain.TableSizeEstimate{Schema: schema}
+		if err := rows.Scan(&estimate.Table, &estimate.EstimatedRows, &estimate.TotalSizeBytes); err != nil {
+			return nil, fmt.Errorf("failed to scan table size: %w", err)
+		}
+		estimates = append(estimates, estimate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return estimates, nil
+}
@@ -0,0 +1,91 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) GetTableStatistics(ctx context.Context, database, schema, table string) (*domain.TableStatistics, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// Partitioned tables have no pg_stat_user_tables row, so their counters read as zero. pg_stats only
+	// lists the columns the reader may select, leaving the row width unknown for the rest.
+	stats := &domain.TableStatistics{Table: table}
+	var lastVacuum, lastAutovacuum, lastAnalyze, lastAutoanalyze sql.NullTime
+	var rowWidth sql.NullInt64
+	err := d.conn(ctx).QueryRowContext(ctx, `
+		SELECT c.reltuples::float8, c.relpages::bigint,
+			COALESCE((SELECT option_value::int FROM pg_options_to_table(c.reloptions) WHERE option_name = 'fillfactor'), 100),
+			current_setting('block_size')::bigint,
+			pg_table_size(c.oid), pg_indexes_size(c.oid), pg_total_relation_size(c.oid),
+			COALESCE(s.n_live_tup, 0), COALESCE(s.n_dead_tup, 0), COALESCE(s.n_mod_since_analyze, 0),
+			COALESCE(s.seq_scan, 0), COALESCE(s.seq_tup_read, 0), COALESCE(s.idx_scan, 0), COALESCE(s.idx_tup_fetch, 0),
+			s.last_vacuum, s.last_autovacuum, s.last_analyze, s.last_autoanalyze,
+			COALESCE(s.vacuum_count, 0), COALESCE(s.autovacuum_count, 0),
+			COALESCE(s.analyze_count, 0), COALESCE(s.autoanalyze_count, 0),
+			(SELECT SUM((1 - st.null_frac) * st.avg_width)::bigint
+				FROM pg_stats st WHERE st.schemaname = n.nspname AND st.tablename = c.relname)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		LEFT JOIN pg_stat_user_tables s ON s.relid = c.oid
+		WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'm', 'p')`, schema, table).
+		Scan(&stats.EstimatedRows, &stats.Pages, &stats.FillFactor, &stats.BlockSize,
+			&stats.TableSizeBytes, &stats.IndexesSizeBytes, &stats.TotalSizeBytes,
+			&stats.LiveTuples, &stats.DeadTuples, &stats.ModificationsSinceAnalyze,
+			&stats.SeqScans, &stats.SeqTuplesRead, &stats.IndexScans, &stats.IndexTuplesFetched,
+			&lastVacuum, &lastAutovacuum, &lastAnalyze, &lastAutoanalyze,
+			&stats.VacuumCount, &stats.AutovacuumCount, &stats.AnalyzeCount, &stats.AutoanalyzeCount,
+			&rowWidth)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("table %s.%s not found", schema, table)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table statistics: %w", err)
+	}
+	stats.LastVacuum = nullTimePointer(lastVacuum)
+	stats.LastAutovacuum = nullTimePointer(lastAutovacuum)
+	stats.LastAnalyze = nullTimePointer(lastAnalyze)
+	stats.LastAutoanalyze = nullTimePointer(lastAutoanalyze)
+	if rowWidth.Valid {
+		stats.RowWidth = &rowWidth.Int64
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT i.indexrelname, i.idx_scan, i.idx_tup_read, i.idx_tup_fetch, pg_relation_size(i.indexrelid), x.indisunique
+		FROM pg_stat_user_indexes i
+		JOIN pg_index x ON x.indexrelid = i.indexrelid
+		WHERE i.schemaname = $1 AND i.relname = $2
+		ORDER BY i.indexrelname`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get index statistics: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var index domain.IndexUsageStats
+		if err := rows.Scan(&index.Name, &index.Scans, &index.TuplesRead, &index.TuplesFetched, &index.SizeBytes, &index.Unique); err != nil {
+			return nil, fmt.Errorf("failed to scan index statistics: %w", err)
+		}
+		stats.Indexes = append(stats.Indexes, index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return stats, nil
+}
+
+// nullTimePointer returns the time of a nullable timestamp, nil when it is NULL
+func nullTimePointer(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
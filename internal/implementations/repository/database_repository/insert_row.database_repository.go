@@ -2,9 +2,44 @@ package database_repository
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (d *DatabaseRepositoryImplementation) InsertRow(ctx context.Context, database, schema, table string, values map[string]interface{}) error {
-	return errors.New("not implemented")
+	tx, err := d.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := d.UpsertRow(ctx, tx, database, schema, table, domain.RowInsert{Values: values}); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit insert: %w", err)
+	}
+	return nil
+}
+
+// bindValue appends value to args and returns its placeholder, wrapping encrypted values in pgp_sym_encrypt
+func bindValue(value interface{}, args *[]interface{}) string {
+	if encrypted, ok := value.(domain.EncryptedValue); ok {
+		*args = append(*args, encrypted.Value, encrypted.Key)
+		return fmt.Sprintf("pgp_sym_encrypt($%d::text, $%d)", len(*args)-1, len(*args))
+	}
+	*args = append(*args, value)
+	return fmt.Sprintf("$%d", len(*args))
+}
+
+// sortedKeys returns the column names of values in a stable order
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
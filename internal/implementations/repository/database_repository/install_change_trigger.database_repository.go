@@ -0,0 +1,57 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/lib/pq"
+)
+
+// channelNamePattern restricts watch channels to plain identifiers, since the channel also names the
+// trigger and its function
+var channelNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,62}$`)
+
+func (d *DatabaseRepositoryImplementation) InstallChangeTrigger(ctx context.Context, channel, schema, table string) error {
+	if d.conn(ctx) == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+	if !channelNamePattern.MatchString(channel) {
+		return fmt.Errorf("invalid notification channel: %s", channel)
+	}
+
+	tx, err := d.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The trigger fires once per statement and only names the operation, so it adds one NOTIFY to each
+	// write however many rows it touches and never runs into the payload size limit
+	function := qualifiedTableName(schema, channel)
+	createFunction := fmt.Sprintf(`CREATE OR REPLACE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+	PERFORM pg_notify(%s, TG_OP);
+	RETURN NULL;
+END;
+$$`, function, pq.QuoteLiteral(channel))
+	if _, err := tx.ExecContext(ctx, createFunction); err != nil {
+		return fmt.Errorf("failed to create trigger function: %w", err)
+	}
+
+	createTrigger := fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON %s FOR EACH STATEMENT EXECUTE FUNCTION %s()",
+		pq.QuoteIdentifier(channel), qualifiedTableName(schema, table), function)
+	if _, err := tx.ExecContext(ctx, createTrigger); err != nil {
+		return fmt.Errorf("failed to create trigger: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit trigger: %w", err)
+	}
+
+	// Until its listener takes the channel's lock, only this mark keeps the trigger from looking stale
+	d.watchMu.Lock()
+	d.watchChannels[channel] = true
+	d.watchMu.Unlock()
+	return nil
+}
@@ -0,0 +1,77 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// functionParameterModes maps pg_proc.proargmodes codes onto parameter modes
+var functionParameterModes = map[string]string{
+	"i": domain.FunctionParameterModeIn,
+	"o": domain.FunctionParameterModeOut,
+	"b": domain.FunctionParameterModeInOut,
+	"v": domain.FunctionParameterModeVariadic,
+	"t": domain.FunctionParameterModeTable,
+}
+
+func (d *DatabaseRepositoryImplementation) ListFunctions(ctx context.Context, database, schema string) ([]domain.StoredFunction, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// proallargtypes is only set when there are output parameters; otherwise every parameter is an
+	// input one listed in proargtypes
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT p.proname, p.prokind = 'p', pg_get_function_identity_arguments(p.oid),
+			COALESCE(pg_get_function_result(p.oid), ''), l.lanname, p.pronargdefaults,
+			ARRAY(SELECT COALESCE(p.proargnames[a.ordinal], '') FROM unnest(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY a(argtype, ordinal) ORDER BY a.ordinal),
+			ARRAY(SELECT format_type(a.argtype, NULL) FROM unnest(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY a(argtype, ordinal) ORDER BY a.ordinal),
+			ARRAY(SELECT COALESCE(p.proargmodes[a.ordinal]::text, 'i') FROM unnest(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY a(argtype, ordinal) ORDER BY a.ordinal)
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		JOIN pg_language l ON l.oid = p.prolang
+		WHERE n.nspname = $1 AND p.prokind IN ('f', 'p')
+		ORDER BY p.proname, 3`, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list functions: %w", err)
+	}
+	defer rows.Close()
+
+	var functions []domain.StoredFunction
+	for rows.Next() {
+		function := domain.StoredFunction{Schema: schema, Kind: domain.FunctionKindFunction}
+		var procedure bool
+		var defaults int
+		var names, types, modes []string
+		if err := rows.Scan(&function.Name, &procedure, &function.Arguments, &function.Result, &function.Language, &defaults,
+			pq.Array(&names), pq.Array(&types), pq.Array(&modes)); err != nil {
+			return nil, fmt.Errorf("failed to scan function: %w", err)
+		}
+		if procedure {
+			function.Kind = domain.FunctionKindProcedure
+		}
+
+		function.Parameters = make([]domain.FunctionParameter, len(types))
+		for i := range types {
+			function.Parameters[i] = domain.FunctionParameter{Name: names[i], DataType: types[i], Mode: functionParameterModes[modes[i]]}
+		}
+		// Defaults belong to the last input parameters
+		for i := len(function.Parameters) - 1; i >= 0 && defaults > 0; i-- {
+			switch function.Parameters[i].Mode {
+			case domain.FunctionParameterModeIn, domain.FunctionParameterModeInOut, domain.FunctionParameterModeVariadic:
+				function.Parameters[i].HasDefault = true
+				defaults--
+			}
+		}
+
+		functions = append(functions, function)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return functions, nil
+}
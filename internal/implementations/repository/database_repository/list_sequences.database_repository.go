@@ -0,0 +1,53 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) ListSequences(ctx context.Context, database, schema string) ([]domain.Sequence, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// Serial columns own their sequence through an auto dependency, identity columns through an
+	// internal one
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT s.sequencename, s.data_type::text, s.last_value, s.start_value, s.increment_by, s.min_value,
+			s.max_value, s.cycle, COALESCE(t.relname, ''), COALESCE(a.attname, '')
+		FROM pg_sequences s
+		JOIN pg_namespace n ON n.nspname = s.schemaname
+		JOIN pg_class c ON c.relnamespace = n.oid AND c.relname = s.sequencename
+		LEFT JOIN pg_depend d ON d.classid = 'pg_class'::regclass AND d.objid = c.oid
+			AND d.refclassid = 'pg_class'::regclass AND d.deptype IN ('a', 'i')
+		LEFT JOIN pg_class t ON t.oid = d.refobjid
+		LEFT JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+		WHERE s.schemaname = $1
+		ORDER BY s.sequencename`, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sequences: %w", err)
+	}
+	defer rows.Close()
+
+	var sequences []domain.Sequence
+	for rows.Next() {
+		sequence := domain.Sequence{Schema: schema}
+		var lastValue sql.NullInt64
+		if err := rows.Scan(&sequence.Name, &sequence.DataType, &lastValue, &sequence.StartValue, &sequence.Increment,
+			&sequence.MinValue, &sequence.MaxValue, &sequence.Cycle, &sequence.OwnedByTable, &sequence.OwnedByColumn); err != nil {
+			return nil, fmt.Errorf("failed to scan sequence: %w", err)
+		}
+		if lastValue.Valid {
+			sequence.LastValue = &lastValue.Int64
+		}
+		sequences = append(sequences, sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return sequences, nil
+}
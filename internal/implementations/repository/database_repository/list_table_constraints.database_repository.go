@@ -0,0 +1,69 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// constraintTypes maps pg_constraint.contype onto the domain constraint types
+var constraintTypes = map[string]string{
+	"p": domain.ConstraintTypePrimaryKey,
+	"u": domain.ConstraintTypeUnique,
+	"c": domain.ConstraintTypeCheck,
+	"f": domain.ConstraintTypeForeignKey,
+}
+
+func (d *DatabaseRepositoryImplementation) ListTableConstraints(ctx context.Context, database, schema, table string) ([]domain.TableConstraint, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// Key columns are listed in constraint order, not column order
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT con.conname, con.contype::text, pg_get_constraintdef(con.oid), con.convalidated,
+			ARRAY(
+				SELECT a.attname::text
+				FROM unnest(con.conkey) WITH ORDINALITY AS k(attnum, ordinal)
+				JOIN pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+				ORDER BY k.ordinal),
+			COALESCE(fn.nspname, ''), COALESCE(ft.relname, ''),
+			ARRAY(
+				SELECT a.attname::text
+				FROM unnest(con.confkey) WITH ORDINALITY AS k(attnum, ordinal)
+				JOIN pg_attribute a ON a.attrelid = con.confrelid AND a.attnum = k.attnum
+				ORDER BY k.ordinal)
+		FROM pg_constraint con
+		JOIN pg_class rel ON rel.oid = con.conrelid
+		JOIN pg_namespace nsp ON nsp.oid = rel.relnamespace
+		LEFT JOIN pg_class ft ON ft.oid = con.confrelid
+		LEFT JOIN pg_namespace fn ON fn.oid = ft.relnamespace
+		WHERE nsp.nspname = $1 AND rel.relname = $2 AND con.contype IN ('p', 'u', 'c', 'f')
+		ORDER BY CASE con.contype WHEN 'p' THEN 0 WHEN 'u' THEN 1 WHEN 'f' THEN 2 ELSE 3 END, con.conname`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list table constraints: %w", err)
+	}
+	defer rows.Close()
+
+	var constraints []domain.TableConstraint
+	for rows.Next() {
+		var constraint domain.TableConstraint
+		var contype string
+		var columns, referencedColumns []string
+		if err := rows.Scan(&constraint.Name, &contype, &constraint.Definition, &constraint.Validated,
+			pq.Array(&columns), &constraint.ReferencedSchema, &constraint.ReferencedTable, pq.Array(&referencedColumns)); err != nil {
+			return nil, fmt.Errorf("failed to scan table constraint: %w", err)
+		}
+		constraint.Type = constraintTypes[contype]
+		constraint.Columns = columns
+		constraint.ReferencedColumns = referencedColumns
+		constraints = append(constraints, constraint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return constraints, nil
+}
@@ -0,0 +1,51 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) ListTableIndexes(ctx context.Context, database, schema, table string) ([]domain.TableIndex, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// Foreign keys also record the referenced index in conindid, so only the table's own
+	// primary key, unique and exclusion constraints are matched
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT i.relname, pg_get_indexdef(x.indexrelid), am.amname, x.indisunique, x.indisprimary, x.indisvalid,
+			con.conname, pg_relation_size(x.indexrelid), COALESCE(s.idx_scan, 0)
+		FROM pg_index x
+		JOIN pg_class t ON t.oid = x.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_am am ON am.oid = i.relam
+		LEFT JOIN pg_constraint con ON con.conindid = x.indexrelid AND con.conrelid = t.oid AND con.contype IN ('p', 'u', 'x')
+		LEFT JOIN pg_stat_user_indexes s ON s.indexrelid = x.indexrelid
+		WHERE n.nspname = $1 AND t.relname = $2
+		ORDER BY i.relname`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list table indexes: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []domain.TableIndex
+	for rows.Next() {
+		var index domain.TableIndex
+		var constraint sql.NullString
+		if err := rows.Scan(&index.Name, &index.Definition, &index.Method, &index.Unique, &index.Primary, &index.Valid,
+			&constraint, &index.SizeBytes, &index.Scans); err != nil {
+			return nil, fmt.Errorf("failed to scan table index: %w", err)
+		}
+		index.Constraint = constraint.String
+		indexes = append(indexes, index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return indexes, nil
+}
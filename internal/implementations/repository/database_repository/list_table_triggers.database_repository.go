@@ -0,0 +1,73 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// pg_trigger.tgtype bits
+const (
+	triggerTypeRow      = 1 << 0
+	triggerTypeBefore   = 1 << 1
+	triggerTypeInsert   = 1 << 2
+	triggerTypeDelete   = 1 << 3
+	triggerTypeUpdate   = 1 << 4
+	triggerTypeTruncate = 1 << 5
+	triggerTypeInstead  = 1 << 6
+)
+
+func (d *DatabaseRepositoryImplementation) ListTableTriggers(ctx context.Context, database, schema, table string) ([]domain.TableTrigger, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT t.tgname, t.tgtype::int, t.tgenabled <> 'D', fn.nspname, p.proname, pg_get_triggerdef(t.oid)
+		FROM pg_trigger t
+		JOIN pg_class rel ON rel.oid = t.tgrelid
+		JOIN pg_namespace nsp ON nsp.oid = rel.relnamespace
+		JOIN pg_proc p ON p.oid = t.tgfoid
+		JOIN pg_namespace fn ON fn.oid = p.pronamespace
+		WHERE nsp.nspname = $1 AND rel.relname = $2 AND NOT t.tgisinternal
+		ORDER BY t.tgname`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list table triggers: %w", err)
+	}
+	defer rows.Close()
+
+	var triggers []domain.TableTrigger
+	for rows.Next() {
+		var trigger domain.TableTrigger
+		var tgtype int
+		if err := rows.Scan(&trigger.Name, &tgtype, &trigger.Enabled, &trigger.FunctionSchema, &trigger.FunctionName, &trigger.Definition); err != nil {
+			return nil, fmt.Errorf("failed to scan table trigger: %w", err)
+		}
+
+		trigger.ForEachRow = tgtype&triggerTypeRow != 0
+		switch {
+		case tgtype&triggerTypeInstead != 0:
+			trigger.Timing = domain.TriggerTimingInsteadOf
+		case tgtype&triggerTypeBefore != 0:
+			trigger.Timing = domain.TriggerTimingBefore
+		default:
+			trigger.Timing = domain.TriggerTimingAfter
+		}
+		for _, event := range []struct {
+			bit  int
+			name string
+		}{{triggerTypeInsert, "INSERT"}, {triggerTypeUpdate, "UPDATE"}, {triggerTypeDelete, "DELETE"}, {triggerTypeTruncate, "TRUNCATE"}} {
+			if tgtype&event.bit != 0 {
+				trigger.Events = append(trigger.Events, event.name)
+			}
+		}
+
+		triggers = append(triggers, trigger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return triggers, nil
+}
@@ -0,0 +1,91 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) ListenNotifications(ctx context.Context, channel string, onNotify func(payload string) error) error {
+	if d.conn(ctx) == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+	if !channelNamePattern.MatchString(channel) {
+		return fmt.Errorf("invalid notification channel: %s", channel)
+	}
+
+	conn, err := d.conn(ctx).Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	// The driver hands notifications over while it reads a reply, so they are queued here and delivered
+	// after each round trip rather than from inside the driver
+	var mu sync.Mutex
+	var pending []string
+	setHandler := func(handler func(*pq.Notification)) error {
+		return conn.Raw(func(driverConn interface{}) error {
+			pq.SetNotificationHandler(driverConn.(driver.Conn), handler)
+			return nil
+		})
+	}
+	if err := setHandler(func(n *pq.Notification) {
+		mu.Lock()
+		pending = append(pending, n.Extra)
+		mu.Unlock()
+	}); err != nil {
+		return fmt.Errorf("failed to register notification handler: %w", err)
+	}
+
+	// Leave the connection as it was found before it goes back to the pool
+	defer func() {
+		conn.ExecContext(context.Background(), "UNLISTEN "+pq.QuoteIdentifier(channel))
+		conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1, hashtext($2))", domain.RowWatchLockClass, channel)
+		setHandler(nil)
+	}()
+
+	// The lock outlives this process only as long as its connection, so a channel nobody holds it for
+	// has no listener left
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1, hashtext($2))", domain.RowWatchLockClass, channel); err != nil {
+		return fmt.Errorf("failed to lock %s: %w", channel, err)
+	}
+	if _, err := conn.ExecContext(ctx, "LISTEN "+pq.QuoteIdentifier(channel)); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	ticker := time.NewTicker(domain.NotificationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// An idle backend sends notifications ahead of the reply to the next command
+		if _, err := conn.ExecContext(ctx, "SELECT 1"); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("notification connection failed: %w", err)
+		}
+
+		mu.Lock()
+		delivered := pending
+		pending = nil
+		mu.Unlock()
+
+		for _, payload := range delivered {
+			if err := onNotify(payload); err != nil {
+				return err
+			}
+		}
+	}
+}
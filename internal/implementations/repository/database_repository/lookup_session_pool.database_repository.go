@@ -0,0 +1,18 @@
+package database_repository
+
+import (
+	"database/sql"
+	"time"
+)
+
+func (d *DatabaseRepositoryImplementation) LookupSessionPool(key string) (*sql.DB, bool) {
+	d.poolsMu.Lock()
+	defer d.poolsMu.Unlock()
+
+	pool, ok := d.pools[key]
+	if !ok {
+		return nil, false
+	}
+	pool.lastUsed = time.Now()
+	return pool.db, true
+}
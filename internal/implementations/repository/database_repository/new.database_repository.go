@@ -2,16 +2,55 @@ package database_repository
 
 import (
 	"database/sql"
+	"sync"
 
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 )
 
 type DatabaseRepositoryImplementation struct {
 	db *sql.DB
+
+	// runningMu guards runningQueries, the backend PIDs of tracked queries keyed by tracking key
+	runningMu      sync.Mutex
+	runningQueries map[string]int
+
+	// poolsMu guards pools, the per-session connection pools keyed by session key, poolConfig,
+	// applicationNamePrefix and sharedConnString, the connection string Connect opened db with
+	poolsMu               sync.Mutex
+	pools                 map[string]*sessionPool
+	poolConfig            domain.ConnectionPoolConfig
+	applicationNamePrefix string
+	sharedConnString      string
+
+	// connLimits caps the connections opened to each target database by login probes, session pools and
+	// the shared connection once Connect opens it
+	connLimits *connLimiter
+
+	// snapshotsMu guards snapshots, the frozen views keyed by session key
+	snapshotsMu sync.Mutex
+	snapshots   map[string]*snapshot
+
+	// watchMu guards watchChannels, the channels whose change triggers this repository installed and
+	// has not removed yet
+	watchMu       sync.Mutex
+	watchChannels map[string]bool
 }
 
 func NewDatabaseRepository(db *sql.DB) repository.DatabaseRepository {
 	return &DatabaseRepositoryImplementation{
-		db: db,
+		db:             db,
+		runningQueries: make(map[string]int),
+		pools:          make(map[string]*sessionPool),
+		snapshots:      make(map[string]*snapshot),
+		watchChannels:  make(map[string]bool),
+		poolConfig: domain.ConnectionPoolConfig{
+			MaxConns:          domain.DefaultPoolMaxConns,
+			MaxIdleTime:       domain.DefaultPoolMaxIdleTime,
+			HealthCheckPeriod: domain.DefaultPoolHealthCheckPeriod,
+			QueueTimeout:      domain.DefaultPoolQueueTimeout,
+		},
+		applicationNamePrefix: domain.DefaultApplicationNamePrefix,
+		connLimits:            newConnLimiter(),
 	}
 }
@@ -0,0 +1,96 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) PreviewColumnDefaults(ctx context.Context, database, schema, table string) (map[string]domain.ColumnDefaultPreview, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+	if schema == "" {
+		schema = "public"
+	}
+
+	// Serial and identity columns resolve to their sequence, whose next value is read from pg_sequences
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT c.column_name, COALESCE(c.column_default, ''),
+			COALESCE(pg_get_serial_sequence(format('%I.%I', c.table_schema, c.table_name), c.column_name), ''),
+			COALESCE((
+				SELECT (COALESCE(s.last_value + s.increment_by, s.start_value))::text
+				FROM pg_sequences s
+				WHERE format('%I.%I', s.schemaname, s.sequencename) =
+					pg_get_serial_sequence(format('%I.%I', c.table_schema, c.table_name), c.column_name)
+			), '')
+		FROM information_schema.columns c
+		WHERE c.table_schema = $1 AND c.table_name = $2
+			AND (c.column_default IS NOT NULL OR c.is_identity = 'YES')
+		ORDER BY c.ordinal_position`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column defaults: %w", err)
+	}
+
+	previews := make(map[string]domain.ColumnDefaultPreview)
+	var expressions []string
+	var expressionColumns []string
+	for rows.Next() {
+		var column, expression, sequence, nextValue string
+		if err := rows.Scan(&column, &expression, &sequence, &nextValue); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan column default: %w", err)
+		}
+
+		if sequence != "" {
+			if expression == "" {
+				expression = "GENERATED AS IDENTITY"
+			}
+			previews[column] = domain.ColumnDefaultPreview{Expression: expression, Value: nextValue, Approximate: true}
+			continue
+		}
+		expressions = append(expressions, expression)
+		expressionColumns = append(expressionColumns, column)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	for i, expression := range expressions {
+		value, err := d.evaluateDefault(ctx, expression)
+		preview := domain.ColumnDefaultPreview{Expression: expression, Value: value, Approximate: isVolatileDefault(expression)}
+		if err != nil {
+			// Defaults that cannot run read-only, like other nextval() calls, only show their expression
+			preview.Value = ""
+			preview.Approximate = true
+		}
+		previews[expressionColumns[i]] = preview
+	}
+
+	return previews, nil
+}
+
+// evaluateDefault runs a column default expression from the catalog in a read-only transaction that is
+// always rolled back, so it cannot advance sequences or write anything
+func (d *DatabaseRepositoryImplementation) evaluateDefault(ctx context.Context, expression string) (string, error) {
+	tx, err := d.conn(ctx).BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var value sql.NullString
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT (%s)::text", expression)).Scan(&value); err != nil {
+		return "", err
+	}
+	return value.String, nil
+}
+
+// isVolatileDefault reports whether a default calls a function, whose value may differ at insert time
+func isVolatileDefault(expression string) bool {
+	return strings.Contains(expression, "(")
+}
@@ -0,0 +1,24 @@
+package database_repository
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// qualifiedTableName returns the quoted, schema-qualified name of a table
+func qualifiedTableName(schema, table string) string {
+	if schema == "" {
+		return pq.QuoteIdentifier(table)
+	}
+	return pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(table)
+}
+
+// quoteIdentifiers quotes and comma-joins a list of identifiers
+func quoteIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = pq.QuoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,33 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) RemoveChangeTrigger(ctx context.Context, channel, schema, table string) error {
+	if d.conn(ctx) == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+	if !channelNamePattern.MatchString(channel) {
+		return fmt.Errorf("invalid notification channel: %s", channel)
+	}
+	defer func() {
+		d.watchMu.Lock()
+		delete(d.watchChannels, channel)
+		d.watchMu.Unlock()
+	}()
+
+	dropTrigger := fmt.Sprintf("DROP TRIGGER IF EXISTS %s ON %s", pq.QuoteIdentifier(channel), qualifiedTableName(schema, table))
+	if _, err := d.conn(ctx).ExecContext(ctx, dropTrigger); err != nil {
+		return fmt.Errorf("failed to drop trigger: %w", err)
+	}
+
+	dropFunction := fmt.Sprintf("DROP FUNCTION IF EXISTS %s()", qualifiedTableName(schema, channel))
+	if _, err := d.conn(ctx).ExecContext(ctx, dropFunction); err != nil {
+		return fmt.Errorf("failed to drop trigger function: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) RemoveStaleChangeTriggers(ctx context.Context) (int, error) {
+	if d.conn(ctx) == nil {
+		return 0, fmt.Errorf("database connection is not established")
+	}
+
+	// Every watch installs one trigger function named after its channel, and dropping the function
+	// drops the trigger with it
+	pattern := strings.ReplaceAll(domain.RowWatchChannelPrefix, "_", `\_`) + "%"
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT n.nspname, p.proname
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		WHERE p.proname LIKE $1 AND p.pronargs = 0 AND p.prorettype = 'trigger'::regtype`, pattern)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list change triggers: %w", err)
+	}
+	type triggerFunction struct{ schema, channel string }
+	var functions []triggerFunction
+	for rows.Next() {
+		var function triggerFunction
+		if err := rows.Scan(&function.schema, &function.channel); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan change trigger: %w", err)
+		}
+		functions = append(functions, function)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to list change triggers: %w", err)
+	}
+
+	removed := 0
+	for _, function := range functions {
+		d.watchMu.Lock()
+		active := d.watchChannels[function.channel]
+		d.watchMu.Unlock()
+		if active || !channelNamePattern.MatchString(function.channel) {
+			continue
+		}
+
+		dropped, err := d.dropUnlistenedTriggerFunction(ctx, function.schema, function.channel)
+		if err != nil {
+			// Another role's leftovers wait for a sweep by a connection allowed to drop them
+			continue
+		}
+		if dropped {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// dropUnlistenedTriggerFunction drops the trigger function of channel, and so its trigger, unless a
+// listener holds the channel's lock. The lock is held until the drop commits, so a listener starting
+// meanwhile waits and finds the trigger gone.
+func (d *DatabaseRepositoryImplementation) dropUnlistenedTriggerFunction(ctx context.Context, schema, channel string) (bool, error) {
+	tx, err := d.conn(ctx).BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var unlistened bool
+	if err := tx.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1, hashtext($2))", domain.RowWatchLockClass, channel).Scan(&unlistened); err != nil {
+		return false, fmt.Errorf("failed to check listener of %s: %w", channel, err)
+	}
+	if !unlistened {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP FUNCTION IF EXISTS %s() CASCADE", qualifiedTableName(schema, channel))); err != nil {
+		return false, fmt.Errorf("failed to drop trigger function: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit trigger removal: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,36 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) SearchReferencedRows(ctx context.Context, params domain.ForeignKeyLookupParams) (*domain.QueryResult, error) {
+	if d.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	columns := append([]string{params.KeyColumn}, params.DisplayColumns...)
+	selected := make([]string, len(columns))
+	matches := make([]string, len(columns))
+	for i, column := range columns {
+		selected[i] = pq.QuoteIdentifier(column)
+		matches[i] = selected[i] + "::text ILIKE $1"
+	}
+
+	// The term is matched literally, so LIKE wildcards in it are escaped
+	pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(params.Term) + "%"
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT %d",
+		strings.Join(selected, ", "),
+		qualifiedTableName(params.Schema, params.Table),
+		strings.Join(matches, " OR "),
+		strings.Join(append(selected[1:], selected[0]), ", "),
+		params.Limit,
+	)
+
+	return d.ExecuteQuery(ctx, query, pattern)
+}
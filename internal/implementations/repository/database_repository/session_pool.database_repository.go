@@ -0,0 +1,108 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// sessionPool is the connection pool opened with one session's credentials
+type sessionPool struct {
+	db         *sql.DB
+	connString string
+	lastUsed   time.Time
+	lastCheck  time.Time
+}
+
+func (d *DatabaseRepositoryImplementation) SessionPool(ctx context.Context, key, connString string) (*sql.DB, error) {
+	if key == "" {
+		return nil, errors.New("session key cannot be empty")
+	}
+	if connString == "" {
+		return nil, errors.New("connection string cannot be empty")
+	}
+
+	d.poolsMu.Lock()
+	now := time.Now()
+	d.closeIdlePoolsLocked(now, key)
+
+	pool, ok := d.pools[key]
+	if ok && pool.connString != connString {
+		// The session re-authenticated with different credentials
+		pool.db.Close()
+		delete(d.pools, key)
+		ok = false
+	}
+	if ok && now.Sub(pool.lastCheck) < d.poolConfig.HealthCheckPeriod {
+		pool.lastUsed = now
+		d.poolsMu.Unlock()
+		return pool.db, nil
+	}
+	tagged := d.taggedConnStringLocked(connString, domain.ApplicationFeatureSession)
+	maxConns := d.poolConfig.MaxConns
+	maxIdleTime := d.poolConfig.MaxIdleTime
+	d.poolsMu.Unlock()
+
+	// Health checks and new pools reach the server outside poolsMu, so one slow server never holds up
+	// the other sessions' logins and queries
+	if ok {
+		if err := pool.db.PingContext(ctx); err == nil {
+			d.poolsMu.Lock()
+			pool.lastCheck, pool.lastUsed = now, now
+			d.poolsMu.Unlock()
+			return pool.db, nil
+		}
+		d.poolsMu.Lock()
+		if d.pools[key] == pool {
+			delete(d.pools, key)
+		}
+		d.poolsMu.Unlock()
+		pool.db.Close()
+	}
+
+	db, err := d.openLimited(tagged)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(maxConns)
+	db.SetMaxIdleConns(maxConns)
+	// An idle connection would hold a slot of a capped database that another session queues for
+	if d.connLimits.capped(connStringDatabase(connString)) {
+		db.SetMaxIdleConns(0)
+	}
+	db.SetConnMaxIdleTime(maxIdleTime)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	d.poolsMu.Lock()
+	defer d.poolsMu.Unlock()
+	// A concurrent request for the same session may have stored a pool while this one was opening
+	if existing, ok := d.pools[key]; ok && existing.connString == connString {
+		db.Close()
+		existing.lastUsed = now
+		return existing.db, nil
+	}
+	if existing, ok := d.pools[key]; ok {
+		existing.db.Close()
+	}
+	d.pools[key] = &sessionPool{db: db, connString: connString, lastUsed: now, lastCheck: now}
+	return db, nil
+}
+
+// closeIdlePoolsLocked closes the pools, other than the one for keep, unused for longer than the
+// configured idle time; poolsMu must be held
+func (d *DatabaseRepositoryImplementation) closeIdlePoolsLocked(now time.Time, keep string) {
+	for key, pool := range d.pools {
+		if key != keep && now.Sub(pool.lastUsed) > d.poolConfig.MaxIdleTime {
+			pool.db.Close()
+			delete(d.pools, key)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// applySessionSettings sets a session's GUC presets on conn for as long as it is pinned, returning
+// the names of the settings changed so far even when one fails
+func applySessionSettings(ctx context.Context, conn *sql.Conn, settings domain.SessionSettings) ([]string, error) {
+	var changed []string
+
+	if settings.WorkMem != "" {
+		if _, err := conn.ExecContext(ctx, "SELECT set_config('work_mem', $1, false)", settings.WorkMem); err != nil {
+			return changed, fmt.Errorf("failed to set work_mem: %w", err)
+		}
+		changed = append(changed, "work_mem")
+	}
+
+	if settings.StatementTimeout > 0 {
+		milliseconds := strconv.FormatInt(settings.StatementTimeout.Milliseconds(), 10)
+		if _, err := conn.ExecContext(ctx, "SELECT set_config('statement_timeout', $1, false)", milliseconds); err != nil {
+			return changed, fmt.Errorf("failed to set statement_timeout: %w", err)
+		}
+		changed = append(changed, "statement_timeout")
+	}
+
+	if settings.ApplicationNameSuffix != "" {
+		if _, err := conn.ExecContext(ctx,
+			"SELECT set_config('application_name', concat_ws(' ', NULLIF(current_setting('application_name'), ''), $1::text), false)",
+			settings.ApplicationNameSuffix); err != nil {
+			return changed, fmt.Errorf("failed to set application_name: %w", err)
+		}
+		changed = append(changed, "application_name")
+	}
+
+	return changed, nil
+}
+
+// resetSettings returns the named settings to the connection's defaults; it runs on release, after
+// the request's context may be done
+func resetSettings(conn *sql.Conn, names []string) {
+	for _, name := range names {
+		conn.ExecContext(context.Background(), "RESET "+name)
+	}
+}
@@ -0,0 +1,59 @@
+package database_repository
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) SetApplicationNamePrefix(prefix string) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		prefix = domain.DefaultApplicationNamePrefix
+	}
+
+	d.poolsMu.Lock()
+	defer d.poolsMu.Unlock()
+	d.applicationNamePrefix = prefix
+}
+
+// applicationName is the application_name tagging a connection used by username for feature
+func (d *DatabaseRepositoryImplementation) applicationName(username, feature string) string {
+	d.poolsMu.Lock()
+	defer d.poolsMu.Unlock()
+	return formatApplicationName(d.applicationNamePrefix, username, feature)
+}
+
+// taggedConnStringLocked adds feature's application_name for the connecting user to a URL or key/value
+// connection string; one naming no user is returned unchanged. poolsMu must be held.
+func (d *DatabaseRepositoryImplementation) taggedConnStringLocked(connString, feature string) string {
+	parsed, err := url.Parse(connString)
+	if err == nil && (parsed.Scheme == "postgres" || parsed.Scheme == "postgresql") {
+		if parsed.User == nil {
+			return connString
+		}
+		query := parsed.Query()
+		query.Set("application_name", formatApplicationName(d.applicationNamePrefix, parsed.User.Username(), feature))
+		parsed.RawQuery = query.Encode()
+		return parsed.String()
+	}
+
+	// A later setting overrides an earlier one, so an application_name already present is replaced
+	for _, field := range strings.Fields(connString) {
+		if user, ok := strings.CutPrefix(field, "user="); ok {
+			name := formatApplicationName(d.applicationNamePrefix, strings.Trim(user, "'"), feature)
+			return connString + " application_name='" + connValueEscaper.Replace(name) + "'"
+		}
+	}
+	return connString
+}
+
+// connValueEscaper escapes a value quoted in a key/value connection string
+var connValueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// formatApplicationName joins prefix, username and feature as "<prefix>/<user>/<feature>". The server
+// truncates application_name to 63 bytes, so a long username may cut the feature short.
+func formatApplicationName(prefix, username, feature string) string {
+	return prefix + "/" + username + "/" + feature
+}
@@ -0,0 +1,25 @@
+package database_repository
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (d *DatabaseRepositoryImplementation) SetPoolConfig(config domain.ConnectionPoolConfig) {
+	if config.MaxConns <= 0 {
+		config.MaxConns = domain.DefaultPoolMaxConns
+	}
+	if config.MaxIdleTime <= 0 {
+		config.MaxIdleTime = domain.DefaultPoolMaxIdleTime
+	}
+	if config.HealthCheckPeriod <= 0 {
+		config.HealthCheckPeriod = domain.DefaultPoolHealthCheckPeriod
+	}
+	if config.QueueTimeout <= 0 {
+		config.QueueTimeout = domain.DefaultPoolQueueTimeout
+	}
+
+	d.poolsMu.Lock()
+	defer d.poolsMu.Unlock()
+	d.poolConfig = config
+	d.connLimits.configure(config.DatabaseMaxConns, config.QueueTimeout)
+}
@@ -0,0 +1,93 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// snapshot is a frozen view: a read-only REPEATABLE READ transaction whose queries all see the data
+// as of takenAt. A transaction runs one statement at a time, so mu serializes its readers.
+type snapshot struct {
+	mu      sync.Mutex
+	tx      *sql.Tx
+	takenAt time.Time
+}
+
+func (d *DatabaseRepositoryImplementation) OpenSnapshot(ctx context.Context, key string) (time.Time, error) {
+	if d.conn(ctx) == nil {
+		return time.Time{}, fmt.Errorf("database connection is not established")
+	}
+
+	d.snapshotsMu.Lock()
+	defer d.snapshotsMu.Unlock()
+	if existing, ok := d.snapshots[key]; ok {
+		return existing.takenAt, nil
+	}
+
+	// The transaction outlives the request opening it, so it must not end with the request's context
+	tx, err := d.conn(ctx).BeginTx(context.WithoutCancel(ctx), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+
+	// A repeatable-read snapshot is taken by the transaction's first statement
+	var takenAt time.Time
+	if err := tx.QueryRowContext(ctx, "SELECT statement_timestamp()").Scan(&takenAt); err != nil {
+		tx.Rollback()
+		return time.Time{}, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+
+	d.snapshots[key] = &snapshot{tx: tx, takenAt: takenAt}
+	return takenAt, nil
+}
+
+func (d *DatabaseRepositoryImplementation) SnapshotTakenAt(key string) (time.Time, bool) {
+	d.snapshotsMu.Lock()
+	defer d.snapshotsMu.Unlock()
+
+	existing, ok := d.snapshots[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	return existing.takenAt, true
+}
+
+func (d *DatabaseRepositoryImplementation) ReleaseSnapshot(key string) error {
+	d.snapshotsMu.Lock()
+	existing, ok := d.snapshots[key]
+	delete(d.snapshots, key)
+	d.snapshotsMu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	// Wait for a read in progress before ending the transaction under it
+	existing.mu.Lock()
+	defer existing.mu.Unlock()
+	if err := existing.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return fmt.Errorf("failed to release snapshot: %w", err)
+	}
+	return nil
+}
+
+// lockSnapshot returns the snapshot open under key locked for reading, or nil when there is none;
+// the caller unlocks it when done
+func (d *DatabaseRepositoryImplementation) lockSnapshot(key string) *snapshot {
+	if key == "" {
+		return nil
+	}
+
+	d.snapshotsMu.Lock()
+	existing, ok := d.snapshots[key]
+	d.snapshotsMu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	existing.mu.Lock()
+	return existing
+}
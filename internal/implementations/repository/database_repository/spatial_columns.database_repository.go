@@ -0,0 +1,122 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// Aliases the rendered values of a spatial column are selected under, next to its raw value
+const (
+	spatialGeoJSONAlias = "__lumen_geojson."
+	spatialWKTAlias     = "__lumen_wkt."
+)
+
+// spatialColumn is a geometry or geography column with the schema PostGIS is installed in, which
+// the rendering functions are qualified with
+type spatialColumn struct {
+	domain.SpatialColumn
+	postgisSchema string
+}
+
+// spatialColumns lists the table's geometry and geography columns; none when PostGIS is not installed
+func (d *DatabaseRepositoryImplementation) spatialColumns(ctx context.Context, schema, table string) ([]spatialColumn, error) {
+	if schema == "" {
+		schema = "public"
+	}
+
+	rows, err := d.conn(ctx).QueryContext(ctx, `
+		SELECT a.attname, t.typname, pn.nspname
+		FROM pg_attribute a
+		JOIN pg_class c ON c.oid = a.attrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_type t ON t.oid = a.atttypid
+		JOIN pg_extension e ON e.extname = 'postgis' AND e.extnamespace = t.typnamespace
+		JOIN pg_namespace pn ON pn.oid = e.extnamespace
+		WHERE n.nspname = $1 AND c.relname = $2 AND a.attnum > 0 AND NOT a.attisdropped
+			AND t.typname IN ('geometry', 'geography')
+		ORDER BY a.attnum`, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spatial columns: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []spatialColumn
+	for rows.Next() {
+		var column spatialColumn
+		if err := rows.Scan(&column.Name, &column.Type, &column.postgisSchema); err != nil {
+			return nil, fmt.Errorf("failed to scan spatial column: %w", err)
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return columns, nil
+}
+
+// spatialProjection selects each spatial column again as GeoJSON and as WKT, to follow the table's projection
+func spatialProjection(columns []spatialColumn) string {
+	var projection strings.Builder
+	for _, column := range columns {
+		quoted := pq.QuoteIdentifier(column.Name)
+		functions := pq.QuoteIdentifier(column.postgisSchema)
+		fmt.Fprintf(&projection, ", %s.ST_AsGeoJSON(%s) AS %s, %s.ST_AsText(%s) AS %s",
+			functions, quoted, pq.QuoteIdentifier(spatialGeoJSONAlias+column.Name),
+			functions, quoted, pq.QuoteIdentifier(spatialWKTAlias+column.Name))
+	}
+	return projection.String()
+}
+
+// splitSpatialValues moves the rendered values selected by spatialProjection out of the rows and
+// columns into the result's Spatial values
+func splitSpatialValues(result *domain.QueryResult, columns []spatialColumn) {
+	if len(columns) == 0 {
+		return
+	}
+
+	hidden := make(map[string]bool, 2*len(columns))
+	for _, column := range columns {
+		result.SpatialColumns = append(result.SpatialColumns, column.SpatialColumn)
+		hidden[spatialGeoJSONAlias+column.Name] = true
+		hidden[spatialWKTAlias+column.Name] = true
+	}
+
+	visible := result.Columns[:0:0]
+	for _, column := range result.Columns {
+		if !hidden[column] {
+			visible = append(visible, column)
+		}
+	}
+	result.Columns = visible
+
+	result.Spatial = make([]map[string]domain.SpatialValue, len(result.Rows))
+	for i, row := range result.Rows {
+		values := make(map[string]domain.SpatialValue)
+		for _, column := range columns {
+			geoJSON, wkt := row[spatialGeoJSONAlias+column.Name], row[spatialWKTAlias+column.Name]
+			delete(row, spatialGeoJSONAlias+column.Name)
+			delete(row, spatialWKTAlias+column.Name)
+			if geoJSON == nil {
+				continue
+			}
+			values[column.Name] = domain.SpatialValue{GeoJSON: spatialText(geoJSON), WKT: spatialText(wkt)}
+		}
+		result.Spatial[i] = values
+	}
+}
+
+// spatialText reads a rendered value, which lib/pq returns as a string or as bytes
+func spatialText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
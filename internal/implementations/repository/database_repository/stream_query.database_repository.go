@@ -0,0 +1,71 @@
+package database_repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) StreamQuery(ctx context.Context, params domain.QueryParams, onColumns func(columns []string) error, onRow func(values []interface{}) error) error {
+	query := exportQuery(params)
+
+	conn, release, err := d.pinConnection(ctx, params.TrackingKey, domain.ApplicationFeatureExport, params.SearchPath, params.Settings)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return queryError(err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("failed to get columns: %w", err)
+	}
+	if err := onColumns(columns); err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(columns))
+	valuePtrs := make([]interface{}, len(columns))
+	for i := range columns {
+		valuePtrs[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		if err := onRow(values); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return queryError(err)
+	}
+
+	return nil
+}
+
+// exportQuery wraps the statement so the current filter and sort apply to any SELECT
+func exportQuery(params domain.QueryParams) string {
+	query := fmt.Sprintf("SELECT * FROM (%s) AS export_source", strings.TrimRight(strings.TrimSpace(params.Query), ";"))
+	if strings.TrimSpace(params.WhereClause) != "" {
+		query += " WHERE " + params.WhereClause
+	}
+	if params.OrderBy != "" {
+		direction := domain.SortDirectionASC
+		if strings.EqualFold(params.OrderDir, domain.SortDirectionDESC) {
+			direction = domain.SortDirectionDESC
+		}
+		query += fmt.Sprintf(" ORDER BY %s %s", pq.QuoteIdentifier(params.OrderBy), direction)
+	}
+	return query
+}
@@ -2,11 +2,14 @@ package database_repository
 
 import (
 	"context"
-	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 
-	_ "github.com/lib/pq"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
 )
 
 func (d *DatabaseRepositoryImplementation) TestConnection(ctx context.Context, connString string) error {
@@ -14,15 +17,40 @@ func (d *DatabaseRepositoryImplementation) TestConnection(ctx context.Context, c
 		return errors.New("connection string cannot be empty")
 	}
 
-	db, err := sql.Open("postgres", connString)
+	// Login probes count against the target database's connection cap
+	db, err := d.openLimited(connString)
 	if err != nil {
-		return fmt.Errorf("failed to open database connection: %w", err)
+		return err
 	}
 	defer db.Close()
 
 	if err := db.PingContext(ctx); err != nil {
-		return fmt.Errorf("failed to ping database: %w", err)
+		if errors.Is(err, domain.ErrServerBusy) {
+			return err
+		}
+		return connectionError(err)
 	}
 
 	return nil
 }
+
+// connectionError tells rejected credentials apart from a server that cannot take the connection, so a
+// login is not blamed on the password when the database is down or full
+func connectionError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		case "28":
+			return fmt.Errorf("%w: %v", domain.ErrInvalidCredentials, err)
+		case "08", "53", "57":
+			return fmt.Errorf("%w: %v", domain.ErrDatabaseUnavailable, err)
+		}
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %v", domain.ErrDatabaseUnavailable, err)
+	}
+	return fmt.Errorf("failed to ping database: %w", err)
+}
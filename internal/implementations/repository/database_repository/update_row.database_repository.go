@@ -2,9 +2,39 @@ package database_repository
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
 )
 
 func (d *DatabaseRepositoryImplementation) UpdateRow(ctx context.Context, database, schema, table string, pkValues map[string]interface{}, values map[string]interface{}) error {
-	return errors.New("not implemented")
+	if d.conn(ctx) == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+	if len(pkValues) == 0 {
+		return fmt.Errorf("primary key values are required")
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("no values to update")
+	}
+
+	var args []interface{}
+	assignments := make([]string, 0, len(values))
+	for _, column := range sortedKeys(values) {
+		assignments = append(assignments, pq.QuoteIdentifier(column)+" = "+bindValue(values[column], &args))
+	}
+	conditions := make([]string, 0, len(pkValues))
+	for _, column := range sortedKeys(pkValues) {
+		args = append(args, pkValues[column])
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", pq.QuoteIdentifier(column), len(args)))
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s",
+		qualifiedTableName(schema, table), strings.Join(assignments, ", "), strings.Join(conditions, " AND "))
+	if _, err := d.conn(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update row: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,41 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) UpdateRowsByFilter(ctx context.Context, tx *sql.Tx, update domain.BulkUpdate) (int64, error) {
+	if tx == nil {
+		return 0, fmt.Errorf("transaction is nil")
+	}
+
+	var args []interface{}
+	query := fmt.Sprintf("UPDATE %s SET %s = %s", qualifiedTableName(update.Schema, update.Table), pq.QuoteIdentifier(update.Column), bindValue(update.Value, &args))
+	if update.WhereClause != "" {
+		query += " WHERE " + update.WhereClause
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update rows: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count updated rows: %w", err)
+	}
+
+	// The filter was confirmed against a row count; refuse to apply it to a different set of rows
+	if affected != update.ExpectedCount {
+		return 0, domain.ValidationError{
+			Field:   "confirm",
+			Message: fmt.Sprintf("filter now matches %d rows instead of the confirmed %d; nothing was updated", affected, update.ExpectedCount),
+		}
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,72 @@
+package database_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (d *DatabaseRepositoryImplementation) UpsertRow(ctx context.Context, tx *sql.Tx, database, schema, table string, insert domain.RowInsert) (bool, error) {
+	if tx == nil {
+		return false, fmt.Errorf("transaction is nil")
+	}
+	if len(insert.Values) == 0 {
+		return false, fmt.Errorf("no values to insert")
+	}
+
+	onConflict, err := conflictClause(insert.OnConflict)
+	if err != nil {
+		return false, err
+	}
+
+	var args []interface{}
+	columns := sortedKeys(insert.Values)
+	placeholders := make([]string, len(columns))
+	for i, column := range columns {
+		placeholders[i] = bindValue(insert.Values[column], &args)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)%s",
+		qualifiedTableName(schema, table), quoteIdentifiers(columns), strings.Join(placeholders, ", "), onConflict)
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert row: %w", err)
+	}
+
+	written, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read inserted rows: %w", err)
+	}
+	return written > 0, nil
+}
+
+// conflictClause compiles the ON CONFLICT clause for action, empty when a conflict should fail the insert
+func conflictClause(action domain.ConflictAction) (string, error) {
+	target := ""
+	if len(action.Target) > 0 {
+		target = " (" + quoteIdentifiers(action.Target) + ")"
+	}
+
+	switch action.Mode {
+	case "", domain.ConflictModeError:
+		return "", nil
+	case domain.ConflictModeSkip:
+		return " ON CONFLICT" + target + " DO NOTHING", nil
+	case domain.ConflictModeUpdate:
+		if target == "" || len(action.UpdateColumns) == 0 {
+			return "", fmt.Errorf("conflict update needs target and update columns")
+		}
+		assignments := make([]string, len(action.UpdateColumns))
+		for i, column := range action.UpdateColumns {
+			quoted := pq.QuoteIdentifier(column)
+			assignments[i] = quoted + " = EXCLUDED." + quoted
+		}
+		return " ON CONFLICT" + target + " DO UPDATE SET " + strings.Join(assignments, ", "), nil
+	default:
+		return "", fmt.Errorf("unknown conflict mode: %s", action.Mode)
+	}
+}
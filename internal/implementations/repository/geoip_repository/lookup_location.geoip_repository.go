@@ -0,0 +1,106 @@
+package geoip_repository
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// geoNetwork is one row of the GeoIP database
+type geoNetwork struct {
+	prefix   netip.Prefix
+	location domain.GeoLocation
+}
+
+func (g *GeoIPRepositoryImplementation) LookupLocation(ctx context.Context, databasePath, ip string) (*domain.GeoLocation, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client address %q: %w", ip, err)
+	}
+	addr = addr.Unmap()
+
+	networks, err := g.load(databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *geoNetwork
+	for i := range networks {
+		if networks[i].prefix.Contains(addr) && (match == nil || networks[i].prefix.Bits() > match.prefix.Bits()) {
+			match = &networks[i]
+		}
+	}
+	if match == nil {
+		return nil, nil
+	}
+	location := match.location
+	return &location, nil
+}
+
+// load returns the networks of the database at path, reading the file only when it is not the one
+// already loaded
+func (g *GeoIPRepositoryImplementation) load(path string) ([]geoNetwork, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoIP database: %w", err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.networks != nil && g.path == path && g.modifiedAt.Equal(info.ModTime()) {
+		return g.networks, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoIP database: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 5
+	reader.TrimLeadingSpace = true
+
+	networks := make([]geoNetwork, 0)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read GeoIP database: %w", err)
+		}
+		// The header row is optional
+		if line == 1 && strings.EqualFold(record[0], "network") {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid network in GeoIP database row %d: %w", line, err)
+		}
+		networks = append(networks, geoNetwork{
+			prefix: prefix.Masked(),
+			location: domain.GeoLocation{
+				CountryCode: strings.ToUpper(record[1]),
+				Country:     record[2],
+				Region:      record[3],
+				City:        record[4],
+			},
+		})
+	}
+
+	g.path = path
+	g.modifiedAt = info.ModTime()
+	g.networks = networks
+	return networks, nil
+}
@@ -0,0 +1,20 @@
+package geoip_repository
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type GeoIPRepositoryImplementation struct {
+	// mu guards the loaded database, which is read again whenever its path or modification time changes
+	mu         sync.Mutex
+	path       string
+	modifiedAt time.Time
+	networks   []geoNetwork
+}
+
+func NewGeoIPRepository() repository.GeoIPRepository {
+	return &GeoIPRepositoryImplementation{}
+}
@@ -0,0 +1,11 @@
+package geoip_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestGeoIPRepository(t *testing.T) {
+	testRunner.GeoIPRepositoryRunner(t, NewGeoIPRepository)
+}
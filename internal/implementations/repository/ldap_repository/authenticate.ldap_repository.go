@@ -0,0 +1,56 @@
+package ldap_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (l *LDAPAuthenticatorImplementation) Authenticate(ctx context.Context, username, password string) (*domain.LDAPEntry, error) {
+	if username == "" || password == "" {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	conn, err := dialLDAP(ctx, l.config, l.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.close()
+
+	if l.config.BindDN != "" {
+		if err := conn.simpleBind(l.config.BindDN, l.config.BindPassword); err != nil {
+			return nil, fmt.Errorf("failed to bind LDAP search account: %w", err)
+		}
+	}
+
+	// Ask for every attribute a role mapping may test, plus the login attribute itself
+	attributes := []string{l.config.UserAttribute}
+	for _, mapping := range l.config.RoleMappings {
+		attributes = append(attributes, mapping.Claim)
+	}
+
+	entries, err := conn.searchEquality(l.config.BaseDN, l.config.UserAttribute, username, attributes)
+	if err != nil {
+		var resultErr *ldapResultError
+		// sizeLimitExceeded means the login name is ambiguous
+		if errors.As(err, &resultErr) && resultErr.Code == 4 {
+			return nil, domain.ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to search LDAP directory: %w", err)
+	}
+	if len(entries) != 1 {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	if err := conn.simpleBind(entries[0].dn, password); err != nil {
+		var resultErr *ldapResultError
+		if errors.As(err, &resultErr) && resultErr.Code == ldapResultInvalidCredentials {
+			return nil, domain.ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to bind as LDAP user: %w", err)
+	}
+
+	return &domain.LDAPEntry{DN: entries[0].dn, Attributes: entries[0].attributes}, nil
+}
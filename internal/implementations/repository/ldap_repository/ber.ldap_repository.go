@@ -0,0 +1,180 @@
+package ldap_repository
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// BER tag classes and the tags of the LDAP protocol elements in use
+const (
+	berSequence    = 0x30
+	berSet         = 0x31
+	berInteger     = 0x02
+	berOctetString = 0x04
+	berBoolean     = 0x01
+	berEnumerated  = 0x0a
+
+	ldapBindRequest      = 0x60
+	ldapBindResponse     = 0x61
+	ldapUnbindRequest    = 0x42
+	ldapSearchRequest    = 0x63
+	ldapSearchResultItem = 0x64
+	ldapSearchResultDone = 0x65
+	ldapExtendedRequest  = 0x77
+	ldapExtendedResponse = 0x78
+	ldapExtendedName     = 0x80
+	ldapSimpleAuth       = 0x80
+	ldapFilterEquality   = 0xa3
+)
+
+// maxBERLength bounds a single element so a misbehaving server cannot make us allocate without limit
+const maxBERLength = 16 << 20
+
+// berElement is a decoded tag-length-value; Children is filled for constructed elements
+type berElement struct {
+	Tag      byte
+	Value    []byte
+	Children []berElement
+}
+
+func berEncode(tag byte, value []byte) []byte {
+	out := []byte{tag}
+	switch n := len(value); {
+	case n < 0x80:
+		out = append(out, byte(n))
+	case n <= 0xff:
+		out = append(out, 0x81, byte(n))
+	case n <= 0xffff:
+		out = append(out, 0x82, byte(n>>8), byte(n))
+	default:
+		out = append(out, 0x84, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(out, value...)
+}
+
+func berConstructed(tag byte, children ...[]byte) []byte {
+	var value []byte
+	for _, child := range children {
+		value = append(value, child...)
+	}
+	return berEncode(tag, value)
+}
+
+func berInt(tag byte, n int) []byte {
+	// Minimal two's complement encoding of a non-negative integer
+	value := []byte{byte(n)}
+	for n >>= 8; n > 0; n >>= 8 {
+		value = append([]byte{byte(n)}, value...)
+	}
+	if value[0]&0x80 != 0 {
+		value = append([]byte{0}, value...)
+	}
+	return berEncode(tag, value)
+}
+
+func berString(s string) []byte {
+	return berEncode(berOctetString, []byte(s))
+}
+
+func berBool(b bool) []byte {
+	if b {
+		return berEncode(berBoolean, []byte{0xff})
+	}
+	return berEncode(berBoolean, []byte{0})
+}
+
+// readBER reads one complete element from r
+func readBER(r *bufio.Reader) (berElement, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return berElement{}, err
+	}
+	length, err := readBERLength(r)
+	if err != nil {
+		return berElement{}, err
+	}
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return berElement{}, err
+	}
+	return parseBERValue(tag, value)
+}
+
+func readBERLength(r io.ByteReader) (int, error) {
+	first, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if first < 0x80 {
+		return int(first), nil
+	}
+	octets := int(first & 0x7f)
+	if octets == 0 || octets > 4 {
+		return 0, fmt.Errorf("unsupported BER length encoding")
+	}
+	length := 0
+	for i := 0; i < octets; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		length = length<<8 | int(b)
+	}
+	if length > maxBERLength {
+		return 0, fmt.Errorf("BER element of %d bytes is too large", length)
+	}
+	return length, nil
+}
+
+// parseBERValue decodes the children of constructed elements
+func parseBERValue(tag byte, value []byte) (berElement, error) {
+	element := berElement{Tag: tag, Value: value}
+	if tag&0x20 == 0 {
+		return element, nil
+	}
+
+	reader := &byteReader{data: value}
+	for reader.pos < len(value) {
+		childTag, err := reader.ReadByte()
+		if err != nil {
+			return berElement{}, err
+		}
+		length, err := readBERLength(reader)
+		if err != nil {
+			return berElement{}, err
+		}
+		if reader.pos+length > len(value) {
+			return berElement{}, fmt.Errorf("truncated BER element")
+		}
+		child, err := parseBERValue(childTag, value[reader.pos:reader.pos+length])
+		if err != nil {
+			return berElement{}, err
+		}
+		element.Children = append(element.Children, child)
+		reader.pos += length
+	}
+	return element, nil
+}
+
+func (e berElement) int() int {
+	n := 0
+	for _, b := range e.Value {
+		n = n<<8 | int(b)
+	}
+	return n
+}
+
+type byteReader struct {
+	data []byte
+	pos  int
+}
+
+func (b *byteReader) ReadByte() (byte, error) {
+	if b.pos >= len(b.data) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	c := b.data[b.pos]
+	b.pos++
+	return c, nil
+}
@@ -0,0 +1,266 @@
+package ldap_repository
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// ldapResultInvalidCredentials is the LDAP resultCode for a failed simple bind
+const ldapResultInvalidCredentials = 49
+
+// ldapStartTLSOID names the StartTLS extended operation (RFC 4511 section 4.14)
+const ldapStartTLSOID = "1.3.6.1.4.1.1466.20037"
+
+// ldapConn is one LDAPv3 connection issuing requests sequentially
+type ldapConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	nextID int
+}
+
+// ldapResultError is a non-success LDAP result
+type ldapResultError struct {
+	Code    int
+	Message string
+}
+
+func (e *ldapResultError) Error() string {
+	return fmt.Sprintf("LDAP result %d: %s", e.Code, e.Message)
+}
+
+type ldapEntry struct {
+	dn         string
+	attributes map[string][]string
+}
+
+// dialLDAP connects to the directory at config.URL; an ldap:// connection is upgraded with StartTLS
+// unless config.AllowPlaintext, so no bind ever sends a password in clear text by default
+func dialLDAP(ctx context.Context, config domain.LDAPConfig, timeout time.Duration) (*ldapConn, error) {
+	parsed, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP URL: %w", err)
+	}
+	tlsConfig, err := ldapTLSConfig(config.CACertFile, parsed.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	switch parsed.Scheme {
+	case "ldap":
+		host := parsed.Host
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), "389")
+		}
+		conn, err = dialer.DialContext(ctx, "tcp", host)
+	case "ldaps":
+		host := parsed.Host
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), "636")
+		}
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: tlsConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", host)
+	default:
+		return nil, fmt.Errorf("unsupported LDAP URL scheme %q", parsed.Scheme)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+
+	c := &ldapConn{conn: conn, reader: bufio.NewReader(conn), nextID: 1}
+	if parsed.Scheme == "ldap" && !config.AllowPlaintext {
+		if err := c.startTLS(ctx, tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// ldapTLSConfig verifies the directory's certificate for serverName against the CAs in caCertFile, or
+// the system roots when it is empty
+func ldapTLSConfig(caCertFile, serverName string) (*tls.Config, error) {
+	config := &tls.Config{ServerName: serverName}
+	if caCertFile == "" {
+		return config, nil
+	}
+
+	pem, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read LDAP CA certificates: %w", err)
+	}
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in LDAP CA file %s", caCertFile)
+	}
+	return config, nil
+}
+
+// startTLS upgrades the connection with the StartTLS extended operation; a server refusing it fails
+// the login rather than falling back to clear text
+func (c *ldapConn) startTLS(ctx context.Context, config *tls.Config) error {
+	id, err := c.send(berConstructed(ldapExtendedRequest, berEncode(ldapExtendedName, []byte(ldapStartTLSOID))))
+	if err != nil {
+		return fmt.Errorf("failed to send LDAP StartTLS: %w", err)
+	}
+
+	response, err := c.receive(id)
+	if err != nil {
+		return err
+	}
+	if response.Tag != ldapExtendedResponse {
+		return fmt.Errorf("unexpected LDAP response to StartTLS")
+	}
+	if err := ldapResult(response); err != nil {
+		return fmt.Errorf("LDAP server refused StartTLS: %w", err)
+	}
+
+	tlsConn := tls.Client(c.conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return fmt.Errorf("LDAP StartTLS handshake failed: %w", err)
+	}
+	c.conn = tlsConn
+	c.reader = bufio.NewReader(tlsConn)
+	return nil
+}
+
+func (c *ldapConn) send(op []byte) (int, error) {
+	id := c.nextID
+	c.nextID++
+	_, err := c.conn.Write(berConstructed(berSequence, berInt(berInteger, id), op))
+	return id, err
+}
+
+// receive reads the next message for id and returns its protocol operation
+func (c *ldapConn) receive(id int) (berElement, error) {
+	for {
+		message, err := readBER(c.reader)
+		if err != nil {
+			return berElement{}, fmt.Errorf("failed to read LDAP response: %w", err)
+		}
+		if message.Tag != berSequence || len(message.Children) < 2 {
+			return berElement{}, fmt.Errorf("malformed LDAP message")
+		}
+		// Unsolicited notifications carry message ID 0 and are not answers to our request
+		if message.Children[0].int() != id {
+			continue
+		}
+		return message.Children[1], nil
+	}
+}
+
+// simpleBind binds as dn; an empty password is refused because servers treat it as an anonymous bind
+func (c *ldapConn) simpleBind(dn, password string) error {
+	if password == "" && dn != "" {
+		return &ldapResultError{Code: ldapResultInvalidCredentials, Message: "empty password"}
+	}
+
+	id, err := c.send(berConstructed(ldapBindRequest,
+		berInt(berInteger, 3),
+		berString(dn),
+		berEncode(ldapSimpleAuth, []byte(password)),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to send LDAP bind: %w", err)
+	}
+
+	response, err := c.receive(id)
+	if err != nil {
+		return err
+	}
+	if response.Tag != ldapBindResponse {
+		return fmt.Errorf("unexpected LDAP response to bind")
+	}
+	return ldapResult(response)
+}
+
+// searchEquality finds the entries under base whose attribute equals value, returning the listed attributes
+func (c *ldapConn) searchEquality(base, attribute, value string, attributes []string) ([]ldapEntry, error) {
+	var requested [][]byte
+	for _, name := range attributes {
+		requested = append(requested, berString(name))
+	}
+
+	id, err := c.send(berConstructed(ldapSearchRequest,
+		berString(base),
+		berInt(berEnumerated, 2), // wholeSubtree
+		berInt(berEnumerated, 0), // neverDerefAliases
+		berInt(berInteger, 2),    // sizeLimit: two is enough to detect an ambiguous login
+		berInt(berInteger, 10),   // timeLimit in seconds
+		berBool(false),
+		berConstructed(ldapFilterEquality, berString(attribute), berString(value)),
+		berConstructed(berSequence, requested...),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send LDAP search: %w", err)
+	}
+
+	var entries []ldapEntry
+	for {
+		response, err := c.receive(id)
+		if err != nil {
+			return nil, err
+		}
+		switch response.Tag {
+		case ldapSearchResultItem:
+			entries = append(entries, parseEntry(response))
+		case ldapSearchResultDone:
+			if err := ldapResult(response); err != nil {
+				return nil, err
+			}
+			return entries, nil
+		}
+		// Search result references are skipped; referrals are not followed
+	}
+}
+
+func (c *ldapConn) close() {
+	c.send(berEncode(ldapUnbindRequest, nil))
+	c.conn.Close()
+}
+
+func ldapResult(response berElement) error {
+	if len(response.Children) < 3 {
+		return fmt.Errorf("malformed LDAP result")
+	}
+	code := response.Children[0].int()
+	if code != 0 {
+		return &ldapResultError{Code: code, Message: string(response.Children[2].Value)}
+	}
+	return nil
+}
+
+func parseEntry(response berElement) ldapEntry {
+	entry := ldapEntry{attributes: make(map[string][]string)}
+	if len(response.Children) < 2 {
+		return entry
+	}
+	entry.dn = string(response.Children[0].Value)
+	for _, attribute := range response.Children[1].Children {
+		if len(attribute.Children) < 2 {
+			continue
+		}
+		name := strings.ToLower(string(attribute.Children[0].Value))
+		for _, value := range attribute.Children[1].Children {
+			entry.attributes[name] = append(entry.attributes[name], string(value.Value))
+		}
+	}
+	return entry
+}
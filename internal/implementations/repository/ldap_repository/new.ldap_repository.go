@@ -0,0 +1,27 @@
+package ldap_repository
+
+import (
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// LDAPAuthenticatorImplementation authenticates logins against an LDAP directory with a minimal LDAPv3
+// client: each login opens a connection, finds the user's entry with the configured search account and
+// then binds as that entry with the supplied password.
+type LDAPAuthenticatorImplementation struct {
+	config  domain.LDAPConfig
+	timeout time.Duration
+}
+
+func NewLDAPAuthenticator(config domain.LDAPConfig) repository.LDAPAuthenticator {
+	if config.UserAttribute == "" {
+		config.UserAttribute = domain.DefaultLDAPUserAttribute
+	}
+
+	return &LDAPAuthenticatorImplementation{
+		config:  config,
+		timeout: 10 * time.Second,
+	}
+}
@@ -0,0 +1,11 @@
+package ldap_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestLDAPAuthenticator(t *testing.T) {
+	testRunner.LDAPAuthenticatorRunner(t, NewLDAPAuthenticator)
+}
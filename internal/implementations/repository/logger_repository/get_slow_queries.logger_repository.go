@@ -0,0 +1,23 @@
+package logger_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (l *LoggerRepositoryImplementation) GetSlowQueries(ctx context.Context, limit int) ([]domain.SlowQuery, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if limit <= 0 || limit > len(l.slowQueries) {
+		limit = len(l.slowQueries)
+	}
+
+	// Queries are appended in order, so walk backwards for newest first
+	result := make([]domain.SlowQuery, 0, limit)
+	for i := len(l.slowQueries) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, l.slowQueries[i])
+	}
+	return result, nil
+}
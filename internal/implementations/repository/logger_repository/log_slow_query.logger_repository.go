@@ -0,0 +1,32 @@
+package logger_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (l *LoggerRepositoryImplementation) LogSlowQuery(ctx context.Context, query *domain.SlowQuery) error {
+	if query == nil {
+		return errors.New("slow query cannot be nil")
+	}
+	if query.ID == "" {
+		query.ID = "slowq_" + uuid.New().String()
+	}
+	if query.RecordedAt.IsZero() {
+		query.RecordedAt = time.Now()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.slowQueries = append(l.slowQueries, *query)
+	if excess := len(l.slowQueries) - domain.SlowQueryLogSize; excess > 0 {
+		l.slowQueries = append([]domain.SlowQuery(nil), l.slowQueries[excess:]...)
+	}
+	return nil
+}
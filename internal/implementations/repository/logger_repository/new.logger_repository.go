@@ -1,13 +1,20 @@
 package logger_repository
 
 import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 )
 
 type LoggerRepositoryImplementation struct {
 	// logger implementation details will be added here
+	mu          sync.RWMutex
+	slowQueries []domain.SlowQuery
 }
 
 func NewLoggerRepository() repository.LoggerRepository {
-	return &LoggerRepositoryImplementation{}
+	return &LoggerRepositoryImplementation{
+		slowQueries: make([]domain.SlowQuery, 0),
+	}
 }
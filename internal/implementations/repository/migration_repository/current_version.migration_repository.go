@@ -0,0 +1,36 @@
+package migration_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *MigrationRepositoryImplementation) CurrentVersion(ctx context.Context) (int, error) {
+	return currentVersion(ctx, m.db)
+}
+
+// versionReader is the part of *sql.DB and *sql.Conn needed to read the applied version
+type versionReader interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// currentVersion reads the newest applied migration; a database never migrated has no version table
+func currentVersion(ctx context.Context, reader versionReader) (int, error) {
+	var table sql.NullString
+	if err := reader.QueryRowContext(ctx, "SELECT to_regclass($1)::text", domain.AppSchema+".schema_migrations").Scan(&table); err != nil {
+		return 0, fmt.Errorf("failed to look up schema version table: %w", err)
+	}
+	if !table.Valid {
+		return 0, nil
+	}
+
+	var version int
+	query := "SELECT COALESCE(MAX(version), 0) FROM " + domain.AppSchema + ".schema_migrations"
+	if err := reader.QueryRowContext(ctx, query).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,8 @@
+package migration_repository
+
+func (m *MigrationRepositoryImplementation) LatestVersion() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].version
+}
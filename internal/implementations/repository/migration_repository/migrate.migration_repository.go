@@ -0,0 +1,70 @@
+package migration_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *MigrationRepositoryImplementation) Migrate(ctx context.Context) ([]int, error) {
+	if m.loadErr != nil {
+		return nil, m.loadErr
+	}
+
+	// Hold one connection for the advisory lock, so instances booting together wait for each other
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect for migrations: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", domain.AppSchemaLockKey); err != nil {
+		return nil, fmt.Errorf("failed to lock schema for migrations: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", domain.AppSchemaLockKey)
+
+	setup := `CREATE SCHEMA IF NOT EXISTS ` + domain.AppSchema + `;
+		CREATE TABLE IF NOT EXISTS ` + domain.AppSchema + `.schema_migrations (
+			version    integer PRIMARY KEY,
+			name       text NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)`
+	if _, err := conn.ExecContext(ctx, setup); err != nil {
+		return nil, fmt.Errorf("failed to create schema %s: %w", domain.AppSchema, err)
+	}
+
+	current, err := currentVersion(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	if current > m.LatestVersion() {
+		return nil, fmt.Errorf("%w: database is at version %d, this build knows up to %d", domain.ErrAppSchemaTooNew, current, m.LatestVersion())
+	}
+
+	applied := make([]int, 0)
+	for _, pending := range m.migrations {
+		if pending.version <= current {
+			continue
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return applied, fmt.Errorf("failed to begin migration %d: %w", pending.version, err)
+		}
+		if _, err := tx.ExecContext(ctx, pending.sql); err != nil {
+			tx.Rollback()
+			return applied, fmt.Errorf("migration %d (%s) failed: %w", pending.version, pending.name, err)
+		}
+		record := "INSERT INTO " + domain.AppSchema + ".schema_migrations (version, name) VALUES ($1, $2)"
+		if _, err := tx.ExecContext(ctx, record, pending.version, pending.name); err != nil {
+			tx.Rollback()
+			return applied, fmt.Errorf("failed to record migration %d: %w", pending.version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return applied, fmt.Errorf("failed to commit migration %d: %w", pending.version, err)
+		}
+		applied = append(applied, pending.version)
+	}
+	return applied, nil
+}
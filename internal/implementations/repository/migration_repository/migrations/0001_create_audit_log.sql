@@ -0,0 +1,15 @@
+CREATE TABLE lumen_pg.audit_log (
+    id            text PRIMARY KEY,
+    username      text NOT NULL,
+    action        text NOT NULL,
+    database_name text NOT NULL DEFAULT '',
+    schema_name   text NOT NULL DEFAULT '',
+    table_name    text NOT NULL DEFAULT '',
+    reason        text NOT NULL DEFAULT '',
+    affected_rows jsonb NOT NULL DEFAULT '[]',
+    approved_by   text NOT NULL DEFAULT '',
+    created_at    timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_log_username_idx ON lumen_pg.audit_log (username, created_at DESC);
+CREATE INDEX audit_log_table_idx ON lumen_pg.audit_log (database_name, schema_name, table_name, created_at DESC);
@@ -0,0 +1,7 @@
+CREATE TABLE lumen_pg.user_preferences (
+    username   text NOT NULL,
+    key        text NOT NULL,
+    value      jsonb NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (username, key)
+);
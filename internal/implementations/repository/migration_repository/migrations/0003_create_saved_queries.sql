@@ -0,0 +1,12 @@
+CREATE TABLE lumen_pg.saved_queries (
+    id         text PRIMARY KEY,
+    username   text NOT NULL,
+    name       text NOT NULL,
+    query      text NOT NULL,
+    tags       text[] NOT NULL DEFAULT '{}',
+    variables  jsonb NOT NULL DEFAULT '[]',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX saved_queries_username_idx ON lumen_pg.saved_queries (username, name);
@@ -0,0 +1,15 @@
+CREATE TABLE lumen_pg.jobs (
+    id          text PRIMARY KEY,
+    kind        text NOT NULL,
+    username    text NOT NULL,
+    status      text NOT NULL DEFAULT 'pending',
+    payload     jsonb NOT NULL DEFAULT '{}',
+    result      jsonb,
+    error       text NOT NULL DEFAULT '',
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    started_at  timestamptz,
+    finished_at timestamptz
+);
+
+CREATE INDEX jobs_pending_idx ON lumen_pg.jobs (created_at) WHERE status = 'pending';
+CREATE INDEX jobs_username_idx ON lumen_pg.jobs (username, created_at DESC);
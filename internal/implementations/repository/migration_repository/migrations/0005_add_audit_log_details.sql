@@ -0,0 +1,18 @@
+ALTER TABLE lumen_pg.audit_log
+    ADD COLUMN target        text NOT NULL DEFAULT '',
+    ADD COLUMN before_values jsonb,
+    ADD COLUMN after_values  jsonb;
+
+CREATE INDEX audit_log_created_at_idx ON lumen_pg.audit_log (created_at DESC);
+
+-- The audit log is append-only: entries are never rewritten or removed through lumen-pg
+CREATE FUNCTION lumen_pg.audit_log_append_only() RETURNS trigger
+    LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'audit log entries cannot be modified';
+END;
+$$;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON lumen_pg.audit_log
+    FOR EACH ROW EXECUTE FUNCTION lumen_pg.audit_log_append_only();
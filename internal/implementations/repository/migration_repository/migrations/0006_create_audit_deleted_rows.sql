@@ -0,0 +1,12 @@
+-- Rows deleted by commits, kept for a limited window so the delete can be undone. Unlike the audit
+-- log itself these expire and are removed.
+CREATE TABLE lumen_pg.audit_deleted_rows (
+    entry_id      text PRIMARY KEY REFERENCES lumen_pg.audit_log (id),
+    database_name text        NOT NULL DEFAULT '',
+    schema_name   text        NOT NULL DEFAULT '',
+    table_name    text        NOT NULL DEFAULT '',
+    deleted_rows  jsonb       NOT NULL,
+    expires_at    timestamptz NOT NULL
+);
+
+CREATE INDEX audit_deleted_rows_expires_at_idx ON lumen_pg.audit_deleted_rows (expires_at);
@@ -0,0 +1,10 @@
+CREATE TABLE lumen_pg.query_notebooks (
+    id         text PRIMARY KEY,
+    username   text NOT NULL,
+    title      text NOT NULL,
+    cells      jsonb NOT NULL DEFAULT '[]',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX query_notebooks_username_idx ON lumen_pg.query_notebooks (username, title);
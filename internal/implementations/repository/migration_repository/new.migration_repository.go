@@ -0,0 +1,25 @@
+package migration_repository
+
+import (
+	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// MigrationRepositoryImplementation applies the SQL migrations embedded in the binary, so a build
+// always carries the schema it expects
+type MigrationRepositoryImplementation struct {
+	db         *sql.DB
+	migrations []migration
+	// loadErr reports malformed embedded migrations; Migrate returns it rather than touching the schema
+	loadErr error
+}
+
+func NewMigrationRepository(db *sql.DB) repository.MigrationRepository {
+	migrations, err := loadMigrations()
+	return &MigrationRepositoryImplementation{
+		db:         db,
+		migrations: migrations,
+		loadErr:    err,
+	}
+}
@@ -0,0 +1,11 @@
+package migration_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestMigrationRepository(t *testing.T) {
+	testRunner.MigrationRepositoryRunner(t, NewMigrationRepository)
+}
@@ -0,0 +1,50 @@
+package monitoring_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *MonitoringRepositoryImplementation) GetBackendActivity(ctx context.Context) ([]domain.BackendActivity, error) {
+	if m.db == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// Durations are measured by the server so they do not depend on the clocks agreeing
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT pid, COALESCE(datname, ''), COALESCE(usename, ''), COALESCE(application_name, ''),
+			COALESCE(host(client_addr), ''), COALESCE(state, ''), COALESCE(query, ''), query_start,
+			COALESCE(EXTRACT(EPOCH FROM clock_timestamp() - query_start), 0),
+			COALESCE(wait_event_type, ''), COALESCE(wait_event, '')
+		FROM pg_stat_activity
+		WHERE backend_type = 'client backend' AND pid <> pg_backend_pid()
+		ORDER BY query_start NULLS LAST, pid`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backend activity: %w", err)
+	}
+	defer rows.Close()
+
+	var backends []domain.BackendActivity
+	for rows.Next() {
+		var backend domain.BackendActivity
+		var queryStart sql.NullTime
+		var seconds float64
+		if err := rows.Scan(&backend.PID, &backend.Database, &backend.Username, &backend.ApplicationName,
+			&backend.ClientAddr, &backend.State, &backend.Query, &queryStart, &seconds,
+			&backend.WaitEventType, &backend.WaitEvent); err != nil {
+			return nil, fmt.Errorf("failed to scan backend activity: %w", err)
+		}
+		backend.QueryStart = queryStart.Time
+		backend.Duration = time.Duration(seconds * float64(time.Second))
+		backends = append(backends, backend)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return backends, nil
+}
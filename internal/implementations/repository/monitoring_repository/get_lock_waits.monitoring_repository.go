@@ -0,0 +1,75 @@
+package monitoring_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *MonitoringRepositoryImplementation) GetLockWaits(ctx context.Context) ([]domain.LockWait, error) {
+	if m.db == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	// pg_blocking_pids resolves who holds or is queued ahead for the lock; the ungranted pg_locks row
+	// says what the blocked backend asked for
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT blocked.pid, COALESCE(blocked.datname, ''), COALESCE(blocked.usename, ''),
+			COALESCE(blocked.application_name, ''), COALESCE(host(blocked.client_addr), ''),
+			COALESCE(blocked.state, ''), COALESCE(blocked.query, ''), blocked.query_start,
+			COALESCE(EXTRACT(EPOCH FROM clock_timestamp() - blocked.query_start), 0),
+			COALESCE(blocked.wait_event_type, ''), COALESCE(blocked.wait_event, ''),
+			blocking.pid, COALESCE(blocking.datname, ''), COALESCE(blocking.usename, ''),
+			COALESCE(blocking.application_name, ''), COALESCE(host(blocking.client_addr), ''),
+			COALESCE(blocking.state, ''), COALESCE(blocking.query, ''), blocking.query_start,
+			COALESCE(EXTRACT(EPOCH FROM clock_timestamp() - blocking.query_start), 0),
+			COALESCE(blocking.wait_event_type, ''), COALESCE(blocking.wait_event, ''),
+			COALESCE(waiting.locktype, ''), COALESCE(waiting.mode, ''),
+			COALESCE(waiting.relation::regclass::text, '')
+		FROM pg_stat_activity blocked
+		CROSS JOIN LATERAL unnest(pg_blocking_pids(blocked.pid)) AS holder(pid)
+		JOIN pg_stat_activity blocking ON blocking.pid = holder.pid
+		LEFT JOIN LATERAL (
+			SELECT locktype, mode, relation
+			FROM pg_locks
+			WHERE pid = blocked.pid AND NOT granted
+			LIMIT 1
+		) waiting ON true
+		WHERE blocked.backend_type = 'client backend'
+		ORDER BY blocked.query_start NULLS LAST, blocked.pid, blocking.pid`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lock waits: %w", err)
+	}
+	defer rows.Close()
+
+	var waits []domain.LockWait
+	for rows.Next() {
+		var wait domain.LockWait
+		var blockedStart, blockingStart sql.NullTime
+		var blockedSeconds, blockingSeconds float64
+		if err := rows.Scan(
+			&wait.Blocked.PID, &wait.Blocked.Database, &wait.Blocked.Username, &wait.Blocked.ApplicationName,
+			&wait.Blocked.ClientAddr, &wait.Blocked.State, &wait.Blocked.Query, &blockedStart, &blockedSeconds,
+			&wait.Blocked.WaitEventType, &wait.Blocked.WaitEvent,
+			&wait.Blocking.PID, &wait.Blocking.Database, &wait.Blocking.Username, &wait.Blocking.ApplicationName,
+			&wait.Blocking.ClientAddr, &wait.Blocking.State, &wait.Blocking.Query, &blockingStart, &blockingSeconds,
+			&wait.Blocking.WaitEventType, &wait.Blocking.WaitEvent,
+			&wait.LockType, &wait.Mode, &wait.Relation,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan lock wait: %w", err)
+		}
+		wait.Blocked.QueryStart = blockedStart.Time
+		wait.Blocked.Duration = time.Duration(blockedSeconds * float64(time.Second))
+		wait.Blocking.QueryStart = blockingStart.Time
+		wait.Blocking.Duration = time.Duration(blockingSeconds * float64(time.Second))
+		waits = append(waits, wait)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return waits, nil
+}
@@ -0,0 +1,64 @@
+package monitoring_repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (m *MonitoringRepositoryImplementation) GetServerStats(ctx context.Context) (*domain.ServerStats, error) {
+	if m.db == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	stats := &domain.ServerStats{}
+	err := m.db.QueryRowContext(ctx, `SELECT clock_timestamp(), current_setting('max_connections')::int`).
+		Scan(&stats.TakenAt, &stats.MaxConnections)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read server settings: %w", err)
+	}
+
+	// pg_database_size needs CONNECT on the database, so sizes the reader may not see are left out
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT d.datname, d.numbackends, d.xact_commit, d.xact_rollback, d.blks_read, d.blks_hit, d.deadlocks,
+			CASE WHEN has_database_privilege(d.datid, 'CONNECT') THEN pg_database_size(d.datid) END
+		FROM pg_stat_database d
+		JOIN pg_database db ON db.oid = d.datid
+		WHERE NOT db.datistemplate
+		ORDER BY d.datname`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database statistics: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var database domain.DatabaseStats
+		var size sql.NullInt64
+		if err := rows.Scan(&database.Name, &database.Connections, &database.XactCommit, &database.XactRollback,
+			&database.BlocksRead, &database.BlocksHit, &database.Deadlocks, &size); err != nil {
+			return nil, fmt.Errorf("failed to scan database statistics: %w", err)
+		}
+		if size.Valid {
+			database.SizeBytes = &size.Int64
+		}
+		stats.Databases = append(stats.Databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	// The checkpoint counters moved to pg_stat_checkpointer in PostgreSQL 17, so only the columns every
+	// supported version keeps are read
+	var statsReset sql.NullTime
+	err = m.db.QueryRowContext(ctx, `SELECT buffers_clean, maxwritten_clean, buffers_alloc, stats_reset FROM pg_stat_bgwriter`).
+		Scan(&stats.BackgroundWriter.BuffersClean, &stats.BackgroundWriter.MaxWrittenClean,
+			&stats.BackgroundWriter.BuffersAlloc, &statsReset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get background writer statistics: %w", err)
+	}
+	stats.BackgroundWriter.StatsReset = statsReset.Time
+
+	return stats, nil
+}
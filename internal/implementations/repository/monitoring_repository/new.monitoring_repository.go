@@ -0,0 +1,17 @@
+package monitoring_repository
+
+import (
+	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type MonitoringRepositoryImplementation struct {
+	db *sql.DB
+}
+
+func NewMonitoringRepository(db *sql.DB) repository.MonitoringRepository {
+	return &MonitoringRepositoryImplementation{
+		db: db,
+	}
+}
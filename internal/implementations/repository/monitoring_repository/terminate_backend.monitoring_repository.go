@@ -0,0 +1,19 @@
+package monitoring_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (m *MonitoringRepositoryImplementation) TerminateBackend(ctx context.Context, pid int) (bool, error) {
+	if m.db == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	// pg_terminate_backend reports false, with a warning, when no backend has the PID
+	var terminated bool
+	if err := m.db.QueryRowContext(ctx, "SELECT pg_terminate_backend($1)", pid).Scan(&terminated); err != nil {
+		return false, fmt.Errorf("failed to terminate backend %d: %w", pid, err)
+	}
+	return terminated, nil
+}
@@ -0,0 +1,11 @@
+package monitoring_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestMonitoringRepository(t *testing.T) {
+	testRunner.MonitoringRepositoryRunner(t, NewMonitoringRepository)
+}
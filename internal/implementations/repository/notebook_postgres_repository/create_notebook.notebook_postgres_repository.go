@@ -0,0 +1,45 @@
+package notebook_postgres_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookPostgresRepositoryImplementation) CreateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	if notebook == nil {
+		return errors.New("notebook cannot be nil")
+	}
+
+	// timestamptz keeps microseconds, so the returned notebook matches what is read back
+	now := time.Now().Truncate(time.Microsecond)
+	notebook.ID = "notebook_" + uuid.New().String()
+	notebook.CreatedAt = now
+	notebook.UpdatedAt = now
+
+	cells, err := json.Marshal(nonNilCells(notebook.Cells))
+	if err != nil {
+		return fmt.Errorf("failed to encode notebook cells: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		"INSERT INTO "+notebooksTable+" (id, username, title, cells, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		notebook.ID, notebook.Username, notebook.Title, cells, notebook.CreatedAt, notebook.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store notebook: %w", err)
+	}
+	return nil
+}
+
+// nonNilCells encodes a notebook without cells as an empty JSON array rather than null
+func nonNilCells(cells []domain.NotebookCell) []domain.NotebookCell {
+	if cells == nil {
+		return []domain.NotebookCell{}
+	}
+	return cells
+}
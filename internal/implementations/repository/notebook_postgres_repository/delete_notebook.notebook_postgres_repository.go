@@ -0,0 +1,24 @@
+package notebook_postgres_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookPostgresRepositoryImplementation) DeleteNotebook(ctx context.Context, username, id string) error {
+	result, err := s.db.ExecContext(ctx, "DELETE FROM "+notebooksTable+" WHERE username = $1 AND id = $2", username, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete notebook: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete notebook: %w", err)
+	}
+	if deleted == 0 {
+		return domain.ErrNotebookNotFound
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package notebook_postgres_repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// notebookColumns are the columns scanNotebook reads, in order
+const notebookColumns = "id, username, title, cells, created_at, updated_at"
+
+func (s *NotebookPostgresRepositoryImplementation) GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	row := s.db.QueryRowContext(ctx,
+		"SELECT "+notebookColumns+" FROM "+notebooksTable+" WHERE username = $1 AND id = $2", username, id)
+
+	notebook, err := scanNotebook(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotebookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notebook: %w", err)
+	}
+	return notebook, nil
+}
+
+// rowScanner is the part of *sql.Row and *sql.Rows that scanNotebook needs
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanNotebook reads one notebook; a notebook without cells comes back with nil cells, as it was saved
+func scanNotebook(row rowScanner) (*domain.QueryNotebook, error) {
+	var notebook domain.QueryNotebook
+	var cells []byte
+	if err := row.Scan(&notebook.ID, &notebook.Username, &notebook.Title, &cells, &notebook.CreatedAt, &notebook.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(cells, &notebook.Cells); err != nil {
+		return nil, fmt.Errorf("failed to decode notebook cells: %w", err)
+	}
+	if len(notebook.Cells) == 0 {
+		notebook.Cells = nil
+	}
+	return &notebook, nil
+}
@@ -0,0 +1,30 @@
+package notebook_postgres_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookPostgresRepositoryImplementation) ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+notebookColumns+" FROM "+notebooksTable+" WHERE username = $1 ORDER BY title, id", username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notebooks: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.QueryNotebook, 0)
+	for rows.Next() {
+		notebook, err := scanNotebook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read notebook: %w", err)
+		}
+		result = append(result, *notebook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list notebooks: %w", err)
+	}
+	return result, nil
+}
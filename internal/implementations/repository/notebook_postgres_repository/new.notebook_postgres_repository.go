@@ -0,0 +1,23 @@
+package notebook_postgres_repository
+
+import (
+	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// notebooksTable is created by the app schema migrations
+const notebooksTable = domain.AppSchema + ".query_notebooks"
+
+// NotebookPostgresRepositoryImplementation keeps query notebooks in lumen-pg's own schema, so every
+// instance pointed at the database shares them
+type NotebookPostgresRepositoryImplementation struct {
+	db *sql.DB
+}
+
+func NewNotebookPostgresRepository(db *sql.DB) repository.NotebookRepository {
+	return &NotebookPostgresRepositoryImplementation{
+		db: db,
+	}
+}
@@ -0,0 +1,12 @@
+package notebook_postgres_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/migration_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestNotebookPostgresRepository(t *testing.T) {
+	testRunner.NotebookPostgresRepositoryRunner(t, migration_repository.NewMigrationRepository, NewNotebookPostgresRepository)
+}
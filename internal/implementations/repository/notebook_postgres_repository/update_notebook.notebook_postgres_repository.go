@@ -0,0 +1,38 @@
+package notebook_postgres_repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookPostgresRepositoryImplementation) UpdateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	if notebook == nil {
+		return errors.New("notebook cannot be nil")
+	}
+
+	cells, err := json.Marshal(nonNilCells(notebook.Cells))
+	if err != nil {
+		return fmt.Errorf("failed to encode notebook cells: %w", err)
+	}
+
+	updatedAt := time.Now().Truncate(time.Microsecond)
+	err = s.db.QueryRowContext(ctx,
+		"UPDATE "+notebooksTable+" SET title = $1, cells = $2, updated_at = $3 WHERE username = $4 AND id = $5 RETURNING created_at",
+		notebook.Title, cells, updatedAt, notebook.Username, notebook.ID).
+		Scan(&notebook.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotebookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update notebook: %w", err)
+	}
+
+	notebook.UpdatedAt = updatedAt
+	return nil
+}
@@ -0,0 +1,30 @@
+package notebook_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookRepositoryImplementation) CreateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	if notebook == nil {
+		return errors.New("notebook cannot be nil")
+	}
+
+	now := time.Now()
+	notebook.ID = "notebook_" + uuid.New().String()
+	notebook.CreatedAt = now
+	notebook.UpdatedAt = now
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.notebooks[notebook.Username] == nil {
+		s.notebooks[notebook.Username] = make(map[string]domain.QueryNotebook)
+	}
+	s.notebooks[notebook.Username][notebook.ID] = copyNotebook(*notebook)
+	return nil
+}
@@ -0,0 +1,19 @@
+package notebook_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookRepositoryImplementation) DeleteNotebook(ctx context.Context, username, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.notebooks[username][id]; !ok {
+		return domain.ErrNotebookNotFound
+	}
+
+	delete(s.notebooks[username], id)
+	return nil
+}
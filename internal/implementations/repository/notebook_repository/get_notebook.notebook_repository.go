@@ -0,0 +1,20 @@
+package notebook_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookRepositoryImplementation) GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	notebook, ok := s.notebooks[username][id]
+	if !ok {
+		return nil, domain.ErrNotebookNotFound
+	}
+
+	result := copyNotebook(notebook)
+	return &result, nil
+}
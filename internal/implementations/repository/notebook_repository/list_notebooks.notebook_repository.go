@@ -0,0 +1,27 @@
+package notebook_repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookRepositoryImplementation) ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]domain.QueryNotebook, 0, len(s.notebooks[username]))
+	for _, notebook := range s.notebooks[username] {
+		result = append(result, copyNotebook(notebook))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Title != result[j].Title {
+			return result[i].Title < result[j].Title
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
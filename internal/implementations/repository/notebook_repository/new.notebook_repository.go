@@ -0,0 +1,27 @@
+package notebook_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// NotebookRepositoryImplementation keeps query notebooks per PostgreSQL username in memory
+type NotebookRepositoryImplementation struct {
+	mu        sync.RWMutex
+	notebooks map[string]map[string]domain.QueryNotebook
+}
+
+func NewNotebookRepository() repository.NotebookRepository {
+	return &NotebookRepositoryImplementation{
+		notebooks: make(map[string]map[string]domain.QueryNotebook),
+	}
+}
+
+// copyNotebook detaches the cells so stored notebooks cannot be mutated by callers; saved results are
+// replaced whole on update, never changed in place
+func copyNotebook(notebook domain.QueryNotebook) domain.QueryNotebook {
+	notebook.Cells = append([]domain.NotebookCell(nil), notebook.Cells...)
+	return notebook
+}
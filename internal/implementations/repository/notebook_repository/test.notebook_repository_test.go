@@ -0,0 +1,11 @@
+package notebook_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestNotebookRepository(t *testing.T) {
+	testRunner.NotebookRepositoryRunner(t, NewNotebookRepository)
+}
@@ -0,0 +1,28 @@
+package notebook_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *NotebookRepositoryImplementation) UpdateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	if notebook == nil {
+		return errors.New("notebook cannot be nil")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.notebooks[notebook.Username][notebook.ID]
+	if !ok {
+		return domain.ErrNotebookNotFound
+	}
+
+	notebook.CreatedAt = existing.CreatedAt
+	notebook.UpdatedAt = time.Now()
+	s.notebooks[notebook.Username][notebook.ID] = copyNotebook(*notebook)
+	return nil
+}
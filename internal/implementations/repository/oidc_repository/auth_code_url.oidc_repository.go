@@ -0,0 +1,29 @@
+package oidc_repository
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+func (o *OIDCRepositoryImplementation) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	discovery, err := o.provider(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := append([]string{"openid"}, o.config.Scopes...)
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", o.config.ClientID)
+	params.Set("redirect_uri", o.config.RedirectURL)
+	params.Set("scope", strings.Join(scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + params.Encode(), nil
+}
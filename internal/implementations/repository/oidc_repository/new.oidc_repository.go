@@ -0,0 +1,36 @@
+package oidc_repository
+
+import (
+	"crypto/rsa"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// OIDCRepositoryImplementation performs the authorization code flow against an OpenID Connect provider.
+// The provider's discovery document and signing keys are fetched on first use and cached; the keys are
+// refetched when a token names one that is not cached, so provider key rotation needs no restart.
+type OIDCRepositoryImplementation struct {
+	config domain.SSOConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]*rsa.PublicKey
+}
+
+// NewOIDCRepository creates the repository; a nil client uses one with a 10 second timeout
+func NewOIDCRepository(config domain.SSOConfig, client *http.Client) repository.OIDCRepository {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &OIDCRepositoryImplementation{
+		config: config,
+		client: client,
+		keys:   make(map[string]*rsa.PublicKey),
+	}
+}
@@ -0,0 +1,11 @@
+package oidc_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestOIDCRepository(t *testing.T) {
+	testRunner.OIDCRepositoryRunner(t, NewOIDCRepository)
+}
@@ -0,0 +1,13 @@
+package preference_postgres_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (p *PreferencePostgresRepositoryImplementation) DeletePreference(ctx context.Context, username, key string) error {
+	if _, err := p.db.ExecContext(ctx, "DELETE FROM "+preferencesTable+" WHERE username = $1 AND key = $2", username, key); err != nil {
+		return fmt.Errorf("failed to delete preference: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package preference_postgres_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (p *PreferencePostgresRepositoryImplementation) GetPreferences(ctx context.Context, username string) (map[string]string, error) {
+	rows, err := p.db.QueryContext(ctx, "SELECT key, value #>> '{}' FROM "+preferencesTable+" WHERE username = $1", username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]string)
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("failed to read preference: %w", err)
+		}
+		result[key] = value
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package preference_postgres_repository
+
+import (
+	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// preferencesTable is created by the app schema migrations
+const preferencesTable = domain.AppSchema + ".user_preferences"
+
+// PreferencePostgresRepositoryImplementation keeps preferences in lumen-pg's own schema, shared by
+// every instance pointed at the database
+type PreferencePostgresRepositoryImplementation struct {
+	db *sql.DB
+}
+
+func NewPreferencePostgresRepository(db *sql.DB) repository.PreferenceRepository {
+	return &PreferencePostgresRepositoryImplementation{
+		db: db,
+	}
+}
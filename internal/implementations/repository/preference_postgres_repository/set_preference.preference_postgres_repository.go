@@ -0,0 +1,23 @@
+package preference_postgres_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+func (p *PreferencePostgresRepositoryImplementation) SetPreference(ctx context.Context, username, key, value string) error {
+	if key == "" {
+		return errors.New("preference key cannot be empty")
+	}
+
+	// Values are stored as JSON strings so the column can hold structured values later
+	_, err := p.db.ExecContext(ctx,
+		"INSERT INTO "+preferencesTable+" (username, key, value, updated_at) VALUES ($1, $2, to_jsonb($3::text), now()) "+
+			"ON CONFLICT (username, key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at",
+		username, key, value)
+	if err != nil {
+		return fmt.Errorf("failed to store preference: %w", err)
+	}
+	return nil
+}
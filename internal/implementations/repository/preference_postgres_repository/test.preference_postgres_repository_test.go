@@ -0,0 +1,12 @@
+package preference_postgres_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/migration_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestPreferencePostgresRepository(t *testing.T) {
+	testRunner.PreferencePostgresRepositoryRunner(t, migration_repository.NewMigrationRepository, NewPreferencePostgresRepository)
+}
@@ -0,0 +1,11 @@
+package preference_repository
+
+import "context"
+
+func (p *PreferenceRepositoryImplementation) DeletePreference(ctx context.Context, username, key string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.preferences[username], key)
+	return nil
+}
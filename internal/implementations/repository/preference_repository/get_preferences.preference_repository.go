@@ -0,0 +1,14 @@
+package preference_repository
+
+import "context"
+
+func (p *PreferenceRepositoryImplementation) GetPreferences(ctx context.Context, username string) (map[string]string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	result := make(map[string]string, len(p.preferences[username]))
+	for key, value := range p.preferences[username] {
+		result[key] = value
+	}
+	return result, nil
+}
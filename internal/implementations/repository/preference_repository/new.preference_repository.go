@@ -0,0 +1,19 @@
+package preference_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// PreferenceRepositoryImplementation keeps preferences in memory, per PostgreSQL username
+type PreferenceRepositoryImplementation struct {
+	mu          sync.RWMutex
+	preferences map[string]map[string]string
+}
+
+func NewPreferenceRepository() repository.PreferenceRepository {
+	return &PreferenceRepositoryImplementation{
+		preferences: make(map[string]map[string]string),
+	}
+}
@@ -0,0 +1,21 @@
+package preference_repository
+
+import (
+	"context"
+	"errors"
+)
+
+func (p *PreferenceRepositoryImplementation) SetPreference(ctx context.Context, username, key, value string) error {
+	if key == "" {
+		return errors.New("preference key cannot be empty")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.preferences[username] == nil {
+		p.preferences[username] = make(map[string]string)
+	}
+	p.preferences[username][key] = value
+	return nil
+}
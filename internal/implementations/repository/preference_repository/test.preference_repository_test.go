@@ -0,0 +1,11 @@
+package preference_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestPreferenceRepository(t *testing.T) {
+	testRunner.PreferenceRepositoryRunner(t, NewPreferenceRepository)
+}
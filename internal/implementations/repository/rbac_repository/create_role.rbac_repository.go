@@ -0,0 +1,31 @@
+package rbac_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (r *RBACRepositoryImplementation) CreateRole(ctx context.Context, role domain.NewRole) error {
+	if r.conn(ctx) == nil {
+		return fmt.Errorf("database connection is not established")
+	}
+
+	// CREATE ROLE takes no parameters, so the name and password are quoted into the statement
+	statement := fmt.Sprintf("CREATE ROLE %s WITH LOGIN PASSWORD %s", pq.QuoteIdentifier(role.Name), pq.QuoteLiteral(role.Password))
+	if role.CreateDB {
+		statement += " CREATEDB"
+	}
+
+	if _, err := r.conn(ctx).ExecContext(ctx, statement); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "42710" {
+			return domain.ErrRoleExists
+		}
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+	return nil
+}
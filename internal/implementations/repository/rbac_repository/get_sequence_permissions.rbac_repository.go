@@ -0,0 +1,32 @@
+package rbac_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *RBACRepositoryImplementation) GetSequencePermissions(ctx context.Context, role, database, schema, sequence string) (*domain.SequencePermissions, error) {
+	if r.conn(ctx) == nil {
+		return nil, fmt.Errorf("database connection is not established")
+	}
+
+	var permissions domain.SequencePermissions
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT has_sequence_privilege($1, c.oid, 'SELECT'), has_sequence_privilege($1, c.oid, 'USAGE'),
+			has_sequence_privilege($1, c.oid, 'UPDATE'), pg_has_role($1, c.relowner, 'MEMBER')
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $2 AND c.relname = $3 AND c.relkind = 'S'`, role, schema, sequence).
+		Scan(&permissions.CanSelect, &permissions.CanUsage, &permissions.CanUpdate, &permissions.IsOwner)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domain.SequencePermissions{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check sequence permissions: %w", err)
+	}
+	return &permissions, nil
+}
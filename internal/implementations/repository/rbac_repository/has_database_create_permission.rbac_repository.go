@@ -0,0 +1,25 @@
+package rbac_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *RBACRepositoryImplementation) HasDatabaseCreatePermission(ctx context.Context, role, database string) (bool, error) {
+	if r.conn(ctx) == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	// A database that does not exist grants nothing, rather than failing has_database_privilege
+	var allowed bool
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT COALESCE((
+			SELECT has_database_privilege($1, d.oid, 'CREATE')
+			FROM pg_database d
+			WHERE d.datname = $2
+		), false)`, role, database).Scan(&allowed)
+	if err != nil {
+		return false, fmt.Errorf("failed to check database CREATE permission: %w", err)
+	}
+	return allowed, nil
+}
@@ -0,0 +1,29 @@
+package rbac_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+func (r *RBACRepositoryImplementation) HasFunctionExecutePermission(ctx context.Context, role, database, schema, name, arguments string) (bool, error) {
+	if r.conn(ctx) == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	var allowed bool
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT has_function_privilege($1, p.oid, 'EXECUTE')
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		WHERE n.nspname = $2 AND p.proname = $3 AND pg_get_function_identity_arguments(p.oid) = $4`,
+		role, schema, name, arguments).Scan(&allowed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check function privilege: %w", err)
+	}
+	return allowed, nil
+}
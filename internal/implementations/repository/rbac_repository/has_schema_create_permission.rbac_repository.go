@@ -0,0 +1,25 @@
+package rbac_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *RBACRepositoryImplementation) HasSchemaCreatePermission(ctx context.Context, role, database, schema string) (bool, error) {
+	if r.conn(ctx) == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	// A schema that does not exist grants nothing, rather than failing has_schema_privilege
+	var allowed bool
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT COALESCE((
+			SELECT has_schema_privilege($1, n.oid, 'CREATE')
+			FROM pg_namespace n
+			WHERE n.nspname = $2
+		), false)`, role, schema).Scan(&allowed)
+	if err != nil {
+		return false, fmt.Errorf("failed to check schema CREATE permission: %w", err)
+	}
+	return allowed, nil
+}
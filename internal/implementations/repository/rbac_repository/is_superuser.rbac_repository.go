@@ -0,0 +1,24 @@
+package rbac_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+func (r *RBACRepositoryImplementation) IsSuperuser(ctx context.Context, role string) (bool, error) {
+	if r.conn(ctx) == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	var superuser bool
+	err := r.conn(ctx).QueryRowContext(ctx, `SELECT rolsuper FROM pg_roles WHERE rolname = $1`, role).Scan(&superuser)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check superuser: %w", err)
+	}
+	return superuser, nil
+}
@@ -0,0 +1,29 @@
+package rbac_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+func (r *RBACRepositoryImplementation) IsTableOwner(ctx context.Context, role, database, schema, table string) (bool, error) {
+	if r.conn(ctx) == nil {
+		return false, fmt.Errorf("database connection is not established")
+	}
+
+	// Superusers count as members of every role, so they own every table here as well
+	var owner bool
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT pg_has_role($1, c.relowner, 'MEMBER')
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $2 AND c.relname = $3 AND c.relkind IN ('r', 'm', 'p')`, role, schema, table).Scan(&owner)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check table owner: %w", err)
+	}
+	return owner, nil
+}
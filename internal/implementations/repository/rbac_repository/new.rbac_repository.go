@@ -1,8 +1,10 @@
 package rbac_repository
 
 import (
+	"context"
 	"database/sql"
 
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 )
 
@@ -15,3 +17,9 @@ func NewRBACRepository(db *sql.DB) repository.RBACRepository {
 		db: db,
 	}
 }
+
+// conn is the handle privilege checks for ctx run on: the session's pool on its profile's server when
+// the request is bound to one, otherwise the shared connection
+func (r *RBACRepositoryImplementation) conn(ctx context.Context) *sql.DB {
+	return domain.SessionConnection(ctx, r.db)
+}
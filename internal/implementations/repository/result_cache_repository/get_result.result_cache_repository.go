@@ -0,0 +1,26 @@
+package result_cache_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *ResultCacheRepositoryImplementation) GetResult(ctx context.Context, id string) (*domain.CachedResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, results := range c.results {
+		for i := range results {
+			if results[i].ID == id {
+				if time.Since(results[i].CachedAt) >= domain.ResultCacheTTL {
+					return nil, domain.ErrResultNotFound
+				}
+				result := results[i]
+				return &result, nil
+			}
+		}
+	}
+	return nil, domain.ErrResultNotFound
+}
@@ -0,0 +1,20 @@
+package result_cache_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type ResultCacheRepositoryImplementation struct {
+	mu sync.Mutex
+	// results holds each user's kept results, oldest first
+	results map[string][]domain.CachedResult
+}
+
+func NewResultCacheRepository() repository.ResultCacheRepository {
+	return &ResultCacheRepositoryImplementation{
+		results: make(map[string][]domain.CachedResult),
+	}
+}
@@ -0,0 +1,40 @@
+package result_cache_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *ResultCacheRepositoryImplementation) StoreResult(ctx context.Context, result *domain.CachedResult) error {
+	if result == nil {
+		return errors.New("cached result cannot be nil")
+	}
+
+	if result.ID == "" {
+		result.ID = "result_" + uuid.New().String()
+	}
+	if result.CachedAt.IsZero() {
+		result.CachedAt = time.Now()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Expired results are only dropped here, when their user runs another query
+	kept := make([]domain.CachedResult, 0, domain.ResultCachePerUser)
+	for _, existing := range c.results[result.Username] {
+		if time.Since(existing.CachedAt) < domain.ResultCacheTTL {
+			kept = append(kept, existing)
+		}
+	}
+	kept = append(kept, *result)
+	if excess := len(kept) - domain.ResultCachePerUser; excess > 0 {
+		kept = kept[excess:]
+	}
+	c.results[result.Username] = kept
+	return nil
+}
@@ -0,0 +1,11 @@
+package result_cache_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestResultCacheRepository(t *testing.T) {
+	testRunner.ResultCacheRepositoryRunner(t, NewResultCacheRepository)
+}
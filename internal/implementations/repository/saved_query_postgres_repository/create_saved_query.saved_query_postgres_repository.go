@@ -0,0 +1,54 @@
+package saved_query_postgres_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (s *SavedQueryPostgresRepositoryImplementation) CreateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	if query == nil {
+		return errors.New("saved query cannot be nil")
+	}
+
+	// timestamptz keeps microseconds, so the returned query matches what is read back
+	now := time.Now().Truncate(time.Microsecond)
+	query.ID = "query_" + uuid.New().String()
+	query.CreatedAt = now
+	query.UpdatedAt = now
+
+	variables, err := json.Marshal(nonNilVariables(query.Variables))
+	if err != nil {
+		return fmt.Errorf("failed to encode template variables: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		"INSERT INTO "+savedQueriesTable+" (id, username, name, query, tags, variables, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		query.ID, query.Username, query.Name, query.Query, pq.Array(nonNilTags(query.Tags)), variables, query.CreatedAt, query.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store saved query: %w", err)
+	}
+	return nil
+}
+
+// nonNilTags keeps the NOT NULL tags column an empty array for untagged queries
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// nonNilVariables encodes a query without variables as an empty JSON array rather than null
+func nonNilVariables(variables []domain.TemplateVariable) []domain.TemplateVariable {
+	if variables == nil {
+		return []domain.TemplateVariable{}
+	}
+	return variables
+}
@@ -0,0 +1,55 @@
+package saved_query_postgres_repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// savedQueryColumns are the columns scanSavedQuery reads, in order
+const savedQueryColumns = "id, username, name, query, tags, variables, created_at, updated_at"
+
+func (s *SavedQueryPostgresRepositoryImplementation) GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error) {
+	row := s.db.QueryRowContext(ctx,
+		"SELECT "+savedQueryColumns+" FROM "+savedQueriesTable+" WHERE username = $1 AND id = $2", username, id)
+
+	query, err := scanSavedQuery(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrSavedQueryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved query: %w", err)
+	}
+	return query, nil
+}
+
+// rowScanner is the part of *sql.Row and *sql.Rows that scanSavedQuery needs
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSavedQuery reads one saved query; empty tags and variables come back nil, as they were saved
+func scanSavedQuery(row rowScanner) (*domain.SavedQuery, error) {
+	var query domain.SavedQuery
+	var tags []string
+	var variables []byte
+	if err := row.Scan(&query.ID, &query.Username, &query.Name, &query.Query, pq.Array(&tags), &variables, &query.CreatedAt, &query.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		query.Tags = tags
+	}
+	if err := json.Unmarshal(variables, &query.Variables); err != nil {
+		return nil, fmt.Errorf("failed to decode template variables: %w", err)
+	}
+	if len(query.Variables) == 0 {
+		query.Variables = nil
+	}
+	return &query, nil
+}
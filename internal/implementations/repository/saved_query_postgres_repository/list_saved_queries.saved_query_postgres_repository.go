@@ -0,0 +1,30 @@
+package saved_query_postgres_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SavedQueryPostgresRepositoryImplementation) ListSavedQueries(ctx context.Context, username string) ([]domain.SavedQuery, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+savedQueryColumns+" FROM "+savedQueriesTable+" WHERE username = $1 ORDER BY name, id", username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved queries: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domain.SavedQuery, 0)
+	for rows.Next() {
+		query, err := scanSavedQuery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read saved query: %w", err)
+		}
+		result = append(result, *query)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list saved queries: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package saved_query_postgres_repository
+
+import (
+	"database/sql"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// savedQueriesTable is created by the app schema migrations
+const savedQueriesTable = domain.AppSchema + ".saved_queries"
+
+// SavedQueryPostgresRepositoryImplementation keeps saved queries in lumen-pg's own schema, so every
+// instance pointed at the database shares them
+type SavedQueryPostgresRepositoryImplementation struct {
+	db *sql.DB
+}
+
+func NewSavedQueryPostgresRepository(db *sql.DB) repository.SavedQueryRepository {
+	return &SavedQueryPostgresRepositoryImplementation{
+		db: db,
+	}
+}
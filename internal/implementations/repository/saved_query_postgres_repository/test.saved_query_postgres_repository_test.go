@@ -0,0 +1,12 @@
+package saved_query_postgres_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/migration_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestSavedQueryPostgresRepository(t *testing.T) {
+	testRunner.SavedQueryPostgresRepositoryRunner(t, migration_repository.NewMigrationRepository, NewSavedQueryPostgresRepository)
+}
@@ -0,0 +1,39 @@
+package saved_query_postgres_repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (s *SavedQueryPostgresRepositoryImplementation) UpdateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	if query == nil {
+		return errors.New("saved query cannot be nil")
+	}
+
+	variables, err := json.Marshal(nonNilVariables(query.Variables))
+	if err != nil {
+		return fmt.Errorf("failed to encode template variables: %w", err)
+	}
+
+	updatedAt := time.Now().Truncate(time.Microsecond)
+	err = s.db.QueryRowContext(ctx,
+		"UPDATE "+savedQueriesTable+" SET name = $1, query = $2, tags = $3, variables = $4, updated_at = $5 WHERE username = $6 AND id = $7 RETURNING created_at",
+		query.Name, query.Query, pq.Array(nonNilTags(query.Tags)), variables, updatedAt, query.Username, query.ID).
+		Scan(&query.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrSavedQueryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update saved query: %w", err)
+	}
+
+	query.UpdatedAt = updatedAt
+	return nil
+}
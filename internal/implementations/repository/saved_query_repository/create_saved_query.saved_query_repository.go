@@ -0,0 +1,30 @@
+package saved_query_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SavedQueryRepositoryImplementation) CreateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	if query == nil {
+		return errors.New("saved query cannot be nil")
+	}
+
+	now := time.Now()
+	query.ID = "query_" + uuid.New().String()
+	query.CreatedAt = now
+	query.UpdatedAt = now
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.queries[query.Username] == nil {
+		s.queries[query.Username] = make(map[string]domain.SavedQuery)
+	}
+	s.queries[query.Username][query.ID] = copySavedQuery(*query)
+	return nil
+}
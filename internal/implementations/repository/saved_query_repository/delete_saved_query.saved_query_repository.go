@@ -0,0 +1,19 @@
+package saved_query_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SavedQueryRepositoryImplementation) DeleteSavedQuery(ctx context.Context, username, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queries[username][id]; !ok {
+		return domain.ErrSavedQueryNotFound
+	}
+
+	delete(s.queries[username], id)
+	return nil
+}
@@ -0,0 +1,20 @@
+package saved_query_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SavedQueryRepositoryImplementation) GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	query, ok := s.queries[username][id]
+	if !ok {
+		return nil, domain.ErrSavedQueryNotFound
+	}
+
+	result := copySavedQuery(query)
+	return &result, nil
+}
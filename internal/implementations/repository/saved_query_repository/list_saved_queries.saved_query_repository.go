@@ -0,0 +1,27 @@
+package saved_query_repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SavedQueryRepositoryImplementation) ListSavedQueries(ctx context.Context, username string) ([]domain.SavedQuery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]domain.SavedQuery, 0, len(s.queries[username]))
+	for _, query := range s.queries[username] {
+		result = append(result, copySavedQuery(query))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
@@ -0,0 +1,28 @@
+package saved_query_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SavedQueryRepositoryImplementation keeps saved queries per PostgreSQL username rather than per
+// session, so a user's library is still there after logging out and back in
+type SavedQueryRepositoryImplementation struct {
+	mu      sync.RWMutex
+	queries map[string]map[string]domain.SavedQuery
+}
+
+func NewSavedQueryRepository() repository.SavedQueryRepository {
+	return &SavedQueryRepositoryImplementation{
+		queries: make(map[string]map[string]domain.SavedQuery),
+	}
+}
+
+// copySavedQuery detaches the tags and variables slices so stored queries cannot be mutated by callers
+func copySavedQuery(query domain.SavedQuery) domain.SavedQuery {
+	query.Tags = append([]string(nil), query.Tags...)
+	query.Variables = append([]domain.TemplateVariable(nil), query.Variables...)
+	return query
+}
@@ -0,0 +1,11 @@
+package saved_query_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestSavedQueryRepository(t *testing.T) {
+	testRunner.SavedQueryRepositoryRunner(t, NewSavedQueryRepository)
+}
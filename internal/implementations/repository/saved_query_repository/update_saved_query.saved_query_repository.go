@@ -0,0 +1,28 @@
+package saved_query_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SavedQueryRepositoryImplementation) UpdateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	if query == nil {
+		return errors.New("saved query cannot be nil")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.queries[query.Username][query.ID]
+	if !ok {
+		return domain.ErrSavedQueryNotFound
+	}
+
+	query.CreatedAt = existing.CreatedAt
+	query.UpdatedAt = time.Now()
+	s.queries[query.Username][query.ID] = copySavedQuery(*query)
+	return nil
+}
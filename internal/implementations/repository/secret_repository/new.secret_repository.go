@@ -0,0 +1,12 @@
+package secret_repository
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type SecretRepositoryImplementation struct {
+}
+
+func NewSecretRepository() repository.SecretRepository {
+	return &SecretRepositoryImplementation{}
+}
@@ -0,0 +1,35 @@
+package secret_repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SecretRepositoryImplementation) ResolveSecret(ctx context.Context, reference string) (string, error) {
+	provider, name, ok := strings.Cut(reference, ":")
+	if !ok || name == "" {
+		return "", fmt.Errorf("invalid secret reference: %s", reference)
+	}
+
+	switch provider {
+	case domain.SecretProviderEnv:
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return value, nil
+	case domain.SecretProviderFile:
+		contents, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret file: %w", err)
+		}
+		// Secret files usually end in a newline that is not part of the secret
+		return strings.TrimRight(string(contents), "\r\n"), nil
+	default:
+		return "", fmt.Errorf("unknown secret provider: %s", provider)
+	}
+}
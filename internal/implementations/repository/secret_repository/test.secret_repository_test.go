@@ -0,0 +1,11 @@
+package secret_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestSecretRepository(t *testing.T) {
+	testRunner.SecretRepositoryRunner(t, NewSecretRepository)
+}
@@ -0,0 +1,71 @@
+package session_redis_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) CreateSession(ctx context.Context, session *domain.Session) error {
+	if session == nil || session.ID == "" {
+		return errors.New("session ID cannot be empty")
+	}
+
+	// A reused ID replaces the previous session, which may belong to another user
+	previous, err := s.loadSession(ctx, session.ID)
+	if err != nil && !errors.Is(err, domain.ErrSessionNotFound) && !errors.Is(err, domain.ErrSessionExpired) {
+		return err
+	}
+	if previous != nil && previous.Username != session.Username {
+		if _, err := s.client.do(ctx, "ZREM", s.userSessionsKey(previous.Username), session.ID); err != nil {
+			return fmt.Errorf("failed to unlink previous session: %w", err)
+		}
+	}
+
+	return s.storeSession(ctx, session)
+}
+
+// storeSession writes the session with a TTL ending at its expiry and links it to its user; an already
+// expired session is removed rather than stored
+func (s *SessionRedisRepositoryImplementation) storeSession(ctx context.Context, session *domain.Session) error {
+	ttl := time.Until(session.ExpiresAt).Milliseconds()
+	if ttl <= 0 {
+		if _, err := s.client.do(ctx, "DEL", s.sessionKey(session.ID)); err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	if _, err := s.client.do(ctx, "SET", s.sessionKey(session.ID), string(data), "PX", strconv.FormatInt(ttl, 10)); err != nil {
+		return fmt.Errorf("failed to store session: %w", err)
+	}
+
+	userKey := s.userSessionsKey(session.Username)
+	score := strconv.FormatInt(session.CreatedAt.UnixNano(), 10)
+	if _, err := s.client.do(ctx, "ZADD", userKey, score, session.ID); err != nil {
+		return fmt.Errorf("failed to index session: %w", err)
+	}
+
+	// Keep the user's index at least as long as its longest-lived session
+	reply, err := s.client.do(ctx, "PTTL", userKey)
+	if err != nil {
+		return fmt.Errorf("failed to read session index TTL: %w", err)
+	}
+	if remaining, _ := reply.(int64); remaining < ttl {
+		if _, err := s.client.do(ctx, "PEXPIRE", userKey, strconv.FormatInt(ttl, 10)); err != nil {
+			return fmt.Errorf("failed to set session index TTL: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package session_redis_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *SessionRedisRepositoryImplementation) DeleteSession(ctx context.Context, sessionID string) error {
+	session, _ := s.loadSession(ctx, sessionID)
+
+	if _, err := s.client.do(ctx, "DEL", s.sessionKey(sessionID)); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	if session != nil {
+		if _, err := s.client.do(ctx, "ZREM", s.userSessionsKey(session.Username), sessionID); err != nil {
+			return fmt.Errorf("failed to unlink session: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package session_redis_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) GetSession(ctx context.Context, sessionID string) (*domain.Session, error) {
+	session, err := s.loadSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// loadSession reads a stored session; an expired one that Redis has not evicted yet is returned along
+// with ErrSessionExpired
+func (s *SessionRedisRepositoryImplementation) loadSession(ctx context.Context, sessionID string) (*domain.Session, error) {
+	reply, err := s.client.do(ctx, "GET", s.sessionKey(sessionID))
+	if errors.Is(err, errRedisNil) {
+		return nil, domain.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	data, _ := reply.(string)
+	var session domain.Session
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+
+	if !session.ExpiresAt.After(time.Now()) {
+		return &session, domain.ErrSessionExpired
+	}
+	return &session, nil
+}
+
+func (s *SessionRedisRepositoryImplementation) sessionKey(sessionID string) string {
+	return s.keyPrefix + "session:" + sessionID
+}
+
+func (s *SessionRedisRepositoryImplementation) userSessionsKey(username string) string {
+	return s.keyPrefix + "user_sessions:" + username
+}
@@ -0,0 +1,34 @@
+package session_redis_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) GetSessionByUsername(ctx context.Context, username string) (*domain.Session, error) {
+	userKey := s.userSessionsKey(username)
+
+	reply, err := s.client.do(ctx, "ZREVRANGE", userKey, "0", "-1")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	// Newest first; IDs whose session has expired are dropped from the index on the way
+	for _, sessionID := range replyStrings(reply) {
+		session, err := s.loadSession(ctx, sessionID)
+		if err == nil {
+			return session, nil
+		}
+		if !errors.Is(err, domain.ErrSessionNotFound) && !errors.Is(err, domain.ErrSessionExpired) {
+			return nil, err
+		}
+		if _, err := s.client.do(ctx, "ZREM", userKey, sessionID); err != nil {
+			return nil, fmt.Errorf("failed to unlink session: %w", err)
+		}
+	}
+
+	return nil, domain.ErrSessionNotFound
+}
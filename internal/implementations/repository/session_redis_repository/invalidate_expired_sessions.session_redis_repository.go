@@ -0,0 +1,62 @@
+package session_redis_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// InvalidateExpiredSessions sweeps the user indexes. Redis evicts expired session keys by itself, so
+// this drops the IDs left behind and any session whose expiry passed before its key was evicted.
+func (s *SessionRedisRepositoryImplementation) InvalidateExpiredSessions(ctx context.Context) error {
+	cursor := "0"
+	for {
+		reply, err := s.client.do(ctx, "SCAN", cursor, "MATCH", s.userSessionsKey("*"), "COUNT", "100")
+		if err != nil {
+			return fmt.Errorf("failed to scan session indexes: %w", err)
+		}
+		page, _ := reply.([]interface{})
+		if len(page) != 2 {
+			return errors.New("unexpected redis SCAN reply")
+		}
+		cursor, _ = page[0].(string)
+
+		for _, userKey := range replyStrings(page[1]) {
+			if err := s.sweepUserSessions(ctx, userKey); err != nil {
+				return err
+			}
+		}
+
+		if cursor == "0" {
+			return nil
+		}
+	}
+}
+
+func (s *SessionRedisRepositoryImplementation) sweepUserSessions(ctx context.Context, userKey string) error {
+	reply, err := s.client.do(ctx, "ZRANGE", userKey, "0", "-1")
+	if err != nil {
+		return fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	for _, sessionID := range replyStrings(reply) {
+		_, err := s.loadSession(ctx, sessionID)
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, domain.ErrSessionExpired):
+			if _, err := s.client.do(ctx, "DEL", s.sessionKey(sessionID)); err != nil {
+				return fmt.Errorf("failed to delete session: %w", err)
+			}
+		case !errors.Is(err, domain.ErrSessionNotFound):
+			return err
+		}
+		if _, err := s.client.do(ctx, "ZREM", userKey, sessionID); err != nil {
+			return fmt.Errorf("failed to unlink session: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package session_redis_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *SessionRedisRepositoryImplementation) InvalidateUserSessions(ctx context.Context, username string) error {
+	userKey := s.userSessionsKey(username)
+
+	reply, err := s.client.do(ctx, "ZRANGE", userKey, "0", "-1")
+	if err != nil {
+		return fmt.Errorf("failed to list user sessions: %w", err)
+	}
+
+	keys := []string{"DEL", userKey}
+	for _, sessionID := range replyStrings(reply) {
+		keys = append(keys, s.sessionKey(sessionID))
+	}
+	if _, err := s.client.do(ctx, keys...); err != nil {
+		return fmt.Errorf("failed to delete user sessions: %w", err)
+	}
+
+	return nil
+}
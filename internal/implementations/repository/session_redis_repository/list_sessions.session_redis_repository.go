@@ -0,0 +1,57 @@
+package session_redis_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) ListSessions(ctx context.Context) ([]*domain.Session, error) {
+	var sessions []*domain.Session
+	prefix := s.sessionKey("")
+
+	cursor := "0"
+	for {
+		reply, err := s.client.do(ctx, "SCAN", cursor, "MATCH", s.sessionKey("*"), "COUNT", "100")
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sessions: %w", err)
+		}
+		page, _ := reply.([]interface{})
+		if len(page) != 2 {
+			return nil, errors.New("unexpected redis SCAN reply")
+		}
+		cursor, _ = page[0].(string)
+
+		for _, key := range replyStrings(page[1]) {
+			session, err := s.loadSession(ctx, strings.TrimPrefix(key, prefix))
+			if errors.Is(err, domain.ErrSessionNotFound) || errors.Is(err, domain.ErrSessionExpired) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			sessions = append(sessions, session)
+		}
+
+		if cursor == "0" {
+			break
+		}
+	}
+
+	// SCAN may return a key more than once; keep the first copy of each session
+	seen := make(map[string]bool)
+	unique := sessions[:0]
+	for _, session := range sessions {
+		if !seen[session.ID] {
+			seen[session.ID] = true
+			unique = append(unique, session)
+		}
+	}
+
+	sort.Slice(unique, func(i, j int) bool { return unique[i].CreatedAt.Before(unique[j].CreatedAt) })
+	return unique, nil
+}
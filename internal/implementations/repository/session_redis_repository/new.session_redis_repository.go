@@ -0,0 +1,26 @@
+package session_redis_repository
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SessionRedisRepositoryImplementation keeps sessions in Redis so they survive restarts and are shared
+// between instances. Each session is a JSON value under <prefix>session:<id> expiring with the session,
+// and <prefix>user_sessions:<username> is a sorted set of the user's session IDs scored by creation time.
+type SessionRedisRepositoryImplementation struct {
+	client    *redisClient
+	keyPrefix string
+}
+
+func NewSessionRedisRepository(config domain.SessionStoreConfig) repository.SessionRepository {
+	prefix := config.KeyPrefix
+	if prefix == "" {
+		prefix = domain.DefaultSessionKeyPrefix
+	}
+
+	return &SessionRedisRepositoryImplementation{
+		client:    newRedisClient(config.RedisAddr, config.RedisPassword, config.RedisDB),
+		keyPrefix: prefix,
+	}
+}
@@ -0,0 +1,213 @@
+package session_redis_repository
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// redisDialTimeout bounds connecting to Redis when the context has no deadline
+const redisDialTimeout = 5 * time.Second
+
+// redisPoolSize caps the connections a client opens to Redis; requests beyond it wait for one to free up
+const redisPoolSize = 8
+
+// errRedisNil is returned for a RESP null reply, such as GET on a missing key
+var errRedisNil = errors.New("redis: nil")
+
+// redisClient is a minimal RESP2 client over a small pool of connections, enough for the session commands
+type redisClient struct {
+	addr     string
+	password string
+	db       int
+
+	// slots holds a token for each open connection, so at most redisPoolSize are open; idle holds the
+	// open connections not in use
+	slots chan struct{}
+	idle  chan *redisConn
+}
+
+// redisConn is one connection to Redis, used by one command at a time
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func newRedisClient(addr, password string, db int) *redisClient {
+	return &redisClient{
+		addr:     addr,
+		password: password,
+		db:       db,
+		slots:    make(chan struct{}, redisPoolSize),
+		idle:     make(chan *redisConn, redisPoolSize),
+	}
+}
+
+// do sends one command and reads its reply on an idle connection, dialing one while the pool has room;
+// a connection whose exchange failed is closed rather than reused
+func (c *redisClient) do(ctx context.Context, args ...string) (interface{}, error) {
+	conn, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.exchange(ctx, args)
+	var redisErr redisError
+	if err != nil && !errors.Is(err, errRedisNil) && !errors.As(err, &redisErr) {
+		conn.conn.Close()
+		<-c.slots
+		return reply, err
+	}
+	c.idle <- conn
+	return reply, err
+}
+
+// acquire takes an idle connection, dials a new one while fewer than redisPoolSize are open, or waits
+// for one to be released
+func (c *redisClient) acquire(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-c.idle:
+		return conn, nil
+	default:
+	}
+
+	select {
+	case conn := <-c.idle:
+		return conn, nil
+	case c.slots <- struct{}{}:
+		conn, err := c.dial(ctx)
+		if err != nil {
+			<-c.slots
+			return nil, err
+		}
+		return conn, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to get a redis connection: %w", ctx.Err())
+	}
+}
+
+func (c *redisClient) dial(ctx context.Context) (*redisConn, error) {
+	dialer := net.Dialer{Timeout: redisDialTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	conn := &redisConn{conn: netConn, reader: bufio.NewReader(netConn)}
+
+	if c.password != "" {
+		if _, err := conn.exchange(ctx, []string{"AUTH", c.password}); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("failed to authenticate to redis: %w", err)
+		}
+	}
+	if c.db != 0 {
+		if _, err := conn.exchange(ctx, []string{"SELECT", strconv.Itoa(c.db)}); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("failed to select redis database: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+func (c *redisConn) exchange(ctx context.Context, args []string) (interface{}, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(redisDialTimeout)
+	}
+	c.conn.SetDeadline(deadline)
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, '\r', '\n')
+	for _, arg := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+		buf = append(buf, '\r', '\n')
+		buf = append(buf, arg...)
+		buf = append(buf, '\r', '\n')
+	}
+	if _, err := c.conn.Write(buf); err != nil {
+		return nil, fmt.Errorf("failed to write redis command: %w", err)
+	}
+
+	return readReply(c.reader)
+}
+
+// redisError is an error reply sent by the server
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// readReply parses one RESP2 reply: simple strings and bulk strings become string, integers int64,
+// arrays []interface{} and null replies errRedisNil
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redis reply: %w", err)
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed redis reply %q", line)
+	}
+	kind, payload := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, redisError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, fmt.Errorf("malformed redis bulk length %q", payload)
+		}
+		if size < 0 {
+			return nil, errRedisNil
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("failed to read redis reply: %w", err)
+		}
+		return string(data[:size]), nil
+	case '*':
+		count, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, fmt.Errorf("malformed redis array length %q", payload)
+		}
+		if count < 0 {
+			return nil, errRedisNil
+		}
+		items := make([]interface{}, 0, count)
+		for i := 0; i < count; i++ {
+			item, err := readReply(r)
+			if err != nil && !errors.Is(err, errRedisNil) {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unexpected redis reply %q", line)
+	}
+}
+
+// replyStrings converts an array reply into its string elements
+func replyStrings(reply interface{}) []string {
+	items, _ := reply.([]interface{})
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if value, ok := item.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,19 @@
+package session_redis_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) SessionExists(ctx context.Context, sessionID string) (bool, error) {
+	_, err := s.loadSession(ctx, sessionID)
+	if errors.Is(err, domain.ErrSessionNotFound) || errors.Is(err, domain.ErrSessionExpired) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,11 @@
+package session_redis_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestSessionRedisRepository(t *testing.T) {
+	testRunner.SessionRedisRepositoryRunner(t, NewSessionRedisRepository)
+}
@@ -0,0 +1,20 @@
+package session_redis_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) UpdateSession(ctx context.Context, session *domain.Session) error {
+	if session == nil || session.ID == "" {
+		return errors.New("session ID cannot be empty")
+	}
+
+	if _, err := s.loadSession(ctx, session.ID); err != nil {
+		return err
+	}
+
+	return s.storeSession(ctx, session)
+}
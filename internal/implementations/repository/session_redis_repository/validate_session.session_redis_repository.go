@@ -0,0 +1,11 @@
+package session_redis_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRedisRepositoryImplementation) ValidateSession(ctx context.Context, sessionID string) (*domain.Session, error) {
+	return s.GetSession(ctx, sessionID)
+}
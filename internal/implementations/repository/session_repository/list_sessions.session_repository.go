@@ -0,0 +1,12 @@
+package session_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionRepositoryImplementation) ListSessions(ctx context.Context) ([]*domain.Session, error) {
+	return nil, errors.New("not implemented")
+}
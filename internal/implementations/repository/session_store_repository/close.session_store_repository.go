@@ -0,0 +1,13 @@
+package session_store_repository
+
+func (s *SessionStoreRepositoryImplementation) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -0,0 +1,15 @@
+package session_store_repository
+
+import (
+	"context"
+)
+
+func (s *SessionStoreRepositoryImplementation) Delete(ctx context.Context, bucket, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.records[bucket][key]; !ok {
+		return nil
+	}
+	return s.write(logEntry{Bucket: bucket, Key: key, Delete: true})
+}
@@ -0,0 +1,19 @@
+package session_store_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SessionStoreRepositoryImplementation) Get(ctx context.Context, bucket, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[bucket][key]
+	if !ok || expired(record, time.Now()) {
+		return nil, domain.ErrStoreKeyNotFound
+	}
+	return record.Value, nil
+}
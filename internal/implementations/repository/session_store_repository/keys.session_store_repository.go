@@ -0,0 +1,22 @@
+package session_store_repository
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+func (s *SessionStoreRepositoryImplementation) Keys(ctx context.Context, bucket string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	keys := make([]string, 0, len(s.records[bucket]))
+	for key, record := range s.records[bucket] {
+		if !expired(record, now) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
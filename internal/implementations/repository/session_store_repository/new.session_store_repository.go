@@ -0,0 +1,190 @@
+package session_store_repository
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SessionStoreRepositoryImplementation is an embedded SessionStore kept in a single file. Every change is
+// appended to the file as a JSON line and synced before returning; opening the store replays the log and
+// rewrites it without the superseded and expired records.
+type SessionStoreRepositoryImplementation struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	records map[string]map[string]storeRecord
+	// logged counts the lines in the file, so it can be compacted once most of them are superseded
+	logged int
+}
+
+// storeRecord is a live value with its expiry
+type storeRecord struct {
+	Value     []byte
+	ExpiresAt time.Time
+}
+
+// logEntry is one line of the store file; Delete entries carry no value
+type logEntry struct {
+	Bucket    string    `json:"b"`
+	Key       string    `json:"k"`
+	Value     []byte    `json:"v,omitempty"`
+	ExpiresAt time.Time `json:"e,omitempty"`
+	Delete    bool      `json:"d,omitempty"`
+}
+
+func NewSessionStoreRepository(path string) (repository.SessionStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("session store path cannot be empty")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create session store directory: %w", err)
+	}
+
+	s := &SessionStoreRepositoryImplementation{
+		path:    path,
+		records: make(map[string]map[string]storeRecord),
+	}
+	if err := s.replay(); err != nil {
+		return nil, err
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// replay loads the records from the store file, if there is one
+func (s *SessionStoreRepositoryImplementation) replay() error {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open session store: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64<<20)
+	for scanner.Scan() {
+		var entry logEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// A torn final line from a crash mid-write loses only that change
+			break
+		}
+		s.apply(entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read session store: %w", err)
+	}
+	return nil
+}
+
+// apply updates the in-memory records with a log entry; mu must be held once the store is open
+func (s *SessionStoreRepositoryImplementation) apply(entry logEntry) {
+	bucket := s.records[entry.Bucket]
+	if entry.Delete {
+		delete(bucket, entry.Key)
+		return
+	}
+	if bucket == nil {
+		bucket = make(map[string]storeRecord)
+		s.records[entry.Bucket] = bucket
+	}
+	bucket[entry.Key] = storeRecord{Value: entry.Value, ExpiresAt: entry.ExpiresAt}
+}
+
+// compact rewrites the store file with only the live records and reopens it for appending; mu must be
+// held once the store is open
+func (s *SessionStoreRepositoryImplementation) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to compact session store: %w", err)
+	}
+
+	now := time.Now()
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	live := 0
+	for bucketName, bucket := range s.records {
+		for key, record := range bucket {
+			if expired(record, now) {
+				delete(bucket, key)
+				continue
+			}
+			entry := logEntry{Bucket: bucketName, Key: key, Value: record.Value, ExpiresAt: record.ExpiresAt}
+			if err := encoder.Encode(entry); err != nil {
+				tmp.Close()
+				return fmt.Errorf("failed to compact session store: %w", err)
+			}
+			live++
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to compact session store: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to compact session store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to compact session store: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to compact session store: %w", err)
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file, err = os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open session store: %w", err)
+	}
+	s.logged = live
+	return nil
+}
+
+// write appends a log entry, syncs it and applies it; mu must be held
+func (s *SessionStoreRepositoryImplementation) write(entry logEntry) error {
+	if s.file == nil {
+		return fmt.Errorf("session store is closed")
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode session store entry: %w", err)
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write session store: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync session store: %w", err)
+	}
+
+	s.apply(entry)
+	s.logged++
+
+	// Rewrite the file once superseded lines outnumber the live records
+	live := 0
+	for _, bucket := range s.records {
+		live += len(bucket)
+	}
+	if s.logged > 2*live+64 {
+		return s.compact()
+	}
+	return nil
+}
+
+func expired(record storeRecord, now time.Time) bool {
+	return !record.ExpiresAt.IsZero() && !record.ExpiresAt.After(now)
+}
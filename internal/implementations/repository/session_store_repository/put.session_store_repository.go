@@ -0,0 +1,18 @@
+package session_store_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+func (s *SessionStoreRepositoryImplementation) Put(ctx context.Context, bucket, key string, value []byte, expiresAt time.Time) error {
+	if bucket == "" || key == "" {
+		return errors.New("bucket and key cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.write(logEntry{Bucket: bucket, Key: key, Value: value, ExpiresAt: expiresAt})
+}
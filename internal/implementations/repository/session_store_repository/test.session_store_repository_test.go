@@ -0,0 +1,11 @@
+package session_store_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestSessionStoreRepository(t *testing.T) {
+	testRunner.SessionStoreRunner(t, NewSessionStoreRepository)
+}
@@ -0,0 +1,23 @@
+package slow_operation_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SlowOperationRepositoryImplementation) GetSlowOperations(ctx context.Context, limit int) ([]domain.SlowOperation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if limit <= 0 || limit > len(s.operations) {
+		limit = len(s.operations)
+	}
+
+	// Operations are appended in order, so walk backwards for newest first
+	result := make([]domain.SlowOperation, 0, limit)
+	for i := len(s.operations) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, s.operations[i])
+	}
+	return result, nil
+}
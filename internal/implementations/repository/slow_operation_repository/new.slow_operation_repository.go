@@ -0,0 +1,19 @@
+package slow_operation_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type SlowOperationRepositoryImplementation struct {
+	mu         sync.RWMutex
+	operations []domain.SlowOperation
+}
+
+func NewSlowOperationRepository() repository.SlowOperationRepository {
+	return &SlowOperationRepositoryImplementation{
+		operations: make([]domain.SlowOperation, 0),
+	}
+}
@@ -0,0 +1,32 @@
+package slow_operation_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *SlowOperationRepositoryImplementation) RecordSlowOperation(ctx context.Context, operation *domain.SlowOperation) error {
+	if operation == nil {
+		return errors.New("slow operation cannot be nil")
+	}
+
+	if operation.ID == "" {
+		operation.ID = "slow_" + uuid.New().String()
+	}
+	if operation.RecordedAt.IsZero() {
+		operation.RecordedAt = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.operations = append(s.operations, *operation)
+	if excess := len(s.operations) - domain.SlowOperationLogSize; excess > 0 {
+		s.operations = append([]domain.SlowOperation(nil), s.operations[excess:]...)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package slow_operation_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestSlowOperationRepository(t *testing.T) {
+	testRunner.SlowOperationRepositoryRunner(t, NewSlowOperationRepository)
+}
@@ -0,0 +1,30 @@
+package stored_audit_repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *StoredAuditRepositoryImplementation) GetDeletedRows(ctx context.Context, entryID string) (*domain.DeletedRows, error) {
+	data, err := a.store.Get(ctx, deletedRowsBucket, entryID)
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		return nil, domain.ErrDeletedRowsNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted rows: %w", err)
+	}
+
+	// Numbers stay json.Number so bigint keys survive the round trip exactly
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var rows domain.DeletedRows
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode deleted rows: %w", err)
+	}
+	return &rows, nil
+}
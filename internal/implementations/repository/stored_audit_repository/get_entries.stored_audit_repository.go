@@ -0,0 +1,63 @@
+package stored_audit_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *StoredAuditRepositoryImplementation) GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = domain.AuditDefaultLimit
+	}
+
+	keys, err := a.store.Keys(ctx, auditBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys sort oldest first, so walk backwards for newest first
+	result := make([]domain.AuditEntry, 0)
+	for i := len(keys) - 1; i >= 0 && len(result) < limit; i-- {
+		data, err := a.store.Get(ctx, auditBucket, keys[i])
+		if errors.Is(err, domain.ErrStoreKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get audit entry: %w", err)
+		}
+
+		var entry domain.AuditEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode audit entry: %w", err)
+		}
+		if filter.Username != "" && entry.Username != filter.Username {
+			continue
+		}
+		if filter.Action != "" && entry.Action != filter.Action {
+			continue
+		}
+		if filter.Database != "" && entry.Database != filter.Database {
+			continue
+		}
+		if filter.Schema != "" && entry.Schema != filter.Schema {
+			continue
+		}
+		if filter.Table != "" && entry.Table != filter.Table {
+			continue
+		}
+		if !filter.Since.IsZero() && entry.CreatedAt.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && entry.CreatedAt.After(filter.Until) {
+			continue
+		}
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package stored_audit_repository
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// auditBucket holds each entry as JSON under its creation time and ID, so the sorted keys are the
+// entries in the order they were recorded
+const auditBucket = "audit"
+
+// deletedRowsBucket holds the rows each commit deleted as JSON under the commit's audit entry ID,
+// expiring with the retention window
+const deletedRowsBucket = "audit_deleted_rows"
+
+// StoredAuditRepositoryImplementation keeps the audit log in a SessionStore so it survives restarts
+// without writing to the database
+type StoredAuditRepositoryImplementation struct {
+	store repository.SessionStore
+}
+
+func NewStoredAuditRepository(store repository.SessionStore) repository.AuditRepository {
+	return &StoredAuditRepositoryImplementation{
+		store: store,
+	}
+}
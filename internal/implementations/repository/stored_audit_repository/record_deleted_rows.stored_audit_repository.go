@@ -0,0 +1,28 @@
+package stored_audit_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *StoredAuditRepositoryImplementation) RecordDeletedRows(ctx context.Context, rows *domain.DeletedRows) error {
+	if rows == nil {
+		return errors.New("deleted rows cannot be nil")
+	}
+	if rows.EntryID == "" {
+		return errors.New("deleted rows need an audit entry ID")
+	}
+
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("failed to encode deleted rows: %w", err)
+	}
+	if err := a.store.Put(ctx, deletedRowsBucket, rows.EntryID, data, rows.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to store deleted rows: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package stored_audit_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (a *StoredAuditRepositoryImplementation) RecordEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	if entry == nil {
+		return errors.New("audit entry cannot be nil")
+	}
+
+	if entry.ID == "" {
+		entry.ID = "audit_" + uuid.New().String()
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	// Zero-padded nanoseconds sort the same as the times they encode
+	key := fmt.Sprintf("%020d_%s", entry.CreatedAt.UnixNano(), entry.ID)
+	if err := a.store.Put(ctx, auditBucket, key, data, time.Time{}); err != nil {
+		return fmt.Errorf("failed to store audit entry: %w", err)
+	}
+	return nil
+}
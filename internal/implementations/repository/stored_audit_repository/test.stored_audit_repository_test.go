@@ -0,0 +1,12 @@
+package stored_audit_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestStoredAuditRepository(t *testing.T) {
+	testRunner.StoredAuditRepositoryRunner(t, session_store_repository.NewSessionStoreRepository, NewStoredAuditRepository)
+}
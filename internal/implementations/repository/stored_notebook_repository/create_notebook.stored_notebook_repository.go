@@ -0,0 +1,40 @@
+package stored_notebook_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredNotebookRepositoryImplementation) CreateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	if notebook == nil {
+		return errors.New("notebook cannot be nil")
+	}
+
+	now := time.Now()
+	notebook.ID = "notebook_" + uuid.New().String()
+	notebook.CreatedAt = now
+	notebook.UpdatedAt = now
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.putNotebook(ctx, notebook)
+}
+
+// putNotebook stores the notebook in its owner's bucket; mu must be held
+func (s *StoredNotebookRepositoryImplementation) putNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	data, err := json.Marshal(notebook)
+	if err != nil {
+		return fmt.Errorf("failed to encode notebook: %w", err)
+	}
+	if err := s.store.Put(ctx, domain.UserBucket(notebooksBucket, notebook.Username), notebook.ID, data, time.Time{}); err != nil {
+		return fmt.Errorf("failed to store notebook: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package stored_notebook_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredNotebookRepositoryImplementation) DeleteNotebook(ctx context.Context, username, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.GetNotebook(ctx, username, id); err != nil {
+		return err
+	}
+	if err := s.store.Delete(ctx, domain.UserBucket(notebooksBucket, username), id); err != nil {
+		return fmt.Errorf("failed to delete notebook: %w", err)
+	}
+	return nil
+}
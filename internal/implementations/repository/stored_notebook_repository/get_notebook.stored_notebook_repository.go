@@ -0,0 +1,26 @@
+package stored_notebook_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredNotebookRepositoryImplementation) GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	data, err := s.store.Get(ctx, domain.UserBucket(notebooksBucket, username), id)
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		return nil, domain.ErrNotebookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notebook: %w", err)
+	}
+
+	var notebook domain.QueryNotebook
+	if err := json.Unmarshal(data, &notebook); err != nil {
+		return nil, fmt.Errorf("failed to decode notebook: %w", err)
+	}
+	return &notebook, nil
+}
@@ -0,0 +1,37 @@
+package stored_notebook_repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredNotebookRepositoryImplementation) ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error) {
+	ids, err := s.store.Keys(ctx, domain.UserBucket(notebooksBucket, username))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.QueryNotebook, 0, len(ids))
+	for _, id := range ids {
+		notebook, err := s.GetNotebook(ctx, username, id)
+		if errors.Is(err, domain.ErrNotebookNotFound) {
+			// Deleted between listing and reading
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *notebook)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Title != result[j].Title {
+			return result[i].Title < result[j].Title
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
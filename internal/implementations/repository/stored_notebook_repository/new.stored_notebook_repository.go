@@ -0,0 +1,24 @@
+package stored_notebook_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// StoredNotebookRepositoryImplementation keeps notebooks in a SessionStore, one bucket per
+// PostgreSQL username, so notebooks survive restarts without writing to the database
+type StoredNotebookRepositoryImplementation struct {
+	// mu serializes the read-modify-write of updates
+	mu    sync.Mutex
+	store repository.SessionStore
+}
+
+func NewStoredNotebookRepository(store repository.SessionStore) repository.NotebookRepository {
+	return &StoredNotebookRepositoryImplementation{
+		store: store,
+	}
+}
+
+// notebooksBucket is the kind of the per-user buckets that hold each of a user's notebooks as JSON under its ID
+const notebooksBucket = "notebooks"
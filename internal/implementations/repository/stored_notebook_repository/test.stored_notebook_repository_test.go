@@ -0,0 +1,12 @@
+package stored_notebook_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestStoredNotebookRepository(t *testing.T) {
+	testRunner.StoredNotebookRepositoryRunner(t, session_store_repository.NewSessionStoreRepository, NewStoredNotebookRepository)
+}
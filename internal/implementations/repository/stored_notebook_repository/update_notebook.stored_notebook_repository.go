@@ -0,0 +1,27 @@
+package stored_notebook_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredNotebookRepositoryImplementation) UpdateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	if notebook == nil {
+		return errors.New("notebook cannot be nil")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.GetNotebook(ctx, notebook.Username, notebook.ID)
+	if err != nil {
+		return err
+	}
+
+	notebook.CreatedAt = existing.CreatedAt
+	notebook.UpdatedAt = time.Now()
+	return s.putNotebook(ctx, notebook)
+}
@@ -0,0 +1,15 @@
+package stored_preference_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (p *StoredPreferenceRepositoryImplementation) DeletePreference(ctx context.Context, username, key string) error {
+	if err := p.store.Delete(ctx, domain.UserBucket(preferencesBucket, username), key); err != nil {
+		return fmt.Errorf("failed to delete preference: %w", err)
+	}
+	return nil
+}
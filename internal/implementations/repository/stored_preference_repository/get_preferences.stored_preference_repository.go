@@ -0,0 +1,29 @@
+package stored_preference_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (p *StoredPreferenceRepositoryImplementation) GetPreferences(ctx context.Context, username string) (map[string]string, error) {
+	keys, err := p.store.Keys(ctx, domain.UserBucket(preferencesBucket, username))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, err := p.store.Get(ctx, domain.UserBucket(preferencesBucket, username), key)
+		if errors.Is(err, domain.ErrStoreKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get preference: %w", err)
+		}
+		result[key] = string(value)
+	}
+	return result, nil
+}
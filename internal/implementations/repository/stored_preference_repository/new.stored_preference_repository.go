@@ -0,0 +1,20 @@
+package stored_preference_repository
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// StoredPreferenceRepositoryImplementation keeps preferences in a SessionStore, one bucket per
+// PostgreSQL username, so they survive restarts without writing to the database
+type StoredPreferenceRepositoryImplementation struct {
+	store repository.SessionStore
+}
+
+func NewStoredPreferenceRepository(store repository.SessionStore) repository.PreferenceRepository {
+	return &StoredPreferenceRepositoryImplementation{
+		store: store,
+	}
+}
+
+// preferencesBucket is the kind of the per-user buckets that hold each of a user's preferences under its key
+const preferencesBucket = "preferences"
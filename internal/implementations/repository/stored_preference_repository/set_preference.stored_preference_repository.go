@@ -0,0 +1,21 @@
+package stored_preference_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (p *StoredPreferenceRepositoryImplementation) SetPreference(ctx context.Context, username, key, value string) error {
+	if key == "" {
+		return errors.New("preference key cannot be empty")
+	}
+
+	if err := p.store.Put(ctx, domain.UserBucket(preferencesBucket, username), key, []byte(value), time.Time{}); err != nil {
+		return fmt.Errorf("failed to store preference: %w", err)
+	}
+	return nil
+}
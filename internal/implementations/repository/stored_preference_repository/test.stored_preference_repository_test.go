@@ -0,0 +1,12 @@
+package stored_preference_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestStoredPreferenceRepository(t *testing.T) {
+	testRunner.StoredPreferenceRepositoryRunner(t, session_store_repository.NewSessionStoreRepository, NewStoredPreferenceRepository)
+}
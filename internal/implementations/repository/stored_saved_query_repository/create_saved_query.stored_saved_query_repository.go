@@ -0,0 +1,40 @@
+package stored_saved_query_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSavedQueryRepositoryImplementation) CreateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	if query == nil {
+		return errors.New("saved query cannot be nil")
+	}
+
+	now := time.Now()
+	query.ID = "query_" + uuid.New().String()
+	query.CreatedAt = now
+	query.UpdatedAt = now
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.putSavedQuery(ctx, query)
+}
+
+// putSavedQuery stores the query in its owner's bucket; mu must be held
+func (s *StoredSavedQueryRepositoryImplementation) putSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	data, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to encode saved query: %w", err)
+	}
+	if err := s.store.Put(ctx, domain.UserBucket(savedQueriesBucket, query.Username), query.ID, data, time.Time{}); err != nil {
+		return fmt.Errorf("failed to store saved query: %w", err)
+	}
+	return nil
+}
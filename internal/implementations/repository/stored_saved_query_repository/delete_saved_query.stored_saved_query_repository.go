@@ -0,0 +1,21 @@
+package stored_saved_query_repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSavedQueryRepositoryImplementation) DeleteSavedQuery(ctx context.Context, username, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.GetSavedQuery(ctx, username, id); err != nil {
+		return err
+	}
+	if err := s.store.Delete(ctx, domain.UserBucket(savedQueriesBucket, username), id); err != nil {
+		return fmt.Errorf("failed to delete saved query: %w", err)
+	}
+	return nil
+}
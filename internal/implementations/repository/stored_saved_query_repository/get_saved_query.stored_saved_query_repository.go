@@ -0,0 +1,26 @@
+package stored_saved_query_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSavedQueryRepositoryImplementation) GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error) {
+	data, err := s.store.Get(ctx, domain.UserBucket(savedQueriesBucket, username), id)
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		return nil, domain.ErrSavedQueryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved query: %w", err)
+	}
+
+	var query domain.SavedQuery
+	if err := json.Unmarshal(data, &query); err != nil {
+		return nil, fmt.Errorf("failed to decode saved query: %w", err)
+	}
+	return &query, nil
+}
@@ -0,0 +1,37 @@
+package stored_saved_query_repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSavedQueryRepositoryImplementation) ListSavedQueries(ctx context.Context, username string) ([]domain.SavedQuery, error) {
+	ids, err := s.store.Keys(ctx, domain.UserBucket(savedQueriesBucket, username))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.SavedQuery, 0, len(ids))
+	for _, id := range ids {
+		query, err := s.GetSavedQuery(ctx, username, id)
+		if errors.Is(err, domain.ErrSavedQueryNotFound) {
+			// Deleted between listing and reading
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *query)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
@@ -0,0 +1,24 @@
+package stored_saved_query_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// StoredSavedQueryRepositoryImplementation keeps saved queries in a SessionStore, one bucket per
+// PostgreSQL username, so libraries survive restarts without writing to the database
+type StoredSavedQueryRepositoryImplementation struct {
+	// mu serializes the read-modify-write of updates
+	mu    sync.Mutex
+	store repository.SessionStore
+}
+
+func NewStoredSavedQueryRepository(store repository.SessionStore) repository.SavedQueryRepository {
+	return &StoredSavedQueryRepositoryImplementation{
+		store: store,
+	}
+}
+
+// savedQueriesBucket is the kind of the per-user buckets that hold each of a user's saved queries as JSON under its ID
+const savedQueriesBucket = "saved_queries"
@@ -0,0 +1,12 @@
+package stored_saved_query_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestStoredSavedQueryRepository(t *testing.T) {
+	testRunner.StoredSavedQueryRepositoryRunner(t, session_store_repository.NewSessionStoreRepository, NewStoredSavedQueryRepository)
+}
@@ -0,0 +1,27 @@
+package stored_saved_query_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSavedQueryRepositoryImplementation) UpdateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	if query == nil {
+		return errors.New("saved query cannot be nil")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.GetSavedQuery(ctx, query.Username, query.ID)
+	if err != nil {
+		return err
+	}
+
+	query.CreatedAt = existing.CreatedAt
+	query.UpdatedAt = time.Now()
+	return s.putSavedQuery(ctx, query)
+}
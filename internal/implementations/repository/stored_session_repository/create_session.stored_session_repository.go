@@ -0,0 +1,33 @@
+package stored_session_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) CreateSession(ctx context.Context, session *domain.Session) error {
+	if session == nil || session.ID == "" {
+		return errors.New("session ID cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.putSession(ctx, session)
+}
+
+// putSession stores the session until it expires; mu must be held
+func (s *StoredSessionRepositoryImplementation) putSession(ctx context.Context, session *domain.Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+	if err := s.store.Put(ctx, sessionsBucket, session.ID, data, session.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to store session: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package stored_session_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *StoredSessionRepositoryImplementation) DeleteSession(ctx context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.store.Delete(ctx, sessionsBucket, sessionID); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package stored_session_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) GetSession(ctx context.Context, sessionID string) (*domain.Session, error) {
+	data, err := s.store.Get(ctx, sessionsBucket, sessionID)
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		return nil, domain.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	var session domain.Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+	return &session, nil
+}
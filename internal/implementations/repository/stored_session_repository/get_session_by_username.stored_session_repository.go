@@ -0,0 +1,50 @@
+package stored_session_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) GetSessionByUsername(ctx context.Context, username string) (*domain.Session, error) {
+	sessions, err := s.userSessions(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *domain.Session
+	for _, session := range sessions {
+		if latest == nil || session.CreatedAt.After(latest.CreatedAt) {
+			latest = session
+		}
+	}
+	if latest == nil {
+		return nil, domain.ErrSessionNotFound
+	}
+	return latest, nil
+}
+
+// userSessions lists the unexpired sessions of a user
+func (s *StoredSessionRepositoryImplementation) userSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	ids, err := s.store.Keys(ctx, sessionsBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*domain.Session
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			// Expired between listing and reading
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if session.Username == username {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
@@ -0,0 +1,22 @@
+package stored_session_repository
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *StoredSessionRepositoryImplementation) InvalidateUserSessions(ctx context.Context, username string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions, err := s.userSessions(ctx, username)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := s.store.Delete(ctx, sessionsBucket, session.ID); err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package stored_session_repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) ListSessions(ctx context.Context) ([]*domain.Session, error) {
+	ids, err := s.store.Keys(ctx, sessionsBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*domain.Session
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			// Expired between listing and reading
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].CreatedAt.Before(sessions[j].CreatedAt) })
+	return sessions, nil
+}
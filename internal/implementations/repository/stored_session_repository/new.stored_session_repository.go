@@ -0,0 +1,22 @@
+package stored_session_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// sessionsBucket holds each session as JSON under its ID, expiring with the session
+const sessionsBucket = "sessions"
+
+// StoredSessionRepositoryImplementation keeps sessions in a SessionStore so they survive restarts
+type StoredSessionRepositoryImplementation struct {
+	mu    sync.Mutex
+	store repository.SessionStore
+}
+
+func NewStoredSessionRepository(store repository.SessionStore) repository.SessionRepository {
+	return &StoredSessionRepositoryImplementation{
+		store: store,
+	}
+}
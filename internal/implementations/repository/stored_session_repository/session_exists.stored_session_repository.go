@@ -0,0 +1,19 @@
+package stored_session_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) SessionExists(ctx context.Context, sessionID string) (bool, error) {
+	_, err := s.GetSession(ctx, sessionID)
+	if errors.Is(err, domain.ErrSessionNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,12 @@
+package stored_session_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestStoredSessionRepository(t *testing.T) {
+	testRunner.StoredSessionRepositoryRunner(t, session_store_repository.NewSessionStoreRepository, NewStoredSessionRepository)
+}
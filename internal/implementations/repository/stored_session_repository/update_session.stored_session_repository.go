@@ -0,0 +1,22 @@
+package stored_session_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) UpdateSession(ctx context.Context, session *domain.Session) error {
+	if session == nil || session.ID == "" {
+		return errors.New("session ID cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.GetSession(ctx, session.ID); err != nil {
+		return err
+	}
+	return s.putSession(ctx, session)
+}
@@ -0,0 +1,11 @@
+package stored_session_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (s *StoredSessionRepositoryImplementation) ValidateSession(ctx context.Context, sessionID string) (*domain.Session, error) {
+	return s.GetSession(ctx, sessionID)
+}
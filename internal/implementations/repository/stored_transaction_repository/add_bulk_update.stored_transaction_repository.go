@@ -0,0 +1,13 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) AddBulkUpdate(ctx context.Context, transactionID string, update domain.BulkUpdate) error {
+	return r.updateBuffers(ctx, transactionID, func(transaction *domain.TransactionState) {
+		transaction.BulkUpdates = append(transaction.BulkUpdates, update)
+	})
+}
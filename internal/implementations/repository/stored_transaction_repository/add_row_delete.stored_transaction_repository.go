@@ -0,0 +1,18 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) AddRowDelete(ctx context.Context, transactionID string, rowIndex int) error {
+	return r.updateBuffers(ctx, transactionID, func(transaction *domain.TransactionState) {
+		for _, deleted := range transaction.Deletes {
+			if deleted == rowIndex {
+				return
+			}
+		}
+		transaction.Deletes = append(transaction.Deletes, rowIndex)
+	})
+}
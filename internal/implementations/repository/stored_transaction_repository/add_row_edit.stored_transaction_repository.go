@@ -0,0 +1,13 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) AddRowEdit(ctx context.Context, transactionID string, edit domain.RowEdit) error {
+	return r.updateBuffers(ctx, transactionID, func(transaction *domain.TransactionState) {
+		transaction.Edits[edit.RowIndex] = edit
+	})
+}
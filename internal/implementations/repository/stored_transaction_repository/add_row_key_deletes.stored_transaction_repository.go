@@ -0,0 +1,13 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) AddRowKeyDeletes(ctx context.Context, transactionID string, keys []map[string]interface{}) error {
+	return r.updateBuffers(ctx, transactionID, func(transaction *domain.TransactionState) {
+		transaction.KeyDeletes = append(transaction.KeyDeletes, keys...)
+	})
+}
@@ -0,0 +1,13 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) ClearRowDeletes(ctx context.Context, transactionID string) error {
+	return r.updateBuffers(ctx, transactionID, func(transaction *domain.TransactionState) {
+		transaction.Deletes = nil
+	})
+}
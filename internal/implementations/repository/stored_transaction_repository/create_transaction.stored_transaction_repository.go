@@ -0,0 +1,37 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) CreateTransaction(ctx context.Context, transaction *domain.TransactionState) error {
+	if transaction == nil || transaction.ID == "" {
+		return errors.New("transaction ID cannot be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.putTransaction(ctx, transaction)
+}
+
+// putTransaction stores the transaction and points its user at it; mu must be held
+func (r *StoredTransactionRepositoryImplementation) putTransaction(ctx context.Context, transaction *domain.TransactionState) error {
+	data, err := json.Marshal(transaction)
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	if err := r.store.Put(ctx, transactionsBucket, transaction.ID, data, time.Time{}); err != nil {
+		return fmt.Errorf("failed to store transaction: %w", err)
+	}
+	if err := r.store.Put(ctx, userTransactionsBucket, transaction.Username, []byte(transaction.ID), time.Time{}); err != nil {
+		return fmt.Errorf("failed to index transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) DeleteTransaction(ctx context.Context, transactionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transaction, err := r.loadTransaction(ctx, transactionID)
+	if errors.Is(err, domain.ErrNoActiveTransaction) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.removeTransaction(ctx, transaction)
+}
+
+// removeTransaction deletes a transaction and, if it is still its user's, the user's pointer to it;
+// mu must be held
+func (r *StoredTransactionRepositoryImplementation) removeTransaction(ctx context.Context, transaction *domain.TransactionState) error {
+	if err := r.store.Delete(ctx, transactionsBucket, transaction.ID); err != nil {
+		return fmt.Errorf("failed to delete transaction: %w", err)
+	}
+
+	id, err := r.store.Get(ctx, userTransactionsBucket, transaction.Username)
+	if err == nil && string(id) == transaction.ID {
+		if err := r.store.Delete(ctx, userTransactionsBucket, transaction.Username); err != nil {
+			return fmt.Errorf("failed to unlink transaction: %w", err)
+		}
+	}
+	return nil
+}
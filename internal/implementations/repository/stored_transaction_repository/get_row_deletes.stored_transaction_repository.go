@@ -0,0 +1,13 @@
+package stored_transaction_repository
+
+import (
+	"context"
+)
+
+func (r *StoredTransactionRepositoryImplementation) GetRowDeletes(ctx context.Context, transactionID string) ([]int, error) {
+	transaction, err := r.loadTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.Deletes, nil
+}
@@ -0,0 +1,15 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) GetRowInserts(ctx context.Context, transactionID string) ([]domain.RowInsert, error) {
+	transaction, err := r.loadTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.Inserts, nil
+}
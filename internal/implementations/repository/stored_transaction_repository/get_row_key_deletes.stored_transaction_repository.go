@@ -0,0 +1,13 @@
+package stored_transaction_repository
+
+import (
+	"context"
+)
+
+func (r *StoredTransactionRepositoryImplementation) GetRowKeyDeletes(ctx context.Context, transactionID string) ([]map[string]interface{}, error) {
+	transaction, err := r.loadTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return transaction.KeyDeletes, nil
+}
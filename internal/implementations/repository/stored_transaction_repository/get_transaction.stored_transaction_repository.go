@@ -0,0 +1,55 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) GetTransaction(ctx context.Context, transactionID string) (*domain.TransactionState, error) {
+	return r.loadTransaction(ctx, transactionID)
+}
+
+// loadTransaction reads a transaction by ID. The transaction usecase addresses buffers by username, so
+// an ID that is not a transaction's is resolved through the user's transaction.
+func (r *StoredTransactionRepositoryImplementation) loadTransaction(ctx context.Context, transactionID string) (*domain.TransactionState, error) {
+	data, err := r.store.Get(ctx, transactionsBucket, transactionID)
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		id, indexErr := r.store.Get(ctx, userTransactionsBucket, transactionID)
+		if indexErr != nil {
+			return nil, domain.ErrNoActiveTransaction
+		}
+		data, err = r.store.Get(ctx, transactionsBucket, string(id))
+	}
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		return nil, domain.ErrNoActiveTransaction
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	var transaction domain.TransactionState
+	if err := json.Unmarshal(data, &transaction); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if transaction.Edits == nil {
+		transaction.Edits = make(map[int]domain.RowEdit)
+	}
+	return &transaction, nil
+}
+
+// updateBuffers applies change to a stored transaction and writes it back
+func (r *StoredTransactionRepositoryImplementation) updateBuffers(ctx context.Context, transactionID string, change func(transaction *domain.TransactionState)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transaction, err := r.loadTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+	change(transaction)
+	return r.putTransaction(ctx, transaction)
+}
@@ -0,0 +1,20 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) GetUserTransaction(ctx context.Context, username string) (*domain.TransactionState, error) {
+	id, err := r.store.Get(ctx, userTransactionsBucket, username)
+	if errors.Is(err, domain.ErrStoreKeyNotFound) {
+		return nil, domain.ErrNoActiveTransaction
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user transaction: %w", err)
+	}
+	return r.loadTransaction(ctx, string(id))
+}
@@ -0,0 +1,31 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"time"
+)
+
+func (r *StoredTransactionRepositoryImplementation) InvalidateExpiredTransactions(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids, err := r.store.Keys(ctx, transactionsBucket)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		transaction, err := r.loadTransaction(ctx, id)
+		if err != nil {
+			return err
+		}
+		if transaction.ExpiresAt.After(now) {
+			continue
+		}
+		if err := r.removeTransaction(ctx, transaction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package stored_transaction_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) ListTransactions(ctx context.Context) ([]*domain.TransactionState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids, err := r.store.Keys(ctx, transactionsBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*domain.TransactionState, 0, len(ids))
+	for _, id := range ids {
+		transaction, err := r.loadTransaction(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions, nil
+}
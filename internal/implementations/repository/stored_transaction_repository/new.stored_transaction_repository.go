@@ -0,0 +1,28 @@
+package stored_transaction_repository
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+const (
+	// transactionsBucket holds each transaction, buffers included, as JSON under its ID
+	transactionsBucket = "transactions"
+	// userTransactionsBucket maps a username to the ID of the user's transaction
+	userTransactionsBucket = "user_transactions"
+)
+
+// StoredTransactionRepositoryImplementation keeps transactions and their buffered changes in a
+// SessionStore so they survive restarts
+type StoredTransactionRepositoryImplementation struct {
+	// mu serializes the read-modify-write of buffered changes
+	mu    sync.Mutex
+	store repository.SessionStore
+}
+
+func NewStoredTransactionRepository(store repository.SessionStore) repository.TransactionRepository {
+	return &StoredTransactionRepositoryImplementation{
+		store: store,
+	}
+}
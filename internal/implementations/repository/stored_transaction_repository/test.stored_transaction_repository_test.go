@@ -0,0 +1,12 @@
+package stored_transaction_repository
+
+import (
+	"testing"
+
+	"github.com/kamil5b/lumen-pg/internal/implementations/repository/session_store_repository"
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestStoredTransactionRepository(t *testing.T) {
+	testRunner.StoredTransactionRepositoryRunner(t, session_store_repository.NewSessionStoreRepository, NewStoredTransactionRepository)
+}
@@ -0,0 +1,20 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) TransactionExists(ctx context.Context, transactionID string) (bool, error) {
+	transaction, err := r.loadTransaction(ctx, transactionID)
+	if errors.Is(err, domain.ErrNoActiveTransaction) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return transaction.ExpiresAt.After(time.Now()), nil
+}
@@ -0,0 +1,22 @@
+package stored_transaction_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (r *StoredTransactionRepositoryImplementation) UpdateTransaction(ctx context.Context, transaction *domain.TransactionState) error {
+	if transaction == nil || transaction.ID == "" {
+		return errors.New("transaction ID cannot be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.loadTransaction(ctx, transaction.ID); err != nil {
+		return err
+	}
+	return r.putTransaction(ctx, transaction)
+}
@@ -0,0 +1,21 @@
+package table_snapshot_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *TableSnapshotRepositoryImplementation) DeleteSnapshot(ctx context.Context, username, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := c.liveSnapshots(username)
+	for i, snapshot := range kept {
+		if snapshot.ID == id {
+			c.snapshots[username] = append(kept[:i], kept[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrTableSnapshotNotFound
+}
@@ -0,0 +1,19 @@
+package table_snapshot_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *TableSnapshotRepositoryImplementation) GetSnapshot(ctx context.Context, username, id string) (*domain.TableSnapshot, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, snapshot := range c.liveSnapshots(username) {
+		if snapshot.ID == id {
+			return &snapshot, nil
+		}
+	}
+	return nil, domain.ErrTableSnapshotNotFound
+}
@@ -0,0 +1,19 @@
+package table_snapshot_repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *TableSnapshotRepositoryImplementation) ListSnapshots(ctx context.Context, username string) ([]domain.TableSnapshot, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := c.liveSnapshots(username)
+	snapshots := make([]domain.TableSnapshot, len(kept))
+	for i, snapshot := range kept {
+		snapshots[len(kept)-1-i] = snapshot
+	}
+	return snapshots, nil
+}
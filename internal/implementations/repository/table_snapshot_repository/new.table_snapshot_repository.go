@@ -0,0 +1,32 @@
+package table_snapshot_repository
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+type TableSnapshotRepositoryImplementation struct {
+	mu sync.Mutex
+	// snapshots holds each user's kept snapshots, oldest first
+	snapshots map[string][]domain.TableSnapshot
+}
+
+func NewTableSnapshotRepository() repository.TableSnapshotRepository {
+	return &TableSnapshotRepositoryImplementation{
+		snapshots: make(map[string][]domain.TableSnapshot),
+	}
+}
+
+// liveSnapshots returns the user's snapshots not yet expired, oldest first; the caller holds mu
+func (c *TableSnapshotRepositoryImplementation) liveSnapshots(username string) []domain.TableSnapshot {
+	kept := make([]domain.TableSnapshot, 0, len(c.snapshots[username]))
+	for _, snapshot := range c.snapshots[username] {
+		if time.Since(snapshot.TakenAt) < domain.TableSnapshotTTL {
+			kept = append(kept, snapshot)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,33 @@
+package table_snapshot_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (c *TableSnapshotRepositoryImplementation) StoreSnapshot(ctx context.Context, snapshot *domain.TableSnapshot) error {
+	if snapshot == nil {
+		return errors.New("table snapshot cannot be nil")
+	}
+
+	if snapshot.ID == "" {
+		snapshot.ID = "snapshot_" + uuid.New().String()
+	}
+	if snapshot.TakenAt.IsZero() {
+		snapshot.TakenAt = time.Now()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	kept := append(c.liveSnapshots(snapshot.Username), *snapshot)
+	if excess := len(kept) - domain.TableSnapshotPerUser; excess > 0 {
+		kept = kept[excess:]
+	}
+	c.snapshots[snapshot.Username] = kept
+	return nil
+}
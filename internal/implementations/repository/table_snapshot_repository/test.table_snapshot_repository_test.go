@@ -0,0 +1,11 @@
+package table_snapshot_repository
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/repository"
+)
+
+func TestTableSnapshotRepository(t *testing.T) {
+	testRunner.TableSnapshotRepositoryRunner(t, NewTableSnapshotRepository)
+}
@@ -0,0 +1,12 @@
+package transaction_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (t *TransactionRepositoryImplementation) AddBulkUpdate(ctx context.Context, transactionID string, update domain.BulkUpdate) error {
+	return errors.New("not implemented")
+}
@@ -0,0 +1,10 @@
+package transaction_repository
+
+import (
+	"context"
+	"errors"
+)
+
+func (t *TransactionRepositoryImplementation) AddRowKeyDeletes(ctx context.Context, transactionID string, keys []map[string]interface{}) error {
+	return errors.New("not implemented")
+}
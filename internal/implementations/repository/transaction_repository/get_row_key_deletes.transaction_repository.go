@@ -0,0 +1,10 @@
+package transaction_repository
+
+import (
+	"context"
+	"errors"
+)
+
+func (t *TransactionRepositoryImplementation) GetRowKeyDeletes(ctx context.Context, transactionID string) ([]map[string]interface{}, error) {
+	return nil, errors.New("not implemented")
+}
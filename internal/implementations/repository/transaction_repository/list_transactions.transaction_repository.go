@@ -0,0 +1,12 @@
+package transaction_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (t *TransactionRepositoryImplementation) ListTransactions(ctx context.Context) ([]*domain.TransactionState, error) {
+	return nil, errors.New("not implemented")
+}
@@ -0,0 +1,35 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuditUseCaseImplementation) ListAccountActivity(ctx context.Context, username string) (*domain.AccountActivity, error) {
+	if username == "" {
+		return nil, domain.ValidationError{Field: "username", Message: "account activity requires a username"}
+	}
+
+	activity := &domain.AccountActivity{}
+	for _, kind := range []struct {
+		action  string
+		entries *[]domain.AuditEntry
+	}{
+		{domain.AuditActionLogin, &activity.Logins},
+		{domain.AuditActionQuery, &activity.Queries},
+		{domain.AuditActionExport, &activity.Exports},
+	} {
+		entries, err := u.auditRepo.GetEntries(ctx, domain.AuditFilter{
+			Username: username,
+			Action:   kind.action,
+			Limit:    domain.AccountActivityLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		*kind.entries = entries
+	}
+
+	return activity, nil
+}
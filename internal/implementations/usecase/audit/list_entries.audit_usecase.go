@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuditUseCaseImplementation) ListEntries(ctx context.Context, adminUsername string, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	entries, err := u.auditRepo.GetEntries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+	return entries, nil
+}
+
+// validateFilter rejects a time range that ends before it starts
+func validateFilter(filter domain.AuditFilter) error {
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return domain.ValidationError{Field: "until", Message: "the end of the time range is before its start"}
+	}
+	if filter.Limit < 0 {
+		return domain.ValidationError{Field: "limit", Message: fmt.Sprintf("invalid limit: %d", filter.Limit)}
+	}
+	return nil
+}
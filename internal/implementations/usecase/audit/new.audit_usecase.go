@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type AuditUseCaseImplementation struct {
+	auditRepo  repository.AuditRepository
+	rbacRepo   repository.RBACRepository
+	configRepo repository.ConfigRepository
+	geoIPRepo  repository.GeoIPRepository
+}
+
+func NewAuditUseCaseImplementation(
+	auditRepo repository.AuditRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	geoIPRepo repository.GeoIPRepository,
+) usecase.AuditUseCase {
+	return &AuditUseCaseImplementation{
+		auditRepo:  auditRepo,
+		rbacRepo:   rbacRepo,
+		configRepo: configRepo,
+		geoIPRepo:  geoIPRepo,
+	}
+}
@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuditUseCaseImplementation) RecordAction(ctx context.Context, entry *domain.AuditEntry) error {
+	if entry == nil {
+		return errors.New("audit entry cannot be nil")
+	}
+	if entry.Username == "" {
+		return domain.ValidationError{Field: "username", Message: "audit entry requires an actor"}
+	}
+	if entry.Action == "" {
+		return domain.ValidationError{Field: "action", Message: "audit entry requires an action"}
+	}
+
+	// The log is append-only, so an entry always gets a fresh ID and the time it was recorded
+	entry.ID = ""
+	entry.CreatedAt = time.Now()
+	return u.auditRepo.RecordEntry(ctx, entry)
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuditUseCaseImplementation) RecordLogin(ctx context.Context, username, clientIP string) (*domain.LoginEvent, error) {
+	if username == "" {
+		return nil, domain.ValidationError{Field: "username", Message: "login requires a username"}
+	}
+
+	event := &domain.LoginEvent{Username: username, ClientIP: clientIP}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if config.GeoIPDatabasePath != "" && clientIP != "" {
+		// Enrichment is best-effort: an unreadable database or unparsable address still records the login
+		location, err := u.geoIPRepo.LookupLocation(ctx, config.GeoIPDatabasePath, clientIP)
+		if err == nil && location != nil {
+			event.Location = location
+			event.NewLocation, err = u.isNewLocation(ctx, username, location)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	after := map[string]interface{}{
+		"client_ip":    clientIP,
+		"new_location": event.NewLocation,
+	}
+	if event.Location != nil {
+		after["country_code"] = event.Location.CountryCode
+		after["country"] = event.Location.Country
+		after["region"] = event.Location.Region
+		after["city"] = event.Location.City
+	}
+
+	err = u.RecordAction(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionLogin,
+		Target:   clientIP,
+		After:    after,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// isNewLocation reports whether none of the user's recent located logins came from the location's
+// country and region. A user's first located login sets the baseline and is not flagged.
+func (u *AuditUseCaseImplementation) isNewLocation(ctx context.Context, username string, location *domain.GeoLocation) (bool, error) {
+	previous, err := u.auditRepo.GetEntries(ctx, domain.AuditFilter{
+		Username: username,
+		Action:   domain.AuditActionLogin,
+		Limit:    domain.LoginLocationHistory,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	located := false
+	for _, entry := range previous {
+		countryCode, _ := entry.After["country_code"].(string)
+		if countryCode == "" {
+			continue
+		}
+		located = true
+		region, _ := entry.After["region"].(string)
+		if countryCode == location.CountryCode && region == location.Region {
+			return false, nil
+		}
+	}
+	return located, nil
+}
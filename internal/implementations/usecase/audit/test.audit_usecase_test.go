@@ -0,0 +1,11 @@
+package audit
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestAuditUsecase(t *testing.T) {
+	testRunner.AuditUsecaseRunner(t, NewAuditUseCaseImplementation)
+}
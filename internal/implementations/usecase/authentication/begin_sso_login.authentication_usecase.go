@@ -0,0 +1,30 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) BeginSSOLogin(ctx context.Context) (*domain.SSOAuthRequest, error) {
+	if u.oidcRepo == nil {
+		return nil, domain.ValidationError{Field: "sso", Message: "single sign-on is not configured"}
+	}
+
+	state, err := u.encryptionRepo.GenerateSecureToken(ctx, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate state: %w", err)
+	}
+	nonce, err := u.encryptionRepo.GenerateSecureToken(ctx, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	authURL, err := u.oidcRepo.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build authorization URL: %w", err)
+	}
+
+	return &domain.SSOAuthRequest{URL: authURL, State: state, Nonce: nonce}, nil
+}
@@ -0,0 +1,34 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) BindLoginConnection(ctx context.Context, profileName, username, password string) (context.Context, error) {
+	profile, err := u.connectionProfile(ctx, profileName)
+	if err != nil {
+		return nil, err
+	}
+
+	connString, err := u.loginConnectionString(ctx, profile, username, password, "postgres")
+	if err != nil {
+		return nil, err
+	}
+
+	// Logins of one user to one profile share a pool, closed like any session's once it idles, which
+	// outlives the request for the discovery still running in the background
+	db, err := u.databaseRepo.SessionPool(ctx, loginPoolKey(profile.Name, username), connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection pool: %w", err)
+	}
+	return domain.WithSessionConnection(ctx, db), nil
+}
+
+// loginPoolKey is the pool key of a login's discovery connection; session keys are UUIDs, so it never
+// collides with one
+func loginPoolKey(profile, username string) string {
+	return "login/" + profile + "/" + username
+}
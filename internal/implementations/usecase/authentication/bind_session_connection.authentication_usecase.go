@@ -0,0 +1,57 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) BindSessionConnection(ctx context.Context, session *domain.Session) (context.Context, error) {
+	if db, ok := u.databaseRepo.LookupSessionPool(session.ID); ok {
+		return domain.WithSessionConnection(ctx, db), nil
+	}
+
+	// The pool is gone after a restart or an idle close, so reopen it with the credentials the session
+	// logged in with
+	connString, err := u.sessionConnectionString(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	db, err := u.databaseRepo.SessionPool(ctx, session.ID, connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection pool: %w", err)
+	}
+	return domain.WithSessionConnection(ctx, db), nil
+}
+
+// sessionConnectionString rebuilds the connection string a session logged in with: its own decrypted
+// password, or the LDAP or SSO service user switching to its role
+func (u *AuthenticationUseCaseImplementation) sessionConnectionString(ctx context.Context, session *domain.Session) (string, error) {
+	profile, err := u.connectionProfile(ctx, session.Profile)
+	if err != nil {
+		return "", err
+	}
+	database := session.Database
+	if database == "" {
+		database = "postgres"
+	}
+
+	if session.EncryptedPassword != "" {
+		password, err := u.encryptionRepo.Decrypt(ctx, session.EncryptedPassword)
+		if err != nil {
+			return "", fmt.Errorf("failed to decrypt password: %w", err)
+		}
+		return connectionString(profile, session.Username, password, database), nil
+	}
+
+	serviceUser, fallback := u.ssoConfig.ServiceUser, u.ssoConfig.ServicePassword
+	if u.ldapAuth != nil {
+		serviceUser, fallback = u.ldapConfig.ServiceUser, u.ldapConfig.ServicePassword
+	}
+	servicePassword, err := u.servicePassword(ctx, profile, fallback)
+	if err != nil {
+		return "", err
+	}
+	return roleConnectionString(profile, serviceUser, servicePassword, session.Username, database), nil
+}
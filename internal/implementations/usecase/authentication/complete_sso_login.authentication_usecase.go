@@ -0,0 +1,105 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) CompleteSSOLogin(ctx context.Context, code, nonce string) (*domain.Session, error) {
+	if u.oidcRepo == nil {
+		return nil, domain.ValidationError{Field: "sso", Message: "single sign-on is not configured"}
+	}
+	if code == "" || nonce == "" {
+		return nil, domain.ValidationError{Field: "sso", Message: "login response is incomplete"}
+	}
+
+	identity, err := u.oidcRepo.ExchangeCode(ctx, code, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify single sign-on identity: %w", err)
+	}
+
+	role := mapSSORole(identity, u.ssoConfig.RoleMappings)
+	if role == "" {
+		return nil, domain.ValidationError{Field: "permission", Message: fmt.Sprintf("no database role is mapped to %s", identityName(identity))}
+	}
+
+	// Land on the role's first accessible table, as a password login does
+	database, err := u.GetFirstAccessibleDatabase(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := u.GetFirstAccessibleSchema(ctx, role, database)
+	if err != nil {
+		return nil, err
+	}
+	table, err := u.GetFirstAccessibleTable(ctx, role, database, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// Single sign-on has no server selector, so it logs in to the default profile
+	profile, err := u.connectionProfile(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	session := &domain.Session{
+		Username: role,
+		Identity: identity.Subject,
+		Profile:  profile.Name,
+	}
+	servicePassword, err := u.servicePassword(ctx, profile, u.ssoConfig.ServicePassword)
+	if err != nil {
+		return nil, err
+	}
+	connString := roleConnectionString(profile, u.ssoConfig.ServiceUser, servicePassword, role, database)
+	return u.openSession(ctx, session, connString, database, schema, table)
+}
+
+// mapSSORole returns the role of the first mapping the identity matches, or "" when none does
+func mapSSORole(identity *domain.OIDCIdentity, mappings []domain.SSORoleMapping) string {
+	for _, mapping := range mappings {
+		if claimMatches(identity.Claims[mapping.Claim], mapping.Value) {
+			return mapping.Role
+		}
+	}
+	return ""
+}
+
+// claimMatches reports whether a claim equals value or, for list claims such as groups, contains it
+func claimMatches(claim interface{}, value string) bool {
+	switch v := claim.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		for _, item := range v {
+			if fmt.Sprint(item) == value {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(v) == value
+	}
+}
+
+func identityName(identity *domain.OIDCIdentity) string {
+	if identity.Email != "" {
+		return identity.Email
+	}
+	return identity.Subject
+}
+
+// roleConnectionString connects as the SSO or LDAP service user and switches to role for the whole
+// session, so privileges are checked against the mapped role without the user knowing any database
+// password; the repository keeps connections opened with it from leaving the role
+func roleConnectionString(profile domain.ConnectionProfile, serviceUser, servicePassword, role, database string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, " ", `\ `)
+	connURL := profileURL(profile, database, url.Values{"options": {"-c role=" + escaper.Replace(role)}})
+	connURL.User = url.UserPassword(serviceUser, servicePassword)
+	return connURL.String()
+}
@@ -0,0 +1,79 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) ListConnectionProfiles(ctx context.Context) ([]domain.ConnectionProfile, error) {
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connection profiles: %w", err)
+	}
+
+	if len(config.ConnectionProfiles) == 0 {
+		return []domain.ConnectionProfile{domain.DefaultConnectionProfile}, nil
+	}
+	return config.ConnectionProfiles, nil
+}
+
+// connectionProfile returns the profile named name, or the default profile when name is empty
+func (u *AuthenticationUseCaseImplementation) connectionProfile(ctx context.Context, name string) (domain.ConnectionProfile, error) {
+	profiles, err := u.ListConnectionProfiles(ctx)
+	if err != nil {
+		return domain.ConnectionProfile{}, err
+	}
+
+	if name == "" {
+		return profiles[0], nil
+	}
+	for _, profile := range profiles {
+		if profile.Name == name {
+			return profile, nil
+		}
+	}
+	return domain.ConnectionProfile{}, domain.ValidationError{
+		Field:   "profile",
+		Message: fmt.Sprintf("unknown connection profile: %s", name),
+	}
+}
+
+// servicePassword is the service user's password on the profile's server: the secret its reference
+// points to, or fallback when it has none
+func (u *AuthenticationUseCaseImplementation) servicePassword(ctx context.Context, profile domain.ConnectionProfile, fallback string) (string, error) {
+	if profile.ServicePasswordRef == "" {
+		return fallback, nil
+	}
+	if u.secretRepo == nil {
+		return "", fmt.Errorf("connection profile %s needs a secret provider to resolve its service password", profile.Name)
+	}
+	password, err := u.secretRepo.ResolveSecret(ctx, profile.ServicePasswordRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the service password of connection profile %s: %w", profile.Name, err)
+	}
+	return password, nil
+}
+
+// profileURL addresses database on the profile's server
+func profileURL(profile domain.ConnectionProfile, database string, query url.Values) url.URL {
+	port := profile.Port
+	if port == 0 {
+		port = domain.DefaultConnectionProfile.Port
+	}
+	sslMode := profile.SSLMode
+	if sslMode == "" {
+		sslMode = domain.DefaultConnectionProfile.SSLMode
+	}
+	query.Set("sslmode", sslMode)
+
+	return url.URL{
+		Scheme:   "postgres",
+		Host:     profile.Host + ":" + strconv.Itoa(port),
+		Path:     "/" + database,
+		RawQuery: query.Encode(),
+	}
+}
@@ -9,17 +9,79 @@ import (
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
-func (u *AuthenticationUseCaseImplementation) CreateSession(ctx context.Context, username, password, database, schema, table string) (*domain.Session, error) {
+func (u *AuthenticationUseCaseImplementation) CreateSession(ctx context.Context, profileName, username, password, database, schema, table string) (*domain.Session, error) {
+	profile, err := u.connectionProfile(ctx, profileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// LDAP logins authenticate again here so a session is never opened for an unverified password
+	if u.ldapAuth != nil {
+		role, err := u.ResolveLoginRole(ctx, username, password)
+		if err != nil {
+			return nil, err
+		}
+		session := &domain.Session{
+			Username: role,
+			Identity: username,
+			Profile:  profile.Name,
+		}
+		servicePassword, err := u.servicePassword(ctx, profile, u.ldapConfig.ServicePassword)
+		if err != nil {
+			return nil, err
+		}
+		connString := roleConnectionString(profile, u.ldapConfig.ServiceUser, servicePassword, role, database)
+		return u.openSession(ctx, session, connString, database, schema, table)
+	}
+
 	// Encrypt the password for storage
-	_, err := u.encryptionRepo.Encrypt(ctx, password)
+	encryptedPassword, err := u.encryptionRepo.Encrypt(ctx, password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encrypt password: %w", err)
 	}
 
-	// Verify user has access to the specified resources
+	session := &domain.Session{
+		Username:          username,
+		EncryptedPassword: encryptedPassword,
+		Profile:           profile.Name,
+	}
+	return u.openSession(ctx, session, connectionString(profile, username, password, database), database, schema, table)
+}
+
+// openSession opens the session's connection pool with connString, checks through it that the session's
+// user can reach the landing resources on the profile's server, then stores the session
+func (u *AuthenticationUseCaseImplementation) openSession(ctx context.Context, session *domain.Session, connString, database, schema, table string) (*domain.Session, error) {
+	session.ID = uuid.New().String()
+	session.Database = database
+
+	pool, err := u.databaseRepo.SessionPool(ctx, session.ID, connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection pool: %w", err)
+	}
+
+	if err := u.checkLandingAccess(domain.WithSessionConnection(ctx, pool), session.Username, database, schema, table); err != nil {
+		u.databaseRepo.CloseSessionPool(session.ID)
+		return nil, err
+	}
+
+	session.CreatedAt = time.Now()
+	session.ExpiresAt = time.Now().Add(24 * time.Hour) // 24-hour expiration
+
+	// Store session with encrypted password
+	err = u.sessionRepo.CreateSession(ctx, session)
+	if err != nil {
+		u.databaseRepo.CloseSessionPool(session.ID)
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return session, nil
+}
+
+// checkLandingAccess verifies the user has access to the database, schema and table a login lands on
+func (u *AuthenticationUseCaseImplementation) checkLandingAccess(ctx context.Context, username, database, schema, table string) error {
 	databases, err := u.rbacRepo.GetAccessibleDatabases(ctx, username)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get accessible databases: %w", err)
+		return fmt.Errorf("failed to get accessible databases: %w", err)
 	}
 
 	databaseAccessible := false
@@ -31,12 +93,12 @@ func (u *AuthenticationUseCaseImplementation) CreateSession(ctx context.Context,
 	}
 
 	if !databaseAccessible {
-		return nil, fmt.Errorf("user does not have access to database: %s", database)
+		return fmt.Errorf("user does not have access to database: %s", database)
 	}
 
 	schemas, err := u.rbacRepo.GetAccessibleSchemas(ctx, username, database)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get accessible schemas: %w", err)
+		return fmt.Errorf("failed to get accessible schemas: %w", err)
 	}
 
 	schemaAccessible := false
@@ -48,12 +110,12 @@ func (u *AuthenticationUseCaseImplementation) CreateSession(ctx context.Context,
 	}
 
 	if !schemaAccessible {
-		return nil, fmt.Errorf("user does not have access to schema: %s", schema)
+		return fmt.Errorf("user does not have access to schema: %s", schema)
 	}
 
 	tables, err := u.rbacRepo.GetAccessibleTables(ctx, username, database, schema)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get accessible tables: %w", err)
+		return fmt.Errorf("failed to get accessible tables: %w", err)
 	}
 
 	tableAccessible := false
@@ -65,22 +127,8 @@ func (u *AuthenticationUseCaseImplementation) CreateSession(ctx context.Context,
 	}
 
 	if !tableAccessible {
-		return nil, fmt.Errorf("user does not have access to table: %s", table)
+		return fmt.Errorf("user does not have access to table: %s", table)
 	}
 
-	// Create session with encrypted password stored
-	session := &domain.Session{
-		ID:        uuid.New().String(),
-		Username:  username,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(24 * time.Hour), // 24-hour expiration
-	}
-
-	// Store session with encrypted password
-	err = u.sessionRepo.CreateSession(ctx, session)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
-	}
-
-	return session, nil
+	return nil
 }
@@ -0,0 +1,167 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// discoverRoleMetadata lists what a role can access with each catalog query bounded by
+// MetadataQueryTimeout. Only the databases, the first database's schemas and its first schema's tables
+// are listed before it returns, enough to open the main view; the rest is listed in the background and
+// cached as it arrives, with Loading naming the stage still running.
+func (u *AuthenticationUseCaseImplementation) discoverRoleMetadata(ctx context.Context, role string) (*domain.RoleMetadata, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, domain.MetadataQueryTimeout)
+	databases, err := u.rbacRepo.GetAccessibleDatabases(queryCtx, role)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accessible databases: %w", err)
+	}
+
+	metadata := &domain.RoleMetadata{Name: role, AccessibleDatabases: databases}
+	if len(databases) == 0 {
+		u.storeRoleMetadata(ctx, metadata)
+		return metadata, nil
+	}
+
+	schemas := u.listAccessibleSchemas(ctx, metadata, databases[0])
+	if len(schemas) > 0 {
+		u.listAccessibleTables(ctx, metadata, databases[0], schemas[0])
+	}
+	metadata.Loading = domain.MetadataStageSchemas
+	u.storeRoleMetadata(ctx, metadata)
+
+	// The login's request ends long before a large catalog is listed
+	pending := make([]accessibleSchema, 0, len(schemas))
+	for i := 1; i < len(schemas); i++ {
+		pending = append(pending, accessibleSchema{database: databases[0], schema: schemas[i]})
+	}
+	go u.completeRoleMetadata(context.WithoutCancel(ctx), cloneRoleMetadata(metadata), pending)
+
+	return metadata, nil
+}
+
+// accessibleSchema is a schema whose tables are still to be listed
+type accessibleSchema struct {
+	database string
+	schema   string
+}
+
+// completeRoleMetadata lists the schemas of the remaining databases, then the tables of every schema
+// not yet listed, caching the metadata after each query so the data explorer fills in as it goes
+func (u *AuthenticationUseCaseImplementation) completeRoleMetadata(ctx context.Context, metadata *domain.RoleMetadata, pending []accessibleSchema) {
+	for _, database := range metadata.AccessibleDatabases[1:] {
+		for _, schema := range u.listAccessibleSchemas(ctx, metadata, database) {
+			pending = append(pending, accessibleSchema{database: database, schema: schema})
+		}
+		u.storeRoleMetadata(ctx, metadata)
+	}
+
+	u.listPendingTables(ctx, metadata, pending)
+}
+
+// listPendingTables lists the tables of the pending schemas, caching the metadata after each query,
+// and marks discovery done
+func (u *AuthenticationUseCaseImplementation) listPendingTables(ctx context.Context, metadata *domain.RoleMetadata, pending []accessibleSchema) {
+	metadata.Loading = domain.MetadataStageTables
+	u.storeRoleMetadata(ctx, metadata)
+	for _, schema := range pending {
+		u.listAccessibleTables(ctx, metadata, schema.database, schema.schema)
+		u.storeRoleMetadata(ctx, metadata)
+	}
+
+	metadata.Loading = ""
+	u.storeRoleMetadata(ctx, metadata)
+}
+
+// retryIncompleteMetadata lists the databases and schemas discovery marked incomplete again in the
+// background, returning the cached metadata with them cleared and Loading naming the stage running
+func (u *AuthenticationUseCaseImplementation) retryIncompleteMetadata(ctx context.Context, cached *domain.RoleMetadata) *domain.RoleMetadata {
+	metadata := cloneRoleMetadata(cached)
+	incomplete := metadata.Incomplete
+	metadata.Incomplete = nil
+
+	// Entries naming a database had its schemas time out; the rest are "database.schema" pairs
+	var databases []string
+	var pending []accessibleSchema
+	for _, entry := range incomplete {
+		if slices.Contains(metadata.AccessibleDatabases, entry) {
+			databases = append(databases, entry)
+			continue
+		}
+		for _, database := range metadata.AccessibleDatabases {
+			if schema, ok := strings.CutPrefix(entry, database+"."); ok {
+				pending = append(pending, accessibleSchema{database: database, schema: schema})
+				break
+			}
+		}
+	}
+
+	metadata.Loading = domain.MetadataStageTables
+	if len(databases) > 0 {
+		metadata.Loading = domain.MetadataStageSchemas
+	}
+	u.storeRoleMetadata(ctx, metadata)
+
+	go func(ctx context.Context, metadata *domain.RoleMetadata) {
+		for _, database := range databases {
+			for _, schema := range u.listAccessibleSchemas(ctx, metadata, database) {
+				pending = append(pending, accessibleSchema{database: database, schema: schema})
+			}
+		}
+		u.listPendingTables(ctx, metadata, pending)
+	}(context.WithoutCancel(ctx), cloneRoleMetadata(metadata))
+
+	return metadata
+}
+
+// listAccessibleSchemas adds a database's schemas to the metadata and returns them; a database that
+// cannot be listed in time is marked incomplete instead of failing discovery
+func (u *AuthenticationUseCaseImplementation) listAccessibleSchemas(ctx context.Context, metadata *domain.RoleMetadata, database string) []string {
+	queryCtx, cancel := context.WithTimeout(ctx, domain.MetadataQueryTimeout)
+	defer cancel()
+
+	schemas, err := u.rbacRepo.GetAccessibleSchemas(queryCtx, metadata.Name, database)
+	if err != nil {
+		metadata.Incomplete = append(metadata.Incomplete, database)
+		return nil
+	}
+	for _, schema := range schemas {
+		if !slices.Contains(metadata.AccessibleSchemas, schema) {
+			metadata.AccessibleSchemas = append(metadata.AccessibleSchemas, schema)
+		}
+	}
+	return schemas
+}
+
+// listAccessibleTables adds a schema's tables to the metadata; a schema that cannot be listed in time
+// is marked incomplete instead of failing discovery
+func (u *AuthenticationUseCaseImplementation) listAccessibleTables(ctx context.Context, metadata *domain.RoleMetadata, database, schema string) {
+	queryCtx, cancel := context.WithTimeout(ctx, domain.MetadataQueryTimeout)
+	defer cancel()
+
+	tables, err := u.rbacRepo.GetAccessibleTables(queryCtx, metadata.Name, database, schema)
+	if err != nil {
+		metadata.Incomplete = append(metadata.Incomplete, database+"."+schema)
+		return
+	}
+	metadata.AccessibleTables = append(metadata.AccessibleTables, tables...)
+}
+
+// storeRoleMetadata caches a copy of the metadata, which discovery goes on filling in; a failed store
+// only means the next request discovers the role again
+func (u *AuthenticationUseCaseImplementation) storeRoleMetadata(ctx context.Context, metadata *domain.RoleMetadata) {
+	_ = u.metadataRepo.StoreRoleMetadata(ctx, metadata.Name, cloneRoleMetadata(metadata))
+}
+
+func cloneRoleMetadata(metadata *domain.RoleMetadata) *domain.RoleMetadata {
+	clone := *metadata
+	clone.AccessibleDatabases = slices.Clone(metadata.AccessibleDatabases)
+	clone.AccessibleSchemas = slices.Clone(metadata.AccessibleSchemas)
+	clone.AccessibleTables = slices.Clone(metadata.AccessibleTables)
+	clone.Incomplete = slices.Clone(metadata.Incomplete)
+	return &clone
+}
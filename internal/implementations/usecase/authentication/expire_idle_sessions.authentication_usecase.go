@@ -0,0 +1,87 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) ExpireIdleSessions(ctx context.Context) (int, error) {
+	if u.timeoutConfig.IdleTimeout <= 0 {
+		return 0, nil
+	}
+
+	sessions, err := u.sessionRepo.ListSessions(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	now := time.Now()
+	expired := 0
+	for _, session := range sessions {
+		if !u.isIdle(session, now) {
+			continue
+		}
+		if err := u.expireSession(ctx, session); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// isIdle reports whether a session has gone unused for longer than the idle timeout
+func (u *AuthenticationUseCaseImplementation) isIdle(session *domain.Session, now time.Time) bool {
+	if u.timeoutConfig.IdleTimeout <= 0 {
+		return false
+	}
+	lastActivity := session.LastActivityAt
+	if lastActivity.IsZero() {
+		lastActivity = session.CreatedAt
+	}
+	return now.Sub(lastActivity) > u.timeoutConfig.IdleTimeout
+}
+
+// activityResolution is how stale a session's recorded activity may get before it is written again;
+// short idle timeouts need finer records so an active session is never mistaken for an idle one
+func (u *AuthenticationUseCaseImplementation) activityResolution() time.Duration {
+	resolution := domain.SessionActivityResolution
+	if quarter := u.timeoutConfig.IdleTimeout / 4; quarter > 0 && quarter < resolution {
+		resolution = quarter
+	}
+	return resolution
+}
+
+// expireSession destroys a session and its connections, rolling back its user's open transaction
+// unless the user is still working in another session
+func (u *AuthenticationUseCaseImplementation) expireSession(ctx context.Context, session *domain.Session) error {
+	if err := u.sessionRepo.DeleteSession(ctx, session.ID); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if err := u.databaseRepo.CloseSessionPool(session.ID); err != nil {
+		return fmt.Errorf("failed to close connection pool: %w", err)
+	}
+
+	// Transactions are buffered per user, so a live session of the same user keeps it open
+	if other, err := u.sessionRepo.GetSessionByUsername(ctx, session.Username); err == nil && other != nil {
+		return nil
+	}
+
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, session.Username)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoActiveTransaction) {
+			return nil
+		}
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if txn == nil {
+		return nil
+	}
+	if err := u.transactionRepo.DeleteTransaction(ctx, txn.ID); err != nil {
+		return fmt.Errorf("failed to roll back transaction: %w", err)
+	}
+	return nil
+}
@@ -7,5 +7,15 @@ import (
 )
 
 func (u *AuthenticationUseCaseImplementation) GetUserAccessibleResources(ctx context.Context, username string) (*domain.RoleMetadata, error) {
-	return u.metadataRepo.GetRoleMetadata(ctx, username)
+	// Cached metadata is served as is, even while discovery is still filling it in; once discovery is
+	// done, what it could not list in time is listed again
+	metadata, err := u.metadataRepo.GetRoleMetadata(ctx, username)
+	if err == nil && metadata != nil {
+		if metadata.Loading == "" && len(metadata.Incomplete) > 0 {
+			return u.retryIncompleteMetadata(ctx, metadata), nil
+		}
+		return metadata, nil
+	}
+
+	return u.discoverRoleMetadata(ctx, username)
 }
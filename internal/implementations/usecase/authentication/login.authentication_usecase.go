@@ -18,13 +18,18 @@ func (u *AuthenticationUseCaseImplementation) Login(ctx context.Context, req dom
 	}
 
 	// Probe the connection with the provided credentials
-	success, err := u.ProbeConnection(ctx, req.Username, req.Password)
+	success, err := u.ProbeConnection(ctx, req.Profile, req.Username, req.Password)
 	if err != nil || !success {
 		return &domain.LoginResponse{Success: false, Message: "invalid credentials"}, err
 	}
 
+	role, err := u.ResolveLoginRole(ctx, req.Username, req.Password)
+	if err != nil {
+		return &domain.LoginResponse{Success: false, Message: err.Error()}, err
+	}
+
 	// Check if the user has accessible databases
-	databases, err := u.rbacRepo.GetAccessibleDatabases(ctx, req.Username)
+	databases, err := u.rbacRepo.GetAccessibleDatabases(ctx, role)
 	if err != nil {
 		return &domain.LoginResponse{Success: false, Message: err.Error()}, err
 	}
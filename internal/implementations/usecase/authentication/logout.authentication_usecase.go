@@ -10,5 +10,10 @@ func (u *AuthenticationUseCaseImplementation) Logout(ctx context.Context, sessio
 	if err != nil {
 		return fmt.Errorf("failed to delete session: %w", err)
 	}
+
+	err = u.databaseRepo.CloseSessionPool(sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to close connection pool: %w", err)
+	}
 	return nil
 }
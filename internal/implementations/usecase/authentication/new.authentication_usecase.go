@@ -1,6 +1,7 @@
 package authentication
 
 import (
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
 )
@@ -11,6 +12,19 @@ type AuthenticationUseCaseImplementation struct {
 	sessionRepo    repository.SessionRepository
 	rbacRepo       repository.RBACRepository
 	encryptionRepo repository.EncryptionRepository
+	// oidcRepo is nil when single sign-on is not configured
+	oidcRepo  repository.OIDCRepository
+	ssoConfig domain.SSOConfig
+	// ldapAuth is nil unless LDAP is the password backend
+	ldapAuth   repository.LDAPAuthenticator
+	ldapConfig domain.LDAPConfig
+	// transactionRepo is used to roll back the open transaction of a session destroyed for idling
+	transactionRepo repository.TransactionRepository
+	timeoutConfig   domain.SessionTimeoutConfig
+	// configRepo holds the connection profiles sessions may log in to
+	configRepo repository.ConfigRepository
+	// secretRepo resolves connection profiles' service password references; nil leaves them unresolvable
+	secretRepo repository.SecretRepository
 }
 
 func NewAuthenticationUseCaseImplementation(
@@ -19,12 +33,28 @@ func NewAuthenticationUseCaseImplementation(
 	sessionRepo repository.SessionRepository,
 	rbacRepo repository.RBACRepository,
 	encryptionRepo repository.EncryptionRepository,
+	oidcRepo repository.OIDCRepository,
+	ssoConfig domain.SSOConfig,
+	ldapAuth repository.LDAPAuthenticator,
+	ldapConfig domain.LDAPConfig,
+	transactionRepo repository.TransactionRepository,
+	timeoutConfig domain.SessionTimeoutConfig,
+	configRepo repository.ConfigRepository,
+	secretRepo repository.SecretRepository,
 ) usecase.AuthenticationUseCase {
 	return &AuthenticationUseCaseImplementation{
-		databaseRepo:   databaseRepo,
-		metadataRepo:   metadataRepo,
-		sessionRepo:    sessionRepo,
-		rbacRepo:       rbacRepo,
-		encryptionRepo: encryptionRepo,
+		databaseRepo:    databaseRepo,
+		metadataRepo:    metadataRepo,
+		sessionRepo:     sessionRepo,
+		rbacRepo:        rbacRepo,
+		encryptionRepo:  encryptionRepo,
+		oidcRepo:        oidcRepo,
+		ssoConfig:       ssoConfig,
+		ldapAuth:        ldapAuth,
+		ldapConfig:      ldapConfig,
+		transactionRepo: transactionRepo,
+		timeoutConfig:   timeoutConfig,
+		configRepo:      configRepo,
+		secretRepo:      secretRepo,
 	}
 }
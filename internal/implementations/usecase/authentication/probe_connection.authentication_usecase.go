@@ -2,17 +2,50 @@ package authentication
 
 import (
 	"context"
-	"fmt"
+	"net/url"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
-func (u *AuthenticationUseCaseImplementation) ProbeConnection(ctx context.Context, username, password string) (bool, error) {
-	// Build a connection string from username and password
-	// Use default host and port
-	connString := fmt.Sprintf("postgres://%s:%s@localhost:5432/postgres?sslmode=disable", username, password)
+func (u *AuthenticationUseCaseImplementation) ProbeConnection(ctx context.Context, profileName, username, password string) (bool, error) {
+	profile, err := u.connectionProfile(ctx, profileName)
+	if err != nil {
+		return false, err
+	}
 
-	err := u.databaseRepo.TestConnection(ctx, connString)
+	connString, err := u.loginConnectionString(ctx, profile, username, password, "postgres")
+	if err != nil {
+		return false, err
+	}
+
+	err = u.databaseRepo.TestConnection(ctx, connString)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
+
+// loginConnectionString builds the connection string a login uses on the profile's server: the user's
+// own credentials, or with the LDAP backend the service user switching to the mapped role
+func (u *AuthenticationUseCaseImplementation) loginConnectionString(ctx context.Context, profile domain.ConnectionProfile, username, password, database string) (string, error) {
+	if u.ldapAuth == nil {
+		return connectionString(profile, username, password, database), nil
+	}
+
+	role, err := u.ResolveLoginRole(ctx, username, password)
+	if err != nil {
+		return "", err
+	}
+	servicePassword, err := u.servicePassword(ctx, profile, u.ldapConfig.ServicePassword)
+	if err != nil {
+		return "", err
+	}
+	return roleConnectionString(profile, u.ldapConfig.ServiceUser, servicePassword, role, database), nil
+}
+
+// connectionString builds a connection string for the user's credentials on the profile's server
+func connectionString(profile domain.ConnectionProfile, username, password, database string) string {
+	connURL := profileURL(profile, database, url.Values{})
+	connURL.User = url.UserPassword(username, password)
+	return connURL.String()
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 )
 
-func (u *AuthenticationUseCaseImplementation) ReAuthenticateWithPassword(ctx context.Context, username, encryptedPassword string) (bool, error) {
+func (u *AuthenticationUseCaseImplementation) ReAuthenticateWithPassword(ctx context.Context, profileName, username, encryptedPassword string) (bool, error) {
 	// Decrypt the password
 	decryptedPassword, err := u.encryptionRepo.Decrypt(ctx, encryptedPassword)
 	if err != nil {
@@ -13,7 +13,7 @@ func (u *AuthenticationUseCaseImplementation) ReAuthenticateWithPassword(ctx con
 	}
 
 	// Test the connection with the decrypted password
-	success, err := u.ProbeConnection(ctx, username, decryptedPassword)
+	success, err := u.ProbeConnection(ctx, profileName, username, decryptedPassword)
 	if err != nil || !success {
 		return false, err
 	}
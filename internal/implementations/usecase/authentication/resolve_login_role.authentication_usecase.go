@@ -0,0 +1,40 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) ResolveLoginRole(ctx context.Context, username, password string) (string, error) {
+	// Without LDAP the login name is the PostgreSQL role
+	if u.ldapAuth == nil {
+		return username, nil
+	}
+
+	entry, err := u.ldapAuth.Authenticate(ctx, username, password)
+	if err != nil {
+		return "", err
+	}
+
+	role := mapLDAPRole(entry, u.ldapConfig.RoleMappings)
+	if role == "" {
+		return "", domain.ValidationError{Field: "permission", Message: fmt.Sprintf("no database role is mapped to %s", username)}
+	}
+	return role, nil
+}
+
+// mapLDAPRole returns the role of the first mapping the entry's attributes match, or "" when none does.
+// Attribute names and values are compared case-insensitively, as LDAP compares names and DNs.
+func mapLDAPRole(entry *domain.LDAPEntry, mappings []domain.SSORoleMapping) string {
+	for _, mapping := range mappings {
+		for _, value := range entry.Attributes[strings.ToLower(mapping.Claim)] {
+			if strings.EqualFold(value, mapping.Value) {
+				return mapping.Role
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) SetSessionSearchPath(ctx context.Context, sessionID string, schemas []string) (*domain.Session, error) {
+	// Validate the session first
+	session, err := u.sessionRepo.ValidateSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate session: %w", err)
+	}
+
+	if session == nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	// Keep the order given, dropping blanks and duplicates
+	searchPath := make([]string, 0, len(schemas))
+	seen := make(map[string]bool, len(schemas))
+	for _, schema := range schemas {
+		schema = strings.TrimSpace(schema)
+		if schema == "" || seen[schema] {
+			continue
+		}
+		seen[schema] = true
+
+		// Only schemas the user can use may appear on the search path
+		hasUsage, err := u.rbacRepo.HasSchemaUsagePermission(ctx, session.Username, "", schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check schema permission: %w", err)
+		}
+		if !hasUsage {
+			return nil, domain.ValidationError{Field: "search_path", Message: fmt.Sprintf("schema %s is not accessible", schema)}
+		}
+
+		searchPath = append(searchPath, schema)
+	}
+
+	session.SearchPath = searchPath
+	if err := u.sessionRepo.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return session, nil
+}
@@ -0,0 +1,32 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *AuthenticationUseCaseImplementation) SetSessionSettings(ctx context.Context, sessionID string, settings domain.SessionSettings) (*domain.Session, error) {
+	// Validate the session first
+	session, err := u.sessionRepo.ValidateSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate session: %w", err)
+	}
+
+	if session == nil {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	settings, err = settings.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	session.Settings = settings
+	if err := u.sessionRepo.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return session, nil
+}
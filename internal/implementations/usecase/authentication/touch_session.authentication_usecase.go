@@ -0,0 +1,24 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (u *AuthenticationUseCaseImplementation) TouchSession(ctx context.Context, sessionID, clientIP string) error {
+	session, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	session.LastActivityAt = time.Now()
+	if clientIP != "" {
+		session.ClientIP = clientIP
+	}
+
+	if err := u.sessionRepo.UpdateSession(ctx, session); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package authentication
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
@@ -17,5 +18,20 @@ func (u *AuthenticationUseCaseImplementation) ValidateSession(ctx context.Contex
 		return nil, fmt.Errorf("session not found")
 	}
 
+	// Destroy sessions left idle past the configured timeout
+	if u.isIdle(session, time.Now()) {
+		if err := u.expireSession(ctx, session); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrSessionExpired
+	}
+
+	// Record activity for the idle timeout and the admin session list; a failed write must not fail
+	// the request it rides on
+	if time.Since(session.LastActivityAt) >= u.activityResolution() {
+		session.LastActivityAt = time.Now()
+		_ = u.sessionRepo.UpdateSession(ctx, session)
+	}
+
 	return session, nil
 }
@@ -0,0 +1,11 @@
+package dashboard
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DashboardUseCaseImplementation) ListDashboardTiles(ctx context.Context, username string) ([]domain.DashboardTile, error) {
+	return u.loadDashboardTiles(ctx, username)
+}
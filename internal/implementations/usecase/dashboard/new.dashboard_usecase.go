@@ -0,0 +1,28 @@
+package dashboard
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type DashboardUseCaseImplementation struct {
+	// preferenceRepo keeps each user's tiles
+	preferenceRepo repository.PreferenceRepository
+	savedQueryRepo repository.SavedQueryRepository
+	databaseRepo   repository.DatabaseRepository
+	rbacRepo       repository.RBACRepository
+}
+
+func NewDashboardUseCaseImplementation(
+	preferenceRepo repository.PreferenceRepository,
+	savedQueryRepo repository.SavedQueryRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+) usecase.DashboardUseCase {
+	return &DashboardUseCaseImplementation{
+		preferenceRepo: preferenceRepo,
+		savedQueryRepo: savedQueryRepo,
+		databaseRepo:   databaseRepo,
+		rbacRepo:       rbacRepo,
+	}
+}
@@ -0,0 +1,85 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DashboardUseCaseImplementation) PinQueryResult(ctx context.Context, username string, tile domain.DashboardTile) (*domain.DashboardTile, error) {
+	if tile.SavedQueryID == "" {
+		return nil, domain.ValidationError{Field: "saved_query_id", Message: "a saved query is required"}
+	}
+	switch tile.Kind {
+	case "":
+		tile.Kind = domain.DashboardTileRowCount
+	case domain.DashboardTileRowCount, domain.DashboardTileScalar:
+	default:
+		return nil, domain.ValidationError{Field: "kind", Message: "tile kind must be row_count or scalar"}
+	}
+	tile.RefreshSeconds = clampRefreshInterval(tile.RefreshSeconds)
+
+	saved, err := u.savedQueryRepo.GetSavedQuery(ctx, username, tile.SavedQueryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPinnableQuery(saved); err != nil {
+		return nil, err
+	}
+
+	tile.Title = strings.TrimSpace(tile.Title)
+	if tile.Title == "" {
+		tile.Title = saved.Name
+	}
+	if utf8.RuneCountInString(tile.Title) > domain.DashboardTileTitleMaxLength {
+		return nil, domain.ValidationError{Field: "title", Message: fmt.Sprintf("tile title is longer than %d characters", domain.DashboardTileTitleMaxLength)}
+	}
+
+	tiles, err := u.loadDashboardTiles(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if len(tiles) >= domain.DashboardMaxTiles {
+		return nil, domain.ValidationError{Field: "tiles", Message: fmt.Sprintf("a dashboard holds at most %d tiles", domain.DashboardMaxTiles)}
+	}
+
+	tile.ID = "tile_" + uuid.New().String()
+	tile.CreatedAt = time.Now()
+	if err := u.storeDashboardTiles(ctx, username, append(tiles, tile)); err != nil {
+		return nil, err
+	}
+	return &tile, nil
+}
+
+// clampRefreshInterval keeps a tile's refresh interval within the live refresh bounds, defaulting
+// when none was given
+func clampRefreshInterval(seconds int) int {
+	switch {
+	case seconds <= 0:
+		return domain.DashboardTileDefaultRefresh
+	case seconds < domain.LiveRefreshMinInterval:
+		return domain.LiveRefreshMinInterval
+	case seconds > domain.LiveRefreshMaxInterval:
+		return domain.LiveRefreshMaxInterval
+	default:
+		return seconds
+	}
+}
+
+// checkPinnableQuery refuses saved queries a tile cannot re-run on its own: anything but a SELECT,
+// and templates whose variables would need values
+func checkPinnableQuery(saved *domain.SavedQuery) error {
+	fields := strings.Fields(saved.Query)
+	if len(fields) == 0 || !strings.EqualFold(fields[0], "SELECT") {
+		return domain.ValidationError{Field: "query", Message: "only SELECT queries can be pinned"}
+	}
+	if len(saved.Variables) > 0 {
+		return domain.ValidationError{Field: "query", Message: "queries with template variables cannot be pinned"}
+	}
+	return nil
+}
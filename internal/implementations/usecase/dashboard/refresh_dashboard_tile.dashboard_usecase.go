@@ -0,0 +1,60 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DashboardUseCaseImplementation) RefreshDashboardTile(ctx context.Context, username, tileID string) (*domain.DashboardTileResult, error) {
+	tiles, err := u.loadDashboardTiles(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	index, err := findDashboardTile(tiles, tileID)
+	if err != nil {
+		return nil, err
+	}
+	tile := tiles[index]
+
+	// The saved query is read again, so the tile follows edits to it
+	saved, err := u.savedQueryRepo.GetSavedQuery(ctx, username, tile.SavedQueryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPinnableQuery(saved); err != nil {
+		return nil, err
+	}
+
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check permissions: %w", err)
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"}
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, domain.DashboardTileQueryTimeout)
+	defer cancel()
+	result, err := u.databaseRepo.ExecuteTrackedQuery(queryCtx, username, saved.Query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	summary := &domain.DashboardTileResult{
+		TileID:      tile.ID,
+		RowCount:    len(result.Rows),
+		RefreshedAt: time.Now(),
+	}
+	if tile.Kind == domain.DashboardTileScalar {
+		if len(result.Columns) != 1 || len(result.Rows) > 1 {
+			return nil, domain.ValidationError{Field: "query", Message: "a scalar tile's query must return a single column and at most one row"}
+		}
+		if len(result.Rows) == 1 {
+			summary.Value = result.Rows[0][result.Columns[0]]
+		}
+	}
+	return summary, nil
+}
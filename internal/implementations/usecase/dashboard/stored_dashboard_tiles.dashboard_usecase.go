@@ -0,0 +1,90 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// storedDashboardTile is the JSON form a tile is kept in, all of a user's tiles under one preference
+type storedDashboardTile struct {
+	ID             string    `json:"id"`
+	SavedQueryID   string    `json:"saved_query_id"`
+	Title          string    `json:"title"`
+	Kind           string    `json:"kind"`
+	RefreshSeconds int       `json:"refresh_seconds"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// loadDashboardTiles reads the user's tiles, in the order they were pinned
+func (u *DashboardUseCaseImplementation) loadDashboardTiles(ctx context.Context, username string) ([]domain.DashboardTile, error) {
+	preferences, err := u.preferenceRepo.GetPreferences(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dashboard: %w", err)
+	}
+	value, ok := preferences[domain.DashboardTilesPreferenceKey]
+	if !ok {
+		return []domain.DashboardTile{}, nil
+	}
+
+	var stored []storedDashboardTile
+	if err := json.Unmarshal([]byte(value), &stored); err != nil {
+		return nil, fmt.Errorf("failed to read dashboard: %w", err)
+	}
+
+	tiles := make([]domain.DashboardTile, len(stored))
+	for i, tile := range stored {
+		tiles[i] = domain.DashboardTile{
+			ID:             tile.ID,
+			SavedQueryID:   tile.SavedQueryID,
+			Title:          tile.Title,
+			Kind:           tile.Kind,
+			RefreshSeconds: tile.RefreshSeconds,
+			CreatedAt:      tile.CreatedAt,
+		}
+	}
+	return tiles, nil
+}
+
+// storeDashboardTiles replaces the user's tiles; with none left the preference is removed
+func (u *DashboardUseCaseImplementation) storeDashboardTiles(ctx context.Context, username string, tiles []domain.DashboardTile) error {
+	if len(tiles) == 0 {
+		if err := u.preferenceRepo.DeletePreference(ctx, username, domain.DashboardTilesPreferenceKey); err != nil {
+			return fmt.Errorf("failed to save dashboard: %w", err)
+		}
+		return nil
+	}
+
+	stored := make([]storedDashboardTile, len(tiles))
+	for i, tile := range tiles {
+		stored[i] = storedDashboardTile{
+			ID:             tile.ID,
+			SavedQueryID:   tile.SavedQueryID,
+			Title:          tile.Title,
+			Kind:           tile.Kind,
+			RefreshSeconds: tile.RefreshSeconds,
+			CreatedAt:      tile.CreatedAt,
+		}
+	}
+	value, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to encode dashboard: %w", err)
+	}
+	if err := u.preferenceRepo.SetPreference(ctx, username, domain.DashboardTilesPreferenceKey, string(value)); err != nil {
+		return fmt.Errorf("failed to save dashboard: %w", err)
+	}
+	return nil
+}
+
+// findDashboardTile returns the index of the tile, or ErrDashboardTileNotFound
+func findDashboardTile(tiles []domain.DashboardTile, tileID string) (int, error) {
+	for i, tile := range tiles {
+		if tile.ID == tileID {
+			return i, nil
+		}
+	}
+	return 0, domain.ErrDashboardTileNotFound
+}
@@ -0,0 +1,11 @@
+package dashboard
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestDashboardUsecase(t *testing.T) {
+	testRunner.DashboardUsecaseRunner(t, NewDashboardUseCaseImplementation)
+}
@@ -0,0 +1,19 @@
+package dashboard
+
+import (
+	"context"
+	"slices"
+)
+
+func (u *DashboardUseCaseImplementation) UnpinDashboardTile(ctx context.Context, username, tileID string) error {
+	tiles, err := u.loadDashboardTiles(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	index, err := findDashboardTile(tiles, tileID)
+	if err != nil {
+		return err
+	}
+	return u.storeDashboardTiles(ctx, username, slices.Delete(tiles, index, index+1))
+}
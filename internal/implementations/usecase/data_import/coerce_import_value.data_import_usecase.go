@@ -0,0 +1,110 @@
+package data_import
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// importTimestampLayouts are the timestamp formats accepted in CSV files
+var importTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// coerceImportValue converts a CSV field to the Go value COPY sends for the column, rejecting fields
+// that do not fit its type; empty fields become NULL
+func coerceImportValue(raw string, column *domain.ColumnMetadata) (interface{}, error) {
+	if raw == "" {
+		if !column.IsNullable {
+			return nil, fmt.Errorf("value is required")
+		}
+		return nil, nil
+	}
+
+	dataType := strings.ToLower(strings.TrimSpace(column.DataType))
+	trimmed := strings.TrimSpace(raw)
+	switch {
+	case isIntegerType(dataType):
+		value, err := strconv.ParseInt(trimmed, 10, integerBits(dataType))
+		if errors.Is(err, strconv.ErrRange) {
+			return nil, fmt.Errorf("%q is out of range for %s", raw, column.DataType)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", raw, column.DataType)
+		}
+		return value, nil
+	case isDecimalType(dataType):
+		// Keep the text so numeric values load without float rounding
+		if _, err := strconv.ParseFloat(trimmed, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", raw, column.DataType)
+		}
+		return trimmed, nil
+	case dataType == "boolean" || dataType == "bool":
+		value, err := parseImportBool(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", raw, column.DataType)
+		}
+		return value, nil
+	case dataType == "date":
+		value, err := time.Parse("2006-01-02", trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid date (YYYY-MM-DD)", raw)
+		}
+		return value, nil
+	case strings.HasPrefix(dataType, "timestamp"):
+		for _, layout := range importTimestampLayouts {
+			if value, err := time.Parse(layout, trimmed); err == nil {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a valid timestamp", raw)
+	default:
+		return raw, nil
+	}
+}
+
+func isIntegerType(dataType string) bool {
+	switch dataType {
+	case "smallint", "integer", "bigint", "int", "int2", "int4", "int8", "smallserial", "serial", "bigserial":
+		return true
+	}
+	return false
+}
+
+// integerBits is the size of an integer type, so values that overflow the column are caught before COPY
+func integerBits(dataType string) int {
+	switch dataType {
+	case "smallint", "int2", "smallserial":
+		return 16
+	case "integer", "int", "int4", "serial":
+		return 32
+	}
+	return 64
+}
+
+func isDecimalType(dataType string) bool {
+	switch dataType {
+	case "decimal", "numeric", "real", "double precision", "float4", "float8":
+		return true
+	}
+	return strings.HasPrefix(dataType, "numeric(") || strings.HasPrefix(dataType, "decimal(")
+}
+
+func parseImportBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "y", "on":
+		return true, nil
+	case "no", "n", "off":
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
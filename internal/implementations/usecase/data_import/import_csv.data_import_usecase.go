@@ -0,0 +1,64 @@
+package data_import
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ImportUseCaseImplementation) ImportCSV(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportResult, error) {
+	// Loading rows is a write, so maintenance mode refuses it to everyone but superusers
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.MaintenanceRefusal(time.Time{}, func() (bool, error) {
+		return u.rbacRepo.IsSuperuser(ctx, username)
+	}); err != nil {
+		return nil, err
+	}
+
+	parsed, err := u.parseCSVImport(ctx, username, params, csvFile, domain.ImportMaxErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing is loaded unless every row coerces cleanly
+	if len(parsed.errors) > 0 {
+		first := parsed.errors[0]
+		location := fmt.Sprintf("line %d", first.Line)
+		if first.Column != "" {
+			location += ", column " + first.Column
+		}
+		return nil, domain.ValidationError{
+			Field:   "file",
+			Message: fmt.Sprintf("%d invalid values, first at %s: %s", parsed.errorCount, location, first.Message),
+		}
+	}
+	if parsed.rowCount == 0 {
+		return nil, domain.ValidationError{Field: "file", Message: "CSV file has no data rows"}
+	}
+
+	// Track the load under the username so it can be cancelled like any other query
+	imported, err := u.databaseRepo.CopyRows(ctx, username, params.Schema, params.Table, parsed.columns, parsed.rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Imported rows have no grid position, so the entry records the columns and row count loaded
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionImport,
+		Database: params.Database,
+		Schema:   params.Schema,
+		Table:    params.Table,
+		After:    map[string]interface{}{"columns": parsed.columns, "rows": imported},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.ImportResult{RowsImported: imported}, nil
+}
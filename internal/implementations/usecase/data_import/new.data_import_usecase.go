@@ -0,0 +1,30 @@
+package data_import
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type ImportUseCaseImplementation struct {
+	metadataRepo repository.MetadataRepository
+	databaseRepo repository.DatabaseRepository
+	rbacRepo     repository.RBACRepository
+	configRepo   repository.ConfigRepository
+	auditRepo    repository.AuditRepository
+}
+
+func NewImportUseCaseImplementation(
+	metadataRepo repository.MetadataRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	auditRepo repository.AuditRepository,
+) usecase.ImportUseCase {
+	return &ImportUseCaseImplementation{
+		metadataRepo: metadataRepo,
+		databaseRepo: databaseRepo,
+		rbacRepo:     rbacRepo,
+		configRepo:   configRepo,
+		auditRepo:    auditRepo,
+	}
+}
@@ -0,0 +1,158 @@
+package data_import
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// csvImport holds a CSV file mapped onto a table and coerced to its column types
+type csvImport struct {
+	mappings []domain.ImportColumnMapping
+	// columns are the mapped table columns, in the order of the values in rows
+	columns    []string
+	rows       [][]interface{}
+	rowCount   int
+	errors     []domain.ImportRowError
+	errorCount int
+	// invalidRows counts the rows left out of rows for holding an error
+	invalidRows int
+	// maxErrors caps the errors kept; errorCount keeps counting past it
+	maxErrors int
+}
+
+// parseCSVImport checks the user may insert into the table, maps the CSV header onto its columns and
+// coerces every row, collecting up to maxErrors of the values that do not fit
+func (u *ImportUseCaseImplementation) parseCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader, maxErrors int) (*csvImport, error) {
+	hasPermission, err := u.rbacRepo.HasInsertPermission(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have INSERT permission on this table",
+		}
+	}
+
+	metadata, err := u.metadataRepo.GetMetadata(ctx, params.Database)
+	if err != nil {
+		return nil, err
+	}
+	tableMetadata, err := metadata.FindTable(params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(csvFile)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, domain.ValidationError{Field: "file", Message: "CSV file is empty"}
+	}
+	if err != nil {
+		return nil, domain.ValidationError{Field: "file", Message: "invalid CSV: " + err.Error()}
+	}
+
+	parsed := &csvImport{maxErrors: maxErrors}
+	var targets []*domain.ColumnMetadata
+	var sources []int
+	seen := make(map[string]string)
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		// Spreadsheet exports often start with a byte order mark
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+
+		mapping := domain.ImportColumnMapping{CSVColumn: name}
+		target, mapped := params.Mapping[name]
+		if !mapped {
+			target = name
+		}
+		if target != "" {
+			column := tableMetadata.FindColumn(target)
+			if column == nil && mapped {
+				return nil, domain.ValidationError{
+					Field:   "mapping",
+					Message: fmt.Sprintf("column %s does not exist in table %s", target, params.Table),
+				}
+			}
+			if column != nil {
+				if previous, ok := seen[column.Name]; ok {
+					return nil, domain.ValidationError{
+						Field:   "mapping",
+						Message: fmt.Sprintf("CSV columns %s and %s both map to column %s", previous, name, column.Name),
+					}
+				}
+				seen[column.Name] = name
+				mapping.TableColumn = column.Name
+				mapping.DataType = column.DataType
+				targets = append(targets, column)
+				sources = append(sources, i)
+				parsed.columns = append(parsed.columns, column.Name)
+			}
+		}
+		parsed.mappings = append(parsed.mappings, mapping)
+	}
+	if len(parsed.columns) == 0 {
+		return nil, domain.ValidationError{Field: "mapping", Message: "no CSV column maps to a column of the table"}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, domain.ValidationError{Field: "file", Message: "invalid CSV: " + err.Error()}
+			}
+			return nil, err
+		}
+		parsed.rowCount++
+
+		if len(record) != len(header) {
+			parsed.addError(domain.ImportRowError{
+				Line:    line,
+				Message: fmt.Sprintf("expected %d fields, got %d", len(header), len(record)),
+			})
+			parsed.invalidRows++
+			continue
+		}
+
+		row := make([]interface{}, len(targets))
+		valid := true
+		for i, column := range targets {
+			value, err := coerceImportValue(record[sources[i]], column)
+			if err != nil {
+				parsed.addError(domain.ImportRowError{Line: line, Column: column.Name, Message: err.Error()})
+				valid = false
+				continue
+			}
+			row[i] = value
+		}
+		if valid {
+			parsed.rows = append(parsed.rows, row)
+		} else {
+			parsed.invalidRows++
+		}
+	}
+
+	return parsed, nil
+}
+
+// addError records a row error, keeping at most maxErrors of them
+func (c *csvImport) addError(rowErr domain.ImportRowError) {
+	c.errorCount++
+	if len(c.errors) < c.maxErrors {
+		c.errors = append(c.errors, rowErr)
+	}
+}
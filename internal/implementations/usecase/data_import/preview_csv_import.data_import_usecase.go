@@ -0,0 +1,28 @@
+package data_import
+
+import (
+	"context"
+	"io"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ImportUseCaseImplementation) PreviewCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportPreview, error) {
+	parsed, err := u.parseCSVImport(ctx, username, params, csvFile, domain.ImportMaxErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &domain.ImportPreview{
+		Columns:  parsed.mappings,
+		RowCount: parsed.rowCount,
+		Errors:   parsed.errors,
+	}
+	if len(parsed.rows) > domain.ImportPreviewRows {
+		preview.Rows = parsed.rows[:domain.ImportPreviewRows]
+	} else {
+		preview.Rows = parsed.rows
+	}
+
+	return preview, nil
+}
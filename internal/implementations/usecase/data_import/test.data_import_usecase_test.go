@@ -0,0 +1,11 @@
+package data_import
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestImportUsecase(t *testing.T) {
+	testRunner.ImportUsecaseRunner(t, NewImportUseCaseImplementation)
+}
@@ -0,0 +1,56 @@
+package data_import
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ImportUseCaseImplementation) ValidateCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportValidation, error) {
+	parsed, err := u.parseCSVImport(ctx, username, params, csvFile, domain.ImportValidationMaxErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cached metadata does not know column defaults, so required columns are read from the table
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+	mapped := make(map[string]bool, len(parsed.columns))
+	for _, column := range parsed.columns {
+		mapped[column] = true
+	}
+
+	validation := &domain.ImportValidation{
+		Columns:         parsed.mappings,
+		MissingColumns:  []string{},
+		RowCount:        parsed.rowCount,
+		InvalidRowCount: parsed.invalidRows,
+		ErrorCount:      parsed.errorCount,
+		Rows:            []domain.ImportRowReport{},
+		Truncated:       parsed.errorCount > len(parsed.errors),
+	}
+	for _, column := range tableMetadata.Columns {
+		if !column.IsNullable && !column.HasDefault && !mapped[column.Name] {
+			validation.MissingColumns = append(validation.MissingColumns, column.Name)
+		}
+	}
+
+	// Errors arrive in file order, so a row's errors are adjacent
+	for _, rowErr := range parsed.errors {
+		if n := len(validation.Rows); n == 0 || validation.Rows[n-1].Line != rowErr.Line {
+			validation.Rows = append(validation.Rows, domain.ImportRowReport{Line: rowErr.Line, Columns: map[string]string{}})
+		}
+		report := &validation.Rows[len(validation.Rows)-1]
+		if rowErr.Column == "" {
+			report.Message = rowErr.Message
+		} else {
+			report.Columns[rowErr.Column] = rowErr.Message
+		}
+	}
+
+	return validation, nil
+}
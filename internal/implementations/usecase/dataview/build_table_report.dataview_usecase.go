@@ -0,0 +1,84 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) BuildTableReport(ctx context.Context, username string, params domain.TableDataParams, title string) (*domain.ResultReport, error) {
+	// A report hands the rows over like an export does, so the export policy applies to it
+	if err := u.prepareTableExport(ctx, username, &params); err != nil {
+		return nil, err
+	}
+	if params.Limit > domain.ReportMaxRows {
+		params.Limit = domain.ReportMaxRows
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if result.TotalCount > int64(params.Limit) {
+		return nil, domain.ValidationError{
+			Field:   "limit",
+			Message: fmt.Sprintf("report of %d rows exceeds the limit of %d rows; narrow the filter", result.TotalCount, params.Limit),
+		}
+	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
+
+	report := &domain.ResultReport{
+		Title:       strings.TrimSpace(title),
+		Source:      params.Database + "." + params.Schema + "." + params.Table,
+		Filter:      params.WhereClause,
+		GeneratedBy: username,
+		GeneratedAt: time.Now(),
+		Columns:     result.Columns,
+		Rows:        make([][]*string, len(result.Rows)),
+	}
+	if report.Title == "" {
+		report.Title = params.Schema + "." + params.Table
+	}
+	if params.OrderBy != "" {
+		report.Sort = params.OrderBy + " " + params.OrderDir
+	}
+	for i, row := range result.Rows {
+		values := make([]*string, len(result.Columns))
+		for j, column := range result.Columns {
+			values[j] = reportValue(row[column])
+		}
+		report.Rows[i] = values
+	}
+
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionExport,
+		Database: params.Database,
+		Schema:   params.Schema,
+		Table:    params.Table,
+		After: map[string]interface{}{
+			"format":   domain.ExportFormatReport,
+			"rows":     len(result.Rows),
+			"filtered": params.WhereClause != "",
+		},
+	})
+	return report, nil
+}
+
+// reportValue renders a driver value as the text printed in a report, or nil for SQL NULL
+func reportValue(value interface{}) *string {
+	var text string
+	switch v := exportValue(value).(type) {
+	case nil:
+		return nil
+	case string:
+		text = v
+	default:
+		text = fmt.Sprint(v)
+	}
+	return &text
+}
@@ -0,0 +1,87 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) CheckReferentialIntegrity(ctx context.Context, username, database, schema, table string, references []domain.ForeignKeyMetadata, limit int) (*domain.ReferentialIntegrityReport, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without manual references, check every declared foreign key
+	if len(references) == 0 {
+		references = tableMetadata.ForeignKeys
+	}
+
+	if limit <= 0 {
+		limit = domain.OrphanedRowsPreviewSize
+	}
+	if limit > domain.QueryResultHardLimit {
+		limit = domain.QueryResultHardLimit
+	}
+
+	report := &domain.ReferentialIntegrityReport{
+		Table:      table,
+		References: []domain.OrphanedReference{},
+	}
+	for _, reference := range references {
+		if reference.ReferencedSchema == "" {
+			reference.ReferencedSchema = schema
+		}
+
+		if tableMetadata.FindColumn(reference.ColumnName) == nil {
+			return nil, domain.ValidationError{
+				Field:   "column",
+				Message: "column " + reference.ColumnName + " does not exist in this table",
+			}
+		}
+		if reference.ReferencedTable == "" || reference.ReferencedColumn == "" {
+			return nil, domain.ValidationError{
+				Field:   "reference",
+				Message: "referenced table and column are required",
+			}
+		}
+
+		// The parent table is read as well
+		hasParentPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, reference.ReferencedSchema, reference.ReferencedTable)
+		if err != nil {
+			return nil, err
+		}
+		if !hasParentPermission {
+			return nil, domain.ValidationError{
+				Field:   "table",
+				Message: "user does not have SELECT permission on the referenced table",
+			}
+		}
+
+		orphaned, err := u.databaseRepo.FindOrphanedRows(ctx, domain.OrphanCheckParams{
+			Database:  database,
+			Schema:    schema,
+			Table:     table,
+			Reference: reference,
+			Limit:     limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		report.References = append(report.References, *orphaned)
+	}
+
+	return report, nil
+}
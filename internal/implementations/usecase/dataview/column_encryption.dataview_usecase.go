@@ -0,0 +1,82 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// applyColumnEncryption marks the table's encrypted columns on params, handing over the key only to
+// users who may edit the table, and caps the rows of reads that stay masked so partially-trusted users
+// cannot harvest them in bulk; it returns the config it read for callers with other settings to apply
+func (u *DataViewUseCaseImplementation) applyColumnEncryption(ctx context.Context, username string, params *domain.TableDataParams) (*domain.AppConfig, error) {
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params.EncryptedColumns = config.EncryptedColumnsOf(params.Schema, params.Table)
+	if len(params.EncryptedColumns) == 0 {
+		return config, nil
+	}
+
+	if config.ColumnEncryptionKey != "" {
+		canEdit, err := u.rbacRepo.HasUpdatePermission(ctx, username, params.Database, params.Schema, params.Table)
+		if err != nil {
+			return nil, err
+		}
+		if canEdit {
+			params.EncryptionKey = config.ColumnEncryptionKey
+			return config, nil
+		}
+	}
+
+	limit := config.MaskedRowLimit
+	if limit <= 0 {
+		limit = domain.DefaultMaskedRowLimit
+	}
+	if params.Limit <= 0 || params.Limit > limit {
+		params.Limit = limit
+	}
+	return config, nil
+}
+
+// isMaskedRead reports whether params read a table whose encrypted columns stay masked for the user
+func isMaskedRead(params domain.TableDataParams) bool {
+	return len(params.EncryptedColumns) > 0 && params.EncryptionKey == ""
+}
+
+// recordMaskedRead leaves a read receipt in the audit log when a user was shown rows of a table whose
+// encrypted columns stayed masked, so the access counts of partially-trusted users can be reviewed.
+// Failing to record never fails the read.
+func (u *DataViewUseCaseImplementation) recordMaskedRead(ctx context.Context, username string, params domain.TableDataParams, result *domain.QueryResult) {
+	if result == nil || !isMaskedRead(params) {
+		return
+	}
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionMaskedRead,
+		Database: params.Database,
+		Schema:   params.Schema,
+		Table:    params.Table,
+		After: map[string]interface{}{
+			"rows":           len(result.Rows),
+			"offset":         params.Offset,
+			"masked_columns": params.EncryptedColumns,
+		},
+	})
+}
+
+// maskEncryptedColumns hides ciphertext of encrypted columns that were not decrypted
+func maskEncryptedColumns(result *domain.QueryResult, params domain.TableDataParams) {
+	if result == nil || !isMaskedRead(params) {
+		return
+	}
+	for _, row := range result.Rows {
+		for _, column := range params.EncryptedColumns {
+			if value, ok := row[column]; ok && value != nil {
+				row[column] = domain.EncryptedValueMask
+			}
+		}
+	}
+}
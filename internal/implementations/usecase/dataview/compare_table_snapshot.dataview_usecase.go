@@ -0,0 +1,116 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) CompareTableSnapshot(ctx context.Context, username, snapshotID string) (*domain.TableSnapshotDiff, error) {
+	snapshot, err := u.snapshotRepo.GetSnapshot(ctx, username, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	tableMetadata, result, err := u.readSnapshotRows(ctx, username, snapshot.Database, snapshot.Schema, snapshot.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(tableMetadata.PrimaryKeys, snapshot.PrimaryKeys) {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "the table's primary key changed since the snapshot was taken",
+		}
+	}
+
+	// Columns added or dropped since the snapshot count as changed values of every row
+	columns := append([]string{}, snapshot.Columns...)
+	for _, column := range result.Columns {
+		if !containsString(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	diff := &domain.TableSnapshotDiff{
+		SnapshotID:  snapshot.ID,
+		Database:    snapshot.Database,
+		Schema:      snapshot.Schema,
+		Table:       snapshot.Table,
+		PrimaryKeys: snapshot.PrimaryKeys,
+		TakenAt:     snapshot.TakenAt,
+		ComparedAt:  time.Now(),
+		Inserted:    []map[string]interface{}{},
+		Updated:     []domain.RowDifference{},
+		Deleted:     []map[string]interface{}{},
+	}
+
+	before := make(map[string]map[string]interface{}, len(snapshot.Rows))
+	for _, row := range snapshot.Rows {
+		before[snapshotRowKey(row, snapshot.PrimaryKeys)] = row
+	}
+
+	seen := make(map[string]bool, len(result.Rows))
+	for _, row := range result.Rows {
+		key := snapshotRowKey(row, snapshot.PrimaryKeys)
+		seen[key] = true
+
+		old, ok := before[key]
+		if !ok {
+			diff.Inserted = append(diff.Inserted, row)
+			continue
+		}
+
+		var changed []string
+		for _, column := range columns {
+			oldValue, hadColumn := old[column]
+			newValue, hasColumn := row[column]
+			if hadColumn != hasColumn || !snapshotValuesEqual(oldValue, newValue) {
+				changed = append(changed, column)
+			}
+		}
+		if len(changed) == 0 {
+			diff.Unchanged++
+			continue
+		}
+
+		rowKey := make(map[string]interface{}, len(snapshot.PrimaryKeys))
+		for _, pk := range snapshot.PrimaryKeys {
+			rowKey[pk] = row[pk]
+		}
+		diff.Updated = append(diff.Updated, domain.RowDifference{
+			Key:     rowKey,
+			Changed: changed,
+			Before:  old,
+			After:   row,
+		})
+	}
+
+	for _, row := range snapshot.Rows {
+		if !seen[snapshotRowKey(row, snapshot.PrimaryKeys)] {
+			diff.Deleted = append(diff.Deleted, row)
+		}
+	}
+	return diff, nil
+}
+
+// snapshotRowKey identifies a row by its primary key values as text
+func snapshotRowKey(row map[string]interface{}, primaryKeys []string) string {
+	values := make([]string, len(primaryKeys))
+	for i, pk := range primaryKeys {
+		values[i] = fmt.Sprint(row[pk])
+	}
+	return strings.Join(values, "\x00")
+}
+
+// snapshotValuesEqual compares two cell values, times by the instant they name
+func snapshotValuesEqual(a, b interface{}) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,7 @@
+package dataview
+
+import "context"
+
+func (u *DataViewUseCaseImplementation) DeleteTableSnapshot(ctx context.Context, username, snapshotID string) error {
+	return u.snapshotRepo.DeleteSnapshot(ctx, username, snapshotID)
+}
@@ -0,0 +1,177 @@
+package dataview
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) ExportTableData(ctx context.Context, username string, params domain.TableDataParams, format string, w io.Writer) error {
+	if format != domain.ExportFormatJSON && format != domain.ExportFormatNDJSON && format != domain.ExportFormatXLSX {
+		return domain.ValidationError{Field: "format", Message: "export format must be json, ndjson or xlsx"}
+	}
+
+	if err := u.prepareTableExport(ctx, username, &params); err != nil {
+		return err
+	}
+	rowLimit := params.Limit
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return err
+	}
+	if result.TotalCount > int64(rowLimit) {
+		return domain.ValidationError{
+			Field:   "limit",
+			Message: fmt.Sprintf("export of %d rows exceeds the limit of %d rows; narrow the filter", result.TotalCount, rowLimit),
+		}
+	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
+
+	switch format {
+	case domain.ExportFormatXLSX:
+		err = writeXLSX(w, params.Table, result)
+	case domain.ExportFormatNDJSON:
+		err = writeJSONRows(w, result, true)
+	default:
+		err = writeJSONRows(w, result, false)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Exports are kept for the user's account activity; the filter is left out as it may quote values
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionExport,
+		Database: params.Database,
+		Schema:   params.Schema,
+		Table:    params.Table,
+		After: map[string]interface{}{
+			"format":   format,
+			"rows":     len(result.Rows),
+			"filtered": params.WhereClause != "",
+		},
+	})
+	return nil
+}
+
+// prepareTableExport checks the user may read and export the table, validates the carried-over filter
+// and sort, and limits the params to the export row limit, which is lower for masked exports
+func (u *DataViewUseCaseImplementation) prepareTableExport(ctx context.Context, username string, params *domain.TableDataParams) error {
+	// Check if user has SELECT permission on the table
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return err
+	}
+	if !hasPermission {
+		return domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	// Carry over the main view's filter and sort
+	if params.WhereClause != "" {
+		valid, err := u.ValidateWhereClause(ctx, params.WhereClause)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return domain.ValidationError{
+				Field:   "whereClause",
+				Message: "WHERE clause contains invalid or malicious patterns",
+			}
+		}
+	}
+	if params.OrderBy != "" {
+		params.OrderDir = strings.ToUpper(strings.TrimSpace(params.OrderDir))
+		if params.OrderDir == "" {
+			params.OrderDir = domain.SortDirectionASC
+		}
+		if params.OrderDir != domain.SortDirectionASC && params.OrderDir != domain.SortDirectionDESC {
+			return domain.ValidationError{
+				Field:   "orderDir",
+				Message: "order direction must be ASC or DESC",
+			}
+		}
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !config.ExportAllowed(username, params.Schema+"."+params.Table) {
+		return domain.ValidationError{
+			Field:   "permission",
+			Message: "export of this table is not allowed for this user",
+		}
+	}
+	params.Offset = 0
+	params.Limit = config.ExportRowLimit
+	if params.Limit <= 0 {
+		params.Limit = domain.ExportDefaultRowLimit
+	}
+
+	// Decrypt configured columns for users who may edit them; exports that stay masked get the lower
+	// masked row limit
+	_, err = u.applyColumnEncryption(ctx, username, params)
+	return err
+}
+
+// writeJSONRows writes the rows as a JSON array, or one object per line for ndjson, keeping keys in column order
+func writeJSONRows(w io.Writer, result *domain.QueryResult, lines bool) error {
+	out := bufio.NewWriter(w)
+	if !lines {
+		out.WriteByte('[')
+	}
+	for i, row := range result.Rows {
+		if !lines && i > 0 {
+			out.WriteByte(',')
+		}
+		out.WriteByte('{')
+		for j, column := range result.Columns {
+			if j > 0 {
+				out.WriteByte(',')
+			}
+			key, err := json.Marshal(column)
+			if err != nil {
+				return err
+			}
+			value, err := json.Marshal(exportValue(row[column]))
+			if err != nil {
+				return err
+			}
+			out.Write(key)
+			out.WriteByte(':')
+			out.Write(value)
+		}
+		out.WriteByte('}')
+		if lines {
+			out.WriteByte('\n')
+		}
+	}
+	if !lines {
+		out.WriteByte(']')
+	}
+	return out.Flush()
+}
+
+// exportValue converts driver values to their exported representation
+func exportValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
@@ -2,6 +2,7 @@ package dataview
 
 import (
 	"context"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
@@ -41,11 +42,27 @@ func (u *DataViewUseCaseImplementation) FilterTableData(ctx context.Context, use
 		Limit:       limit,
 	}
 
+	return u.readFilteredTableData(ctx, username, params)
+}
+
+// readFilteredTableData loads a page of rows matching the params' filter, decrypting configured columns
+// for users who may edit them and logging the filter if it runs slow
+func (u *DataViewUseCaseImplementation) readFilteredTableData(ctx context.Context, username string, params domain.TableDataParams) (*domain.QueryResult, error) {
+	// Decrypt configured columns for users who may edit them
+	config, err := u.applyColumnEncryption(ctx, username, &params)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get filtered table data from database
+	started := time.Now()
 	result, err := u.databaseRepo.GetTableData(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	u.recordSlowFilter(ctx, username, params, result.Columns, time.Since(started), config)
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
 
 	return result, nil
 }
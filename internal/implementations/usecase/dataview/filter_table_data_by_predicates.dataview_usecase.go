@@ -0,0 +1,165 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// filterComparisons maps the comparison operators of a FilterPredicate to SQL
+var filterComparisons = map[string]string{
+	domain.FilterOperatorEqual:        "=",
+	domain.FilterOperatorNotEqual:     "<>",
+	domain.FilterOperatorLess:         "<",
+	domain.FilterOperatorLessEqual:    "<=",
+	domain.FilterOperatorGreater:      ">",
+	domain.FilterOperatorGreaterEqual: ">=",
+}
+
+func (u *DataViewUseCaseImplementation) FilterTableDataByPredicates(ctx context.Context, username, database, schema, table string, filter domain.FilterPredicate, offset, limit int) (*domain.QueryResult, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := filterCompiler{table: tableMetadata}
+	whereClause, err := compiler.compile(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	params := domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: whereClause,
+		WhereArgs:   compiler.args,
+		Offset:      offset,
+		Limit:       limit,
+	}
+
+	return u.readFilteredTableData(ctx, username, params)
+}
+
+// filterCompiler turns a FilterPredicate into a WHERE clause whose values are all bound as $n
+// arguments, so only the table's own column names and fixed operators reach the SQL text
+type filterCompiler struct {
+	table       *domain.TableMetadata
+	args        []interface{}
+	comparisons int
+}
+
+func (c *filterCompiler) compile(predicate domain.FilterPredicate) (string, error) {
+	if predicate.Group != "" {
+		return c.compileGroup(predicate)
+	}
+
+	c.comparisons++
+	if c.comparisons > domain.FilterMaxPredicates {
+		return "", filterError(fmt.Sprintf("filter has more than %d conditions", domain.FilterMaxPredicates))
+	}
+
+	if predicate.Column == "" || c.table.FindColumn(predicate.Column) == nil {
+		return "", filterError(fmt.Sprintf("column %q not found in table %s", predicate.Column, c.table.Name))
+	}
+	column := pq.QuoteIdentifier(predicate.Column)
+
+	switch predicate.Operator {
+	case domain.FilterOperatorIsNull:
+		return column + " IS NULL", nil
+	case domain.FilterOperatorIsNotNull:
+		return column + " IS NOT NULL", nil
+	case domain.FilterOperatorLike, domain.FilterOperatorILike:
+		pattern, ok := predicate.Value.(string)
+		if !ok {
+			return "", filterError(fmt.Sprintf("%s on column %s needs a text pattern", predicate.Operator, predicate.Column))
+		}
+		// Non-text columns are matched on their text form
+		return fmt.Sprintf("%s::text %s %s", column, strings.ToUpper(predicate.Operator), c.bind(pattern)), nil
+	case domain.FilterOperatorIn:
+		values, ok := predicate.Value.([]interface{})
+		if !ok || len(values) == 0 {
+			return "", filterError(fmt.Sprintf("in on column %s needs a non-empty list of values", predicate.Column))
+		}
+		placeholders := make([]string, len(values))
+		for i, value := range values {
+			if !isFilterScalar(value) {
+				return "", filterError(fmt.Sprintf("in on column %s accepts only scalar values", predicate.Column))
+			}
+			placeholders[i] = c.bind(value)
+		}
+		return fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")), nil
+	}
+
+	operator, ok := filterComparisons[predicate.Operator]
+	if !ok {
+		return "", filterError(fmt.Sprintf("unsupported filter operator %q", predicate.Operator))
+	}
+	if predicate.Value == nil {
+		return "", filterError(fmt.Sprintf("%s on column %s needs a value; use is_null to match NULL", predicate.Operator, predicate.Column))
+	}
+	if !isFilterScalar(predicate.Value) {
+		return "", filterError(fmt.Sprintf("%s on column %s accepts only a scalar value", predicate.Operator, predicate.Column))
+	}
+	return fmt.Sprintf("%s %s %s", column, operator, c.bind(predicate.Value)), nil
+}
+
+// compileGroup joins a group's predicates with AND or OR, parenthesized so groups nest as given
+func (c *filterCompiler) compileGroup(group domain.FilterPredicate) (string, error) {
+	var joiner string
+	switch group.Group {
+	case domain.FilterGroupAnd:
+		joiner = " AND "
+	case domain.FilterGroupOr:
+		joiner = " OR "
+	default:
+		return "", filterError(fmt.Sprintf("unsupported filter group %q", group.Group))
+	}
+	if len(group.Predicates) == 0 {
+		return "", filterError("filter group has no conditions")
+	}
+
+	parts := make([]string, len(group.Predicates))
+	for i, predicate := range group.Predicates {
+		part, err := c.compile(predicate)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = part
+	}
+	return "(" + strings.Join(parts, joiner) + ")", nil
+}
+
+// bind adds a value to the arguments and returns its placeholder
+func (c *filterCompiler) bind(value interface{}) string {
+	c.args = append(c.args, value)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+// isFilterScalar reports whether a value can be bound as a single comparison argument
+func isFilterScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, bool, float64, float32, int, int32, int64:
+		return true
+	}
+	return false
+}
+
+func filterError(message string) error {
+	return domain.ValidationError{Field: "filter", Message: message}
+}
@@ -0,0 +1,75 @@
+package dataview
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) FindDuplicateRows(ctx context.Context, username string, params domain.DuplicateParams) (*domain.DuplicateReport, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	if len(params.Columns) == 0 {
+		return nil, domain.ValidationError{
+			Field:   "columns",
+			Message: "at least one column is required",
+		}
+	}
+
+	// Validate the current filter, if any
+	if strings.TrimSpace(params.WhereClause) != "" {
+		valid, err := u.ValidateWhereClause(ctx, params.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, domain.ValidationError{
+				Field:   "whereClause",
+				Message: "WHERE clause contains invalid or malicious patterns",
+			}
+		}
+	}
+
+	// Validate the compared columns and pick up the primary key for samples
+	tableMetadata, err := u.findTableMetadata(ctx, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range params.Columns {
+		if tableMetadata.FindColumn(column) == nil {
+			return nil, domain.ValidationError{
+				Field:   "columns",
+				Message: "column " + column + " does not exist in this table",
+			}
+		}
+	}
+	params.PrimaryKeys = tableMetadata.PrimaryKeys
+
+	if params.Limit <= 0 || params.Limit > domain.QueryResultHardLimit {
+		params.Limit = domain.GroupByDefaultLimit
+	}
+	if params.SampleSize <= 0 {
+		params.SampleSize = domain.DuplicateSampleSize
+	}
+
+	groups, err := u.databaseRepo.FindDuplicateRows(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.DuplicateReport{
+		Columns: params.Columns,
+		Groups:  groups,
+	}, nil
+}
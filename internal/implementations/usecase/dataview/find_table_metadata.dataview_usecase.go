@@ -0,0 +1,35 @@
+package dataview
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// findTableMetadata looks up the cached metadata of a table
+func (u *DataViewUseCaseImplementation) findTableMetadata(ctx context.Context, database, schema, table string) (*domain.TableMetadata, error) {
+	metadata, err := u.metadataRepo.GetMetadata(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.FindTable(schema, table)
+}
+
+// isNumericType reports whether a PostgreSQL data type holds numeric values
+func isNumericType(dataType string) bool {
+	switch strings.ToLower(strings.TrimSpace(dataType)) {
+	case "smallint", "integer", "bigint", "int", "int2", "int4", "int8",
+		"decimal", "numeric", "real", "double precision", "float4", "float8",
+		"smallserial", "serial", "bigserial", "money":
+		return true
+	}
+	lower := strings.ToLower(dataType)
+	return strings.HasPrefix(lower, "numeric(") || strings.HasPrefix(lower, "decimal(")
+}
+
+// isTemporalType reports whether a PostgreSQL data type holds dates or timestamps
+func isTemporalType(dataType string) bool {
+	lower := strings.ToLower(strings.TrimSpace(dataType))
+	return lower == "date" || lower == "timestamptz" || strings.HasPrefix(lower, "timestamp")
+}
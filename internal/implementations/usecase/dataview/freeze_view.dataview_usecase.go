@@ -0,0 +1,54 @@
+package dataview
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) FreezeView(ctx context.Context, snapshotKey string) (*domain.SnapshotStatus, error) {
+	if snapshotKey == "" {
+		return nil, domain.ValidationError{
+			Field:   "snapshot",
+			Message: "a session is required to freeze the view",
+		}
+	}
+
+	// Replace a snapshot that has outlived its limit instead of extending it
+	status, err := u.GetSnapshotStatus(ctx, snapshotKey)
+	if err != nil {
+		return nil, err
+	}
+	if status.Active {
+		return status, nil
+	}
+
+	takenAt, err := u.databaseRepo.OpenSnapshot(ctx, snapshotKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SnapshotStatus{Active: true, TakenAt: takenAt}, nil
+}
+
+func (u *DataViewUseCaseImplementation) GetSnapshotStatus(ctx context.Context, snapshotKey string) (*domain.SnapshotStatus, error) {
+	takenAt, ok := u.databaseRepo.SnapshotTakenAt(snapshotKey)
+	if !ok {
+		return &domain.SnapshotStatus{}, nil
+	}
+
+	// An open snapshot holds back vacuum, so it is not kept past its limit
+	if time.Since(takenAt) > domain.SnapshotMaxAgeSeconds*time.Second {
+		if err := u.databaseRepo.ReleaseSnapshot(snapshotKey); err != nil {
+			return nil, err
+		}
+		return &domain.SnapshotStatus{TakenAt: takenAt, Expired: true}, nil
+	}
+
+	return &domain.SnapshotStatus{Active: true, TakenAt: takenAt}, nil
+}
+
+func (u *DataViewUseCaseImplementation) ReleaseSnapshot(ctx context.Context, snapshotKey string) error {
+	return u.databaseRepo.ReleaseSnapshot(snapshotKey)
+}
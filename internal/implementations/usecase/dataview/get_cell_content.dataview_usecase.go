@@ -0,0 +1,108 @@
+package dataview
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetCellContent(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.CellContent, error) {
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataType string
+	for _, col := range tableMetadata.Columns {
+		if col.Name == column {
+			dataType = col.DataType
+			break
+		}
+	}
+	if dataType == "" {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("column %s does not exist", column)}
+	}
+
+	// The row must be identified by its full primary key
+	if len(tableMetadata.PrimaryKeys) == 0 {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no primary key to identify rows",
+		}
+	}
+	for _, key := range tableMetadata.PrimaryKeys {
+		if _, ok := pkValues[key]; !ok {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: "missing primary key value for " + key,
+			}
+		}
+	}
+	for key := range pkValues {
+		if !containsString(tableMetadata.PrimaryKeys, key) {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: key + " is not a primary key column",
+			}
+		}
+	}
+
+	whereClause, whereArgs := rowKeyCondition(pkValues)
+	params := domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: whereClause,
+		WhereArgs:   whereArgs,
+		Limit:       1,
+	}
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	u.recordMaskedRead(ctx, username, params, result)
+	if len(result.Rows) == 0 {
+		return nil, domain.ValidationError{Field: "pk", Message: "no row matches the given primary key"}
+	}
+
+	content := &domain.CellContent{Column: column, DataType: dataType, ContentType: "text/plain; charset=utf-8"}
+	switch v := result.Rows[0][column].(type) {
+	case nil:
+		content.IsNull = true
+		return content, nil
+	case []byte:
+		content.Content = v
+	case string:
+		content.Content = []byte(v)
+	default:
+		content.Content = []byte(fmt.Sprint(v))
+	}
+
+	// Only bytea holds arbitrary bytes; a masked bytea cell is text and detected as such
+	if dataType == "bytea" {
+		content.ContentType = http.DetectContentType(content.Content)
+	}
+	digest := sha256.Sum256(content.Content)
+	content.SHA256 = hex.EncodeToString(digest[:])
+	content.Size = int64(len(content.Content))
+	return content, nil
+}
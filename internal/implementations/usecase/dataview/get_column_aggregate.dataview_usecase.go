@@ -0,0 +1,57 @@
+package dataview
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetColumnAggregate(ctx context.Context, username, database, schema, table, column, whereClause string) (*domain.ColumnAggregate, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	// Validate the current filter, if any
+	if strings.TrimSpace(whereClause) != "" {
+		valid, err := u.ValidateWhereClause(ctx, whereClause)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, domain.ValidationError{
+				Field:   "whereClause",
+				Message: "WHERE clause contains invalid or malicious patterns",
+			}
+		}
+	}
+
+	// Aggregates are only meaningful for numeric columns
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	columnMetadata := tableMetadata.FindColumn(column)
+	if columnMetadata == nil {
+		return nil, domain.ValidationError{
+			Field:   "column",
+			Message: "column does not exist in this table",
+		}
+	}
+	if !isNumericType(columnMetadata.DataType) {
+		return nil, domain.ValidationError{
+			Field:   "column",
+			Message: "aggregates are only available for numeric columns",
+		}
+	}
+
+	return u.databaseRepo.GetColumnAggregate(ctx, database, schema, table, column, whereClause)
+}
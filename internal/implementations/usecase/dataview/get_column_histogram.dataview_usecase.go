@@ -0,0 +1,75 @@
+package dataview
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetColumnHistogram(ctx context.Context, username string, params domain.HistogramParams) (*domain.HistogramResult, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	// Validate the current filter, if any
+	if strings.TrimSpace(params.WhereClause) != "" {
+		valid, err := u.ValidateWhereClause(ctx, params.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, domain.ValidationError{
+				Field:   "whereClause",
+				Message: "WHERE clause contains invalid or malicious patterns",
+			}
+		}
+	}
+
+	// Histograms are only available for numeric and temporal columns
+	tableMetadata, err := u.findTableMetadata(ctx, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	columnMetadata := tableMetadata.FindColumn(params.Column)
+	if columnMetadata == nil {
+		return nil, domain.ValidationError{
+			Field:   "column",
+			Message: "column does not exist in this table",
+		}
+	}
+	params.IsTemporal = isTemporalType(columnMetadata.DataType)
+	if !params.IsTemporal && !isNumericType(columnMetadata.DataType) {
+		return nil, domain.ValidationError{
+			Field:   "column",
+			Message: "histograms are only available for numeric and date columns",
+		}
+	}
+
+	// Clamp the bucket count
+	if params.Buckets <= 0 {
+		params.Buckets = domain.HistogramDefaultBuckets
+	}
+	if params.Buckets > domain.HistogramMaxBuckets {
+		params.Buckets = domain.HistogramMaxBuckets
+	}
+
+	buckets, err := u.databaseRepo.GetColumnHistogram(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.HistogramResult{
+		Column:     params.Column,
+		IsTemporal: params.IsTemporal,
+		Buckets:    buckets,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetDataQualityReport(ctx context.Context, username, database, schema, table string, sampleLimit int) (*domain.DataQualityReport, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, len(tableMetadata.Columns))
+	for i, column := range tableMetadata.Columns {
+		columns[i] = column.Name
+	}
+
+	if sampleLimit <= 0 || sampleLimit > domain.DataQualitySampleLimit {
+		sampleLimit = domain.DataQualitySampleLimit
+	}
+
+	report, err := u.databaseRepo.GetColumnQualityStats(ctx, database, schema, table, columns, sampleLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach data types so the report reads without the table structure at hand
+	for i := range report.Columns {
+		if columnMetadata := tableMetadata.FindColumn(report.Columns[i].Column); columnMetadata != nil {
+			report.Columns[i].DataType = columnMetadata.DataType
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,71 @@
+package dataview
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetHistoryConfig(ctx context.Context, username, database, schema, table string) (*domain.HistoryTableConfig, error) {
+	historyTable := table + domain.HistoryTableSuffix
+
+	// Check if user has SELECT permission on both the table and its history
+	for _, target := range []string{table, historyTable} {
+		hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, target)
+		if err != nil {
+			return nil, err
+		}
+		if !hasPermission {
+			return nil, domain.ValidationError{
+				Field:   "table",
+				Message: "user does not have SELECT permission on table " + target,
+			}
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	historyMetadata, err := u.findTableMetadata(ctx, database, schema, historyTable)
+	if errors.Is(err, domain.ErrTableNotFound) {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no " + historyTable + " history companion",
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config := &domain.HistoryTableConfig{
+		Database:      database,
+		Schema:        schema,
+		Table:         table,
+		HistorySchema: schema,
+		HistoryTable:  historyTable,
+		PrimaryKeys:   tableMetadata.PrimaryKeys,
+	}
+	for _, column := range tableMetadata.Columns {
+		if historyMetadata.FindColumn(column.Name) != nil {
+			config.Columns = append(config.Columns, column.Name)
+		}
+	}
+
+	// Prefer the system versioning pattern, fall back to validity columns
+	switch {
+	case tableMetadata.FindColumn(domain.HistoryPeriodColumn) != nil && historyMetadata.FindColumn(domain.HistoryPeriodColumn) != nil:
+		config.PeriodColumn = domain.HistoryPeriodColumn
+	case historyMetadata.FindColumn(domain.HistoryValidFromColumn) != nil && historyMetadata.FindColumn(domain.HistoryValidToColumn) != nil:
+		config.ValidFromColumn = domain.HistoryValidFromColumn
+		config.ValidToColumn = domain.HistoryValidToColumn
+	default:
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "history companion has neither a " + domain.HistoryPeriodColumn + " period nor " + domain.HistoryValidFromColumn + "/" + domain.HistoryValidToColumn + " columns",
+		}
+	}
+
+	return config, nil
+}
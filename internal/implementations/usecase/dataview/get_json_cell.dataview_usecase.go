@@ -0,0 +1,133 @@
+package dataview
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *DataViewUseCaseImplementation) GetJSONCell(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.JSONCellDetail, error) {
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataType string
+	for _, col := range tableMetadata.Columns {
+		if col.Name == column {
+			dataType = col.DataType
+			break
+		}
+	}
+	if dataType != "json" && dataType != "jsonb" {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("column %s is not a JSON column", column)}
+	}
+
+	// The row must be identified by its full primary key
+	if len(tableMetadata.PrimaryKeys) == 0 {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no primary key to identify rows",
+		}
+	}
+	for _, key := range tableMetadata.PrimaryKeys {
+		if _, ok := pkValues[key]; !ok {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: "missing primary key value for " + key,
+			}
+		}
+	}
+	for key := range pkValues {
+		if !containsString(tableMetadata.PrimaryKeys, key) {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: key + " is not a primary key column",
+			}
+		}
+	}
+
+	whereClause, whereArgs := rowKeyCondition(pkValues)
+	params := domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: whereClause,
+		WhereArgs:   whereArgs,
+		Limit:       1,
+	}
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	u.recordMaskedRead(ctx, username, params, result)
+	if len(result.Rows) == 0 {
+		return nil, domain.ValidationError{Field: "pk", Message: "no row matches the given primary key"}
+	}
+
+	detail := &domain.JSONCellDetail{Column: column, DataType: dataType}
+	var text string
+	switch v := result.Rows[0][column].(type) {
+	case nil:
+		detail.IsNull = true
+		return detail, nil
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JSON cell: %w", err)
+		}
+		text = string(data)
+	}
+
+	// A masked cell is not JSON and is shown as it was read
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, []byte(text), "", "  "); err != nil {
+		detail.Value = text
+		return detail, nil
+	}
+	detail.Value = pretty.String()
+	return detail, nil
+}
+
+// rowKeyCondition matches the one row with the given primary key values, bound as parameters so the
+// server reads them as the key columns' types and the primary key index serves the lookup
+func rowKeyCondition(pkValues map[string]interface{}) (string, []interface{}) {
+	columns := make([]string, 0, len(pkValues))
+	for column := range pkValues {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	conditions := make([]string, len(columns))
+	args := make([]interface{}, len(columns))
+	for i, column := range columns {
+		conditions[i] = fmt.Sprintf("%s = $%d", pq.QuoteIdentifier(column), i+1)
+		args[i] = pkValues[column]
+	}
+	return strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,110 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetRowDetail(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) (*domain.RowDetail, error) {
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	// The row must be identified by its full primary key
+	if len(tableMetadata.PrimaryKeys) == 0 {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no primary key to identify rows",
+		}
+	}
+	for _, key := range tableMetadata.PrimaryKeys {
+		if _, ok := pkValues[key]; !ok {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: "missing primary key value for " + key,
+			}
+		}
+	}
+	for key := range pkValues {
+		if !containsString(tableMetadata.PrimaryKeys, key) {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: key + " is not a primary key column",
+			}
+		}
+	}
+
+	whereClause, whereArgs := rowKeyCondition(pkValues)
+	params := domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: whereClause,
+		WhereArgs:   whereArgs,
+		Limit:       1,
+	}
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
+	if len(result.Rows) == 0 {
+		return nil, domain.ValidationError{Field: "pk", Message: "no row matches the given primary key"}
+	}
+
+	// Declared types, defaults and comments are read from the catalog rather than the cached metadata
+	definition, err := u.databaseRepo.GetTableDefinition(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	definitions := make(map[string]domain.TableColumnDefinition, len(definition.Columns))
+	for _, column := range definition.Columns {
+		definitions[column.Name] = column
+	}
+
+	row := result.Rows[0]
+	detail := &domain.RowDetail{Database: database, Schema: schema, Table: table}
+	for _, column := range tableMetadata.Columns {
+		entry := domain.RowDetailColumn{
+			Name:       column.Name,
+			DataType:   column.DataType,
+			IsNullable: column.IsNullable,
+			IsPrimary:  containsString(tableMetadata.PrimaryKeys, column.Name),
+			Value:      row[column.Name],
+		}
+		if declared, ok := definitions[column.Name]; ok {
+			entry.DataType = declared.Type
+			entry.Default = declared.Default
+			entry.Comment = declared.Comment
+		}
+		for i := range tableMetadata.ForeignKeys {
+			if tableMetadata.ForeignKeys[i].ColumnName == column.Name {
+				entry.ForeignKey = &tableMetadata.ForeignKeys[i]
+				break
+			}
+		}
+		if data, ok := entry.Value.([]byte); ok {
+			entry.Value = string(data)
+		}
+		detail.Columns = append(detail.Columns, entry)
+	}
+	return detail, nil
+}
@@ -0,0 +1,50 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetRowTimeline(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) (*domain.QueryResult, error) {
+	history, err := u.GetHistoryConfig(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	// The row must be identified by its full primary key
+	if len(history.PrimaryKeys) == 0 {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no primary key to identify rows",
+		}
+	}
+	for _, key := range history.PrimaryKeys {
+		if _, ok := pkValues[key]; !ok {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: "missing primary key value for " + key,
+			}
+		}
+	}
+	for key := range pkValues {
+		if !containsString(history.PrimaryKeys, key) {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: key + " is not a primary key column",
+			}
+		}
+	}
+
+	return u.databaseRepo.GetRowHistory(ctx, *history, pkValues)
+}
+
+// containsString reports whether a slice contains the given value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetSpatialCell(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.SpatialCellDetail, error) {
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	// The row must be identified by its full primary key
+	if len(tableMetadata.PrimaryKeys) == 0 {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no primary key to identify rows",
+		}
+	}
+	for _, key := range tableMetadata.PrimaryKeys {
+		if _, ok := pkValues[key]; !ok {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: "missing primary key value for " + key,
+			}
+		}
+	}
+	for key := range pkValues {
+		if !containsString(tableMetadata.PrimaryKeys, key) {
+			return nil, domain.ValidationError{
+				Field:   "pk",
+				Message: key + " is not a primary key column",
+			}
+		}
+	}
+
+	whereClause, whereArgs := rowKeyCondition(pkValues)
+	params := domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: whereClause,
+		WhereArgs:   whereArgs,
+		Limit:       1,
+	}
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	u.recordMaskedRead(ctx, username, params, result)
+
+	// Spatial columns are only reported where PostGIS is installed
+	detail := &domain.SpatialCellDetail{Column: column}
+	for _, spatial := range result.SpatialColumns {
+		if spatial.Name == column {
+			detail.DataType = spatial.Type
+			break
+		}
+	}
+	if detail.DataType == "" {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("column %s is not a geometry or geography column", column)}
+	}
+	if len(result.Rows) == 0 {
+		return nil, domain.ValidationError{Field: "pk", Message: "no row matches the given primary key"}
+	}
+
+	switch raw := result.Rows[0][column].(type) {
+	case nil:
+		detail.IsNull = true
+		return detail, nil
+	case []byte:
+		detail.Raw = string(raw)
+	default:
+		detail.Raw = fmt.Sprint(raw)
+	}
+	if len(result.Spatial) > 0 {
+		value := result.Spatial[0][column]
+		detail.GeoJSON = value.GeoJSON
+		detail.WKT = value.WKT
+	}
+	return detail, nil
+}
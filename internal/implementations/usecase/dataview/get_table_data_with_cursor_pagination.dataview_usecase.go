@@ -28,11 +28,18 @@ func (u *DataViewUseCaseImplementation) GetTableDataWithCursorPagination(ctx con
 		Limit:    limit,
 	}
 
+	// Decrypt configured columns for users who may edit them
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
 	// Get table data with cursor pagination from database
 	result, err := u.databaseRepo.GetTableData(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
 
 	return result, nil
 }
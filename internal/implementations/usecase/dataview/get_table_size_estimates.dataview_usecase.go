@@ -0,0 +1,34 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) GetTableSizeEstimates(ctx context.Context, username, database, schema string, tables []string) ([]domain.TableSizeEstimate, error) {
+	if len(tables) > domain.TableSizeBatchLimit {
+		return nil, domain.ValidationError{
+			Field:   "tables",
+			Message: fmt.Sprintf("at most %d tables can be sized at once", domain.TableSizeBatchLimit),
+		}
+	}
+
+	// Tables the user cannot read are left out rather than failing the batch
+	readable := make([]string, 0, len(tables))
+	for _, table := range tables {
+		hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+		if err != nil {
+			return nil, err
+		}
+		if hasPermission {
+			readable = append(readable, table)
+		}
+	}
+	if len(readable) == 0 {
+		return []domain.TableSizeEstimate{}, nil
+	}
+
+	return u.databaseRepo.GetTableSizeEstimates(ctx, database, schema, readable)
+}
@@ -0,0 +1,100 @@
+package dataview
+
+import (
+	"context"
+	"math"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// Heap page layout used by the bloat estimate: a page header, and per row a line pointer and a
+// tuple header, with each tuple padded to the maximum alignment
+const (
+	pageHeaderBytes  = 24
+	linePointerBytes = 4
+	tupleHeaderBytes = 24
+	maxAlignBytes    = 8
+)
+
+func (u *DataViewUseCaseImplementation) GetTableStatistics(ctx context.Context, username, database, schema, table string) (*domain.TableStatistics, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	stats, err := u.databaseRepo.GetTableStatistics(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	deriveTableStatistics(stats)
+	return stats, nil
+}
+
+// deriveTableStatistics fills in the percentages, the bloat estimate and the unused indexes from the
+// counters the repository read
+func deriveTableStatistics(stats *domain.TableStatistics) {
+	if tuples := stats.LiveTuples + stats.DeadTuples; tuples > 0 {
+		stats.DeadTuplePercent = float64(stats.DeadTuples) * 100 / float64(tuples)
+	}
+	if scans := stats.SeqScans + stats.IndexScans; scans > 0 {
+		percent := float64(stats.IndexScans) * 100 / float64(scans)
+		stats.IndexScanPercent = &percent
+	}
+
+	stats.UnusedIndexes = nil
+	for _, index := range stats.Indexes {
+		if index.Scans == 0 && !index.Unique {
+			stats.UnusedIndexes = append(stats.UnusedIndexes, index.Name)
+		}
+	}
+
+	stats.EstimatedBloatBytes = nil
+	stats.EstimatedBloatPercent = nil
+	expectedPages, ok := expectedHeapPages(stats)
+	if !ok {
+		return
+	}
+	bloatPages := stats.Pages - expectedPages
+	if bloatPages < 0 {
+		bloatPages = 0
+	}
+	bloatBytes := bloatPages * stats.BlockSize
+	bloatPercent := float64(bloatPages) * 100 / float64(stats.Pages)
+	stats.EstimatedBloatBytes = &bloatBytes
+	stats.EstimatedBloatPercent = &bloatPercent
+}
+
+// expectedHeapPages estimates the pages the table's rows would fill if tightly packed at its fill
+// factor, the way pgstattuple's approximation reads it off the statistics rather than the heap.
+// It reports false when the table has not been analyzed or has no pages to compare against.
+func expectedHeapPages(stats *domain.TableStatistics) (int64, bool) {
+	if stats.RowWidth == nil || stats.EstimatedRows < 0 || stats.Pages <= 0 || stats.BlockSize <= 0 {
+		return 0, false
+	}
+
+	fillFactor := int64(stats.FillFactor)
+	if fillFactor <= 0 || fillFactor > 100 {
+		fillFactor = 100
+	}
+	tupleBytes := alignUp(tupleHeaderBytes+*stats.RowWidth, maxAlignBytes) + linePointerBytes
+	usableBytes := stats.BlockSize*fillFactor/100 - pageHeaderBytes
+	rowsPerPage := usableBytes / tupleBytes
+	if rowsPerPage < 1 {
+		rowsPerPage = 1
+	}
+
+	return int64(math.Ceil(stats.EstimatedRows / float64(rowsPerPage))), true
+}
+
+// alignUp rounds n up to a multiple of alignment
+func alignUp(n, alignment int64) int64 {
+	return (n + alignment - 1) / alignment * alignment
+}
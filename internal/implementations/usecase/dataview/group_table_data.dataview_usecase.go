@@ -0,0 +1,132 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *DataViewUseCaseImplementation) GroupTableData(ctx context.Context, username string, params domain.GroupByParams) (*domain.GroupByResult, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	// Validate the current filter, if any
+	if strings.TrimSpace(params.WhereClause) != "" {
+		valid, err := u.ValidateWhereClause(ctx, params.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, domain.ValidationError{
+				Field:   "whereClause",
+				Message: "WHERE clause contains invalid or malicious patterns",
+			}
+		}
+	}
+
+	// Validate the grouped and aggregated columns against metadata
+	tableMetadata, err := u.findTableMetadata(ctx, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if tableMetadata.FindColumn(params.GroupColumn) == nil {
+		return nil, domain.ValidationError{
+			Field:   "column",
+			Message: "group column does not exist in this table",
+		}
+	}
+
+	if params.AggregateColumn != "" {
+		params.AggregateFunc = strings.ToUpper(strings.TrimSpace(params.AggregateFunc))
+		if params.AggregateFunc == "" {
+			params.AggregateFunc = "SUM"
+		}
+		switch params.AggregateFunc {
+		case "SUM", "AVG", "MIN", "MAX", "COUNT":
+		default:
+			return nil, domain.ValidationError{
+				Field:   "aggregate",
+				Message: "unsupported aggregate function",
+			}
+		}
+
+		aggregateColumn := tableMetadata.FindColumn(params.AggregateColumn)
+		if aggregateColumn == nil {
+			return nil, domain.ValidationError{
+				Field:   "column",
+				Message: "aggregate column does not exist in this table",
+			}
+		}
+		if params.AggregateFunc != "COUNT" && !isNumericType(aggregateColumn.DataType) {
+			return nil, domain.ValidationError{
+				Field:   "column",
+				Message: "aggregates are only available for numeric columns",
+			}
+		}
+	} else {
+		params.AggregateFunc = ""
+	}
+
+	// Keep the preview bounded
+	if params.Limit <= 0 {
+		params.Limit = domain.GroupByDefaultLimit
+	}
+	if params.Limit > domain.QueryResultHardLimit {
+		params.Limit = domain.QueryResultHardLimit
+	}
+
+	buckets, err := u.databaseRepo.GetGroupedCounts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach a drill-down filter to every group so the grid can jump back to its rows
+	for i := range buckets {
+		buckets[i].DrillDownFilter = buildDrillDownFilter(params.WhereClause, params.GroupColumn, buckets[i].Value)
+	}
+
+	return &domain.GroupByResult{
+		GroupColumn:     params.GroupColumn,
+		AggregateColumn: params.AggregateColumn,
+		AggregateFunc:   params.AggregateFunc,
+		Groups:          buckets,
+	}, nil
+}
+
+// buildDrillDownFilter combines the current filter with an equality predicate on the grouped value
+func buildDrillDownFilter(whereClause, column string, value interface{}) string {
+	predicate := pq.QuoteIdentifier(column) + " IS NULL"
+	if value != nil {
+		predicate = pq.QuoteIdentifier(column) + " = " + pq.QuoteLiteral(formatFilterValue(value))
+	}
+
+	if strings.TrimSpace(whereClause) == "" {
+		return predicate
+	}
+	return "(" + whereClause + ") AND " + predicate
+}
+
+// formatFilterValue renders a scanned value as text PostgreSQL can cast back to the column type
+func formatFilterValue(value interface{}) string {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,11 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) ListTableSnapshots(ctx context.Context, username string) ([]domain.TableSnapshot, error) {
+	return u.snapshotRepo.ListSnapshots(ctx, username)
+}
@@ -0,0 +1,126 @@
+package dataview
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) LoadJoinedTableData(ctx context.Context, username string, params domain.JoinViewParams) (*domain.QueryResult, error) {
+	// Use FK suggestions when no condition was given
+	if len(params.Conditions) == 0 {
+		suggested, err := u.SuggestJoinConditions(ctx, username, params.Database, params.LeftSchema, params.LeftTable, params.RightSchema, params.RightTable)
+		if err != nil {
+			return nil, err
+		}
+		if len(suggested) == 0 {
+			return nil, domain.ValidationError{
+				Field:   "conditions",
+				Message: "no foreign key relates these tables, a join condition is required",
+			}
+		}
+		params.Conditions = suggested[:1]
+	} else {
+		// Check if user has SELECT permission on both tables
+		for _, target := range [][2]string{{params.LeftSchema, params.LeftTable}, {params.RightSchema, params.RightTable}} {
+			hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, params.Database, target[0], target[1])
+			if err != nil {
+				return nil, err
+			}
+			if !hasPermission {
+				return nil, domain.ValidationError{
+					Field:   "table",
+					Message: "user does not have SELECT permission on table " + target[1],
+				}
+			}
+		}
+	}
+
+	params.JoinType = strings.ToUpper(strings.TrimSpace(params.JoinType))
+	if params.JoinType == "" {
+		params.JoinType = "INNER"
+	}
+	if params.JoinType != "INNER" && params.JoinType != "LEFT" {
+		return nil, domain.ValidationError{
+			Field:   "joinType",
+			Message: "join type must be INNER or LEFT",
+		}
+	}
+
+	// Project every column of both tables and validate the join columns
+	leftMetadata, err := u.findTableMetadata(ctx, params.Database, params.LeftSchema, params.LeftTable)
+	if err != nil {
+		return nil, err
+	}
+	rightMetadata, err := u.findTableMetadata(ctx, params.Database, params.RightSchema, params.RightTable)
+	if err != nil {
+		return nil, err
+	}
+	for _, condition := range params.Conditions {
+		if leftMetadata.FindColumn(condition.LeftColumn) == nil || rightMetadata.FindColumn(condition.RightColumn) == nil {
+			return nil, domain.ValidationError{
+				Field:   "conditions",
+				Message: "join condition refers to an unknown column",
+			}
+		}
+	}
+
+	params.LeftColumns = make([]string, len(leftMetadata.Columns))
+	for i, column := range leftMetadata.Columns {
+		params.LeftColumns[i] = column.Name
+	}
+	params.RightColumns = make([]string, len(rightMetadata.Columns))
+	for i, column := range rightMetadata.Columns {
+		params.RightColumns[i] = column.Name
+	}
+
+	// Validate the filter, if any
+	if strings.TrimSpace(params.WhereClause) != "" {
+		valid, err := u.ValidateWhereClause(ctx, params.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, domain.ValidationError{
+				Field:   "whereClause",
+				Message: "WHERE clause contains invalid or malicious patterns",
+			}
+		}
+	}
+
+	// Sorting is only allowed on projected columns
+	if params.OrderBy != "" && !isJoinedColumn(params, params.OrderBy) {
+		return nil, domain.ValidationError{
+			Field:   "orderBy",
+			Message: "sort column is not part of the joined view",
+		}
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = domain.QueryResultPageSize
+	}
+	if params.Limit > domain.QueryResultHardLimit {
+		params.Limit = domain.QueryResultHardLimit
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	return u.databaseRepo.GetJoinedTableData(ctx, params)
+}
+
+// isJoinedColumn reports whether an aliased "<table>.<column>" name is projected by the join
+func isJoinedColumn(params domain.JoinViewParams, alias string) bool {
+	for _, column := range params.LeftColumns {
+		if params.LeftTable+"."+column == alias {
+			return true
+		}
+	}
+	for _, column := range params.RightColumns {
+		if params.RightTable+"."+column == alias {
+			return true
+		}
+	}
+	return false
+}
@@ -2,28 +2,57 @@ package dataview
 
 import (
 	"context"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (u *DataViewUseCaseImplementation) LoadTableData(ctx context.Context, username string, params domain.TableDataParams) (*domain.QueryResult, error) {
+	result, params, err := u.loadTableData(ctx, username, params)
+	if err != nil {
+		return nil, err
+	}
+	u.recordMaskedRead(ctx, username, params, result)
+
+	return result, nil
+}
+
+// loadTableData is LoadTableData without the read receipt, for watches that record one per watch
+// rather than one per refresh; it also returns params as read, with the encrypted columns marked
+func (u *DataViewUseCaseImplementation) loadTableData(ctx context.Context, username string, params domain.TableDataParams) (*domain.QueryResult, domain.TableDataParams, error) {
 	// Check if user has SELECT permission on the table
 	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, params.Database, params.Schema, params.Table)
 	if err != nil {
-		return nil, err
+		return nil, params, err
 	}
 	if !hasPermission {
-		return nil, domain.ValidationError{
+		return nil, params, domain.ValidationError{
 			Field:   "table",
 			Message: "user does not have SELECT permission on this table",
 		}
 	}
 
+	// Decrypt configured columns for users who may edit them
+	config, err := u.applyColumnEncryption(ctx, username, &params)
+	if err != nil {
+		return nil, params, err
+	}
+
+	// Read live data once a frozen view has outlived its limit
+	if params.SnapshotKey != "" {
+		if _, err := u.GetSnapshotStatus(ctx, params.SnapshotKey); err != nil {
+			return nil, params, err
+		}
+	}
+
 	// Get table data from database
+	started := time.Now()
 	result, err := u.databaseRepo.GetTableData(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, params, err
 	}
+	u.recordSlowFilter(ctx, username, params, result.Columns, time.Since(started), config)
+	maskEncryptedColumns(result, params)
 
-	return result, nil
+	return result, params, nil
 }
@@ -0,0 +1,48 @@
+package dataview
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) LoadTableDataAsOf(ctx context.Context, username, database, schema, table string, asOf time.Time, offset, limit int) (*domain.QueryResult, error) {
+	if asOf.IsZero() {
+		return nil, domain.ValidationError{
+			Field:   "asOf",
+			Message: "a point in time is required",
+		}
+	}
+
+	history, err := u.GetHistoryConfig(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = domain.QueryResultPageSize
+	}
+	if limit > domain.QueryResultHardLimit {
+		limit = domain.QueryResultHardLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Encrypted columns stay masked in the past as they are in the live table, under the same row cap
+	// and read receipt
+	params := domain.TableDataParams{Database: database, Schema: schema, Table: table, Offset: offset, Limit: limit}
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := u.databaseRepo.GetTableDataAsOf(ctx, *history, asOf, params.Offset, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
+
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// lookupNameColumns are the column names a referenced table is shown by when no display columns are
+// configured for it, most telling first
+var lookupNameColumns = []string{"name", "title", "label", "display_name", "full_name", "username", "email", "code"}
+
+func (u *DataViewUseCaseImplementation) LookupForeignKeyValues(ctx context.Context, username, database, schema, table, column, term string) (*domain.ForeignKeyLookup, error) {
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var reference *domain.ForeignKeyMetadata
+	for i := range tableMetadata.ForeignKeys {
+		if tableMetadata.ForeignKeys[i].ColumnName == column {
+			reference = &tableMetadata.ForeignKeys[i]
+			break
+		}
+	}
+	if reference == nil {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("column %s is not a foreign key", column)}
+	}
+	referencedSchema := reference.ReferencedSchema
+	if referencedSchema == "" {
+		referencedSchema = schema
+	}
+
+	// The parent rows are only offered to users who may read them
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, referencedSchema, reference.ReferencedTable)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on the referenced table",
+		}
+	}
+
+	referencedMetadata, err := u.findTableMetadata(ctx, database, referencedSchema, reference.ReferencedTable)
+	if err != nil {
+		return nil, err
+	}
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	displayColumns, err := lookupDisplayColumns(config, referencedSchema, referencedMetadata, reference.ReferencedColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := u.databaseRepo.SearchReferencedRows(ctx, domain.ForeignKeyLookupParams{
+		Database:       database,
+		Schema:         referencedSchema,
+		Table:          reference.ReferencedTable,
+		KeyColumn:      reference.ReferencedColumn,
+		DisplayColumns: displayColumns,
+		Term:           strings.TrimSpace(term),
+		Limit:          domain.ForeignKeyLookupLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := &domain.ForeignKeyLookup{
+		Column:         column,
+		Reference:      *reference,
+		DisplayColumns: displayColumns,
+		Matches:        []domain.ForeignKeyMatch{},
+	}
+	lookup.Reference.ReferencedSchema = referencedSchema
+	for _, row := range result.Rows {
+		labels := make([]string, 0, len(displayColumns))
+		for _, displayColumn := range displayColumns {
+			if value := row[displayColumn]; value != nil {
+				labels = append(labels, fmt.Sprint(exportValue(value)))
+			}
+		}
+		lookup.Matches = append(lookup.Matches, domain.ForeignKeyMatch{
+			Value: exportValue(row[reference.ReferencedColumn]),
+			Label: strings.Join(labels, " · "),
+		})
+	}
+
+	return lookup, nil
+}
+
+// lookupDisplayColumns returns the configured display columns of the referenced table, or else its
+// first name-like column, or else its first text column that is not the key
+func lookupDisplayColumns(config *domain.AppConfig, schema string, tableMetadata *domain.TableMetadata, keyColumn string) ([]string, error) {
+	if configured, ok := config.LookupDisplayColumns[schema+"."+tableMetadata.Name]; ok {
+		for _, column := range configured {
+			if tableMetadata.FindColumn(column) == nil {
+				return nil, fmt.Errorf("lookup display column %s does not exist in %s.%s", column, schema, tableMetadata.Name)
+			}
+		}
+		return configured, nil
+	}
+
+	for _, name := range lookupNameColumns {
+		if name != keyColumn && tableMetadata.FindColumn(name) != nil {
+			return []string{name}, nil
+		}
+	}
+	for _, column := range tableMetadata.Columns {
+		if column.Name == keyColumn {
+			continue
+		}
+		switch column.DataType {
+		case "text", "character varying", "character", "citext":
+			return []string{column.Name}, nil
+		}
+	}
+	return []string{}, nil
+}
@@ -37,11 +37,18 @@ func (u *DataViewUseCaseImplementation) NavigateToParentRow(ctx context.Context,
 		Limit:       1,
 	}
 
+	// Decrypt configured columns for users who may edit them
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
 	// Get parent row from database
 	result, err := u.databaseRepo.GetTableData(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
 
 	return result, nil
 }
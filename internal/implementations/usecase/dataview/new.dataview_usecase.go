@@ -9,16 +9,31 @@ type DataViewUseCaseImplementation struct {
 	metadataRepo repository.MetadataRepository
 	databaseRepo repository.DatabaseRepository
 	rbacRepo     repository.RBACRepository
+	configRepo   repository.ConfigRepository
+	// slowOperationRepo keeps filters that ran past the slow filter threshold
+	slowOperationRepo repository.SlowOperationRepository
+	// auditRepo keeps exports for the user's account activity
+	auditRepo repository.AuditRepository
+	// snapshotRepo keeps table snapshots to compare the live table against
+	snapshotRepo repository.TableSnapshotRepository
 }
 
 func NewDataViewUseCaseImplementation(
 	metadataRepo repository.MetadataRepository,
 	databaseRepo repository.DatabaseRepository,
 	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	slowOperationRepo repository.SlowOperationRepository,
+	auditRepo repository.AuditRepository,
+	snapshotRepo repository.TableSnapshotRepository,
 ) usecase.DataViewUseCase {
 	return &DataViewUseCaseImplementation{
-		metadataRepo: metadataRepo,
-		databaseRepo: databaseRepo,
-		rbacRepo:     rbacRepo,
+		metadataRepo:      metadataRepo,
+		databaseRepo:      databaseRepo,
+		rbacRepo:          rbacRepo,
+		configRepo:        configRepo,
+		slowOperationRepo: slowOperationRepo,
+		auditRepo:         auditRepo,
+		snapshotRepo:      snapshotRepo,
 	}
 }
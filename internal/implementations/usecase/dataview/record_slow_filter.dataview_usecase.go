@@ -0,0 +1,124 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// filterColumnPattern finds the columns compared in a plan's Filter, such as "(status = 'open'::text)"
+// or "((name)::text = 'Alice'::text)"; the operator is captured to put equality columns first
+var filterColumnPattern = regexp.MustCompile(`"?([A-Za-z_][A-Za-z0-9_]*)"?\)?(?:::[A-Za-z ]+)?\s*(=|<=|>=|<|>|IS NULL|IS NOT NULL)`)
+
+// recordSlowFilter logs a filtered load that ran past the slow filter threshold, with index suggestions
+// drawn from its plan. The log is advice for admins, so failing to explain or record never fails the load.
+func (u *DataViewUseCaseImplementation) recordSlowFilter(ctx context.Context, username string, params domain.TableDataParams, columns []string, elapsed time.Duration, config *domain.AppConfig) {
+	threshold := config.SlowFilterThreshold
+	if threshold <= 0 {
+		threshold = domain.DefaultSlowFilterThreshold
+	}
+	if params.WhereClause == "" || elapsed < threshold {
+		return
+	}
+
+	u.slowOperationRepo.RecordSlowOperation(ctx, &domain.SlowOperation{
+		Username:    username,
+		Operation:   domain.SlowOperationFilter,
+		Database:    params.Database,
+		Schema:      params.Schema,
+		Table:       params.Table,
+		WhereClause: params.WhereClause,
+		Duration:    elapsed,
+		Suggestions: u.suggestIndexes(ctx, params, columns),
+	})
+}
+
+// suggestIndexes explains the filter and suggests an index for each sequential scan of the table that
+// is estimated to keep few of a large table's rows
+func (u *DataViewUseCaseImplementation) suggestIndexes(ctx context.Context, params domain.TableDataParams, columns []string) []domain.IndexSuggestion {
+	table := pq.QuoteIdentifier(params.Table)
+	if params.Schema != "" {
+		table = pq.QuoteIdentifier(params.Schema) + "." + table
+	}
+
+	filtered, err := u.databaseRepo.ExplainQuery(ctx, "SELECT * FROM "+table+" WHERE "+params.WhereClause, false)
+	if err != nil {
+		return nil
+	}
+	whole, err := u.databaseRepo.ExplainQuery(ctx, "SELECT * FROM "+table, false)
+	if err != nil {
+		return nil
+	}
+	tableRows := whole.Root.PlanRows
+	if tableRows < domain.IndexAdvisorMinRows {
+		return nil
+	}
+
+	known := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		known[column] = true
+	}
+
+	var suggestions []domain.IndexSuggestion
+	walkPlan(filtered.Root, func(node domain.QueryPlanNode) {
+		filter, _ := node.Details["Filter"].(string)
+		if node.NodeType != "Seq Scan" || node.RelationName != params.Table || filter == "" {
+			return
+		}
+		selectivity := node.PlanRows / tableRows
+		if selectivity > domain.IndexAdvisorMaxSelectivity {
+			return
+		}
+
+		indexColumns := filterColumns(filter, known)
+		if len(indexColumns) == 0 {
+			return
+		}
+		quoted := make([]string, len(indexColumns))
+		for i, column := range indexColumns {
+			quoted[i] = pq.QuoteIdentifier(column)
+		}
+		suggestions = append(suggestions, domain.IndexSuggestion{
+			Schema:    params.Schema,
+			Table:     params.Table,
+			Columns:   indexColumns,
+			Statement: fmt.Sprintf("CREATE INDEX ON %s (%s)", table, strings.Join(quoted, ", ")),
+			Reason: fmt.Sprintf("sequential scan keeps an estimated %.0f of %.0f rows (%.2f%%) with filter %s",
+				node.PlanRows, tableRows, selectivity*100, filter),
+		})
+	})
+	return suggestions
+}
+
+// walkPlan calls visit for a plan node and every node below it
+func walkPlan(node domain.QueryPlanNode, visit func(domain.QueryPlanNode)) {
+	visit(node)
+	for _, child := range node.Children {
+		walkPlan(child, visit)
+	}
+}
+
+// filterColumns lists the table's columns compared in a plan filter, equality comparisons first as
+// they lead a useful multicolumn index
+func filterColumns(filter string, known map[string]bool) []string {
+	var equality, others []string
+	seen := make(map[string]bool)
+	for _, match := range filterColumnPattern.FindAllStringSubmatch(filter, -1) {
+		column := match[1]
+		if !known[column] || seen[column] {
+			continue
+		}
+		seen[column] = true
+		if match[2] == "=" {
+			equality = append(equality, column)
+		} else {
+			others = append(others, column)
+		}
+	}
+	return append(equality, others...)
+}
@@ -0,0 +1,121 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// objectKindOrder lists matches of equal rank with relations first, as those are what users jump to
+var objectKindOrder = map[string]int{
+	domain.ObjectKindTable:    0,
+	domain.ObjectKindView:     1,
+	domain.ObjectKindFunction: 2,
+	domain.ObjectKindColumn:   3,
+}
+
+func (u *DataViewUseCaseImplementation) SearchObjects(ctx context.Context, username, term string) ([]domain.ObjectSearchMatch, error) {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return nil, domain.ValidationError{Field: "search", Message: "search term is required"}
+	}
+
+	roleMetadata, err := u.metadataRepo.GetRoleMetadata(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role metadata: %w", err)
+	}
+	matches := []domain.ObjectSearchMatch{}
+	if roleMetadata == nil {
+		return matches, nil
+	}
+
+	// Only relations the user may read are offered, and only the schemas they may use are searched
+	readable := make(map[string]bool, len(roleMetadata.AccessibleTables))
+	for _, table := range roleMetadata.AccessibleTables {
+		if table.HasSelect {
+			readable[table.Database+"."+table.Schema+"."+table.Name] = true
+		}
+	}
+
+	// A term with a dot is matched against qualified names, such as schema.table or table.column
+	qualified := strings.Contains(term, ".")
+	add := func(match domain.ObjectSearchMatch, qualifier string) {
+		name := match.Name
+		if qualified {
+			name = qualifier + "." + name
+		}
+		if rank, ok := objectMatchRank(name, term); ok {
+			match.Rank = rank
+			matches = append(matches, match)
+		}
+	}
+	addRelations := func(database, schema, kind string, relations []domain.TableMetadata) {
+		for _, relation := range relations {
+			if !readable[database+"."+schema+"."+relation.Name] {
+				continue
+			}
+			add(domain.ObjectSearchMatch{Kind: kind, Database: database, Schema: schema, Name: relation.Name}, schema)
+			for _, column := range relation.Columns {
+				add(domain.ObjectSearchMatch{Kind: domain.ObjectKindColumn, Database: database, Schema: schema, Table: relation.Name, Name: column.Name}, relation.Name)
+			}
+		}
+	}
+
+	for _, database := range roleMetadata.AccessibleDatabases {
+		// Databases whose metadata is not cached yet are left out rather than read live
+		metadata, err := u.metadataRepo.GetMetadata(ctx, database)
+		if err != nil || metadata == nil {
+			continue
+		}
+		for _, schema := range metadata.Schemas {
+			if !slices.Contains(roleMetadata.AccessibleSchemas, schema.Name) {
+				continue
+			}
+			addRelations(database, schema.Name, domain.ObjectKindTable, schema.Tables)
+			addRelations(database, schema.Name, domain.ObjectKindView, schema.Views)
+			for _, function := range schema.Functions {
+				add(domain.ObjectSearchMatch{Kind: domain.ObjectKindFunction, Database: database, Schema: schema.Name, Name: function}, schema.Name)
+			}
+		}
+	}
+
+	// Closest matches first; among equals, relations before columns and shorter names before longer
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Rank != b.Rank {
+			return a.Rank < b.Rank
+		}
+		if objectKindOrder[a.Kind] != objectKindOrder[b.Kind] {
+			return objectKindOrder[a.Kind] < objectKindOrder[b.Kind]
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		return a.Database+"."+a.Schema+"."+a.Table+"."+a.Name < b.Database+"."+b.Schema+"."+b.Table+"."+b.Name
+	})
+	if len(matches) > domain.ObjectSearchMaxResults {
+		matches = matches[:domain.ObjectSearchMaxResults]
+	}
+	return matches, nil
+}
+
+// objectMatchRank ranks how closely a name matches the lowercased term, reporting false when it does
+// not contain the term at all
+func objectMatchRank(name, term string) (int, bool) {
+	name = strings.ToLower(name)
+	switch {
+	case name == term:
+		return domain.ObjectMatchExact, true
+	case strings.HasPrefix(name, term):
+		return domain.ObjectMatchPrefix, true
+	case strings.Contains(name, "_"+term), strings.Contains(name, "."+term):
+		return domain.ObjectMatchWord, true
+	case strings.Contains(name, term):
+		return domain.ObjectMatchContains, true
+	}
+	return 0, false
+}
@@ -0,0 +1,123 @@
+package dataview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// searchableTypes are the column types a table search matches the term against as text; enum columns
+// are searched as well
+var searchableTypes = map[string]bool{
+	"text":              true,
+	"character varying": true,
+	"character":         true,
+	"citext":            true,
+	"name":              true,
+	"uuid":              true,
+	"json":              true,
+	"jsonb":             true,
+	"xml":               true,
+}
+
+// likeEscaper escapes the wildcards of a search term so ILIKE matches it literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (u *DataViewUseCaseImplementation) SearchTableData(ctx context.Context, username, database, schema, table, term string) (*domain.TableSearchResult, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, domain.ValidationError{Field: "search", Message: "search term is required"}
+	}
+	if utf8.RuneCountInString(term) > domain.TableSearchMaxTermLength {
+		return nil, domain.ValidationError{
+			Field:   "search",
+			Message: fmt.Sprintf("search term is longer than %d characters", domain.TableSearchMaxTermLength),
+		}
+	}
+
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encrypted columns hold ciphertext, which the term could only match by accident
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := config.EncryptedColumnsOf(schema, table)
+
+	var searched, predicates []string
+	for _, column := range tableMetadata.Columns {
+		if slices.Contains(encrypted, column.Name) {
+			continue
+		}
+		if !searchableTypes[column.DataType] && column.EnumValues == nil {
+			continue
+		}
+		searched = append(searched, column.Name)
+		predicates = append(predicates, pq.QuoteIdentifier(column.Name)+"::text ILIKE $1")
+	}
+	if len(searched) == 0 {
+		return nil, domain.ValidationError{Field: "search", Message: "table has no text columns to search"}
+	}
+
+	searchCtx, cancel := context.WithTimeout(ctx, domain.TableSearchTimeout)
+	defer cancel()
+	result, err := u.readFilteredTableData(searchCtx, username, domain.TableDataParams{
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		WhereClause: strings.Join(predicates, " OR "),
+		WhereArgs:   []interface{}{"%" + likeEscaper.Replace(term) + "%"},
+		Limit:       domain.TableSearchMaxRows,
+	})
+	if err != nil {
+		if errors.Is(searchCtx.Err(), context.DeadlineExceeded) {
+			return nil, domain.ValidationError{
+				Field:   "search",
+				Message: fmt.Sprintf("search did not finish within %s; filter on a column instead", domain.TableSearchTimeout),
+			}
+		}
+		return nil, err
+	}
+
+	// The database only says a row matched, so the matching columns are found again here
+	needle := strings.ToLower(term)
+	matched := make([][]string, len(result.Rows))
+	for i, row := range result.Rows {
+		matched[i] = []string{}
+		for _, column := range searched {
+			value := row[column]
+			if value != nil && strings.Contains(strings.ToLower(fmt.Sprint(exportValue(value))), needle) {
+				matched[i] = append(matched[i], column)
+			}
+		}
+	}
+
+	return &domain.TableSearchResult{
+		Columns:         result.Columns,
+		Rows:            result.Rows,
+		MatchedColumns:  matched,
+		SearchedColumns: searched,
+		TotalMatches:    result.TotalCount,
+	}, nil
+}
@@ -40,11 +40,18 @@ func (u *DataViewUseCaseImplementation) SortTableData(ctx context.Context, usern
 		Limit:    limit,
 	}
 
+	// Decrypt configured columns for users who may edit them
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, err
+	}
+
 	// Get sorted table data from database
 	result, err := u.databaseRepo.GetTableData(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	maskEncryptedColumns(result, params)
+	u.recordMaskedRead(ctx, username, params, result)
 
 	return result, nil
 }
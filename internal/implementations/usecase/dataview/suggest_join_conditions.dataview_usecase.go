@@ -0,0 +1,50 @@
+package dataview
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) SuggestJoinConditions(ctx context.Context, username, database, leftSchema, leftTable, rightSchema, rightTable string) ([]domain.JoinCondition, error) {
+	// Check if user has SELECT permission on both tables
+	for _, target := range [][2]string{{leftSchema, leftTable}, {rightSchema, rightTable}} {
+		hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, target[0], target[1])
+		if err != nil {
+			return nil, err
+		}
+		if !hasPermission {
+			return nil, domain.ValidationError{
+				Field:   "table",
+				Message: "user does not have SELECT permission on table " + target[1],
+			}
+		}
+	}
+
+	leftMetadata, err := u.findTableMetadata(ctx, database, leftSchema, leftTable)
+	if err != nil {
+		return nil, err
+	}
+	rightMetadata, err := u.findTableMetadata(ctx, database, rightSchema, rightTable)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := []domain.JoinCondition{}
+
+	// Left table referencing the right table
+	for _, fk := range leftMetadata.ForeignKeys {
+		if fk.ReferencedTable == rightTable && (fk.ReferencedSchema == "" || fk.ReferencedSchema == rightSchema) {
+			conditions = append(conditions, domain.JoinCondition{LeftColumn: fk.ColumnName, RightColumn: fk.ReferencedColumn})
+		}
+	}
+
+	// Right table referencing the left table
+	for _, fk := range rightMetadata.ForeignKeys {
+		if fk.ReferencedTable == leftTable && (fk.ReferencedSchema == "" || fk.ReferencedSchema == leftSchema) {
+			conditions = append(conditions, domain.JoinCondition{LeftColumn: fk.ReferencedColumn, RightColumn: fk.ColumnName})
+		}
+	}
+
+	return conditions, nil
+}
@@ -0,0 +1,90 @@
+package dataview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) TakeTableSnapshot(ctx context.Context, username, database, schema, table string) (*domain.TableSnapshot, error) {
+	tableMetadata, result, err := u.readSnapshotRows(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &domain.TableSnapshot{
+		Username:    username,
+		Database:    database,
+		Schema:      schema,
+		Table:       table,
+		PrimaryKeys: tableMetadata.PrimaryKeys,
+		Columns:     result.Columns,
+		Rows:        result.Rows,
+	}
+	if err := u.snapshotRepo.StoreSnapshot(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to store table snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// readSnapshotRows reads every row of a table to snapshot or compare against a snapshot, as the user
+// sees them in the main view. Rows are matched by primary key, so the table needs one, and it is
+// refused outright when it has more than TableSnapshotMaxRows rows rather than compared in part.
+func (u *DataViewUseCaseImplementation) readSnapshotRows(ctx context.Context, username, database, schema, table string) (*domain.TableMetadata, *domain.QueryResult, error) {
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasPermission {
+		return nil, nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	tableMetadata, err := u.findTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tableMetadata.PrimaryKeys) == 0 {
+		return nil, nil, domain.ValidationError{
+			Field:   "table",
+			Message: "table has no primary key to match rows by",
+		}
+	}
+
+	params := domain.TableDataParams{
+		Database: database,
+		Schema:   schema,
+		Table:    table,
+		OrderBy:  tableMetadata.PrimaryKeys[0],
+		OrderDir: domain.SortDirectionASC,
+		Limit:    domain.TableSnapshotMaxRows + 1,
+	}
+	if _, err := u.applyColumnEncryption(ctx, username, &params); err != nil {
+		return nil, nil, err
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	u.recordMaskedRead(ctx, username, params, result)
+	if len(result.Rows) > domain.TableSnapshotMaxRows {
+		return nil, nil, domain.ValidationError{
+			Field:   "table",
+			Message: fmt.Sprintf("table has more than %d rows, too many to snapshot", domain.TableSnapshotMaxRows),
+		}
+	}
+
+	// Byte values are kept as text so they compare and display like the grid's cells
+	for _, row := range result.Rows {
+		for column, value := range row {
+			if data, ok := value.([]byte); ok {
+				row[column] = string(data)
+			}
+		}
+	}
+	return tableMetadata, result, nil
+}
@@ -0,0 +1,255 @@
+package dataview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) WatchRows(ctx context.Context, username string, params domain.RowWatchParams, onEvent func(*domain.RowWatchEvent) error) error {
+	mode := params.Mode
+	if mode == "" {
+		mode = domain.RowWatchModeNotify
+	}
+	if mode != domain.RowWatchModeNotify && mode != domain.RowWatchModePoll {
+		return domain.ValidationError{Field: "mode", Message: fmt.Sprintf("unsupported watch mode: %s", mode)}
+	}
+	interval := domain.LiveRefreshInterval(params.Interval)
+
+	// Without a filter the whole table is watched, which the row cap keeps to small tables
+	if params.WhereClause != "" {
+		valid, err := u.ValidateWhereClause(ctx, params.WhereClause)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return domain.ValidationError{Field: "whereClause", Message: "WHERE clause contains invalid or malicious patterns"}
+		}
+	}
+
+	primaryKeys, err := u.GetPrimaryKeyInfo(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return err
+	}
+
+	// The first load goes through the usual checks, so a refused table is refused before anything is
+	// installed, and leaves the watch's one read receipt
+	watched := watchedRows{params: params, primaryKeys: primaryKeys}
+	rows, err := u.loadWatchedRows(ctx, username, watched, true)
+	if err != nil {
+		return err
+	}
+
+	event := &domain.RowWatchEvent{Sequence: 1, CheckedAt: time.Now(), Mode: mode, MatchingRows: len(rows)}
+
+	// Notifications only wake the watch; the filter is re-checked by diffing, since the trigger cannot
+	// tell which writes touch matching rows
+	wake := make(chan struct{}, 1)
+	var listenErr chan error
+	if mode == domain.RowWatchModeNotify {
+		// Triggers of watches that never got to remove theirs, say because the process stopped, are
+		// swept first; failing to is no reason to refuse this watch
+		u.databaseRepo.RemoveStaleChangeTriggers(ctx)
+
+		channel := domain.RowWatchChannelPrefix + strings.ReplaceAll(uuid.NewString(), "-", "")
+		if err := u.databaseRepo.InstallChangeTrigger(ctx, channel, params.Schema, params.Table); err != nil {
+			mode = domain.RowWatchModePoll
+			event.Mode = mode
+			event.Notice = fmt.Sprintf("change trigger could not be installed, checking every %s instead: %v", interval, err)
+		} else {
+			// The trigger goes with the watch; ctx is already done by then
+			defer u.databaseRepo.RemoveChangeTrigger(context.Background(), channel, params.Schema, params.Table)
+
+			listenCtx, stopListening := context.WithCancel(ctx)
+			defer stopListening()
+			listenErr = make(chan error, 1)
+			go func() {
+				listenErr <- u.databaseRepo.ListenNotifications(listenCtx, channel, func(string) error {
+					select {
+					case wake <- struct{}{}:
+					default:
+					}
+					return nil
+				})
+			}()
+		}
+	}
+
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	var poll <-chan time.Time
+	if mode == domain.RowWatchModePoll {
+		ticker = time.NewTicker(interval)
+		poll = ticker.C
+	}
+
+	if err := onEvent(event); err != nil {
+		return err
+	}
+
+	sequence := event.Sequence
+	for {
+		// The watch ends with the request, which is how a closed tab removes the trigger
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-poll:
+		case <-wake:
+			// Let a burst of writes land before checking once
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(domain.RowWatchSettleDelay):
+			}
+			select {
+			case <-wake:
+			default:
+			}
+		case err := <-listenErr:
+			if ctx.Err() != nil {
+				return nil
+			}
+			listenErr = nil
+			mode = domain.RowWatchModePoll
+			ticker = time.NewTicker(interval)
+			poll = ticker.C
+			sequence++
+			if err := onEvent(&domain.RowWatchEvent{
+				Sequence:     sequence,
+				CheckedAt:    time.Now(),
+				Mode:         mode,
+				Notice:       fmt.Sprintf("lost the notification connection, checking every %s instead: %v", interval, err),
+				MatchingRows: len(rows),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		current, err := u.loadWatchedRows(ctx, username, watched, false)
+		if ctx.Err() != nil {
+			return nil
+		}
+		event := &domain.RowWatchEvent{Sequence: sequence + 1, CheckedAt: time.Now(), Mode: mode, MatchingRows: len(rows)}
+		if err != nil {
+			event.Error = err.Error()
+		} else {
+			changes := diffWatchedRows(rows, current, primaryKeys)
+			if len(changes) == 0 {
+				continue
+			}
+			rows = current
+			event.Changes = changes
+			event.MatchingRows = len(current)
+		}
+
+		sequence = event.Sequence
+		if err := onEvent(event); err != nil {
+			return err
+		}
+	}
+}
+
+// watchedRows is what a row watch loads on each check
+type watchedRows struct {
+	params      domain.RowWatchParams
+	primaryKeys []string
+}
+
+// loadWatchedRows loads the rows matching the watch filter keyed by their primary key, or by the
+// whole row for tables without one, leaving a read receipt when recordRead is set
+func (u *DataViewUseCaseImplementation) loadWatchedRows(ctx context.Context, username string, watched watchedRows, recordRead bool) (map[string]map[string]interface{}, error) {
+	params := domain.TableDataParams{
+		Database:    watched.params.Database,
+		Schema:      watched.params.Schema,
+		Table:       watched.params.Table,
+		WhereClause: watched.params.WhereClause,
+		Limit:       domain.RowWatchMaxRows + 1,
+	}
+	if len(watched.primaryKeys) > 0 {
+		params.OrderBy = watched.primaryKeys[0]
+		params.OrderDir = "ASC"
+	}
+
+	result, read, err := u.loadTableData(ctx, username, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Rows) > domain.RowWatchMaxRows {
+		return nil, domain.ValidationError{
+			Field:   "whereClause",
+			Message: fmt.Sprintf("the filter matches more than %d rows; narrow it to the records to watch", domain.RowWatchMaxRows),
+		}
+	}
+
+	if recordRead {
+		u.recordMaskedRead(ctx, username, read, result)
+	}
+
+	rows := make(map[string]map[string]interface{}, len(result.Rows))
+	for _, row := range result.Rows {
+		rows[rowFingerprint(rowKey(row, watched.primaryKeys))] = row
+	}
+	return rows, nil
+}
+
+// rowKey picks the primary key values out of a row; without a primary key the whole row is its key
+func rowKey(row map[string]interface{}, primaryKeys []string) map[string]interface{} {
+	if len(primaryKeys) == 0 {
+		return row
+	}
+	key := make(map[string]interface{}, len(primaryKeys))
+	for _, column := range primaryKeys {
+		key[column] = row[column]
+	}
+	return key
+}
+
+// diffWatchedRows lists the rows inserted, updated and deleted between two checks, ordered by key
+func diffWatchedRows(before, after map[string]map[string]interface{}, primaryKeys []string) []domain.RowChange {
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []domain.RowChange
+	for _, key := range keys {
+		old, hadRow := before[key]
+		current, hasRow := after[key]
+
+		switch {
+		case !hadRow:
+			changes = append(changes, domain.RowChange{Operation: domain.RowChangeInsert, Key: rowKey(current, primaryKeys), After: current})
+		case !hasRow:
+			changes = append(changes, domain.RowChange{Operation: domain.RowChangeDelete, Key: rowKey(old, primaryKeys), Before: old})
+		case rowFingerprint(old) != rowFingerprint(current):
+			changes = append(changes, domain.RowChange{Operation: domain.RowChangeUpdate, Key: rowKey(current, primaryKeys), Before: old, After: current})
+		}
+	}
+	return changes
+}
+
+// rowFingerprint renders values as JSON, which sorts map keys, so equal rows give equal strings
+func rowFingerprint(values map[string]interface{}) string {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Sprint(values)
+	}
+	return string(data)
+}
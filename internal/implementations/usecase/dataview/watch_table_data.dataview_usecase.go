@@ -0,0 +1,51 @@
+package dataview
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *DataViewUseCaseImplementation) WatchTableData(ctx context.Context, username string, params domain.TableDataParams, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error {
+	interval = domain.LiveRefreshInterval(interval)
+
+	// Watching follows the live table; a frozen view's snapshot would never change
+	params.SnapshotKey = ""
+
+	// The first load goes through the usual checks; a table refused now is refused before anything streams.
+	// Refreshes show the same rows again, so one read receipt covers the whole watch.
+	result, read, err := u.loadTableData(ctx, username, params)
+	if err != nil {
+		return err
+	}
+	u.recordMaskedRead(ctx, username, read, result)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	update := &domain.LiveResultUpdate{Sequence: 1, RefreshedAt: time.Now(), Interval: interval, Result: result}
+	for {
+		if err := onUpdate(update); err != nil {
+			return err
+		}
+
+		// The watch ends with the request, which is how a disconnected tab pauses it
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		update = &domain.LiveResultUpdate{Sequence: update.Sequence + 1, RefreshedAt: time.Now(), Interval: interval}
+		result, _, err := u.loadTableData(ctx, username, params)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			update.Error = err.Error()
+		} else {
+			update.Result = result
+		}
+	}
+}
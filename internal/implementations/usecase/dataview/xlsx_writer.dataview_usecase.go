@@ -0,0 +1,131 @@
+package dataview
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// xlsxStaticParts are the package parts of a single-sheet workbook that do not depend on the data
+var xlsxStaticParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// writeXLSX writes the rows as a single-sheet workbook, numbers as numeric cells and everything else as inline strings
+func writeXLSX(w io.Writer, sheetName string, result *domain.QueryResult) error {
+	archive := zip.NewWriter(w)
+
+	for _, part := range xlsxStaticParts {
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+
+	// Sheet names are limited to 31 characters
+	if len(sheetName) > 31 {
+		sheetName = sheetName[:31]
+	}
+	if sheetName == "" {
+		sheetName = "Sheet1"
+	}
+	workbook, err := archive.Create("xl/workbook.xml")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(workbook, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`+
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`, xmlEscape(sheetName))
+
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriter(sheet)
+	out.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	header := make([]interface{}, len(result.Columns))
+	for i, column := range result.Columns {
+		header[i] = column
+	}
+	writeXLSXRow(out, 1, header)
+	for i, row := range result.Rows {
+		values := make([]interface{}, len(result.Columns))
+		for j, column := range result.Columns {
+			values[j] = exportValue(row[column])
+		}
+		writeXLSXRow(out, i+2, values)
+	}
+
+	out.WriteString(`</sheetData></worksheet>`)
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func writeXLSXRow(out *bufio.Writer, rowNumber int, values []interface{}) {
+	fmt.Fprintf(out, `<row r="%d">`, rowNumber)
+	for i, value := range values {
+		ref := xlsxColumnName(i) + strconv.Itoa(rowNumber)
+		switch v := value.(type) {
+		case nil:
+			continue
+		case int, int32, int64, float32, float64:
+			fmt.Fprintf(out, `<c r="%s"><v>%v</v></c>`, ref, v)
+		case bool:
+			b := 0
+			if v {
+				b = 1
+			}
+			fmt.Fprintf(out, `<c r="%s" t="b"><v>%d</v></c>`, ref, b)
+		default:
+			fmt.Fprintf(out, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, xmlEscape(fmt.Sprintf("%v", v)))
+		}
+	}
+	out.WriteString(`</row>`)
+}
+
+// xlsxColumnName converts a zero-based column index to its spreadsheet letters (0 -> A, 26 -> AA)
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
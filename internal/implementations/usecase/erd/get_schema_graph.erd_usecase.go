@@ -0,0 +1,147 @@
+package erd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ERDUseCaseImplementation) GetSchemaGraph(ctx context.Context, username, database, schema, root string, depth int) (*domain.SchemaGraph, error) {
+	if depth < 0 {
+		return nil, domain.ValidationError{Field: "depth", Message: "depth must not be negative"}
+	}
+
+	// Get database metadata
+	metadata, err := u.metadataRepo.GetMetadata(ctx, database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata: %w", err)
+	}
+
+	if metadata == nil {
+		return nil, fmt.Errorf("database metadata not found")
+	}
+
+	// Find the schema
+	var targetSchema *domain.SchemaMetadata
+	for i := range metadata.Schemas {
+		if metadata.Schemas[i].Name == schema {
+			targetSchema = &metadata.Schemas[i]
+			break
+		}
+	}
+
+	if targetSchema == nil {
+		return nil, fmt.Errorf("schema %s not found", schema)
+	}
+
+	// Filter tables based on RBAC permissions
+	accessible := make(map[string]domain.TableMetadata)
+	var order []string
+	for _, table := range targetSchema.Tables {
+		canAccess, err := u.rbacRepo.CanAccessTable(ctx, username, database, schema, table.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check table access: %w", err)
+		}
+
+		if canAccess {
+			accessible[table.Name] = table
+			order = append(order, table.Name)
+		}
+	}
+
+	// Edges only join accessible tables of this schema; references into other schemas are left out
+	var relationships []domain.ERDRelationship
+	neighbours := make(map[string][]string)
+	for _, name := range order {
+		table := accessible[name]
+		for _, fk := range table.ForeignKeys {
+			if fk.ReferencedSchema != "" && fk.ReferencedSchema != schema {
+				continue
+			}
+			if _, ok := accessible[fk.ReferencedTable]; !ok {
+				continue
+			}
+			relationships = append(relationships, relationshipFor(table, fk))
+			neighbours[name] = append(neighbours[name], fk.ReferencedTable)
+			neighbours[fk.ReferencedTable] = append(neighbours[fk.ReferencedTable], name)
+		}
+	}
+
+	graph := &domain.SchemaGraph{Database: database, Schema: schema, Root: root, Depth: depth}
+
+	included := make(map[string]bool, len(order))
+	if root == "" {
+		for _, name := range order {
+			included[name] = true
+		}
+	} else {
+		if _, ok := accessible[root]; !ok {
+			return nil, domain.ErrTableNotFound
+		}
+
+		// Breadth-first from the root, so each table is reached at its shortest distance
+		included[root] = true
+		frontier := []string{root}
+		for level := 1; len(frontier) > 0 && (depth == 0 || level <= depth); level++ {
+			var next []string
+			for _, name := range frontier {
+				for _, neighbour := range neighbours[name] {
+					if !included[neighbour] {
+						included[neighbour] = true
+						next = append(next, neighbour)
+					}
+				}
+			}
+			frontier = next
+		}
+	}
+
+	for _, name := range order {
+		if included[name] {
+			graph.Tables = append(graph.Tables, erdTableFor(accessible[name]))
+		}
+	}
+	for _, relationship := range relationships {
+		if included[relationship.FromTable] && included[relationship.ToTable] {
+			graph.Relationships = append(graph.Relationships, relationship)
+		}
+	}
+
+	return graph, nil
+}
+
+// relationshipFor describes a foreign key from the referencing table's side: many rows reference one,
+// unless the referencing column is the table's whole primary key, which allows at most one
+func relationshipFor(table domain.TableMetadata, fk domain.ForeignKeyMetadata) domain.ERDRelationship {
+	relationship := domain.ERDRelationship{
+		FromTable:    table.Name,
+		FromColumn:   fk.ColumnName,
+		ToTable:      fk.ReferencedTable,
+		ToColumn:     fk.ReferencedColumn,
+		RelationType: domain.RelationTypeManyToOne,
+	}
+	if len(table.PrimaryKeys) == 1 && table.PrimaryKeys[0] == fk.ColumnName {
+		relationship.RelationType = domain.RelationTypeOneToOne
+	}
+	for _, column := range table.Columns {
+		if column.Name == fk.ColumnName {
+			relationship.Optional = column.IsNullable
+			break
+		}
+	}
+	return relationship
+}
+
+func erdTableFor(table domain.TableMetadata) domain.ERDTable {
+	erdTable := domain.ERDTable{Name: table.Name, PrimaryKeys: table.PrimaryKeys}
+	for _, column := range table.Columns {
+		erdTable.Columns = append(erdTable.Columns, domain.ERDColumn{
+			Name:       column.Name,
+			DataType:   column.DataType,
+			IsNullable: column.IsNullable,
+			IsPrimary:  column.IsPrimary,
+		})
+	}
+	return erdTable
+}
@@ -0,0 +1,141 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *FunctionUseCaseImplementation) ExecuteFunction(ctx context.Context, username string, call domain.FunctionCall, params domain.QueryParams) (*domain.FunctionCallResult, error) {
+	allowed, err := u.rbacRepo.HasFunctionExecutePermission(ctx, username, call.Database, call.Schema, call.Name, call.Arguments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check permissions: %w", err)
+	}
+	if !allowed {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "user does not have EXECUTE permission on this routine",
+		}
+	}
+
+	function, err := u.findFunction(ctx, call.Database, call.Schema, call.Name, call.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	statement, args, err := buildFunctionCall(function, call.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run on the user's own connection like an editor query, so it can be cancelled and the
+	// database applies the user's privileges to whatever the routine touches
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+	if params.Limit > 1000 {
+		params.Limit = 1000
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+	params.Query = statement
+	params.Args = args
+	params.TrackingKey = username
+
+	result, err := u.databaseRepo.ExecuteQueryWithPagination(ctx, params)
+
+	// The statement only holds placeholders, so it is kept as is; the argument values are not
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionQuery,
+		After: map[string]interface{}{
+			"statement": statement,
+			"succeeded": err == nil,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute %s: %w", function.Kind, err)
+	}
+
+	return &domain.FunctionCallResult{Statement: statement, Result: result}, nil
+}
+
+// buildFunctionCall generates the SELECT or CALL statement for a routine, with one placeholder cast to
+// its declared type per given argument, and the values bound to them
+func buildFunctionCall(function *domain.StoredFunction, values []domain.FunctionArgument) (string, []interface{}, error) {
+	procedure := function.Kind == domain.FunctionKindProcedure
+
+	inputs := 0
+	for _, parameter := range function.Parameters {
+		if isInputParameter(parameter) {
+			inputs++
+		}
+	}
+	if len(values) != inputs {
+		return "", nil, domain.ValidationError{
+			Field:   "arguments",
+			Message: fmt.Sprintf("%s takes %d input arguments, got %d", function.Name, inputs, len(values)),
+		}
+	}
+
+	var placeholders []string
+	var args []interface{}
+	next := 0
+	defaulted := false
+	for _, parameter := range function.Parameters {
+		if !isInputParameter(parameter) {
+			// Procedures take NULL for their output parameters; functions leave them out
+			if procedure && parameter.Mode == domain.FunctionParameterModeOut {
+				if defaulted {
+					return "", nil, domain.ValidationError{Field: "arguments", Message: "only the last arguments can use their defaults"}
+				}
+				placeholders = append(placeholders, "NULL::"+parameter.DataType)
+			}
+			continue
+		}
+
+		value := values[next]
+		next++
+		if value.Default {
+			if !parameter.HasDefault {
+				return "", nil, domain.ValidationError{Field: "arguments", Message: fmt.Sprintf("argument %d has no default", next)}
+			}
+			defaulted = true
+			continue
+		}
+		// Positional arguments can only leave the trailing ones to their defaults
+		if defaulted {
+			return "", nil, domain.ValidationError{Field: "arguments", Message: "only the last arguments can use their defaults"}
+		}
+
+		placeholder := fmt.Sprintf("$%d::%s", len(args)+1, parameter.DataType)
+		if parameter.Mode == domain.FunctionParameterModeVariadic {
+			placeholder = "VARIADIC " + placeholder
+		}
+		placeholders = append(placeholders, placeholder)
+		if value.Null {
+			args = append(args, nil)
+		} else {
+			args = append(args, value.Value)
+		}
+	}
+
+	name := pq.QuoteIdentifier(function.Schema) + "." + pq.QuoteIdentifier(function.Name)
+	if procedure {
+		return "CALL " + name + "(" + strings.Join(placeholders, ", ") + ")", args, nil
+	}
+	return "SELECT * FROM " + name + "(" + strings.Join(placeholders, ", ") + ")", args, nil
+}
+
+// isInputParameter reports whether a call passes a value for the parameter
+func isInputParameter(parameter domain.FunctionParameter) bool {
+	switch parameter.Mode {
+	case domain.FunctionParameterModeIn, domain.FunctionParameterModeInOut, domain.FunctionParameterModeVariadic:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package function
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// findFunction looks up one of a schema's routines by name and identity signature
+func (u *FunctionUseCaseImplementation) findFunction(ctx context.Context, database, schema, name, arguments string) (*domain.StoredFunction, error) {
+	functions, err := u.databaseRepo.ListFunctions(ctx, database, schema)
+	if err != nil {
+		return nil, err
+	}
+	for i := range functions {
+		if functions[i].Name == name && functions[i].Arguments == arguments {
+			return &functions[i], nil
+		}
+	}
+	return nil, domain.ErrFunctionNotFound
+}
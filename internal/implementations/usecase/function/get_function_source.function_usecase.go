@@ -0,0 +1,10 @@
+package function
+
+import (
+	"context"
+)
+
+func (u *FunctionUseCaseImplementation) GetFunctionSource(ctx context.Context, username, database, schema, name, arguments string) (string, error) {
+	// Routine sources are readable from pg_proc by every role, so no privilege is checked here
+	return u.databaseRepo.GetFunctionDefinition(ctx, database, schema, name, arguments)
+}
@@ -0,0 +1,24 @@
+package function
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *FunctionUseCaseImplementation) ListFunctions(ctx context.Context, username, database, schema string) ([]domain.StoredFunction, error) {
+	functions, err := u.databaseRepo.ListFunctions(ctx, database, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range functions {
+		allowed, err := u.rbacRepo.HasFunctionExecutePermission(ctx, username, database, schema, functions[i].Name, functions[i].Arguments)
+		if err != nil {
+			return nil, err
+		}
+		functions[i].CanExecute = allowed
+	}
+
+	return functions, nil
+}
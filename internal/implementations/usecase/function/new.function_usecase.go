@@ -0,0 +1,24 @@
+package function
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type FunctionUseCaseImplementation struct {
+	databaseRepo repository.DatabaseRepository
+	rbacRepo     repository.RBACRepository
+	auditRepo    repository.AuditRepository
+}
+
+func NewFunctionUseCaseImplementation(
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+) usecase.FunctionUseCase {
+	return &FunctionUseCaseImplementation{
+		databaseRepo: databaseRepo,
+		rbacRepo:     rbacRepo,
+		auditRepo:    auditRepo,
+	}
+}
@@ -0,0 +1,11 @@
+package function
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestFunctionUsecase(t *testing.T) {
+	testRunner.FunctionUsecaseRunner(t, NewFunctionUseCaseImplementation)
+}
@@ -0,0 +1,109 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *LocksUseCaseImplementation) GetBlockingChains(ctx context.Context, username string) (*domain.LockReport, error) {
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check superuser status: %w", err)
+	}
+
+	waits, err := u.monitoringRepo.GetLockWaits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.LockReport{TakenAt: time.Now(), CanTerminate: isSuperuser}
+	chains := buildBlockingChains(waits)
+	if isSuperuser {
+		report.Chains = chains
+		return report, nil
+	}
+
+	databases, err := u.rbacRepo.GetAccessibleDatabases(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accessible databases: %w", err)
+	}
+	visible := make(map[string]bool, len(databases))
+	for _, database := range databases {
+		visible[database] = true
+	}
+
+	for _, chain := range chains {
+		if !visible[chain.Database] {
+			continue
+		}
+		// The app reads pg_stat_activity as a privileged role, so hide what PostgreSQL would hide
+		chain.Root = hideForeignBackend(chain.Root, username)
+		for i := range chain.Waiters {
+			chain.Waiters[i].Backend = hideForeignBackend(chain.Waiters[i].Backend, username)
+		}
+		report.Chains = append(report.Chains, chain)
+	}
+	return report, nil
+}
+
+// buildBlockingChains walks the waits from each backend that blocks others without waiting itself.
+// Backends waiting on each other in a cycle have no such root; PostgreSQL's deadlock detector ends
+// those within deadlock_timeout, so they are left out.
+func buildBlockingChains(waits []domain.LockWait) []domain.BlockingChain {
+	blocked := make(map[int]bool, len(waits))
+	waitersOf := make(map[int][]domain.LockWait)
+	for _, wait := range waits {
+		blocked[wait.Blocked.PID] = true
+		waitersOf[wait.Blocking.PID] = append(waitersOf[wait.Blocking.PID], wait)
+	}
+
+	var chains []domain.BlockingChain
+	seenRoots := make(map[int]bool)
+	for _, wait := range waits {
+		root := wait.Blocking
+		if blocked[root.PID] || seenRoots[root.PID] {
+			continue
+		}
+		seenRoots[root.PID] = true
+
+		chain := domain.BlockingChain{Database: root.Database, Root: root}
+		// A backend queued behind several holders is listed once, under the first it was found waiting on
+		placed := map[int]bool{root.PID: true}
+		queue := []int{root.PID}
+		for depth := 1; len(queue) > 0; depth++ {
+			var next []int
+			for _, pid := range queue {
+				for _, waiter := range waitersOf[pid] {
+					if placed[waiter.Blocked.PID] {
+						continue
+					}
+					placed[waiter.Blocked.PID] = true
+					chain.Waiters = append(chain.Waiters, domain.BlockedBackend{
+						Backend:   waiter.Blocked,
+						BlockedBy: pid,
+						Depth:     depth,
+						LockType:  waiter.LockType,
+						Mode:      waiter.Mode,
+						Relation:  waiter.Relation,
+					})
+					next = append(next, waiter.Blocked.PID)
+				}
+			}
+			queue = next
+		}
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
+// hideForeignBackend blanks the query and client of a backend the user does not own
+func hideForeignBackend(backend domain.BackendActivity, username string) domain.BackendActivity {
+	if backend.Username != username {
+		backend.Query = ""
+		backend.ClientAddr = ""
+	}
+	return backend
+}
@@ -0,0 +1,21 @@
+package locks
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type LocksUseCaseImplementation struct {
+	monitoringRepo repository.MonitoringRepository
+	rbacRepo       repository.RBACRepository
+}
+
+func NewLocksUseCaseImplementation(
+	monitoringRepo repository.MonitoringRepository,
+	rbacRepo repository.RBACRepository,
+) usecase.LocksUseCase {
+	return &LocksUseCaseImplementation{
+		monitoringRepo: monitoringRepo,
+		rbacRepo:       rbacRepo,
+	}
+}
@@ -0,0 +1,11 @@
+package locks
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestLocksUsecase(t *testing.T) {
+	testRunner.LocksUsecaseRunner(t, NewLocksUseCaseImplementation)
+}
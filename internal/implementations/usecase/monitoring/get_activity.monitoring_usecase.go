@@ -0,0 +1,49 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *MonitoringUseCaseImplementation) GetActivity(ctx context.Context, username string) (*domain.ActivitySnapshot, error) {
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check superuser status: %w", err)
+	}
+
+	backends, err := u.monitoringRepo.GetBackendActivity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &domain.ActivitySnapshot{TakenAt: time.Now(), CanTerminate: isSuperuser}
+	if isSuperuser {
+		snapshot.Backends = backends
+		return snapshot, nil
+	}
+
+	databases, err := u.rbacRepo.GetAccessibleDatabases(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accessible databases: %w", err)
+	}
+	visible := make(map[string]bool, len(databases))
+	for _, database := range databases {
+		visible[database] = true
+	}
+
+	for _, backend := range backends {
+		if !visible[backend.Database] {
+			continue
+		}
+		// The app reads pg_stat_activity as a privileged role, so hide what PostgreSQL would hide
+		if backend.Username != username {
+			backend.Query = ""
+			backend.ClientAddr = ""
+		}
+		snapshot.Backends = append(snapshot.Backends, backend)
+	}
+	return snapshot, nil
+}
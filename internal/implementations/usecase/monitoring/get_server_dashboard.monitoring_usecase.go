@@ -0,0 +1,98 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *MonitoringUseCaseImplementation) GetServerDashboard(ctx context.Context, adminUsername string) (*domain.ServerDashboard, error) {
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, adminUsername)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check superuser status: %w", err)
+	}
+	if !isSuperuser {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "only superusers can view server statistics",
+		}
+	}
+
+	stats, err := u.monitoringRepo.GetServerStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server statistics: %w", err)
+	}
+
+	u.statsMu.Lock()
+	previous := u.previousStats
+	u.previousStats = stats
+	u.statsMu.Unlock()
+
+	return buildServerDashboard(stats, previous), nil
+}
+
+// buildServerDashboard derives rates and totals from a sample; transaction rates need an earlier sample,
+// and are left out for a database whose counters were reset since it
+func buildServerDashboard(stats, previous *domain.ServerStats) *domain.ServerDashboard {
+	dashboard := &domain.ServerDashboard{
+		TakenAt:          stats.TakenAt,
+		MaxConnections:   stats.MaxConnections,
+		BackgroundWriter: stats.BackgroundWriter,
+		Databases:        make([]domain.DatabaseDashboardRow, 0, len(stats.Databases)),
+	}
+
+	var elapsed float64
+	earlier := make(map[string]domain.DatabaseStats)
+	if previous != nil {
+		elapsed = stats.TakenAt.Sub(previous.TakenAt).Seconds()
+		for _, database := range previous.Databases {
+			earlier[database.Name] = database
+		}
+	}
+
+	var totalTransactions, totalHit, totalRead int64
+	measured := elapsed > 0
+	for _, database := range stats.Databases {
+		row := domain.DatabaseDashboardRow{
+			Stats:         database,
+			CacheHitRatio: cacheHitRatio(database.BlocksHit, database.BlocksRead),
+		}
+
+		before, seen := earlier[database.Name]
+		transactions := database.XactCommit + database.XactRollback - before.XactCommit - before.XactRollback
+		if elapsed > 0 && seen && transactions >= 0 {
+			rate := float64(transactions) / elapsed
+			row.TransactionsPerSecond = &rate
+			totalTransactions += transactions
+		} else {
+			measured = false
+		}
+
+		dashboard.TotalConnections += database.Connections
+		if database.SizeBytes != nil {
+			dashboard.TotalSizeBytes += *database.SizeBytes
+		}
+		totalHit += database.BlocksHit
+		totalRead += database.BlocksRead
+		dashboard.Databases = append(dashboard.Databases, row)
+	}
+
+	// The server-wide rate is only given when every database could be measured, so it never undercounts
+	if measured {
+		rate := float64(totalTransactions) / elapsed
+		dashboard.TransactionsPerSecond = &rate
+	}
+	dashboard.CacheHitRatio = cacheHitRatio(totalHit, totalRead)
+
+	return dashboard
+}
+
+// cacheHitRatio is the share of block reads found in shared buffers, or nil before any block is read
+func cacheHitRatio(hit, read int64) *float64 {
+	if hit+read == 0 {
+		return nil
+	}
+	ratio := float64(hit) / float64(hit+read)
+	return &ratio
+}
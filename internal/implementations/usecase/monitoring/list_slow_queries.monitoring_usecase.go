@@ -0,0 +1,27 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *MonitoringUseCaseImplementation) ListSlowQueries(ctx context.Context, adminUsername string, limit int) ([]domain.SlowQuery, error) {
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, adminUsername)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check superuser status: %w", err)
+	}
+	if !isSuperuser {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "only superusers can view the slow-query log",
+		}
+	}
+
+	if limit < 0 {
+		return nil, domain.ValidationError{Field: "limit", Message: "limit must not be negative"}
+	}
+
+	return u.loggerRepo.GetSlowQueries(ctx, limit)
+}
@@ -0,0 +1,37 @@
+package monitoring
+
+import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type MonitoringUseCaseImplementation struct {
+	monitoringRepo repository.MonitoringRepository
+	rbacRepo       repository.RBACRepository
+	auditRepo      repository.AuditRepository
+	slowOpRepo     repository.SlowOperationRepository
+	loggerRepo     repository.LoggerRepository
+
+	// previousStats is the last server statistics sample, which transaction rates are measured against
+	statsMu       sync.Mutex
+	previousStats *domain.ServerStats
+}
+
+func NewMonitoringUseCaseImplementation(
+	monitoringRepo repository.MonitoringRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+	slowOpRepo repository.SlowOperationRepository,
+	loggerRepo repository.LoggerRepository,
+) usecase.MonitoringUseCase {
+	return &MonitoringUseCaseImplementation{
+		monitoringRepo: monitoringRepo,
+		rbacRepo:       rbacRepo,
+		auditRepo:      auditRepo,
+		slowOpRepo:     slowOpRepo,
+		loggerRepo:     loggerRepo,
+	}
+}
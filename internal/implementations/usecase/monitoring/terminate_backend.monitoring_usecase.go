@@ -0,0 +1,58 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *MonitoringUseCaseImplementation) TerminateBackend(ctx context.Context, adminUsername string, pid int) error {
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, adminUsername)
+	if err != nil {
+		return fmt.Errorf("failed to check superuser status: %w", err)
+	}
+	if !isSuperuser {
+		return domain.ValidationError{
+			Field:   "permission",
+			Message: "only superusers can terminate backends",
+		}
+	}
+
+	// Look the backend up first so the audit log says whose work was ended
+	backends, err := u.monitoringRepo.GetBackendActivity(ctx)
+	if err != nil {
+		return err
+	}
+	var target *domain.BackendActivity
+	for i := range backends {
+		if backends[i].PID == pid {
+			target = &backends[i]
+			break
+		}
+	}
+	if target == nil {
+		return domain.ErrBackendNotFound
+	}
+
+	terminated, err := u.monitoringRepo.TerminateBackend(ctx, pid)
+	if err != nil {
+		return err
+	}
+	if !terminated {
+		return domain.ErrBackendNotFound
+	}
+
+	return u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionTerminateBackend,
+		Target:   strconv.Itoa(pid),
+		Database: target.Database,
+		Before: map[string]interface{}{
+			"username": target.Username,
+			"state":    target.State,
+			"query":    target.Query,
+		},
+	})
+}
@@ -0,0 +1,11 @@
+package monitoring
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestMonitoringUsecase(t *testing.T) {
+	testRunner.MonitoringUsecaseRunner(t, NewMonitoringUseCaseImplementation)
+}
@@ -0,0 +1,146 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) BuildChartData(ctx context.Context, username, query string) (*domain.ChartData, error) {
+	result, err := u.ExecuteQuery(ctx, username, query, 0, domain.ChartMaxPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	// Charts need one label column followed by at least one numeric series
+	if len(result.Columns) < 2 {
+		return nil, domain.ValidationError{Field: "query", Message: "chart data needs a label column followed by at least one numeric column"}
+	}
+
+	labelColumn := result.Columns[0]
+	chart := &domain.ChartData{
+		LabelColumn: labelColumn,
+		Labels:      make([]string, len(result.Rows)),
+		Series:      make([]domain.ChartSeries, len(result.Columns)-1),
+	}
+	for i, column := range result.Columns[1:] {
+		chart.Series[i] = domain.ChartSeries{Name: column, Values: make([]*float64, len(result.Rows))}
+	}
+
+	labels := make([]interface{}, len(result.Rows))
+	for rowIndex, row := range result.Rows {
+		labels[rowIndex] = row[labelColumn]
+		chart.Labels[rowIndex] = chartLabel(row[labelColumn])
+
+		for i, column := range result.Columns[1:] {
+			value, ok := chartValue(row[column])
+			if !ok {
+				return nil, domain.ValidationError{Field: "query", Message: fmt.Sprintf("column %s is not numeric", column)}
+			}
+			chart.Series[i].Values[rowIndex] = value
+		}
+	}
+
+	chart.SuggestedType = suggestChartType(labels)
+	return chart, nil
+}
+
+// chartValue converts a result cell into a series value, keeping NULL as nil
+func chartValue(value interface{}) (*float64, bool) {
+	var number float64
+	switch v := value.(type) {
+	case nil:
+		return nil, true
+	case int:
+		number = float64(v)
+	case int32:
+		number = float64(v)
+	case int64:
+		number = float64(v)
+	case float32:
+		number = float64(v)
+	case float64:
+		number = v
+	case []byte:
+		parsed, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return nil, false
+		}
+		number = parsed
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, false
+		}
+		number = parsed
+	default:
+		return nil, false
+	}
+	return &number, true
+}
+
+func chartLabel(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// suggestChartType picks a line chart for time or ascending numeric labels and a bar chart otherwise
+func suggestChartType(labels []interface{}) string {
+	if len(labels) < 2 {
+		return domain.ChartTypeBar
+	}
+
+	temporal := true
+	ascending := true
+	previous := 0.0
+	for i, label := range labels {
+		if !isTemporalLabel(label) {
+			temporal = false
+		}
+		value, ok := chartValue(label)
+		if !ok || value == nil || (i > 0 && *value <= previous) {
+			ascending = false
+		} else {
+			previous = *value
+		}
+	}
+
+	if temporal || ascending {
+		return domain.ChartTypeLine
+	}
+	return domain.ChartTypeBar
+}
+
+func isTemporalLabel(label interface{}) bool {
+	var text string
+	switch v := label.(type) {
+	case time.Time:
+		return true
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return false
+	}
+
+	text = strings.TrimSpace(text)
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if _, err := time.Parse(layout, text); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// errReportTooLarge stops reading a result once it has more rows than a report holds
+var errReportTooLarge = errors.New("report row limit reached")
+
+func (u *QueryUseCaseImplementation) BuildQueryReport(ctx context.Context, username string, params domain.QueryParams, title string) (*domain.ResultReport, error) {
+	// A report hands the rows over like an export does, so the export policy applies to it
+	if err := u.checkQueryExport(ctx, username, params); err != nil {
+		return nil, err
+	}
+	params.TrackingKey = username
+
+	report := &domain.ResultReport{
+		Title:       strings.TrimSpace(title),
+		Source:      strings.TrimSpace(params.Query),
+		Filter:      params.WhereClause,
+		GeneratedBy: username,
+		GeneratedAt: time.Now(),
+	}
+	if report.Title == "" {
+		report.Title = "Query result"
+	}
+	if params.OrderBy != "" {
+		report.Sort = strings.TrimSpace(params.OrderBy + " " + strings.ToUpper(params.OrderDir))
+	}
+
+	err := u.databaseRepo.StreamQuery(ctx, params,
+		func(columns []string) error {
+			report.Columns = columns
+			return nil
+		},
+		func(values []interface{}) error {
+			if len(report.Rows) == domain.ReportMaxRows {
+				return errReportTooLarge
+			}
+			row := make([]*string, len(values))
+			for i, value := range values {
+				if value != nil {
+					text := csvValue(value)
+					row[i] = &text
+				}
+			}
+			report.Rows = append(report.Rows, row)
+			return nil
+		},
+	)
+	if errors.Is(err, errReportTooLarge) {
+		return nil, domain.ValidationError{
+			Field:   "limit",
+			Message: fmt.Sprintf("report exceeds the limit of %d rows; narrow the filter", domain.ReportMaxRows),
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionExport,
+		After: map[string]interface{}{
+			"format":    domain.ExportFormatReport,
+			"statement": normalizeStatement(params.Query),
+			"rows":      len(report.Rows),
+		},
+	})
+	return report, nil
+}
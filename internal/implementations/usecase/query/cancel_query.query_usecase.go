@@ -0,0 +1,15 @@
+package query
+
+import (
+	"context"
+	"fmt"
+)
+
+func (u *QueryUseCaseImplementation) CancelQuery(ctx context.Context, username string) (bool, error) {
+	cancelled, err := u.databaseRepo.CancelTrackedQuery(ctx, username)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel query: %w", err)
+	}
+
+	return cancelled, nil
+}
@@ -47,6 +47,9 @@ func (u *QueryUseCaseImplementation) ExecuteMultipleQueries(ctx context.Context,
 
 	// Execute multiple queries using database repository
 	results, err := u.databaseRepo.ExecuteMultipleQueries(ctx, queries)
+	for _, query := range splitQueries {
+		u.recordQueryActivity(ctx, username, query, err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute queries: %w", err)
 	}
@@ -33,8 +33,9 @@ func (u *QueryUseCaseImplementation) ExecuteQuery(ctx context.Context, username,
 		return nil, fmt.Errorf("access denied: user does not have SELECT permission")
 	}
 
-	// Execute the query with the database repository
-	result, err := u.databaseRepo.ExecuteQuery(ctx, query, nil)
+	// Execute the query under the username so it can be cancelled
+	result, err := u.databaseRepo.ExecuteTrackedQuery(ctx, username, query)
+	u.recordQueryActivity(ctx, username, query, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
@@ -51,10 +52,22 @@ func (u *QueryUseCaseImplementation) ExecuteQueryWithPagination(ctx context.Cont
 	// Update params with corrected values
 	params.Offset = offset
 	params.Limit = limit
+	params.TrackingKey = username
+
+	// A fresh run fetches the whole accessible window once, so it can be kept for re-sorting and
+	// filtering, and pages it here
+	fresh := offset == 0
+	if fresh {
+		params.Limit = domain.ResultCacheRows
+	}
 
 	// Execute the query with pagination
+	started := time.Now()
 	result, err := u.databaseRepo.ExecuteQueryWithPagination(ctx, params)
+	u.recordSlowQuery(ctx, username, params.Query, time.Since(started), err)
+	u.recordQueryActivity(ctx, username, params.Query, err)
 	if err != nil {
+		u.publishEditorRun(username, params.Query, nil, err)
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
 
@@ -62,5 +75,17 @@ func (u *QueryUseCaseImplementation) ExecuteQueryWithPagination(ctx context.Cont
 		return nil, fmt.Errorf("unexpected nil result from database")
 	}
 
+	if fresh {
+		cached := &domain.CachedResult{Username: username, Query: params.Query, Columns: result.Columns, Rows: result.Rows}
+		if err := u.resultCache.StoreResult(ctx, cached); err == nil {
+			result.ResultID = cached.ID
+		}
+		if len(result.Rows) > limit {
+			result.Rows = result.Rows[:limit]
+		}
+		result.RowCount = int64(len(result.Rows))
+	}
+
+	u.publishEditorRun(username, params.Query, result, nil)
 	return result, nil
 }
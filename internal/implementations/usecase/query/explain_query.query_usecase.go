@@ -0,0 +1,68 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) ExplainQuery(ctx context.Context, username, query string, analyze bool) (*domain.QueryPlan, error) {
+	// Only a single statement can be explained
+	statements, err := u.SplitQueries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, domain.ValidationError{Field: "query", Message: "query cannot be empty"}
+	}
+	if len(statements) > 1 {
+		return nil, domain.ValidationError{Field: "query", Message: "only a single statement can be explained"}
+	}
+
+	// Check RBAC permissions for SELECT
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check permissions: %w", err)
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"}
+	}
+
+	// ANALYZE executes the statement, so it is reserved for users who may write
+	if analyze {
+		canWrite, err := u.hasWritePermission(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check permissions: %w", err)
+		}
+		if !canWrite {
+			return nil, domain.ValidationError{Field: "permission", Message: "EXPLAIN ANALYZE requires write permission"}
+		}
+	}
+
+	plan, err := u.databaseRepo.ExplainQuery(ctx, statements[0], analyze)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+
+	return plan, nil
+}
+
+// hasWritePermission reports whether the user holds any of INSERT, UPDATE or DELETE
+func (u *QueryUseCaseImplementation) hasWritePermission(ctx context.Context, username string) (bool, error) {
+	checks := []func(ctx context.Context, role, database, schema, table string) (bool, error){
+		u.rbacRepo.HasInsertPermission,
+		u.rbacRepo.HasUpdatePermission,
+		u.rbacRepo.HasDeletePermission,
+	}
+	for _, check := range checks {
+		allowed, err := check(ctx, username, "", "", "")
+		if err != nil {
+			return false, err
+		}
+		if allowed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
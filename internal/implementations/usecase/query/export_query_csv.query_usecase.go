@@ -0,0 +1,132 @@
+package query
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) ExportQueryCSV(ctx context.Context, username string, params domain.QueryParams, w io.Writer) error {
+	if err := u.checkQueryExport(ctx, username, params); err != nil {
+		return err
+	}
+
+	// Track the export under the username so it can be cancelled like any other query
+	params.TrackingKey = username
+
+	// COPY TO streams the CSV straight from the server; scanning row by row remains for connections
+	// COPY cannot be run on
+	rowCount, err := u.databaseRepo.CopyQueryCSV(ctx, params, w)
+	if errors.Is(err, domain.ErrCopyUnavailable) {
+		rowCount, err = u.streamQueryCSV(ctx, params, w)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Like queries, exports are kept for the user's account activity without their constants
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionExport,
+		After: map[string]interface{}{
+			"format":    "csv",
+			"statement": normalizeStatement(params.Query),
+			"rows":      int(rowCount),
+		},
+	})
+	return nil
+}
+
+// streamQueryCSV writes the result as CSV by scanning it row by row, flushing every ExportFlushRows rows
+func (u *QueryUseCaseImplementation) streamQueryCSV(ctx context.Context, params domain.QueryParams, w io.Writer) (int64, error) {
+	writer := csv.NewWriter(w)
+	var rowCount int64
+	err := u.databaseRepo.StreamQuery(ctx, params,
+		func(columns []string) error {
+			return writer.Write(columns)
+		},
+		func(values []interface{}) error {
+			record := make([]string, len(values))
+			for i, value := range values {
+				record[i] = csvValue(value)
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+
+			// Flush periodically so large results reach the client in chunks
+			rowCount++
+			if rowCount%domain.ExportFlushRows == 0 {
+				writer.Flush()
+				return writer.Error()
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	writer.Flush()
+	return rowCount, writer.Error()
+}
+
+// checkQueryExport refuses exports of anything but a SELECT, filters that could end the wrapped
+// query, and users the SELECT permission or export policy does not allow
+func (u *QueryUseCaseImplementation) checkQueryExport(ctx context.Context, username string, params domain.QueryParams) error {
+	if strings.TrimSpace(params.Query) == "" {
+		return domain.ValidationError{Field: "query", Message: "query cannot be empty"}
+	}
+
+	// Only SELECT results can be exported
+	isSelect, err := u.IsSelectQuery(ctx, params.Query)
+	if err != nil {
+		return fmt.Errorf("failed to check query type: %w", err)
+	}
+	if !isSelect {
+		return domain.ValidationError{Field: "query", Message: "only SELECT queries can be exported"}
+	}
+
+	// The filter is appended to the wrapped query, so it must stay a single expression
+	if strings.Contains(params.WhereClause, ";") || strings.Contains(params.WhereClause, "--") {
+		return domain.ValidationError{Field: "whereClause", Message: "WHERE clause contains invalid or malicious patterns"}
+	}
+
+	// Check RBAC permissions for SELECT
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, "", "", "")
+	if err != nil {
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+	if !hasPermission {
+		return domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"}
+	}
+
+	// A query may read any table, so a table the user may not export refuses every query export
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get export policy: %w", err)
+	}
+	if !config.QueryExportAllowed(username) {
+		return domain.ValidationError{Field: "permission", Message: "query exports are not allowed for this user"}
+	}
+	return nil
+}
+
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
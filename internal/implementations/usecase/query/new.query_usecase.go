@@ -1,6 +1,9 @@
 package query
 
 import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
 )
@@ -8,14 +11,34 @@ import (
 type QueryUseCaseImplementation struct {
 	databaseRepo repository.DatabaseRepository
 	rbacRepo     repository.RBACRepository
+	configRepo   repository.ConfigRepository
+	loggerRepo   repository.LoggerRepository
+	auditRepo    repository.AuditRepository
+	resultCache  repository.ResultCacheRepository
+
+	// shares keeps the open editor invites by token, and sharedRuns the latest run of each user sharing
+	// their editor
+	sharesMu   sync.Mutex
+	shares     map[string]domain.EditorShare
+	sharedRuns map[string]domain.EditorShareUpdate
 }
 
 func NewQueryUseCaseImplementation(
 	databaseRepo repository.DatabaseRepository,
 	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	loggerRepo repository.LoggerRepository,
+	auditRepo repository.AuditRepository,
+	resultCache repository.ResultCacheRepository,
 ) usecase.QueryUseCase {
 	return &QueryUseCaseImplementation{
 		databaseRepo: databaseRepo,
 		rbacRepo:     rbacRepo,
+		configRepo:   configRepo,
+		loggerRepo:   loggerRepo,
+		auditRepo:    auditRepo,
+		resultCache:  resultCache,
+		shares:       make(map[string]domain.EditorShare),
+		sharedRuns:   make(map[string]domain.EditorShareUpdate),
 	}
 }
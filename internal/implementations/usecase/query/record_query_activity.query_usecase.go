@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// recordQueryActivity keeps an editor query in the audit log for its user's account activity. Only the
+// statement with its constants replaced is kept, and neither its error, which may quote values. The log
+// is informational here, so failing to record never fails the query.
+func (u *QueryUseCaseImplementation) recordQueryActivity(ctx context.Context, username, query string, queryErr error) {
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   domain.AuditActionQuery,
+		After: map[string]interface{}{
+			"statement": normalizeStatement(query),
+			"succeeded": queryErr == nil,
+		},
+	})
+}
@@ -0,0 +1,201 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// recordSlowQuery keeps an editor query that ran past the slow-query threshold in the logger's slow-query
+// log, with its estimated plan. The log is for admins, so failing to explain or record never fails the query.
+func (u *QueryUseCaseImplementation) recordSlowQuery(ctx context.Context, username, query string, elapsed time.Duration, queryErr error) {
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil || config == nil {
+		return
+	}
+	threshold := config.SlowQueryThreshold
+	if threshold <= 0 {
+		threshold = domain.DefaultSlowQueryThreshold
+	}
+	if elapsed < threshold {
+		return
+	}
+
+	slow := &domain.SlowQuery{
+		Username:  username,
+		Statement: normalizeStatement(query),
+		Duration:  elapsed,
+	}
+	if queryErr != nil {
+		slow.Error = queryErr.Error()
+	}
+	if plan, err := u.databaseRepo.ExplainQuery(ctx, query, false); err == nil {
+		slow.Plan = plan
+	}
+
+	u.loggerRepo.LogSlowQuery(ctx, slow)
+}
+
+// normalizeStatement replaces a statement's string and numeric constants with $n placeholders, drops its
+// comments and collapses whitespace, so runs of one statement with different values read alike. Quoted
+// identifiers and existing placeholders are kept; new placeholders are numbered after the highest existing one.
+func normalizeStatement(query string) string {
+	const marker = '\x00'
+
+	var out strings.Builder
+	highest := 0
+	pendingSpace := false
+	write := func(text string) {
+		if pendingSpace && out.Len() > 0 {
+			out.WriteByte(' ')
+		}
+		pendingSpace = false
+		out.WriteString(text)
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			pendingSpace = true
+			i++
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			pendingSpace = true
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += end + 4
+			}
+			pendingSpace = true
+		case c == '\'':
+			i = skipQuoted(query, i, '\'', false)
+			write(string(marker))
+		case c == '"':
+			end := skipQuoted(query, i, '"', false)
+			write(query[i:end])
+			i = end
+		case c == '$':
+			end := i + 1
+			for end < len(query) && query[end] >= '0' && query[end] <= '9' {
+				end++
+			}
+			if end > i+1 {
+				// An existing $n placeholder
+				if n, err := strconv.Atoi(query[i+1 : end]); err == nil && n > highest {
+					highest = n
+				}
+				write(query[i:end])
+				i = end
+				break
+			}
+			if tagEnd := dollarTagEnd(query, i); tagEnd > 0 {
+				tag := query[i:tagEnd]
+				closing := strings.Index(query[tagEnd:], tag)
+				if closing < 0 {
+					i = len(query)
+				} else {
+					i = tagEnd + closing + len(tag)
+				}
+				write(string(marker))
+				break
+			}
+			write("$")
+			i++
+		case isDigit(c) || (c == '.' && i+1 < len(query) && isDigit(query[i+1])):
+			end := i
+			for end < len(query) && (isDigit(query[end]) || query[end] == '.') {
+				end++
+			}
+			if end < len(query) && (query[end] == 'e' || query[end] == 'E') {
+				exp := end + 1
+				if exp < len(query) && (query[exp] == '+' || query[exp] == '-') {
+					exp++
+				}
+				if exp < len(query) && isDigit(query[exp]) {
+					for end = exp; end < len(query) && isDigit(query[end]); end++ {
+					}
+				}
+			}
+			write(string(marker))
+			i = end
+		case strings.IndexByte("eEbBxXnN", c) >= 0 && i+1 < len(query) && query[i+1] == '\'' &&
+			(i == 0 || !isIdentifierChar(query[i-1]) && !isDigit(query[i-1])):
+			// A prefixed string such as E'\n' or B'101'
+			i = skipQuoted(query, i+1, '\'', c == 'e' || c == 'E')
+			write(string(marker))
+		case isIdentifierChar(c):
+			end := i
+			for end < len(query) && (isIdentifierChar(query[end]) || isDigit(query[end]) || query[end] == '$') {
+				end++
+			}
+			write(query[i:end])
+			i = end
+		default:
+			write(query[i : i+1])
+			i++
+		}
+	}
+
+	normalized := out.String()
+	var numbered strings.Builder
+	next := highest
+	for _, r := range normalized {
+		if r == marker {
+			next++
+			numbered.WriteString("$" + strconv.Itoa(next))
+			continue
+		}
+		numbered.WriteRune(r)
+	}
+	return numbered.String()
+}
+
+// skipQuoted returns the index just past the quoted text starting at start, treating a doubled quote as
+// an escaped one, and with backslashEscapes a backslash as escaping the next character
+func skipQuoted(query string, start int, quote byte, backslashEscapes bool) int {
+	for i := start + 1; i < len(query); i++ {
+		if backslashEscapes && query[i] == '\\' {
+			i++
+			continue
+		}
+		if query[i] != quote {
+			continue
+		}
+		if i+1 < len(query) && query[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(query)
+}
+
+// dollarTagEnd returns the index just past a dollar-quote opening tag such as $$ or $body$ at start, or 0
+// when there is none
+func dollarTagEnd(query string, start int) int {
+	for i := start + 1; i < len(query); i++ {
+		if query[i] == '$' {
+			return i + 1
+		}
+		if !isIdentifierChar(query[i]) && !isDigit(query[i]) {
+			return 0
+		}
+	}
+	return 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
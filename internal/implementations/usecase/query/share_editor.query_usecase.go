@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) ShareEditor(ctx context.Context, username string) (*domain.EditorShare, error) {
+	now := time.Now()
+	share := domain.EditorShare{
+		Token:     uuid.NewString(),
+		Owner:     username,
+		CreatedAt: now,
+		ExpiresAt: now.Add(domain.EditorShareTTL),
+	}
+
+	u.sharesMu.Lock()
+	defer u.sharesMu.Unlock()
+
+	// A user shares one editor at a time; a new invite ends the old one and starts a fresh run count
+	for token, existing := range u.shares {
+		if existing.Owner == username || now.After(existing.ExpiresAt) {
+			delete(u.shares, token)
+		}
+	}
+	delete(u.sharedRuns, username)
+	u.shares[share.Token] = share
+
+	return &share, nil
+}
+
+// publishEditorRun records a run of the user's editor for the users watching it; nothing is kept
+// while the user shares no editor
+func (u *QueryUseCaseImplementation) publishEditorRun(username, query string, result *domain.QueryResult, err error) {
+	u.sharesMu.Lock()
+	defer u.sharesMu.Unlock()
+
+	if _, ok := u.ownerShare(username); !ok {
+		return
+	}
+
+	run := domain.EditorShareUpdate{
+		Sequence: u.sharedRuns[username].Sequence + 1,
+		Owner:    username,
+		Query:    query,
+		RanAt:    time.Now(),
+		Result:   result,
+	}
+	if err != nil {
+		run.Error = err.Error()
+	}
+	u.sharedRuns[username] = run
+}
+
+// ownerShare finds the user's unexpired invite; callers hold sharesMu
+func (u *QueryUseCaseImplementation) ownerShare(username string) (domain.EditorShare, bool) {
+	for _, share := range u.shares {
+		if share.Owner == username && time.Now().Before(share.ExpiresAt) {
+			return share, true
+		}
+	}
+	return domain.EditorShare{}, false
+}
@@ -0,0 +1,17 @@
+package query
+
+import "context"
+
+func (u *QueryUseCaseImplementation) StopSharingEditor(ctx context.Context, username string) error {
+	u.sharesMu.Lock()
+	defer u.sharesMu.Unlock()
+
+	for token, share := range u.shares {
+		if share.Owner == username {
+			delete(u.shares, token)
+		}
+	}
+	delete(u.sharedRuns, username)
+
+	return nil
+}
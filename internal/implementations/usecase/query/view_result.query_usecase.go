@@ -0,0 +1,130 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) ViewResult(ctx context.Context, username, resultID string, view domain.ResultView) (*domain.QueryResult, error) {
+	cached, err := u.resultCache.GetResult(ctx, resultID)
+	if err != nil {
+		return nil, err
+	}
+	// Another user's result is reported the same as a missing one
+	if cached.Username != username {
+		return nil, domain.ErrResultNotFound
+	}
+
+	// Filter and sort a copy so the kept rows stay in the query's order
+	filter := strings.ToLower(strings.TrimSpace(view.Filter))
+	rows := make([]map[string]interface{}, 0, len(cached.Rows))
+	for _, row := range cached.Rows {
+		if filter == "" || rowContains(row, cached.Columns, filter) {
+			rows = append(rows, row)
+		}
+	}
+
+	if view.SortColumn != "" {
+		known := false
+		for _, column := range cached.Columns {
+			if column == view.SortColumn {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, domain.ValidationError{Field: "sort", Message: fmt.Sprintf("column %s is not in the result", view.SortColumn)}
+		}
+		descending := strings.EqualFold(view.SortDir, domain.SortDirectionDESC)
+		sort.SliceStable(rows, func(i, j int) bool {
+			order := compareResultValues(rows[i][view.SortColumn], rows[j][view.SortColumn])
+			if descending {
+				return order > 0
+			}
+			return order < 0
+		})
+	}
+
+	// Page the same way the editor pages a fresh run
+	limit := view.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	offset := view.Offset
+	if offset < 0 || offset > len(rows) {
+		offset = len(rows)
+	}
+	end := len(rows)
+	if offset+limit < end {
+		end = offset + limit
+	}
+
+	return &domain.QueryResult{
+		Columns:    cached.Columns,
+		Rows:       rows[offset:end],
+		RowCount:   int64(end - offset),
+		TotalCount: int64(len(rows)),
+		ResultID:   cached.ID,
+	}, nil
+}
+
+// rowContains reports whether any value of the row contains filter, which is already lower case
+func rowContains(row map[string]interface{}, columns []string, filter string) bool {
+	for _, column := range columns {
+		value := row[column]
+		if value != nil && strings.Contains(strings.ToLower(chartLabel(value)), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareResultValues orders two result cells, numerically when both are numbers, by time when both
+// are times and by text otherwise; NULL sorts last ascending, as PostgreSQL sorts it
+func compareResultValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Compare(bt)
+		}
+	}
+	if an, ok := chartValue(a); ok {
+		if bn, ok := chartValue(b); ok {
+			switch {
+			case *an < *bn:
+				return -1
+			case *an > *bn:
+				return 1
+			}
+			return 0
+		}
+	}
+	if ab, ok := a.(bool); ok {
+		if bb, ok := b.(bool); ok {
+			switch {
+			case ab == bb:
+				return 0
+			case bb:
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(chartLabel(a), chartLabel(b))
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) WatchQuery(ctx context.Context, username, query string, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error {
+	interval = domain.LiveRefreshInterval(interval)
+
+	// The first run goes through the usual checks; a query refused now is refused before anything streams
+	result, err := u.ExecuteQuery(ctx, username, query, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	update := &domain.LiveResultUpdate{Sequence: 1, RefreshedAt: time.Now(), Interval: interval, Result: result}
+	for {
+		if err := onUpdate(update); err != nil {
+			return err
+		}
+
+		// The watch ends with the request, which is how a disconnected tab pauses it
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		update = &domain.LiveResultUpdate{Sequence: update.Sequence + 1, RefreshedAt: time.Now(), Interval: interval}
+		result, err := u.ExecuteQuery(ctx, username, query, 0, 0)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			update.Error = err.Error()
+		} else {
+			update.Result = result
+		}
+	}
+}
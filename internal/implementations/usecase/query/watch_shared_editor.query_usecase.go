@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryUseCaseImplementation) WatchSharedEditor(ctx context.Context, viewer, token string, onUpdate func(*domain.EditorShareUpdate) error) error {
+	// An unknown or expired invite is refused before anything streams
+	first, ok := u.sharedRun(token)
+	if !ok {
+		return domain.ErrEditorShareNotFound
+	}
+
+	// Viewers see the owner's results, so each one joining is kept in the audit log; failing to
+	// record never fails the watch
+	_ = u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: viewer,
+		Action:   domain.AuditActionWatchSharedEditor,
+		After:    map[string]interface{}{"owner": first.Owner},
+	})
+
+	ticker := time.NewTicker(domain.EditorShareWatchInterval)
+	defer ticker.Stop()
+
+	// The current state goes out at once, even before the owner's first run
+	sent := -1
+	for {
+		run, ok := u.sharedRun(token)
+		if !ok {
+			// The owner stopped sharing or the invite ran out
+			return nil
+		}
+		if run.Sequence > sent {
+			sent = run.Sequence
+			if err := onUpdate(&run); err != nil {
+				return err
+			}
+		}
+
+		// The watch ends with the viewer's request
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sharedRun returns the latest run of the editor shared under token, with a zero Sequence before
+// the owner's first run, and whether the invite is still open
+func (u *QueryUseCaseImplementation) sharedRun(token string) (domain.EditorShareUpdate, bool) {
+	u.sharesMu.Lock()
+	defer u.sharesMu.Unlock()
+
+	share, ok := u.shares[token]
+	if !ok {
+		return domain.EditorShareUpdate{}, false
+	}
+	if time.Now().After(share.ExpiresAt) {
+		delete(u.shares, token)
+		delete(u.sharedRuns, share.Owner)
+		return domain.EditorShareUpdate{}, false
+	}
+
+	run, ok := u.sharedRuns[share.Owner]
+	if !ok {
+		run = domain.EditorShareUpdate{Owner: share.Owner}
+	}
+	return run, true
+}
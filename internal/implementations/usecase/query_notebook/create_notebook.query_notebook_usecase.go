@@ -0,0 +1,64 @@
+package query_notebook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryNotebookUseCaseImplementation) CreateNotebook(ctx context.Context, username, title string, cells []domain.NotebookCell) (*domain.QueryNotebook, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, domain.ValidationError{Field: "title", Message: "notebook title cannot be empty"}
+	}
+
+	prepared, err := prepareCells(cells, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook := &domain.QueryNotebook{
+		Username: username,
+		Title:    title,
+		Cells:    prepared,
+	}
+	if err := u.notebookRepo.CreateNotebook(ctx, notebook); err != nil {
+		return nil, err
+	}
+	return notebook, nil
+}
+
+// prepareCells checks the kinds of cells given by a user and gives new cells an ID. Results are only
+// ever saved by running a cell, so any given are dropped; a cell of previous keeping its ID, kind and
+// source keeps its saved result.
+func prepareCells(cells []domain.NotebookCell, previous []domain.NotebookCell) ([]domain.NotebookCell, error) {
+	saved := make(map[string]domain.NotebookCell, len(previous))
+	for _, cell := range previous {
+		saved[cell.ID] = cell
+	}
+
+	prepared := make([]domain.NotebookCell, 0, len(cells))
+	seen := make(map[string]bool, len(cells))
+	for i, cell := range cells {
+		if cell.Kind != domain.NotebookCellSQL && cell.Kind != domain.NotebookCellMarkdown {
+			return nil, domain.ValidationError{Field: "cells", Message: fmt.Sprintf("cell %d has unknown kind %q", i+1, cell.Kind)}
+		}
+		if cell.ID == "" {
+			cell.ID = "cell_" + uuid.New().String()
+		}
+		if seen[cell.ID] {
+			return nil, domain.ValidationError{Field: "cells", Message: fmt.Sprintf("cell ID %s is used twice", cell.ID)}
+		}
+		seen[cell.ID] = true
+
+		kept := domain.NotebookCell{ID: cell.ID, Kind: cell.Kind, Source: cell.Source}
+		if old, ok := saved[cell.ID]; ok && old.Kind == cell.Kind && old.Source == cell.Source {
+			kept = old
+		}
+		prepared = append(prepared, kept)
+	}
+	return prepared, nil
+}
@@ -0,0 +1,7 @@
+package query_notebook
+
+import "context"
+
+func (u *QueryNotebookUseCaseImplementation) DeleteNotebook(ctx context.Context, username, id string) error {
+	return u.notebookRepo.DeleteNotebook(ctx, username, id)
+}
@@ -0,0 +1,111 @@
+package query_notebook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// notebookDocument is the JSON form of a notebook file. Like a workspace file it carries no IDs or
+// owner, so it reads on its own as a record of what was run and what it returned.
+type notebookDocument struct {
+	Version    int    `json:"version"`
+	ExportedBy string `json:"exported_by,omitempty"`
+	ExportedAt string `json:"exported_at,omitempty"`
+	Title      string `json:"title"`
+	// ResultsOmitted marks a file whose saved results were left out because the export policy denies the
+	// user exporting query results
+	ResultsOmitted bool           `json:"results_omitted,omitempty"`
+	Cells          []notebookCell `json:"cells"`
+}
+
+type notebookCell struct {
+	Kind   string          `json:"kind"`
+	Source string          `json:"source"`
+	Result *notebookResult `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	RanAt  string          `json:"ran_at,omitempty"`
+}
+
+type notebookResult struct {
+	Columns  []string                 `json:"columns"`
+	Rows     []map[string]interface{} `json:"rows"`
+	RowCount int64                    `json:"row_count"`
+	// TotalCount is the number of rows the query returned, of which the first RowCount were saved
+	TotalCount int64 `json:"total_count"`
+}
+
+func (u *QueryNotebookUseCaseImplementation) ExportNotebook(ctx context.Context, username, id string) (*domain.NotebookExport, error) {
+	notebook, err := u.notebookRepo.GetNotebook(ctx, username, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Saved results are query results, so they leave with the file only when a query export would be
+	// allowed
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export policy: %w", err)
+	}
+	withResults := config.QueryExportAllowed(username)
+
+	document := notebookDocument{
+		Version:        domain.NotebookFormatVersion,
+		ExportedBy:     username,
+		ExportedAt:     time.Now().UTC().Format(time.RFC3339),
+		Title:          notebook.Title,
+		ResultsOmitted: !withResults,
+		Cells:          make([]notebookCell, 0, len(notebook.Cells)),
+	}
+	for _, cell := range notebook.Cells {
+		exported := notebookCell{Kind: cell.Kind, Source: cell.Source, Error: cell.Error}
+		if !cell.RanAt.IsZero() {
+			exported.RanAt = cell.RanAt.UTC().Format(time.RFC3339)
+		}
+		if cell.Result != nil && withResults {
+			exported.Result = &notebookResult{
+				Columns:    cell.Result.Columns,
+				Rows:       cell.Result.Rows,
+				RowCount:   cell.Result.RowCount,
+				TotalCount: cell.Result.TotalCount,
+			}
+		}
+		document.Cells = append(document.Cells, exported)
+	}
+
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode notebook: %w", err)
+	}
+	return &domain.NotebookExport{
+		Filename:    notebookFilename(notebook.Title) + ".json",
+		ContentType: "application/json",
+		Content:     content,
+	}, nil
+}
+
+// notebookFilename turns a notebook title into a file name, keeping letters, digits, dashes and
+// underscores and joining the rest with underscores
+func notebookFilename(title string) string {
+	var name strings.Builder
+	pending := false
+	for _, r := range strings.ToLower(title) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			if pending && name.Len() > 0 {
+				name.WriteByte('_')
+			}
+			name.WriteRune(r)
+			pending = false
+			continue
+		}
+		pending = true
+	}
+	if name.Len() == 0 {
+		return "notebook"
+	}
+	return name.String()
+}
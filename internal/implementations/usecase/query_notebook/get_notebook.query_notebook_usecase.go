@@ -0,0 +1,11 @@
+package query_notebook
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryNotebookUseCaseImplementation) GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	return u.notebookRepo.GetNotebook(ctx, username, id)
+}
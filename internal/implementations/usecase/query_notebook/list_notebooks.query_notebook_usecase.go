@@ -0,0 +1,11 @@
+package query_notebook
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryNotebookUseCaseImplementation) ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error) {
+	return u.notebookRepo.ListNotebooks(ctx, username)
+}
@@ -0,0 +1,27 @@
+package query_notebook
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type QueryNotebookUseCaseImplementation struct {
+	notebookRepo repository.NotebookRepository
+	databaseRepo repository.DatabaseRepository
+	rbacRepo     repository.RBACRepository
+	configRepo   repository.ConfigRepository
+}
+
+func NewQueryNotebookUseCaseImplementation(
+	notebookRepo repository.NotebookRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+) usecase.QueryNotebookUseCase {
+	return &QueryNotebookUseCaseImplementation{
+		notebookRepo: notebookRepo,
+		databaseRepo: databaseRepo,
+		rbacRepo:     rbacRepo,
+		configRepo:   configRepo,
+	}
+}
@@ -0,0 +1,33 @@
+package query_notebook
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryNotebookUseCaseImplementation) RunAllCells(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	notebook, err := u.notebookRepo.GetNotebook(ctx, username, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Later cells often build on earlier ones, so the run stops at the first failure and leaves the
+	// cells after it as they were
+	for i := range notebook.Cells {
+		if notebook.Cells[i].Kind != domain.NotebookCellSQL {
+			continue
+		}
+		if err := u.runSQLCell(ctx, username, &notebook.Cells[i]); err != nil {
+			return nil, err
+		}
+		if notebook.Cells[i].Error != "" {
+			break
+		}
+	}
+
+	if err := u.notebookRepo.UpdateNotebook(ctx, notebook); err != nil {
+		return nil, err
+	}
+	return notebook, nil
+}
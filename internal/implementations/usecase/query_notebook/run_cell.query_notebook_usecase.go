@@ -0,0 +1,86 @@
+package query_notebook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryNotebookUseCaseImplementation) RunCell(ctx context.Context, username, id, cellID string) (*domain.QueryNotebook, error) {
+	notebook, err := u.notebookRepo.GetNotebook(ctx, username, id)
+	if err != nil {
+		return nil, err
+	}
+
+	index := -1
+	for i, cell := range notebook.Cells {
+		if cell.ID == cellID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, domain.ValidationError{Field: "cell", Message: fmt.Sprintf("notebook has no cell %s", cellID)}
+	}
+	if notebook.Cells[index].Kind != domain.NotebookCellSQL {
+		return nil, domain.ValidationError{Field: "cell", Message: "only SQL cells can be run"}
+	}
+
+	if err := u.runSQLCell(ctx, username, &notebook.Cells[index]); err != nil {
+		return nil, err
+	}
+	if err := u.notebookRepo.UpdateNotebook(ctx, notebook); err != nil {
+		return nil, err
+	}
+	return notebook, nil
+}
+
+// runSQLCell runs a SQL cell under the query editor's rules and saves its result on the cell, keeping
+// the first NotebookResultMaxRows rows. A query that fails is saved as the cell's error; only a failure
+// to check the user's permissions is returned.
+func (u *QueryNotebookUseCaseImplementation) runSQLCell(ctx context.Context, username string, cell *domain.NotebookCell) error {
+	cell.RanAt = time.Now()
+	cell.Result = nil
+	cell.Error = ""
+
+	fields := strings.Fields(cell.Source)
+	if len(fields) == 0 || !strings.EqualFold(fields[0], "SELECT") {
+		cell.Error = "only SELECT queries are allowed"
+		return nil
+	}
+
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, "", "", "")
+	if err != nil {
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+	if !hasPermission {
+		cell.Error = "access denied: user does not have SELECT permission"
+		return nil
+	}
+
+	// Run under the username so the cell can be cancelled like an editor query
+	result, err := u.databaseRepo.ExecuteTrackedQuery(ctx, username, cell.Source)
+	if err != nil {
+		cell.Error = err.Error()
+		return nil
+	}
+	if result == nil {
+		cell.Error = "unexpected nil result from database"
+		return nil
+	}
+
+	saved := &domain.QueryResult{
+		Columns:    result.Columns,
+		Rows:       result.Rows,
+		TotalCount: int64(len(result.Rows)),
+	}
+	if len(saved.Rows) > domain.NotebookResultMaxRows {
+		saved.Rows = saved.Rows[:domain.NotebookResultMaxRows]
+	}
+	saved.RowCount = int64(len(saved.Rows))
+	cell.Result = saved
+	return nil
+}
@@ -0,0 +1,11 @@
+package query_notebook
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestQueryNotebookUsecase(t *testing.T) {
+	testRunner.QueryNotebookUsecaseRunner(t, NewQueryNotebookUseCaseImplementation)
+}
@@ -0,0 +1,32 @@
+package query_notebook
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *QueryNotebookUseCaseImplementation) UpdateNotebook(ctx context.Context, username, id, title string, cells []domain.NotebookCell) (*domain.QueryNotebook, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, domain.ValidationError{Field: "title", Message: "notebook title cannot be empty"}
+	}
+
+	notebook, err := u.notebookRepo.GetNotebook(ctx, username, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prepared, err := prepareCells(cells, notebook.Cells)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook.Title = title
+	notebook.Cells = prepared
+	if err := u.notebookRepo.UpdateNotebook(ctx, notebook); err != nil {
+		return nil, err
+	}
+	return notebook, nil
+}
@@ -0,0 +1,26 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+)
+
+func (u *RBACUseCaseImplementation) CheckExportPermission(ctx context.Context, username, database, schema, table string) (bool, error) {
+	canSelect, err := u.CheckSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return false, err
+	}
+	if !canSelect {
+		return false, nil
+	}
+	return u.exportAllowed(ctx, username, schema, table)
+}
+
+// exportAllowed checks the export policy for a table the user can already SELECT from
+func (u *RBACUseCaseImplementation) exportAllowed(ctx context.Context, username, schema, table string) (bool, error) {
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get export policy: %w", err)
+	}
+	return config.ExportAllowed(username, schema+"."+table), nil
+}
@@ -25,6 +25,12 @@ func (u *RBACUseCaseImplementation) GetTablePermissions(ctx context.Context, use
 		CanUpdate: perms.HasUpdate,
 		CanDelete: perms.HasDelete,
 	}
+	if permissionSet.CanSelect {
+		permissionSet.CanExport, err = u.exportAllowed(ctx, username, schema, table)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return permissionSet, nil
 }
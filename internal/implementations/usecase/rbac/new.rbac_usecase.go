@@ -8,14 +8,18 @@ import (
 type RBACUseCaseImplementation struct {
 	rbacRepo     repository.RBACRepository
 	metadataRepo repository.MetadataRepository
+	// configRepo holds the export policy, which is lumen-pg's own on top of PostgreSQL's grants
+	configRepo repository.ConfigRepository
 }
 
 func NewRBACUseCaseImplementation(
 	metadataRepo repository.MetadataRepository,
 	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
 ) usecase.RBACUseCase {
 	return &RBACUseCaseImplementation{
 		rbacRepo:     rbacRepo,
 		metadataRepo: metadataRepo,
+		configRepo:   configRepo,
 	}
 }
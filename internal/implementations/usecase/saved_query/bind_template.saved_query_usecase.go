@@ -0,0 +1,44 @@
+package saved_query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SavedQueryUseCaseImplementation) BindTemplate(ctx context.Context, username, id string, values map[string]string) (string, []interface{}, error) {
+	saved, err := u.savedQueryRepo.GetSavedQuery(ctx, username, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	variables, err := parseTemplateVariables(saved.Query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Convert every declared variable before touching the statement
+	positions := make(map[string]int, len(variables))
+	args := make([]interface{}, len(variables))
+	for i, variable := range variables {
+		value, ok := values[variable.Name]
+		if !ok {
+			return "", nil, domain.ValidationError{Field: variable.Name, Message: fmt.Sprintf("variable %s (%s) is required", variable.Name, variable.Type)}
+		}
+		arg, err := convertTemplateValue(variable, value)
+		if err != nil {
+			return "", nil, err
+		}
+		args[i] = arg
+		positions[variable.Name] = i + 1
+	}
+
+	// Replace each placeholder with its bind parameter so values never reach the SQL text
+	query := templatePlaceholder.ReplaceAllStringFunc(saved.Query, func(placeholder string) string {
+		name := templatePlaceholder.FindStringSubmatch(placeholder)[1]
+		return fmt.Sprintf("$%d", positions[name])
+	})
+
+	return query, args, nil
+}
@@ -0,0 +1,9 @@
+package saved_query
+
+import (
+	"context"
+)
+
+func (u *SavedQueryUseCaseImplementation) DeleteSavedQuery(ctx context.Context, username, id string) error {
+	return u.savedQueryRepo.DeleteSavedQuery(ctx, username, id)
+}
@@ -0,0 +1,11 @@
+package saved_query
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SavedQueryUseCaseImplementation) GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error) {
+	return u.savedQueryRepo.GetSavedQuery(ctx, username, id)
+}
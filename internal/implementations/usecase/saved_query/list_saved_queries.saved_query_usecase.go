@@ -0,0 +1,33 @@
+package saved_query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SavedQueryUseCaseImplementation) ListSavedQueries(ctx context.Context, username, tag string) ([]domain.SavedQuery, error) {
+	queries, err := u.savedQueryRepo.ListSavedQueries(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return queries, nil
+	}
+
+	// Keep only the queries carrying the requested tag
+	result := make([]domain.SavedQuery, 0, len(queries))
+	for _, query := range queries {
+		for _, t := range query.Tags {
+			if t == tag {
+				result = append(result, query)
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
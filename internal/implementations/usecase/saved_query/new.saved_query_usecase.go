@@ -0,0 +1,18 @@
+package saved_query
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SavedQueryUseCaseImplementation struct {
+	savedQueryRepo repository.SavedQueryRepository
+}
+
+func NewSavedQueryUseCaseImplementation(
+	savedQueryRepo repository.SavedQueryRepository,
+) usecase.SavedQueryUseCase {
+	return &SavedQueryUseCaseImplementation{
+		savedQueryRepo: savedQueryRepo,
+	}
+}
@@ -0,0 +1,57 @@
+package saved_query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SavedQueryUseCaseImplementation) SaveQuery(ctx context.Context, username, name, query string, tags []string) (*domain.SavedQuery, error) {
+	saved := &domain.SavedQuery{
+		Username: username,
+		Name:     strings.TrimSpace(name),
+		Query:    strings.TrimSpace(query),
+		Tags:     normalizeTags(tags),
+	}
+	if err := validateSavedQuery(saved); err != nil {
+		return nil, err
+	}
+
+	variables, err := parseTemplateVariables(saved.Query)
+	if err != nil {
+		return nil, err
+	}
+	saved.Variables = variables
+
+	if err := u.savedQueryRepo.CreateSavedQuery(ctx, saved); err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+func validateSavedQuery(saved *domain.SavedQuery) error {
+	if saved.Name == "" {
+		return domain.ValidationError{Field: "name", Message: "saved query name cannot be empty"}
+	}
+	if saved.Query == "" {
+		return domain.ValidationError{Field: "query", Message: "saved query cannot be empty"}
+	}
+	return nil
+}
+
+// normalizeTags trims and lowercases tags, dropping blanks and duplicates
+func normalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package saved_query
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// templatePlaceholder matches {{name}} and {{name:type}}; an omitted type means text
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*(?::\s*([A-Za-z]+)\s*)?\}\}`)
+
+// parseTemplateVariables lists the variables declared in a query, rejecting unknown types and a
+// variable declared with two different types
+func parseTemplateVariables(query string) ([]domain.TemplateVariable, error) {
+	variables := make([]domain.TemplateVariable, 0)
+	types := make(map[string]string)
+
+	for _, match := range templatePlaceholder.FindAllStringSubmatch(query, -1) {
+		name, varType := match[1], strings.ToLower(match[2])
+		if varType == "" {
+			varType = domain.TemplateVariableText
+		}
+		if !isTemplateVariableType(varType) {
+			return nil, domain.ValidationError{Field: "query", Message: fmt.Sprintf("variable %s has unknown type %s", name, varType)}
+		}
+
+		if existing, ok := types[name]; ok {
+			if existing != varType {
+				return nil, domain.ValidationError{Field: "query", Message: fmt.Sprintf("variable %s is declared as both %s and %s", name, existing, varType)}
+			}
+			continue
+		}
+		types[name] = varType
+		variables = append(variables, domain.TemplateVariable{Name: name, Type: varType})
+	}
+
+	return variables, nil
+}
+
+func isTemplateVariableType(varType string) bool {
+	switch varType {
+	case domain.TemplateVariableText, domain.TemplateVariableInt, domain.TemplateVariableFloat,
+		domain.TemplateVariableBool, domain.TemplateVariableDate, domain.TemplateVariableTimestamp:
+		return true
+	}
+	return false
+}
+
+// convertTemplateValue parses a submitted value into the Go type bound for the variable
+func convertTemplateValue(variable domain.TemplateVariable, value string) (interface{}, error) {
+	invalid := domain.ValidationError{Field: variable.Name, Message: fmt.Sprintf("variable %s must be a valid %s", variable.Name, variable.Type)}
+
+	switch variable.Type {
+	case domain.TemplateVariableInt:
+		parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case domain.TemplateVariableFloat:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case domain.TemplateVariableBool:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case domain.TemplateVariableDate:
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case domain.TemplateVariableTimestamp:
+		parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	default:
+		return value, nil
+	}
+}
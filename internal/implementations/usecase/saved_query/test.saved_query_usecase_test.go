@@ -0,0 +1,11 @@
+package saved_query
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestSavedQueryUsecase(t *testing.T) {
+	testRunner.SavedQueryUsecaseRunner(t, NewSavedQueryUseCaseImplementation)
+}
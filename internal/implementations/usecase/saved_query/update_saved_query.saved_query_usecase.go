@@ -0,0 +1,33 @@
+package saved_query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SavedQueryUseCaseImplementation) UpdateSavedQuery(ctx context.Context, username, id, name, query string, tags []string) (*domain.SavedQuery, error) {
+	saved := &domain.SavedQuery{
+		ID:       id,
+		Username: username,
+		Name:     strings.TrimSpace(name),
+		Query:    strings.TrimSpace(query),
+		Tags:     normalizeTags(tags),
+	}
+	if err := validateSavedQuery(saved); err != nil {
+		return nil, err
+	}
+
+	variables, err := parseTemplateVariables(saved.Query)
+	if err != nil {
+		return nil, err
+	}
+	saved.Variables = variables
+
+	if err := u.savedQueryRepo.UpdateSavedQuery(ctx, saved); err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
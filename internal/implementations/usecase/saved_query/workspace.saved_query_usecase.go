@@ -0,0 +1,129 @@
+package saved_query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// workspaceDocument is the JSON form of a workspace file. It carries no IDs or owners, so it can be
+// imported into another instance or account.
+type workspaceDocument struct {
+	Version      int                  `json:"version"`
+	ExportedBy   string               `json:"exported_by,omitempty"`
+	ExportedAt   string               `json:"exported_at,omitempty"`
+	SavedQueries []workspaceQuery     `json:"saved_queries"`
+	Preferences  workspacePreferences `json:"preferences"`
+}
+
+type workspaceQuery struct {
+	Name  string   `json:"name"`
+	Query string   `json:"query"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+type workspacePreferences struct {
+	SearchPath []string `json:"search_path,omitempty"`
+}
+
+func (u *SavedQueryUseCaseImplementation) ExportWorkspace(ctx context.Context, username string, searchPath []string) ([]byte, error) {
+	queries, err := u.savedQueryRepo.ListSavedQueries(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	document := workspaceDocument{
+		Version:      domain.WorkspaceFormatVersion,
+		ExportedBy:   username,
+		ExportedAt:   time.Now().UTC().Format(time.RFC3339),
+		SavedQueries: make([]workspaceQuery, 0, len(queries)),
+		Preferences:  workspacePreferences{SearchPath: searchPath},
+	}
+	for _, saved := range queries {
+		document.SavedQueries = append(document.SavedQueries, workspaceQuery{
+			Name:  saved.Name,
+			Query: saved.Query,
+			Tags:  saved.Tags,
+		})
+	}
+
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workspace: %w", err)
+	}
+	return content, nil
+}
+
+func (u *SavedQueryUseCaseImplementation) ImportWorkspace(ctx context.Context, username string, content []byte) (*domain.WorkspaceImport, error) {
+	var document workspaceDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, domain.ValidationError{Field: "file", Message: "not a workspace file: " + err.Error()}
+	}
+	if document.Version != domain.WorkspaceFormatVersion {
+		return nil, domain.ValidationError{Field: "file", Message: fmt.Sprintf("unsupported workspace version: %d", document.Version)}
+	}
+
+	// Check every query before adding any, so a bad file imports nothing
+	imported := make([]*domain.SavedQuery, 0, len(document.SavedQueries))
+	for i, query := range document.SavedQueries {
+		saved := &domain.SavedQuery{
+			Username: username,
+			Name:     strings.TrimSpace(query.Name),
+			Query:    strings.TrimSpace(query.Query),
+			Tags:     normalizeTags(query.Tags),
+		}
+		if err := validateSavedQuery(saved); err != nil {
+			return nil, domain.ValidationError{Field: "file", Message: fmt.Sprintf("saved query %d: %s", i+1, err.Error())}
+		}
+		variables, err := parseTemplateVariables(saved.Query)
+		if err != nil {
+			return nil, domain.ValidationError{Field: "file", Message: fmt.Sprintf("saved query %q: %s", saved.Name, err.Error())}
+		}
+		saved.Variables = variables
+		imported = append(imported, saved)
+	}
+
+	existing, err := u.savedQueryRepo.ListSavedQueries(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	library := make(map[string]string, len(existing))
+	for _, saved := range existing {
+		library[saved.Name] = saved.Query
+	}
+
+	result := &domain.WorkspaceImport{SearchPath: document.Preferences.SearchPath}
+	for _, saved := range imported {
+		if query, ok := library[saved.Name]; ok {
+			if query == saved.Query {
+				result.Skipped++
+				continue
+			}
+			saved.Name = importedName(saved.Name, library)
+			result.Renamed++
+		}
+
+		if err := u.savedQueryRepo.CreateSavedQuery(ctx, saved); err != nil {
+			return nil, err
+		}
+		library[saved.Name] = saved.Query
+		result.Imported++
+	}
+
+	return result, nil
+}
+
+// importedName finds a free name for an imported query whose name is already taken
+func importedName(name string, library map[string]string) string {
+	candidate := name + " (imported)"
+	for n := 2; ; n++ {
+		if _, taken := library[candidate]; !taken {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s (imported %d)", name, n)
+	}
+}
@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) AddConstraint(ctx context.Context, username string, definition domain.ConstraintDefinition, confirm string) (*domain.SchemaChange, error) {
+	if err := u.requireTableOwner(ctx, username, definition.Database, definition.Schema, definition.Table); err != nil {
+		return nil, err
+	}
+
+	statement, err := u.addConstraintStatement(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	target := definition.Schema + "." + definition.Table
+	return u.applySchemaChange(ctx, username, domain.AuditActionAddConstraint, target, statement, confirm, nil)
+}
+
+// addConstraintStatement checks the definition against the table and generates its ALTER TABLE
+// statement
+func (u *SchemaUseCaseImplementation) addConstraintStatement(ctx context.Context, definition domain.ConstraintDefinition) (string, error) {
+	name := strings.TrimSpace(definition.Name)
+	if len(name) > domain.MaxIdentifierLength {
+		return "", domain.ValidationError{Field: "name", Message: fmt.Sprintf("constraint name is longer than %d bytes", domain.MaxIdentifierLength)}
+	}
+
+	metadata, err := u.metadataRepo.GetMetadata(ctx, definition.Database)
+	if err != nil {
+		return "", err
+	}
+	tableMetadata, err := metadata.FindTable(definition.Schema, definition.Table)
+	if err != nil {
+		return "", err
+	}
+
+	var body string
+	switch definition.Type {
+	case domain.ConstraintTypePrimaryKey, domain.ConstraintTypeUnique:
+		columns, err := quoteColumns(tableMetadata, definition.Columns, "columns")
+		if err != nil {
+			return "", err
+		}
+		keyword := "UNIQUE"
+		if definition.Type == domain.ConstraintTypePrimaryKey {
+			keyword = "PRIMARY KEY"
+		}
+		body = keyword + " (" + columns + ")"
+	case domain.ConstraintTypeCheck:
+		expression := strings.TrimSpace(definition.Expression)
+		if expression == "" {
+			return "", domain.ValidationError{Field: "expression", Message: "a check constraint requires an expression"}
+		}
+		// The expression is spliced into the statement, so it may not end it and start another
+		if strings.Contains(expression, ";") {
+			return "", domain.ValidationError{Field: "expression", Message: "the check expression cannot contain a semicolon"}
+		}
+		body = "CHECK (" + expression + ")"
+	case domain.ConstraintTypeForeignKey:
+		body, err = foreignKeyClause(definition, metadata, tableMetadata)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", domain.ValidationError{Field: "type", Message: fmt.Sprintf("unsupported constraint type: %s", definition.Type)}
+	}
+
+	statement := "ALTER TABLE " + pq.QuoteIdentifier(definition.Schema) + "." + pq.QuoteIdentifier(definition.Table) + " ADD "
+	if name != "" {
+		statement += "CONSTRAINT " + pq.QuoteIdentifier(name) + " "
+	}
+	return statement + body, nil
+}
+
+// foreignKeyClause generates the FOREIGN KEY clause of a definition, referencing the referenced
+// table's primary key when no referenced columns are given
+func foreignKeyClause(definition domain.ConstraintDefinition, metadata *domain.DatabaseMetadata, tableMetadata *domain.TableMetadata) (string, error) {
+	columns, err := quoteColumns(tableMetadata, definition.Columns, "columns")
+	if err != nil {
+		return "", err
+	}
+
+	if definition.ReferencedTable == "" {
+		return "", domain.ValidationError{Field: "referenced_table", Message: "a foreign key requires a referenced table"}
+	}
+	referencedSchema := definition.ReferencedSchema
+	if referencedSchema == "" {
+		referencedSchema = definition.Schema
+	}
+	referencedMetadata, err := metadata.FindTable(referencedSchema, definition.ReferencedTable)
+	if errors.Is(err, domain.ErrTableNotFound) {
+		return "", domain.ValidationError{
+			Field:   "referenced_table",
+			Message: fmt.Sprintf("table %s.%s does not exist", referencedSchema, definition.ReferencedTable),
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	referencedColumns := definition.ReferencedColumns
+	if len(referencedColumns) == 0 {
+		referencedColumns = referencedMetadata.PrimaryKeys
+	}
+	if len(referencedColumns) != len(definition.Columns) {
+		return "", domain.ValidationError{
+			Field:   "referenced_columns",
+			Message: fmt.Sprintf("the foreign key has %d columns but references %d", len(definition.Columns), len(referencedColumns)),
+		}
+	}
+	quotedReferenced, err := quoteColumns(referencedMetadata, referencedColumns, "referenced_columns")
+	if err != nil {
+		return "", err
+	}
+
+	clause := "FOREIGN KEY (" + columns + ") REFERENCES " + pq.QuoteIdentifier(referencedSchema) + "." +
+		pq.QuoteIdentifier(definition.ReferencedTable) + " (" + quotedReferenced + ")"
+	for _, action := range []struct{ event, value, field string }{
+		{"DELETE", definition.OnDelete, "on_delete"},
+		{"UPDATE", definition.OnUpdate, "on_update"},
+	} {
+		value := strings.ToUpper(strings.TrimSpace(action.value))
+		if value == "" || value == "NO ACTION" {
+			continue
+		}
+		if !isForeignKeyAction(value) {
+			return "", domain.ValidationError{Field: action.field, Message: fmt.Sprintf("unsupported referential action: %s", action.value)}
+		}
+		clause += " ON " + action.event + " " + value
+	}
+	return clause, nil
+}
+
+// isForeignKeyAction reports whether action is one of domain.ForeignKeyActions
+func isForeignKeyAction(action string) bool {
+	for _, known := range domain.ForeignKeyActions {
+		if action == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) AlterTable(ctx context.Context, username string, alteration domain.TableAlteration, confirm string) (*domain.SchemaChange, error) {
+	if len(alteration.Changes) == 0 {
+		return nil, domain.ValidationError{Field: "changes", Message: "at least one change is required"}
+	}
+	// PostgreSQL lets only the table's owner run any form of ALTER TABLE
+	if err := u.requireTableOwner(ctx, username, alteration.Database, alteration.Schema, alteration.Table); err != nil {
+		return nil, err
+	}
+
+	// The cache may predate earlier designer changes, so the designer works from the live table
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, alteration.Database, alteration.Schema, alteration.Table)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := u.alterTableStatement(ctx, alteration, tableMetadata)
+	if err != nil {
+		return nil, err
+	}
+	impact, err := u.columnChangeImpact(ctx, alteration, tableMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(tableMetadata.Columns))
+	for _, column := range tableMetadata.Columns {
+		columns = append(columns, column.Name)
+	}
+	target := alteration.Schema + "." + alteration.Table
+	change, err := u.applySchemaChange(ctx, username, domain.AuditActionAlterTable, target, statement, confirm,
+		map[string]interface{}{"columns": columns})
+	if err != nil {
+		return nil, err
+	}
+	change.Impact = impact
+	if change.Applied {
+		u.invalidateMetadata(ctx, alteration.Database)
+	}
+	return change, nil
+}
+
+// columnChangeImpact lists the objects referencing the table's columns that the alteration renames or
+// drops, so they are shown with the preview. A column renamed and then dropped is looked up once,
+// under the name it has now.
+func (u *SchemaUseCaseImplementation) columnChangeImpact(ctx context.Context, alteration domain.TableAlteration, tableMetadata *domain.TableMetadata) ([]domain.ColumnDependent, error) {
+	existing := make(map[string]bool, len(tableMetadata.Columns))
+	for _, column := range tableMetadata.Columns {
+		existing[column.Name] = true
+	}
+
+	impact := []domain.ColumnDependent{}
+	checked := make(map[string]bool)
+	for _, change := range alteration.Changes {
+		if change.Operation != domain.ColumnOperationRename && change.Operation != domain.ColumnOperationDrop {
+			continue
+		}
+		column := strings.TrimSpace(change.Column)
+		if !existing[column] || checked[column] {
+			continue
+		}
+		checked[column] = true
+
+		dependents, err := u.databaseRepo.GetColumnDependents(ctx, alteration.Database, alteration.Schema, alteration.Table, column)
+		if err != nil {
+			return nil, err
+		}
+		impact = append(impact, dependents...)
+	}
+	return impact, nil
+}
+
+// alterTableStatement checks each change against the table as the earlier changes leave it and
+// generates the statements. Changes share one ALTER TABLE where PostgreSQL allows; a rename needs a
+// statement of its own, so it ends the current one. The statements are sent together, which PostgreSQL
+// runs as one transaction.
+func (u *SchemaUseCaseImplementation) alterTableStatement(ctx context.Context, alteration domain.TableAlteration, tableMetadata *domain.TableMetadata) (string, error) {
+	table := pq.QuoteIdentifier(alteration.Schema) + "." + pq.QuoteIdentifier(alteration.Table)
+	columns := make(map[string]bool, len(tableMetadata.Columns))
+	for _, column := range tableMetadata.Columns {
+		columns[column.Name] = true
+	}
+
+	var statements, actions []string
+	flush := func() {
+		if len(actions) > 0 {
+			statements = append(statements, "ALTER TABLE "+table+"\n    "+strings.Join(actions, ",\n    "))
+			actions = nil
+		}
+	}
+	primaryKeyChanged := false
+
+	for i, change := range alteration.Changes {
+		field := fmt.Sprintf("changes[%d]", i)
+		column := strings.TrimSpace(change.Column)
+		if change.Operation != domain.ColumnOperationAdd && change.Operation != domain.ColumnOperationSetPrimaryKey &&
+			change.Operation != domain.ColumnOperationDropPrimaryKey && !columns[column] {
+			return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("column %s does not exist on %s", column, alteration.Table)}
+		}
+
+		switch change.Operation {
+		case domain.ColumnOperationAdd:
+			if columns[column] {
+				return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("column %s already exists on %s", column, alteration.Table)}
+			}
+			clause, err := columnDesignClause(domain.ColumnDesign{
+				Name: column, DataType: change.DataType, Default: change.Default, NotNull: change.NotNull,
+			}, field)
+			if err != nil {
+				return "", err
+			}
+			actions = append(actions, "ADD COLUMN "+clause)
+			columns[column] = true
+		case domain.ColumnOperationRename:
+			newName := strings.TrimSpace(change.NewName)
+			if err := checkColumnName(newName, field); err != nil {
+				return "", err
+			}
+			if columns[newName] {
+				return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("column %s already exists on %s", newName, alteration.Table)}
+			}
+			flush()
+			statements = append(statements, "ALTER TABLE "+table+" RENAME COLUMN "+pq.QuoteIdentifier(column)+" TO "+pq.QuoteIdentifier(newName))
+			delete(columns, column)
+			columns[newName] = true
+		case domain.ColumnOperationDrop:
+			actions = append(actions, "DROP COLUMN "+pq.QuoteIdentifier(column))
+			delete(columns, column)
+		case domain.ColumnOperationSetType:
+			dataType, err := checkDataType(change.DataType, field)
+			if err != nil {
+				return "", err
+			}
+			using, err := checkExpression(change.Using, field)
+			if err != nil {
+				return "", err
+			}
+			action := "ALTER COLUMN " + pq.QuoteIdentifier(column) + " TYPE " + dataType
+			if using != "" {
+				action += " USING " + using
+			}
+			actions = append(actions, action)
+		case domain.ColumnOperationSetDefault:
+			expression, err := checkExpression(change.Default, field)
+			if err != nil {
+				return "", err
+			}
+			if expression == "" {
+				return "", domain.ValidationError{Field: field, Message: "a default expression is required; drop the default to remove it"}
+			}
+			actions = append(actions, "ALTER COLUMN "+pq.QuoteIdentifier(column)+" SET DEFAULT "+expression)
+		case domain.ColumnOperationDropDefault:
+			actions = append(actions, "ALTER COLUMN "+pq.QuoteIdentifier(column)+" DROP DEFAULT")
+		case domain.ColumnOperationSetNotNull:
+			actions = append(actions, "ALTER COLUMN "+pq.QuoteIdentifier(column)+" SET NOT NULL")
+		case domain.ColumnOperationDropNotNull:
+			actions = append(actions, "ALTER COLUMN "+pq.QuoteIdentifier(column)+" DROP NOT NULL")
+		case domain.ColumnOperationSetPrimaryKey, domain.ColumnOperationDropPrimaryKey:
+			if primaryKeyChanged {
+				return "", domain.ValidationError{Field: field, Message: "the primary key can only be changed once at a time"}
+			}
+			primaryKeyChanged = true
+
+			current, err := u.primaryKeyName(ctx, alteration)
+			if err != nil {
+				return "", err
+			}
+			if current != "" {
+				actions = append(actions, "DROP CONSTRAINT "+pq.QuoteIdentifier(current))
+			} else if change.Operation == domain.ColumnOperationDropPrimaryKey {
+				return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("table %s has no primary key", alteration.Table)}
+			}
+			if change.Operation == domain.ColumnOperationSetPrimaryKey {
+				designed := &domain.TableMetadata{Name: alteration.Table}
+				for name := range columns {
+					designed.Columns = append(designed.Columns, domain.ColumnMetadata{Name: name})
+				}
+				keyColumns, err := quoteColumns(designed, change.Columns, field)
+				if err != nil {
+					return "", err
+				}
+				actions = append(actions, "ADD PRIMARY KEY ("+keyColumns+")")
+			}
+		default:
+			return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("unsupported column operation: %s", change.Operation)}
+		}
+	}
+	flush()
+
+	return strings.Join(statements, ";\n"), nil
+}
+
+// primaryKeyName returns the name of the table's primary key constraint, or "" when it has none
+func (u *SchemaUseCaseImplementation) primaryKeyName(ctx context.Context, alteration domain.TableAlteration) (string, error) {
+	constraints, err := u.databaseRepo.ListTableConstraints(ctx, alteration.Database, alteration.Schema, alteration.Table)
+	if err != nil {
+		return "", err
+	}
+	for _, constraint := range constraints {
+		if constraint.Type == domain.ConstraintTypePrimaryKey {
+			return constraint.Name, nil
+		}
+	}
+	return "", nil
+}
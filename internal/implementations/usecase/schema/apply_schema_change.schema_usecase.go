@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// applySchemaChange previews statement when confirm is empty, and otherwise runs and audits it once
+// confirm shows it is the statement the user saw. Maintenance mode refuses the change like any other write.
+func (u *SchemaUseCaseImplementation) applySchemaChange(ctx context.Context, username, action, target, statement, confirm string, before map[string]interface{}) (*domain.SchemaChange, error) {
+	change := &domain.SchemaChange{Statement: statement}
+	if confirm == "" {
+		return change, nil
+	}
+	if confirm != statement {
+		return nil, domain.ValidationError{
+			Field:   "confirm",
+			Message: "the statement differs from the one confirmed; preview the change again",
+		}
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.MaintenanceRefusal(time.Time{}, func() (bool, error) {
+		return u.rbacRepo.IsSuperuser(ctx, username)
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.databaseRepo.ExecuteQuery(ctx, statement); err != nil {
+		return nil, err
+	}
+	change.Applied = true
+
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   action,
+		Target:   target,
+		Before:   before,
+		After:    map[string]interface{}{"statement": statement},
+	}); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// dataTypePattern admits type names such as integer, character varying(255), numeric(12, 2),
+// timestamp(3) with time zone, public.mood and text[], as the type is spliced into the statement
+var dataTypePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)?( [A-Za-z]+)*( ?\(\s*\d+\s*(,\s*\d+\s*)?\))?( [A-Za-z]+)*(\[\d*\])*$`)
+
+// checkColumnName refuses empty names and names PostgreSQL would truncate
+func checkColumnName(name, field string) error {
+	if name == "" {
+		return domain.ValidationError{Field: field, Message: "a column name is required"}
+	}
+	if len(name) > domain.MaxIdentifierLength {
+		return domain.ValidationError{Field: field, Message: fmt.Sprintf("column name %s is longer than %d bytes", name, domain.MaxIdentifierLength)}
+	}
+	return nil
+}
+
+// checkDataType refuses anything but a plain type name, so the type cannot carry other SQL
+func checkDataType(dataType, field string) (string, error) {
+	dataType = strings.Join(strings.Fields(dataType), " ")
+	if dataType == "" {
+		return "", domain.ValidationError{Field: field, Message: "a data type is required"}
+	}
+	if !dataTypePattern.MatchString(dataType) {
+		return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("%s is not a data type name", dataType)}
+	}
+	return dataType, nil
+}
+
+// checkExpression refuses a default or USING expression that could end the statement and start another
+func checkExpression(expression, field string) (string, error) {
+	expression = strings.TrimSpace(expression)
+	if strings.Contains(expression, ";") {
+		return "", domain.ValidationError{Field: field, Message: "the expression cannot contain a semicolon"}
+	}
+	return expression, nil
+}
+
+// columnDesignClause writes a column's name, type, default and NOT NULL as CREATE TABLE and
+// ADD COLUMN take them
+func columnDesignClause(column domain.ColumnDesign, field string) (string, error) {
+	name := strings.TrimSpace(column.Name)
+	if err := checkColumnName(name, field); err != nil {
+		return "", err
+	}
+	dataType, err := checkDataType(column.DataType, field)
+	if err != nil {
+		return "", err
+	}
+	defaultExpression, err := checkExpression(column.Default, field)
+	if err != nil {
+		return "", err
+	}
+
+	clause := pq.QuoteIdentifier(name) + " " + dataType
+	if defaultExpression != "" {
+		clause += " DEFAULT " + defaultExpression
+	}
+	if column.NotNull {
+		clause += " NOT NULL"
+	}
+	return clause, nil
+}
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// encodingPattern admits character set names such as UTF8, LATIN1 and WIN1252, as the encoding is
+// spliced into the statement as a literal
+var encodingPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+func (u *SchemaUseCaseImplementation) CreateDatabase(ctx context.Context, username string, creation domain.DatabaseCreation, confirm string) (*domain.SchemaChange, error) {
+	canCreate, err := u.rbacRepo.CanCreateDatabase(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check CREATEDB: %w", err)
+	}
+	if !canCreate {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "only superusers and roles with CREATEDB may create databases",
+		}
+	}
+
+	statement, err := createDatabaseStatement(creation)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := u.applySchemaChange(ctx, username, domain.AuditActionCreateDatabase, strings.TrimSpace(creation.Name), statement, confirm, nil)
+	if err != nil {
+		return nil, err
+	}
+	if change.Applied {
+		u.refreshRoleMetadata(ctx, username, creation.Owner)
+	}
+	return change, nil
+}
+
+// createDatabaseStatement checks the creation and generates its CREATE DATABASE statement
+func createDatabaseStatement(creation domain.DatabaseCreation) (string, error) {
+	name, err := checkObjectName(creation.Name, "name", "database")
+	if err != nil {
+		return "", err
+	}
+	statement := "CREATE DATABASE " + pq.QuoteIdentifier(name)
+
+	if owner := strings.TrimSpace(creation.Owner); owner != "" {
+		statement += " OWNER " + pq.QuoteIdentifier(owner)
+	}
+	if encoding := strings.TrimSpace(creation.Encoding); encoding != "" {
+		if !encodingPattern.MatchString(encoding) {
+			return "", domain.ValidationError{Field: "encoding", Message: fmt.Sprintf("%s is not an encoding name", encoding)}
+		}
+		statement += " ENCODING '" + strings.ToUpper(encoding) + "'"
+	}
+	if template := strings.TrimSpace(creation.Template); template != "" {
+		if template == name {
+			return "", domain.ValidationError{Field: "template", Message: "a database cannot be its own template"}
+		}
+		statement += " TEMPLATE " + pq.QuoteIdentifier(template)
+	}
+	return statement, nil
+}
+
+// checkObjectName refuses empty names and names PostgreSQL would truncate
+func checkObjectName(name, field, kind string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("a %s name is required", kind)}
+	}
+	if len(name) > domain.MaxIdentifierLength {
+		return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("%s name is longer than %d bytes", kind, domain.MaxIdentifierLength)}
+	}
+	return name, nil
+}
+
+// refreshRoleMetadata rebuilds the cached accessible resources of the roles, skipping empty and
+// repeated names, so a new database or schema shows in their sidebar. The change is already
+// applied, so a failure is not reported; it only delays the object showing until the next login.
+func (u *SchemaUseCaseImplementation) refreshRoleMetadata(ctx context.Context, roles ...string) {
+	seen := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		role = strings.TrimSpace(role)
+		if role == "" || seen[role] {
+			continue
+		}
+		seen[role] = true
+
+		metadata, err := u.rbacRepo.GetRoleMetadata(ctx, role)
+		if err != nil || metadata == nil {
+			continue
+		}
+		_ = u.metadataRepo.StoreRoleMetadata(ctx, role, metadata)
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) CreateIndex(ctx context.Context, username string, definition domain.IndexDefinition, confirm string) (*domain.SchemaChange, error) {
+	if err := u.requireTableOwner(ctx, username, definition.Database, definition.Schema, definition.Table); err != nil {
+		return nil, err
+	}
+
+	statement, err := u.createIndexStatement(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	target := definition.Schema + "." + definition.Table
+	return u.applySchemaChange(ctx, username, domain.AuditActionCreateIndex, target, statement, confirm, nil)
+}
+
+// createIndexStatement checks the definition against the table and generates its CREATE INDEX statement
+func (u *SchemaUseCaseImplementation) createIndexStatement(ctx context.Context, definition domain.IndexDefinition) (string, error) {
+	name := strings.TrimSpace(definition.Name)
+	if len(name) > domain.MaxIdentifierLength {
+		return "", domain.ValidationError{Field: "name", Message: fmt.Sprintf("index name is longer than %d bytes", domain.MaxIdentifierLength)}
+	}
+
+	method := strings.ToLower(strings.TrimSpace(definition.Method))
+	if method == "" {
+		method = "btree"
+	}
+	if !isIndexMethod(method) {
+		return "", domain.ValidationError{Field: "method", Message: fmt.Sprintf("unsupported index method: %s", method)}
+	}
+	if definition.Unique && method != "btree" {
+		return "", domain.ValidationError{Field: "unique", Message: "only btree indexes can be unique"}
+	}
+
+	metadata, err := u.metadataRepo.GetMetadata(ctx, definition.Database)
+	if err != nil {
+		return "", err
+	}
+	tableMetadata, err := metadata.FindTable(definition.Schema, definition.Table)
+	if err != nil {
+		return "", err
+	}
+	columns, err := quoteColumns(tableMetadata, definition.Columns, "columns")
+	if err != nil {
+		return "", err
+	}
+
+	var statement strings.Builder
+	statement.WriteString("CREATE ")
+	if definition.Unique {
+		statement.WriteString("UNIQUE ")
+	}
+	statement.WriteString("INDEX ")
+	if definition.Concurrently {
+		statement.WriteString("CONCURRENTLY ")
+	}
+	if name != "" {
+		statement.WriteString(pq.QuoteIdentifier(name) + " ")
+	}
+	statement.WriteString("ON " + pq.QuoteIdentifier(definition.Schema) + "." + pq.QuoteIdentifier(definition.Table))
+	if method != "btree" {
+		statement.WriteString(" USING " + method)
+	}
+	statement.WriteString(" (" + columns + ")")
+
+	return statement.String(), nil
+}
+
+// isIndexMethod reports whether method is one of domain.IndexMethods
+func isIndexMethod(method string) bool {
+	for _, known := range domain.IndexMethods {
+		if method == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) CreateSchema(ctx context.Context, username string, creation domain.SchemaCreation, confirm string) (*domain.SchemaChange, error) {
+	hasPermission, err := u.rbacRepo.HasDatabaseCreatePermission(ctx, username, creation.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check database permission: %w", err)
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: fmt.Sprintf("user does not have CREATE permission on database %s", creation.Database),
+		}
+	}
+
+	name, err := checkObjectName(creation.Name, "name", "schema")
+	if err != nil {
+		return nil, err
+	}
+	statement := "CREATE SCHEMA " + pq.QuoteIdentifier(name)
+	if owner := strings.TrimSpace(creation.Owner); owner != "" {
+		statement += " AUTHORIZATION " + pq.QuoteIdentifier(owner)
+	}
+
+	change, err := u.applySchemaChange(ctx, username, domain.AuditActionCreateSchema, creation.Database+"."+name, statement, confirm, nil)
+	if err != nil {
+		return nil, err
+	}
+	if change.Applied {
+		u.invalidateMetadata(ctx, creation.Database)
+		u.refreshRoleMetadata(ctx, username, creation.Owner)
+	}
+	return change, nil
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) CreateTable(ctx context.Context, username string, creation domain.TableCreation, confirm string) (*domain.SchemaChange, error) {
+	hasPermission, err := u.rbacRepo.HasSchemaCreatePermission(ctx, username, creation.Database, creation.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check schema permission: %w", err)
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: fmt.Sprintf("user does not have CREATE permission on schema %s", creation.Schema),
+		}
+	}
+
+	statement, err := u.createTableStatement(ctx, creation)
+	if err != nil {
+		return nil, err
+	}
+
+	target := creation.Schema + "." + creation.Table
+	change, err := u.applySchemaChange(ctx, username, domain.AuditActionCreateTable, target, statement, confirm, nil)
+	if err != nil {
+		return nil, err
+	}
+	if change.Applied {
+		u.invalidateMetadata(ctx, creation.Database)
+	}
+	return change, nil
+}
+
+// createTableStatement checks the design and generates its CREATE TABLE statement
+func (u *SchemaUseCaseImplementation) createTableStatement(ctx context.Context, creation domain.TableCreation) (string, error) {
+	table := strings.TrimSpace(creation.Table)
+	if table == "" {
+		return "", domain.ValidationError{Field: "table", Message: "a table name is required"}
+	}
+	if len(table) > domain.MaxIdentifierLength {
+		return "", domain.ValidationError{Field: "table", Message: fmt.Sprintf("table name is longer than %d bytes", domain.MaxIdentifierLength)}
+	}
+	_, err := u.databaseRepo.GetTableMetadata(ctx, creation.Database, creation.Schema, table)
+	if err == nil {
+		return "", domain.ValidationError{Field: "table", Message: fmt.Sprintf("table %s.%s already exists", creation.Schema, table)}
+	}
+	if !errors.Is(err, domain.ErrTableNotFound) {
+		return "", err
+	}
+
+	if len(creation.Columns) == 0 {
+		return "", domain.ValidationError{Field: "columns", Message: "a table needs at least one column"}
+	}
+	items := make([]string, 0, len(creation.Columns)+1)
+	seen := make(map[string]bool, len(creation.Columns))
+	for _, column := range creation.Columns {
+		clause, err := columnDesignClause(column, "columns")
+		if err != nil {
+			return "", err
+		}
+		name := strings.TrimSpace(column.Name)
+		if seen[name] {
+			return "", domain.ValidationError{Field: "columns", Message: fmt.Sprintf("column %s is listed more than once", name)}
+		}
+		seen[name] = true
+		items = append(items, clause)
+	}
+
+	if len(creation.PrimaryKey) > 0 {
+		designed := &domain.TableMetadata{Name: table}
+		for _, column := range creation.Columns {
+			designed.Columns = append(designed.Columns, domain.ColumnMetadata{Name: strings.TrimSpace(column.Name)})
+		}
+		columns, err := quoteColumns(designed, creation.PrimaryKey, "primary_key")
+		if err != nil {
+			return "", err
+		}
+		items = append(items, "PRIMARY KEY ("+columns+")")
+	}
+
+	return "CREATE TABLE " + pq.QuoteIdentifier(creation.Schema) + "." + pq.QuoteIdentifier(table) + " (\n    " +
+		strings.Join(items, ",\n    ") + "\n)", nil
+}
+
+// invalidateMetadata drops the cached metadata of a database whose structure changed. The change is
+// already applied, so a failure is not reported; it only delays the change showing until the cache
+// is refreshed.
+func (u *SchemaUseCaseImplementation) invalidateMetadata(ctx context.Context, database string) {
+	_ = u.metadataRepo.InvalidateMetadata(ctx, database)
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) DropConstraint(ctx context.Context, username string, drop domain.ConstraintDrop, confirm string) (*domain.SchemaChange, error) {
+	if err := u.requireTableOwner(ctx, username, drop.Database, drop.Schema, drop.Table); err != nil {
+		return nil, err
+	}
+
+	// Only the table's own constraints may be dropped through it
+	constraints, err := u.databaseRepo.ListTableConstraints(ctx, drop.Database, drop.Schema, drop.Table)
+	if err != nil {
+		return nil, err
+	}
+	var constraint *domain.TableConstraint
+	for i := range constraints {
+		if constraints[i].Name == drop.Name {
+			constraint = &constraints[i]
+			break
+		}
+	}
+	if constraint == nil {
+		return nil, domain.ValidationError{Field: "constraint", Message: fmt.Sprintf("constraint %s not found on %s", drop.Name, drop.Table)}
+	}
+
+	statement := "ALTER TABLE " + pq.QuoteIdentifier(drop.Schema) + "." + pq.QuoteIdentifier(drop.Table) +
+		" DROP CONSTRAINT " + pq.QuoteIdentifier(constraint.Name)
+
+	target := drop.Schema + "." + drop.Table
+	before := map[string]interface{}{"definition": constraint.Definition}
+	return u.applySchemaChange(ctx, username, domain.AuditActionDropConstraint, target, statement, confirm, before)
+}
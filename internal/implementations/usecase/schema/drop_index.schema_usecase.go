@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) DropIndex(ctx context.Context, username string, drop domain.IndexDrop, confirm string) (*domain.SchemaChange, error) {
+	if err := u.requireTableOwner(ctx, username, drop.Database, drop.Schema, drop.Table); err != nil {
+		return nil, err
+	}
+
+	// Only the table's own indexes may be dropped through it
+	indexes, err := u.databaseRepo.ListTableIndexes(ctx, drop.Database, drop.Schema, drop.Table)
+	if err != nil {
+		return nil, err
+	}
+	var index *domain.TableIndex
+	for i := range indexes {
+		if indexes[i].Name == drop.Name {
+			index = &indexes[i]
+			break
+		}
+	}
+	if index == nil {
+		return nil, domain.ValidationError{Field: "index", Message: fmt.Sprintf("index %s not found on %s", drop.Name, drop.Table)}
+	}
+	if index.Constraint != "" {
+		return nil, domain.ValidationError{
+			Field:   "index",
+			Message: fmt.Sprintf("index %s backs constraint %s; drop the constraint instead", index.Name, index.Constraint),
+		}
+	}
+
+	statement := "DROP INDEX "
+	if drop.Concurrently {
+		statement += "CONCURRENTLY "
+	}
+	statement += pq.QuoteIdentifier(drop.Schema) + "." + pq.QuoteIdentifier(index.Name)
+
+	target := drop.Schema + "." + drop.Table
+	before := map[string]interface{}{"definition": index.Definition}
+	return u.applySchemaChange(ctx, username, domain.AuditActionDropIndex, target, statement, confirm, before)
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) GetTableDDL(ctx context.Context, username, database, schema, table string) (*domain.TableDDL, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	definition, err := u.databaseRepo.GetTableDefinition(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := u.databaseRepo.ListTableConstraints(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := u.databaseRepo.ListTableIndexes(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TableDDL{
+		Database:  database,
+		Schema:    schema,
+		Table:     table,
+		Statement: tableDDL(schema, table, definition, constraints, indexes),
+	}, nil
+}
+
+// tableDDL writes CREATE TABLE with the columns and constraints, then the indexes no constraint
+// creates, then the table and column comments
+func tableDDL(schema, table string, definition *domain.TableDefinition, constraints []domain.TableConstraint, indexes []domain.TableIndex) string {
+	name := pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(table)
+
+	var items []string
+	for _, column := range definition.Columns {
+		items = append(items, columnDDL(column))
+	}
+	for _, constraint := range constraints {
+		items = append(items, "CONSTRAINT "+pq.QuoteIdentifier(constraint.Name)+" "+constraint.Definition)
+	}
+
+	var ddl strings.Builder
+	ddl.WriteString("CREATE TABLE " + name + " (\n")
+	for i, item := range items {
+		ddl.WriteString("    " + item)
+		if i < len(items)-1 {
+			ddl.WriteString(",")
+		}
+		ddl.WriteString("\n")
+	}
+	ddl.WriteString(");\n")
+
+	// Indexes behind primary key, unique and exclusion constraints come with their constraints, and an
+	// index whose concurrent build failed is not part of the structure
+	wroteIndex := false
+	for _, index := range indexes {
+		if index.Constraint != "" || !index.Valid {
+			continue
+		}
+		if !wroteIndex {
+			ddl.WriteString("\n")
+			wroteIndex = true
+		}
+		ddl.WriteString(index.Definition + ";\n")
+	}
+
+	var comments []string
+	if definition.Comment != "" {
+		comments = append(comments, "COMMENT ON TABLE "+name+" IS "+pq.QuoteLiteral(definition.Comment)+";")
+	}
+	for _, column := range definition.Columns {
+		if column.Comment != "" {
+			comments = append(comments, "COMMENT ON COLUMN "+name+"."+pq.QuoteIdentifier(column.Name)+" IS "+pq.QuoteLiteral(column.Comment)+";")
+		}
+	}
+	if len(comments) > 0 {
+		ddl.WriteString("\n" + strings.Join(comments, "\n") + "\n")
+	}
+
+	return ddl.String()
+}
+
+// columnDDL declares a column in the order CREATE TABLE expects its clauses
+func columnDDL(column domain.TableColumnDefinition) string {
+	parts := []string{pq.QuoteIdentifier(column.Name), column.Type}
+	if column.Collation != "" {
+		parts = append(parts, "COLLATE "+column.Collation)
+	}
+	switch {
+	case column.Generated != "":
+		parts = append(parts, "GENERATED ALWAYS AS ("+column.Generated+") STORED")
+	case column.Identity != "":
+		parts = append(parts, "GENERATED "+column.Identity+" AS IDENTITY")
+	case column.Default != "":
+		parts = append(parts, "DEFAULT "+column.Default)
+	}
+	if column.NotNull {
+		parts = append(parts, "NOT NULL")
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SchemaUseCaseImplementation) ListComments(ctx context.Context, username, database, schema, table string) (*domain.TableComments, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	definition, err := u.databaseRepo.GetTableDefinition(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := u.rbacRepo.IsTableOwner(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check table owner: %w", err)
+	}
+
+	comments := &domain.TableComments{
+		Comment: definition.Comment,
+		Columns: make([]domain.ColumnComment, len(definition.Columns)),
+		CanEdit: owner,
+	}
+	for i, column := range definition.Columns {
+		comments.Columns[i] = domain.ColumnComment{Name: column.Name, Type: column.Type, Comment: column.Comment}
+	}
+	return comments, nil
+}
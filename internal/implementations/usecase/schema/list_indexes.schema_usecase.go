@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SchemaUseCaseImplementation) ListIndexes(ctx context.Context, username, database, schema, table string) (*domain.TableIndexList, error) {
+	// Check if user has SELECT permission
+	hasPermission, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{
+			Field:   "table",
+			Message: "user does not have SELECT permission on this table",
+		}
+	}
+
+	indexes, err := u.databaseRepo.ListTableIndexes(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := u.rbacRepo.IsTableOwner(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check table owner: %w", err)
+	}
+
+	return &domain.TableIndexList{Indexes: indexes, CanManage: owner}, nil
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SchemaUseCaseImplementation struct {
+	databaseRepo repository.DatabaseRepository
+	metadataRepo repository.MetadataRepository
+	rbacRepo     repository.RBACRepository
+	auditRepo    repository.AuditRepository
+	configRepo   repository.ConfigRepository
+}
+
+func NewSchemaUseCaseImplementation(
+	databaseRepo repository.DatabaseRepository,
+	metadataRepo repository.MetadataRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+	configRepo repository.ConfigRepository,
+) usecase.SchemaUseCase {
+	return &SchemaUseCaseImplementation{
+		databaseRepo: databaseRepo,
+		metadataRepo: metadataRepo,
+		rbacRepo:     rbacRepo,
+		auditRepo:    auditRepo,
+		configRepo:   configRepo,
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+// quoteColumns checks that the columns exist on the table, each listed once, and joins them quoted;
+// errors name field
+func quoteColumns(tableMetadata *domain.TableMetadata, columns []string, field string) (string, error) {
+	if len(columns) == 0 {
+		return "", domain.ValidationError{Field: field, Message: "at least one column is required"}
+	}
+	quoted := make([]string, 0, len(columns))
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if tableMetadata.FindColumn(column) == nil {
+			return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("column %s does not exist on %s", column, tableMetadata.Name)}
+		}
+		if seen[column] {
+			return "", domain.ValidationError{Field: field, Message: fmt.Sprintf("column %s is listed more than once", column)}
+		}
+		seen[column] = true
+		quoted = append(quoted, pq.QuoteIdentifier(column))
+	}
+	return strings.Join(quoted, ", "), nil
+}
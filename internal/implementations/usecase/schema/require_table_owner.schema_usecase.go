@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// requireTableOwner refuses users who do not own the table, as PostgreSQL only lets a table's owner
+// change its structure
+func (u *SchemaUseCaseImplementation) requireTableOwner(ctx context.Context, username, database, schema, table string) error {
+	owner, err := u.rbacRepo.IsTableOwner(ctx, username, database, schema, table)
+	if err != nil {
+		return fmt.Errorf("failed to check table owner: %w", err)
+	}
+	if !owner {
+		return domain.ValidationError{
+			Field:   "permission",
+			Message: "only the table's owner can change its structure",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) SetComment(ctx context.Context, username string, change domain.CommentChange, confirm string) (*domain.SchemaChange, error) {
+	if err := u.requireTableOwner(ctx, username, change.Database, change.Schema, change.Table); err != nil {
+		return nil, err
+	}
+
+	definition, err := u.databaseRepo.GetTableDefinition(ctx, change.Database, change.Schema, change.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	object := "TABLE " + pq.QuoteIdentifier(change.Schema) + "." + pq.QuoteIdentifier(change.Table)
+	target := change.Schema + "." + change.Table
+	before := definition.Comment
+	if change.Column != "" {
+		found := false
+		for _, column := range definition.Columns {
+			if column.Name == change.Column {
+				before = column.Comment
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, domain.ValidationError{
+				Field:   "column",
+				Message: fmt.Sprintf("column %s does not exist on table %s", change.Column, change.Table),
+			}
+		}
+		object = "COLUMN " + pq.QuoteIdentifier(change.Schema) + "." + pq.QuoteIdentifier(change.Table) + "." + pq.QuoteIdentifier(change.Column)
+		target += "." + change.Column
+	}
+
+	// An empty comment removes it rather than storing an empty string
+	value := "NULL"
+	if comment := strings.TrimSpace(change.Comment); comment != "" {
+		value = pq.QuoteLiteral(comment)
+	}
+	statement := "COMMENT ON " + object + " IS " + value
+
+	return u.applySchemaChange(ctx, username, domain.AuditActionSetComment, target, statement, confirm,
+		map[string]interface{}{"comment": before})
+}
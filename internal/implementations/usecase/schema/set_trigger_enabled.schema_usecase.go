@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SchemaUseCaseImplementation) SetTriggerEnabled(ctx context.Context, username string, toggle domain.TriggerToggle, confirm string) (*domain.SchemaChange, error) {
+	if err := u.requireTableOwner(ctx, username, toggle.Database, toggle.Schema, toggle.Table); err != nil {
+		return nil, err
+	}
+
+	// Only the table's own triggers may be toggled through it
+	triggers, err := u.databaseRepo.ListTableTriggers(ctx, toggle.Database, toggle.Schema, toggle.Table)
+	if err != nil {
+		return nil, err
+	}
+	var trigger *domain.TableTrigger
+	for i := range triggers {
+		if triggers[i].Name == toggle.Name {
+			trigger = &triggers[i]
+			break
+		}
+	}
+	if trigger == nil {
+		return nil, domain.ValidationError{Field: "trigger", Message: fmt.Sprintf("trigger %s not found on %s", toggle.Name, toggle.Table)}
+	}
+
+	verb, action := " DISABLE TRIGGER ", domain.AuditActionDisableTrigger
+	if toggle.Enable {
+		verb, action = " ENABLE TRIGGER ", domain.AuditActionEnableTrigger
+	}
+	statement := "ALTER TABLE " + pq.QuoteIdentifier(toggle.Schema) + "." + pq.QuoteIdentifier(toggle.Table) + verb + pq.QuoteIdentifier(trigger.Name)
+
+	target := toggle.Schema + "." + toggle.Table
+	before := map[string]interface{}{"trigger": trigger.Name, "enabled": trigger.Enabled}
+	return u.applySchemaChange(ctx, username, action, target, statement, confirm, before)
+}
@@ -0,0 +1,11 @@
+package schema
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestSchemaUsecase(t *testing.T) {
+	testRunner.SchemaUsecaseRunner(t, NewSchemaUseCaseImplementation)
+}
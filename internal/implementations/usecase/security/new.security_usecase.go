@@ -9,16 +9,20 @@ type SecurityUseCaseImplementation struct {
 	encryptionRepo repository.EncryptionRepository
 	sessionRepo    repository.SessionRepository
 	clockRepo      repository.ClockRepository
+	// auditRepo records the queries refused as injection attempts
+	auditRepo repository.AuditRepository
 }
 
 func NewSecurityUseCaseImplementation(
 	encryptionRepo repository.EncryptionRepository,
 	sessionRepo repository.SessionRepository,
 	clockRepo repository.ClockRepository,
+	auditRepo repository.AuditRepository,
 ) usecase.SecurityUseCase {
 	return &SecurityUseCaseImplementation{
 		encryptionRepo: encryptionRepo,
 		sessionRepo:    sessionRepo,
 		clockRepo:      clockRepo,
+		auditRepo:      auditRepo,
 	}
 }
@@ -3,16 +3,29 @@ package security
 import (
 	"context"
 	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 func (u *SecurityUseCaseImplementation) ValidateQueryForInjection(ctx context.Context, query string) (bool, error) {
 	// Validate the query signature to detect SQL injection attempts
 	// Returns true if injection detected, false if query is safe
-	hasInjection, err := u.encryptionRepo.ValidateSignature(ctx, query, "")
+	safe, err := u.encryptionRepo.ValidateSignature(ctx, query, "")
 	if err != nil {
 		return false, fmt.Errorf("failed to validate query for injection: %w", err)
 	}
 
 	// ValidateSignature returns true for safe queries, so we need to invert it
-	return !hasInjection, nil
+	if safe {
+		return false, nil
+	}
+
+	// The rejection is named after the WHERE clause rule the query also trips, if any; a failed write
+	// must not hide the verdict
+	rule, ok := domain.UnsafeWhereRule(query)
+	if !ok {
+		rule = domain.RejectionRuleQuerySignature
+	}
+	_ = u.auditRepo.RecordEntry(ctx, domain.RejectionAuditEntry(ctx, "", domain.RejectionSourceSQLValidator, rule))
+	return true, nil
 }
@@ -0,0 +1,39 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// applySequenceChange previews statement when confirm is empty, and otherwise runs and audits it once
+// confirm shows it is the statement the user saw
+func (u *SequenceUseCaseImplementation) applySequenceChange(ctx context.Context, username, action, target, statement, confirm string, before map[string]interface{}) (*domain.SchemaChange, error) {
+	change := &domain.SchemaChange{Statement: statement}
+	if confirm == "" {
+		return change, nil
+	}
+	if confirm != statement {
+		return nil, domain.ValidationError{
+			Field:   "confirm",
+			Message: "the statement differs from the one confirmed; preview the change again",
+		}
+	}
+
+	if _, err := u.databaseRepo.ExecuteQuery(ctx, statement); err != nil {
+		return nil, err
+	}
+	change.Applied = true
+
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: username,
+		Action:   action,
+		Target:   target,
+		Before:   before,
+		After:    map[string]interface{}{"statement": statement},
+	}); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
@@ -0,0 +1,42 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// findSequence looks up one of a schema's sequences by name
+func (u *SequenceUseCaseImplementation) findSequence(ctx context.Context, database, schema, name string) (*domain.Sequence, error) {
+	sequences, err := u.databaseRepo.ListSequences(ctx, database, schema)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sequences {
+		if sequences[i].Name == name {
+			return &sequences[i], nil
+		}
+	}
+	return nil, domain.ValidationError{Field: "sequence", Message: fmt.Sprintf("sequence %s not found in %s", name, schema)}
+}
+
+// checkRange refuses values outside the sequence's bounds, which PostgreSQL would reject on running
+func checkRange(sequence *domain.Sequence, value int64) error {
+	if value < sequence.MinValue || value > sequence.MaxValue {
+		return domain.ValidationError{
+			Field:   "value",
+			Message: fmt.Sprintf("value %d is outside the bounds of %s (%d to %d)", value, sequence.Name, sequence.MinValue, sequence.MaxValue),
+		}
+	}
+	return nil
+}
+
+// beforeState records a sequence's position ahead of a change for the audit log
+func beforeState(sequence *domain.Sequence) map[string]interface{} {
+	before := map[string]interface{}{"last_value": nil}
+	if sequence.LastValue != nil {
+		before["last_value"] = *sequence.LastValue
+	}
+	return before
+}
@@ -0,0 +1,33 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SequenceUseCaseImplementation) ListSequences(ctx context.Context, username, database, schema string) ([]domain.Sequence, error) {
+	sequences, err := u.databaseRepo.ListSequences(ctx, database, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make([]domain.Sequence, 0, len(sequences))
+	for _, sequence := range sequences {
+		permissions, err := u.rbacRepo.GetSequencePermissions(ctx, username, database, schema, sequence.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !permissions.CanSelect && !permissions.CanUsage && !permissions.CanUpdate {
+			continue
+		}
+		if !permissions.CanSelect {
+			sequence.LastValue = nil
+		}
+		sequence.CanSet = permissions.CanUpdate
+		sequence.CanRestart = permissions.IsOwner
+		visible = append(visible, sequence)
+	}
+
+	return visible, nil
+}
@@ -0,0 +1,24 @@
+package sequence
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SequenceUseCaseImplementation struct {
+	databaseRepo repository.DatabaseRepository
+	rbacRepo     repository.RBACRepository
+	auditRepo    repository.AuditRepository
+}
+
+func NewSequenceUseCaseImplementation(
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+) usecase.SequenceUseCase {
+	return &SequenceUseCaseImplementation{
+		databaseRepo: databaseRepo,
+		rbacRepo:     rbacRepo,
+		auditRepo:    auditRepo,
+	}
+}
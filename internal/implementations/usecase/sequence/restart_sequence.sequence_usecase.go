@@ -0,0 +1,38 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SequenceUseCaseImplementation) RestartSequence(ctx context.Context, username string, restart domain.SequenceRestart, confirm string) (*domain.SchemaChange, error) {
+	permissions, err := u.rbacRepo.GetSequencePermissions(ctx, username, restart.Database, restart.Schema, restart.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !permissions.IsOwner {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "only the sequence's owner can restart it",
+		}
+	}
+
+	sequence, err := u.findSequence(ctx, restart.Database, restart.Schema, restart.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := "ALTER SEQUENCE " + pq.QuoteIdentifier(restart.Schema) + "." + pq.QuoteIdentifier(restart.Name) + " RESTART"
+	if restart.Value != nil {
+		if err := checkRange(sequence, *restart.Value); err != nil {
+			return nil, err
+		}
+		statement += fmt.Sprintf(" WITH %d", *restart.Value)
+	}
+
+	target := restart.Schema + "." + restart.Name
+	return u.applySequenceChange(ctx, username, domain.AuditActionRestartSequence, target, statement, confirm, beforeState(sequence))
+}
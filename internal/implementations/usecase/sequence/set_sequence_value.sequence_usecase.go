@@ -0,0 +1,36 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *SequenceUseCaseImplementation) SetSequenceValue(ctx context.Context, username string, value domain.SequenceValue, confirm string) (*domain.SchemaChange, error) {
+	permissions, err := u.rbacRepo.GetSequencePermissions(ctx, username, value.Database, value.Schema, value.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !permissions.CanUpdate {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "only roles with UPDATE on the sequence can set its value",
+		}
+	}
+
+	sequence, err := u.findSequence(ctx, value.Database, value.Schema, value.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRange(sequence, value.Value); err != nil {
+		return nil, err
+	}
+
+	name := pq.QuoteIdentifier(value.Schema) + "." + pq.QuoteIdentifier(value.Name)
+	statement := fmt.Sprintf("SELECT setval(%s, %d, %t)", pq.QuoteLiteral(name), value.Value, value.IsCalled)
+
+	target := value.Schema + "." + value.Name
+	return u.applySequenceChange(ctx, username, domain.AuditActionSetSequence, target, statement, confirm, beforeState(sequence))
+}
@@ -0,0 +1,11 @@
+package sequence
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestSequenceUsecase(t *testing.T) {
+	testRunner.SequenceUsecaseRunner(t, NewSequenceUseCaseImplementation)
+}
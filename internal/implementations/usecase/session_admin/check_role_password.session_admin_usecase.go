@@ -0,0 +1,103 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// commonPasswords are passwords, and the stems of passwords, too widely used to resist guessing
+var commonPasswords = map[string]bool{
+	"password": true, "passw0rd": true, "p@ssw0rd": true, "p@ssword": true, "123456": true,
+	"12345678": true, "123456789": true, "1234567890": true, "qwerty": true, "qwertyuiop": true,
+	"abc123": true, "letmein": true, "welcome": true, "admin": true, "administrator": true,
+	"changeme": true, "secret": true, "iloveyou": true, "monkey": true, "dragon": true,
+	"sunshine": true, "princess": true, "football": true, "baseball": true, "master": true,
+	"trustno1": true, "superman": true, "starwars": true, "whatever": true, "shadow": true,
+	"postgres": true, "postgresql": true, "database": true, "root": true, "toor": true,
+	"default": true, "summer": true, "winter": true, "spring": true, "autumn": true,
+}
+
+func (u *SessionAdminUseCaseImplementation) CheckRolePassword(ctx context.Context, adminUsername, roleName, password string) (*domain.PasswordCheck, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load password policy: %w", err)
+	}
+	return &domain.PasswordCheck{Violations: passwordViolations(config.PasswordPolicy, roleName, password)}, nil
+}
+
+// passwordViolations describes each rule of the policy the password breaks
+func passwordViolations(policy domain.PasswordPolicy, roleName, password string) []string {
+	minLength := policy.MinLength
+	if minLength <= 0 {
+		minLength = domain.DefaultPasswordMinLength
+	}
+	minClasses := policy.MinCharacterClasses
+	if minClasses <= 0 {
+		minClasses = domain.DefaultPasswordCharacterClasses
+	}
+
+	violations := []string{}
+	if utf8.RuneCountInString(password) < minLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", minLength))
+	}
+	if classes := passwordCharacterClasses(password); classes < minClasses {
+		violations = append(violations, fmt.Sprintf("must mix at least %d of lowercase letters, uppercase letters, digits and symbols", minClasses))
+	}
+	if !policy.AllowCommonPasswords {
+		if isCommonPassword(password) {
+			violations = append(violations, "is too common to resist guessing")
+		}
+		if roleName != "" && strings.Contains(strings.ToLower(password), strings.ToLower(roleName)) {
+			violations = append(violations, "must not contain the role name")
+		}
+	}
+	return violations
+}
+
+// passwordCharacterClasses counts which of lowercase letters, uppercase letters, digits and symbols
+// the password uses
+func passwordCharacterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, used := range []bool{lower, upper, digit, symbol} {
+		if used {
+			classes++
+		}
+	}
+	return classes
+}
+
+// isCommonPassword reports whether the password is a common one, ignoring case and the digits and
+// symbols commonly added to its end, as in "Password123!"
+func isCommonPassword(password string) bool {
+	lowered := strings.ToLower(password)
+	if commonPasswords[lowered] {
+		return true
+	}
+	stem := strings.TrimRightFunc(lowered, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return stem != "" && commonPasswords[stem]
+}
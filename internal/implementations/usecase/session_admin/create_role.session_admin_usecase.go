@@ -0,0 +1,48 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// roleNamePattern admits the role names that need no quoting, which login forms can take as typed
+var roleNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_$]{0,62}$`)
+
+func (u *SessionAdminUseCaseImplementation) CreateRole(ctx context.Context, adminUsername string, role domain.NewRole) error {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return err
+	}
+
+	role.Name = strings.TrimSpace(role.Name)
+	if !roleNamePattern.MatchString(role.Name) || strings.HasPrefix(role.Name, "pg_") {
+		return domain.ValidationError{
+			Field:   "name",
+			Message: "role name must be lowercase letters, digits and underscores, start with a letter or underscore, and not start with pg_",
+		}
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load password policy: %w", err)
+	}
+	if violations := passwordViolations(config.PasswordPolicy, role.Name, role.Password); len(violations) > 0 {
+		return domain.ValidationError{
+			Field:   "password",
+			Message: "password " + strings.Join(violations, "; "),
+		}
+	}
+
+	if err := u.rbacRepo.CreateRole(ctx, role); err != nil {
+		return err
+	}
+	return u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionCreateRole,
+		Target:   role.Name,
+		After:    map[string]interface{}{"login": true, "createdb": role.CreateDB},
+	})
+}
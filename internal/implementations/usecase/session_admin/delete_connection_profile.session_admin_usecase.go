@@ -0,0 +1,52 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) DeleteConnectionProfile(ctx context.Context, adminUsername, name string) error {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return err
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load connection profiles: %w", err)
+	}
+
+	profiles := config.ConnectionProfiles
+	if len(profiles) == 0 {
+		profiles = []domain.ConnectionProfile{domain.DefaultConnectionProfile}
+	}
+
+	// Sessions already connected to the profile's server keep their connections
+	var deleted domain.ConnectionProfile
+	kept := make([]domain.ConnectionProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		if profile.Name != name {
+			kept = append(kept, profile)
+		} else {
+			deleted = profile
+		}
+	}
+	if len(kept) == len(profiles) {
+		return domain.ValidationError{Field: "profile", Message: fmt.Sprintf("unknown connection profile: %s", name)}
+	}
+	if len(kept) == 0 {
+		return domain.ValidationError{Field: "profile", Message: "the last connection profile cannot be removed"}
+	}
+
+	config.ConnectionProfiles = kept
+	if err := u.configRepo.UpdateConfig(ctx, config); err != nil {
+		return fmt.Errorf("failed to delete connection profile: %w", err)
+	}
+	return u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionDeleteConnectionProfile,
+		Target:   name,
+		Before:   profileValues(deleted),
+	})
+}
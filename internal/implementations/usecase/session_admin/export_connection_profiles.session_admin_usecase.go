@@ -0,0 +1,65 @@
+package session_admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// profileDocument is the JSON form of a connection profile export. Service passwords appear only as
+// the secret references profiles hold, which each instance resolves against its own environment.
+type profileDocument struct {
+	Version    int               `json:"version"`
+	ExportedBy string            `json:"exported_by,omitempty"`
+	ExportedAt string            `json:"exported_at,omitempty"`
+	Profiles   []exportedProfile `json:"profiles"`
+}
+
+type exportedProfile struct {
+	Name               string `json:"name"`
+	Host               string `json:"host"`
+	Port               int    `json:"port,omitempty"`
+	SSLMode            string `json:"sslmode,omitempty"`
+	ServicePasswordRef string `json:"service_password_ref,omitempty"`
+}
+
+func (u *SessionAdminUseCaseImplementation) ExportConnectionProfiles(ctx context.Context, adminUsername string) ([]byte, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connection profiles: %w", err)
+	}
+
+	profiles := config.ConnectionProfiles
+	if len(profiles) == 0 {
+		profiles = []domain.ConnectionProfile{domain.DefaultConnectionProfile}
+	}
+
+	document := profileDocument{
+		Version:    domain.ConnectionProfileExportVersion,
+		ExportedBy: adminUsername,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+		Profiles:   make([]exportedProfile, 0, len(profiles)),
+	}
+	for _, profile := range profiles {
+		document.Profiles = append(document.Profiles, exportedProfile{
+			Name:               profile.Name,
+			Host:               profile.Host,
+			Port:               profile.Port,
+			SSLMode:            profile.SSLMode,
+			ServicePasswordRef: profile.ServicePasswordRef,
+		})
+	}
+
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode connection profiles: %w", err)
+	}
+	return content, nil
+}
@@ -0,0 +1,32 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) GetEnvironmentBanner(ctx context.Context) (*domain.EnvironmentBanner, error) {
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load environment banner: %w", err)
+	}
+	return environmentBanner(config), nil
+}
+
+// environmentBanner reads the environment banner out of the application config
+func environmentBanner(config *domain.AppConfig) *domain.EnvironmentBanner {
+	if config.EnvironmentName == "" {
+		return &domain.EnvironmentBanner{}
+	}
+
+	color := config.EnvironmentColor
+	if color == "" {
+		color = domain.EnvironmentColors[config.EnvironmentName]
+	}
+	if color == "" {
+		color = domain.DefaultEnvironmentColor
+	}
+	return &domain.EnvironmentBanner{Name: config.EnvironmentName, Color: color}
+}
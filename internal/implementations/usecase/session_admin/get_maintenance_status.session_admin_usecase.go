@@ -0,0 +1,39 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) GetMaintenanceStatus(ctx context.Context, adminUsername string) (*domain.MaintenanceStatus, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load maintenance status: %w", err)
+	}
+	return maintenanceStatus(config), nil
+}
+
+// maintenanceStatus reads the maintenance settings out of the application config
+func maintenanceStatus(config *domain.AppConfig) *domain.MaintenanceStatus {
+	if !config.MaintenanceMode {
+		return &domain.MaintenanceStatus{}
+	}
+
+	message := config.MaintenanceMessage
+	if message == "" {
+		message = domain.DefaultMaintenanceMessage
+	}
+	return &domain.MaintenanceStatus{
+		Enabled:     true,
+		Message:     message,
+		Since:       config.MaintenanceSince,
+		GraceEndsAt: config.MaintenanceSince.Add(time.Duration(domain.MaintenanceGracePeriod) * time.Second),
+	}
+}
@@ -0,0 +1,61 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) GetRejectionStats(ctx context.Context, adminUsername string, since time.Time) ([]domain.RejectionStat, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	if since.IsZero() {
+		since = time.Now().Add(-domain.RejectionStatsDefaultWindow)
+	}
+	entries, err := u.auditRepo.GetEntries(ctx, domain.AuditFilter{Action: domain.AuditActionRequestRejected, Since: since})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rejections: %w", err)
+	}
+
+	type statKey struct{ source, rule, method, route string }
+	counted := make(map[statKey]*domain.RejectionStat)
+	stats := []domain.RejectionStat{}
+	order := []statKey{}
+	for _, entry := range entries {
+		key := statKey{
+			source: rejectionField(entry, "source"),
+			rule:   rejectionField(entry, "rule"),
+			method: rejectionField(entry, "method"),
+			route:  rejectionField(entry, "route"),
+		}
+		stat, ok := counted[key]
+		if !ok {
+			stat = &domain.RejectionStat{Source: key.source, Rule: key.rule, Method: key.method, Route: key.route}
+			counted[key] = stat
+			order = append(order, key)
+		}
+		stat.Count++
+		if entry.CreatedAt.After(stat.LastSeen) {
+			stat.LastSeen = entry.CreatedAt
+		}
+	}
+
+	for _, key := range order {
+		stats = append(stats, *counted[key])
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Count > stats[j].Count
+	})
+	return stats, nil
+}
+
+// rejectionField reads one of the fields RejectionAuditEntry records
+func rejectionField(entry domain.AuditEntry, field string) string {
+	value, _ := entry.After[field].(string)
+	return value
+}
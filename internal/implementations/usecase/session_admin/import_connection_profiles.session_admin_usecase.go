@@ -0,0 +1,107 @@
+package session_admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) ImportConnectionProfiles(ctx context.Context, adminUsername string, content []byte) (*domain.ConnectionProfileImport, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	var document profileDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, domain.ValidationError{Field: "file", Message: "not a connection profile export: " + err.Error()}
+	}
+	if document.Version != domain.ConnectionProfileExportVersion {
+		return nil, domain.ValidationError{Field: "file", Message: fmt.Sprintf("unsupported connection profile export version: %d", document.Version)}
+	}
+	if len(document.Profiles) == 0 {
+		return nil, domain.ValidationError{Field: "file", Message: "the export holds no connection profiles"}
+	}
+
+	// Check every profile before saving any, so a bad file imports nothing
+	imported := make([]domain.ConnectionProfile, 0, len(document.Profiles))
+	seen := make(map[string]bool, len(document.Profiles))
+	for i, exported := range document.Profiles {
+		profile := domain.ConnectionProfile{
+			Name:               strings.TrimSpace(exported.Name),
+			Host:               strings.TrimSpace(exported.Host),
+			Port:               exported.Port,
+			SSLMode:            exported.SSLMode,
+			ServicePasswordRef: strings.TrimSpace(exported.ServicePasswordRef),
+		}
+		label := profile.Name
+		if label == "" {
+			label = fmt.Sprintf("#%d", i+1)
+		}
+		if err := validateConnectionProfile(profile); err != nil {
+			return nil, domain.ValidationError{Field: "file", Message: fmt.Sprintf("profile %s: %s", label, err.Error())}
+		}
+		if seen[profile.Name] {
+			return nil, domain.ValidationError{Field: "file", Message: fmt.Sprintf("profile %s appears more than once", label)}
+		}
+		seen[profile.Name] = true
+		imported = append(imported, profile)
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connection profiles: %w", err)
+	}
+	profiles := config.ConnectionProfiles
+	if len(profiles) == 0 {
+		profiles = []domain.ConnectionProfile{domain.DefaultConnectionProfile}
+	}
+
+	result := &domain.ConnectionProfileImport{}
+	before := make(map[string]interface{})
+	after := make(map[string]interface{})
+	for _, profile := range imported {
+		replaced := false
+		for i := range profiles {
+			if profiles[i].Name == profile.Name {
+				before[profile.Name] = profileValues(profiles[i])
+				profiles[i] = profile
+				replaced = true
+				break
+			}
+		}
+		if replaced {
+			result.Replaced = append(result.Replaced, profile.Name)
+		} else {
+			profiles = append(profiles, profile)
+			result.Added = append(result.Added, profile.Name)
+		}
+		after[profile.Name] = profileValues(profile)
+
+		// An unresolved reference is reported rather than refused, as the secret is often provisioned
+		// on the target instance after its configuration is promoted
+		if profile.ServicePasswordRef != "" {
+			if _, err := u.secretRepo.ResolveSecret(ctx, profile.ServicePasswordRef); err != nil {
+				result.UnresolvedSecrets = append(result.UnresolvedSecrets, profile.ServicePasswordRef)
+			}
+		}
+	}
+
+	config.ConnectionProfiles = profiles
+	if err := u.configRepo.UpdateConfig(ctx, config); err != nil {
+		return nil, fmt.Errorf("failed to import connection profiles: %w", err)
+	}
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionImportProfiles,
+		Target:   fmt.Sprintf("%d connection profiles", len(imported)),
+		Before:   before,
+		After:    after,
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,28 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) ListSessions(ctx context.Context, adminUsername string) ([]*domain.Session, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	sessions, err := u.sessionRepo.ListSessions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	// Hand out copies so stored credentials never leave the repository
+	result := make([]*domain.Session, 0, len(sessions))
+	for _, session := range sessions {
+		listed := *session
+		listed.EncryptedPassword = ""
+		result = append(result, &listed)
+	}
+	return result, nil
+}
@@ -0,0 +1,37 @@
+package session_admin
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type SessionAdminUseCaseImplementation struct {
+	sessionRepo     repository.SessionRepository
+	transactionRepo repository.TransactionRepository
+	databaseRepo    repository.DatabaseRepository
+	rbacRepo        repository.RBACRepository
+	configRepo      repository.ConfigRepository
+	auditRepo       repository.AuditRepository
+	// secretRepo checks that imported profiles' secret references resolve on this instance
+	secretRepo repository.SecretRepository
+}
+
+func NewSessionAdminUseCaseImplementation(
+	sessionRepo repository.SessionRepository,
+	transactionRepo repository.TransactionRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	auditRepo repository.AuditRepository,
+	secretRepo repository.SecretRepository,
+) usecase.SessionAdminUseCase {
+	return &SessionAdminUseCaseImplementation{
+		sessionRepo:     sessionRepo,
+		transactionRepo: transactionRepo,
+		databaseRepo:    databaseRepo,
+		rbacRepo:        rbacRepo,
+		configRepo:      configRepo,
+		auditRepo:       auditRepo,
+		secretRepo:      secretRepo,
+	}
+}
@@ -0,0 +1,23 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// requireSuperuser rejects admins whose role is not a PostgreSQL superuser
+func (u *SessionAdminUseCaseImplementation) requireSuperuser(ctx context.Context, adminUsername string) error {
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, adminUsername)
+	if err != nil {
+		return fmt.Errorf("failed to check superuser status: %w", err)
+	}
+	if !isSuperuser {
+		return domain.ValidationError{
+			Field:   "permission",
+			Message: "only superusers can use the admin panel",
+		}
+	}
+	return nil
+}
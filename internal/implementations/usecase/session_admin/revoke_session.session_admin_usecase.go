@@ -0,0 +1,55 @@
+package session_admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) RevokeSession(ctx context.Context, adminUsername, sessionID string) error {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return err
+	}
+
+	session, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return domain.ErrSessionNotFound
+	}
+
+	// Deleting the session invalidates its cookie on the next request
+	if err := u.sessionRepo.DeleteSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if err := u.databaseRepo.CloseSessionPool(sessionID); err != nil {
+		return fmt.Errorf("failed to close connection pool: %w", err)
+	}
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionRevokeSession,
+		Target:   sessionID,
+		Before:   map[string]interface{}{"username": session.Username, "client_ip": session.ClientIP},
+	}); err != nil {
+		return err
+	}
+
+	// Transaction buffers are kept per user, so the revoked user's pending edits are discarded
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, session.Username)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoActiveTransaction) {
+			return nil
+		}
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if txn == nil {
+		return nil
+	}
+	if err := u.transactionRepo.DeleteTransaction(ctx, txn.ID); err != nil {
+		return fmt.Errorf("failed to delete transaction: %w", err)
+	}
+	return nil
+}
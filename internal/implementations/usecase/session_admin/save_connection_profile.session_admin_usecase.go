@@ -0,0 +1,115 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) SaveConnectionProfile(ctx context.Context, adminUsername string, profile domain.ConnectionProfile) error {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return err
+	}
+
+	profile.Name = strings.TrimSpace(profile.Name)
+	profile.Host = strings.TrimSpace(profile.Host)
+	profile.ServicePasswordRef = strings.TrimSpace(profile.ServicePasswordRef)
+	if err := validateConnectionProfile(profile); err != nil {
+		return err
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load connection profiles: %w", err)
+	}
+
+	// The built-in server stays offered, and the default, until a superuser removes it
+	profiles := config.ConnectionProfiles
+	if len(profiles) == 0 {
+		profiles = []domain.ConnectionProfile{domain.DefaultConnectionProfile}
+	}
+
+	var before map[string]interface{}
+	replaced := false
+	for i := range profiles {
+		if profiles[i].Name == profile.Name {
+			before = profileValues(profiles[i])
+			profiles[i] = profile
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		profiles = append(profiles, profile)
+	}
+
+	config.ConnectionProfiles = profiles
+	if err := u.configRepo.UpdateConfig(ctx, config); err != nil {
+		return fmt.Errorf("failed to save connection profile: %w", err)
+	}
+	return u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionSaveConnectionProfile,
+		Target:   profile.Name,
+		Before:   before,
+		After:    profileValues(profile),
+	})
+}
+
+// profileValues is the audited form of a connection profile
+func profileValues(profile domain.ConnectionProfile) map[string]interface{} {
+	return map[string]interface{}{
+		"host":                 profile.Host,
+		"port":                 profile.Port,
+		"sslmode":              profile.SSLMode,
+		"service_password_ref": profile.ServicePasswordRef,
+	}
+}
+
+// validateConnectionProfile checks a profile names a reachable server address
+func validateConnectionProfile(profile domain.ConnectionProfile) error {
+	if profile.Name == "" {
+		return domain.ValidationError{Field: "name", Message: "profile name is required"}
+	}
+	if profile.Host == "" {
+		return domain.ValidationError{Field: "host", Message: "host is required"}
+	}
+	if profile.Port < 0 || profile.Port > 65535 {
+		return domain.ValidationError{Field: "port", Message: fmt.Sprintf("invalid port: %d", profile.Port)}
+	}
+	if err := validateSecretReference(profile.ServicePasswordRef); err != nil {
+		return err
+	}
+	if profile.SSLMode == "" {
+		return nil
+	}
+	for _, mode := range domain.ConnectionSSLModes {
+		if profile.SSLMode == mode {
+			return nil
+		}
+	}
+	return domain.ValidationError{Field: "sslmode", Message: fmt.Sprintf("unsupported sslmode: %s", profile.SSLMode)}
+}
+
+// validateSecretReference checks a service password is given as a secret-provider reference, so a
+// profile never holds the password itself; empty is allowed
+func validateSecretReference(reference string) error {
+	if reference == "" {
+		return nil
+	}
+	provider, name, _ := strings.Cut(reference, ":")
+	if name != "" {
+		for _, known := range domain.SecretProviders {
+			if provider == known {
+				return nil
+			}
+		}
+	}
+	// The value is not echoed, as it may be a password pasted in by mistake
+	return domain.ValidationError{
+		Field:   "service_password_ref",
+		Message: "service password must be a secret reference such as env:NAME or file:/path",
+	}
+}
@@ -0,0 +1,65 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) SetDisabledShortcuts(ctx context.Context, adminUsername string, actions []string) ([]string, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	// Keep DefaultShortcuts order so the audited before and after compare cleanly
+	requested := make(map[string]bool, len(actions))
+	for _, action := range actions {
+		action = strings.TrimSpace(action)
+		if action == "" {
+			continue
+		}
+		if !isShortcutAction(action) {
+			return nil, domain.ValidationError{Field: "actions", Message: fmt.Sprintf("unknown shortcut action: %s", action)}
+		}
+		requested[action] = true
+	}
+	disabled := []string{}
+	for _, shortcut := range domain.DefaultShortcuts {
+		if requested[shortcut.Action] {
+			disabled = append(disabled, shortcut.Action)
+		}
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load disabled shortcuts: %w", err)
+	}
+
+	before := config.DisabledShortcuts
+	config.DisabledShortcuts = disabled
+
+	if err := u.configRepo.UpdateConfig(ctx, config); err != nil {
+		return nil, fmt.Errorf("failed to save disabled shortcuts: %w", err)
+	}
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionDisableShortcuts,
+		Before:   map[string]interface{}{"actions": before},
+		After:    map[string]interface{}{"actions": disabled},
+	}); err != nil {
+		return nil, err
+	}
+	return disabled, nil
+}
+
+// isShortcutAction reports whether action names one of DefaultShortcuts
+func isShortcutAction(action string) bool {
+	for _, shortcut := range domain.DefaultShortcuts {
+		if shortcut.Action == action {
+			return true
+		}
+	}
+	return false
+}
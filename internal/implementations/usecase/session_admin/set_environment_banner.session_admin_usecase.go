@@ -0,0 +1,64 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// environmentColorPattern admits only #rrggbb colors, as the color is written into every page's CSS
+var environmentColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (u *SessionAdminUseCaseImplementation) SetEnvironmentBanner(ctx context.Context, adminUsername, name, color string) (*domain.EnvironmentBanner, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	name = strings.ToUpper(strings.TrimSpace(name))
+	color = strings.ToLower(strings.TrimSpace(color))
+	if len(name) > domain.EnvironmentNameMaxLength {
+		return nil, domain.ValidationError{
+			Field:   "name",
+			Message: fmt.Sprintf("environment name must be at most %d characters", domain.EnvironmentNameMaxLength),
+		}
+	}
+	if color != "" && !environmentColorPattern.MatchString(color) {
+		return nil, domain.ValidationError{Field: "color", Message: "banner color must be a #rrggbb hex color"}
+	}
+	if name == "" {
+		color = ""
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load environment banner: %w", err)
+	}
+
+	before := environmentValues(config)
+	config.EnvironmentName = name
+	config.EnvironmentColor = color
+
+	if err := u.configRepo.UpdateConfig(ctx, config); err != nil {
+		return nil, fmt.Errorf("failed to save environment banner: %w", err)
+	}
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionSetEnvironment,
+		Before:   before,
+		After:    environmentValues(config),
+	}); err != nil {
+		return nil, err
+	}
+	return environmentBanner(config), nil
+}
+
+// environmentValues is the audited form of the environment banner settings
+func environmentValues(config *domain.AppConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"name":  config.EnvironmentName,
+		"color": config.EnvironmentColor,
+	}
+}
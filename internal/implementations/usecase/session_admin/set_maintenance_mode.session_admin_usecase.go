@@ -0,0 +1,55 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) SetMaintenanceMode(ctx context.Context, adminUsername string, enabled bool, message string) (*domain.MaintenanceStatus, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load maintenance status: %w", err)
+	}
+
+	before := maintenanceValues(config)
+
+	// Updating the message while already on keeps the original start, so the grace period is not extended
+	if enabled && !config.MaintenanceMode {
+		config.MaintenanceSince = time.Now()
+	}
+	if !enabled {
+		config.MaintenanceSince = time.Time{}
+		message = ""
+	}
+	config.MaintenanceMode = enabled
+	config.MaintenanceMessage = strings.TrimSpace(message)
+
+	if err := u.configRepo.UpdateConfig(ctx, config); err != nil {
+		return nil, fmt.Errorf("failed to save maintenance mode: %w", err)
+	}
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionMaintenance,
+		Before:   before,
+		After:    maintenanceValues(config),
+	}); err != nil {
+		return nil, err
+	}
+	return maintenanceStatus(config), nil
+}
+
+// maintenanceValues is the audited form of the maintenance settings
+func maintenanceValues(config *domain.AppConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled": config.MaintenanceMode,
+		"message": config.MaintenanceMessage,
+	}
+}
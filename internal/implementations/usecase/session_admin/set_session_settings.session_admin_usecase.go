@@ -0,0 +1,53 @@
+package session_admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *SessionAdminUseCaseImplementation) SetSessionSettings(ctx context.Context, adminUsername, sessionID string, settings domain.SessionSettings) (*domain.Session, error) {
+	if err := u.requireSuperuser(ctx, adminUsername); err != nil {
+		return nil, err
+	}
+
+	session, err := u.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session == nil {
+		return nil, domain.ErrSessionNotFound
+	}
+
+	settings, err = settings.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	before := sessionSettingsEntry(session.Settings)
+	session.Settings = settings
+	if err := u.sessionRepo.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: adminUsername,
+		Action:   domain.AuditActionSessionSettings,
+		Target:   sessionID,
+		Before:   before,
+		After:    sessionSettingsEntry(settings),
+	}); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// sessionSettingsEntry is the audited form of a session's settings
+func sessionSettingsEntry(settings domain.SessionSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"work_mem":                settings.WorkMem,
+		"statement_timeout":       settings.StatementTimeout.String(),
+		"application_name_suffix": settings.ApplicationNameSuffix,
+	}
+}
@@ -0,0 +1,11 @@
+package session_admin
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestSessionAdminUsecase(t *testing.T) {
+	testRunner.SessionAdminUsecaseRunner(t, NewSessionAdminUseCaseImplementation)
+}
@@ -0,0 +1,11 @@
+package shortcut
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ShortcutUseCaseImplementation) ListShortcuts(ctx context.Context, username string) ([]domain.KeyboardShortcut, error) {
+	return u.resolveShortcuts(ctx, username)
+}
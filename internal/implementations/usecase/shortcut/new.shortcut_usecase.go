@@ -0,0 +1,22 @@
+package shortcut
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type ShortcutUseCaseImplementation struct {
+	preferenceRepo repository.PreferenceRepository
+	// configRepo holds the actions an admin disabled for everyone
+	configRepo repository.ConfigRepository
+}
+
+func NewShortcutUseCaseImplementation(
+	preferenceRepo repository.PreferenceRepository,
+	configRepo repository.ConfigRepository,
+) usecase.ShortcutUseCase {
+	return &ShortcutUseCaseImplementation{
+		preferenceRepo: preferenceRepo,
+		configRepo:     configRepo,
+	}
+}
@@ -0,0 +1,100 @@
+package shortcut
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// shortcutModifiers are the modifiers a combination may hold, in the order they are written
+var shortcutModifiers = []string{"Ctrl", "Alt", "Shift", "Meta"}
+
+// modifierAliases maps the spellings browsers and users use onto shortcutModifiers
+var modifierAliases = map[string]string{
+	"ctrl":    "Ctrl",
+	"control": "Ctrl",
+	"alt":     "Alt",
+	"option":  "Alt",
+	"shift":   "Shift",
+	"meta":    "Meta",
+	"cmd":     "Meta",
+	"command": "Meta",
+}
+
+// namedKeys are the non-character keys a combination may end with, keyed by their lowercase spelling
+var namedKeys = map[string]string{
+	"enter":      "Enter",
+	"escape":     "Escape",
+	"esc":        "Escape",
+	"tab":        "Tab",
+	"space":      "Space",
+	"backspace":  "Backspace",
+	"delete":     "Delete",
+	"insert":     "Insert",
+	"home":       "Home",
+	"end":        "End",
+	"pageup":     "PageUp",
+	"pagedown":   "PageDown",
+	"arrowup":    "ArrowUp",
+	"arrowdown":  "ArrowDown",
+	"arrowleft":  "ArrowLeft",
+	"arrowright": "ArrowRight",
+}
+
+func init() {
+	for i := 1; i <= 12; i++ {
+		namedKeys[fmt.Sprintf("f%d", i)] = fmt.Sprintf("F%d", i)
+	}
+}
+
+// normalizeKeys rewrites a combination such as "shift+ctrl+enter" to its canonical form
+// "Ctrl+Shift+Enter", so equal combinations compare equal whatever order they were typed in
+func normalizeKeys(keys string) (string, error) {
+	invalid := domain.ValidationError{Field: "keys", Message: fmt.Sprintf("invalid key combination: %q", keys)}
+
+	parts := strings.Split(strings.TrimSpace(keys), "+")
+	// "Ctrl++" binds the plus key itself
+	if len(parts) > 1 && parts[len(parts)-1] == "" && parts[len(parts)-2] == "" {
+		parts = append(parts[:len(parts)-2], "+")
+	}
+
+	modifiers := make(map[string]bool, len(parts))
+	for _, part := range parts[:len(parts)-1] {
+		modifier, ok := modifierAliases[strings.ToLower(strings.TrimSpace(part))]
+		if !ok || modifiers[modifier] {
+			return "", invalid
+		}
+		modifiers[modifier] = true
+	}
+
+	key := strings.TrimSpace(parts[len(parts)-1])
+	if named, ok := namedKeys[strings.ToLower(key)]; ok {
+		key = named
+	} else if len([]rune(key)) == 1 {
+		key = strings.ToUpper(key)
+		// A bare letter or digit would fire while typing a query, so it needs Ctrl, Alt or Meta
+		if isAlphanumeric(key) && !modifiers["Ctrl"] && !modifiers["Alt"] && !modifiers["Meta"] {
+			return "", domain.ValidationError{Field: "keys", Message: fmt.Sprintf("%q needs Ctrl, Alt or Meta so it does not fire while typing", keys)}
+		}
+	} else {
+		return "", invalid
+	}
+
+	combination := make([]string, 0, len(modifiers)+1)
+	for _, modifier := range shortcutModifiers {
+		if modifiers[modifier] {
+			combination = append(combination, modifier)
+		}
+	}
+	return strings.Join(append(combination, key), "+"), nil
+}
+
+// isAlphanumeric reports whether key is a single ASCII letter or digit
+func isAlphanumeric(key string) bool {
+	if len(key) != 1 {
+		return false
+	}
+	c := key[0]
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
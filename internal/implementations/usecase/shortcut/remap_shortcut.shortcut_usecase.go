@@ -0,0 +1,45 @@
+package shortcut
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ShortcutUseCaseImplementation) RemapShortcut(ctx context.Context, username, action, keys string) (*domain.KeyboardShortcut, error) {
+	shortcuts, err := u.resolveShortcuts(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	index, err := findShortcut(shortcuts, action)
+	if err != nil {
+		return nil, err
+	}
+	if shortcuts[index].Disabled {
+		return nil, domain.ValidationError{Field: "permission", Message: fmt.Sprintf("the %s shortcut is disabled by an administrator", action)}
+	}
+
+	normalized, err := normalizeKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConflict(shortcuts, action, normalized); err != nil {
+		return nil, err
+	}
+
+	// Remapping back to the default drops the override, so later changes to the default apply
+	shortcut := shortcuts[index]
+	if normalized == shortcut.DefaultKeys {
+		err = u.preferenceRepo.DeletePreference(ctx, username, domain.ShortcutPreferencePrefix+action)
+	} else {
+		err = u.preferenceRepo.SetPreference(ctx, username, domain.ShortcutPreferencePrefix+action, normalized)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save shortcut: %w", err)
+	}
+
+	shortcut.Keys = normalized
+	shortcut.Customized = normalized != shortcut.DefaultKeys
+	return &shortcut, nil
+}
@@ -0,0 +1,35 @@
+package shortcut
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *ShortcutUseCaseImplementation) ResetShortcut(ctx context.Context, username, action string) (*domain.KeyboardShortcut, error) {
+	shortcuts, err := u.resolveShortcuts(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	index, err := findShortcut(shortcuts, action)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcut := shortcuts[index]
+	if !shortcut.Disabled {
+		if err := checkConflict(shortcuts, action, shortcut.DefaultKeys); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.preferenceRepo.DeletePreference(ctx, username, domain.ShortcutPreferencePrefix+action); err != nil {
+		return nil, fmt.Errorf("failed to reset shortcut: %w", err)
+	}
+
+	shortcut.Customized = false
+	if !shortcut.Disabled {
+		shortcut.Keys = shortcut.DefaultKeys
+	}
+	return &shortcut, nil
+}
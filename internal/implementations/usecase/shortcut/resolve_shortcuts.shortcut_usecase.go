@@ -0,0 +1,66 @@
+package shortcut
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// resolveShortcuts applies the user's remappings and the admin's disabled actions to DefaultShortcuts
+func (u *ShortcutUseCaseImplementation) resolveShortcuts(ctx context.Context, username string) ([]domain.KeyboardShortcut, error) {
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load disabled shortcuts: %w", err)
+	}
+	preferences, err := u.preferenceRepo.GetPreferences(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load shortcut preferences: %w", err)
+	}
+
+	disabled := make(map[string]bool, len(config.DisabledShortcuts))
+	for _, action := range config.DisabledShortcuts {
+		disabled[action] = true
+	}
+
+	shortcuts := make([]domain.KeyboardShortcut, 0, len(domain.DefaultShortcuts))
+	for _, shortcut := range domain.DefaultShortcuts {
+		shortcut.DefaultKeys = shortcut.Keys
+		// A remapping that no longer parses, say one stored by an older build, falls back to the default
+		if keys, ok := preferences[domain.ShortcutPreferencePrefix+shortcut.Action]; ok {
+			if normalized, err := normalizeKeys(keys); err == nil {
+				shortcut.Keys = normalized
+				shortcut.Customized = normalized != shortcut.DefaultKeys
+			}
+		}
+		if disabled[shortcut.Action] {
+			shortcut.Keys = ""
+			shortcut.Disabled = true
+		}
+		shortcuts = append(shortcuts, shortcut)
+	}
+	return shortcuts, nil
+}
+
+// findShortcut returns the index of action in shortcuts
+func findShortcut(shortcuts []domain.KeyboardShortcut, action string) (int, error) {
+	for i, shortcut := range shortcuts {
+		if shortcut.Action == action {
+			return i, nil
+		}
+	}
+	return 0, domain.ValidationError{Field: "action", Message: fmt.Sprintf("unknown shortcut action: %s", action)}
+}
+
+// checkConflict refuses keys when another of the user's shortcuts is bound to them
+func checkConflict(shortcuts []domain.KeyboardShortcut, action, keys string) error {
+	for _, shortcut := range shortcuts {
+		if shortcut.Action != action && shortcut.Keys == keys {
+			return domain.ValidationError{
+				Field:   "keys",
+				Message: fmt.Sprintf("%s is already bound to %s; remap that shortcut first", keys, shortcut.Action),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package shortcut
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestShortcutUsecase(t *testing.T) {
+	testRunner.ShortcutUsecaseRunner(t, NewShortcutUseCaseImplementation)
+}
@@ -0,0 +1,22 @@
+package table_view
+
+import (
+	"context"
+	"slices"
+)
+
+func (u *TableViewUseCaseImplementation) DeleteTableView(ctx context.Context, username, database, schema, table, name string) error {
+	if err := u.checkTableAccess(ctx, username, database, schema, table); err != nil {
+		return err
+	}
+	views, err := u.loadTableViews(ctx, username, database, schema, table)
+	if err != nil {
+		return err
+	}
+
+	index, err := findTableView(views, name)
+	if err != nil {
+		return err
+	}
+	return u.storeTableViews(ctx, username, database, schema, table, slices.Delete(views, index, index+1))
+}
@@ -0,0 +1,20 @@
+package table_view
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TableViewUseCaseImplementation) GetDefaultTableView(ctx context.Context, username, database, schema, table string) (*domain.SavedTableView, error) {
+	views, err := u.ListTableViews(ctx, username, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	for i := range views {
+		if views[i].IsDefault {
+			return &views[i], nil
+		}
+	}
+	return nil, nil
+}
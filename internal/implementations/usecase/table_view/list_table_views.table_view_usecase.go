@@ -0,0 +1,14 @@
+package table_view
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TableViewUseCaseImplementation) ListTableViews(ctx context.Context, username, database, schema, table string) ([]domain.SavedTableView, error) {
+	if err := u.checkTableAccess(ctx, username, database, schema, table); err != nil {
+		return nil, err
+	}
+	return u.loadTableViews(ctx, username, database, schema, table)
+}
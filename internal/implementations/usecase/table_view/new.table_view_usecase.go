@@ -0,0 +1,22 @@
+package table_view
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+)
+
+type TableViewUseCaseImplementation struct {
+	preferenceRepo repository.PreferenceRepository
+	// rbacRepo refuses views of tables the user cannot read
+	rbacRepo repository.RBACRepository
+}
+
+func NewTableViewUseCaseImplementation(
+	preferenceRepo repository.PreferenceRepository,
+	rbacRepo repository.RBACRepository,
+) usecase.TableViewUseCase {
+	return &TableViewUseCaseImplementation{
+		preferenceRepo: preferenceRepo,
+		rbacRepo:       rbacRepo,
+	}
+}
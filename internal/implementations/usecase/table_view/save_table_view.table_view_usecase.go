@@ -0,0 +1,55 @@
+package table_view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TableViewUseCaseImplementation) SaveTableView(ctx context.Context, username string, view domain.SavedTableView) (*domain.SavedTableView, error) {
+	view.Name = strings.TrimSpace(view.Name)
+	if view.Name == "" {
+		return nil, domain.ValidationError{Field: "name", Message: "view name is required"}
+	}
+	if utf8.RuneCountInString(view.Name) > domain.TableViewNameMaxLength {
+		return nil, domain.ValidationError{Field: "name", Message: fmt.Sprintf("view name is longer than %d characters", domain.TableViewNameMaxLength)}
+	}
+	switch strings.ToUpper(view.OrderDir) {
+	case "":
+	case "ASC", "DESC":
+		view.OrderDir = strings.ToUpper(view.OrderDir)
+	default:
+		return nil, domain.ValidationError{Field: "order_dir", Message: "sort direction must be ASC or DESC"}
+	}
+	if view.OrderBy == "" {
+		view.OrderDir = ""
+	}
+
+	if err := u.checkTableAccess(ctx, username, view.Database, view.Schema, view.Table); err != nil {
+		return nil, err
+	}
+	views, err := u.loadTableViews(ctx, username, view.Database, view.Schema, view.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one view of a table is its default
+	if view.IsDefault {
+		for i := range views {
+			views[i].IsDefault = false
+		}
+	}
+	if index, err := findTableView(views, view.Name); err == nil {
+		views[index] = view
+	} else {
+		views = append(views, view)
+	}
+
+	if err := u.storeTableViews(ctx, username, view.Database, view.Schema, view.Table, views); err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
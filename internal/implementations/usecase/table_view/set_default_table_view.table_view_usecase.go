@@ -0,0 +1,23 @@
+package table_view
+
+import "context"
+
+func (u *TableViewUseCaseImplementation) SetDefaultTableView(ctx context.Context, username, database, schema, table, name string) error {
+	if err := u.checkTableAccess(ctx, username, database, schema, table); err != nil {
+		return err
+	}
+	views, err := u.loadTableViews(ctx, username, database, schema, table)
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		if _, err := findTableView(views, name); err != nil {
+			return err
+		}
+	}
+	for i := range views {
+		views[i].IsDefault = views[i].Name == name
+	}
+	return u.storeTableViews(ctx, username, database, schema, table, views)
+}
@@ -0,0 +1,116 @@
+package table_view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// storedTableView is the JSON form a saved view is kept in, all of a table's views under one preference
+type storedTableView struct {
+	Name          string   `json:"name"`
+	WhereClause   string   `json:"where,omitempty"`
+	OrderBy       string   `json:"order_by,omitempty"`
+	OrderDir      string   `json:"order_dir,omitempty"`
+	HiddenColumns []string `json:"hidden_columns,omitempty"`
+	IsDefault     bool     `json:"default,omitempty"`
+}
+
+// tableViewKey is the preference key holding the user's views of a table
+func tableViewKey(database, schema, table string) string {
+	return domain.TableViewPreferencePrefix + url.PathEscape(database) + "/" + url.PathEscape(schema) + "/" + url.PathEscape(table)
+}
+
+// loadTableViews reads the user's views of a table, ordered by name
+func (u *TableViewUseCaseImplementation) loadTableViews(ctx context.Context, username, database, schema, table string) ([]domain.SavedTableView, error) {
+	preferences, err := u.preferenceRepo.GetPreferences(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load saved views: %w", err)
+	}
+	value, ok := preferences[tableViewKey(database, schema, table)]
+	if !ok {
+		return []domain.SavedTableView{}, nil
+	}
+
+	var stored []storedTableView
+	if err := json.Unmarshal([]byte(value), &stored); err != nil {
+		return nil, fmt.Errorf("failed to read saved views: %w", err)
+	}
+
+	views := make([]domain.SavedTableView, len(stored))
+	for i, view := range stored {
+		views[i] = domain.SavedTableView{
+			Name:          view.Name,
+			Database:      database,
+			Schema:        schema,
+			Table:         table,
+			WhereClause:   view.WhereClause,
+			OrderBy:       view.OrderBy,
+			OrderDir:      view.OrderDir,
+			HiddenColumns: view.HiddenColumns,
+			IsDefault:     view.IsDefault,
+		}
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i].Name < views[j].Name })
+	return views, nil
+}
+
+// storeTableViews replaces the user's views of a table; with none left the preference is removed
+func (u *TableViewUseCaseImplementation) storeTableViews(ctx context.Context, username, database, schema, table string, views []domain.SavedTableView) error {
+	key := tableViewKey(database, schema, table)
+	if len(views) == 0 {
+		if err := u.preferenceRepo.DeletePreference(ctx, username, key); err != nil {
+			return fmt.Errorf("failed to save views: %w", err)
+		}
+		return nil
+	}
+
+	stored := make([]storedTableView, len(views))
+	for i, view := range views {
+		stored[i] = storedTableView{
+			Name:          view.Name,
+			WhereClause:   view.WhereClause,
+			OrderBy:       view.OrderBy,
+			OrderDir:      view.OrderDir,
+			HiddenColumns: view.HiddenColumns,
+			IsDefault:     view.IsDefault,
+		}
+	}
+	value, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to encode views: %w", err)
+	}
+	if err := u.preferenceRepo.SetPreference(ctx, username, key, string(value)); err != nil {
+		return fmt.Errorf("failed to save views: %w", err)
+	}
+	return nil
+}
+
+// checkTableAccess refuses views of a table the user cannot read
+func (u *TableViewUseCaseImplementation) checkTableAccess(ctx context.Context, username, database, schema, table string) error {
+	if database == "" || schema == "" || table == "" {
+		return domain.ValidationError{Field: "table", Message: "database, schema and table are required"}
+	}
+	allowed, err := u.rbacRepo.HasSelectPermission(ctx, username, database, schema, table)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission on this table"}
+	}
+	return nil
+}
+
+// findTableView returns the index of the named view, or ErrTableViewNotFound
+func findTableView(views []domain.SavedTableView, name string) (int, error) {
+	for i, view := range views {
+		if view.Name == name {
+			return i, nil
+		}
+	}
+	return 0, domain.ErrTableViewNotFound
+}
@@ -0,0 +1,11 @@
+package table_view
+
+import (
+	"testing"
+
+	testRunner "github.com/kamil5b/lumen-pg/internal/testrunners/usecase"
+)
+
+func TestTableViewUsecase(t *testing.T) {
+	testRunner.TableViewUsecaseRunner(t, NewTableViewUseCaseImplementation)
+}
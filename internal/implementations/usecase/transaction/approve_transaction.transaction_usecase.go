@@ -0,0 +1,57 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) ApproveTransaction(ctx context.Context, approver, username string) error {
+	// Only configured approvers may release held commits, and never their own
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !containsUsername(config.Approvers, approver) {
+		return domain.ErrNotApprover
+	}
+	if approver == username {
+		return domain.ErrSelfApproval
+	}
+
+	// Get the held transaction of the requesting user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if txn == nil {
+		return domain.ErrNoActiveTransaction
+	}
+
+	if txn.Status != domain.TransactionStatusPendingApproval {
+		return domain.ErrApprovalNotPending
+	}
+
+	// Releasing the commit writes it, so maintenance mode applies to the approver
+	if err := u.checkMaintenance(ctx, config, approver, txn.StartedAt); err != nil {
+		return err
+	}
+
+	// Re-check the author's permissions since they may have changed while the commit was held
+	changes, err := u.loadCommitChanges(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	return u.finishCommit(ctx, config, txn, changes, txn.CommitReason, approver)
+}
+
+func containsUsername(usernames []string, username string) bool {
+	for _, name := range usernames {
+		if name == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) CellValueOptions(ctx context.Context, username, database, schema, table, column string) (*domain.CellValueOptions, error) {
+	// Get the active transaction for the user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn == nil {
+		return nil, domain.ErrNoActiveTransaction
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+
+	found := false
+	for _, col := range tableMetadata.Columns {
+		if col.Name != column {
+			continue
+		}
+		if col.EnumValues != nil {
+			return &domain.CellValueOptions{Column: column, AllowedValues: col.EnumValues, Source: domain.CellValueSourceEnum}, nil
+		}
+		found = true
+	}
+	if !found {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("column %s does not exist", column)}
+	}
+
+	constraints, err := u.databaseRepo.GetCheckConstraints(ctx, database, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get check constraints: %w", err)
+	}
+
+	return &domain.CellValueOptions{Column: column, AllowedValues: checkListValues(constraints, column), Source: domain.CellValueSourceCheck}, nil
+}
+
+// checkListValues returns the values the IN lists of constraints allow for column, keeping only those
+// every list allows when there are several; nil when no constraint restricts column to a list
+func checkListValues(constraints []domain.CheckConstraint, column string) []string {
+	var values []string
+	restricted := false
+	for _, constraint := range constraints {
+		for _, condition := range parseCheckDefinition(constraint.Definition) {
+			if condition.column != column || condition.op != "ANY" {
+				continue
+			}
+			if !restricted {
+				for _, literal := range condition.literals {
+					values = append(values, literal.text)
+				}
+				restricted = true
+				continue
+			}
+			kept := values[:0]
+			for _, value := range values {
+				if satisfied, known := condition.evaluate(value); satisfied || !known {
+					kept = append(kept, value)
+				}
+			}
+			values = kept
+		}
+	}
+	return values
+}
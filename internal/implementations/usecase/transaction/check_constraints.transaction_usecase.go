@@ -0,0 +1,291 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// checkCondition is one comparison of a CHECK constraint between a column and literals. Op is a
+// comparison operator, or "ANY" for an IN list.
+type checkCondition struct {
+	column   string
+	op       string
+	literals []checkLiteral
+}
+
+type checkLiteral struct {
+	text   string
+	quoted bool
+}
+
+// validateCheckConstraints evaluates the table's simple CHECK constraints against a value about to be
+// buffered for column, so range and IN-list violations surface at edit time instead of at commit. Only
+// top-level AND-ed comparisons of the column with literals are evaluated; anything else, including any
+// comparison this code cannot decide, is left for PostgreSQL to enforce at commit.
+func (u *TransactionUseCaseImplementation) validateCheckConstraints(ctx context.Context, database, schema, table, column string, value interface{}) error {
+	// A CHECK expression on NULL is unknown, which PostgreSQL treats as passing
+	if value == nil {
+		return nil
+	}
+
+	constraints, err := u.databaseRepo.GetCheckConstraints(ctx, database, schema, table)
+	if err != nil {
+		return fmt.Errorf("failed to get check constraints: %w", err)
+	}
+
+	text := fmt.Sprint(value)
+	for _, constraint := range constraints {
+		for _, condition := range parseCheckDefinition(constraint.Definition) {
+			if condition.column != column {
+				continue
+			}
+			if satisfied, known := condition.evaluate(text); known && !satisfied {
+				return domain.ValidationError{
+					Field:   column,
+					Message: fmt.Sprintf("value %s violates check constraint %s: %s", text, constraint.Name, constraint.Definition),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseCheckDefinition extracts the simple conditions of a pg_get_constraintdef definition
+func parseCheckDefinition(definition string) []checkCondition {
+	body := strings.TrimSpace(definition)
+	body = strings.TrimSuffix(body, " NOT VALID")
+	body = strings.TrimSuffix(body, " NO INHERIT")
+	if !strings.HasPrefix(body, "CHECK ") {
+		return nil
+	}
+	body = stripParens(strings.TrimPrefix(body, "CHECK "))
+
+	var conditions []checkCondition
+	for _, conjunct := range splitTopLevel(body, " AND ") {
+		if condition, ok := parseCheckCondition(stripParens(conjunct)); ok {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// comparisonOperators are tried longest first so ">=" is not read as ">"
+var comparisonOperators = []string{">=", "<=", "<>", "=", ">", "<"}
+
+// flippedOperators turn "literal op column" into "column op literal"
+var flippedOperators = map[string]string{">=": "<=", "<=": ">=", ">": "<", "<": ">", "=": "=", "<>": "<>"}
+
+func parseCheckCondition(expr string) (checkCondition, bool) {
+	if parts := splitTopLevel(expr, " = ANY "); len(parts) == 2 {
+		column, ok := parseCheckColumn(parts[0])
+		if !ok {
+			return checkCondition{}, false
+		}
+		array := stripCasts(parts[1])
+		if !strings.HasPrefix(array, "ARRAY[") || !strings.HasSuffix(array, "]") {
+			return checkCondition{}, false
+		}
+		condition := checkCondition{column: column, op: "ANY"}
+		for _, element := range splitTopLevel(array[len("ARRAY["):len(array)-1], ", ") {
+			literal, ok := parseCheckLiteral(element)
+			if !ok {
+				return checkCondition{}, false
+			}
+			condition.literals = append(condition.literals, literal)
+		}
+		return condition, true
+	}
+
+	for _, op := range comparisonOperators {
+		parts := splitTopLevel(expr, " "+op+" ")
+		if len(parts) != 2 {
+			continue
+		}
+		if column, ok := parseCheckColumn(parts[0]); ok {
+			if literal, ok := parseCheckLiteral(parts[1]); ok {
+				return checkCondition{column: column, op: op, literals: []checkLiteral{literal}}, true
+			}
+		}
+		if column, ok := parseCheckColumn(parts[1]); ok {
+			if literal, ok := parseCheckLiteral(parts[0]); ok {
+				return checkCondition{column: column, op: flippedOperators[op], literals: []checkLiteral{literal}}, true
+			}
+		}
+		return checkCondition{}, false
+	}
+
+	return checkCondition{}, false
+}
+
+// evaluate reports whether value satisfies the condition; known is false when it cannot be decided here
+func (c checkCondition) evaluate(value string) (satisfied, known bool) {
+	if c.op == "ANY" {
+		for _, literal := range c.literals {
+			equal, known := literal.equals(value)
+			if !known {
+				return false, false
+			}
+			if equal {
+				return true, true
+			}
+		}
+		return false, true
+	}
+
+	literal := c.literals[0]
+	switch c.op {
+	case "=", "<>":
+		equal, known := literal.equals(value)
+		return equal == (c.op == "="), known
+	}
+
+	// Ordering is only decided for numbers; text and date ordering depend on collation and types
+	left, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false, false
+	}
+	right, err := strconv.ParseFloat(literal.text, 64)
+	if err != nil {
+		return false, false
+	}
+	switch c.op {
+	case ">=":
+		return left >= right, true
+	case "<=":
+		return left <= right, true
+	case ">":
+		return left > right, true
+	default:
+		return left < right, true
+	}
+}
+
+func (l checkLiteral) equals(value string) (equal, known bool) {
+	if l.quoted {
+		return l.text == value, true
+	}
+	left, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false, false
+	}
+	right, err := strconv.ParseFloat(l.text, 64)
+	if err != nil {
+		return false, false
+	}
+	return left == right, true
+}
+
+// parseCheckColumn reads a possibly cast or quoted column reference such as (status)::text
+func parseCheckColumn(expr string) (string, bool) {
+	expr = stripCasts(expr)
+	if len(expr) >= 2 && expr[0] == '"' && expr[len(expr)-1] == '"' {
+		return strings.ReplaceAll(expr[1:len(expr)-1], `""`, `"`), true
+	}
+	if expr == "" || (expr[0] >= '0' && expr[0] <= '9') {
+		return "", false
+	}
+	for _, r := range expr {
+		if !(r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')) {
+			return "", false
+		}
+	}
+	return expr, true
+}
+
+// parseCheckLiteral reads a possibly cast string or numeric literal such as 'open'::text or (0)::numeric
+func parseCheckLiteral(expr string) (checkLiteral, bool) {
+	expr = stripCasts(expr)
+	if len(expr) >= 2 && expr[0] == '\'' && expr[len(expr)-1] == '\'' {
+		return checkLiteral{text: strings.ReplaceAll(expr[1:len(expr)-1], "''", "'"), quoted: true}, true
+	}
+	if _, err := strconv.ParseFloat(expr, 64); err == nil {
+		return checkLiteral{text: expr}, true
+	}
+	return checkLiteral{}, false
+}
+
+// stripCasts removes enclosing parentheses and trailing ::type casts until neither remains
+func stripCasts(expr string) string {
+	for {
+		expr = stripParens(strings.TrimSpace(expr))
+		positions := topLevelPositions(expr, "::")
+		if len(positions) == 0 {
+			return expr
+		}
+		expr = expr[:positions[len(positions)-1]]
+	}
+}
+
+// stripParens removes parentheses that enclose the whole expression
+func stripParens(expr string) string {
+	for len(expr) >= 2 && expr[0] == '(' && matchingParen(expr) == len(expr)-1 {
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	return expr
+}
+
+// matchingParen returns the index of the parenthesis closing expr[0], or -1
+func matchingParen(expr string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits expr on sep where it appears outside quotes, parentheses and brackets
+func splitTopLevel(expr, sep string) []string {
+	var parts []string
+	start := 0
+	for _, position := range topLevelPositions(expr, sep) {
+		parts = append(parts, expr[start:position])
+		start = position + len(sep)
+	}
+	return append(parts, expr[start:])
+}
+
+func topLevelPositions(expr, sep string) []int {
+	var positions []int
+	depth := 0
+	var quote byte
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case depth == 0 && strings.HasPrefix(expr[i:], sep):
+			positions = append(positions, i)
+			i += len(sep) - 1
+		}
+	}
+	return positions
+}
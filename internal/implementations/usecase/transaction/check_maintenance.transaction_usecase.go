@@ -0,0 +1,16 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// checkMaintenance refuses a write while maintenance mode is on, following the rule of
+// AppConfig.MaintenanceRefusal
+func (u *TransactionUseCaseImplementation) checkMaintenance(ctx context.Context, config *domain.AppConfig, username string, startedAt time.Time) error {
+	return config.MaintenanceRefusal(startedAt, func() (bool, error) {
+		return u.rbacRepo.IsSuperuser(ctx, username)
+	})
+}
@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// writeBlockingLockModes are the lock modes that conflict with the ROW EXCLUSIVE lock a commit's
+// INSERT, UPDATE and DELETE statements take
+var writeBlockingLockModes = map[string]bool{
+	"ShareLock":             true,
+	"ShareRowExclusiveLock": true,
+	"ExclusiveLock":         true,
+	"AccessExclusiveLock":   true,
+}
+
+func (u *TransactionUseCaseImplementation) CheckTableLocks(ctx context.Context, username, database, schema, table string) ([]domain.TableLock, error) {
+	locks, err := u.databaseRepo.GetTableLocks(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	longHeld := time.Now().Add(-time.Duration(domain.LongHeldLockSeconds) * time.Second)
+	var conflicting []domain.TableLock
+	for _, lock := range locks {
+		if writeBlockingLockModes[lock.Mode] || (!lock.HeldSince.IsZero() && lock.HeldSince.Before(longHeld)) {
+			conflicting = append(conflicting, lock)
+		}
+	}
+	if len(conflicting) == 0 {
+		return nil, nil
+	}
+
+	// Only superusers see what other users' sessions are running and where from
+	isSuperuser, err := u.rbacRepo.IsSuperuser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if !isSuperuser {
+		for i := range conflicting {
+			if conflicting[i].Username == username {
+				continue
+			}
+			conflicting[i].State = ""
+			conflicting[i].Query = ""
+			conflicting[i].ApplicationName = ""
+			conflicting[i].ClientAddr = ""
+		}
+	}
+
+	return conflicting, nil
+}
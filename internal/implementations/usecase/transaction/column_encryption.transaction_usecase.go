@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// columnEncrypter wraps values written to a table's configured encrypted columns so the repository
+// stores them via pgp_sym_encrypt, which reads decrypt with pgp_sym_decrypt
+type columnEncrypter struct {
+	columns map[string]bool
+	key     string
+}
+
+func newColumnEncrypter(config *domain.AppConfig, schema, table string) *columnEncrypter {
+	encrypter := &columnEncrypter{columns: make(map[string]bool), key: config.ColumnEncryptionKey}
+	for _, column := range config.EncryptedColumnsOf(schema, table) {
+		encrypter.columns[column] = true
+	}
+	return encrypter
+}
+
+// wrap returns value as it is written to column; a NULL stays NULL
+func (e *columnEncrypter) wrap(column string, value interface{}) (interface{}, error) {
+	if !e.columns[column] || value == nil {
+		return value, nil
+	}
+	// Plaintext in an encrypted column would fail every later decrypting read of the table
+	if e.key == "" {
+		return nil, domain.ValidationError{Field: column, Message: "column " + column + " is encrypted and no column encryption key is configured"}
+	}
+	return domain.EncryptedValue{Value: value, Key: e.key}, nil
+}
+
+// insert returns a copy of the insert with its encrypted columns wrapped, leaving the buffered row,
+// which the audit log records, free of the key
+func (e *columnEncrypter) insert(insert domain.RowInsert) (domain.RowInsert, error) {
+	if len(e.columns) == 0 {
+		return insert, nil
+	}
+	values := make(map[string]interface{}, len(insert.Values))
+	for column, value := range insert.Values {
+		wrapped, err := e.wrap(column, value)
+		if err != nil {
+			return insert, err
+		}
+		values[column] = wrapped
+	}
+	insert.Values = values
+	return insert, nil
+}
+
+// update returns a copy of the bulk update with its value wrapped when it sets an encrypted column
+func (e *columnEncrypter) update(update domain.BulkUpdate) (domain.BulkUpdate, error) {
+	value, err := e.wrap(update.Column, update.Value)
+	if err != nil {
+		return update, err
+	}
+	update.Value = value
+	return update, nil
+}
@@ -2,11 +2,16 @@ package transaction
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
-func (u *TransactionUseCaseImplementation) CommitTransaction(ctx context.Context, username string) error {
+func (u *TransactionUseCaseImplementation) CommitTransaction(ctx context.Context, username, reason string) error {
 	// Get the active transaction for the user
 	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
 	if err != nil {
@@ -17,30 +22,100 @@ func (u *TransactionUseCaseImplementation) CommitTransaction(ctx context.Context
 		return domain.ErrNoActiveTransaction
 	}
 
+	// A commit already waiting for approval stays held until a second user approves it
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return domain.ErrCommitPendingApproval
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Maintenance mode leaves transactions started before it only the grace period to commit
+	if err := u.checkMaintenance(ctx, config, username, txn.StartedAt); err != nil {
+		return err
+	}
+
+	// Require a change reason when configured
+	reason = strings.TrimSpace(reason)
+	if config.RequireChangeReason && reason == "" {
+		return domain.ErrChangeReasonRequired
+	}
+
+	changes, err := u.loadCommitChanges(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	// Hold commits on sensitive tables until a second user approves them
+	if !changes.empty() && isSensitiveTable(config, txn.Schema, txn.Table) {
+		txn.Status = domain.TransactionStatusPendingApproval
+		txn.CommitReason = reason
+		txn.ExpiresAt = time.Now().Add(time.Duration(domain.ApprovalHoldDuration) * time.Second)
+		if err := u.transactionRepo.UpdateTransaction(ctx, txn); err != nil {
+			return err
+		}
+		return domain.ErrCommitPendingApproval
+	}
+
+	return u.finishCommit(ctx, config, txn, changes, reason, "")
+}
+
+// commitChanges holds the buffered operations of a transaction
+type commitChanges struct {
+	edits       map[int]domain.RowEdit
+	inserts     []domain.RowInsert
+	deletes     []int
+	keyDeletes  []map[string]interface{}
+	bulkUpdates []domain.BulkUpdate
+}
+
+func (c *commitChanges) empty() bool {
+	return len(c.edits) == 0 && len(c.inserts) == 0 && len(c.deletes) == 0 && len(c.keyDeletes) == 0 && len(c.bulkUpdates) == 0
+}
+
+// writes reports whether any buffered operation is written to the table at commit
+func (c *commitChanges) writes() bool {
+	return len(c.bulkUpdates) > 0 || len(c.inserts) > 0 || len(c.keyDeletes) > 0
+}
+
+// loadCommitChanges fetches the buffered operations and checks the user may apply them
+func (u *TransactionUseCaseImplementation) loadCommitChanges(ctx context.Context, username string) (*commitChanges, error) {
 	// Get all buffered operations
 	edits, err := u.transactionRepo.GetRowEdits(ctx, username)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	inserts, err := u.transactionRepo.GetRowInserts(ctx, username)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	deletes, err := u.transactionRepo.GetRowDeletes(ctx, username)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	keyDeletes, err := u.transactionRepo.GetRowKeyDeletes(ctx, username)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check permissions for updates
-	if len(edits) > 0 {
+	bulkUpdates, err := u.transactionRepo.GetBulkUpdates(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check permissions for updates, including inserts that overwrite rows on conflict
+	if len(edits) > 0 || len(bulkUpdates) > 0 || hasConflictUpdates(inserts) {
 		hasPermission, err := u.rbacRepo.HasUpdatePermission(ctx, username, "", "", "")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !hasPermission {
-			return domain.ErrInsufficientPermissions
+			return nil, domain.ErrInsufficientPermissions
 		}
 	}
 
@@ -48,24 +123,181 @@ func (u *TransactionUseCaseImplementation) CommitTransaction(ctx context.Context
 	if len(inserts) > 0 {
 		hasPermission, err := u.rbacRepo.HasInsertPermission(ctx, username, "", "", "")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !hasPermission {
-			return domain.ErrInsufficientPermissions
+			return nil, domain.ErrInsufficientPermissions
 		}
 	}
 
 	// Check permissions for deletes
-	if len(deletes) > 0 {
+	if len(deletes) > 0 || len(keyDeletes) > 0 {
 		hasPermission, err := u.rbacRepo.HasDeletePermission(ctx, username, "", "", "")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !hasPermission {
-			return domain.ErrInsufficientPermissions
+			return nil, domain.ErrInsufficientPermissions
+		}
+	}
+
+	return &commitChanges{edits: edits, inserts: inserts, deletes: deletes, keyDeletes: keyDeletes, bulkUpdates: bulkUpdates}, nil
+}
+
+// finishCommit applies the changes, ends the transaction and records the commit in the audit log,
+// keeping the rows its DELETEs removed for the retention window so they can be restored
+func (u *TransactionUseCaseImplementation) finishCommit(ctx context.Context, config *domain.AppConfig, txn *domain.TransactionState, changes *commitChanges, reason, approvedBy string) error {
+	// The buffered changes are applied in one database transaction, so a failure leaves none of them
+	// behind and a retry starts from the original rows
+	var deleted []map[string]interface{}
+	if changes.writes() {
+		tx, err := u.databaseRepo.BeginTransaction(ctx)
+		if err != nil {
+			return err
 		}
+		deleted, err = u.applyChanges(ctx, tx, txn, changes, newColumnEncrypter(config, txn.Schema, txn.Table))
+		if err != nil {
+			_ = u.databaseRepo.RollbackTransaction(ctx, tx)
+			return err
+		}
+		if err := u.databaseRepo.CommitTransaction(ctx, tx); err != nil {
+			return fmt.Errorf("failed to commit changes: %w", err)
+		}
+	}
+
+	// The committed changes leave the buffer with the transaction, so a later commit cannot replay
+	// them and the user can start a new one
+	if err := u.transactionRepo.DeleteTransaction(ctx, txn.ID); err != nil {
+		return err
+	}
+
+	if changes.empty() {
+		return nil
+	}
+
+	// Record the commit so data fixes stay explainable later
+	entry := &domain.AuditEntry{
+		Username:     txn.Username,
+		Action:       domain.AuditActionCommit,
+		Database:     txn.Database,
+		Schema:       txn.Schema,
+		Table:        txn.Table,
+		Reason:       reason,
+		AffectedRows: affectedRows(changes),
+		ApprovedBy:   approvedBy,
+	}
+	if err := u.auditRepo.RecordEntry(ctx, entry); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	retention := config.DeletedRowRetention
+	if retention <= 0 {
+		retention = domain.DefaultDeletedRowRetention
+	}
+	return u.auditRepo.RecordDeletedRows(ctx, &domain.DeletedRows{
+		EntryID:   entry.ID,
+		Database:  txn.Database,
+		Schema:    txn.Schema,
+		Table:     txn.Table,
+		Rows:      deleted,
+		ExpiresAt: time.Now().Add(retention),
+	})
+}
+
+// applyChanges writes the buffered changes inside tx, encrypting values of encrypted columns, returning the deleted rows as the DELETEs
+// removed them
+func (u *TransactionUseCaseImplementation) applyChanges(ctx context.Context, tx *sql.Tx, txn *domain.TransactionState, changes *commitChanges, encrypter *columnEncrypter) ([]map[string]interface{}, error) {
+	// Apply filter-based updates, each as one UPDATE guarded by its confirmed row count
+	for _, update := range changes.bulkUpdates {
+		update, err := encrypter.update(update)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.databaseRepo.UpdateRowsByFilter(ctx, tx, update); err != nil {
+			return nil, err
+		}
+	}
+
+	// Insert new rows, each with the ON CONFLICT behavior chosen when it was buffered
+	for _, insert := range changes.inserts {
+		insert, err := encrypter.insert(insert)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.databaseRepo.UpsertRow(ctx, tx, txn.Database, txn.Schema, txn.Table, insert); err != nil {
+			return nil, err
+		}
+	}
+
+	// Delete the rows marked by primary key, each by its own parameterized DELETE
+	if len(changes.keyDeletes) == 0 {
+		return nil, nil
 	}
+	return u.databaseRepo.DeleteRowsByKey(ctx, tx, txn.Database, txn.Schema, txn.Table, changes.keyDeletes)
+}
+
+// isSensitiveTable reports whether commits on the table need a second user's approval
+func isSensitiveTable(config *domain.AppConfig, schema, table string) bool {
+	for _, name := range config.SensitiveTables {
+		if name == schema+"."+table || (schema == "" && name == table) {
+			return true
+		}
+	}
+	return false
+}
 
-	// Update the transaction to mark it as committed
-	return u.transactionRepo.UpdateTransaction(ctx, txn)
+// affectedRows lists the rows touched by a commit in a stable order
+func affectedRows(changes *commitChanges) []map[string]interface{} {
+	edits := make([]domain.RowEdit, 0, len(changes.edits))
+	for _, edit := range changes.edits {
+		edits = append(edits, edit)
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].RowIndex < edits[j].RowIndex })
+
+	rows := make([]map[string]interface{}, 0, len(changes.edits)+len(changes.deletes)+len(changes.keyDeletes)+len(changes.bulkUpdates)+len(changes.inserts))
+	for _, edit := range edits {
+		rows = append(rows, map[string]interface{}{
+			"operation": "update",
+			"row_index": edit.RowIndex,
+			"column":    edit.ColumnName,
+			"old_value": edit.OldValue,
+			"new_value": edit.NewValue,
+		})
+	}
+	for _, rowIndex := range changes.deletes {
+		rows = append(rows, map[string]interface{}{"operation": "delete", "row_index": rowIndex})
+	}
+	for _, key := range changes.keyDeletes {
+		rows = append(rows, map[string]interface{}{"operation": "delete", "primary_key": key})
+	}
+	for _, update := range changes.bulkUpdates {
+		rows = append(rows, map[string]interface{}{
+			"operation": "bulk_update",
+			"column":    update.Column,
+			"value":     update.Value,
+			"where":     update.WhereClause,
+			"rows":      update.ExpectedCount,
+		})
+	}
+	for _, insert := range changes.inserts {
+		row := map[string]interface{}{"operation": "insert", "values": insert.Values}
+		if mode := insert.OnConflict.Mode; mode != "" && mode != domain.ConflictModeError {
+			row["on_conflict"] = mode
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// hasConflictUpdates reports whether any insert overwrites an existing row on conflict
+func hasConflictUpdates(inserts []domain.RowInsert) bool {
+	for _, insert := range inserts {
+		if insert.OnConflict.Mode == domain.ConflictModeUpdate {
+			return true
+		}
+	}
+	return false
 }
@@ -17,6 +17,11 @@ func (u *TransactionUseCaseImplementation) DeleteRow(ctx context.Context, userna
 		return domain.ErrNoActiveTransaction
 	}
 
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return domain.ErrCommitPendingApproval
+	}
+
 	// Add the row deletion to the transaction
 	return u.transactionRepo.AddRowDelete(ctx, username, rowIndex)
 }
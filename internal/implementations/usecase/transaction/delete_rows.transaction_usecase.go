@@ -0,0 +1,165 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) DeleteRows(ctx context.Context, username string, params domain.BulkDeleteParams) (*domain.BulkDeleteResult, error) {
+	// Get the active transaction for the user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn == nil {
+		return nil, domain.ErrNoActiveTransaction
+	}
+
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return nil, domain.ErrCommitPendingApproval
+	}
+
+	if params.Database != txn.Database || params.Schema != txn.Schema || params.Table != txn.Table {
+		return nil, domain.ValidationError{Field: "table", Message: "rows can only be deleted from the table of the active transaction"}
+	}
+
+	hasPermission, err := u.rbacRepo.HasDeletePermission(ctx, username, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, domain.ValidationError{Field: "permission", Message: "user does not have DELETE permission on this table"}
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, params.Database, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(tableMetadata.PrimaryKeys) == 0 {
+		return nil, domain.ValidationError{Field: "table", Message: "rows can only be bulk deleted from tables with a primary key"}
+	}
+
+	var keys []map[string]interface{}
+	if params.MatchFilter {
+		keys, err = u.matchingRowKeys(ctx, username, params, tableMetadata.PrimaryKeys)
+	} else {
+		keys, err = selectedRowKeys(params.Keys, tableMetadata.PrimaryKeys)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip rows already marked so the pending count stays exact
+	pending, err := u.transactionRepo.GetRowKeyDeletes(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(pending)+len(keys))
+	for _, key := range pending {
+		seen[rowKeyString(key, tableMetadata.PrimaryKeys)] = true
+	}
+	var added []map[string]interface{}
+	for _, key := range keys {
+		id := rowKeyString(key, tableMetadata.PrimaryKeys)
+		if !seen[id] {
+			seen[id] = true
+			added = append(added, key)
+		}
+	}
+
+	if len(added) > 0 {
+		if err := u.transactionRepo.AddRowKeyDeletes(ctx, username, added); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.BulkDeleteResult{
+		Buffered:       len(added),
+		PendingDeletes: len(pending) + len(added),
+	}, nil
+}
+
+// matchingRowKeys resolves the primary keys of every row matching the filter, refusing filters that
+// match more than BulkDeleteMaxRows rows
+func (u *TransactionUseCaseImplementation) matchingRowKeys(ctx context.Context, username string, params domain.BulkDeleteParams, primaryKeys []string) ([]map[string]interface{}, error) {
+	whereClause := strings.TrimSpace(params.WhereClause)
+	if err := u.validateFilter(ctx, username, whereClause); err != nil {
+		return nil, err
+	}
+
+	count, err := u.databaseRepo.GetRowCount(ctx, params.Database, params.Schema, params.Table, whereClause)
+	if err != nil {
+		return nil, err
+	}
+	if count > domain.BulkDeleteMaxRows {
+		return nil, domain.ValidationError{
+			Field:   "whereClause",
+			Message: fmt.Sprintf("filter matches %d rows; bulk delete is limited to %d rows", count, domain.BulkDeleteMaxRows),
+		}
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	result, err := u.databaseRepo.GetTableData(ctx, domain.TableDataParams{
+		Database:    params.Database,
+		Schema:      params.Schema,
+		Table:       params.Table,
+		WhereClause: whereClause,
+		Limit:       int(count),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]map[string]interface{}, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		key := make(map[string]interface{}, len(primaryKeys))
+		for _, column := range primaryKeys {
+			key[column] = row[column]
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// selectedRowKeys checks that each selected tuple names every primary key column
+func selectedRowKeys(selected []map[string]interface{}, primaryKeys []string) ([]map[string]interface{}, error) {
+	if len(selected) == 0 {
+		return nil, domain.ValidationError{Field: "keys", Message: "no rows selected"}
+	}
+	if len(selected) > domain.BulkDeleteMaxRows {
+		return nil, domain.ValidationError{
+			Field:   "keys",
+			Message: fmt.Sprintf("bulk delete is limited to %d rows", domain.BulkDeleteMaxRows),
+		}
+	}
+
+	keys := make([]map[string]interface{}, 0, len(selected))
+	for _, tuple := range selected {
+		key := make(map[string]interface{}, len(primaryKeys))
+		for _, column := range primaryKeys {
+			value, ok := tuple[column]
+			if !ok || value == nil {
+				return nil, domain.ValidationError{Field: "keys", Message: "every selected row needs a value for primary key column " + column}
+			}
+			key[column] = value
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// rowKeyString identifies a primary key tuple for de-duplication
+func rowKeyString(key map[string]interface{}, primaryKeys []string) string {
+	parts := make([]string, len(primaryKeys))
+	for i, column := range primaryKeys {
+		parts[i] = fmt.Sprintf("%v", key[column])
+	}
+	return strings.Join(parts, "\x00")
+}
@@ -17,6 +17,21 @@ func (u *TransactionUseCaseImplementation) EditCell(ctx context.Context, usernam
 		return domain.ErrNoActiveTransaction
 	}
 
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return domain.ErrCommitPendingApproval
+	}
+
+	// Reject values the column's type cannot convert before they reach the buffer
+	if err := u.validateColumnType(ctx, database, schema, table, columnName, newValue); err != nil {
+		return err
+	}
+
+	// Surface simple CHECK constraint violations now rather than when the transaction commits
+	if err := u.validateCheckConstraints(ctx, database, schema, table, columnName, newValue); err != nil {
+		return err
+	}
+
 	// Create a row edit
 	edit := domain.RowEdit{
 		RowIndex:   rowIndex,
@@ -24,6 +39,12 @@ func (u *TransactionUseCaseImplementation) EditCell(ctx context.Context, usernam
 		NewValue:   newValue,
 	}
 
+	// Successive edits of the same cell keep the earlier values as the cell's timeline
+	if previous, ok := txn.Edits[rowIndex]; ok && previous.ColumnName == columnName {
+		edit.OldValue = previous.OldValue
+		edit.History = append(append([]interface{}{}, previous.History...), previous.NewValue)
+	}
+
 	// Add the edit to the transaction
 	return u.transactionRepo.AddRowEdit(ctx, username, edit)
 }
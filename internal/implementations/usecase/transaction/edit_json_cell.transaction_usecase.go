@@ -0,0 +1,201 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) EditJSONCell(ctx context.Context, username, database, schema, table string, rowIndex int, columnName, currentValue string, ops []domain.JSONPatchOp) (*domain.RowEdit, error) {
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn == nil {
+		return nil, domain.ErrNoActiveTransaction
+	}
+
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return nil, domain.ErrCommitPendingApproval
+	}
+
+	if len(ops) == 0 {
+		return nil, domain.ValidationError{Field: "ops", Message: "no JSON operations given"}
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+	isJSONB := false
+	for _, col := range tableMetadata.Columns {
+		if col.Name == columnName {
+			isJSONB = col.DataType == "jsonb"
+			break
+		}
+	}
+	if !isJSONB {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("column %s is not a jsonb column", columnName)}
+	}
+
+	// Operations build on the cell's buffered value; patches only describe the change while every
+	// earlier edit of the cell was a patch too
+	edit := domain.RowEdit{
+		RowIndex:   rowIndex,
+		ColumnName: columnName,
+		OldValue:   currentValue,
+	}
+	base := currentValue
+	var patches []domain.JSONPatchOp
+	if previous, ok := txn.Edits[rowIndex]; ok && previous.ColumnName == columnName {
+		base = fmt.Sprint(previous.NewValue)
+		edit.OldValue = previous.OldValue
+		edit.History = append(append([]interface{}{}, previous.History...), previous.NewValue)
+		if previous.JSONPatches != nil {
+			patches = append(patches, previous.JSONPatches...)
+		}
+	} else {
+		patches = []domain.JSONPatchOp{}
+	}
+
+	document, err := decodeJSONDocument(base)
+	if err != nil || document == nil {
+		return nil, domain.ValidationError{Field: columnName, Message: "the cell does not hold a JSON object or array to edit"}
+	}
+	for _, op := range ops {
+		document, err = applyJSONPatch(document, op)
+		if err != nil {
+			return nil, domain.ValidationError{Field: "ops", Message: err.Error()}
+		}
+	}
+
+	result, err := json.Marshal(document)
+	if err != nil || !json.Valid(result) {
+		return nil, domain.ValidationError{Field: columnName, Message: "the edited cell is not valid JSON"}
+	}
+	edit.NewValue = string(result)
+	if patches != nil {
+		edit.JSONPatches = append(patches, ops...)
+	}
+
+	if err := u.transactionRepo.AddRowEdit(ctx, username, edit); err != nil {
+		return nil, err
+	}
+	return &edit, nil
+}
+
+// decodeJSONDocument parses JSON text keeping numbers exactly as written
+func decodeJSONDocument(text string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON value")
+	}
+	return document, nil
+}
+
+// applyJSONPatch applies one operation the way jsonb_set and #- would: set replaces or adds the last
+// path element, an array index past either end adding the value there, and negative indexes count from
+// the end. A path whose parents are missing is rejected rather than left unchanged as PostgreSQL does.
+func applyJSONPatch(document interface{}, op domain.JSONPatchOp) (interface{}, error) {
+	if len(op.Path) == 0 {
+		return nil, fmt.Errorf("a JSON operation needs a path")
+	}
+
+	var value interface{}
+	switch op.Op {
+	case domain.JSONPatchOpSet:
+		var err error
+		if value, err = decodeJSONDocument(op.Value); err != nil {
+			return nil, fmt.Errorf("value for %s is not valid JSON: %v", formatJSONPath(op.Path), err)
+		}
+	case domain.JSONPatchOpRemove:
+	default:
+		return nil, fmt.Errorf("unknown JSON operation %q", op.Op)
+	}
+
+	return patchJSONElement(document, op, value, 0)
+}
+
+// patchJSONElement applies op below the element at depth of its path and returns the replaced element
+func patchJSONElement(element interface{}, op domain.JSONPatchOp, value interface{}, depth int) (interface{}, error) {
+	key := op.Path[depth]
+	last := depth == len(op.Path)-1
+
+	switch node := element.(type) {
+	case map[string]interface{}:
+		child, ok := node[key]
+		switch {
+		case last && op.Op == domain.JSONPatchOpSet:
+			node[key] = value
+		case !ok:
+			return nil, fmt.Errorf("path %s does not exist", formatJSONPath(op.Path[:depth+1]))
+		case last:
+			delete(node, key)
+		default:
+			patched, err := patchJSONElement(child, op, value, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node[key] = patched
+		}
+		return node, nil
+
+	case []interface{}:
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("path element %q of %s is not an array index", key, formatJSONPath(op.Path))
+		}
+		if index < 0 {
+			index += len(node)
+		}
+		inRange := index >= 0 && index < len(node)
+		switch {
+		case last && op.Op == domain.JSONPatchOpSet && !inRange:
+			if index < 0 {
+				return append([]interface{}{value}, node...), nil
+			}
+			return append(node, value), nil
+		case !inRange:
+			return nil, fmt.Errorf("path %s does not exist", formatJSONPath(op.Path[:depth+1]))
+		case last && op.Op == domain.JSONPatchOpSet:
+			node[index] = value
+		case last:
+			return append(node[:index:index], node[index+1:]...), nil
+		default:
+			patched, err := patchJSONElement(node[index], op, value, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node[index] = patched
+		}
+		return node, nil
+	}
+
+	return nil, fmt.Errorf("path %s passes through a scalar value", formatJSONPath(op.Path[:depth+1]))
+}
+
+// formatJSONPath writes a path as a PostgreSQL text array literal, the form jsonb_set takes it in
+func formatJSONPath(path []string) string {
+	var literal bytes.Buffer
+	literal.WriteByte('{')
+	for i, element := range path {
+		if i > 0 {
+			literal.WriteByte(',')
+		}
+		literal.WriteString(`"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(element) + `"`)
+	}
+	literal.WriteByte('}')
+	return literal.String()
+}
@@ -0,0 +1,313 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *TransactionUseCaseImplementation) ExportTransaction(ctx context.Context, username, format string) (*domain.TransactionExport, error) {
+	if format != domain.TransactionExportSQL && format != domain.TransactionExportJSONPatch {
+		return nil, domain.ValidationError{Field: "format", Message: fmt.Sprintf("unsupported export format: %s", format)}
+	}
+
+	// Get the active transaction for the user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn == nil {
+		return nil, domain.ErrNoActiveTransaction
+	}
+
+	// Exporting applies nothing, so it needs no write permission on the table
+	if format == domain.TransactionExportJSONPatch {
+		content, err := json.MarshalIndent(exportPatchDocument(txn, time.Now()), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode change set: %w", err)
+		}
+		return &domain.TransactionExport{
+			Format:      format,
+			ContentType: "application/json",
+			Filename:    txn.Table + "_changes.json",
+			Content:     content,
+		}, nil
+	}
+
+	return &domain.TransactionExport{
+		Format:      format,
+		ContentType: "application/sql",
+		Filename:    txn.Table + "_changes.sql",
+		Content:     exportSQLScript(txn, time.Now()),
+	}, nil
+}
+
+// sortedEdits lists the buffered cell edits by row position
+func sortedEdits(txn *domain.TransactionState) []domain.RowEdit {
+	edits := make([]domain.RowEdit, 0, len(txn.Edits))
+	for _, edit := range txn.Edits {
+		edits = append(edits, edit)
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].RowIndex < edits[j].RowIndex })
+	return edits
+}
+
+// exportSQLScript renders the change set as one script wrapped in a transaction. Edits and deletes
+// buffered by grid position carry no row key, so they are written commented out for the reader to
+// complete; the old value guard keeps a completed statement from overwriting a row changed since.
+func exportSQLScript(txn *domain.TransactionState, exportedAt time.Time) []byte {
+	var script bytes.Buffer
+	table := qualifiedName(txn.Schema, txn.Table)
+
+	fmt.Fprintf(&script, "-- Change set for %s in database %s\n", table, txn.Database)
+	fmt.Fprintf(&script, "-- Exported by %s at %s\n", txn.Username, exportedAt.UTC().Format(time.RFC3339))
+	script.WriteString("BEGIN;\n")
+
+	for _, edit := range sortedEdits(txn) {
+		column := pq.QuoteIdentifier(edit.ColumnName)
+		fmt.Fprintf(&script, "\n-- Row %d of the grid has no key; add it to the WHERE clause before running\n", edit.RowIndex)
+		fmt.Fprintf(&script, "-- UPDATE %s SET %s = %s WHERE %s IS NOT DISTINCT FROM %s AND <key of row %d>;\n",
+			table, column, editExpression(edit), column, sqlLiteral(edit.OldValue), edit.RowIndex)
+	}
+
+	for _, update := range txn.BulkUpdates {
+		statement := fmt.Sprintf("UPDATE %s SET %s = %s", table, pq.QuoteIdentifier(update.Column), sqlLiteral(update.Value))
+		if update.WhereClause != "" {
+			statement += " WHERE " + update.WhereClause
+		}
+		fmt.Fprintf(&script, "\n-- Expected to change %d rows\n%s;\n", update.ExpectedCount, statement)
+	}
+
+	for _, rowIndex := range txn.Deletes {
+		fmt.Fprintf(&script, "\n-- Row %d of the grid has no key; add it to the WHERE clause before running\n", rowIndex)
+		fmt.Fprintf(&script, "-- DELETE FROM %s WHERE <key of row %d>;\n", table, rowIndex)
+	}
+
+	for _, key := range txn.KeyDeletes {
+		fmt.Fprintf(&script, "\nDELETE FROM %s WHERE %s;\n", table, keyCondition(key))
+	}
+
+	for _, insert := range txn.Inserts {
+		columns := sortedColumns(insert.Values)
+		quoted := make([]string, len(columns))
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			quoted[i] = pq.QuoteIdentifier(column)
+			values[i] = sqlLiteral(insert.Values[column])
+		}
+		fmt.Fprintf(&script, "\nINSERT INTO %s (%s) VALUES (%s)%s;\n",
+			table, strings.Join(quoted, ", "), strings.Join(values, ", "), exportConflictClause(insert.OnConflict))
+	}
+
+	script.WriteString("\nCOMMIT;\n")
+	return script.Bytes()
+}
+
+// patchDocument is the JSON form of a change set. Operations follow JSON Patch (RFC 6902) with paths
+// rooted at the table's rows; members beyond op, path and value say how to find rows without a position.
+type patchDocument struct {
+	Database   string           `json:"database"`
+	Schema     string           `json:"schema"`
+	Table      string           `json:"table"`
+	ExportedBy string           `json:"exported_by"`
+	ExportedAt string           `json:"exported_at"`
+	Operations []patchOperation `json:"operations"`
+}
+
+type patchOperation struct {
+	Op           string                 `json:"op"`
+	Path         string                 `json:"path"`
+	Value        interface{}            `json:"value,omitempty"`
+	Key          map[string]interface{} `json:"key,omitempty"`
+	Where        string                 `json:"where,omitempty"`
+	ExpectedRows int64                  `json:"expected_rows,omitempty"`
+	OnConflict   *patchConflict         `json:"on_conflict,omitempty"`
+}
+
+type patchConflict struct {
+	Mode          string   `json:"mode"`
+	Target        []string `json:"target,omitempty"`
+	UpdateColumns []string `json:"update_columns,omitempty"`
+}
+
+// exportPatchDocument renders the change set as JSON patch operations; each cell edit is preceded by a
+// test of its old value so a stale patch fails instead of overwriting
+func exportPatchDocument(txn *domain.TransactionState, exportedAt time.Time) *patchDocument {
+	document := &patchDocument{
+		Database:   txn.Database,
+		Schema:     txn.Schema,
+		Table:      txn.Table,
+		ExportedBy: txn.Username,
+		ExportedAt: exportedAt.UTC().Format(time.RFC3339),
+		Operations: []patchOperation{},
+	}
+
+	for _, edit := range sortedEdits(txn) {
+		path := fmt.Sprintf("/rows/%d/%s", edit.RowIndex, jsonPointerToken(edit.ColumnName))
+		document.Operations = append(document.Operations,
+			patchOperation{Op: "test", Path: path, Value: edit.OldValue},
+			patchOperation{Op: "replace", Path: path, Value: edit.NewValue},
+		)
+	}
+
+	for _, update := range txn.BulkUpdates {
+		document.Operations = append(document.Operations, patchOperation{
+			Op:           "replace",
+			Path:         "/rows/*/" + jsonPointerToken(update.Column),
+			Value:        update.Value,
+			Where:        update.WhereClause,
+			ExpectedRows: update.ExpectedCount,
+		})
+	}
+
+	for _, rowIndex := range txn.Deletes {
+		document.Operations = append(document.Operations, patchOperation{Op: "remove", Path: fmt.Sprintf("/rows/%d", rowIndex)})
+	}
+
+	for _, key := range txn.KeyDeletes {
+		document.Operations = append(document.Operations, patchOperation{Op: "remove", Path: "/rows", Key: key})
+	}
+
+	for _, insert := range txn.Inserts {
+		operation := patchOperation{Op: "add", Path: "/rows/-", Value: insert.Values}
+		if mode := insert.OnConflict.Mode; mode != "" && mode != domain.ConflictModeError {
+			operation.OnConflict = &patchConflict{
+				Mode:          mode,
+				Target:        insert.OnConflict.Target,
+				UpdateColumns: insert.OnConflict.UpdateColumns,
+			}
+		}
+		document.Operations = append(document.Operations, operation)
+	}
+
+	return document
+}
+
+// exportConflictClause writes the ON CONFLICT clause of an exported insert
+func exportConflictClause(action domain.ConflictAction) string {
+	target := ""
+	if len(action.Target) > 0 {
+		quoted := make([]string, len(action.Target))
+		for i, column := range action.Target {
+			quoted[i] = pq.QuoteIdentifier(column)
+		}
+		target = " (" + strings.Join(quoted, ", ") + ")"
+	}
+
+	switch action.Mode {
+	case domain.ConflictModeSkip:
+		return " ON CONFLICT" + target + " DO NOTHING"
+	case domain.ConflictModeUpdate:
+		assignments := make([]string, len(action.UpdateColumns))
+		for i, column := range action.UpdateColumns {
+			quoted := pq.QuoteIdentifier(column)
+			assignments[i] = quoted + " = EXCLUDED." + quoted
+		}
+		return " ON CONFLICT" + target + " DO UPDATE SET " + strings.Join(assignments, ", ")
+	default:
+		return ""
+	}
+}
+
+// keyCondition matches a row by its primary key values
+func keyCondition(key map[string]interface{}) string {
+	columns := sortedColumns(key)
+	conditions := make([]string, len(columns))
+	for i, column := range columns {
+		if key[column] == nil {
+			conditions[i] = pq.QuoteIdentifier(column) + " IS NULL"
+			continue
+		}
+		conditions[i] = pq.QuoteIdentifier(column) + " = " + sqlLiteral(key[column])
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func sortedColumns(values map[string]interface{}) []string {
+	columns := make([]string, 0, len(values))
+	for column := range values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// editExpression is the value a cell edit sets; structured JSONB edits are compiled to jsonb_set and
+// #- on the column so keys changed by others since the cell was read are kept
+func editExpression(edit domain.RowEdit) string {
+	if len(edit.JSONPatches) == 0 {
+		return sqlLiteral(edit.NewValue)
+	}
+	expression := pq.QuoteIdentifier(edit.ColumnName)
+	for _, op := range edit.JSONPatches {
+		path := pq.QuoteLiteral(formatJSONPath(op.Path))
+		if op.Op == domain.JSONPatchOpRemove {
+			expression = fmt.Sprintf("(%s #- %s)", expression, path)
+			continue
+		}
+		expression = fmt.Sprintf("jsonb_set(%s, %s, %s::jsonb)", expression, path, pq.QuoteLiteral(op.Value))
+	}
+	return expression
+}
+
+// qualifiedName quotes a schema-qualified table name
+func qualifiedName(schema, table string) string {
+	if schema == "" {
+		return pq.QuoteIdentifier(table)
+	}
+	return pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(table)
+}
+
+// sqlLiteral writes a buffered value as a SQL literal; structured values are written as JSON text
+func sqlLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v)
+	case float32:
+		return floatLiteral(float64(v))
+	case float64:
+		return floatLiteral(v)
+	case string:
+		return pq.QuoteLiteral(v)
+	case json.Number:
+		return v.String()
+	case []byte:
+		return pq.QuoteLiteral(string(v))
+	case time.Time:
+		return pq.QuoteLiteral(v.Format(time.RFC3339Nano))
+	default:
+		if data, err := json.Marshal(v); err == nil {
+			return pq.QuoteLiteral(string(data))
+		}
+		return pq.QuoteLiteral(fmt.Sprint(v))
+	}
+}
+
+// floatLiteral writes a float, quoting the special values PostgreSQL only accepts as strings
+func floatLiteral(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return pq.QuoteLiteral(fmt.Sprint(v))
+	}
+	return fmt.Sprint(v)
+}
+
+// jsonPointerToken escapes a column name for use in a JSON pointer path
+func jsonPointerToken(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,22 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) Heartbeat(ctx context.Context, username string) error {
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return err
+	}
+	if txn == nil {
+		return domain.ErrNoActiveTransaction
+	}
+
+	txn.LastHeartbeatAt = time.Now()
+	txn.IdleWarnedAt = time.Time{}
+	return u.transactionRepo.UpdateTransaction(ctx, txn)
+}
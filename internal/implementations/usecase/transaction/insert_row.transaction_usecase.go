@@ -7,21 +7,5 @@ import (
 )
 
 func (u *TransactionUseCaseImplementation) InsertRow(ctx context.Context, username, database, schema, table string, values map[string]interface{}) error {
-	// Get the active transaction for the user
-	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
-	if err != nil {
-		return err
-	}
-
-	if txn == nil {
-		return domain.ErrNoActiveTransaction
-	}
-
-	// Create the row insert
-	insert := domain.RowInsert{
-		Values: values,
-	}
-
-	// Add the row insertion to the transaction
-	return u.transactionRepo.AddRowInsert(ctx, username, insert)
+	return u.UpsertRow(ctx, username, database, schema, table, values, domain.ConflictAction{})
 }
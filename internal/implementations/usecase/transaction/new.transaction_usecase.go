@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"sync"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
 )
@@ -9,16 +12,27 @@ type TransactionUseCaseImplementation struct {
 	transactionRepo repository.TransactionRepository
 	databaseRepo    repository.DatabaseRepository
 	rbacRepo        repository.RBACRepository
+	auditRepo       repository.AuditRepository
+	configRepo      repository.ConfigRepository
+
+	// timedOut keeps the reaper's rollbacks by transaction ID until their users' event streams see them
+	timedOutMu sync.Mutex
+	timedOut   map[string]domain.TransactionEvent
 }
 
 func NewTransactionUseCaseImplementation(
 	transactionRepo repository.TransactionRepository,
 	databaseRepo repository.DatabaseRepository,
 	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+	configRepo repository.ConfigRepository,
 ) usecase.TransactionUseCase {
 	return &TransactionUseCaseImplementation{
 		transactionRepo: transactionRepo,
 		databaseRepo:    databaseRepo,
 		rbacRepo:        rbacRepo,
+		auditRepo:       auditRepo,
+		configRepo:      configRepo,
+		timedOut:        make(map[string]domain.TransactionEvent),
 	}
 }
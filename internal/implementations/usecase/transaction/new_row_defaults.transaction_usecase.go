@@ -0,0 +1,55 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) NewRowDefaults(ctx context.Context, username, database, schema, table string) (*domain.NewRowDefaults, error) {
+	// Get the active transaction for the user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn == nil {
+		return nil, domain.ErrNoActiveTransaction
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	u.fillGeneratedKeys(ctx, tableMetadata, values)
+
+	placeholders, err := u.databaseRepo.PreviewColumnDefaults(ctx, database, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.NewRowDefaults{Values: values, Placeholders: placeholders}, nil
+}
+
+// fillGeneratedKeys sets a fresh UUID for each uuid primary key column without a default that values
+// leaves out or empty, so the insert does not fail its NOT NULL constraint
+func (u *TransactionUseCaseImplementation) fillGeneratedKeys(ctx context.Context, tableMetadata *domain.TableMetadata, values map[string]interface{}) {
+	for _, column := range tableMetadata.Columns {
+		if !column.IsPrimary || column.HasDefault || column.DataType != "uuid" {
+			continue
+		}
+		if value, ok := values[column.Name]; ok && value != nil && value != "" {
+			continue
+		}
+
+		// Prefer the server's generator; fall back to one made here when pgcrypto or PG13+ is unavailable
+		id, err := u.databaseRepo.GenerateUUID(ctx)
+		if err != nil {
+			id = uuid.NewString()
+		}
+		values[column.Name] = id
+	}
+}
@@ -0,0 +1,90 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) ReapIdleTransactions(ctx context.Context) (int, error) {
+	transactions, err := u.transactionRepo.ListTransactions(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	now := time.Now()
+	u.forgetTimedOut(now)
+
+	reaped := 0
+	for _, txn := range transactions {
+		idle := now.Sub(lastHeartbeat(txn))
+		switch {
+		case idle >= domain.TransactionIdleTimeout:
+			if err := u.reapTransaction(ctx, txn, idle, now); err != nil {
+				return reaped, err
+			}
+			reaped++
+		case idle >= domain.TransactionIdleTimeout-domain.TransactionIdleWarning && txn.IdleWarnedAt.IsZero():
+			// The warning reaches the user through WatchTransaction, which reads it off the transaction
+			txn.IdleWarnedAt = now
+			if err := u.transactionRepo.UpdateTransaction(ctx, txn); err != nil {
+				return reaped, fmt.Errorf("failed to warn idle transaction: %w", err)
+			}
+		}
+	}
+	return reaped, nil
+}
+
+// lastHeartbeat is when a transaction's page last reported activity, or when it started
+func lastHeartbeat(txn *domain.TransactionState) time.Time {
+	if txn.LastHeartbeatAt.IsZero() {
+		return txn.StartedAt
+	}
+	return txn.LastHeartbeatAt
+}
+
+// reapTransaction rolls back an idle transaction, keeping the rollback for its user's event streams and
+// auditing it with what was discarded
+func (u *TransactionUseCaseImplementation) reapTransaction(ctx context.Context, txn *domain.TransactionState, idle time.Duration, now time.Time) error {
+	if err := u.transactionRepo.DeleteTransaction(ctx, txn.ID); err != nil {
+		return fmt.Errorf("failed to roll back idle transaction: %w", err)
+	}
+
+	u.timedOutMu.Lock()
+	u.timedOut[txn.ID] = domain.TransactionEvent{
+		Type:          domain.TransactionEventTimeout,
+		TransactionID: txn.ID,
+		At:            now,
+	}
+	u.timedOutMu.Unlock()
+
+	return u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username: txn.Username,
+		Action:   domain.AuditActionTransactionTimeout,
+		Database: txn.Database,
+		Schema:   txn.Schema,
+		Table:    txn.Table,
+		Before: map[string]interface{}{
+			"transaction_id": txn.ID,
+			"idle_seconds":   int64(idle / time.Second),
+			"edits":          len(txn.Edits),
+			"deletes":        len(txn.Deletes) + len(txn.KeyDeletes),
+			"bulk_updates":   len(txn.BulkUpdates),
+			"inserts":        len(txn.Inserts),
+		},
+	})
+}
+
+// forgetTimedOut drops rollbacks older than an idle timeout, by when every open stream has seen them
+func (u *TransactionUseCaseImplementation) forgetTimedOut(now time.Time) {
+	u.timedOutMu.Lock()
+	defer u.timedOutMu.Unlock()
+
+	for id, event := range u.timedOut {
+		if now.Sub(event.At) > domain.TransactionIdleTimeout {
+			delete(u.timedOut, id)
+		}
+	}
+}
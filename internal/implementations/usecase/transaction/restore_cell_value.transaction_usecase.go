@@ -0,0 +1,49 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) RestoreCellValue(ctx context.Context, username string, rowIndex int, columnName string) (*domain.RowEdit, error) {
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn == nil {
+		return nil, domain.ErrNoActiveTransaction
+	}
+
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return nil, domain.ErrCommitPendingApproval
+	}
+
+	edit, ok := txn.Edits[rowIndex]
+	if !ok || edit.ColumnName != columnName {
+		return nil, domain.ValidationError{Field: "column", Message: fmt.Sprintf("row %d has no buffered edit to %s", rowIndex, columnName)}
+	}
+
+	// Other buffered changes are left untouched; only this cell's timeline moves back a step
+	var restored *domain.RowEdit
+	if len(edit.History) == 0 {
+		delete(txn.Edits, rowIndex)
+	} else {
+		last := len(edit.History) - 1
+		edit.NewValue = edit.History[last]
+		edit.History = append([]interface{}{}, edit.History[:last]...)
+		// The patches no longer describe the restored value, which is exported whole instead
+		edit.JSONPatches = nil
+		txn.Edits[rowIndex] = edit
+		restored = &edit
+	}
+
+	if err := u.transactionRepo.UpdateTransaction(ctx, txn); err != nil {
+		return nil, fmt.Errorf("failed to restore cell value: %w", err)
+	}
+
+	return restored, nil
+}
@@ -0,0 +1,120 @@
+package transaction
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/lib/pq"
+)
+
+func (u *TransactionUseCaseImplementation) RestoreDeletedRows(ctx context.Context, username, entryID, confirm string) (*domain.RowRestore, error) {
+	deleted, err := u.auditRepo.GetDeletedRows(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(deleted.Rows) == 0 {
+		return nil, domain.ErrDeletedRowsNotFound
+	}
+
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkMaintenance(ctx, config, username, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Restoring writes the rows back, so it needs the same right as inserting them
+	canInsert, err := u.rbacRepo.HasInsertPermission(ctx, username, deleted.Database, deleted.Schema, deleted.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !canInsert {
+		return nil, domain.ValidationError{
+			Field:   "permission",
+			Message: "INSERT privilege on " + deleted.Schema + "." + deleted.Table + " is required to restore its rows",
+		}
+	}
+
+	// A second restore would insert the same rows again
+	restores, err := u.auditRepo.GetEntries(ctx, domain.AuditFilter{
+		Action:   domain.AuditActionRestoreRows,
+		Database: deleted.Database,
+		Schema:   deleted.Schema,
+		Table:    deleted.Table,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range restores {
+		if entry.Target == entryID {
+			return nil, domain.ValidationError{
+				Field:   "entry_id",
+				Message: "the rows deleted by this commit were already restored by " + entry.Username,
+			}
+		}
+	}
+
+	restore := &domain.RowRestore{
+		EntryID:   entryID,
+		Database:  deleted.Database,
+		Schema:    deleted.Schema,
+		Table:     deleted.Table,
+		Statement: restoreStatement(deleted),
+		Rows:      len(deleted.Rows),
+	}
+	if confirm == "" {
+		return restore, nil
+	}
+	if confirm != restore.Statement {
+		return nil, domain.ValidationError{
+			Field:   "confirm",
+			Message: "the statement differs from the one confirmed; preview the restore again",
+		}
+	}
+
+	if _, err := u.databaseRepo.ExecuteQuery(ctx, restore.Statement); err != nil {
+		return nil, err
+	}
+	restore.Applied = true
+
+	inserted := make([]map[string]interface{}, len(deleted.Rows))
+	for i, row := range deleted.Rows {
+		inserted[i] = map[string]interface{}{"operation": "insert", "values": row}
+	}
+	if err := u.auditRepo.RecordEntry(ctx, &domain.AuditEntry{
+		Username:     username,
+		Action:       domain.AuditActionRestoreRows,
+		Database:     deleted.Database,
+		Schema:       deleted.Schema,
+		Table:        deleted.Table,
+		Target:       entryID,
+		AffectedRows: inserted,
+	}); err != nil {
+		return nil, err
+	}
+	return restore, nil
+}
+
+// restoreStatement writes one INSERT putting every kept row back as it was, identity columns included
+func restoreStatement(deleted *domain.DeletedRows) string {
+	columns := sortedColumns(deleted.Rows[0])
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = pq.QuoteIdentifier(column)
+	}
+
+	tuples := make([]string, len(deleted.Rows))
+	for i, row := range deleted.Rows {
+		values := make([]string, len(columns))
+		for j, column := range columns {
+			values[j] = sqlLiteral(row[column])
+		}
+		tuples[i] = "(" + strings.Join(values, ", ") + ")"
+	}
+
+	return "INSERT INTO " + qualifiedName(deleted.Schema, deleted.Table) + " (" + strings.Join(quoted, ", ") +
+		") OVERRIDING SYSTEM VALUE VALUES " + strings.Join(tuples, ", ")
+}
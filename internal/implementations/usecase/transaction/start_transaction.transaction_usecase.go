@@ -16,6 +16,15 @@ func (u *TransactionUseCaseImplementation) StartTransaction(ctx context.Context,
 		return nil, domain.ErrActiveTransactionExists
 	}
 
+	// No new transactions while the instance is in maintenance mode
+	config, err := u.configRepo.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkMaintenance(ctx, config, username, time.Time{}); err != nil {
+		return nil, err
+	}
+
 	// Create a new transaction state
 	txnID := "txn_" + uuid.New().String()
 	now := time.Now()
@@ -24,6 +33,9 @@ func (u *TransactionUseCaseImplementation) StartTransaction(ctx context.Context,
 	txnState := &domain.TransactionState{
 		ID:        txnID,
 		Username:  username,
+		Database:  database,
+		Schema:    schema,
+		Table:     table,
 		StartedAt: now,
 		ExpiresAt: expiresAt,
 		Edits:     make(map[int]domain.RowEdit),
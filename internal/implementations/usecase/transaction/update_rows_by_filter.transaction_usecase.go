@@ -0,0 +1,105 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) PreviewBulkUpdate(ctx context.Context, username string, update domain.BulkUpdate) (*domain.BulkUpdateResult, error) {
+	matching, err := u.countBulkUpdateRows(ctx, username, &update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.BulkUpdateResult{MatchingRows: matching}, nil
+}
+
+func (u *TransactionUseCaseImplementation) UpdateRowsByFilter(ctx context.Context, username string, update domain.BulkUpdate) (*domain.BulkUpdateResult, error) {
+	matching, err := u.countBulkUpdateRows(ctx, username, &update)
+	if err != nil {
+		return nil, err
+	}
+
+	// The confirmation is only valid for the row count the user saw
+	if matching != update.ExpectedCount {
+		return nil, domain.ValidationError{
+			Field:   "confirm",
+			Message: fmt.Sprintf("filter now matches %d rows instead of the confirmed %d; preview again", matching, update.ExpectedCount),
+		}
+	}
+	if matching == 0 {
+		return &domain.BulkUpdateResult{}, nil
+	}
+
+	if err := u.transactionRepo.AddBulkUpdate(ctx, username, update); err != nil {
+		return nil, err
+	}
+
+	return &domain.BulkUpdateResult{MatchingRows: matching, Buffered: true}, nil
+}
+
+// countBulkUpdateRows checks the update targets a column of the active transaction's table the user
+// may update, and counts the rows matching its filter
+func (u *TransactionUseCaseImplementation) countBulkUpdateRows(ctx context.Context, username string, update *domain.BulkUpdate) (int64, error) {
+	// Get the active transaction for the user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+
+	if txn == nil {
+		return 0, domain.ErrNoActiveTransaction
+	}
+
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return 0, domain.ErrCommitPendingApproval
+	}
+
+	if update.Database != txn.Database || update.Schema != txn.Schema || update.Table != txn.Table {
+		return 0, domain.ValidationError{Field: "table", Message: "rows can only be updated in the table of the active transaction"}
+	}
+
+	hasPermission, err := u.rbacRepo.HasUpdatePermission(ctx, username, update.Database, update.Schema, update.Table)
+	if err != nil {
+		return 0, err
+	}
+	if !hasPermission {
+		return 0, domain.ValidationError{Field: "permission", Message: "user does not have UPDATE permission on this table"}
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, update.Database, update.Schema, update.Table)
+	if err != nil {
+		return 0, err
+	}
+	columnExists := false
+	for _, column := range tableMetadata.Columns {
+		if column.Name == update.Column {
+			columnExists = true
+			break
+		}
+	}
+	if !columnExists {
+		return 0, domain.ValidationError{Field: "column", Message: "column " + update.Column + " does not exist in table " + update.Table}
+	}
+
+	update.WhereClause = strings.TrimSpace(update.WhereClause)
+	if err := u.validateFilter(ctx, username, update.WhereClause); err != nil {
+		return 0, err
+	}
+
+	return u.databaseRepo.GetRowCount(ctx, update.Database, update.Schema, update.Table, update.WhereClause)
+}
+
+// validateFilter rejects filters matching the data view's SQL injection rules, leaving an audit entry
+// naming the rule that matched
+func (u *TransactionUseCaseImplementation) validateFilter(ctx context.Context, username, whereClause string) error {
+	if rule, unsafe := domain.UnsafeWhereRule(whereClause); unsafe {
+		_ = u.auditRepo.RecordEntry(ctx, domain.RejectionAuditEntry(ctx, username, domain.RejectionSourceSQLValidator, rule))
+		return domain.ValidationError{Field: "whereClause", Message: "WHERE clause contains invalid or malicious patterns"}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) UpsertRow(ctx context.Context, username, database, schema, table string, values map[string]interface{}, onConflict domain.ConflictAction) error {
+	// Get the active transaction for the user
+	txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if txn == nil {
+		return domain.ErrNoActiveTransaction
+	}
+
+	// Changes are frozen while a commit waits for approval
+	if txn.Status == domain.TransactionStatusPendingApproval {
+		return domain.ErrCommitPendingApproval
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return err
+	}
+
+	if onConflict.Mode != "" && onConflict.Mode != domain.ConflictModeError {
+		if err := u.validateConflictAction(ctx, username, database, schema, table, tableMetadata, values, onConflict); err != nil {
+			return err
+		}
+	}
+
+	// Generate keys the row left out instead of letting the insert fail on them at commit
+	rowValues := make(map[string]interface{}, len(values))
+	for column, value := range values {
+		rowValues[column] = value
+	}
+	u.fillGeneratedKeys(ctx, tableMetadata, rowValues)
+
+	// Create the row insert
+	insert := domain.RowInsert{
+		Values:     rowValues,
+		OnConflict: onConflict,
+	}
+
+	// Add the row insertion to the transaction
+	return u.transactionRepo.AddRowInsert(ctx, username, insert)
+}
+
+// validateConflictAction checks the conflict target and update columns name columns of the table, and
+// that a user overwriting existing rows may update them
+func (u *TransactionUseCaseImplementation) validateConflictAction(ctx context.Context, username, database, schema, table string, tableMetadata *domain.TableMetadata, values map[string]interface{}, onConflict domain.ConflictAction) error {
+	switch onConflict.Mode {
+	case domain.ConflictModeSkip:
+		if len(onConflict.UpdateColumns) > 0 {
+			return domain.ValidationError{Field: "on_conflict", Message: "update columns only apply to the update conflict mode"}
+		}
+	case domain.ConflictModeUpdate:
+		if len(onConflict.Target) == 0 || len(onConflict.UpdateColumns) == 0 {
+			return domain.ValidationError{Field: "on_conflict", Message: "updating on conflict needs the conflict columns and the columns to update"}
+		}
+
+		hasPermission, err := u.rbacRepo.HasUpdatePermission(ctx, username, database, schema, table)
+		if err != nil {
+			return err
+		}
+		if !hasPermission {
+			return domain.ValidationError{Field: "permission", Message: "user does not have UPDATE permission on this table"}
+		}
+	default:
+		return domain.ValidationError{Field: "on_conflict", Message: "unknown conflict mode: " + onConflict.Mode}
+	}
+
+	columns := make(map[string]bool, len(tableMetadata.Columns))
+	for _, column := range tableMetadata.Columns {
+		columns[column.Name] = true
+	}
+
+	for _, column := range onConflict.Target {
+		if !columns[column] {
+			return domain.ValidationError{Field: "on_conflict", Message: "unknown conflict column: " + column}
+		}
+	}
+	for _, column := range onConflict.UpdateColumns {
+		if !columns[column] {
+			return domain.ValidationError{Field: "on_conflict", Message: "unknown update column: " + column}
+		}
+		if _, ok := values[column]; !ok {
+			return domain.ValidationError{Field: "on_conflict", Message: "update column has no value in the new row: " + column}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+const (
+	integerFormats   = "a whole number such as 42 or -7"
+	numericFormats   = "a decimal number such as 12.50 or 1.5e3, or NaN"
+	floatFormats     = "a number such as 3.14 or 1e-5, or NaN, Infinity, -Infinity"
+	booleanFormats   = "true/false, yes/no, on/off, 1/0, or a prefix such as t, f, y, n"
+	dateFormats      = "YYYY-MM-DD, Mon DD, YYYY, DD-Mon-YYYY, or today, tomorrow, yesterday, epoch, infinity, -infinity"
+	timestampFormats = "YYYY-MM-DD[ HH:MM[:SS[.ffffff]]] with a space or T separator and an optional offset such as Z, +02 or +02:00, or now, today, tomorrow, yesterday, epoch, infinity, -infinity"
+	timeFormats      = "HH:MM[:SS[.ffffff]] with an optional offset such as +02:00, or now, allballs"
+)
+
+// integerRanges maps the integer types onto their bit sizes
+var integerRanges = map[string]int{
+	"smallint": 16,
+	"integer":  32,
+	"bigint":   64,
+}
+
+var (
+	dateLayouts       = []string{"2006-1-2", "January 2, 2006", "Jan 2, 2006", "2-Jan-2006", "2006-Jan-2"}
+	timeLayouts       = []string{"15:04:05", "15:04"}
+	zoneLayouts       = []string{"", "Z07:00", "Z07", "Z0700", " Z07:00", " Z07"}
+	dateSpecials      = []string{"today", "tomorrow", "yesterday", "epoch", "infinity", "-infinity"}
+	timestampSpecials = append([]string{"now"}, dateSpecials...)
+	timeSpecials      = []string{"now", "allballs"}
+)
+
+// validateColumnType checks a value about to be buffered for column against the column's PostgreSQL
+// type, so conversion errors surface at edit time with the formats the type accepts. Types this code
+// does not know, arrays and user-defined types included, are left for PostgreSQL to convert at commit.
+func (u *TransactionUseCaseImplementation) validateColumnType(ctx context.Context, database, schema, table, column string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	tableMetadata, err := u.databaseRepo.GetTableMetadata(ctx, database, schema, table)
+	if err != nil {
+		return fmt.Errorf("failed to get table metadata: %w", err)
+	}
+
+	for _, col := range tableMetadata.Columns {
+		if col.Name != column {
+			continue
+		}
+		if problem := checkColumnValue(col, strings.TrimSpace(fmt.Sprint(value))); problem != "" {
+			return domain.ValidationError{
+				Field:   column,
+				Message: fmt.Sprintf("value %q is not a valid %s for column %s: %s", fmt.Sprint(value), col.DataType, column, problem),
+			}
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// checkColumnValue returns why text does not convert to the column's type, or "" when it does or the
+// type is not checked here
+func checkColumnValue(column domain.ColumnMetadata, text string) string {
+	if column.EnumValues != nil {
+		for _, label := range column.EnumValues {
+			if text == label {
+				return ""
+			}
+		}
+		return "expected one of " + strings.Join(column.EnumValues, ", ")
+	}
+
+	if bits, ok := integerRanges[column.DataType]; ok {
+		return checkInteger(text, bits)
+	}
+
+	switch column.DataType {
+	case "numeric":
+		return checkNumeric(text, column.NumericPrecision, column.NumericScale)
+	case "real", "double precision":
+		return checkFloat(text, column.DataType == "real")
+	case "boolean":
+		if !isBooleanLiteral(text) {
+			return "expected " + booleanFormats
+		}
+	case "date":
+		if !matchesTimeLayouts(text, dateLayouts, dateSpecials) {
+			return "expected " + dateFormats
+		}
+	case "timestamp without time zone", "timestamp with time zone":
+		if !matchesTimeLayouts(text, timestampLayouts(), timestampSpecials) {
+			return "expected " + timestampFormats
+		}
+	case "time without time zone", "time with time zone":
+		if !matchesTimeLayouts(text, zonedLayouts(timeLayouts), timeSpecials) {
+			return "expected " + timeFormats
+		}
+	}
+
+	return ""
+}
+
+func checkInteger(text string, bits int) string {
+	_, err := strconv.ParseInt(text, 10, bits)
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Sprintf("out of range, expected a whole number between %d and %d", int64(-1)<<(bits-1), int64(1)<<(bits-1)-1)
+	}
+	if err != nil {
+		return "expected " + integerFormats
+	}
+	return ""
+}
+
+// checkNumeric parses text as a decimal and, for numeric(p,s), checks that it still fits once rounded
+// to s fractional digits, as PostgreSQL rounds rather than rejects extra fractional digits
+func checkNumeric(text string, precision, scale int) string {
+	if strings.EqualFold(text, "NaN") {
+		return ""
+	}
+	if strings.ContainsAny(text, "_xXpP/") {
+		return "expected " + numericFormats
+	}
+	// Bound the exponent before big.Rat expands it; PostgreSQL's own limit is 131072 digits
+	if e := strings.IndexAny(text, "eE"); e >= 0 {
+		if exponent, err := strconv.Atoi(text[e+1:]); err == nil && (exponent > 131072 || exponent < -131072) {
+			return "out of range"
+		}
+	}
+	number, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return "expected " + numericFormats
+	}
+	if precision == 0 {
+		return ""
+	}
+
+	// round(|number| * 10^scale) must stay below 10^precision
+	scaled := new(big.Rat).Mul(new(big.Rat).Abs(number), new(big.Rat).SetInt(pow10(scale)))
+	doubled := new(big.Int).Mul(scaled.Num(), big.NewInt(2))
+	rounded := new(big.Int).Quo(doubled.Add(doubled, scaled.Denom()), new(big.Int).Mul(scaled.Denom(), big.NewInt(2)))
+	if rounded.Cmp(pow10(precision)) >= 0 {
+		return fmt.Sprintf("numeric(%d,%d) holds at most %d digits before the decimal point and rounds to %d after it",
+			precision, scale, precision-scale, scale)
+	}
+	return ""
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func checkFloat(text string, single bool) string {
+	// ParseFloat also takes hex floats and underscores, which PostgreSQL does not
+	if strings.ContainsAny(text, "_xXpP") {
+		return "expected " + floatFormats
+	}
+	bits := 64
+	if single {
+		bits = 32
+	}
+	_, err := strconv.ParseFloat(text, bits)
+	if errors.Is(err, strconv.ErrRange) {
+		return "out of range"
+	}
+	if err != nil {
+		return "expected " + floatFormats
+	}
+	return ""
+}
+
+// isBooleanLiteral accepts the boolean input PostgreSQL does: any unambiguous prefix of true, false,
+// yes, no, on and off, or 1 and 0, in any case
+func isBooleanLiteral(text string) bool {
+	text = strings.ToLower(text)
+	if text == "" {
+		return false
+	}
+	switch text {
+	case "1", "0", "on", "of", "off":
+		return true
+	}
+	for _, word := range []string{"true", "false", "yes", "no"} {
+		if strings.HasPrefix(word, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// timestampLayouts combines the date layouts with a space or T separated time and optional offset
+func timestampLayouts() []string {
+	layouts := append([]string{}, dateLayouts...)
+	for _, date := range dateLayouts {
+		for _, separator := range []string{" ", "T"} {
+			for _, clock := range timeLayouts {
+				layouts = append(layouts, date+separator+clock)
+			}
+		}
+	}
+	return zonedLayouts(layouts)
+}
+
+func zonedLayouts(layouts []string) []string {
+	var zoned []string
+	for _, layout := range layouts {
+		for _, zone := range zoneLayouts {
+			zoned = append(zoned, layout+zone)
+		}
+	}
+	return zoned
+}
+
+// matchesTimeLayouts reports whether text parses with one of the layouts or is a special value; when
+// parsing, time.Parse accepts fractional seconds after the seconds field without a layout for them
+func matchesTimeLayouts(text string, layouts, specials []string) bool {
+	for _, special := range specials {
+		if strings.EqualFold(text, special) {
+			return true
+		}
+	}
+	for _, layout := range layouts {
+		if _, err := time.Parse(layout, text); err == nil {
+			return true
+		}
+	}
+	return false
+}
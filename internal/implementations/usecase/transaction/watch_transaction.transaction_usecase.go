@@ -0,0 +1,65 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+func (u *TransactionUseCaseImplementation) WatchTransaction(ctx context.Context, username string, onEvent func(*domain.TransactionEvent) error) error {
+	ticker := time.NewTicker(domain.TransactionWatchInterval)
+	defer ticker.Stop()
+
+	var watchedID string
+	var warnedAt time.Time
+	for {
+		txn, err := u.transactionRepo.GetUserTransaction(ctx, username)
+		if err != nil && !errors.Is(err, domain.ErrNoActiveTransaction) {
+			return err
+		}
+		if err != nil {
+			txn = nil
+		}
+
+		// A transaction that ended is only reported when the reaper rolled it back
+		if watchedID != "" && (txn == nil || txn.ID != watchedID) {
+			u.timedOutMu.Lock()
+			event, timedOut := u.timedOut[watchedID]
+			u.timedOutMu.Unlock()
+			if timedOut {
+				if err := onEvent(&event); err != nil {
+					return err
+				}
+			}
+			watchedID = ""
+			warnedAt = time.Time{}
+		}
+
+		if txn != nil {
+			watchedID = txn.ID
+			if !txn.IdleWarnedAt.IsZero() && !txn.IdleWarnedAt.Equal(warnedAt) {
+				warnedAt = txn.IdleWarnedAt
+				remaining := domain.TransactionIdleTimeout - time.Since(lastHeartbeat(txn))
+				if remaining < 0 {
+					remaining = 0
+				}
+				if err := onEvent(&domain.TransactionEvent{
+					Type:             domain.TransactionEventIdleWarning,
+					TransactionID:    txn.ID,
+					At:               warnedAt,
+					RemainingSeconds: int64(remaining / time.Second),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
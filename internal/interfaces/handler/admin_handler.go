@@ -0,0 +1,25 @@
+package handler
+
+import "net/http"
+
+// AdminHandler handles superuser administration HTTP requests
+type AdminHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleSessionsPage(w http.ResponseWriter, r *http.Request)
+	HandleListSessions(w http.ResponseWriter, r *http.Request)
+	HandleRevokeSession(w http.ResponseWriter, r *http.Request)
+	HandleSessionSettings(w http.ResponseWriter, r *http.Request)
+	HandleConnectionProfiles(w http.ResponseWriter, r *http.Request)
+	HandleDeleteConnectionProfile(w http.ResponseWriter, r *http.Request)
+	HandleExportConnectionProfiles(w http.ResponseWriter, r *http.Request)
+	HandleImportConnectionProfiles(w http.ResponseWriter, r *http.Request)
+	HandleMaintenance(w http.ResponseWriter, r *http.Request)
+	HandleEnvironment(w http.ResponseWriter, r *http.Request)
+	HandleDisabledShortcuts(w http.ResponseWriter, r *http.Request)
+	HandleCreateRole(w http.ResponseWriter, r *http.Request)
+	HandleCheckRolePassword(w http.ResponseWriter, r *http.Request)
+	HandleRejectionStats(w http.ResponseWriter, r *http.Request)
+	HandleAuditPage(w http.ResponseWriter, r *http.Request)
+	HandleAuditLog(w http.ResponseWriter, r *http.Request)
+	HandleExportAuditLog(w http.ResponseWriter, r *http.Request)
+}
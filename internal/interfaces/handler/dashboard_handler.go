@@ -0,0 +1,13 @@
+package handler
+
+import "net/http"
+
+// DashboardHandler handles home dashboard HTTP requests
+type DashboardHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleDashboardPage(w http.ResponseWriter, r *http.Request)
+	HandleListDashboardTiles(w http.ResponseWriter, r *http.Request)
+	HandlePinQueryResult(w http.ResponseWriter, r *http.Request)
+	HandleUnpinDashboardTile(w http.ResponseWriter, r *http.Request)
+	HandleRefreshDashboardTile(w http.ResponseWriter, r *http.Request)
+}
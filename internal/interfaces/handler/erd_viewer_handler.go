@@ -10,4 +10,5 @@ type ERDViewerHandler interface {
 	HandleERDZoom(w http.ResponseWriter, r *http.Request)
 	HandleERDPan(w http.ResponseWriter, r *http.Request)
 	HandleTableClickInERD(w http.ResponseWriter, r *http.Request)
+	HandleSchemaGraph(w http.ResponseWriter, r *http.Request)
 }
@@ -0,0 +1,11 @@
+package handler
+
+import "net/http"
+
+// FunctionHandler handles stored function and procedure HTTP requests
+type FunctionHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleListFunctions(w http.ResponseWriter, r *http.Request)
+	HandleFunctionSource(w http.ResponseWriter, r *http.Request)
+	HandleExecuteFunction(w http.ResponseWriter, r *http.Request)
+}
@@ -7,5 +7,8 @@ type LoginHandler interface {
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 	HandleLoginPage(w http.ResponseWriter, r *http.Request)
 	HandleLogin(w http.ResponseWriter, r *http.Request)
+	HandleSSOLogin(w http.ResponseWriter, r *http.Request)
+	HandleSSOCallback(w http.ResponseWriter, r *http.Request)
 	HandleLogout(w http.ResponseWriter, r *http.Request)
+	HandleAccountActivity(w http.ResponseWriter, r *http.Request)
 }
@@ -8,8 +8,38 @@ type MainViewHandler interface {
 	HandleMainViewPage(w http.ResponseWriter, r *http.Request)
 	HandleTableSelect(w http.ResponseWriter, r *http.Request)
 	HandleLoadTableData(w http.ResponseWriter, r *http.Request)
+	HandleLiveTableData(w http.ResponseWriter, r *http.Request)
+	HandleWatchRows(w http.ResponseWriter, r *http.Request)
 	HandleFilterTable(w http.ResponseWriter, r *http.Request)
+	HandleStructuredFilter(w http.ResponseWriter, r *http.Request)
+	HandleTableSearch(w http.ResponseWriter, r *http.Request)
+	HandleObjectSearch(w http.ResponseWriter, r *http.Request)
 	HandleSortTable(w http.ResponseWriter, r *http.Request)
 	HandlePaginationNext(w http.ResponseWriter, r *http.Request)
 	HandlePaginationPrevious(w http.ResponseWriter, r *http.Request)
+	HandleColumnAggregate(w http.ResponseWriter, r *http.Request)
+	HandleGroupBy(w http.ResponseWriter, r *http.Request)
+	HandleHistogram(w http.ResponseWriter, r *http.Request)
+	HandleDuplicateRows(w http.ResponseWriter, r *http.Request)
+	HandleDataQuality(w http.ResponseWriter, r *http.Request)
+	HandleTableStats(w http.ResponseWriter, r *http.Request)
+	HandleTableSizes(w http.ResponseWriter, r *http.Request)
+	HandleReferentialIntegrity(w http.ResponseWriter, r *http.Request)
+	HandleForeignKeyLookup(w http.ResponseWriter, r *http.Request)
+	HandleJSONCell(w http.ResponseWriter, r *http.Request)
+	HandleCellContent(w http.ResponseWriter, r *http.Request)
+	HandleSpatialCell(w http.ResponseWriter, r *http.Request)
+	HandleRowDetail(w http.ResponseWriter, r *http.Request)
+	HandleTableSnapshots(w http.ResponseWriter, r *http.Request)
+	HandleCompareTableSnapshot(w http.ResponseWriter, r *http.Request)
+	HandleDeleteTableSnapshot(w http.ResponseWriter, r *http.Request)
+	HandleSuggestJoin(w http.ResponseWriter, r *http.Request)
+	HandleJoinView(w http.ResponseWriter, r *http.Request)
+	HandleTableAsOf(w http.ResponseWriter, r *http.Request)
+	HandleRowTimeline(w http.ResponseWriter, r *http.Request)
+	HandleExportTable(w http.ResponseWriter, r *http.Request)
+	HandleImportTable(w http.ResponseWriter, r *http.Request)
+	HandleFreezeView(w http.ResponseWriter, r *http.Request)
+	HandleSnapshotStatus(w http.ResponseWriter, r *http.Request)
+	HandleReleaseSnapshot(w http.ResponseWriter, r *http.Request)
 }
@@ -0,0 +1,19 @@
+package handler
+
+import "net/http"
+
+// MonitoringHandler handles the live view of the server's backends and the locks they wait on
+type MonitoringHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleActivityPage(w http.ResponseWriter, r *http.Request)
+	HandleListActivity(w http.ResponseWriter, r *http.Request)
+	HandleTerminateBackend(w http.ResponseWriter, r *http.Request)
+	HandleSlowOperationsPage(w http.ResponseWriter, r *http.Request)
+	HandleListSlowOperations(w http.ResponseWriter, r *http.Request)
+	HandleSlowQueriesPage(w http.ResponseWriter, r *http.Request)
+	HandleListSlowQueries(w http.ResponseWriter, r *http.Request)
+	HandleStatsPage(w http.ResponseWriter, r *http.Request)
+	HandleServerStats(w http.ResponseWriter, r *http.Request)
+	HandleLocksPage(w http.ResponseWriter, r *http.Request)
+	HandleListLocks(w http.ResponseWriter, r *http.Request)
+}
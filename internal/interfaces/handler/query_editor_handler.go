@@ -7,5 +7,29 @@ type QueryEditorHandler interface {
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 	HandleQueryEditorPage(w http.ResponseWriter, r *http.Request)
 	HandleExecuteQuery(w http.ResponseWriter, r *http.Request)
+	HandleViewResult(w http.ResponseWriter, r *http.Request)
 	HandleExecuteMultipleQueries(w http.ResponseWriter, r *http.Request)
+	HandleLiveQuery(w http.ResponseWriter, r *http.Request)
+	HandleShareEditor(w http.ResponseWriter, r *http.Request)
+	HandleSharedEditor(w http.ResponseWriter, r *http.Request)
+	HandleSearchPath(w http.ResponseWriter, r *http.Request)
+	HandleSessionSettings(w http.ResponseWriter, r *http.Request)
+	HandleExportQuery(w http.ResponseWriter, r *http.Request)
+	HandleCancelQuery(w http.ResponseWriter, r *http.Request)
+	HandleExplainQuery(w http.ResponseWriter, r *http.Request)
+	HandleQueryChart(w http.ResponseWriter, r *http.Request)
+	HandleListSavedQueries(w http.ResponseWriter, r *http.Request)
+	HandleCreateSavedQuery(w http.ResponseWriter, r *http.Request)
+	HandleUpdateSavedQuery(w http.ResponseWriter, r *http.Request)
+	HandleDeleteSavedQuery(w http.ResponseWriter, r *http.Request)
+	HandleRunSavedQuery(w http.ResponseWriter, r *http.Request)
+	HandleExportWorkspace(w http.ResponseWriter, r *http.Request)
+	HandleImportWorkspace(w http.ResponseWriter, r *http.Request)
+	HandleListNotebooks(w http.ResponseWriter, r *http.Request)
+	HandleCreateNotebook(w http.ResponseWriter, r *http.Request)
+	HandleGetNotebook(w http.ResponseWriter, r *http.Request)
+	HandleUpdateNotebook(w http.ResponseWriter, r *http.Request)
+	HandleDeleteNotebook(w http.ResponseWriter, r *http.Request)
+	HandleRunNotebook(w http.ResponseWriter, r *http.Request)
+	HandleExportNotebook(w http.ResponseWriter, r *http.Request)
 }
@@ -0,0 +1,10 @@
+package handler
+
+import "net/http"
+
+// ReportHandler handles printable report HTTP requests
+type ReportHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleQueryReport(w http.ResponseWriter, r *http.Request)
+	HandleTableReport(w http.ResponseWriter, r *http.Request)
+}
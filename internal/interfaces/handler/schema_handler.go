@@ -0,0 +1,24 @@
+package handler
+
+import "net/http"
+
+// SchemaHandler handles table structure HTTP requests
+type SchemaHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleListIndexes(w http.ResponseWriter, r *http.Request)
+	HandleCreateIndex(w http.ResponseWriter, r *http.Request)
+	HandleDropIndex(w http.ResponseWriter, r *http.Request)
+	HandleListConstraints(w http.ResponseWriter, r *http.Request)
+	HandleAddConstraint(w http.ResponseWriter, r *http.Request)
+	HandleDropConstraint(w http.ResponseWriter, r *http.Request)
+	HandleListTriggers(w http.ResponseWriter, r *http.Request)
+	HandleTableDDL(w http.ResponseWriter, r *http.Request)
+	HandleCreateTable(w http.ResponseWriter, r *http.Request)
+	HandleAlterTable(w http.ResponseWriter, r *http.Request)
+	HandleCreateDatabase(w http.ResponseWriter, r *http.Request)
+	HandleCreateSchema(w http.ResponseWriter, r *http.Request)
+	HandleListComments(w http.ResponseWriter, r *http.Request)
+	HandleSetComment(w http.ResponseWriter, r *http.Request)
+	HandleEnableTrigger(w http.ResponseWriter, r *http.Request)
+	HandleDisableTrigger(w http.ResponseWriter, r *http.Request)
+}
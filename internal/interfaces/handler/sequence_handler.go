@@ -0,0 +1,11 @@
+package handler
+
+import "net/http"
+
+// SequenceHandler handles sequence HTTP requests
+type SequenceHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleListSequences(w http.ResponseWriter, r *http.Request)
+	HandleSetSequenceValue(w http.ResponseWriter, r *http.Request)
+	HandleRestartSequence(w http.ResponseWriter, r *http.Request)
+}
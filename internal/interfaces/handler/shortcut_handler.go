@@ -0,0 +1,11 @@
+package handler
+
+import "net/http"
+
+// ShortcutHandler handles keyboard shortcut HTTP requests
+type ShortcutHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleListShortcuts(w http.ResponseWriter, r *http.Request)
+	HandleRemapShortcut(w http.ResponseWriter, r *http.Request)
+	HandleResetShortcut(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,12 @@
+package handler
+
+import "net/http"
+
+// TableViewHandler handles saved table view HTTP requests
+type TableViewHandler interface {
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+	HandleListTableViews(w http.ResponseWriter, r *http.Request)
+	HandleSaveTableView(w http.ResponseWriter, r *http.Request)
+	HandleDeleteTableView(w http.ResponseWriter, r *http.Request)
+	HandleSetDefaultTableView(w http.ResponseWriter, r *http.Request)
+}
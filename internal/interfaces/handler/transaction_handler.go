@@ -6,10 +6,22 @@ import "net/http"
 type TransactionHandler interface {
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 	HandleStartTransaction(w http.ResponseWriter, r *http.Request)
+	HandleTableLocks(w http.ResponseWriter, r *http.Request)
 	HandleEditCell(w http.ResponseWriter, r *http.Request)
+	HandleEditJSONCell(w http.ResponseWriter, r *http.Request)
+	HandleCellValueOptions(w http.ResponseWriter, r *http.Request)
+	HandleRestoreCell(w http.ResponseWriter, r *http.Request)
 	HandleDeleteRow(w http.ResponseWriter, r *http.Request)
+	HandleDeleteRows(w http.ResponseWriter, r *http.Request)
+	HandleUpdateRows(w http.ResponseWriter, r *http.Request)
 	HandleInsertRow(w http.ResponseWriter, r *http.Request)
+	HandleNewRowDefaults(w http.ResponseWriter, r *http.Request)
 	HandleCommitTransaction(w http.ResponseWriter, r *http.Request)
+	HandleApproveTransaction(w http.ResponseWriter, r *http.Request)
 	HandleRollbackTransaction(w http.ResponseWriter, r *http.Request)
 	HandleGetTransactionStatus(w http.ResponseWriter, r *http.Request)
+	HandleExportTransaction(w http.ResponseWriter, r *http.Request)
+	HandleRestoreDeletedRows(w http.ResponseWriter, r *http.Request)
+	HandleTransactionHeartbeat(w http.ResponseWriter, r *http.Request)
+	HandleTransactionEvents(w http.ResponseWriter, r *http.Request)
 }
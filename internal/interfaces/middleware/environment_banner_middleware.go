@@ -0,0 +1,10 @@
+package middleware
+
+import "net/http"
+
+// EnvironmentBannerMiddleware marks every page with the environment the instance serves
+type EnvironmentBannerMiddleware interface {
+	// RenderBanner adds the configured environment's colored banner to full HTML pages and prefixes
+	// their titles with its name; fragments, JSON, streams and downloads pass through untouched
+	RenderBanner(next http.Handler) http.Handler
+}
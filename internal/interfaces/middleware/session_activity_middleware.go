@@ -0,0 +1,10 @@
+package middleware
+
+import "net/http"
+
+// SessionActivityMiddleware keeps idle session timeouts sliding with user activity
+type SessionActivityMiddleware interface {
+	// TrackActivity records activity on the request's session and reissues its cookie with a fresh
+	// idle lifetime, or clears the cookie once the session has been destroyed for idling
+	TrackActivity(next http.Handler) http.Handler
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// AuditRepository defines operations for storing audit entries and the rows commits deleted
+type AuditRepository interface {
+	// RecordEntry stores an audit entry, assigning its ID and timestamp when missing
+	RecordEntry(ctx context.Context, entry *domain.AuditEntry) error
+
+	// GetEntries retrieves audit entries matching the filter, newest first
+	GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+
+	// RecordDeletedRows keeps the rows a commit deleted under its audit entry until rows.ExpiresAt
+	RecordDeletedRows(ctx context.Context, rows *domain.DeletedRows) error
+
+	// GetDeletedRows retrieves the rows kept for an audit entry, returning ErrDeletedRowsNotFound once
+	// they have expired
+	GetDeletedRows(ctx context.Context, entryID string) (*domain.DeletedRows, error)
+}
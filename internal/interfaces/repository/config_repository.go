@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// ConfigRepository defines operations for runtime application settings
+type ConfigRepository interface {
+	// GetConfig retrieves the current application settings
+	GetConfig(ctx context.Context) (*domain.AppConfig, error)
+
+	// UpdateConfig replaces the application settings
+	UpdateConfig(ctx context.Context, config *domain.AppConfig) error
+}
@@ -3,30 +3,102 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"io"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 // DatabaseRepository defines operations for database connections
 type DatabaseRepository interface {
-	// Connect establishes a connection to a PostgreSQL database
+	// Connect establishes the shared connection to a PostgreSQL database, replacing the one the repository
+	// was built with; its connections count against the database's connection cap
 	Connect(ctx context.Context, connString string) error
 
 	// Disconnect closes the database connection
 	Disconnect(ctx context.Context) error
 
-	// TestConnection verifies connectivity to a database
+	// TestConnection verifies connectivity to a database; rejected credentials wrap
+	// domain.ErrInvalidCredentials, a server that is down or out of connections wraps
+	// domain.ErrDatabaseUnavailable, and a database whose connection cap stays reached fails with
+	// domain.ErrServerBusy
 	TestConnection(ctx context.Context, connString string) error
 
 	// GetConnection returns the active database connection
 	GetConnection() *sql.DB
 
+	// SessionPool returns the connection pool for the session under key, opening it with connString on
+	// first use and pinging it again once the configured health check period has passed
+	SessionPool(ctx context.Context, key, connString string) (*sql.DB, error)
+
+	// LookupSessionPool returns the open connection pool of the session under key, if there is one
+	LookupSessionPool(key string) (*sql.DB, bool)
+
+	// CloseSessionPool closes the connection pool and releases the snapshot of the session under key,
+	// if they are open
+	CloseSessionPool(key string) error
+
+	// OpenSnapshot opens a read-only REPEATABLE READ transaction under key for GetTableData to read
+	// through, returning when its snapshot was taken; an open snapshot under key is kept
+	OpenSnapshot(ctx context.Context, key string) (time.Time, error)
+
+	// SnapshotTakenAt reports when the snapshot under key was taken, if one is open
+	SnapshotTakenAt(key string) (time.Time, bool)
+
+	// ReleaseSnapshot ends the snapshot under key, if one is open
+	ReleaseSnapshot(key string) error
+
+	// SetPoolConfig sets the limits applied to session pools opened afterwards; its per-database
+	// connection caps take effect at once for connections opened from then on
+	SetPoolConfig(config domain.ConnectionPoolConfig)
+
+	// SetApplicationNamePrefix sets the prefix of the application_name given to connections opened
+	// afterwards, the shared one Connect opens included; empty restores DefaultApplicationNamePrefix
+	SetApplicationNamePrefix(prefix string)
+
 	// ExecuteQuery executes a SQL query and returns results
 	ExecuteQuery(ctx context.Context, query string, args ...interface{}) (*domain.QueryResult, error)
 
 	// ExecuteQueryWithPagination executes a query with pagination
 	ExecuteQueryWithPagination(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error)
 
+	// ExecuteTrackedQuery executes a query on a dedicated connection whose backend PID is registered
+	// under key while it runs
+	ExecuteTrackedQuery(ctx context.Context, key, query string, args ...interface{}) (*domain.QueryResult, error)
+
+	// StreamQuery runs a query narrowed by the params' WHERE clause and sort, handing the columns and
+	// then each row to the callbacks as they are read instead of buffering the result
+	StreamQuery(ctx context.Context, params domain.QueryParams, onColumns func(columns []string) error, onRow func(values []interface{}) error) error
+
+	// CopyQueryCSV writes the query, narrowed like StreamQuery, to w as CSV with a header using
+	// COPY TO STDOUT, registering the backend PID under the params' TrackingKey; it returns the rows
+	// written, or domain.ErrCopyUnavailable before writing anything when no COPY connection can be opened
+	CopyQueryCSV(ctx context.Context, params domain.QueryParams, w io.Writer) (int64, error)
+
+	// InstallChangeTrigger installs a statement-level trigger, and its trigger function in the table's
+	// schema, that NOTIFYs channel with the operation after every write to the table
+	InstallChangeTrigger(ctx context.Context, channel, schema, table string) error
+
+	// RemoveChangeTrigger drops the trigger and trigger function installed for channel, if present
+	RemoveChangeTrigger(ctx context.Context, channel, schema, table string) error
+
+	// RemoveStaleChangeTriggers drops the change triggers and trigger functions in the database that
+	// no watch is listening on any more, such as those left by a process that exited mid-watch, and
+	// returns how many it dropped; ones the connection may not drop are skipped
+	RemoveStaleChangeTriggers(ctx context.Context) (int, error)
+
+	// ListenNotifications LISTENs on channel over a dedicated connection, handing each payload to
+	// onNotify until ctx is done or onNotify fails. The connection holds an advisory lock on the channel
+	// meanwhile, which is how RemoveStaleChangeTriggers tells a channel is still in use.
+	ListenNotifications(ctx context.Context, channel string, onNotify func(payload string) error) error
+
+	// CancelTrackedQuery issues pg_cancel_backend for the query running under key, reporting whether one was running
+	CancelTrackedQuery(ctx context.Context, key string) (bool, error)
+
+	// ExplainQuery returns the plan of a single statement; with analyze the statement is executed
+	// inside a transaction that is always rolled back
+	ExplainQuery(ctx context.Context, query string, analyze bool) (*domain.QueryPlan, error)
+
 	// ExecuteMultipleQueries executes multiple SQL queries separated by semicolons
 	ExecuteMultipleQueries(ctx context.Context, queries string) ([]domain.QueryResult, error)
 
@@ -51,21 +123,123 @@ type DatabaseRepository interface {
 	// GetTableMetadata retrieves detailed metadata for a table
 	GetTableMetadata(ctx context.Context, database, schema, table string) (*domain.TableMetadata, error)
 
+	// GetCheckConstraints lists the table's CHECK constraints with their definitions as PostgreSQL prints them
+	GetCheckConstraints(ctx context.Context, database, schema, table string) ([]domain.CheckConstraint, error)
+
+	// ListTableIndexes lists a table's indexes with their definitions, sizes, scan counts and the
+	// constraints they back
+	ListTableIndexes(ctx context.Context, database, schema, table string) ([]domain.TableIndex, error)
+
+	// GetTableDefinition reads a table's columns as CREATE TABLE declares them, with its table and
+	// column comments; it returns ErrTableNotFound for a missing table
+	GetTableDefinition(ctx context.Context, database, schema, table string) (*domain.TableDefinition, error)
+
+	// ListTableConstraints lists a table's primary key, unique, check and foreign key constraints with
+	// their columns and definitions, primary key first
+	ListTableConstraints(ctx context.Context, database, schema, table string) ([]domain.TableConstraint, error)
+
+	// GetColumnDependents lists the views, indexes, constraints and triggers PostgreSQL records as
+	// depending on a column, and the functions whose source mentions both the column and its table
+	GetColumnDependents(ctx context.Context, database, schema, table, column string) ([]domain.ColumnDependent, error)
+
+	// ListTableTriggers lists a table's user-defined triggers, leaving out the internal ones backing
+	// foreign keys
+	ListTableTriggers(ctx context.Context, database, schema, table string) ([]domain.TableTrigger, error)
+
+	// ListSequences lists a schema's sequences with their settings, last values and the columns that
+	// own them, by name
+	ListSequences(ctx context.Context, database, schema string) ([]domain.Sequence, error)
+
+	// ListFunctions lists a schema's functions and procedures with their signatures, languages and
+	// parameters, by name and then signature; aggregates and window functions are left out
+	ListFunctions(ctx context.Context, database, schema string) ([]domain.StoredFunction, error)
+
+	// GetFunctionDefinition returns the CREATE statement of the routine with the identity signature
+	// arguments, as pg_get_functiondef prints it, or ErrFunctionNotFound
+	GetFunctionDefinition(ctx context.Context, database, schema, name, arguments string) (string, error)
+
+	// GetTableLocks lists the locks other sessions hold or await on a table, with the activity of each
+	// holding session, oldest transaction first
+	GetTableLocks(ctx context.Context, database, schema, table string) ([]domain.TableLock, error)
+
 	// GetDatabaseMetadata retrieves complete metadata for a database
 	GetDatabaseMetadata(ctx context.Context, database string) (*domain.DatabaseMetadata, error)
 
 	// GetTableData retrieves data from a table with optional filtering and pagination
 	GetTableData(ctx context.Context, params domain.TableDataParams) (*domain.QueryResult, error)
 
-	// InsertRow inserts a new row into a table
+	// InsertRow inserts a new row into a table; domain.EncryptedValue values are stored via pgp_sym_encrypt
 	InsertRow(ctx context.Context, database, schema, table string, values map[string]interface{}) error
 
-	// UpdateRow updates a row in a table
+	// GenerateUUID returns a random UUID from the server's gen_random_uuid()
+	GenerateUUID(ctx context.Context) (string, error)
+
+	// PreviewColumnDefaults evaluates the defaults of a table's columns in a read-only transaction, giving
+	// sequence-backed columns a next value hint without advancing the sequence
+	PreviewColumnDefaults(ctx context.Context, database, schema, table string) (map[string]domain.ColumnDefaultPreview, error)
+
+	// UpsertRow inserts a buffered row with its ON CONFLICT clause inside tx, reporting whether a row
+	// was written; domain.EncryptedValue values are stored via pgp_sym_encrypt
+	UpsertRow(ctx context.Context, tx *sql.Tx, database, schema, table string, insert domain.RowInsert) (bool, error)
+
+	// CopyRows loads rows with COPY FROM in a single transaction on a connection whose backend PID is
+	// registered under key, so the load can be cancelled
+	CopyRows(ctx context.Context, key, schema, table string, columns []string, rows [][]interface{}) (int64, error)
+
+	// UpdateRow updates a row in a table; domain.EncryptedValue values are stored via pgp_sym_encrypt
 	UpdateRow(ctx context.Context, database, schema, table string, pkValues map[string]interface{}, values map[string]interface{}) error
 
+	// UpdateRowsByFilter sets a column on every row matching the update's filter in a single
+	// parameterized UPDATE inside tx, failing unless exactly ExpectedCount rows change; the caller
+	// rolls tx back on failure. A domain.EncryptedValue value is stored via pgp_sym_encrypt
+	UpdateRowsByFilter(ctx context.Context, tx *sql.Tx, update domain.BulkUpdate) (int64, error)
+
+	// DeleteRowsByKey deletes the row of each primary key tuple inside tx with a parameterized DELETE,
+	// failing unless every tuple matches exactly one row; it returns the deleted rows as they were
+	DeleteRowsByKey(ctx context.Context, tx *sql.Tx, database, schema, table string, keys []map[string]interface{}) ([]map[string]interface{}, error)
+
 	// DeleteRow deletes a row from a table
 	DeleteRow(ctx context.Context, database, schema, table string, pkValues map[string]interface{}) error
 
 	// GetRowCount retrieves the total count of rows in a table (with optional WHERE clause)
 	GetRowCount(ctx context.Context, database, schema, table, whereClause string) (int64, error)
+
+	// GetColumnAggregate computes COUNT, SUM, AVG, MIN and MAX of a column (with optional WHERE clause)
+	GetColumnAggregate(ctx context.Context, database, schema, table, column, whereClause string) (*domain.ColumnAggregate, error)
+
+	// GetGroupedCounts groups rows by a column and returns per-group counts (and optional aggregate)
+	GetGroupedCounts(ctx context.Context, params domain.GroupByParams) ([]domain.GroupByBucket, error)
+
+	// GetColumnHistogram counts rows per equal-width bucket of a numeric or temporal column
+	GetColumnHistogram(ctx context.Context, params domain.HistogramParams) ([]domain.HistogramBucket, error)
+
+	// FindDuplicateRows returns groups of rows sharing identical values in the given columns
+	FindDuplicateRows(ctx context.Context, params domain.DuplicateParams) ([]domain.DuplicateGroup, error)
+
+	// GetColumnQualityStats counts NULL and empty values per column over a sample of rows
+	GetColumnQualityStats(ctx context.Context, database, schema, table string, columns []string, sampleLimit int) (*domain.DataQualityReport, error)
+
+	// GetTableStatistics reads a table's pg_stat_user_tables and pg_stat_user_indexes counters with
+	// the page, row and width figures its bloat is estimated from
+	GetTableStatistics(ctx context.Context, database, schema, table string) (*domain.TableStatistics, error)
+
+	// GetTableSizeEstimates reads the planner's row estimate and the total size of each of the schema's
+	// listed tables that exists, in the order given
+	GetTableSizeEstimates(ctx context.Context, database, schema string, tables []string) ([]domain.TableSizeEstimate, error)
+
+	// FindOrphanedRows returns rows whose reference column points to a missing parent row
+	FindOrphanedRows(ctx context.Context, params domain.OrphanCheckParams) (*domain.OrphanedReference, error)
+
+	// SearchReferencedRows returns the key and display columns of the rows whose key or display columns
+	// contain the term, ignoring case
+	SearchReferencedRows(ctx context.Context, params domain.ForeignKeyLookupParams) (*domain.QueryResult, error)
+
+	// GetJoinedTableData retrieves a page of a two-table join (with optional WHERE, ORDER BY)
+	GetJoinedTableData(ctx context.Context, params domain.JoinViewParams) (*domain.QueryResult, error)
+
+	// GetTableDataAsOf retrieves the rows of a history-tracked table as they were at the given time
+	GetTableDataAsOf(ctx context.Context, history domain.HistoryTableConfig, asOf time.Time, offset, limit int) (*domain.QueryResult, error)
+
+	// GetRowHistory retrieves every recorded version of a row, oldest first
+	GetRowHistory(ctx context.Context, history domain.HistoryTableConfig, pkValues map[string]interface{}) (*domain.QueryResult, error)
 }
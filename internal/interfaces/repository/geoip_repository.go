@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// GeoIPRepository locates client addresses in a local GeoIP database
+type GeoIPRepository interface {
+	// LookupLocation returns the location of ip in the database at databasePath, nil when the database
+	// does not cover the address. The database is a CSV file of "network,country_code,country,region,city"
+	// rows keyed by CIDR network; the most specific network holding the address wins.
+	LookupLocation(ctx context.Context, databasePath, ip string) (*domain.GeoLocation, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// LDAPAuthenticator validates login credentials against an LDAP directory
+type LDAPAuthenticator interface {
+	// Authenticate binds as the user's entry with password and returns the entry, or
+	// domain.ErrInvalidCredentials when the user is unknown or the password is wrong
+	Authenticate(ctx context.Context, username, password string) (*domain.LDAPEntry, error)
+}
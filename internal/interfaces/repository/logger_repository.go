@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 // LoggerRepository defines operations for logging
@@ -24,6 +26,13 @@ type LoggerRepository interface {
 	// LogQueryExecution logs a query execution
 	LogQueryExecution(ctx context.Context, username string, query string, executionTimeMs int64, success bool, err error) error
 
+	// LogSlowQuery keeps a slow query in the slow-query log, assigning its ID and time when missing; only
+	// the latest SlowQueryLogSize queries are kept
+	LogSlowQuery(ctx context.Context, query *domain.SlowQuery) error
+
+	// GetSlowQueries retrieves up to limit slow queries, newest first; zero or less returns all kept
+	GetSlowQueries(ctx context.Context, limit int) ([]domain.SlowQuery, error)
+
 	// LogTransactionEvent logs a transaction event
 	LogTransactionEvent(ctx context.Context, username string, eventType string, details map[string]interface{}) error
 }
@@ -0,0 +1,16 @@
+package repository
+
+import "context"
+
+// MigrationRepository creates and upgrades the schema holding lumen-pg's own tables
+type MigrationRepository interface {
+	// CurrentVersion returns the version of the newest applied migration, or 0 before any has run
+	CurrentVersion(ctx context.Context) (int, error)
+
+	// LatestVersion returns the version of the newest migration shipped with this build
+	LatestVersion() int
+
+	// Migrate applies pending migrations in order, each in its own transaction, and returns the versions
+	// it applied; a schema already newer than this build fails with ErrAppSchemaTooNew
+	Migrate(ctx context.Context) ([]int, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MonitoringRepository defines read and control operations over the server's backends
+type MonitoringRepository interface {
+	// GetBackendActivity lists the client backends other than the caller's own, longest running query first
+	GetBackendActivity(ctx context.Context) ([]domain.BackendActivity, error)
+
+	// GetLockWaits lists every backend waiting on another's lock, once per backend it waits on
+	GetLockWaits(ctx context.Context) ([]domain.LockWait, error)
+
+	// GetServerStats samples pg_stat_database with each database's size, and pg_stat_bgwriter
+	GetServerStats(ctx context.Context) (*domain.ServerStats, error)
+
+	// TerminateBackend ends a backend with pg_terminate_backend, reporting whether one was signalled
+	TerminateBackend(ctx context.Context, pid int) (bool, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// NotebookRepository defines operations for storing query notebooks keyed by PostgreSQL username
+type NotebookRepository interface {
+	// CreateNotebook stores a new notebook, assigning its ID and timestamps
+	CreateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error
+
+	// GetNotebook retrieves a notebook owned by the user
+	GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error)
+
+	// ListNotebooks retrieves all notebooks owned by the user, ordered by title
+	ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error)
+
+	// UpdateNotebook replaces an existing notebook owned by the same user, cells and saved results included
+	UpdateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error
+
+	// DeleteNotebook removes a notebook owned by the user
+	DeleteNotebook(ctx context.Context, username, id string) error
+}
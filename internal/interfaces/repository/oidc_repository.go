@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// OIDCRepository talks to the OpenID Connect provider used for single sign-on
+type OIDCRepository interface {
+	// AuthCodeURL returns the provider's authorization URL for an authorization code login
+	AuthCodeURL(ctx context.Context, state, nonce string) (string, error)
+
+	// ExchangeCode redeems an authorization code and returns the identity of its verified ID token
+	ExchangeCode(ctx context.Context, code, nonce string) (*domain.OIDCIdentity, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import "context"
+
+// PreferenceRepository defines operations for storing per-user settings keyed by PostgreSQL username
+type PreferenceRepository interface {
+	// GetPreferences returns every preference the user has set; a user with none gets an empty map
+	GetPreferences(ctx context.Context, username string) (map[string]string, error)
+
+	// SetPreference stores value under key for the user, replacing any earlier value
+	SetPreference(ctx context.Context, username, key, value string) error
+
+	// DeletePreference removes the user's preference; removing one never set is not an error
+	DeletePreference(ctx context.Context, username, key string) error
+}
@@ -11,6 +11,13 @@ type RBACRepository interface {
 	// GetUserRole returns the role/username of an authenticated user
 	GetUserRole(ctx context.Context, username string) (string, error)
 
+	// IsSuperuser reports whether a role is a PostgreSQL superuser; unknown roles are not
+	IsSuperuser(ctx context.Context, role string) (bool, error)
+
+	// CreateRole creates a login role with the given password; it returns ErrRoleExists when the name
+	// is taken
+	CreateRole(ctx context.Context, role domain.NewRole) error
+
 	// GetAllRoles retrieves all PostgreSQL roles in the instance
 	GetAllRoles(ctx context.Context) ([]string, error)
 
@@ -35,6 +42,17 @@ type RBACRepository interface {
 	// HasSchemaUsagePermission checks if a role can USE a schema
 	HasSchemaUsagePermission(ctx context.Context, role, database, schema string) (bool, error)
 
+	// HasSchemaCreatePermission checks if a role may CREATE tables in a schema
+	HasSchemaCreatePermission(ctx context.Context, role, database, schema string) (bool, error)
+
+	// CanCreateDatabase checks if a role may CREATE DATABASE, as superusers and CREATEDB roles can;
+	// unknown roles cannot
+	CanCreateDatabase(ctx context.Context, role string) (bool, error)
+
+	// HasDatabaseCreatePermission checks if a role may CREATE schemas in a database; an unknown
+	// database grants nothing
+	HasDatabaseCreatePermission(ctx context.Context, role, database string) (bool, error)
+
 	// GetAccessibleDatabases returns all databases accessible by a role
 	GetAccessibleDatabases(ctx context.Context, role string) ([]string, error)
 
@@ -50,6 +68,18 @@ type RBACRepository interface {
 	// GetRoleMetadata returns complete metadata for a role including all accessible resources
 	GetRoleMetadata(ctx context.Context, role string) (*domain.RoleMetadata, error)
 
+	// IsTableOwner checks if a role owns a table, directly or through role membership, as creating
+	// and dropping its indexes requires
+	IsTableOwner(ctx context.Context, role, database, schema, table string) (bool, error)
+
+	// GetSequencePermissions returns a role's privileges on a sequence, counting ownership through
+	// role membership; an unknown sequence grants nothing
+	GetSequencePermissions(ctx context.Context, role, database, schema, sequence string) (*domain.SequencePermissions, error)
+
+	// HasFunctionExecutePermission checks if a role can EXECUTE the routine with the identity signature
+	// arguments; an unknown routine cannot be executed
+	HasFunctionExecutePermission(ctx context.Context, role, database, schema, name, arguments string) (bool, error)
+
 	// IsReadOnlyRole checks if a role has read-only access (SELECT only)
 	IsReadOnlyRole(ctx context.Context, role, database, schema, table string) (bool, error)
 
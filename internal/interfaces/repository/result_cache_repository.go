@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// ResultCacheRepository defines operations for keeping editor results to re-sort and filter
+type ResultCacheRepository interface {
+	// StoreResult keeps a result, assigning its ID and time when missing; only the latest
+	// ResultCachePerUser results of each user are kept, each for ResultCacheTTL
+	StoreResult(ctx context.Context, result *domain.CachedResult) error
+
+	// GetResult retrieves a kept result, returning ErrResultNotFound once it is dropped or expired
+	GetResult(ctx context.Context, id string) (*domain.CachedResult, error)
+}
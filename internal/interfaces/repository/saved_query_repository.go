@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// SavedQueryRepository defines operations for storing saved queries keyed by PostgreSQL username
+type SavedQueryRepository interface {
+	// CreateSavedQuery stores a new saved query, assigning its ID and timestamps
+	CreateSavedQuery(ctx context.Context, query *domain.SavedQuery) error
+
+	// GetSavedQuery retrieves a saved query owned by the user
+	GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error)
+
+	// ListSavedQueries retrieves all saved queries owned by the user, ordered by name
+	ListSavedQueries(ctx context.Context, username string) ([]domain.SavedQuery, error)
+
+	// UpdateSavedQuery replaces an existing saved query owned by the same user
+	UpdateSavedQuery(ctx context.Context, query *domain.SavedQuery) error
+
+	// DeleteSavedQuery removes a saved query owned by the user
+	DeleteSavedQuery(ctx context.Context, username, id string) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+)
+
+// SecretRepository resolves secret-provider references, so configuration can name a secret without
+// holding it
+type SecretRepository interface {
+	// ResolveSecret returns the secret a reference such as "env:NAME" or "file:/run/secrets/name" points
+	// to; it fails for an unknown provider or a secret that is not there
+	ResolveSecret(ctx context.Context, reference string) (string, error)
+}
@@ -26,6 +26,9 @@ type SessionRepository interface {
 	// GetSessionByUsername retrieves the most recent session for a user
 	GetSessionByUsername(ctx context.Context, username string) (*domain.Session, error)
 
+	// ListSessions returns every unexpired session, oldest first
+	ListSessions(ctx context.Context) ([]*domain.Session, error)
+
 	// InvalidateUserSessions invalidates all sessions for a user
 	InvalidateUserSessions(ctx context.Context, username string) error
 
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// SessionStore is a durable key-value store with per-key expiry. It lets sessions and transaction buffers
+// outlive the process on deployments without external infrastructure.
+type SessionStore interface {
+	// Put stores value under key in bucket until expiresAt; a zero expiresAt never expires
+	Put(ctx context.Context, bucket, key string, value []byte, expiresAt time.Time) error
+
+	// Get returns the value under key in bucket, or domain.ErrStoreKeyNotFound when missing or expired
+	Get(ctx context.Context, bucket, key string) ([]byte, error)
+
+	// Delete removes key from bucket; deleting a missing key is not an error
+	Delete(ctx context.Context, bucket, key string) error
+
+	// Keys lists the unexpired keys of bucket in sorted order
+	Keys(ctx context.Context, bucket string) ([]string, error)
+
+	// Close flushes and releases the store
+	Close() error
+}
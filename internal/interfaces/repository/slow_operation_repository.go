@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// SlowOperationRepository defines operations for the log of slow operations
+type SlowOperationRepository interface {
+	// RecordSlowOperation stores a slow operation, assigning its ID and time when missing; only the
+	// latest SlowOperationLogSize operations are kept
+	RecordSlowOperation(ctx context.Context, operation *domain.SlowOperation) error
+
+	// GetSlowOperations retrieves up to limit slow operations, newest first; zero or less returns all kept
+	GetSlowOperations(ctx context.Context, limit int) ([]domain.SlowOperation, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// TableSnapshotRepository defines operations for keeping table snapshots to compare tables against
+type TableSnapshotRepository interface {
+	// StoreSnapshot keeps a snapshot, assigning its ID and time when missing; only the latest
+	// TableSnapshotPerUser snapshots of each user are kept, each for TableSnapshotTTL
+	StoreSnapshot(ctx context.Context, snapshot *domain.TableSnapshot) error
+
+	// GetSnapshot retrieves one of the user's snapshots, returning ErrTableSnapshotNotFound once it
+	// is dropped or expired
+	GetSnapshot(ctx context.Context, username, id string) (*domain.TableSnapshot, error)
+
+	// ListSnapshots retrieves the user's kept snapshots, newest first
+	ListSnapshots(ctx context.Context, username string) ([]domain.TableSnapshot, error)
+
+	// DeleteSnapshot removes one of the user's snapshots
+	DeleteSnapshot(ctx context.Context, username, id string) error
+}
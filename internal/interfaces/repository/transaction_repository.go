@@ -29,6 +29,12 @@ type TransactionRepository interface {
 	// AddRowDelete buffers a row deletion in a transaction
 	AddRowDelete(ctx context.Context, transactionID string, rowIndex int) error
 
+	// AddRowKeyDeletes buffers deletions of rows identified by primary key in a transaction
+	AddRowKeyDeletes(ctx context.Context, transactionID string, keys []map[string]interface{}) error
+
+	// AddBulkUpdate buffers a filter-based column update in a transaction
+	AddBulkUpdate(ctx context.Context, transactionID string, update domain.BulkUpdate) error
+
 	// AddRowInsert buffers a new row insertion in a transaction
 	AddRowInsert(ctx context.Context, transactionID string, insert domain.RowInsert) error
 
@@ -38,6 +44,12 @@ type TransactionRepository interface {
 	// GetRowDeletes retrieves all buffered deletions for a transaction
 	GetRowDeletes(ctx context.Context, transactionID string) ([]int, error)
 
+	// GetRowKeyDeletes retrieves all buffered primary key deletions for a transaction
+	GetRowKeyDeletes(ctx context.Context, transactionID string) ([]map[string]interface{}, error)
+
+	// GetBulkUpdates retrieves all buffered filter-based updates for a transaction
+	GetBulkUpdates(ctx context.Context, transactionID string) ([]domain.BulkUpdate, error)
+
 	// GetRowInserts retrieves all buffered insertions for a transaction
 	GetRowInserts(ctx context.Context, transactionID string) ([]domain.RowInsert, error)
 
@@ -55,4 +67,7 @@ type TransactionRepository interface {
 
 	// InvalidateExpiredTransactions removes all expired transactions
 	InvalidateExpiredTransactions(ctx context.Context) error
+
+	// ListTransactions returns every user's open transaction
+	ListTransactions(ctx context.Context) ([]*domain.TransactionState, error)
 }
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// AuditUseCase defines operations over the append-only log of changes made through the application
+type AuditUseCase interface {
+	// RecordAction appends an entry for a data-mutating action, stamping it with the current time;
+	// entries are never updated or removed
+	RecordAction(ctx context.Context, entry *domain.AuditEntry) error
+
+	// RecordLogin appends a login entry for the user, located through the configured GeoIP database
+	// when there is one and flagged when the location is new for the user
+	RecordLogin(ctx context.Context, username, clientIP string) (*domain.LoginEvent, error)
+
+	// ListAccountActivity returns the user's own recent logins, queries and exports, so anyone can see
+	// what was done under their credentials
+	ListAccountActivity(ctx context.Context, username string) (*domain.AccountActivity, error)
+
+	// ListEntries returns the entries matching the filter, newest first; superusers only
+	ListEntries(ctx context.Context, adminUsername string, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+
+	// ExportEntries renders the entries matching the filter as a CSV or JSON file; superusers only
+	ExportEntries(ctx context.Context, adminUsername string, filter domain.AuditFilter, format string) (*domain.AuditExport, error)
+}
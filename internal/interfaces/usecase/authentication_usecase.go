@@ -11,16 +11,32 @@ type AuthenticationUseCase interface {
 	// ValidateLoginForm validates login form input (username and password)
 	ValidateLoginForm(ctx context.Context, req domain.LoginRequest) ([]domain.ValidationError, error)
 
-	// ProbeConnection tests if a user can connect to their first accessible database
-	ProbeConnection(ctx context.Context, username, password string) (bool, error)
+	// ProbeConnection tests if a user can connect to the server of the named connection profile; an empty
+	// profile name uses the default profile. A rejected login fails with domain.ErrInvalidCredentials and a
+	// server that cannot take the connection with domain.ErrDatabaseUnavailable.
+	ProbeConnection(ctx context.Context, profileName, username, password string) (bool, error)
+
+	// ResolveLoginRole returns the PostgreSQL role a login acts as: the login name itself, or with the LDAP
+	// backend the role mapped from the user's directory entry once the password is verified
+	ResolveLoginRole(ctx context.Context, username, password string) (string, error)
+
+	// BindLoginConnection returns ctx bound to a connection on the named profile's server with the login's
+	// credentials, so the resources discovered before the session exists are the ones that server grants
+	BindLoginConnection(ctx context.Context, profileName, username, password string) (context.Context, error)
 
 	// Login authenticates a user with their PostgreSQL credentials
 	Login(ctx context.Context, req domain.LoginRequest) (*domain.LoginResponse, error)
 
-	// CreateSession creates a new session after successful authentication
-	CreateSession(ctx context.Context, username, password, database, schema, table string) (*domain.Session, error)
+	// CreateSession creates a new session after successful authentication, connected to the server of the
+	// named connection profile
+	CreateSession(ctx context.Context, profileName, username, password, database, schema, table string) (*domain.Session, error)
+
+	// ListConnectionProfiles lists the servers offered at login, the default first
+	ListConnectionProfiles(ctx context.Context) ([]domain.ConnectionProfile, error)
 
-	// GetUserAccessibleResources returns databases, schemas, and tables accessible by a user
+	// GetUserAccessibleResources returns databases, schemas, and tables accessible by a user. Uncached
+	// metadata is discovered with per-query timeouts and may come back partial, its Loading stage still
+	// being listed in the background.
 	GetUserAccessibleResources(ctx context.Context, username string) (*domain.RoleMetadata, error)
 
 	// GetFirstAccessibleDatabase returns the first database accessible by a user
@@ -35,6 +51,25 @@ type AuthenticationUseCase interface {
 	// ValidateSession validates if a session is still active and not expired
 	ValidateSession(ctx context.Context, sessionID string) (*domain.Session, error)
 
+	// BindSessionConnection returns ctx bound to the session's connection pool, reopening the pool with the
+	// session's credentials when it was closed, so the queries the request runs go to the session's
+	// server as its user
+	BindSessionConnection(ctx context.Context, session *domain.Session) (context.Context, error)
+
+	// SetSessionSearchPath replaces the session's search_path after checking every schema is accessible
+	SetSessionSearchPath(ctx context.Context, sessionID string, schemas []string) (*domain.Session, error)
+
+	// SetSessionSettings replaces the session's GUC presets after checking each is one PostgreSQL accepts;
+	// zero values restore the server defaults
+	SetSessionSettings(ctx context.Context, sessionID string, settings domain.SessionSettings) (*domain.Session, error)
+
+	// TouchSession records activity on a session and, when given, the client address it is used from
+	TouchSession(ctx context.Context, sessionID, clientIP string) error
+
+	// ExpireIdleSessions destroys every session idle past the configured timeout, rolling back the open
+	// transactions they leave behind, and returns how many were destroyed
+	ExpireIdleSessions(ctx context.Context) (int, error)
+
 	// RefreshSession extends a session's expiration time
 	RefreshSession(ctx context.Context, sessionID string) (*domain.Session, error)
 
@@ -47,6 +82,13 @@ type AuthenticationUseCase interface {
 	// IsUserAuthenticated checks if a user has a valid session
 	IsUserAuthenticated(ctx context.Context, sessionID string) (bool, error)
 
+	// BeginSSOLogin starts an OIDC login and returns the provider URL to send the user to
+	BeginSSOLogin(ctx context.Context) (*domain.SSOAuthRequest, error)
+
+	// CompleteSSOLogin verifies the provider's callback, maps the identity to a PostgreSQL role through the
+	// role-mapping table and creates a session for that role
+	CompleteSSOLogin(ctx context.Context, code, nonce string) (*domain.Session, error)
+
 	// ReAuthenticateWithPassword re-authenticates a user using their stored password cookie
-	ReAuthenticateWithPassword(ctx context.Context, username, encryptedPassword string) (bool, error)
+	ReAuthenticateWithPassword(ctx context.Context, profileName, username, encryptedPassword string) (bool, error)
 }
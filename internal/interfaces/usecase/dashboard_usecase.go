@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// DashboardUseCase defines operations for the saved query results each user pins to their home dashboard
+type DashboardUseCase interface {
+	// ListDashboardTiles returns the user's tiles in the order they were pinned
+	ListDashboardTiles(ctx context.Context, username string) ([]domain.DashboardTile, error)
+
+	// PinQueryResult pins one of the user's saved queries to their dashboard as a row count or scalar
+	// tile, assigning its ID; the refresh interval is kept within the live refresh bounds
+	PinQueryResult(ctx context.Context, username string, tile domain.DashboardTile) (*domain.DashboardTile, error)
+
+	// UnpinDashboardTile removes a tile from the user's dashboard
+	UnpinDashboardTile(ctx context.Context, username, tileID string) error
+
+	// RefreshDashboardTile re-runs the tile's saved query and summarizes its result
+	RefreshDashboardTile(ctx context.Context, username, tileID string) (*domain.DashboardTileResult, error)
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
@@ -11,18 +13,44 @@ type DataViewUseCase interface {
 	// LoadTableData loads data from a table with optional filtering and pagination
 	LoadTableData(ctx context.Context, username string, params domain.TableDataParams) (*domain.QueryResult, error)
 
+	// WatchTableData loads a page of table data now and again every interval, held to the server's
+	// minimum, passing each result to onUpdate until ctx is done or onUpdate fails
+	WatchTableData(ctx context.Context, username string, params domain.TableDataParams, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error
+
+	// WatchRows reports inserts, updates and deletes of the rows matching a filter until ctx is done or
+	// onEvent fails. Notify mode wakes on a temporary NOTIFY trigger, removed when the watch ends, and
+	// falls back to checking every interval when the trigger cannot be installed or listened to.
+	WatchRows(ctx context.Context, username string, params domain.RowWatchParams, onEvent func(*domain.RowWatchEvent) error) error
+
 	// GetTableDataWithCursorPagination loads table data with cursor-based pagination
 	GetTableDataWithCursorPagination(ctx context.Context, username, database, schema, table, cursor string, limit int) (*domain.QueryResult, error)
 
 	// FilterTableData filters table data with a WHERE clause
 	FilterTableData(ctx context.Context, username, database, schema, table, whereClause string, offset, limit int) (*domain.QueryResult, error)
 
+	// FilterTableDataByPredicates filters table data with structured predicates, compiled into a WHERE
+	// clause that binds every value as a parameter
+	FilterTableDataByPredicates(ctx context.Context, username, database, schema, table string, filter domain.FilterPredicate, offset, limit int) (*domain.QueryResult, error)
+
+	// SearchTableData returns the rows whose text columns contain term, ignoring case, along with the
+	// columns each row matched in; at most TableSearchMaxRows rows are returned within TableSearchTimeout
+	SearchTableData(ctx context.Context, username, database, schema, table, term string) (*domain.TableSearchResult, error)
+
+	// SearchObjects finds the tables, views, columns and functions whose names contain term across the
+	// user's accessible schemas in the cached metadata, closest matches first and at most
+	// ObjectSearchMaxResults of them
+	SearchObjects(ctx context.Context, username, term string) ([]domain.ObjectSearchMatch, error)
+
 	// ValidateWhereClause validates a WHERE clause fragment for SQL injection
 	ValidateWhereClause(ctx context.Context, whereClause string) (bool, error)
 
 	// SortTableData sorts table data by a column
 	SortTableData(ctx context.Context, username, database, schema, table, orderBy, orderDir string, offset, limit int) (*domain.QueryResult, error)
 
+	// GetTableSizeEstimates returns the row estimate and size of each listed table of a schema the user
+	// can read, for the data explorer to show next to its entries
+	GetTableSizeEstimates(ctx context.Context, username, database, schema string, tables []string) ([]domain.TableSizeEstimate, error)
+
 	// GetTableRowCount returns the total count of rows in a table
 	GetTableRowCount(ctx context.Context, username, database, schema, table string) (int64, error)
 
@@ -49,4 +77,93 @@ type DataViewUseCase interface {
 
 	// IsTableReadOnly checks if a table is read-only for the user
 	IsTableReadOnly(ctx context.Context, username, database, schema, table string) (bool, error)
+
+	// GetColumnAggregate computes SUM/AVG/MIN/MAX/COUNT of a numeric column under an optional WHERE clause
+	GetColumnAggregate(ctx context.Context, username, database, schema, table, column, whereClause string) (*domain.ColumnAggregate, error)
+
+	// GroupTableData summarizes table rows grouped by a column, with drill-down filters per group
+	GroupTableData(ctx context.Context, username string, params domain.GroupByParams) (*domain.GroupByResult, error)
+
+	// GetColumnHistogram computes bucketed counts of a numeric or date column under an optional WHERE clause
+	GetColumnHistogram(ctx context.Context, username string, params domain.HistogramParams) (*domain.HistogramResult, error)
+
+	// FindDuplicateRows reports groups of rows sharing identical values in the given columns, with sample PKs
+	FindDuplicateRows(ctx context.Context, username string, params domain.DuplicateParams) (*domain.DuplicateReport, error)
+
+	// GetDataQualityReport reports per-column NULL and empty-string percentages over a sample of rows
+	GetDataQualityReport(ctx context.Context, username, database, schema, table string, sampleLimit int) (*domain.DataQualityReport, error)
+
+	// GetTableStatistics reports a table's live and dead tuples, vacuum and analyze history, index
+	// usage and estimated bloat
+	GetTableStatistics(ctx context.Context, username, database, schema, table string) (*domain.TableStatistics, error)
+
+	// CheckReferentialIntegrity scans FK columns (declared, or given manually) for orphaned values
+	CheckReferentialIntegrity(ctx context.Context, username, database, schema, table string, references []domain.ForeignKeyMetadata, limit int) (*domain.ReferentialIntegrityReport, error)
+
+	// LookupForeignKeyValues searches the table a foreign key column references for the parent rows
+	// matching term, for type-ahead while editing the column's cells
+	LookupForeignKeyValues(ctx context.Context, username, database, schema, table, column, term string) (*domain.ForeignKeyLookup, error)
+
+	// SuggestJoinConditions suggests join conditions between two tables based on their foreign keys
+	SuggestJoinConditions(ctx context.Context, username, database, leftSchema, leftTable, rightSchema, rightTable string) ([]domain.JoinCondition, error)
+
+	// LoadJoinedTableData loads a read-only page of a two-table join with filter, sort and pagination
+	LoadJoinedTableData(ctx context.Context, username string, params domain.JoinViewParams) (*domain.QueryResult, error)
+
+	// GetHistoryConfig detects the history companion of a table by naming convention
+	GetHistoryConfig(ctx context.Context, username, database, schema, table string) (*domain.HistoryTableConfig, error)
+
+	// LoadTableDataAsOf loads the rows of a history-tracked table as of a point in time
+	LoadTableDataAsOf(ctx context.Context, username, database, schema, table string, asOf time.Time, offset, limit int) (*domain.QueryResult, error)
+
+	// GetRowTimeline returns every recorded version of a row of a history-tracked table
+	GetRowTimeline(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) (*domain.QueryResult, error)
+
+	// GetJSONCell returns a json or jsonb cell of the row with the given primary key, pretty-printed
+	// for reading in full
+	GetJSONCell(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.JSONCellDetail, error)
+
+	// GetCellContent returns the full value of a cell of the row with the given primary key, with its
+	// size, hash and detected content type, for downloading binary and large text cells
+	GetCellContent(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.CellContent, error)
+
+	// GetRowDetail returns the row with the given primary key with each column's type, nullability,
+	// default, foreign key target and comment
+	GetRowDetail(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) (*domain.RowDetail, error)
+
+	// GetSpatialCell returns a geometry or geography cell of the row with the given primary key as
+	// GeoJSON and WKT for previewing on a map, with the raw value it is edited as
+	GetSpatialCell(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.SpatialCellDetail, error)
+
+	// TakeTableSnapshot copies the rows of a table with a primary key and at most TableSnapshotMaxRows
+	// rows, to compare the table against later
+	TakeTableSnapshot(ctx context.Context, username, database, schema, table string) (*domain.TableSnapshot, error)
+
+	// CompareTableSnapshot diffs the live table against one of the user's snapshots, reporting rows
+	// inserted, updated and deleted since it was taken, matched by primary key
+	CompareTableSnapshot(ctx context.Context, username, snapshotID string) (*domain.TableSnapshotDiff, error)
+
+	// ListTableSnapshots returns the user's kept table snapshots, newest first
+	ListTableSnapshots(ctx context.Context, username string) ([]domain.TableSnapshot, error)
+
+	// DeleteTableSnapshot discards one of the user's table snapshots
+	DeleteTableSnapshot(ctx context.Context, username, snapshotID string) error
+
+	// FreezeView opens a repeatable-read snapshot under snapshotKey so LoadTableData pages stay
+	// consistent until it is released; an open snapshot is kept
+	FreezeView(ctx context.Context, snapshotKey string) (*domain.SnapshotStatus, error)
+
+	// GetSnapshotStatus reports the snapshot under snapshotKey, releasing it once older than SnapshotMaxAgeSeconds
+	GetSnapshotStatus(ctx context.Context, snapshotKey string) (*domain.SnapshotStatus, error)
+
+	// ReleaseSnapshot ends the snapshot under snapshotKey so reads see live data again
+	ReleaseSnapshot(ctx context.Context, snapshotKey string) error
+
+	// ExportTableData writes the filtered and sorted table to w as json, ndjson or xlsx, refusing
+	// tables larger than the configured export row limit
+	ExportTableData(ctx context.Context, username string, params domain.TableDataParams, format string, w io.Writer) error
+
+	// BuildTableReport lays the filtered and sorted table out for printing under title, defaulting to the
+	// table's name; the export policy applies and tables over ReportMaxRows rows are refused
+	BuildTableReport(ctx context.Context, username string, params domain.TableDataParams, title string) (*domain.ResultReport, error)
 }
@@ -26,6 +26,11 @@ type ERDUseCase interface {
 	// IsSchemaEmpty checks if a schema has no tables
 	IsSchemaEmpty(ctx context.Context, username, database, schema string) (bool, error)
 
+	// GetSchemaGraph returns the accessible tables of a schema with their columns and the foreign keys
+	// between them, with cardinality hints; a non-empty root limits it to the tables within depth
+	// references of the root table, following references in both directions
+	GetSchemaGraph(ctx context.Context, username, database, schema, root string, depth int) (*domain.SchemaGraph, error)
+
 	// GetAvailableSchemas returns all schemas accessible by a user in a database
 	GetAvailableSchemas(ctx context.Context, username, database string) ([]string, error)
 }
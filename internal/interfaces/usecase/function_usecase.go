@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// FunctionUseCase defines operations for browsing stored functions and procedures and calling them
+type FunctionUseCase interface {
+	// ListFunctions returns a schema's functions and procedures with whether the user may execute each
+	ListFunctions(ctx context.Context, username, database, schema string) ([]domain.StoredFunction, error)
+
+	// GetFunctionSource returns the CREATE statement of the routine with the identity signature arguments
+	GetFunctionSource(ctx context.Context, username, database, schema, name, arguments string) (string, error)
+
+	// ExecuteFunction calls a routine with SELECT, or CALL for a procedure, binding every argument as a
+	// parameter cast to its declared type. It runs like an editor query, on the user's connection with
+	// the search_path and settings in params and paged by its offset and limit.
+	ExecuteFunction(ctx context.Context, username string, call domain.FunctionCall, params domain.QueryParams) (*domain.FunctionCallResult, error)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// ImportUseCase defines operations for loading CSV files into tables
+type ImportUseCase interface {
+	// PreviewCSVImport maps the CSV header onto the table's columns and coerces the rows to their
+	// column types without loading anything
+	PreviewCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportPreview, error)
+
+	// ValidateCSVImport type-checks every row of the CSV file against the table and reports each value
+	// that would not load, row by row and column by column, without loading anything
+	ValidateCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportValidation, error)
+
+	// ImportCSV validates the whole CSV file and loads it with COPY FROM in a single transaction that
+	// can be cancelled like a running query
+	ImportCSV(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportResult, error)
+}
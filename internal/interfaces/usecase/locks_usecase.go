@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// LocksUseCase defines the view of which backends block which on locks
+type LocksUseCase interface {
+	// GetBlockingChains lists, per database the user can access, each backend holding a lock others
+	// wait on and the chain of backends waiting behind it. Only superusers see the query and client
+	// of other users' backends.
+	GetBlockingChains(ctx context.Context, username string) (*domain.LockReport, error)
+}
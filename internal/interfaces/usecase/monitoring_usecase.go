@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MonitoringUseCase defines the live view of the server's backends
+type MonitoringUseCase interface {
+	// GetActivity lists the backends connected to the databases the user can access. As in PostgreSQL
+	// itself, only superusers see the query and client of other users' backends.
+	GetActivity(ctx context.Context, username string) (*domain.ActivitySnapshot, error)
+
+	// TerminateBackend ends a backend with pg_terminate_backend; only superusers may
+	TerminateBackend(ctx context.Context, adminUsername string, pid int) error
+
+	// ListSlowOperations lists up to limit logged slow operations with their index suggestions, newest
+	// first; only superusers may
+	ListSlowOperations(ctx context.Context, adminUsername string, limit int) ([]domain.SlowOperation, error)
+
+	// GetServerDashboard samples the server's statistics, with transaction rates measured since the previous
+	// sample and cache hit ratios; only superusers may
+	GetServerDashboard(ctx context.Context, adminUsername string) (*domain.ServerDashboard, error)
+
+	// ListSlowQueries lists up to limit editor queries kept in the slow-query log with their plans, newest
+	// first; only superusers may
+	ListSlowQueries(ctx context.Context, adminUsername string, limit int) ([]domain.SlowQuery, error)
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// QueryNotebookUseCase defines operations for a user's notebooks of SQL cells and markdown notes
+type QueryNotebookUseCase interface {
+	// CreateNotebook stores a new notebook for the user, giving cells without an ID one
+	CreateNotebook(ctx context.Context, username, title string, cells []domain.NotebookCell) (*domain.QueryNotebook, error)
+
+	// GetNotebook retrieves one of the user's notebooks with its saved results
+	GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error)
+
+	// ListNotebooks retrieves the user's notebooks ordered by title
+	ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error)
+
+	// UpdateNotebook retitles one of the user's notebooks and replaces its cells; a cell keeping its ID
+	// and source keeps its saved result
+	UpdateNotebook(ctx context.Context, username, id, title string, cells []domain.NotebookCell) (*domain.QueryNotebook, error)
+
+	// DeleteNotebook removes one of the user's notebooks
+	DeleteNotebook(ctx context.Context, username, id string) error
+
+	// RunCell runs one SQL cell of a notebook and saves its result, or why it failed, in the notebook
+	RunCell(ctx context.Context, username, id, cellID string) (*domain.QueryNotebook, error)
+
+	// RunAllCells runs the SQL cells of a notebook in order, stopping at the first that fails, and saves
+	// their results in the notebook
+	RunAllCells(ctx context.Context, username, id string) (*domain.QueryNotebook, error)
+
+	// ExportNotebook writes one of the user's notebooks, notes and saved results included, as a single
+	// JSON file; the results are left out when the export policy denies the user query exports
+	ExportNotebook(ctx context.Context, username, id string) (*domain.NotebookExport, error)
+}
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/kamil5b/lumen-pg/internal/domain"
 )
@@ -14,9 +16,45 @@ type QueryUseCase interface {
 	// ExecuteMultipleQueries executes multiple SQL queries separated by semicolons
 	ExecuteMultipleQueries(ctx context.Context, username, queries string) ([]domain.QueryResult, error)
 
-	// ExecuteQueryWithPagination executes a query with offset pagination
+	// ExecuteQueryWithPagination executes a query with offset pagination; a run from offset zero keeps
+	// the first ResultCacheRows rows and returns their ResultID
 	ExecuteQueryWithPagination(ctx context.Context, username string, params domain.QueryParams) (*domain.QueryResult, error)
 
+	// ViewResult re-sorts, filters and pages the kept rows of one of the user's earlier runs without
+	// running the query again
+	ViewResult(ctx context.Context, username, resultID string, view domain.ResultView) (*domain.QueryResult, error)
+
+	// ExportQueryCSV streams a SELECT result as CSV to w, applying the params' WHERE clause and sort
+	ExportQueryCSV(ctx context.Context, username string, params domain.QueryParams, w io.Writer) error
+
+	// BuildQueryReport lays a SELECT result out for printing under title, applying the params' WHERE
+	// clause and sort; the export policy applies and results over ReportMaxRows rows are refused
+	BuildQueryReport(ctx context.Context, username string, params domain.QueryParams, title string) (*domain.ResultReport, error)
+
+	// WatchQuery runs a SELECT now and again every interval, held to the server's minimum, passing each
+	// result to onUpdate until ctx is done or onUpdate fails; later failed runs are reported, not fatal
+	WatchQuery(ctx context.Context, username, query string, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error
+
+	// ShareEditor creates a temporary invite letting other users watch the user's editor runs read-only,
+	// replacing any earlier invite of the user
+	ShareEditor(ctx context.Context, username string) (*domain.EditorShare, error)
+
+	// StopSharingEditor revokes the user's editor invite, ending the streams of its viewers
+	StopSharingEditor(ctx context.Context, username string) error
+
+	// WatchSharedEditor passes each run of the editor shared under token to onUpdate, starting with the
+	// latest, until ctx is done, the invite ends or onUpdate fails
+	WatchSharedEditor(ctx context.Context, viewer, token string, onUpdate func(*domain.EditorShareUpdate) error) error
+
+	// CancelQuery cancels the query the user is currently running, reporting whether one was running
+	CancelQuery(ctx context.Context, username string) (bool, error)
+
+	// ExplainQuery returns the plan tree of a single statement; analyze requires write permission
+	ExplainQuery(ctx context.Context, username, query string, analyze bool) (*domain.QueryPlan, error)
+
+	// BuildChartData runs a SELECT and reshapes its result into one label column and numeric series
+	BuildChartData(ctx context.Context, username, query string) (*domain.ChartData, error)
+
 	// SplitQueries splits a multi-query string by semicolons
 	SplitQueries(ctx context.Context, queries string) ([]string, error)
 
@@ -23,6 +23,10 @@ type RBACUseCase interface {
 	// CheckDeletePermission checks if a user can DELETE from a table
 	CheckDeletePermission(ctx context.Context, username, database, schema, table string) (bool, error)
 
+	// CheckExportPermission checks if a user can export a table's data, which takes SELECT and the
+	// permission of the export policy in AppConfig.ExportRules
+	CheckExportPermission(ctx context.Context, username, database, schema, table string) (bool, error)
+
 	// CheckDatabaseAccess checks if a user can access a database
 	CheckDatabaseAccess(ctx context.Context, username, database string) (bool, error)
 
@@ -38,7 +42,8 @@ type RBACUseCase interface {
 	// GetUserAccessibleTables returns all tables accessible by a user in a schema
 	GetUserAccessibleTables(ctx context.Context, username, database, schema string) ([]domain.AccessibleTable, error)
 
-	// GetTablePermissions returns the permissions a user has on a table
+	// GetTablePermissions returns the permissions a user has on a table, including whether the export
+	// policy lets the user export it
 	GetTablePermissions(ctx context.Context, username, database, schema, table string) (*domain.PermissionSet, error)
 
 	// IsTableReadOnly checks if a table is read-only for the user
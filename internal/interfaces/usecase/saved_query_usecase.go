@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// SavedQueryUseCase defines operations for managing a user's saved query library
+type SavedQueryUseCase interface {
+	// SaveQuery stores a new named query with optional tags for the user
+	SaveQuery(ctx context.Context, username, name, query string, tags []string) (*domain.SavedQuery, error)
+
+	// GetSavedQuery retrieves one of the user's saved queries
+	GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error)
+
+	// ListSavedQueries retrieves the user's saved queries, optionally only those carrying a tag
+	ListSavedQueries(ctx context.Context, username, tag string) ([]domain.SavedQuery, error)
+
+	// UpdateSavedQuery renames, rewrites or retags one of the user's saved queries
+	UpdateSavedQuery(ctx context.Context, username, id, name, query string, tags []string) (*domain.SavedQuery, error)
+
+	// BindTemplate converts one of the user's saved queries into a parameterized statement, checking
+	// each declared variable's value against its type
+	BindTemplate(ctx context.Context, username, id string, values map[string]string) (string, []interface{}, error)
+
+	// DeleteSavedQuery removes one of the user's saved queries
+	DeleteSavedQuery(ctx context.Context, username, id string) error
+
+	// ExportWorkspace writes the user's saved queries and search_path preference as a JSON workspace file
+	ExportWorkspace(ctx context.Context, username string, searchPath []string) ([]byte, error)
+
+	// ImportWorkspace adds the saved queries of a workspace file to the user's library and returns its
+	// search_path preference
+	ImportWorkspace(ctx context.Context, username string, document []byte) (*domain.WorkspaceImport, error)
+}
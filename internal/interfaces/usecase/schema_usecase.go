@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// SchemaUseCase defines operations for managing the structure of tables, and for creating the
+// databases and schemas that hold them
+type SchemaUseCase interface {
+	// ListIndexes returns a table's indexes with their definitions, sizes and scan counts, and whether
+	// the user may create and drop them
+	ListIndexes(ctx context.Context, username, database, schema, table string) (*domain.TableIndexList, error)
+
+	// CreateIndex generates the CREATE INDEX statement for the definition; the statement only runs
+	// when confirm repeats it, so an empty confirm previews the change
+	CreateIndex(ctx context.Context, username string, definition domain.IndexDefinition, confirm string) (*domain.SchemaChange, error)
+
+	// DropIndex generates the DROP INDEX statement for one of the table's indexes; the statement only
+	// runs when confirm repeats it, so an empty confirm previews the change
+	DropIndex(ctx context.Context, username string, drop domain.IndexDrop, confirm string) (*domain.SchemaChange, error)
+
+	// ListConstraints returns a table's primary key, unique, check and foreign key constraints, and
+	// whether the user may add and drop them
+	ListConstraints(ctx context.Context, username, database, schema, table string) (*domain.TableConstraintList, error)
+
+	// AddConstraint generates the ALTER TABLE statement adding the constraint; the statement only runs
+	// when confirm repeats it, so an empty confirm previews the change
+	AddConstraint(ctx context.Context, username string, definition domain.ConstraintDefinition, confirm string) (*domain.SchemaChange, error)
+
+	// DropConstraint generates the ALTER TABLE statement dropping one of the table's constraints; the
+	// statement only runs when confirm repeats it, so an empty confirm previews the change
+	DropConstraint(ctx context.Context, username string, drop domain.ConstraintDrop, confirm string) (*domain.SchemaChange, error)
+
+	// CreateTable generates the CREATE TABLE statement for a table designed column by column; the
+	// statement only runs when confirm repeats it, so an empty confirm previews the change
+	CreateTable(ctx context.Context, username string, creation domain.TableCreation, confirm string) (*domain.SchemaChange, error)
+
+	// AlterTable generates the ALTER TABLE statements applying the table designer's column changes in
+	// order; the statements only run, together, when confirm repeats them, so an empty confirm
+	// previews the change
+	AlterTable(ctx context.Context, username string, alteration domain.TableAlteration, confirm string) (*domain.SchemaChange, error)
+
+	// GetTableDDL reconstructs the SQL that recreates a table's columns, constraints, indexes and
+	// comments, so its structure can be copied to another environment
+	GetTableDDL(ctx context.Context, username, database, schema, table string) (*domain.TableDDL, error)
+
+	// ListComments returns the comments on a table and its columns, and whether the user may edit them
+	ListComments(ctx context.Context, username, database, schema, table string) (*domain.TableComments, error)
+
+	// SetComment generates the COMMENT ON statement for a table or column comment; the statement only
+	// runs when confirm repeats it, so an empty confirm previews the change
+	SetComment(ctx context.Context, username string, change domain.CommentChange, confirm string) (*domain.SchemaChange, error)
+
+	// ListTriggers returns a table's triggers with their timing, events and function, and whether the
+	// user may enable and disable them
+	ListTriggers(ctx context.Context, username, database, schema, table string) (*domain.TableTriggerList, error)
+
+	// SetTriggerEnabled generates the ALTER TABLE statement enabling or disabling one of the table's
+	// triggers; the statement only runs when confirm repeats it, so an empty confirm previews the change
+	SetTriggerEnabled(ctx context.Context, username string, toggle domain.TriggerToggle, confirm string) (*domain.SchemaChange, error)
+
+	// CreateDatabase generates the CREATE DATABASE statement for the creation; the statement only runs
+	// when confirm repeats it, so an empty confirm previews the change. Once it runs, the user's
+	// cached accessible resources are rebuilt so the database shows without logging in again
+	CreateDatabase(ctx context.Context, username string, creation domain.DatabaseCreation, confirm string) (*domain.SchemaChange, error)
+
+	// CreateSchema generates the CREATE SCHEMA statement for the creation; the statement only runs
+	// when confirm repeats it, so an empty confirm previews the change. Once it runs, the database's
+	// metadata and the user's cached accessible resources are refreshed
+	CreateSchema(ctx context.Context, username string, creation domain.SchemaCreation, confirm string) (*domain.SchemaChange, error)
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// SequenceUseCase defines operations for browsing sequences and moving them
+type SequenceUseCase interface {
+	// ListSequences returns the sequences of a schema the user holds any privilege on, with whether
+	// the user may set or restart each; last values are only shown to roles that may SELECT them
+	ListSequences(ctx context.Context, username, database, schema string) ([]domain.Sequence, error)
+
+	// SetSequenceValue generates the setval call moving the sequence; the statement only runs when
+	// confirm repeats it, so an empty confirm previews the change
+	SetSequenceValue(ctx context.Context, username string, value domain.SequenceValue, confirm string) (*domain.SchemaChange, error)
+
+	// RestartSequence generates the ALTER SEQUENCE ... RESTART statement for the sequence; the
+	// statement only runs when confirm repeats it, so an empty confirm previews the change
+	RestartSequence(ctx context.Context, username string, restart domain.SequenceRestart, confirm string) (*domain.SchemaChange, error)
+}
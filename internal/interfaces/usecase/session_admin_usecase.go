@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// SessionAdminUseCase defines superuser operations over every user's sessions, the servers they may
+// log in to, maintenance mode and the environment banner
+type SessionAdminUseCase interface {
+	// ListSessions returns all active sessions, without their stored credentials
+	ListSessions(ctx context.Context, adminUsername string) ([]*domain.Session, error)
+
+	// RevokeSession ends a session immediately, closing its connections and discarding its user's
+	// buffered transaction
+	RevokeSession(ctx context.Context, adminUsername, sessionID string) error
+
+	// SetSessionSettings replaces the GUC presets of another user's session on their behalf; the
+	// session's next query checks out a connection with them applied
+	SetSessionSettings(ctx context.Context, adminUsername, sessionID string, settings domain.SessionSettings) (*domain.Session, error)
+
+	// SaveConnectionProfile adds a connection profile, or replaces the one with the same name
+	SaveConnectionProfile(ctx context.Context, adminUsername string, profile domain.ConnectionProfile) error
+
+	// DeleteConnectionProfile removes a connection profile; the last one cannot be removed
+	DeleteConnectionProfile(ctx context.Context, adminUsername, name string) error
+
+	// ExportConnectionProfiles writes the connection profiles as a JSON file for another instance;
+	// profiles hold secret references rather than secrets, so nothing secret is exported
+	ExportConnectionProfiles(ctx context.Context, adminUsername string) ([]byte, error)
+
+	// ImportConnectionProfiles adds the profiles of an exported file, replacing those with the same
+	// names, and reports the secret references this instance cannot resolve yet
+	ImportConnectionProfiles(ctx context.Context, adminUsername string, document []byte) (*domain.ConnectionProfileImport, error)
+
+	// GetMaintenanceStatus reports whether the instance is in maintenance mode
+	GetMaintenanceStatus(ctx context.Context, adminUsername string) (*domain.MaintenanceStatus, error)
+
+	// SetMaintenanceMode turns maintenance mode on or off; while on, only superusers may start
+	// transactions or write, and transactions already open get a grace period to commit
+	SetMaintenanceMode(ctx context.Context, adminUsername string, enabled bool, message string) (*domain.MaintenanceStatus, error)
+
+	// GetEnvironmentBanner reports the environment banner every page shows; anyone may read it
+	GetEnvironmentBanner(ctx context.Context) (*domain.EnvironmentBanner, error)
+
+	// SetEnvironmentBanner tags the instance with an environment name and banner color; an empty name
+	// removes the banner
+	SetEnvironmentBanner(ctx context.Context, adminUsername, name, color string) (*domain.EnvironmentBanner, error)
+
+	// CheckRolePassword measures a new role's password against the password policy, so the rules it
+	// breaks can be shown while it is typed
+	CheckRolePassword(ctx context.Context, adminUsername, roleName, password string) (*domain.PasswordCheck, error)
+
+	// CreateRole creates a login role once its password meets the password policy; a password that
+	// breaks it is refused with the rules it breaks before any CREATE ROLE runs
+	CreateRole(ctx context.Context, adminUsername string, role domain.NewRole) error
+
+	// GetRejectionStats counts the requests refused by the SQL validators since the given time, per rule
+	// and route, most frequent first; a zero since looks back RejectionStatsDefaultWindow
+	GetRejectionStats(ctx context.Context, adminUsername string, since time.Time) ([]domain.RejectionStat, error)
+
+	// SetDisabledShortcuts turns the keyboard shortcuts of actions off for every user, replacing the
+	// earlier list; an empty list enables them all again
+	SetDisabledShortcuts(ctx context.Context, adminUsername string, actions []string) ([]string, error)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// ShortcutUseCase defines operations for the keyboard shortcuts the frontend binds, remapped per user
+type ShortcutUseCase interface {
+	// ListShortcuts returns every shortcut action with the user's binding, in DefaultShortcuts order;
+	// actions an admin disabled are listed without keys
+	ListShortcuts(ctx context.Context, username string) ([]domain.KeyboardShortcut, error)
+
+	// RemapShortcut binds the action to keys for the user, refusing combinations another of the
+	// user's shortcuts already uses and actions an admin disabled
+	RemapShortcut(ctx context.Context, username, action, keys string) (*domain.KeyboardShortcut, error)
+
+	// ResetShortcut restores the action's default binding for the user
+	ResetShortcut(ctx context.Context, username, action string) (*domain.KeyboardShortcut, error)
+}
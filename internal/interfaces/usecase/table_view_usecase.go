@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// TableViewUseCase defines operations for the named views of a table each user saves and re-applies
+type TableViewUseCase interface {
+	// ListTableViews returns the user's saved views of the table, ordered by name
+	ListTableViews(ctx context.Context, username, database, schema, table string) ([]domain.SavedTableView, error)
+
+	// SaveTableView stores the view under its name, replacing the user's earlier view of that name; a
+	// view saved as the default takes over from the table's previous default
+	SaveTableView(ctx context.Context, username string, view domain.SavedTableView) (*domain.SavedTableView, error)
+
+	// DeleteTableView removes the user's saved view of the table
+	DeleteTableView(ctx context.Context, username, database, schema, table, name string) error
+
+	// SetDefaultTableView makes the named view the one applied when the user selects the table; an
+	// empty name clears the default
+	SetDefaultTableView(ctx context.Context, username, database, schema, table, name string) error
+
+	// GetDefaultTableView returns the view applied when the user selects the table, or nil if none is set
+	GetDefaultTableView(ctx context.Context, username, database, schema, table string) (*domain.SavedTableView, error)
+}
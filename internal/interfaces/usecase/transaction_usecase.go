@@ -11,14 +11,22 @@ type TransactionUseCase interface {
 	// StartTransaction creates a new transaction for a user
 	StartTransaction(ctx context.Context, username, database, schema, table string) (*domain.TransactionState, error)
 
+	// CheckTableLocks lists the locks on a table a commit may block behind: modes conflicting with row
+	// writes and locks held longer than LongHeldLockSeconds. Other users' query and client details are
+	// only included for superusers.
+	CheckTableLocks(ctx context.Context, username, database, schema, table string) ([]domain.TableLock, error)
+
 	// GetActiveTransaction retrieves the active transaction for a user
 	GetActiveTransaction(ctx context.Context, username string) (*domain.TransactionState, error)
 
 	// CheckActiveTransaction checks if a user already has an active transaction
 	CheckActiveTransaction(ctx context.Context, username string) (bool, error)
 
-	// CommitTransaction commits all buffered changes in a transaction
-	CommitTransaction(ctx context.Context, username string) error
+	// CommitTransaction commits all buffered changes in a transaction, recording the change reason in the audit log
+	CommitTransaction(ctx context.Context, username, reason string) error
+
+	// ApproveTransaction lets a second user release a commit held for approval on a sensitive table
+	ApproveTransaction(ctx context.Context, approver, username string) error
 
 	// RollbackTransaction rolls back all buffered changes in a transaction
 	RollbackTransaction(ctx context.Context, username string) error
@@ -26,12 +34,42 @@ type TransactionUseCase interface {
 	// EditCell buffers an edit to a table cell
 	EditCell(ctx context.Context, username, database, schema, table string, rowIndex int, columnName string, newValue interface{}) error
 
+	// EditJSONCell buffers set and remove operations on a JSONB cell, applied to its buffered value or
+	// else to currentValue, and returns the edit; the operations are exported as jsonb_set calls
+	EditJSONCell(ctx context.Context, username, database, schema, table string, rowIndex int, columnName, currentValue string, ops []domain.JSONPatchOp) (*domain.RowEdit, error)
+
+	// CellValueOptions lists the values a cell of column may be edited to, from the labels of an enum
+	// type or the IN lists of the table's CHECK constraints, which EditCell also enforces
+	CellValueOptions(ctx context.Context, username, database, schema, table, column string) (*domain.CellValueOptions, error)
+
+	// RestoreCellValue steps a buffered cell edit back to the value before its latest one, dropping the
+	// edit once the cell is back to its original value; it returns the remaining edit, or nil
+	RestoreCellValue(ctx context.Context, username string, rowIndex int, columnName string) (*domain.RowEdit, error)
+
 	// DeleteRow buffers a row deletion
 	DeleteRow(ctx context.Context, username, database, schema, table string, rowIndex int) error
 
+	// DeleteRows buffers deletions of rows selected by primary key, or of every row matching a filter
+	// up to BulkDeleteMaxRows, reporting the exact number of rows the commit will delete
+	DeleteRows(ctx context.Context, username string, params domain.BulkDeleteParams) (*domain.BulkDeleteResult, error)
+
+	// PreviewBulkUpdate counts the rows a filter-based column update would change
+	PreviewBulkUpdate(ctx context.Context, username string, update domain.BulkUpdate) (*domain.BulkUpdateResult, error)
+
+	// UpdateRowsByFilter buffers a filter-based column update once the caller confirms the previewed
+	// row count, which must still match
+	UpdateRowsByFilter(ctx context.Context, username string, update domain.BulkUpdate) (*domain.BulkUpdateResult, error)
+
 	// InsertRow buffers a new row insertion
 	InsertRow(ctx context.Context, username, database, schema, table string, values map[string]interface{}) error
 
+	// NewRowDefaults generates values for the primary key columns a new row cannot leave out, currently
+	// uuid keys without a default, and previews the defaults the server fills into blank fields
+	NewRowDefaults(ctx context.Context, username, database, schema, table string) (*domain.NewRowDefaults, error)
+
+	// UpsertRow buffers a new row insertion with the behavior to apply when it hits a unique constraint
+	UpsertRow(ctx context.Context, username, database, schema, table string, values map[string]interface{}, onConflict domain.ConflictAction) error
+
 	// GetTransactionEdits retrieves all buffered edits for an active transaction
 	GetTransactionEdits(ctx context.Context, username string) (map[int]domain.RowEdit, error)
 
@@ -41,12 +79,33 @@ type TransactionUseCase interface {
 	// GetTransactionInserts retrieves all buffered insertions for an active transaction
 	GetTransactionInserts(ctx context.Context, username string) ([]domain.RowInsert, error)
 
+	// ExportTransaction renders the buffered changes as a SQL script or JSON patch document without
+	// applying them, for users who hand change sets to someone with commit rights
+	ExportTransaction(ctx context.Context, username, format string) (*domain.TransactionExport, error)
+
 	// GetTransactionRemainingTime returns the remaining time for an active transaction
 	GetTransactionRemainingTime(ctx context.Context, username string) (int64, error)
 
 	// IsTransactionExpired checks if a transaction has expired
 	IsTransactionExpired(ctx context.Context, username string) (bool, error)
 
+	// RestoreDeletedRows puts back the rows a commit deleted with a compensating INSERT. An empty confirm
+	// only previews the statement; it runs once confirm repeats it, while the rows are still kept.
+	RestoreDeletedRows(ctx context.Context, username, entryID, confirm string) (*domain.RowRestore, error)
+
 	// CancelExpiredTransactions cancels all expired transactions
 	CancelExpiredTransactions(ctx context.Context) error
+
+	// Heartbeat records that the user's page is still in use, so the user's open transaction is not
+	// rolled back as idle and any idle warning is withdrawn
+	Heartbeat(ctx context.Context, username string) error
+
+	// ReapIdleTransactions warns the users of open transactions without a heartbeat for
+	// TransactionIdleTimeout less TransactionIdleWarning, and rolls back and audits as
+	// transaction_timeout those without one for TransactionIdleTimeout; it returns how many it rolled back
+	ReapIdleTransactions(ctx context.Context) (int, error)
+
+	// WatchTransaction pushes the idle warnings and idle rollbacks of the user's transactions until ctx
+	// is done or onEvent fails
+	WatchTransaction(ctx context.Context, username string, onEvent func(*domain.TransactionEvent) error) error
 }
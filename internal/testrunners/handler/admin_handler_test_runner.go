@@ -0,0 +1,830 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// AdminHandlerConstructor is a function type that creates an AdminHandler
+type AdminHandlerConstructor func(
+	sessionAdminUC usecase.SessionAdminUseCase,
+	authUC usecase.AuthenticationUseCase,
+	auditUC usecase.AuditUseCase,
+) handler.AdminHandler
+
+// AdminHandlerRunner runs all admin handler tests
+func AdminHandlerRunner(t *testing.T, constructor AdminHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAdmin := mockUsecase.NewMockSessionAdminUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+	mockAudit := mockUsecase.NewMockAuditUseCase(ctrl)
+
+	h := constructor(mockAdmin, mockAuth, mockAudit)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "admin_session").
+		Return(&domain.Session{ID: "admin_session", Username: "postgres"}, nil).
+		AnyTimes()
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "user_session").
+		Return(&domain.Session{ID: "user_session", Username: "alice"}, nil).
+		AnyTimes()
+
+	listed := []*domain.Session{
+		{
+			ID:             "admin_session",
+			Username:       "postgres",
+			CreatedAt:      time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+			LastActivityAt: time.Date(2026, 1, 2, 9, 30, 0, 0, time.UTC),
+			ClientIP:       "192.0.2.1",
+		},
+		{
+			ID:        "user_session",
+			Username:  "alice",
+			CreatedAt: time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+			ClientIP:  "<script>",
+			Settings:  domain.SessionSettings{WorkMem: "64MB"},
+		},
+	}
+
+	t.Run("Sessions API lists active sessions", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			ListSessions(gomock.Any(), "postgres").
+			Return(listed, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/sessions", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		require.Equal(t, "postgres", response[0]["username"])
+		require.Equal(t, "2026-01-02T09:30:00Z", response[0]["last_activity_at"])
+		require.Equal(t, "192.0.2.1", response[0]["client_ip"])
+		require.Equal(t, true, response[0]["current"])
+		require.Nil(t, response[1]["last_activity_at"])
+	})
+
+	t.Run("Sessions API is forbidden to non-superusers", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			ListSessions(gomock.Any(), "alice").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can use the admin panel"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/sessions", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Sessions API requires a session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/sessions", nil)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Sessions page renders escaped rows with revoke buttons", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			ListSessions(gomock.Any(), "postgres").
+			Return(listed, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/sessions", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		require.Contains(t, body, `data-session-id="user_session"`)
+		require.Contains(t, body, "revoke-session")
+		require.Contains(t, body, `name="work_mem" placeholder="work_mem" value="64MB"`)
+		require.Contains(t, body, "&lt;script&gt;")
+		require.NotContains(t, body, "<td><script>")
+	})
+
+	t.Run("Revoke session invalidates the target session", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			RevokeSession(gomock.Any(), "postgres", "user_session").
+			Return(nil)
+
+		form := url.Values{"session_id": {"user_session"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/sessions/revoke", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Empty(t, w.Result().Cookies())
+		require.Contains(t, w.Body.String(), `"revoked":"user_session"`)
+	})
+
+	t.Run("Revoking your own session clears the cookie", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			RevokeSession(gomock.Any(), "postgres", "admin_session").
+			Return(nil)
+
+		form := url.Values{"session_id": {"admin_session"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/sessions/revoke", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		require.Equal(t, "session_id", cookies[0].Name)
+		require.True(t, cookies[0].MaxAge < 0)
+	})
+
+	t.Run("Revoke session reports unknown sessions", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			RevokeSession(gomock.Any(), "postgres", "missing").
+			Return(domain.ErrSessionNotFound)
+
+		form := url.Values{"session_id": {"missing"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/sessions/revoke", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Revoke session requires POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/sessions/revoke?session_id=user_session", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Session settings are saved on the user's behalf", func(t *testing.T) {
+		settings := domain.SessionSettings{WorkMem: "512MB", StatementTimeout: 10 * time.Minute, ApplicationNameSuffix: "nightly"}
+		mockAdmin.EXPECT().
+			SetSessionSettings(gomock.Any(), "postgres", "user_session", settings).
+			Return(&domain.Session{ID: "user_session", Username: "alice", Settings: settings}, nil)
+
+		form := url.Values{
+			"session_id":              {"user_session"},
+			"work_mem":                {"512MB"},
+			"statement_timeout":       {"10m"},
+			"application_name_suffix": {"nightly"},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/sessions/settings", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			ID       string `json:"id"`
+			Settings struct {
+				WorkMem            string `json:"work_mem"`
+				StatementTimeoutMS int64  `json:"statement_timeout_ms"`
+			} `json:"settings"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, "user_session", response.ID)
+		require.Equal(t, "512MB", response.Settings.WorkMem)
+		require.Equal(t, int64(600000), response.Settings.StatementTimeoutMS)
+	})
+
+	t.Run("Session settings are forbidden to non-superusers", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SetSessionSettings(gomock.Any(), "alice", "admin_session", domain.SessionSettings{WorkMem: "1GB"}).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can use the admin panel"})
+
+		form := url.Values{"session_id": {"admin_session"}, "work_mem": {"1GB"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/sessions/settings", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Session settings reject an unreadable timeout", func(t *testing.T) {
+		form := url.Values{"session_id": {"user_session"}, "statement_timeout": {"later"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/sessions/settings", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Session settings require POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/sessions/settings?session_id=user_session", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Profiles API saves a profile and lists the profiles", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SaveConnectionProfile(gomock.Any(), "postgres", domain.ConnectionProfile{Name: "replica", Host: "db2.internal", Port: 6543, SSLMode: "require"}).
+			Return(nil)
+		mockAuth.EXPECT().
+			ListConnectionProfiles(gomock.Any()).
+			Return([]domain.ConnectionProfile{
+				domain.DefaultConnectionProfile,
+				{Name: "replica", Host: "db2.internal", Port: 6543, SSLMode: "require"},
+			}, nil)
+
+		form := url.Values{"name": {"replica"}, "host": {"db2.internal"}, "port": {"6543"}, "sslmode": {"require"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/profiles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		require.Equal(t, true, response[0]["default"])
+		require.Equal(t, "replica", response[1]["name"])
+		require.Equal(t, float64(6543), response[1]["port"])
+	})
+
+	t.Run("Profiles API rejects an invalid port", func(t *testing.T) {
+		form := url.Values{"name": {"replica"}, "host": {"db2.internal"}, "port": {"abc"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/profiles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Profiles API is forbidden to non-superusers", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SaveConnectionProfile(gomock.Any(), "alice", gomock.Any()).
+			Return(domain.ValidationError{Field: "permission", Message: "only superusers can use the admin panel"})
+
+		form := url.Values{"name": {"replica"}, "host": {"db2.internal"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/profiles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Delete profile removes the named profile", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			DeleteConnectionProfile(gomock.Any(), "postgres", "replica").
+			Return(nil)
+
+		form := url.Values{"name": {"replica"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/profiles/delete", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"deleted":"replica"`)
+	})
+
+	t.Run("Profiles API saves the service password reference", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SaveConnectionProfile(gomock.Any(), "postgres", domain.ConnectionProfile{Name: "replica", Host: "db2.internal", ServicePasswordRef: "env:REPLICA_SERVICE_PASSWORD"}).
+			Return(nil)
+		mockAuth.EXPECT().
+			ListConnectionProfiles(gomock.Any()).
+			Return([]domain.ConnectionProfile{
+				domain.DefaultConnectionProfile,
+				{Name: "replica", Host: "db2.internal", ServicePasswordRef: "env:REPLICA_SERVICE_PASSWORD"},
+			}, nil)
+
+		form := url.Values{"name": {"replica"}, "host": {"db2.internal"}, "service_password_ref": {"env:REPLICA_SERVICE_PASSWORD"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/profiles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, "env:REPLICA_SERVICE_PASSWORD", response[1]["service_password_ref"])
+	})
+
+	t.Run("Profile export downloads the profiles file", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			ExportConnectionProfiles(gomock.Any(), "postgres").
+			Return([]byte(`{"version":1,"profiles":[]}`), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/profiles/export", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, `attachment; filename="lumen-pg-connection-profiles.json"`, w.Header().Get("Content-Disposition"))
+		require.Equal(t, `{"version":1,"profiles":[]}`, w.Body.String())
+	})
+
+	t.Run("Profile export is forbidden to non-superusers", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			ExportConnectionProfiles(gomock.Any(), "alice").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can use the admin panel"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/profiles/export", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Profile import reports added, replaced and unresolved profiles", func(t *testing.T) {
+		content := `{"version":1,"profiles":[{"name":"replica","host":"db2.internal"}]}`
+		mockAdmin.EXPECT().
+			ImportConnectionProfiles(gomock.Any(), "postgres", []byte(content)).
+			Return(&domain.ConnectionProfileImport{
+				Added:             []string{"replica"},
+				Replaced:          []string{"primary"},
+				UnresolvedSecrets: []string{"env:REPLICA_SERVICE_PASSWORD"},
+			}, nil)
+
+		req := newProfileImportRequest(t, content)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string][]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, []string{"replica"}, response["added"])
+		require.Equal(t, []string{"primary"}, response["replaced"])
+		require.Equal(t, []string{"env:REPLICA_SERVICE_PASSWORD"}, response["unresolved_secrets"])
+	})
+
+	t.Run("Profile import rejects an invalid file", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			ImportConnectionProfiles(gomock.Any(), "postgres", []byte("not json")).
+			Return(nil, domain.ValidationError{Field: "file", Message: "not a connection profile export"})
+
+		req := newProfileImportRequest(t, "not json")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "not a connection profile export")
+	})
+
+	t.Run("Profile import requires POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/profiles/import", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Maintenance API turns maintenance mode on", func(t *testing.T) {
+		since := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+		mockAdmin.EXPECT().
+			SetMaintenanceMode(gomock.Any(), "postgres", true, "Upgrading to PG 17").
+			Return(&domain.MaintenanceStatus{
+				Enabled:     true,
+				Message:     "Upgrading to PG 17",
+				Since:       since,
+				GraceEndsAt: since.Add(10 * time.Minute),
+			}, nil)
+
+		form := url.Values{"enabled": {"true"}, "message": {"Upgrading to PG 17"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/maintenance", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, true, response["enabled"])
+		require.Equal(t, "Upgrading to PG 17", response["message"])
+		require.Equal(t, "2026-01-02T09:10:00Z", response["grace_ends_at"])
+	})
+
+	t.Run("Maintenance API reports the current status", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			GetMaintenanceStatus(gomock.Any(), "postgres").
+			Return(&domain.MaintenanceStatus{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/maintenance", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"enabled":false}`, w.Body.String())
+	})
+
+	t.Run("Maintenance API rejects an invalid toggle", func(t *testing.T) {
+		form := url.Values{"enabled": {"maybe"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/maintenance", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Maintenance API is forbidden to non-superusers", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SetMaintenanceMode(gomock.Any(), "alice", true, "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can use the admin panel"})
+
+		form := url.Values{"enabled": {"true"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/maintenance", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Environment API tags the instance", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SetEnvironmentBanner(gomock.Any(), "postgres", "production", "").
+			Return(&domain.EnvironmentBanner{Name: "PRODUCTION", Color: "#c62828"}, nil)
+
+		form := url.Values{"name": {"production"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/environment", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"name":"PRODUCTION","color":"#c62828"}`, w.Body.String())
+	})
+
+	t.Run("Environment API rejects an invalid color", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SetEnvironmentBanner(gomock.Any(), "postgres", "STAGING", "orange").
+			Return(nil, domain.ValidationError{Field: "color", Message: "banner color must be a #rrggbb hex color"})
+
+		form := url.Values{"name": {"STAGING"}, "color": {"orange"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/environment", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Roles API creates a role", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			CreateRole(gomock.Any(), "postgres", domain.NewRole{Name: "reporting", Password: "Tide-Harbor-42-Lantern", CreateDB: true}).
+			Return(nil)
+
+		form := url.Values{"name": {"reporting"}, "password": {"Tide-Harbor-42-Lantern"}, "createdb": {"true"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.JSONEq(t, `{"created":"reporting","createdb":true}`, w.Body.String())
+	})
+
+	t.Run("Roles API refuses a password that breaks the policy", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			CreateRole(gomock.Any(), "postgres", domain.NewRole{Name: "reporting", Password: "letmein"}).
+			Return(domain.ValidationError{Field: "password", Message: "password must be at least 12 characters long; is too common to resist guessing"})
+
+		form := url.Values{"name": {"reporting"}, "password": {"letmein"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "at least 12 characters")
+	})
+
+	t.Run("Roles API reports a taken role name as a conflict", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			CreateRole(gomock.Any(), "postgres", gomock.Any()).
+			Return(domain.ErrRoleExists)
+
+		form := url.Values{"name": {"postgres"}, "password": {"Tide-Harbor-42-Lantern"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Password check API lists the broken rules", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			CheckRolePassword(gomock.Any(), "postgres", "reporting", "short").
+			Return(&domain.PasswordCheck{Violations: []string{"must be at least 12 characters long"}}, nil)
+
+		form := url.Values{"name": {"reporting"}, "password": {"short"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/roles/check-password", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"valid":false,"violations":["must be at least 12 characters long"]}`, w.Body.String())
+	})
+
+	t.Run("Rejections API lists counts per rule and route", func(t *testing.T) {
+		lastSeen := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+		mockAdmin.EXPECT().
+			GetRejectionStats(gomock.Any(), "postgres", time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)).
+			Return([]domain.RejectionStat{{Source: "sql_validator", Rule: "system_procedure", Method: "POST", Route: "/main/filter", Count: 4, LastSeen: lastSeen}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/rejections?since=2026-10-01", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"rejections":[{"source":"sql_validator","rule":"system_procedure","method":"POST","route":"/main/filter","count":4,"last_seen":"2026-10-17T09:30:00Z"}]}`, w.Body.String())
+	})
+
+	t.Run("Shortcuts API disables actions for every user", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			SetDisabledShortcuts(gomock.Any(), "postgres", []string{"commit_transaction", "rollback_transaction"}).
+			Return([]string{"commit_transaction", "rollback_transaction"}, nil)
+
+		form := url.Values{"actions": {"commit_transaction,rollback_transaction"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/shortcuts", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"disabled":["commit_transaction","rollback_transaction"]}`, w.Body.String())
+	})
+
+	auditEntries := []domain.AuditEntry{
+		{
+			ID:           "audit_1",
+			Username:     "alice",
+			Action:       domain.AuditActionCommit,
+			Database:     "shop",
+			Schema:       "public",
+			Table:        "users",
+			Reason:       "<b>fix</b>",
+			AffectedRows: []map[string]interface{}{{"operation": "update", "row_index": 2, "old_value": "Alcie", "new_value": "Alice"}},
+			CreatedAt:    time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	t.Run("Audit API lists entries matching the filter", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ListEntries(gomock.Any(), "postgres", domain.AuditFilter{
+				Username: "alice",
+				Table:    "users",
+				Since:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:    time.Date(2026, 1, 2, 23, 59, 59, 999999999, time.UTC),
+				Limit:    20,
+			}).
+			Return(auditEntries, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/audit?username=alice&table=users&since=2026-01-01&until=2026-01-02&limit=20", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		require.Equal(t, "commit", response[0]["action"])
+		require.Equal(t, "2026-01-02T09:00:00Z", response[0]["created_at"])
+	})
+
+	t.Run("Audit API rejects an invalid time", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/audit?since=yesterday", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Audit API is forbidden to non-superusers", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ListEntries(gomock.Any(), "alice", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can read the audit log"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/audit", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Audit page renders escaped entries with export links", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ListEntries(gomock.Any(), "postgres", domain.AuditFilter{Action: "commit"}).
+			Return(auditEntries, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/audit?action=commit", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		require.Contains(t, body, "shop: public.users")
+		require.Contains(t, body, "&lt;b&gt;fix&lt;/b&gt;")
+		require.NotContains(t, body, "<b>fix</b>")
+		require.Contains(t, body, "/api/admin/audit/export?action=commit&amp;format=csv")
+	})
+
+	t.Run("Audit page highlights logins from new locations", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ListEntries(gomock.Any(), "postgres", domain.AuditFilter{Action: domain.AuditActionLogin}).
+			Return([]domain.AuditEntry{
+				{ID: "audit_login_2", Username: "alice", Action: domain.AuditActionLogin, Target: "203.0.113.9",
+					After: map[string]interface{}{"client_ip": "203.0.113.9", "country_code": "FR", "new_location": true}},
+				{ID: "audit_login_1", Username: "alice", Action: domain.AuditActionLogin, Target: "192.0.2.1",
+					After: map[string]interface{}{"client_ip": "192.0.2.1", "country_code": "NL", "new_location": false}},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/audit?action=login", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		require.Contains(t, body, `<tr data-audit-id="audit_login_2" class="new-location">`)
+		require.Contains(t, body, "login (new location)")
+		require.Contains(t, body, `<tr data-audit-id="audit_login_1"><td>`)
+	})
+
+	t.Run("Audit page offers to restore rows deleted by key", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ListEntries(gomock.Any(), "postgres", domain.AuditFilter{Action: domain.AuditActionCommit}).
+			Return([]domain.AuditEntry{
+				{ID: "audit_delete", Username: "alice", Action: domain.AuditActionCommit, Schema: "public", Table: "users",
+					AffectedRows: []map[string]interface{}{{"operation": "delete", "primary_key": map[string]interface{}{"id": 7}}}},
+				{ID: "audit_edit", Username: "alice", Action: domain.AuditActionCommit, Schema: "public", Table: "users",
+					AffectedRows: []map[string]interface{}{{"operation": "update", "row_index": 1, "column": "name"}}},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/audit?action=commit", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		require.Contains(t, body, `<button type="button" class="restore-deleted" data-entry-id="audit_delete">`)
+		require.NotContains(t, body, `data-entry-id="audit_edit"`)
+		require.Contains(t, body, "/api/transaction/restore-deleted")
+	})
+
+	t.Run("Audit export downloads the filtered log", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ExportEntries(gomock.Any(), "postgres", domain.AuditFilter{Action: "commit"}, domain.AuditExportJSON).
+			Return(&domain.AuditExport{
+				Format:      domain.AuditExportJSON,
+				ContentType: "application/json",
+				Filename:    "audit_log.json",
+				Content:     []byte("[]"),
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/audit/export?action=commit&format=json", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		require.Equal(t, `attachment; filename="audit_log.json"`, w.Header().Get("Content-Disposition"))
+		require.Equal(t, "[]", w.Body.String())
+	})
+
+	t.Run("Audit export rejects an unknown format", func(t *testing.T) {
+		mockAudit.EXPECT().
+			ExportEntries(gomock.Any(), "postgres", domain.AuditFilter{}, "xml").
+			Return(nil, domain.ValidationError{Field: "format", Message: "unsupported export format: xml"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/audit/export?format=xml", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+// newProfileImportRequest builds a multipart connection profile upload for /api/admin/profiles/import
+func newProfileImportRequest(t *testing.T, content string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "lumen-pg-connection-profiles.json")
+	require.NoError(t, err)
+	_, err = io.WriteString(part, content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/profiles/import", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
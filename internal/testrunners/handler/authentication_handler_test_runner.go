@@ -2,6 +2,9 @@ package handler
 
 import (
 	"context"
+	"crypto/tls"
+	"database/sql"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -22,6 +25,7 @@ type AuthenticationHandlerConstructor func(
 	authUC usecase.AuthenticationUseCase,
 	setupUC usecase.SetupUseCase,
 	rbacUC usecase.RBACUseCase,
+	auditUC usecase.AuditUseCase,
 ) handler.LoginHandler
 
 // AuthenticationHandlerRunner runs all authentication handler tests
@@ -38,10 +42,32 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 
 	ctx := context.Background()
 	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+	// Password logins act as the role named by the login
+	mockAuth.EXPECT().
+		ResolveLoginRole(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, username, _ string) (string, error) { return username, nil }).
+		AnyTimes()
+	mockAuth.EXPECT().
+		BindLoginConnection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, _ string) (context.Context, error) { return ctx, nil }).
+		AnyTimes()
+	mockAuth.EXPECT().
+		TouchSession(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	mockAuth.EXPECT().
+		ListConnectionProfiles(gomock.Any()).
+		Return([]domain.ConnectionProfile{domain.DefaultConnectionProfile}, nil).
+		AnyTimes()
 	mockSetup := mockUsecase.NewMockSetupUseCase(ctrl)
 	mockRBAC := mockUsecase.NewMockRBACUseCase(ctrl)
+	mockAudit := mockUsecase.NewMockAuditUseCase(ctrl)
+	mockAudit.EXPECT().
+		RecordLogin(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&domain.LoginEvent{}, nil).
+		AnyTimes()
 
-	h := constructor(mockAuth, mockSetup, mockRBAC)
+	h := constructor(mockAuth, mockSetup, mockRBAC, mockAudit)
 
 	// UC-S2-01: Login Form Validation - Empty Username
 	t.Run("UC-S2-01: Login Form Validation - Empty Username", func(t *testing.T) {
@@ -100,7 +126,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -127,7 +153,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -154,7 +180,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "limited_user", "password123").
+			ProbeConnection(gomock.Any(), "", "limited_user", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -188,7 +214,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -215,7 +241,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -243,7 +269,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -270,7 +296,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -312,7 +338,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -339,7 +365,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -386,7 +412,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -415,7 +441,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb1", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb1", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -511,7 +537,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -538,7 +564,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -566,7 +592,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "limited_user", "password123").
+			ProbeConnection(gomock.Any(), "", "limited_user", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -600,7 +626,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "wrongpassword").
+			ProbeConnection(gomock.Any(), "", "testuser", "wrongpassword").
 			Return(false, domain.ValidationError{Field: "credentials", Message: "Invalid credentials"})
 
 		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
@@ -614,6 +640,80 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 		require.Contains(t, body, "Invalid credentials")
 	})
 
+	t.Run("Login Reports An Unavailable Database Instead Of Bad Credentials", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("username", "testuser")
+		form.Add("password", "password123")
+
+		mockAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), gomock.Any()).
+			Return([]domain.ValidationError{}, nil)
+
+		mockAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
+			Return(false, fmt.Errorf("%w: pq: sorry, too many clients already", domain.ErrDatabaseUnavailable))
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+
+		h.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "database is unavailable")
+		require.NotContains(t, body, "Invalid credentials")
+		require.NotContains(t, body, "too many clients")
+	})
+
+	t.Run("Login Reports A Busy Server When The Connection Cap Is Reached", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("username", "testuser")
+		form.Add("password", "password123")
+
+		mockAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), gomock.Any()).
+			Return([]domain.ValidationError{}, nil)
+
+		mockAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
+			Return(false, fmt.Errorf("failed to ping database: %w", domain.ErrServerBusy))
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+
+		h.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		require.Contains(t, rec.Body.String(), "server is busy")
+	})
+
+	t.Run("Login Hides The Server Message For Rejected Credentials", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("username", "testuser")
+		form.Add("password", "wrongpassword")
+
+		mockAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), gomock.Any()).
+			Return([]domain.ValidationError{}, nil)
+
+		mockAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "", "testuser", "wrongpassword").
+			Return(false, fmt.Errorf("%w: pq: password authentication failed for user \"testuser\"", domain.ErrInvalidCredentials))
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+
+		h.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "Invalid credentials")
+		require.NotContains(t, body, "password authentication failed")
+	})
+
 	// E2E-S2-04: Logout Flow
 	t.Run("E2E-S2-04: Logout Flow", func(t *testing.T) {
 		mockAuth.EXPECT().
@@ -661,7 +761,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -690,7 +790,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb1", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb1", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -729,7 +829,7 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(false, domain.ValidationError{Field: "connection", Message: "Database connection failed"})
 
 		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
@@ -757,4 +857,349 @@ func AuthenticationHandlerRunner(t *testing.T, constructor AuthenticationHandler
 		require.Contains(t, body, "username")
 		require.Contains(t, body, "password")
 	})
+
+	t.Run("SSO Login Redirects To The Provider With State Cookies", func(t *testing.T) {
+		mockAuth.EXPECT().
+			BeginSSOLogin(gomock.Any()).
+			Return(&domain.SSOAuthRequest{
+				URL:   "https://idp.example.com/authorize?state=state-token",
+				State: "state-token",
+				Nonce: "nonce-token",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/login/sso", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "https://idp.example.com/authorize?state=state-token", rec.Header().Get("Location"))
+		cookies := map[string]string{}
+		for _, cookie := range rec.Result().Cookies() {
+			cookies[cookie.Name] = cookie.Value
+		}
+		require.Equal(t, "state-token", cookies["sso_state"])
+		require.Equal(t, "nonce-token", cookies["sso_nonce"])
+	})
+
+	t.Run("SSO Callback Creates A Session For The Mapped Role", func(t *testing.T) {
+		mockAuth.EXPECT().
+			CompleteSSOLogin(gomock.Any(), "auth-code", "nonce-token").
+			Return(&domain.Session{ID: "sso-session", Username: "lumen_admin", Identity: "user-123"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/login/sso/callback?code=auth-code&state=state-token", nil)
+		req.AddCookie(&http.Cookie{Name: "sso_state", Value: "state-token"})
+		req.AddCookie(&http.Cookie{Name: "sso_nonce", Value: "nonce-token"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "/main", rec.Header().Get("Location"))
+		var sessionCookie *http.Cookie
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == "session_id" {
+				sessionCookie = cookie
+			}
+		}
+		require.NotNil(t, sessionCookie)
+		require.Equal(t, "sso-session", sessionCookie.Value)
+	})
+
+	t.Run("SSO Callback Rejects A Mismatched State", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/login/sso/callback?code=auth-code&state=forged", nil)
+		req.AddCookie(&http.Cookie{Name: "sso_state", Value: "state-token"})
+		req.AddCookie(&http.Cookie{Name: "sso_nonce", Value: "nonce-token"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("SSO Callback Forbids Unmapped Identities", func(t *testing.T) {
+		mockAuth.EXPECT().
+			CompleteSSOLogin(gomock.Any(), "auth-code", "nonce-token").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "no database role is mapped to guest@example.com"})
+
+		req := httptest.NewRequest(http.MethodGet, "/login/sso/callback?code=auth-code&state=state-token", nil)
+		req.AddCookie(&http.Cookie{Name: "sso_state", Value: "state-token"})
+		req.AddCookie(&http.Cookie{Name: "sso_nonce", Value: "nonce-token"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Contains(t, rec.Body.String(), "guest@example.com")
+	})
+
+	t.Run("Login Discovers Resources For The Role Mapped From LDAP", func(t *testing.T) {
+		ldapAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+		ldapAudit := mockUsecase.NewMockAuditUseCase(ctrl)
+		ldapHandler := constructor(ldapAuth, mockSetup, mockRBAC, ldapAudit)
+
+		form := url.Values{}
+		form.Add("username", "alice")
+		form.Add("password", "directory-password")
+
+		ldapAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), gomock.Any()).
+			Return([]domain.ValidationError{}, nil)
+		ldapAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "", "alice", "directory-password").
+			Return(true, nil)
+		ldapAuth.EXPECT().
+			ResolveLoginRole(gomock.Any(), "alice", "directory-password").
+			Return("lumen_admin", nil)
+		ldapAuth.EXPECT().
+			BindLoginConnection(gomock.Any(), "", "alice", "directory-password").
+			DoAndReturn(func(ctx context.Context, _, _, _ string) (context.Context, error) { return ctx, nil })
+		ldapAuth.EXPECT().
+			GetUserAccessibleResources(gomock.Any(), "lumen_admin").
+			Return(&domain.RoleMetadata{Name: "lumen_admin", AccessibleDatabases: []string{"appdb"}}, nil)
+		ldapAuth.EXPECT().
+			GetFirstAccessibleDatabase(gomock.Any(), "lumen_admin").
+			Return("appdb", nil)
+		ldapAuth.EXPECT().
+			GetFirstAccessibleSchema(gomock.Any(), "lumen_admin", "appdb").
+			Return("public", nil)
+		ldapAuth.EXPECT().
+			GetFirstAccessibleTable(gomock.Any(), "lumen_admin", "appdb", "public").
+			Return("orders", nil)
+		ldapAuth.EXPECT().
+			CreateSession(gomock.Any(), "", "alice", "directory-password", "appdb", "public", "orders").
+			Return(&domain.Session{ID: "ldap-session", Username: "lumen_admin", Identity: "alice"}, nil)
+		ldapAuth.EXPECT().
+			TouchSession(gomock.Any(), "ldap-session", "192.0.2.1").
+			Return(nil)
+		ldapAudit.EXPECT().
+			RecordLogin(gomock.Any(), "lumen_admin", "192.0.2.1").
+			Return(&domain.LoginEvent{Username: "lumen_admin", ClientIP: "192.0.2.1"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+
+		ldapHandler.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "/main", rec.Header().Get("Location"))
+	})
+
+	t.Run("Login Page Offers A Server Selector For Several Profiles", func(t *testing.T) {
+		profileAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+		profileHandler := constructor(profileAuth, mockSetup, mockRBAC, mockAudit)
+
+		profileAuth.EXPECT().
+			ListConnectionProfiles(gomock.Any()).
+			Return([]domain.ConnectionProfile{
+				{Name: "primary", Host: "db1.internal", Port: 5432},
+				{Name: "<replica>", Host: "db2.internal"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/login", nil)
+		rec := httptest.NewRecorder()
+
+		profileHandler.HandleLoginPage(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `<select id="profile" name="profile">`)
+		require.Contains(t, body, `<option value="primary">primary (db1.internal:5432)</option>`)
+		require.Contains(t, body, `<option value="&lt;replica&gt;">&lt;replica&gt; (db2.internal:5432)</option>`)
+	})
+
+	t.Run("Login Page Omits The Server Selector For One Profile", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/login", nil)
+		rec := httptest.NewRecorder()
+
+		h.HandleLoginPage(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NotContains(t, rec.Body.String(), `name="profile"`)
+	})
+
+	t.Run("Login Connects To The Selected Profile", func(t *testing.T) {
+		profileAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+		profileHandler := constructor(profileAuth, mockSetup, mockRBAC, mockAudit)
+
+		form := url.Values{}
+		form.Add("username", "testuser")
+		form.Add("password", "password123")
+		form.Add("profile", "replica")
+
+		profileAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), domain.LoginRequest{Username: "testuser", Password: "password123", Profile: "replica"}).
+			Return([]domain.ValidationError{}, nil)
+		profileAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "replica", "testuser", "password123").
+			Return(true, nil)
+		profileAuth.EXPECT().
+			ResolveLoginRole(gomock.Any(), "testuser", "password123").
+			Return("testuser", nil)
+		// Resources are discovered on the selected profile's server
+		pool := &sql.DB{}
+		profileAuth.EXPECT().
+			BindLoginConnection(gomock.Any(), "replica", "testuser", "password123").
+			DoAndReturn(func(ctx context.Context, _, _, _ string) (context.Context, error) {
+				return domain.WithSessionConnection(ctx, pool), nil
+			})
+		profileAuth.EXPECT().
+			GetUserAccessibleResources(gomock.Any(), "testuser").
+			DoAndReturn(func(ctx context.Context, _ string) (*domain.RoleMetadata, error) {
+				require.Same(t, pool, domain.SessionConnection(ctx, nil))
+				return &domain.RoleMetadata{Name: "testuser", AccessibleDatabases: []string{"testdb"}}, nil
+			})
+		profileAuth.EXPECT().
+			GetFirstAccessibleDatabase(gomock.Any(), "testuser").
+			Return("testdb", nil)
+		profileAuth.EXPECT().
+			GetFirstAccessibleSchema(gomock.Any(), "testuser", "testdb").
+			Return("public", nil)
+		profileAuth.EXPECT().
+			GetFirstAccessibleTable(gomock.Any(), "testuser", "testdb", "public").
+			Return("users", nil)
+		profileAuth.EXPECT().
+			CreateSession(gomock.Any(), "replica", "testuser", "password123", "testdb", "public", "users").
+			Return(&domain.Session{ID: "replica-session", Username: "testuser", Profile: "replica"}, nil)
+		profileAuth.EXPECT().
+			TouchSession(gomock.Any(), "replica-session", gomock.Any()).
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+
+		profileHandler.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusFound, rec.Code)
+	})
+
+	t.Run("Login Rejects An Unknown Profile", func(t *testing.T) {
+		profileAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+		profileHandler := constructor(profileAuth, mockSetup, mockRBAC, mockAudit)
+
+		form := url.Values{}
+		form.Add("username", "testuser")
+		form.Add("password", "password123")
+		form.Add("profile", "<staging>")
+
+		profileAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), gomock.Any()).
+			Return([]domain.ValidationError{}, nil)
+		profileAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "<staging>", "testuser", "password123").
+			Return(false, domain.ValidationError{Field: "profile", Message: "unknown connection profile: <staging>"})
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+
+		profileHandler.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "unknown connection profile: &lt;staging&gt;")
+	})
+
+	t.Run("Session Cookie Is Secure When Served Over HTTPS", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("username", "tlsuser")
+		form.Add("password", "password123")
+
+		mockAuth.EXPECT().
+			ValidateLoginForm(gomock.Any(), gomock.Any()).
+			Return([]domain.ValidationError{}, nil)
+
+		mockAuth.EXPECT().
+			ProbeConnection(gomock.Any(), "", "tlsuser", "password123").
+			Return(true, nil)
+
+		mockAuth.EXPECT().
+			GetUserAccessibleResources(gomock.Any(), "tlsuser").
+			Return(&domain.RoleMetadata{Name: "tlsuser", AccessibleDatabases: []string{"testdb"}}, nil)
+
+		mockAuth.EXPECT().
+			GetFirstAccessibleDatabase(gomock.Any(), "tlsuser").
+			Return("testdb", nil)
+
+		mockAuth.EXPECT().
+			GetFirstAccessibleSchema(gomock.Any(), "tlsuser", "testdb").
+			Return("public", nil)
+
+		mockAuth.EXPECT().
+			GetFirstAccessibleTable(gomock.Any(), "tlsuser", "testdb", "public").
+			Return("users", nil)
+
+		mockAuth.EXPECT().
+			CreateSession(gomock.Any(), "", "tlsuser", "password123", "testdb", "public", "users").
+			Return(&domain.Session{ID: "session_tls", Username: "tlsuser"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "https://lumen.example.com/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.TLS = &tls.ConnectionState{}
+		rec := httptest.NewRecorder()
+
+		h.HandleLogin(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		var sessionCookie *http.Cookie
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == "session_id" {
+				sessionCookie = cookie
+			}
+		}
+		require.NotNil(t, sessionCookie)
+		require.True(t, sessionCookie.Secure)
+	})
+
+	t.Run("Account activity page shows the user's own activity", func(t *testing.T) {
+		activityAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+		activityAudit := mockUsecase.NewMockAuditUseCase(ctrl)
+		activityHandler := constructor(activityAuth, mockSetup, mockRBAC, activityAudit)
+
+		activityAuth.EXPECT().
+			ValidateSession(gomock.Any(), "alice-session").
+			Return(&domain.Session{ID: "alice-session", Username: "alice"}, nil)
+		activityAudit.EXPECT().
+			ListAccountActivity(gomock.Any(), "alice").
+			Return(&domain.AccountActivity{
+				Logins: []domain.AuditEntry{
+					{Username: "alice", Action: domain.AuditActionLogin, Target: "203.0.113.9",
+						After: map[string]interface{}{"city": "Paris", "region": "Ile-de-France", "country": "France", "new_location": true}},
+					{Username: "alice", Action: domain.AuditActionLogin, Target: "192.0.2.1", After: map[string]interface{}{"new_location": false}},
+				},
+				Queries: []domain.AuditEntry{
+					{Username: "alice", Action: domain.AuditActionQuery, After: map[string]interface{}{"statement": "SELECT * FROM t WHERE a < $1", "succeeded": true}},
+				},
+				Exports: []domain.AuditEntry{
+					{Username: "alice", Action: domain.AuditActionExport, Database: "shop", Schema: "public", Table: "orders",
+						After: map[string]interface{}{"format": "xlsx", "rows": 12}},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/account/activity", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "alice-session"})
+		rec := httptest.NewRecorder()
+
+		activityHandler.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `<tr class="new-location">`)
+		require.Contains(t, body, "Paris, Ile-de-France, France (new location)")
+		require.Contains(t, body, "unknown")
+		require.Contains(t, body, "SELECT * FROM t WHERE a &lt; $1")
+		require.Contains(t, body, "shop: public.orders")
+		require.Contains(t, body, "<td>xlsx</td><td>12</td>")
+	})
+
+	t.Run("Account activity page redirects without a session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/account/activity", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "/login", rec.Header().Get("Location"))
+	})
 }
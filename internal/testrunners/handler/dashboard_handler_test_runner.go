@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// DashboardHandlerConstructor is a function type that creates a DashboardHandler
+type DashboardHandlerConstructor func(
+	dashboardUC usecase.DashboardUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.DashboardHandler
+
+// DashboardHandlerRunner runs all home dashboard handler tests
+func DashboardHandlerRunner(t *testing.T, constructor DashboardHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDashboard := mockUsecase.NewMockDashboardUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockDashboard, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "alice"}, nil).
+		AnyTimes()
+
+	newRequest := func(method, path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	pinnedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	ordersTile := domain.DashboardTile{
+		ID: "tile_orders", SavedQueryID: "query_orders", Title: "Open orders",
+		Kind: domain.DashboardTileRowCount, RefreshSeconds: 30, CreatedAt: pinnedAt,
+	}
+
+	t.Run("Dashboard page renders each tile with its refresh interval", func(t *testing.T) {
+		mockDashboard.EXPECT().ListDashboardTiles(gomock.Any(), "alice").Return([]domain.DashboardTile{
+			ordersTile,
+			{ID: "tile_revenue", SavedQueryID: "query_revenue", Title: "<Revenue>", Kind: domain.DashboardTileScalar, RefreshSeconds: 300},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodGet, "/dashboard", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		require.Contains(t, body, `data-tile-id="tile_orders" data-kind="row_count" data-refresh-seconds="30"`)
+		require.Contains(t, body, `data-tile-id="tile_revenue" data-kind="scalar" data-refresh-seconds="300"`)
+		require.Contains(t, body, "&lt;Revenue&gt;")
+		require.NotContains(t, body, "no-tiles")
+	})
+
+	t.Run("Dashboard page redirects without a session", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/dashboard", nil))
+
+		require.Equal(t, http.StatusFound, w.Code)
+		require.Equal(t, "/login", w.Header().Get("Location"))
+	})
+
+	t.Run("Dashboard API lists the user's tiles", func(t *testing.T) {
+		mockDashboard.EXPECT().ListDashboardTiles(gomock.Any(), "alice").Return([]domain.DashboardTile{ordersTile}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodGet, "/api/dashboard/tiles", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"tiles":[{"id":"tile_orders","saved_query_id":"query_orders","title":"Open orders",
+			"kind":"row_count","refresh_seconds":30,"created_at":"2026-10-18T09:00:00Z"}]}`, w.Body.String())
+	})
+
+	t.Run("Dashboard API pins a saved query", func(t *testing.T) {
+		mockDashboard.EXPECT().
+			PinQueryResult(gomock.Any(), "alice", domain.DashboardTile{
+				SavedQueryID: "query_orders", Title: "Open orders", Kind: "row_count", RefreshSeconds: 30,
+			}).
+			Return(&ordersTile, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodPost, "/api/dashboard/pin", url.Values{
+			"saved_query_id": {"query_orders"}, "title": {"Open orders"}, "kind": {"row_count"}, "refresh_seconds": {"30"},
+		}))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Contains(t, w.Body.String(), `"id":"tile_orders"`)
+	})
+
+	t.Run("Dashboard API refuses a malformed refresh interval and unpinnable queries", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodPost, "/api/dashboard/pin", url.Values{
+			"saved_query_id": {"query_orders"}, "refresh_seconds": {"soon"},
+		}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockDashboard.EXPECT().
+			PinQueryResult(gomock.Any(), "alice", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "query", Message: "only SELECT queries can be pinned"})
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodPost, "/api/dashboard/pin", url.Values{"saved_query_id": {"query_purge"}}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "only SELECT queries can be pinned")
+	})
+
+	t.Run("Dashboard API pin requires POST", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodGet, "/api/dashboard/pin", nil))
+
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Dashboard API unpins a tile", func(t *testing.T) {
+		mockDashboard.EXPECT().UnpinDashboardTile(gomock.Any(), "alice", "tile_orders").Return(nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodPost, "/api/dashboard/unpin", url.Values{"id": {"tile_orders"}}))
+
+		require.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Dashboard API reports an unknown tile", func(t *testing.T) {
+		mockDashboard.EXPECT().UnpinDashboardTile(gomock.Any(), "alice", "tile_missing").Return(domain.ErrDashboardTileNotFound)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodPost, "/api/dashboard/unpin", url.Values{"id": {"tile_missing"}}))
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Dashboard API refreshes a tile", func(t *testing.T) {
+		mockDashboard.EXPECT().RefreshDashboardTile(gomock.Any(), "alice", "tile_revenue").Return(&domain.DashboardTileResult{
+			TileID: "tile_revenue", RowCount: 1, Value: "1520.50", RefreshedAt: pinnedAt,
+		}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodGet, "/api/dashboard/refresh?id=tile_revenue", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"tile_id":"tile_revenue","row_count":1,"value":"1520.50","refreshed_at":"2026-10-18T09:00:00Z"}`, w.Body.String())
+	})
+
+	t.Run("Dashboard API refresh refuses users without permission", func(t *testing.T) {
+		mockDashboard.EXPECT().
+			RefreshDashboardTile(gomock.Any(), "alice", "tile_orders").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(http.MethodGet, "/api/dashboard/refresh?id=tile_orders", nil))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
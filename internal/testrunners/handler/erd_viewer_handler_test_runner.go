@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -366,4 +367,66 @@ func ERDViewerHandlerRunner(t *testing.T, constructor ERDViewerHandlerConstructo
 
 		require.Equal(t, http.StatusUnauthorized, rec.Code)
 	})
+
+	t.Run("Schema graph API returns tables and edges with cardinality hints", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockERD.EXPECT().
+			GetSchemaGraph(gomock.Any(), "testuser", "testdb", "public", "orders", 1).
+			Return(&domain.SchemaGraph{
+				Database: "testdb", Schema: "public", Root: "orders", Depth: 1,
+				Tables: []domain.ERDTable{
+					{Name: "orders", PrimaryKeys: []string{"id"}, Columns: []domain.ERDColumn{{Name: "id", DataType: "integer", IsPrimary: true}, {Name: "customer_id", DataType: "integer", IsNullable: true}}},
+					{Name: "customers", PrimaryKeys: []string{"id"}, Columns: []domain.ERDColumn{{Name: "id", DataType: "integer", IsPrimary: true}}},
+				},
+				Relationships: []domain.ERDRelationship{
+					{FromTable: "orders", FromColumn: "customer_id", ToTable: "customers", ToColumn: "id", RelationType: domain.RelationTypeManyToOne, Optional: true},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/schema/graph?database=testdb&schema=public&root=orders&depth=1", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			Root   string                   `json:"root"`
+			Tables []map[string]interface{} `json:"tables"`
+			Edges  []map[string]interface{} `json:"edges"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Equal(t, "orders", response.Root)
+		require.Len(t, response.Tables, 2)
+		require.Len(t, response.Tables[0]["columns"], 2)
+		require.Len(t, response.Edges, 1)
+		require.Equal(t, "many-to-one", response.Edges[0]["cardinality"])
+		require.Equal(t, true, response.Edges[0]["optional"])
+	})
+
+	t.Run("Schema graph API rejects a bad depth and reports a missing root", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil).
+			Times(2)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/schema/graph?database=testdb&schema=public&depth=two", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req.WithContext(ctx))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+
+		mockERD.EXPECT().
+			GetSchemaGraph(gomock.Any(), "testuser", "testdb", "public", "ghosts", 0).
+			Return(nil, domain.ErrTableNotFound)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/schema/graph?database=testdb&schema=public&root=ghosts", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, req.WithContext(ctx))
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
 }
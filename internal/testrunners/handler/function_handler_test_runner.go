@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// FunctionHandlerConstructor is a function type that creates a FunctionHandler
+type FunctionHandlerConstructor func(
+	functionUC usecase.FunctionUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.FunctionHandler
+
+// FunctionHandlerRunner runs all function handler tests
+func FunctionHandlerRunner(t *testing.T, constructor FunctionHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFunction := mockUsecase.NewMockFunctionUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockFunction, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "analyst", SearchPath: []string{"sales", "public"}}, nil).
+		AnyTimes()
+
+	newFormRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	t.Run("Functions API lists signatures and parameters", func(t *testing.T) {
+		mockFunction.EXPECT().
+			ListFunctions(gomock.Any(), "analyst", "shop", "public").
+			Return([]domain.StoredFunction{
+				{Schema: "public", Name: "order_total", Kind: domain.FunctionKindFunction, Arguments: "order_id integer", Result: "numeric", Language: "sql",
+					Parameters: []domain.FunctionParameter{{Name: "order_id", DataType: "integer", Mode: domain.FunctionParameterModeIn}}, CanExecute: true},
+				{Schema: "public", Name: "archive_orders", Kind: domain.FunctionKindProcedure, Language: "plpgsql"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/functions?database=shop&schema=public", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Functions []map[string]interface{} `json:"functions"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Functions, 2)
+		require.Equal(t, "order_id integer", response.Functions[0]["arguments"])
+		require.Equal(t, true, response.Functions[0]["can_execute"])
+		require.Len(t, response.Functions[0]["parameters"], 1)
+		require.Equal(t, "procedure", response.Functions[1]["kind"])
+		require.Equal(t, false, response.Functions[1]["can_execute"])
+	})
+
+	t.Run("Functions API requires the schema and a session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/functions?database=shop", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/functions?database=shop&schema=public", nil)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Source API returns the definition and maps a missing routine to 404", func(t *testing.T) {
+		mockFunction.EXPECT().
+			GetFunctionSource(gomock.Any(), "analyst", "shop", "public", "order_total", "order_id integer").
+			Return("CREATE OR REPLACE FUNCTION public.order_total(order_id integer)", nil)
+		mockFunction.EXPECT().
+			GetFunctionSource(gomock.Any(), "analyst", "shop", "public", "dropped", "").
+			Return("", domain.ErrFunctionNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/functions/source?database=shop&schema=public&name=order_total&arguments="+url.QueryEscape("order_id integer"), nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Contains(t, response["definition"], "FUNCTION public.order_total")
+
+		req = httptest.NewRequest(http.MethodGet, "/api/functions/source?database=shop&schema=public&name=dropped", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Execute API pairs values with their kinds and passes the session search path", func(t *testing.T) {
+		mockFunction.EXPECT().
+			ExecuteFunction(gomock.Any(), "analyst", domain.FunctionCall{
+				Database: "shop", Schema: "public", Name: "order_total", Arguments: "order_id integer, currency text",
+				Values: []domain.FunctionArgument{{Value: "42"}, {Null: true}},
+			}, gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ string, _ domain.FunctionCall, params domain.QueryParams) (*domain.FunctionCallResult, error) {
+				require.Equal(t, []string{"sales", "public"}, params.SearchPath)
+				require.Equal(t, 50, params.Limit)
+				return &domain.FunctionCallResult{
+					Statement: `SELECT * FROM "public"."order_total"($1::integer, $2::text)`,
+					Result: &domain.QueryResult{
+						Columns:    []string{"order_total"},
+						Rows:       []map[string]interface{}{{"order_total": "99.50"}},
+						RowCount:   1,
+						TotalCount: 1,
+					},
+				}, nil
+			})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/functions/execute", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"order_total"}, "arguments": {"order_id integer, currency text"},
+			"value": {"42", ""}, "kind": {"value", "null"},
+		}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Contains(t, response["statement"], "$1::integer")
+		require.Equal(t, float64(1), response["row_count"])
+	})
+
+	t.Run("Execute API rejects unpaired values, GET and a missing permission", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/functions/execute", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"order_total"}, "value": {"42"},
+		}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/functions/execute", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+		mockFunction.EXPECT().
+			ExecuteFunction(gomock.Any(), "analyst", gomock.Any(), gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "EXECUTE permission required"})
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/functions/execute", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"archive_orders"},
+		}))
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
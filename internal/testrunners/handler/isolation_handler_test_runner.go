@@ -50,6 +50,19 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 
 	ctx := context.Background()
 	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+	// Password logins act as the role named by the login
+	mockAuth.EXPECT().
+		ResolveLoginRole(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, username, _ string) (string, error) { return username, nil }).
+		AnyTimes()
+	mockAuth.EXPECT().
+		BindLoginConnection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, _ string) (context.Context, error) { return ctx, nil }).
+		AnyTimes()
+	mockAuth.EXPECT().
+		TouchSession(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
 	mockDataView := mockUsecase.NewMockDataViewUseCase(ctrl)
 	mockTxn := mockUsecase.NewMockTransactionUseCase(ctrl)
 	mockRBAC := mockUsecase.NewMockRBACUseCase(ctrl)
@@ -160,6 +173,10 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 			CheckActiveTransaction(gomock.Any(), "user1").
 			Return(false, nil).Times(1)
 
+		mockTxn.EXPECT().
+			CheckTableLocks(gomock.Any(), "user1", "testdb", "public", "users").
+			Return(nil, nil)
+
 		mockTxn.EXPECT().
 			StartTransaction(gomock.Any(), "user1", "testdb", "public", "users").
 			Return(&domain.TransactionState{
@@ -193,6 +210,10 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 			CheckActiveTransaction(gomock.Any(), "user2").
 			Return(false, nil).Times(1)
 
+		mockTxn.EXPECT().
+			CheckTableLocks(gomock.Any(), "user2", "testdb", "public", "users").
+			Return(nil, nil)
+
 		mockTxn.EXPECT().
 			StartTransaction(gomock.Any(), "user2", "testdb", "public", "users").
 			Return(&domain.TransactionState{
@@ -299,7 +320,7 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "userA", "passwordA").
+			ProbeConnection(gomock.Any(), "", "userA", "passwordA").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -326,7 +347,7 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "userA", "passwordA", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "userA", "passwordA", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_userA",
 				Username: "userA",
@@ -350,7 +371,7 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "userB", "passwordB").
+			ProbeConnection(gomock.Any(), "", "userB", "passwordB").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -377,7 +398,7 @@ func IsolationHandlerRunner(t *testing.T, constructor IsolationHandlerConstructo
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "userB", "passwordB", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "userB", "passwordB", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_userB",
 				Username: "userB",
@@ -1,13 +1,17 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -23,6 +27,8 @@ type MainViewHandlerConstructor func(
 	dataViewUC usecase.DataViewUseCase,
 	authUC usecase.AuthenticationUseCase,
 	rbacUC usecase.RBACUseCase,
+	importUC usecase.ImportUseCase,
+	tableViewUC usecase.TableViewUseCase,
 ) handler.MainViewHandler
 
 // MainViewHandlerRunner runs all main view handler tests
@@ -42,8 +48,16 @@ func MainViewHandlerRunner(t *testing.T, constructor MainViewHandlerConstructor)
 	mockDataView := mockUsecase.NewMockDataViewUseCase(ctrl)
 	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
 	mockRBAC := mockUsecase.NewMockRBACUseCase(ctrl)
+	mockImport := mockUsecase.NewMockImportUseCase(ctrl)
+	mockTableView := mockUsecase.NewMockTableViewUseCase(ctrl)
 
-	h := constructor(mockDataView, mockAuth, mockRBAC)
+	h := constructor(mockDataView, mockAuth, mockRBAC, mockImport, mockTableView)
+
+	// No saved views are set unless a test sets them
+	mockTableView.EXPECT().
+		GetDefaultTableView(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		AnyTimes()
 
 	// E2E-S5-01: Main View Default Load
 	t.Run("E2E-S5-01: Main View Default Load", func(t *testing.T) {
@@ -93,6 +107,34 @@ func MainViewHandlerRunner(t *testing.T, constructor MainViewHandlerConstructor)
 		require.Contains(t, body, "users")
 		require.Contains(t, body, "Alice")
 		require.Contains(t, body, "Bob")
+
+		// The Stats, Indexes and Structure tabs load through their APIs
+		require.Contains(t, body, `data-tab="stats-tab"`)
+		require.Contains(t, body, `data-tab="indexes-tab"`)
+		require.Contains(t, body, `<option value="gin">gin</option>`)
+		require.Contains(t, body, `data-tab="structure-tab"`)
+		require.Contains(t, body, `<option value="SET NULL">SET NULL</option>`)
+		require.Contains(t, body, `id="table-designer"`)
+		require.Contains(t, body, `<th data-column="email">email</th>`)
+		require.Contains(t, body, `id="comment-list"`)
+		require.Contains(t, body, "/api/table/comments/set")
+		require.Contains(t, body, `<option value="set_type">Change type</option>`)
+		require.Contains(t, body, "/api/table/alter")
+		require.Contains(t, body, `data-tab="triggers-tab"`)
+		require.Contains(t, body, `data-tab="sql-tab"`)
+		require.Contains(t, body, "/api/table/ddl?")
+		require.Contains(t, body, `id="function-list"`)
+
+		// The sidebar creates databases and schemas through the schema API
+		require.Contains(t, body, `data-path="/api/databases/create"`)
+		require.Contains(t, body, `data-path="/api/schemas/create"`)
+
+		// Activity on the page keeps an open transaction from being rolled back as idle
+		require.Contains(t, body, `id="transaction-idle-warning"`)
+		require.Contains(t, body, "/api/transaction/heartbeat")
+		require.Contains(t, body, "}, 30000);")
+		require.Contains(t, body, "new EventSource('/api/transaction/events')")
+		require.Contains(t, body, "/api/functions/execute")
 	})
 
 	// E2E-S5-02: Table Selection from Sidebar
@@ -142,6 +184,68 @@ func MainViewHandlerRunner(t *testing.T, constructor MainViewHandlerConstructor)
 		require.Contains(t, body, "user_id")
 	})
 
+	t.Run("Table selection applies the user's default view", func(t *testing.T) {
+		viewCtrl := gomock.NewController(t)
+		defer viewCtrl.Finish()
+
+		viewDataView := mockUsecase.NewMockDataViewUseCase(viewCtrl)
+		viewAuth := mockUsecase.NewMockAuthenticationUseCase(viewCtrl)
+		viewRBAC := mockUsecase.NewMockRBACUseCase(viewCtrl)
+		viewTableView := mockUsecase.NewMockTableViewUseCase(viewCtrl)
+		viewHandler := constructor(viewDataView, viewAuth, viewRBAC, mockUsecase.NewMockImportUseCase(viewCtrl), viewTableView)
+
+		viewAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		viewRBAC.EXPECT().
+			CheckTableAccess(gomock.Any(), "testuser", "testdb", "public", "posts").
+			Return(true, nil)
+		viewTableView.EXPECT().
+			GetDefaultTableView(gomock.Any(), "testuser", "testdb", "public", "posts").
+			Return(&domain.SavedTableView{
+				Name:          "Published",
+				WhereClause:   "published = true",
+				OrderBy:       "id",
+				OrderDir:      "DESC",
+				HiddenColumns: []string{"content"},
+				IsDefault:     true,
+			}, nil)
+		viewDataView.EXPECT().
+			ValidateWhereClause(gomock.Any(), "published = true").
+			Return(true, nil)
+		viewDataView.EXPECT().
+			LoadTableData(gomock.Any(), "testuser", domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "posts",
+				WhereClause: "published = true",
+				OrderBy:     "id",
+				OrderDir:    "DESC",
+				Limit:       50,
+				SnapshotKey: "session_123",
+			}).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "title", "content"},
+				Rows:     []map[string]interface{}{{"id": 2, "title": "Second Post", "content": "Long body"}},
+				RowCount: 1,
+			}, nil)
+
+		form := url.Values{"database": {"testdb"}, "schema": {"public"}, "table": {"posts"}}
+		req := httptest.NewRequest(http.MethodPost, "/main/select-table", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		viewHandler.HandleTableSelect(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `data-view="Published"`)
+		require.Contains(t, body, "Second Post")
+		require.NotContains(t, body, "<th>content</th>")
+		require.NotContains(t, body, "Long body")
+	})
+
 	// E2E-S5-03: WHERE Bar Filtering
 	t.Run("E2E-S5-03: WHERE Bar Filtering", func(t *testing.T) {
 		form := url.Values{}
@@ -190,6 +294,133 @@ func MainViewHandlerRunner(t *testing.T, constructor MainViewHandlerConstructor)
 		require.Contains(t, body, "active")
 	})
 
+	t.Run("Table search API returns the matching rows and the columns they matched in", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil).
+			Times(2)
+
+		mockDataView.EXPECT().
+			SearchTableData(gomock.Any(), "testuser", "testdb", "public", "users", "ali").
+			Return(&domain.TableSearchResult{
+				Columns:         []string{"id", "name", "email"},
+				Rows:            []map[string]interface{}{{"id": 1, "name": "Alice", "email": "alice@example.com"}},
+				MatchedColumns:  [][]string{{"name", "email"}},
+				SearchedColumns: []string{"name", "email"},
+				TotalMatches:    3,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/search?database=testdb&schema=public&table=users&q=ali", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"columns":["id","name","email"],"rows":[{"id":1,"name":"Alice","email":"alice@example.com"}],
+			"matched_columns":[["name","email"]],"searched_columns":["name","email"],"total_matches":3,"truncated":true}`, rec.Body.String())
+
+		// A search that runs out of time is reported as a bad request, asking for a narrower filter
+		mockDataView.EXPECT().
+			SearchTableData(gomock.Any(), "testuser", "testdb", "public", "events", "x").
+			Return(nil, domain.ValidationError{Field: "search", Message: "search did not finish within 10s; filter on a column instead"})
+
+		req = httptest.NewRequest(http.MethodGet, "/api/table/search?database=testdb&schema=public&table=events&q=x", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec = httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "filter on a column instead")
+	})
+
+	t.Run("Object search API returns ranked matches across accessible schemas", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil).
+			Times(2)
+
+		mockDataView.EXPECT().
+			SearchObjects(gomock.Any(), "testuser", "ord").
+			Return([]domain.ObjectSearchMatch{
+				{Kind: domain.ObjectKindTable, Database: "testdb", Schema: "public", Name: "orders", Rank: domain.ObjectMatchPrefix},
+				{Kind: domain.ObjectKindColumn, Database: "testdb", Schema: "public", Table: "customers", Name: "last_order_at", Rank: domain.ObjectMatchWord},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/search?q=ord", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"matches":[
+			{"kind":"table","database":"testdb","schema":"public","table":"","name":"orders","rank":1},
+			{"kind":"column","database":"testdb","schema":"public","table":"customers","name":"last_order_at","rank":2}]}`, rec.Body.String())
+
+		mockDataView.EXPECT().
+			SearchObjects(gomock.Any(), "testuser", "").
+			Return(nil, domain.ValidationError{Field: "search", Message: "search term is required"})
+
+		req = httptest.NewRequest(http.MethodGet, "/api/search", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec = httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "search term is required")
+	})
+
+	t.Run("Structured filter API compiles predicates instead of a WHERE clause", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil).
+			Times(2)
+
+		mockDataView.EXPECT().
+			FilterTableDataByPredicates(gomock.Any(), "testuser", "testdb", "public", "users", domain.FilterPredicate{
+				Group: domain.FilterGroupOr,
+				Predicates: []domain.FilterPredicate{
+					{Column: "status", Operator: domain.FilterOperatorEqual, Value: "active"},
+					{Column: "id", Operator: domain.FilterOperatorIn, Value: []interface{}{float64(11), float64(12)}},
+				},
+			}, 50, 50).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id", "status"},
+				Rows:       []map[string]interface{}{{"id": 11, "status": "active"}},
+				RowCount:   1,
+				TotalCount: 1,
+			}, nil)
+
+		body := `{"database":"testdb","schema":"public","table":"users","offset":50,"filter":{"group":"or","predicates":[
+			{"column":"status","operator":"eq","value":"active"},
+			{"column":"id","operator":"in","value":[11,12]}]}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/table/filter", strings.NewReader(body))
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"columns":["id","status"],"rows":[{"id":11,"status":"active"}],"row_count":1,"total_count":1}`, rec.Body.String())
+
+		// A predicate the usecase refuses is a bad request
+		mockDataView.EXPECT().
+			FilterTableDataByPredicates(gomock.Any(), "testuser", "testdb", "public", "users", gomock.Any(), 0, 50).
+			Return(nil, domain.ValidationError{Field: "filter", Message: `unsupported filter operator "~"`})
+
+		req = httptest.NewRequest(http.MethodPost, "/api/table/filter", strings.NewReader(`{"database":"testdb","schema":"public","table":"users","filter":{"column":"id","operator":"~","value":"1"}}`))
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec = httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "unsupported filter operator")
+	})
+
 	// E2E-S5-04: Column Header Sorting
 	t.Run("E2E-S5-04: Column Header Sorting", func(t *testing.T) {
 		form := url.Values{}
@@ -418,6 +649,30 @@ func MainViewHandlerRunner(t *testing.T, constructor MainViewHandlerConstructor)
 		// which is tested via HandleMainViewPage
 	})
 
+	t.Run("Main view says which metadata is still loading or could not be listed", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockAuth.EXPECT().
+			GetUserAccessibleResources(gomock.Any(), "testuser").
+			Return(&domain.RoleMetadata{
+				Name:                "testuser",
+				AccessibleDatabases: []string{"shop", "warehouse"},
+				Loading:             domain.MetadataStageTables,
+				Incomplete:          []string{"warehouse"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.HandleMainViewPage(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "Schemas loaded, tables loading… Not listed in time: warehouse")
+		require.Contains(t, rec.Body.String(), "No accessible tables")
+	})
+
 	// Additional test: Unauthorized access
 	t.Run("Unauthorized Access to Main View", func(t *testing.T) {
 		// No mock needed - handler returns early when no cookie is present
@@ -534,4 +789,1531 @@ func MainViewHandlerRunner(t *testing.T, constructor MainViewHandlerConstructor)
 		// Verify previous page loaded
 		require.NotEmpty(t, body)
 	})
+
+	// Additional test: Column aggregate footer
+	t.Run("Column Aggregate Returns JSON", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("column", "amount")
+		form.Add("where", "id > 0")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		sum, avg, min, max := 60.0, 20.0, 10.0, 30.0
+		mockDataView.EXPECT().
+			GetColumnAggregate(gomock.Any(), "testuser", "testdb", "public", "orders", "amount", "id > 0").
+			Return(&domain.ColumnAggregate{Column: "amount", Count: 3, Sum: &sum, Avg: &avg, Min: &min, Max: &max}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/aggregate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleColumnAggregate(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		body := rec.Body.String()
+		require.Contains(t, body, `"Sum":60`)
+		require.Contains(t, body, `"Count":3`)
+	})
+
+	// Additional test: Group-by pivot preview
+	t.Run("Group By Returns Summary JSON", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("column", "status")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GroupTableData(gomock.Any(), "testuser", gomock.Any()).
+			Return(&domain.GroupByResult{
+				GroupColumn: "status",
+				Groups: []domain.GroupByBucket{
+					{Value: "paid", Count: 2, DrillDownFilter: `"status" = 'paid'`},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/group-by", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleGroupBy(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"Value":"paid"`)
+		require.Contains(t, body, "DrillDownFilter")
+	})
+
+	// Additional test: Histogram
+	t.Run("Histogram Returns Buckets JSON", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("column", "amount")
+		form.Add("buckets", "2")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetColumnHistogram(gomock.Any(), "testuser", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.HistogramParams) (*domain.HistogramResult, error) {
+				require.Equal(t, 2, params.Buckets)
+				return &domain.HistogramResult{
+					Column: "amount",
+					Buckets: []domain.HistogramBucket{
+						{LowerBound: 0.0, UpperBound: 50.0, Count: 4},
+						{LowerBound: 50.0, UpperBound: 100.0, Count: 1},
+					},
+				}, nil
+			})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/histogram", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleHistogram(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"Count":4`)
+		require.Contains(t, body, `"UpperBound":100`)
+	})
+
+	// Additional test: Duplicate row report
+	t.Run("Duplicate Rows Returns Groups JSON", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "users")
+		form.Add("columns", "name, email")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			FindDuplicateRows(gomock.Any(), "testuser", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.DuplicateParams) (*domain.DuplicateReport, error) {
+				require.Equal(t, []string{"name", "email"}, params.Columns)
+				return &domain.DuplicateReport{
+					Columns: params.Columns,
+					Groups: []domain.DuplicateGroup{
+						{
+							Values:            map[string]interface{}{"name": "Alice", "email": "alice@example.com"},
+							Count:             3,
+							SamplePrimaryKeys: []map[string]interface{}{{"id": 1}},
+						},
+					},
+				}, nil
+			})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/duplicates", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleDuplicateRows(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"Count":3`)
+		require.Contains(t, body, "SamplePrimaryKeys")
+	})
+
+	// Additional test: Null and empty-value audit
+	t.Run("Data Quality Returns Report JSON", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "users")
+		form.Add("sample", "500")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetDataQualityReport(gomock.Any(), "testuser", "testdb", "public", "users", 500).
+			Return(&domain.DataQualityReport{
+				Table:       "users",
+				SampledRows: 500,
+				Columns: []domain.ColumnQualityStats{
+					{Column: "email", DataType: "text", NullCount: 50, NullPercent: 10},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/quality", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleDataQuality(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"SampledRows":500`)
+		require.Contains(t, body, `"NullPercent":10`)
+	})
+
+	t.Run("Table Sizes Reports Estimates For A Batch Of Tables", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		rows := int64(1200)
+		mockDataView.EXPECT().
+			GetTableSizeEstimates(gomock.Any(), "testuser", "testdb", "public", []string{"orders", "events"}).
+			Return([]domain.TableSizeEstimate{
+				{Schema: "public", Table: "orders", EstimatedRows: &rows, TotalSizeBytes: 98304},
+				{Schema: "public", Table: "events", TotalSizeBytes: 8192},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/sizes?database=testdb&schema=public&table=orders&table=events", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"tables":[
+			{"schema":"public","table":"orders","estimated_rows":1200,"total_size_bytes":98304},
+			{"schema":"public","table":"events","estimated_rows":null,"total_size_bytes":8192}]}`, rec.Body.String())
+	})
+
+	t.Run("Table Stats Reports Statistics As JSON", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		lastAutovacuum := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+		bloat := int64(8192)
+		mockDataView.EXPECT().
+			GetTableStatistics(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(&domain.TableStatistics{
+				Table:               "users",
+				LiveTuples:          900,
+				DeadTuples:          100,
+				DeadTuplePercent:    10,
+				LastAutovacuum:      &lastAutovacuum,
+				EstimatedBloatBytes: &bloat,
+				Indexes:             []domain.IndexUsageStats{{Name: "users_created_idx"}},
+				UnusedIndexes:       []string{"users_created_idx"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/stats?database=testdb&schema=public&table=users", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Equal(t, float64(100), response["dead_tuples"])
+		require.Equal(t, float64(10), response["dead_tuple_percent"])
+		require.Equal(t, "2026-03-04T05:06:07Z", response["last_autovacuum"])
+		require.Nil(t, response["last_vacuum"])
+		require.Equal(t, float64(8192), response["estimated_bloat_bytes"])
+		require.Nil(t, response["estimated_bloat_percent"])
+		require.Equal(t, []interface{}{"users_created_idx"}, response["unused_indexes"])
+	})
+
+	t.Run("Table Stats Rejects Tables Without SELECT Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+		mockDataView.EXPECT().
+			GetTableStatistics(gomock.Any(), "testuser", "testdb", "public", "salaries").
+			Return(nil, domain.ValidationError{Field: "table", Message: "user does not have SELECT permission on this table"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/stats?database=testdb&schema=public&table=salaries", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	// Additional test: Referential integrity export
+	t.Run("Referential Integrity Exports Orphaned Rows As CSV", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "posts")
+		form.Add("column", "user_id")
+		form.Add("ref_table", "users")
+		form.Add("ref_column", "id")
+		form.Add("format", "csv")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockRBAC.EXPECT().
+			CheckExportPermission(gomock.Any(), "testuser", "testdb", "public", "posts").
+			Return(true, nil)
+
+		mockDataView.EXPECT().
+			CheckReferentialIntegrity(gomock.Any(), "testuser", "testdb", "public", "posts", gomock.Any(), domain.QueryResultHardLimit).
+			Return(&domain.ReferentialIntegrityReport{
+				Table: "posts",
+				References: []domain.OrphanedReference{
+					{
+						Reference:   domain.ForeignKeyMetadata{ColumnName: "user_id", ReferencedTable: "users", ReferencedColumn: "id"},
+						OrphanCount: 1,
+						Rows: &domain.QueryResult{
+							Columns: []string{"id", "user_id"},
+							Rows:    []map[string]interface{}{{"id": 9, "user_id": 404}},
+						},
+					},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/orphans", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleReferentialIntegrity(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+		body := rec.Body.String()
+		require.Contains(t, body, "reference,id,user_id")
+		require.Contains(t, body, "user_id -> users.id,9,404")
+	})
+
+	// Additional test: Referential integrity export under the export policy
+	t.Run("Referential Integrity CSV Export Is Forbidden Without Export Permission", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "salaries")
+		form.Add("format", "csv")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockRBAC.EXPECT().
+			CheckExportPermission(gomock.Any(), "testuser", "testdb", "public", "salaries").
+			Return(false, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/orphans", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleReferentialIntegrity(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	// Additional test: Foreign key lookup
+	t.Run("Foreign Key Lookup Offers Matching Parent Rows", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LookupForeignKeyValues(gomock.Any(), "testuser", "testdb", "public", "orders", "user_id", "ali").
+			Return(&domain.ForeignKeyLookup{
+				Column:         "user_id",
+				Reference:      domain.ForeignKeyMetadata{ColumnName: "user_id", ReferencedSchema: "public", ReferencedTable: "users", ReferencedColumn: "id"},
+				DisplayColumns: []string{"name"},
+				Matches:        []domain.ForeignKeyMatch{{Value: int64(7), Label: "Alice"}},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/fk-lookup?database=testdb&schema=public&table=orders&column=user_id&q=ali", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{
+			"column": "user_id",
+			"referenced_schema": "public",
+			"referenced_table": "users",
+			"referenced_column": "id",
+			"display_columns": ["name"],
+			"matches": [{"value": 7, "label": "Alice"}]
+		}`, rec.Body.String())
+	})
+
+	// Additional test: Foreign key lookup without access to the parent table
+	t.Run("Foreign Key Lookup Forbidden Without SELECT On The Parent Table", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LookupForeignKeyValues(gomock.Any(), "testuser", "testdb", "public", "orders", "user_id", "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission on the referenced table"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/fk-lookup?database=testdb&schema=public&table=orders&column=user_id", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	// Additional test: JSON cell detail
+	t.Run("JSON Cell Returns The Pretty-Printed Document", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetJSONCell(gomock.Any(), "testuser", "testdb", "public", "profiles", "settings", map[string]interface{}{"id": "7"}).
+			Return(&domain.JSONCellDetail{Column: "settings", DataType: "jsonb", Value: "{\n  \"a\": 1\n}"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/json-cell?database=testdb&schema=public&table=profiles&column=settings&pk.id=7", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"column":"settings","data_type":"jsonb","value":"{\n  \"a\": 1\n}","is_null":false}`, rec.Body.String())
+	})
+
+	// Additional test: binary and large cells in the grid
+	t.Run("Grid Shows Size And Hash For Binary And Large Cells", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "attachments")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LoadTableData(gomock.Any(), "testuser", gomock.Any()).
+			Return(&domain.QueryResult{
+				Columns: []string{"id", "data", "notes", "amount"},
+				Rows: []map[string]interface{}{{
+					"id":     int64(1),
+					"data":   []byte{0x89, 'P', 'N', 'G', 0x00, 0xff},
+					"notes":  strings.Repeat("a", domain.CellPreviewMaxBytes+1),
+					"amount": []byte("12.50"),
+				}},
+				RowCount: 1,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/main/load-data", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleLoadTableData(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `data-size="6"`)
+		require.Contains(t, body, "binary, 6 B, sha256 ")
+		require.Contains(t, body, "text, 1.0 KiB, sha256 ")
+		require.NotContains(t, body, strings.Repeat("a", domain.CellPreviewMaxBytes+1))
+		require.Contains(t, body, "<td>12.50</td>")
+	})
+
+	// Additional test: geometry cells in the grid
+	t.Run("Grid Shows Geometry Cells As WKT With Their GeoJSON", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "places")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LoadTableData(gomock.Any(), "testuser", gomock.Any()).
+			Return(&domain.QueryResult{
+				Columns: []string{"id", "location"},
+				Rows: []map[string]interface{}{
+					{"id": int64(1), "location": []byte("0101000000000000000000F03F0000000000000040")},
+					{"id": int64(2), "location": nil},
+				},
+				RowCount:       2,
+				SpatialColumns: []domain.SpatialColumn{{Name: "location", Type: "geometry"}},
+				Spatial: []map[string]domain.SpatialValue{
+					{"location": {GeoJSON: `{"type":"Point","coordinates":[1,2]}`, WKT: "POINT(1 2)"}},
+					{},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/main/load-data", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleLoadTableData(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `<span class="spatial-value" data-geojson="{&#34;type&#34;:&#34;Point&#34;,&#34;coordinates&#34;:[1,2]}">POINT(1 2)</span>`)
+		require.NotContains(t, body, "0101000000000000000000F03F0000000000000040")
+		require.Contains(t, body, "<td>NULL</td>")
+	})
+
+	// Additional test: geometry cell map preview
+	t.Run("Spatial Cell Returns GeoJSON, WKT And The Raw Value", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetSpatialCell(gomock.Any(), "testuser", "testdb", "public", "places", "location", map[string]interface{}{"id": "1"}).
+			Return(&domain.SpatialCellDetail{
+				Column:   "location",
+				DataType: "geometry",
+				GeoJSON:  `{"type":"Point","coordinates":[1,2]}`,
+				WKT:      "POINT(1 2)",
+				Raw:      "0101000000000000000000F03F0000000000000040",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/spatial-cell?database=testdb&schema=public&table=places&column=location&pk.id=1", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"column":"location","data_type":"geometry","geojson":{"type":"Point","coordinates":[1,2]},"wkt":"POINT(1 2)","raw":"0101000000000000000000F03F0000000000000040","is_null":false}`, rec.Body.String())
+	})
+
+	// Additional test: full-row detail panel
+	t.Run("Row Detail Returns Each Column With Its Metadata", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetRowDetail(gomock.Any(), "testuser", "testdb", "public", "orders", map[string]interface{}{"id": "5"}).
+			Return(&domain.RowDetail{
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "orders",
+				Columns: []domain.RowDetailColumn{
+					{Name: "id", DataType: "integer", IsPrimary: true, Value: int64(5)},
+					{Name: "customer_id", DataType: "integer", Value: int64(9), ForeignKey: &domain.ForeignKeyMetadata{ColumnName: "customer_id", ReferencedSchema: "public", ReferencedTable: "customers", ReferencedColumn: "id"}},
+					{Name: "note", DataType: "text", IsNullable: true, Comment: "Free text"},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/row?database=testdb&schema=public&table=orders&pk.id=5", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{
+			"database": "testdb", "schema": "public", "table": "orders",
+			"columns": [
+				{"name": "id", "data_type": "integer", "nullable": false, "primary_key": true, "default": "", "foreign_key": null, "comment": "", "value": 5, "is_null": false},
+				{"name": "customer_id", "data_type": "integer", "nullable": false, "primary_key": false, "default": "", "foreign_key": {"schema": "public", "table": "customers", "column": "id"}, "comment": "", "value": 9, "is_null": false},
+				{"name": "note", "data_type": "text", "nullable": true, "primary_key": false, "default": "", "foreign_key": null, "comment": "Free text", "value": null, "is_null": true}
+			]
+		}`, rec.Body.String())
+	})
+
+	// Additional test: row detail without a full key
+	t.Run("Row Detail Requires A Primary Key", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/row?database=testdb&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	// Additional test: cell content download
+	t.Run("Cell Content Downloads With Its Detected Type", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetCellContent(gomock.Any(), "testuser", "testdb", "public", "attachments", "data", map[string]interface{}{"id": "1"}).
+			Return(&domain.CellContent{
+				Column:      "data",
+				DataType:    "bytea",
+				ContentType: "image/png",
+				Size:        4,
+				SHA256:      "abc123",
+				Content:     []byte{0x89, 'P', 'N', 'G'},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/cell-content?database=testdb&schema=public&table=attachments&column=data&pk.id=1", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+		require.Equal(t, `attachment; filename="attachments_data.png"`, rec.Header().Get("Content-Disposition"))
+		require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+		require.Equal(t, "4", rec.Header().Get("Content-Length"))
+		require.Equal(t, []byte{0x89, 'P', 'N', 'G'}, rec.Body.Bytes())
+	})
+
+	// Additional test: table snapshot
+	t.Run("Table Snapshot Is Taken From The Form", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			TakeTableSnapshot(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(&domain.TableSnapshot{
+				ID:          "snapshot_1",
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "orders",
+				PrimaryKeys: []string{"id"},
+				Rows:        []map[string]interface{}{{"id": 1}, {"id": 2}},
+				TakenAt:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/snapshots", strings.NewReader("database=testdb&schema=public&table=orders"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+		require.Contains(t, rec.Body.String(), `"row_count":2`)
+		require.Contains(t, rec.Body.String(), `"taken_at":"2024-01-02T03:04:05Z"`)
+	})
+
+	// Additional test: table snapshot comparison
+	t.Run("Table Snapshot Comparison Reports Changed Rows", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			CompareTableSnapshot(gomock.Any(), "testuser", "snapshot_1").
+			Return(&domain.TableSnapshotDiff{
+				SnapshotID: "snapshot_1",
+				Inserted:   []map[string]interface{}{{"id": 4}},
+				Updated: []domain.RowDifference{{
+					Key:     map[string]interface{}{"id": 2},
+					Changed: []string{"status"},
+					Before:  map[string]interface{}{"id": 2, "status": "new"},
+					After:   map[string]interface{}{"id": 2, "status": "shipped"},
+				}},
+				Deleted:   []map[string]interface{}{},
+				Unchanged: 1,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/snapshots/compare?id=snapshot_1", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"inserted":[{"id":4}]`)
+		require.Contains(t, body, `"changed":["status"]`)
+		require.Contains(t, body, `"unchanged":1`)
+	})
+
+	// Additional test: expired table snapshot
+	t.Run("Table Snapshot Comparison Reports An Expired Snapshot", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			CompareTableSnapshot(gomock.Any(), "testuser", "snapshot_old").
+			Return(nil, domain.ErrTableSnapshotNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/snapshots/compare?id=snapshot_old", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	// Additional test: JSON cell detail without a row key
+	t.Run("JSON Cell Requires The Row Key", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/json-cell?database=testdb&schema=public&table=profiles&column=settings", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	// Additional test: Ad hoc join view
+	t.Run("Join View Renders Read-Only Joined Rows", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("left_schema", "public")
+		form.Add("left_table", "users")
+		form.Add("right_schema", "public")
+		form.Add("right_table", "posts")
+		form.Add("on", "id=user_id")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LoadJoinedTableData(gomock.Any(), "testuser", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.JoinViewParams) (*domain.QueryResult, error) {
+				require.Equal(t, []domain.JoinCondition{{LeftColumn: "id", RightColumn: "user_id"}}, params.Conditions)
+				return &domain.QueryResult{
+					Columns:    []string{"users.name", "posts.title"},
+					Rows:       []map[string]interface{}{{"users.name": "Alice", "posts.title": "Hello"}},
+					RowCount:   1,
+					TotalCount: 1,
+				}, nil
+			})
+
+		req := httptest.NewRequest(http.MethodPost, "/main/join", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleJoinView(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "read-only")
+		require.Contains(t, body, "posts.title")
+		require.Contains(t, body, "Alice")
+	})
+
+	// Additional test: Temporal "as of" browsing
+	t.Run("Table As Of Returns Historical Rows", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "prices")
+		form.Add("as_of", "2024-01-01T00:00")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LoadTableDataAsOf(gomock.Any(), "testuser", "testdb", "public", "prices", gomock.Any(), 0, domain.QueryResultPageSize).
+			DoAndReturn(func(ctx context.Context, username, database, schema, table string, asOf time.Time, offset, limit int) (*domain.QueryResult, error) {
+				require.Equal(t, 2024, asOf.Year())
+				return &domain.QueryResult{
+					Columns:  []string{"id", "amount"},
+					Rows:     []map[string]interface{}{{"id": 1, "amount": 10}},
+					RowCount: 1,
+				}, nil
+			})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/as-of", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleTableAsOf(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"amount":10`)
+	})
+
+	// Additional test: Row change timeline
+	t.Run("Row Timeline Passes Primary Key Values", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "prices")
+		form.Add("pk.id", "1")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetRowTimeline(gomock.Any(), "testuser", "testdb", "public", "prices", map[string]interface{}{"id": "1"}).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "amount"},
+				Rows:     []map[string]interface{}{{"id": 1, "amount": 5}, {"id": 1, "amount": 10}},
+				RowCount: 2,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/row-history", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleRowTimeline(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"RowCount":2`)
+	})
+
+	t.Run("Export Table Streams NDJSON With Filter And Sort", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "users")
+		form.Add("format", "ndjson")
+		form.Add("where", "active = true")
+		form.Add("orderBy", "name")
+		form.Add("orderDir", "ASC")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			ExportTableData(gomock.Any(), "testuser", domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "users",
+				WhereClause: "active = true",
+				OrderBy:     "name",
+				OrderDir:    "ASC",
+			}, domain.ExportFormatNDJSON, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.TableDataParams, format string, w io.Writer) error {
+				_, err := io.WriteString(w, "{\"id\":1}\n")
+				return err
+			})
+
+		req := httptest.NewRequest(http.MethodPost, "/main/export", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		require.Contains(t, rec.Header().Get("Content-Disposition"), `filename="users.ndjson"`)
+		require.Equal(t, "{\"id\":1}\n", rec.Body.String())
+	})
+
+	t.Run("Export Table Reports Row Limit", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "events")
+		form.Add("format", "xlsx")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			ExportTableData(gomock.Any(), "testuser", gomock.Any(), domain.ExportFormatXLSX, gomock.Any()).
+			Return(domain.ValidationError{Field: "limit", Message: "export of 500000 rows exceeds the limit of 100000 rows; narrow the filter"})
+
+		req := httptest.NewRequest(http.MethodPost, "/main/export", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "exceeds the limit")
+	})
+
+	t.Run("Export Table Rejects Unknown Format", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/main/export?database=testdb&schema=public&table=users&format=pdf", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Import Table Previews CSV Mapping", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockImport.EXPECT().
+			PreviewCSVImport(gomock.Any(), "testuser", domain.ImportParams{
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "users",
+				Mapping:  map[string]string{"user_id": "id"},
+			}, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportPreview, error) {
+				content, err := io.ReadAll(csvFile)
+				require.NoError(t, err)
+				require.Equal(t, "user_id,name\n1,Alice\n", string(content))
+				return &domain.ImportPreview{
+					Columns:  []domain.ImportColumnMapping{{CSVColumn: "user_id", TableColumn: "id", DataType: "integer"}},
+					RowCount: 1,
+				}, nil
+			})
+
+		req := newImportRequest(t, map[string]string{
+			"database":    "testdb",
+			"schema":      "public",
+			"table":       "users",
+			"map.user_id": "id",
+		}, "user_id,name\n1,Alice\n")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		require.Contains(t, rec.Body.String(), `"TableColumn":"id"`)
+	})
+
+	t.Run("Import Table Loads CSV", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockImport.EXPECT().
+			ImportCSV(gomock.Any(), "testuser", domain.ImportParams{
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "users",
+				Mapping:  map[string]string{},
+			}, gomock.Any()).
+			Return(&domain.ImportResult{RowsImported: 1}, nil)
+
+		req := newImportRequest(t, map[string]string{
+			"database": "testdb",
+			"schema":   "public",
+			"table":    "users",
+			"mode":     "import",
+		}, "id,name\n1,Alice\n")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"RowsImported":1`)
+	})
+
+	t.Run("Import Table Validates CSV Without Loading", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockImport.EXPECT().
+			ValidateCSVImport(gomock.Any(), "testuser", gomock.Any(), gomock.Any()).
+			Return(&domain.ImportValidation{
+				RowCount:        2,
+				InvalidRowCount: 1,
+				ErrorCount:      1,
+				Rows:            []domain.ImportRowReport{{Line: 3, Columns: map[string]string{"id": `"x" is not a valid integer`}}},
+			}, nil)
+
+		req := newImportRequest(t, map[string]string{
+			"database": "testdb",
+			"schema":   "public",
+			"table":    "users",
+			"mode":     "validate",
+		}, "id,name\n1,Alice\nx,Bob\n")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"InvalidRowCount":1`)
+		require.Contains(t, rec.Body.String(), `"Line":3`)
+	})
+
+	t.Run("Import Table Forbidden Without INSERT Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "viewer",
+			}, nil)
+
+		mockImport.EXPECT().
+			ImportCSV(gomock.Any(), "viewer", gomock.Any(), gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "user does not have INSERT permission on this table"})
+
+		req := newImportRequest(t, map[string]string{
+			"database": "testdb",
+			"schema":   "public",
+			"table":    "users",
+			"mode":     "import",
+		}, "id,name\n1,Alice\n")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Import Table Requires File", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "users")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/table/import", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	// Additional test: Freeze view snapshot
+	t.Run("Freeze View Reports Snapshot Age", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			FreezeView(gomock.Any(), "session_123").
+			Return(&domain.SnapshotStatus{Active: true, TakenAt: time.Now().Add(-90 * time.Second)}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/view/freeze", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleFreezeView(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"active":true`)
+		require.Contains(t, body, `"age_seconds":90`)
+		require.Contains(t, body, `"max_age_seconds":1800`)
+	})
+
+	t.Run("Freeze View Requires POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/view/freeze", nil)
+		rec := httptest.NewRecorder()
+
+		h.HandleFreezeView(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("Pagination Reads Through The Session Snapshot", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "large_table")
+		form.Add("offset", "100")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			LoadTableData(gomock.Any(), "testuser", domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "large_table",
+				Offset:      50,
+				Limit:       50,
+				SnapshotKey: "session_123",
+			}).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id"},
+				Rows:       make([]map[string]interface{}, 50),
+				RowCount:   50,
+				TotalCount: 5000,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/main/pagination/previous", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandlePaginationPrevious(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Snapshot Status Reports Expired Snapshot", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			GetSnapshotStatus(gomock.Any(), "session_123").
+			Return(&domain.SnapshotStatus{TakenAt: time.Now().Add(-time.Hour), Expired: true}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/view/snapshot", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleSnapshotStatus(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"active":false`)
+		require.Contains(t, body, `"expired":true`)
+		require.NotContains(t, body, `"age_seconds"`)
+	})
+
+	t.Run("Release Snapshot Returns To Live Data", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockDataView.EXPECT().
+			ReleaseSnapshot(gomock.Any(), "session_123").
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/view/release", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleReleaseSnapshot(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"active":false`)
+	})
+
+	t.Run("Live Table Data Streams Refreshed Rows", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "live_session").
+			Return(&domain.Session{ID: "live_session", Username: "testuser"}, nil)
+
+		mockDataView.EXPECT().
+			WatchTableData(gomock.Any(), "testuser", domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "orders",
+				WhereClause: "status = 'open'",
+				Limit:       50,
+			}, 30*time.Second, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.TableDataParams, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error {
+				return onUpdate(&domain.LiveResultUpdate{
+					Sequence:    1,
+					RefreshedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					Interval:    interval,
+					Result: &domain.QueryResult{
+						Columns:  []string{"id", "status"},
+						Rows:     []map[string]interface{}{{"id": 7, "status": "open"}},
+						RowCount: 1,
+					},
+				})
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/live?database=testdb&schema=public&table=orders&where=status+%3D+%27open%27&interval=30", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "live_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		require.Contains(t, rec.Body.String(), "id: 1\nevent: result\ndata: ")
+		require.Contains(t, rec.Body.String(), `"rows":[{"id":7,"status":"open"}]`)
+	})
+
+	t.Run("Live Table Data Rejects Invalid Interval", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "live_session").
+			Return(&domain.Session{ID: "live_session", Username: "testuser"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/live?database=testdb&schema=public&table=orders&interval=-5", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "live_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Live Table Data Forbidden Without Select Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "live_session").
+			Return(&domain.Session{ID: "live_session", Username: "testuser"}, nil)
+
+		mockDataView.EXPECT().
+			WatchTableData(gomock.Any(), "testuser", gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/live?database=testdb&schema=public&table=secrets", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "live_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Watch Rows Streams Changes To Matching Rows", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "watch_session").
+			Return(&domain.Session{ID: "watch_session", Username: "testuser"}, nil)
+
+		mockDataView.EXPECT().
+			WatchRows(gomock.Any(), "testuser", domain.RowWatchParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "incidents",
+				WhereClause: "id = 7",
+				Interval:    time.Duration(domain.LiveRefreshMinInterval) * time.Second,
+			}, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.RowWatchParams, onEvent func(*domain.RowWatchEvent) error) error {
+				checkedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+				if err := onEvent(&domain.RowWatchEvent{Sequence: 1, CheckedAt: checkedAt, Mode: domain.RowWatchModeNotify, MatchingRows: 1}); err != nil {
+					return err
+				}
+				return onEvent(&domain.RowWatchEvent{
+					Sequence:     2,
+					CheckedAt:    checkedAt.Add(time.Minute),
+					Mode:         domain.RowWatchModeNotify,
+					MatchingRows: 1,
+					Changes: []domain.RowChange{{
+						Operation: domain.RowChangeUpdate,
+						Key:       map[string]interface{}{"id": 7},
+						Before:    map[string]interface{}{"id": 7, "state": "open"},
+						After:     map[string]interface{}{"id": 7, "state": "resolved"},
+					}},
+				})
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/watch?database=testdb&schema=public&table=incidents&where=id+%3D+7", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "watch_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		body := rec.Body.String()
+		require.Contains(t, body, "id: 1\nevent: watching\ndata: ")
+		require.Contains(t, body, `"mode":"notify"`)
+		require.Contains(t, body, "id: 2\nevent: changes\ndata: ")
+		require.Contains(t, body, `"operation":"UPDATE"`)
+		require.Contains(t, body, `"after":{"id":7,"state":"resolved"}`)
+	})
+
+	t.Run("Watch Rows Rejects An Unknown Mode", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "watch_session").
+			Return(&domain.Session{ID: "watch_session", Username: "testuser"}, nil)
+
+		mockDataView.EXPECT().
+			WatchRows(gomock.Any(), "testuser", gomock.Any(), gomock.Any()).
+			Return(domain.ValidationError{Field: "mode", Message: "unsupported watch mode: webhook"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/watch?database=testdb&schema=public&table=incidents&mode=webhook", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "watch_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "unsupported watch mode")
+	})
+
+	t.Run("Watch Rows Forbidden Without Select Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "watch_session").
+			Return(&domain.Session{ID: "watch_session", Username: "testuser"}, nil)
+
+		mockDataView.EXPECT().
+			WatchRows(gomock.Any(), "testuser", gomock.Any(), gomock.Any()).
+			Return(domain.ValidationError{Field: "table", Message: "user does not have SELECT permission on this table"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/watch?database=testdb&schema=public&table=secrets", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "watch_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+// newImportRequest builds a multipart CSV upload for /api/table/import
+func newImportRequest(t *testing.T, fields map[string]string, csvContent string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range fields {
+		require.NoError(t, writer.WriteField(name, value))
+	}
+	part, err := writer.CreateFormFile("file", "upload.csv")
+	require.NoError(t, err)
+	_, err = io.WriteString(part, csvContent)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/table/import", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
 }
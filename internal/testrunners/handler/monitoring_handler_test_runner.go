@@ -0,0 +1,607 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// MonitoringHandlerConstructor is a function type that creates a MonitoringHandler
+type MonitoringHandlerConstructor func(
+	monitoringUC usecase.MonitoringUseCase,
+	locksUC usecase.LocksUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.MonitoringHandler
+
+// MonitoringHandlerRunner runs all monitoring handler tests
+func MonitoringHandlerRunner(t *testing.T, constructor MonitoringHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMonitoring := mockUsecase.NewMockMonitoringUseCase(ctrl)
+	mockLocks := mockUsecase.NewMockLocksUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockMonitoring, mockLocks, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "admin_session").
+		Return(&domain.Session{ID: "admin_session", Username: "postgres"}, nil).
+		AnyTimes()
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "user_session").
+		Return(&domain.Session{ID: "user_session", Username: "alice"}, nil).
+		AnyTimes()
+
+	takenAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := &domain.ActivitySnapshot{
+		TakenAt:      takenAt,
+		CanTerminate: true,
+		Backends: []domain.BackendActivity{
+			{
+				PID:           4242,
+				Database:      "shop",
+				Username:      "bob",
+				ClientAddr:    "10.0.0.6",
+				State:         "active",
+				Query:         "SELECT * FROM orders WHERE note = '<script>'",
+				QueryStart:    takenAt.Add(-90 * time.Second),
+				Duration:      90 * time.Second,
+				WaitEventType: "Lock",
+				WaitEvent:     "transactionid",
+			},
+		},
+	}
+
+	t.Run("Activity Page Lists Backends With Terminate For Superusers", func(t *testing.T) {
+		mockMonitoring.EXPECT().GetActivity(gomock.Any(), "postgres").Return(snapshot, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/activity", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `data-pid="4242"`)
+		require.Contains(t, body, "Lock: transactionid")
+		require.Contains(t, body, "1m30s")
+		require.Contains(t, body, "&lt;script&gt;")
+		require.NotContains(t, body, "'<script>'")
+		require.Contains(t, body, `<button class="terminate-backend"`)
+	})
+
+	t.Run("Activity Page Hides Terminate From Other Users", func(t *testing.T) {
+		mockMonitoring.EXPECT().
+			GetActivity(gomock.Any(), "alice").
+			Return(&domain.ActivitySnapshot{TakenAt: takenAt, Backends: snapshot.Backends}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/activity", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `data-pid="4242"`)
+		require.NotContains(t, rec.Body.String(), `<button class="terminate-backend"`)
+	})
+
+	t.Run("Activity Page Redirects Without Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/activity", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "/login", rec.Header().Get("Location"))
+	})
+
+	t.Run("List Activity Returns Backends As JSON", func(t *testing.T) {
+		mockMonitoring.EXPECT().GetActivity(gomock.Any(), "postgres").Return(snapshot, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/activity", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			TakenAt      string                   `json:"taken_at"`
+			CanTerminate bool                     `json:"can_terminate"`
+			Backends     []map[string]interface{} `json:"backends"`
+		}
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+		require.Equal(t, "2024-01-01T12:00:00Z", response.TakenAt)
+		require.True(t, response.CanTerminate)
+		require.Len(t, response.Backends, 1)
+		require.Equal(t, float64(4242), response.Backends[0]["pid"])
+		require.Equal(t, float64(90), response.Backends[0]["duration_seconds"])
+		require.Equal(t, "transactionid", response.Backends[0]["wait_event"])
+		require.Equal(t, "2024-01-01T11:58:30Z", response.Backends[0]["query_start"])
+	})
+
+	t.Run("Terminate Backend Ends The Backend", func(t *testing.T) {
+		mockMonitoring.EXPECT().TerminateBackend(gomock.Any(), "postgres", 4242).Return(nil)
+
+		form := url.Values{"pid": {"4242"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/activity/terminate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"terminated":4242`)
+	})
+
+	t.Run("Terminate Backend Forbidden For Non Superusers", func(t *testing.T) {
+		mockMonitoring.EXPECT().
+			TerminateBackend(gomock.Any(), "alice", 4242).
+			Return(domain.ValidationError{Field: "permission", Message: "only superusers can terminate backends"})
+
+		form := url.Values{"pid": {"4242"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/activity/terminate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Terminate Backend Reports A Missing Backend", func(t *testing.T) {
+		mockMonitoring.EXPECT().TerminateBackend(gomock.Any(), "postgres", 77).Return(domain.ErrBackendNotFound)
+
+		form := url.Values{"pid": {"77"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/activity/terminate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Terminate Backend Rejects An Invalid PID", func(t *testing.T) {
+		form := url.Values{"pid": {"abc"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/activity/terminate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Terminate Backend Requires POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/activity/terminate?pid=4242", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	slowOperations := []domain.SlowOperation{{
+		ID:          "op-1",
+		Username:    "bob",
+		Operation:   domain.SlowOperationFilter,
+		Database:    "shop",
+		Schema:      "public",
+		Table:       "orders",
+		WhereClause: "note = '<script>'",
+		Duration:    1500 * time.Millisecond,
+		Suggestions: []domain.IndexSuggestion{{
+			Schema:    "public",
+			Table:     "orders",
+			Columns:   []string{"note"},
+			Statement: `CREATE INDEX ON "public"."orders" ("note")`,
+			Reason:    "sequential scan keeps an estimated 12 of 200000 rows (0.01%)",
+		}},
+		RecordedAt: takenAt,
+	}}
+
+	t.Run("Slow Operations Page Lists Suggestions", func(t *testing.T) {
+		mockMonitoring.EXPECT().ListSlowOperations(gomock.Any(), "postgres", 0).Return(slowOperations, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/slow-operations", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "An index on (note) might help")
+		require.Contains(t, body, "CREATE INDEX ON &#34;public&#34;.&#34;orders&#34; (&#34;note&#34;)")
+		require.Contains(t, body, "note = &#39;&lt;script&gt;&#39;")
+		require.NotContains(t, body, "<script>'")
+	})
+
+	t.Run("Slow Operations Page Forbidden For Non Superusers", func(t *testing.T) {
+		mockMonitoring.EXPECT().
+			ListSlowOperations(gomock.Any(), "alice", 0).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can view the slow-operation log"})
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/slow-operations", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("List Slow Operations Returns JSON", func(t *testing.T) {
+		mockMonitoring.EXPECT().ListSlowOperations(gomock.Any(), "postgres", 10).Return(slowOperations, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/slow-operations?limit=10", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			Operations []struct {
+				ID              string  `json:"id"`
+				WhereClause     string  `json:"where_clause"`
+				DurationSeconds float64 `json:"duration_seconds"`
+				Suggestions     []struct {
+					Columns   []string `json:"columns"`
+					Statement string   `json:"statement"`
+				} `json:"suggestions"`
+			} `json:"operations"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Operations, 1)
+		require.Equal(t, "op-1", response.Operations[0].ID)
+		require.Equal(t, 1.5, response.Operations[0].DurationSeconds)
+		require.Len(t, response.Operations[0].Suggestions, 1)
+		require.Equal(t, []string{"note"}, response.Operations[0].Suggestions[0].Columns)
+	})
+
+	t.Run("List Slow Operations Rejects An Invalid Limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/slow-operations?limit=-1", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("List Slow Operations Requires A Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/slow-operations", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	lockReport := &domain.LockReport{
+		TakenAt:      takenAt,
+		CanTerminate: true,
+		Chains: []domain.BlockingChain{{
+			Database: "shop",
+			Root:     domain.BackendActivity{PID: 4242, Database: "shop", Username: "bob", State: "idle in transaction", Query: "UPDATE orders SET note = '<b>'"},
+			Waiters: []domain.BlockedBackend{{
+				Backend:   domain.BackendActivity{PID: 4243, Database: "shop", Username: "alice", State: "active", Query: "DELETE FROM orders"},
+				BlockedBy: 4242,
+				Depth:     1,
+				LockType:  "transactionid",
+				Mode:      "ShareLock",
+			}},
+		}},
+	}
+
+	t.Run("Locks Page Shows Who Holds The Lock", func(t *testing.T) {
+		mockLocks.EXPECT().GetBlockingChains(gomock.Any(), "postgres").Return(lockReport, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/locks", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `<tr class="holder" data-pid="4242">`)
+		require.Contains(t, body, "UPDATE orders SET note = &#39;&lt;b&gt;&#39;")
+		require.Contains(t, body, `<tr data-pid="4243">`)
+		require.Contains(t, body, "ShareLock on transactionid")
+		require.Contains(t, body, `class="terminate-backend"`)
+	})
+
+	t.Run("Locks Page Says When Nothing Is Waiting", func(t *testing.T) {
+		mockLocks.EXPECT().GetBlockingChains(gomock.Any(), "alice").Return(&domain.LockReport{TakenAt: takenAt}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/locks", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "No backend is waiting on a lock")
+		require.NotContains(t, rec.Body.String(), `class="terminate-backend"`)
+	})
+
+	t.Run("Locks Page Redirects Without Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/admin/locks", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "/login", rec.Header().Get("Location"))
+	})
+
+	t.Run("List Locks Returns Chains As JSON", func(t *testing.T) {
+		mockLocks.EXPECT().GetBlockingChains(gomock.Any(), "postgres").Return(lockReport, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/locks", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			CanTerminate bool `json:"can_terminate"`
+			Chains       []struct {
+				Database string `json:"database"`
+				Root     struct {
+					PID int `json:"pid"`
+				} `json:"root"`
+				Waiters []struct {
+					BlockedBy int    `json:"blocked_by"`
+					Depth     int    `json:"depth"`
+					Mode      string `json:"mode"`
+					Backend   struct {
+						PID int `json:"pid"`
+					} `json:"backend"`
+				} `json:"waiters"`
+			} `json:"chains"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.True(t, response.CanTerminate)
+		require.Len(t, response.Chains, 1)
+		require.Equal(t, "shop", response.Chains[0].Database)
+		require.Equal(t, 4242, response.Chains[0].Root.PID)
+		require.Len(t, response.Chains[0].Waiters, 1)
+		require.Equal(t, 4243, response.Chains[0].Waiters[0].Backend.PID)
+		require.Equal(t, 4242, response.Chains[0].Waiters[0].BlockedBy)
+		require.Equal(t, "ShareLock", response.Chains[0].Waiters[0].Mode)
+	})
+
+	t.Run("List Locks Requires A Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/locks", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	slowQueries := []domain.SlowQuery{
+		{
+			ID:         "slowq-1",
+			Username:   "alice",
+			Statement:  "SELECT * FROM orders WHERE note = $1",
+			Duration:   2500 * time.Millisecond,
+			RecordedAt: takenAt,
+			Plan: &domain.QueryPlan{Root: domain.QueryPlanNode{
+				NodeType: "Gather",
+				Children: []domain.QueryPlanNode{{
+					NodeType:     "Seq Scan",
+					RelationName: "orders",
+					TotalCost:    35.5,
+					PlanRows:     12,
+					Details:      map[string]interface{}{"Filter": "(note = '<b>'::text)"},
+				}},
+			}},
+		},
+		{
+			ID:         "slowq-2",
+			Username:   "bob",
+			Statement:  "SELECT pg_sleep($1)",
+			Duration:   5 * time.Second,
+			Error:      "canceling statement due to statement timeout",
+			RecordedAt: takenAt,
+		},
+	}
+
+	t.Run("Slow Queries Page Shows Statements And Plans", func(t *testing.T) {
+		mockMonitoring.EXPECT().ListSlowQueries(gomock.Any(), "postgres", 0).Return(slowQueries, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/slow-queries", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "SELECT * FROM orders WHERE note = $1")
+		require.Contains(t, body, "-&gt; Seq Scan on orders  (cost=0.00..35.50 rows=12 width=0)")
+		require.Contains(t, body, "Filter: (note = &#39;&lt;b&gt;&#39;::text)")
+		require.Contains(t, body, "canceling statement due to statement timeout")
+		require.Contains(t, body, "2.5s")
+		require.NotContains(t, body, "<b>")
+	})
+
+	t.Run("Slow Queries Page Forbidden For Non Superusers", func(t *testing.T) {
+		mockMonitoring.EXPECT().
+			ListSlowQueries(gomock.Any(), "alice", 0).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can view the slow-query log"})
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/slow-queries", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("List Slow Queries Returns JSON", func(t *testing.T) {
+		mockMonitoring.EXPECT().ListSlowQueries(gomock.Any(), "postgres", 5).Return(slowQueries, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/slow-queries?limit=5", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			Queries []struct {
+				ID              string                 `json:"id"`
+				Statement       string                 `json:"statement"`
+				DurationSeconds float64                `json:"duration_seconds"`
+				Error           string                 `json:"error"`
+				Plan            map[string]interface{} `json:"plan"`
+			} `json:"queries"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Queries, 2)
+		require.Equal(t, "slowq-1", response.Queries[0].ID)
+		require.Equal(t, 2.5, response.Queries[0].DurationSeconds)
+		require.NotNil(t, response.Queries[0].Plan)
+		require.Nil(t, response.Queries[1].Plan)
+		require.Contains(t, response.Queries[1].Error, "statement timeout")
+	})
+
+	t.Run("List Slow Queries Requires A Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/slow-queries", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	shopSize := int64(8 << 20)
+	shopRate, shopRatio, serverRatio := 12.5, 0.995, 0.99
+	serverDashboard := &domain.ServerDashboard{
+		TakenAt:          takenAt,
+		TotalConnections: 5,
+		MaxConnections:   100,
+		TotalSizeBytes:   shopSize,
+		CacheHitRatio:    &serverRatio,
+		BackgroundWriter: domain.BackgroundWriterStats{BuffersClean: 40, MaxWrittenClean: 2, BuffersAlloc: 900},
+		Databases: []domain.DatabaseDashboardRow{
+			{
+				Stats:                 domain.DatabaseStats{Name: "shop", Connections: 4, XactCommit: 1200, XactRollback: 3, SizeBytes: &shopSize},
+				TransactionsPerSecond: &shopRate,
+				CacheHitRatio:         &shopRatio,
+			},
+			{Stats: domain.DatabaseStats{Name: "<restricted>", Connections: 1}},
+		},
+	}
+
+	t.Run("Stats Page Shows Rates Ratios And Sizes", func(t *testing.T) {
+		mockMonitoring.EXPECT().GetServerDashboard(gomock.Any(), "postgres").Return(serverDashboard, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `<div class="value" id="connections">5 / 100</div>`)
+		require.Contains(t, body, `<div class="value" id="cache-hit-ratio">99.00%</div>`)
+		require.Contains(t, body, `<div class="value" id="transactions-per-second">-</div>`)
+		require.Contains(t, body, "8.0 MiB")
+		require.Contains(t, body, "12.5")
+		require.Contains(t, body, "99.50%")
+		require.Contains(t, body, "40 buffers cleaned")
+		require.Contains(t, body, "&lt;restricted&gt;")
+		require.Contains(t, body, "fetch('/api/admin/stats')")
+	})
+
+	t.Run("Stats Page Forbidden For Non Superusers", func(t *testing.T) {
+		mockMonitoring.EXPECT().
+			GetServerDashboard(gomock.Any(), "alice").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers can view server statistics"})
+
+		req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "user_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Server Stats Returns JSON", func(t *testing.T) {
+		mockMonitoring.EXPECT().GetServerDashboard(gomock.Any(), "postgres").Return(serverDashboard, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/stats", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "admin_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			TotalConnections      int      `json:"total_connections"`
+			MaxConnections        int      `json:"max_connections"`
+			TransactionsPerSecond *float64 `json:"transactions_per_second"`
+			CacheHitRatio         *float64 `json:"cache_hit_ratio"`
+			BackgroundWriter      struct {
+				BuffersAlloc int64 `json:"buffers_alloc"`
+			} `json:"background_writer"`
+			Databases []struct {
+				Name                  string   `json:"name"`
+				SizeBytes             *int64   `json:"size_bytes"`
+				TransactionsPerSecond *float64 `json:"transactions_per_second"`
+			} `json:"databases"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Equal(t, 5, response.TotalConnections)
+		require.Equal(t, 100, response.MaxConnections)
+		require.Nil(t, response.TransactionsPerSecond)
+		require.Equal(t, 0.99, *response.CacheHitRatio)
+		require.Equal(t, int64(900), response.BackgroundWriter.BuffersAlloc)
+		require.Len(t, response.Databases, 2)
+		require.Equal(t, shopSize, *response.Databases[0].SizeBytes)
+		require.Equal(t, 12.5, *response.Databases[0].TransactionsPerSecond)
+		require.Nil(t, response.Databases[1].SizeBytes)
+	})
+
+	t.Run("Server Stats Requires A Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/stats", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
@@ -1,12 +1,18 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -21,6 +27,8 @@ import (
 type QueryEditorHandlerConstructor func(
 	queryUC usecase.QueryUseCase,
 	authUC usecase.AuthenticationUseCase,
+	savedQueryUC usecase.SavedQueryUseCase,
+	notebookUC usecase.QueryNotebookUseCase,
 ) handler.QueryEditorHandler
 
 // QueryEditorHandlerRunner runs all query editor handler tests
@@ -39,8 +47,10 @@ func QueryEditorHandlerRunner(t *testing.T, constructor QueryEditorHandlerConstr
 	ctx := context.Background()
 	mockQuery := mockUsecase.NewMockQueryUseCase(ctrl)
 	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+	mockSavedQuery := mockUsecase.NewMockSavedQueryUseCase(ctrl)
+	mockNotebook := mockUsecase.NewMockQueryNotebookUseCase(ctrl)
 
-	h := constructor(mockQuery, mockAuth)
+	h := constructor(mockQuery, mockAuth, mockSavedQuery, mockNotebook)
 
 	// E2E-S4-01: Query Editor Page Access
 	t.Run("E2E-S4-01: Query Editor Page Access", func(t *testing.T) {
@@ -491,4 +501,1156 @@ func QueryEditorHandlerRunner(t *testing.T, constructor QueryEditorHandlerConstr
 		// Verify error message
 		require.Contains(t, body, "Query cannot be empty")
 	})
+
+	t.Run("Create Saved Query", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			SaveQuery(gomock.Any(), "testuser", "Active users", "SELECT * FROM users", []string{"reports", "users"}).
+			Return(&domain.SavedQuery{
+				ID:       "query_1",
+				Username: "testuser",
+				Name:     "Active users",
+				Query:    "SELECT * FROM users",
+				Tags:     []string{"reports", "users"},
+			}, nil)
+
+		form := url.Values{}
+		form.Add("name", "Active users")
+		form.Add("query", "SELECT * FROM users")
+		form.Add("tags", "reports,users")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/queries", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+		require.Contains(t, rec.Body.String(), "query_1")
+	})
+
+	t.Run("List Saved Queries By Tag", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			ListSavedQueries(gomock.Any(), "testuser", "reports").
+			Return([]domain.SavedQuery{{ID: "query_1", Name: "Active users"}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/queries?tag=reports", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "Active users")
+	})
+
+	t.Run("Run Saved Query", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			BindTemplate(gomock.Any(), "testuser", "query_1", map[string]string{}).
+			Return("SELECT id FROM users", nil, nil)
+
+		mockQuery.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), "testuser", domain.QueryParams{Query: "SELECT id FROM users", Offset: 0, Limit: 50}).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id"},
+				Rows:       []map[string]interface{}{{"id": 7}},
+				RowCount:   1,
+				TotalCount: 1,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/queries/run", strings.NewReader("id=query_1"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "<td>7</td>")
+	})
+
+	t.Run("Run Saved Query Applies Session Settings", func(t *testing.T) {
+		settings := domain.SessionSettings{WorkMem: "256MB", StatementTimeout: time.Minute}
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+				Settings: settings,
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			BindTemplate(gomock.Any(), "testuser", "query_1", map[string]string{}).
+			Return("SELECT id FROM users", nil, nil)
+
+		mockQuery.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), "testuser", domain.QueryParams{Query: "SELECT id FROM users", Offset: 0, Limit: 50, Settings: settings}).
+			Return(&domain.QueryResult{Columns: []string{"id"}}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/queries/run", strings.NewReader("id=query_1"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Delete Missing Saved Query", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			DeleteSavedQuery(gomock.Any(), "testuser", "missing").
+			Return(domain.ErrSavedQueryNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/queries/delete", strings.NewReader("id=missing"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Explain Query Returns Plan", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			ExplainQuery(gomock.Any(), "testuser", "SELECT * FROM users", false).
+			Return(&domain.QueryPlan{
+				Root: domain.QueryPlanNode{NodeType: "Seq Scan", RelationName: "users"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/explain", strings.NewReader("query=SELECT+*+FROM+users"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "Seq Scan")
+	})
+
+	t.Run("Explain Analyze Forbidden Without Write Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			ExplainQuery(gomock.Any(), "testuser", "SELECT * FROM users", true).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "EXPLAIN ANALYZE requires write permission"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/explain", strings.NewReader("query=SELECT+*+FROM+users&analyze=true"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Contains(t, rec.Body.String(), "write permission")
+	})
+
+	t.Run("Query Chart Returns Series", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		count := 3.0
+		mockQuery.EXPECT().
+			BuildChartData(gomock.Any(), "testuser", "SELECT status, COUNT(*) FROM orders GROUP BY status").
+			Return(&domain.ChartData{
+				LabelColumn:   "status",
+				Labels:        []string{"open"},
+				Series:        []domain.ChartSeries{{Name: "count", Values: []*float64{&count}}},
+				SuggestedType: domain.ChartTypeBar,
+			}, nil)
+
+		form := url.Values{}
+		form.Add("query", "SELECT status, COUNT(*) FROM orders GROUP BY status")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/chart", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"SuggestedType":"bar"`)
+	})
+
+	t.Run("Query Chart Rejects Invalid Shape", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			BuildChartData(gomock.Any(), "testuser", "SELECT name FROM users").
+			Return(nil, domain.ValidationError{Field: "query", Message: "chart data needs a label column followed by at least one numeric column"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/chart", strings.NewReader("query=SELECT+name+FROM+users"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "label column")
+	})
+
+	t.Run("Cancel Running Query", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			CancelQuery(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/cancel", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"cancelled":true`)
+	})
+
+	t.Run("Cancelled Query Shows Notice", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), "testuser", gomock.Any()).
+			Return(nil, fmt.Errorf("failed to execute query: %w", domain.ErrQueryCancelled))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/execute", strings.NewReader("query=SELECT+pg_sleep(60)"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "Query cancelled")
+	})
+
+	t.Run("Database Error Links To The Documentation For Its SQLSTATE", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), "testuser", gomock.Any()).
+			Return(nil, fmt.Errorf("failed to execute query: %w", &domain.DatabaseError{
+				SQLState: "23505",
+				DocsKey:  "unique_violation",
+				DocsURL:  domain.PostgresDocsBaseURL + "ddl-constraints.html",
+				Err:      errors.New(`pq: duplicate key value violates unique constraint "users_email_key"`),
+			}))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/execute", strings.NewReader("query=INSERT+INTO+users+VALUES+(1)"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleExecuteQuery(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "duplicate key value")
+		require.Contains(t, body, "data-sqlstate='23505'")
+		require.Contains(t, body, "href='https://www.postgresql.org/docs/current/ddl-constraints.html'")
+		require.Contains(t, body, "Learn more (SQLSTATE 23505)")
+	})
+
+	t.Run("Editor Page Shows Search Path", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:         "session_123",
+				Username:   "testuser",
+				SearchPath: []string{"sales", "public"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/query-editor", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "search_path: <code>sales, public</code>")
+	})
+
+	t.Run("Set Search Path", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockAuth.EXPECT().
+			SetSessionSearchPath(gomock.Any(), "session_123", []string{"sales", "public"}).
+			Return(&domain.Session{
+				ID:         "session_123",
+				Username:   "testuser",
+				SearchPath: []string{"sales", "public"},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/search-path", strings.NewReader("schemas=sales,public"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"effective":"sales, public"`)
+	})
+
+	t.Run("Set Search Path Rejects Inaccessible Schema", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockAuth.EXPECT().
+			SetSessionSearchPath(gomock.Any(), "session_123", []string{"payroll"}).
+			Return(nil, domain.ValidationError{Field: "search_path", Message: "schema payroll is not accessible"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/search-path", strings.NewReader("schemas=payroll"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Query Editor Page Shows Session Settings", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+				Settings: domain.SessionSettings{
+					WorkMem:               "64MB",
+					StatementTimeout:      30 * time.Second,
+					ApplicationNameSuffix: `"report"`,
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/query-editor", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `name="work_mem" placeholder="server default" value="64MB"`)
+		require.Contains(t, body, `name="statement_timeout" placeholder="server default" value="30s"`)
+		require.Contains(t, body, `value="&#34;report&#34;"`)
+	})
+
+	t.Run("Set Session Settings", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		settings := domain.SessionSettings{
+			WorkMem:               "128MB",
+			StatementTimeout:      1500 * time.Millisecond,
+			ApplicationNameSuffix: "etl",
+		}
+		mockAuth.EXPECT().
+			SetSessionSettings(gomock.Any(), "session_123", settings).
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+				Settings: settings,
+			}, nil)
+
+		form := url.Values{"work_mem": {"128MB"}, "statement_timeout": {"1500"}, "application_name_suffix": {"etl"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/query/session-settings", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"statement_timeout_ms":1500`)
+		require.Contains(t, rec.Body.String(), `"work_mem":"128MB"`)
+	})
+
+	t.Run("Set Session Settings Accepts A Timeout With A Unit", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockAuth.EXPECT().
+			SetSessionSettings(gomock.Any(), "session_123", domain.SessionSettings{StatementTimeout: 2 * time.Minute}).
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+				Settings: domain.SessionSettings{StatementTimeout: 2 * time.Minute},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/session-settings", strings.NewReader("statement_timeout=2m"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"statement_timeout_ms":120000`)
+	})
+
+	t.Run("Set Session Settings Rejects An Unreadable Timeout", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/session-settings", strings.NewReader("statement_timeout=soon"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Set Session Settings Rejects An Invalid Preset", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockAuth.EXPECT().
+			SetSessionSettings(gomock.Any(), "session_123", domain.SessionSettings{WorkMem: "lots"}).
+			Return(nil, domain.ValidationError{Field: "work_mem", Message: "invalid work_mem: lots"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/session-settings", strings.NewReader("work_mem=lots"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "invalid work_mem")
+	})
+
+	t.Run("Export Query Streams CSV", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			ExportQueryCSV(gomock.Any(), "testuser", domain.QueryParams{
+				Query:       "SELECT * FROM users",
+				WhereClause: "active",
+				OrderBy:     "name",
+				OrderDir:    "DESC",
+			}, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, params domain.QueryParams, w io.Writer) error {
+				_, err := w.Write([]byte("id,name\n1,Alice\n"))
+				return err
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/export?format=csv&query=SELECT+*+FROM+users&where=active&orderBy=name&orderDir=DESC", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+		require.Equal(t, "id,name\n1,Alice\n", rec.Body.String())
+		require.True(t, rec.Flushed)
+	})
+
+	t.Run("Export Query Rejects Unsupported Format", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/export?format=xml&query=SELECT+1", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Export Query Forbidden Without Select Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockQuery.EXPECT().
+			ExportQueryCSV(gomock.Any(), "testuser", gomock.Any(), gomock.Any()).
+			Return(domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/export?query=SELECT+*+FROM+secrets", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Run Saved Template Binds Variables", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			BindTemplate(gomock.Any(), "testuser", "query_2", map[string]string{"user_id": "42"}).
+			Return("SELECT * FROM orders WHERE user_id = $1", []interface{}{int64(42)}, nil)
+
+		mockQuery.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), "testuser", domain.QueryParams{
+				Query: "SELECT * FROM orders WHERE user_id = $1",
+				Args:  []interface{}{int64(42)},
+				Limit: 50,
+			}).
+			Return(&domain.QueryResult{Columns: []string{"id"}}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/queries/run", strings.NewReader("id=query_2&var.user_id=42"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Run Saved Template Rejects Invalid Variable", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockSavedQuery.EXPECT().
+			BindTemplate(gomock.Any(), "testuser", "query_2", map[string]string{"user_id": "abc"}).
+			Return("", nil, domain.ValidationError{Field: "user_id", Message: "variable user_id must be a valid int"})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/queries/run", strings.NewReader("id=query_2&var.user_id=abc"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "valid int")
+	})
+
+	t.Run("Export Workspace Downloads The Workspace File", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "ws_session").
+			Return(&domain.Session{ID: "ws_session", Username: "testuser", SearchPath: []string{"reporting"}}, nil)
+		mockSavedQuery.EXPECT().
+			ExportWorkspace(gomock.Any(), "testuser", []string{"reporting"}).
+			Return([]byte(`{"version":1}`), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/workspace/export", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "ws_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, `attachment; filename="lumen-pg-workspace.json"`, rec.Header().Get("Content-Disposition"))
+		require.Equal(t, `{"version":1}`, rec.Body.String())
+	})
+
+	t.Run("Import Workspace Adds Queries And Applies The Search Path", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "ws_session").
+			Return(&domain.Session{ID: "ws_session", Username: "testuser"}, nil)
+		mockSavedQuery.EXPECT().
+			ImportWorkspace(gomock.Any(), "testuser", []byte(`{"version":1}`)).
+			Return(&domain.WorkspaceImport{Imported: 2, Renamed: 1, Skipped: 1, SearchPath: []string{"reporting"}}, nil)
+		mockAuth.EXPECT().
+			SetSessionSearchPath(gomock.Any(), "ws_session", []string{"reporting"}).
+			Return(&domain.Session{ID: "ws_session", Username: "testuser", SearchPath: []string{"reporting"}}, nil)
+
+		req := newWorkspaceImportRequest(t, `{"version":1}`)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "ws_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"imported":2`)
+		require.Contains(t, body, `"renamed":1`)
+		require.Contains(t, body, `"skipped":1`)
+		require.Contains(t, body, `"search_path":["reporting"]`)
+	})
+
+	t.Run("Import Workspace Keeps Queries When The Search Path Is Not Accessible", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "ws_session").
+			Return(&domain.Session{ID: "ws_session", Username: "testuser"}, nil)
+		mockSavedQuery.EXPECT().
+			ImportWorkspace(gomock.Any(), "testuser", gomock.Any()).
+			Return(&domain.WorkspaceImport{Imported: 1, SearchPath: []string{"legacy"}}, nil)
+		mockAuth.EXPECT().
+			SetSessionSearchPath(gomock.Any(), "ws_session", []string{"legacy"}).
+			Return(nil, domain.ValidationError{Field: "search_path", Message: "schema legacy is not accessible"})
+
+		req := newWorkspaceImportRequest(t, `{"version":1}`)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "ws_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"imported":1`)
+		require.Contains(t, body, `"search_path_error":"schema legacy is not accessible"`)
+	})
+
+	t.Run("Import Workspace Rejects An Invalid File", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "ws_session").
+			Return(&domain.Session{ID: "ws_session", Username: "testuser"}, nil)
+		mockSavedQuery.EXPECT().
+			ImportWorkspace(gomock.Any(), "testuser", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "file", Message: "unsupported workspace version: 9"})
+
+		req := newWorkspaceImportRequest(t, `{"version":9}`)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "ws_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "unsupported workspace version")
+	})
+
+	t.Run("Live Query Streams Refreshed Results", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "live_session").
+			Return(&domain.Session{ID: "live_session", Username: "testuser"}, nil)
+
+		mockQuery.EXPECT().
+			WatchQuery(gomock.Any(), "testuser", "SELECT count(*) FROM orders", 10*time.Second, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username, query string, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error {
+				refreshedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+				if err := onUpdate(&domain.LiveResultUpdate{
+					Sequence:    1,
+					RefreshedAt: refreshedAt,
+					Interval:    interval,
+					Result: &domain.QueryResult{
+						Columns:  []string{"count"},
+						Rows:     []map[string]interface{}{{"count": 42}},
+						RowCount: 1,
+					},
+				}); err != nil {
+					return err
+				}
+				return onUpdate(&domain.LiveResultUpdate{
+					Sequence:    2,
+					RefreshedAt: refreshedAt.Add(interval),
+					Interval:    interval,
+					Error:       "connection reset",
+				})
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/live?query=SELECT+count(*)+FROM+orders&interval=10", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "live_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		require.True(t, rec.Flushed)
+		body := rec.Body.String()
+		require.Contains(t, body, "id: 1\nevent: result\ndata: ")
+		require.Contains(t, body, `"rows":[{"count":42}]`)
+		require.Contains(t, body, `"interval_seconds":10`)
+		require.Contains(t, body, "id: 2\nevent: refresh-error\ndata: ")
+		require.Contains(t, body, `"error":"connection reset"`)
+	})
+
+	t.Run("Live Query Rejects Invalid Interval", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "live_session").
+			Return(&domain.Session{ID: "live_session", Username: "testuser"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/live?query=SELECT+1&interval=soon", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "live_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "invalid refresh interval")
+	})
+
+	t.Run("Live Query Forbidden Without Select Permission", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "live_session").
+			Return(&domain.Session{ID: "live_session", Username: "testuser"}, nil)
+
+		mockQuery.EXPECT().
+			WatchQuery(gomock.Any(), "testuser", "SELECT * FROM secrets", gomock.Any(), gomock.Any()).
+			Return(domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission"})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/live?query=SELECT+*+FROM+secrets", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "live_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.NotEqual(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	})
+
+	t.Run("Share Editor Returns A Temporary Invite", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		expiresAt := time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+		mockQuery.EXPECT().
+			ShareEditor(gomock.Any(), "testuser").
+			Return(&domain.EditorShare{Token: "tok-1", Owner: "testuser", ExpiresAt: expiresAt}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/query/share", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"token":"tok-1","expires_at":"2024-01-01T12:30:00Z","watch_url":"/api/query/shared?token=tok-1"}`, rec.Body.String())
+	})
+
+	t.Run("Stop Sharing Editor Revokes The Invite", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockQuery.EXPECT().
+			StopSharingEditor(gomock.Any(), "testuser").
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/query/share", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Shared Editor Streams The Owner's Runs", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "viewer_session").
+			Return(&domain.Session{ID: "viewer_session", Username: "viewer"}, nil)
+
+		mockQuery.EXPECT().
+			WatchSharedEditor(gomock.Any(), "viewer", "tok-1", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, viewer, token string, onUpdate func(*domain.EditorShareUpdate) error) error {
+				if err := onUpdate(&domain.EditorShareUpdate{Owner: "testuser"}); err != nil {
+					return err
+				}
+				return onUpdate(&domain.EditorShareUpdate{
+					Sequence: 1,
+					Owner:    "testuser",
+					Query:    "SELECT id FROM jobs",
+					RanAt:    time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+					Result:   &domain.QueryResult{Columns: []string{"id"}, Rows: []map[string]interface{}{{"id": 7}}, RowCount: 1},
+				})
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/shared?token=tok-1", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "viewer_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		body := rec.Body.String()
+		require.Contains(t, body, "id: 0\nevent: waiting\ndata: {\"owner\":\"testuser\",\"sequence\":0}")
+		require.Contains(t, body, "id: 1\nevent: run\ndata: ")
+		require.Contains(t, body, `"query":"SELECT id FROM jobs"`)
+		require.Contains(t, body, `"rows":[{"id":7}]`)
+	})
+
+	t.Run("Shared Editor Unknown Invite Not Found", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "viewer_session").
+			Return(&domain.Session{ID: "viewer_session", Username: "viewer"}, nil)
+
+		mockQuery.EXPECT().
+			WatchSharedEditor(gomock.Any(), "viewer", "expired", gomock.Any()).
+			Return(domain.ErrEditorShareNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/shared?token=expired", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "viewer_session"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Execute links the headers and filter of a kept result", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockQuery.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), "testuser", gomock.Any()).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id", "name"},
+				Rows:       []map[string]interface{}{{"id": 1, "name": "Alice"}},
+				RowCount:   1,
+				TotalCount: 1,
+				ResultID:   "result_1",
+			}, nil)
+
+		form := url.Values{"query": {"SELECT id, name FROM users"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/query/execute", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "class='result-filter'")
+		require.Contains(t, body, "href='/api/query/result?dir=ASC&amp;result_id=result_1&amp;sort=name'")
+	})
+
+	t.Run("Result view re-sorts and filters the kept rows", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockQuery.EXPECT().
+			ViewResult(gomock.Any(), "testuser", "result_1", domain.ResultView{SortColumn: "name", SortDir: domain.SortDirectionDESC, Filter: "ali", Limit: 50}).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id", "name"},
+				Rows:       []map[string]interface{}{{"id": 1, "name": "Alice"}},
+				RowCount:   1,
+				TotalCount: 1,
+				ResultID:   "result_1",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/result?result_id=result_1&sort=name&dir=desc&filter=ali", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "Alice")
+		require.Contains(t, body, "name ▼")
+		require.Contains(t, body, "value='ali'")
+	})
+
+	t.Run("Create notebook from JSON body", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockNotebook.EXPECT().
+			CreateNotebook(gomock.Any(), "testuser", "Weekly report", []domain.NotebookCell{
+				{Kind: domain.NotebookCellMarkdown, Source: "# Signups"},
+				{ID: "count", Kind: domain.NotebookCellSQL, Source: "SELECT COUNT(*) FROM users"},
+			}).
+			Return(&domain.QueryNotebook{ID: "notebook_1", Title: "Weekly report"}, nil)
+
+		body := `{"title":"Weekly report","cells":[{"kind":"markdown","source":"# Signups"},{"id":"count","kind":"sql","source":"SELECT COUNT(*) FROM users"}]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/notebooks", strings.NewReader(body))
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+		require.Contains(t, rec.Body.String(), "notebook_1")
+	})
+
+	t.Run("Run one notebook cell", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockNotebook.EXPECT().
+			RunCell(gomock.Any(), "testuser", "notebook_1", "count").
+			Return(&domain.QueryNotebook{ID: "notebook_1"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/notebooks/run", strings.NewReader("id=notebook_1&cell_id=count"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Run all notebook cells", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockNotebook.EXPECT().
+			RunAllCells(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/notebooks/run", strings.NewReader("id=notebook_1"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Get missing notebook returns not found", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_9").
+			Return(nil, domain.ErrNotebookNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/notebooks/get?id=notebook_9", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Export notebook as an attachment", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockNotebook.EXPECT().
+			ExportNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.NotebookExport{Filename: "weekly_report.json", ContentType: "application/json", Content: []byte(`{"version":1}`)}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/notebooks/export?id=notebook_1", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, `attachment; filename="weekly_report.json"`, rec.Header().Get("Content-Disposition"))
+		require.Equal(t, `{"version":1}`, rec.Body.String())
+	})
+
+	t.Run("Result view reports an expired result", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockQuery.EXPECT().
+			ViewResult(gomock.Any(), "testuser", "result_old", gomock.Any()).
+			Return(nil, domain.ErrResultNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/query/result?result_id=result_old", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Contains(t, rec.Body.String(), "run the query again")
+	})
+}
+
+// newWorkspaceImportRequest builds a multipart workspace file upload for /api/workspace/import
+func newWorkspaceImportRequest(t *testing.T, content string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "lumen-pg-workspace.json")
+	require.NoError(t, err)
+	_, err = io.WriteString(part, content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/workspace/import", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
 }
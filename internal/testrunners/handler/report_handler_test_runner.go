@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// ReportHandlerConstructor is a function type that creates a ReportHandler
+type ReportHandlerConstructor func(
+	queryUC usecase.QueryUseCase,
+	dataViewUC usecase.DataViewUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.ReportHandler
+
+// ReportHandlerRunner runs all printable report handler tests
+func ReportHandlerRunner(t *testing.T, constructor ReportHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuery := mockUsecase.NewMockQueryUseCase(ctrl)
+	mockDataView := mockUsecase.NewMockDataViewUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockQuery, mockDataView, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "alice", SearchPath: []string{"sales"}}, nil).
+		AnyTimes()
+
+	newFormRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	generatedAt := time.Date(2026, 10, 18, 14, 30, 0, 0, time.UTC)
+
+	t.Run("Query report renders the title, filter, timestamp and requesting user", func(t *testing.T) {
+		text := func(s string) *string { return &s }
+		mockQuery.EXPECT().
+			BuildQueryReport(gomock.Any(), "alice", domain.QueryParams{
+				Query:       "SELECT id, note FROM orders",
+				WhereClause: "id > 1",
+				OrderBy:     "id",
+				OrderDir:    "DESC",
+				SearchPath:  []string{"sales"},
+			}, "Q3 <audit>").
+			Return(&domain.ResultReport{
+				Title:       "Q3 <audit>",
+				Source:      "SELECT id, note FROM orders",
+				Filter:      "id > 1",
+				Sort:        "id DESC",
+				GeneratedBy: "alice",
+				GeneratedAt: generatedAt,
+				Columns:     []string{"id", "note"},
+				Rows:        [][]*string{{text("3"), text("<b>rush</b>")}, {text("2"), nil}, {text("1"), text("NULL")}},
+			}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/report/query", url.Values{
+			"query": {"SELECT id, note FROM orders"}, "where": {"id > 1"}, "orderBy": {"id"}, "orderDir": {"DESC"},
+			"title": {"Q3 <audit>"},
+		}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "text/html", w.Header().Get("Content-Type"))
+		body := w.Body.String()
+		require.Contains(t, body, "<h1>Q3 &lt;audit&gt;</h1>")
+		require.Contains(t, body, "<dt>Filter</dt><dd>id &gt; 1</dd>")
+		require.Contains(t, body, "<dt>Sort</dt><dd>id DESC</dd>")
+		require.Contains(t, body, "<dt>Generated</dt><dd>2026-10-18T14:30:00Z</dd>")
+		require.Contains(t, body, "<dt>Requested by</dt><dd>alice</dd>")
+		require.Contains(t, body, "<td>&lt;b&gt;rush&lt;/b&gt;</td>")
+		require.Equal(t, 1, strings.Count(body, `<td class="null">NULL</td>`))
+		require.Contains(t, body, "<td>NULL</td>")
+		require.Contains(t, body, "Page 1 of 1")
+		require.Contains(t, body, "@page")
+	})
+
+	t.Run("Table report splits the rows across printed pages", func(t *testing.T) {
+		rows := make([][]*string, domain.ReportRowsPerPage+1)
+		for i := range rows {
+			id := fmt.Sprint(i + 1)
+			rows[i] = []*string{&id}
+		}
+		mockDataView.EXPECT().
+			BuildTableReport(gomock.Any(), "alice", domain.TableDataParams{
+				Database: "shop",
+				Schema:   "public",
+				Table:    "orders",
+			}, "").
+			Return(&domain.ResultReport{
+				Title:       "public.orders",
+				Source:      "shop.public.orders",
+				GeneratedBy: "alice",
+				GeneratedAt: generatedAt,
+				Columns:     []string{"id"},
+				Rows:        rows,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/report/table?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		require.Equal(t, 2, strings.Count(body, `<section class="report-page">`))
+		require.Equal(t, 2, strings.Count(body, "<thead><tr><th>id</th></tr></thead>"))
+		require.Contains(t, body, "Page 1 of 2")
+		require.Contains(t, body, "Page 2 of 2")
+		require.Contains(t, body, "<dt>Filter</dt><dd>None</dd>")
+	})
+
+	t.Run("Table report requires the table", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/report/table", url.Values{"database": {"shop"}}))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Reports refused by the export policy are forbidden", func(t *testing.T) {
+		mockDataView.EXPECT().
+			BuildTableReport(gomock.Any(), "alice", gomock.Any(), gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "export of this table is not allowed for this user"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/report/table", url.Values{"database": {"shop"}, "schema": {"hr"}, "table": {"salaries"}}))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Reports over the row limit ask for a narrower filter", func(t *testing.T) {
+		mockQuery.EXPECT().
+			BuildQueryReport(gomock.Any(), "alice", gomock.Any(), gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "limit", Message: "report exceeds the limit of 2000 rows; narrow the filter"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/report/query", url.Values{"query": {"SELECT * FROM events"}}))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "narrow the filter")
+	})
+
+	t.Run("Reports require a session", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/report/query?query=SELECT+1", nil))
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,653 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// SchemaHandlerConstructor is a function type that creates a SchemaHandler
+type SchemaHandlerConstructor func(
+	schemaUC usecase.SchemaUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.SchemaHandler
+
+// SchemaHandlerRunner runs all schema handler tests
+func SchemaHandlerRunner(t *testing.T, constructor SchemaHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSchema := mockUsecase.NewMockSchemaUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockSchema, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "owner"}, nil).
+		AnyTimes()
+
+	newFormRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	t.Run("Indexes API lists the table's indexes", func(t *testing.T) {
+		mockSchema.EXPECT().
+			ListIndexes(gomock.Any(), "owner", "shop", "public", "orders").
+			Return(&domain.TableIndexList{
+				Indexes: []domain.TableIndex{
+					{Name: "orders_pkey", Definition: "CREATE UNIQUE INDEX orders_pkey ON public.orders USING btree (id)", Method: "btree", Unique: true, Primary: true, Valid: true, Constraint: "orders_pkey", SizeBytes: 16384, Scans: 40},
+				},
+				CanManage: true,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/indexes?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Indexes   []map[string]interface{} `json:"indexes"`
+			CanManage bool                     `json:"can_manage"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.True(t, response.CanManage)
+		require.Len(t, response.Indexes, 1)
+		require.Equal(t, "orders_pkey", response.Indexes[0]["constraint"])
+		require.Equal(t, float64(40), response.Indexes[0]["scans"])
+	})
+
+	t.Run("Indexes API requires the table", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/table/indexes?database=shop&schema=public", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Indexes API requires a session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/table/indexes?database=shop&schema=public&table=orders", nil)
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Create index previews the statement until it is confirmed", func(t *testing.T) {
+		statement := `CREATE UNIQUE INDEX "orders_ref_idx" ON "public"."orders" ("customer_id", "ref")`
+		definition := domain.IndexDefinition{
+			Database: "shop",
+			Schema:   "public",
+			Table:    "orders",
+			Name:     "orders_ref_idx",
+			Columns:  []string{"customer_id", "ref"},
+			Unique:   true,
+		}
+		mockSchema.EXPECT().
+			CreateIndex(gomock.Any(), "owner", definition, "").
+			Return(&domain.SchemaChange{Statement: statement}, nil)
+		mockSchema.EXPECT().
+			CreateIndex(gomock.Any(), "owner", definition, statement).
+			Return(&domain.SchemaChange{Statement: statement, Applied: true}, nil)
+
+		form := url.Values{
+			"database": {"shop"}, "schema": {"public"}, "table": {"orders"},
+			"name": {"orders_ref_idx"}, "column": {"customer_id", "ref"}, "unique": {"true"},
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/indexes/create", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var preview map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &preview))
+		require.Equal(t, statement, preview["statement"])
+		require.Equal(t, false, preview["applied"])
+
+		form.Set("confirm", statement)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/indexes/create", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"applied":true`)
+	})
+
+	t.Run("Create index is forbidden to users who do not own the table", func(t *testing.T) {
+		mockSchema.EXPECT().
+			CreateIndex(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only the table's owner can change its structure"})
+
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "column": {"id"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/indexes/create", form))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Create index rejects an invalid definition", func(t *testing.T) {
+		mockSchema.EXPECT().
+			CreateIndex(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "method", Message: "unsupported index method: <bloom>"})
+
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "column": {"id"}, "method": {"<bloom>"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/indexes/create", form))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.NotContains(t, w.Body.String(), "<bloom>")
+	})
+
+	t.Run("Create index requires POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/table/indexes/create", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Drop index runs the confirmed statement", func(t *testing.T) {
+		statement := `DROP INDEX CONCURRENTLY "public"."orders_ref_idx"`
+		mockSchema.EXPECT().
+			DropIndex(gomock.Any(), "owner", domain.IndexDrop{Database: "shop", Schema: "public", Table: "orders", Name: "orders_ref_idx", Concurrently: true}, statement).
+			Return(&domain.SchemaChange{Statement: statement, Applied: true}, nil)
+
+		form := url.Values{
+			"database": {"shop"}, "schema": {"public"}, "table": {"orders"},
+			"name": {"orders_ref_idx"}, "concurrently": {"true"}, "confirm": {statement},
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/indexes/drop", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"applied":true`)
+	})
+
+	t.Run("Drop index requires the index name", func(t *testing.T) {
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/indexes/drop", form))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Constraints API lists the table's constraints", func(t *testing.T) {
+		mockSchema.EXPECT().
+			ListConstraints(gomock.Any(), "owner", "shop", "public", "orders").
+			Return(&domain.TableConstraintList{
+				Constraints: []domain.TableConstraint{
+					{Name: "orders_customer_id_fkey", Type: domain.ConstraintTypeForeignKey, Columns: []string{"customer_id"},
+						Definition: "FOREIGN KEY (customer_id) REFERENCES customers(id)", ReferencedSchema: "public",
+						ReferencedTable: "customers", ReferencedColumns: []string{"id"}, Validated: true},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/constraints?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Constraints []map[string]interface{} `json:"constraints"`
+			CanManage   bool                     `json:"can_manage"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.False(t, response.CanManage)
+		require.Len(t, response.Constraints, 1)
+		require.Equal(t, "foreign_key", response.Constraints[0]["type"])
+		require.Equal(t, "customers", response.Constraints[0]["referenced_table"])
+		require.Equal(t, []interface{}{"customer_id"}, response.Constraints[0]["columns"])
+	})
+
+	t.Run("Add constraint previews the statement until it is confirmed", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders" ADD CONSTRAINT "orders_customer_fkey" FOREIGN KEY ("customer_id") REFERENCES "public"."customers" ("id") ON DELETE CASCADE`
+		definition := domain.ConstraintDefinition{
+			Database:          "shop",
+			Schema:            "public",
+			Table:             "orders",
+			Name:              "orders_customer_fkey",
+			Type:              domain.ConstraintTypeForeignKey,
+			Columns:           []string{"customer_id"},
+			ReferencedTable:   "customers",
+			ReferencedColumns: []string{"id"},
+			OnDelete:          "CASCADE",
+		}
+		mockSchema.EXPECT().
+			AddConstraint(gomock.Any(), "owner", definition, "").
+			Return(&domain.SchemaChange{Statement: statement}, nil)
+		mockSchema.EXPECT().
+			AddConstraint(gomock.Any(), "owner", definition, statement).
+			Return(&domain.SchemaChange{Statement: statement, Applied: true}, nil)
+
+		form := url.Values{
+			"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "name": {"orders_customer_fkey"},
+			"type": {"foreign_key"}, "column": {"customer_id"}, "referenced_table": {"customers"},
+			"referenced_column": {"id"}, "on_delete": {"CASCADE"},
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/constraints/add", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var preview map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &preview))
+		require.Equal(t, statement, preview["statement"])
+		require.Equal(t, false, preview["applied"])
+
+		form.Set("confirm", statement)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/constraints/add", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"applied":true`)
+	})
+
+	t.Run("Add constraint rejects an invalid definition", func(t *testing.T) {
+		mockSchema.EXPECT().
+			AddConstraint(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "expression", Message: "the check expression cannot contain a semicolon"})
+
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "type": {"check"}, "expression": {"true; --"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/constraints/add", form))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Drop constraint is forbidden to users who do not own the table", func(t *testing.T) {
+		mockSchema.EXPECT().
+			DropConstraint(gomock.Any(), "owner", domain.ConstraintDrop{Database: "shop", Schema: "public", Table: "orders", Name: "orders_pkey"}, "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only the table's owner can change its structure"})
+
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "name": {"orders_pkey"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/constraints/drop", form))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Drop constraint requires POST", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/table/constraints/drop?database=shop&schema=public&table=orders&name=orders_pkey", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Triggers API lists timing, events and function", func(t *testing.T) {
+		mockSchema.EXPECT().
+			ListTriggers(gomock.Any(), "owner", "shop", "public", "orders").
+			Return(&domain.TableTriggerList{
+				Triggers: []domain.TableTrigger{
+					{Name: "orders_audit", Timing: domain.TriggerTimingAfter, Events: []string{"INSERT", "UPDATE"}, ForEachRow: true,
+						FunctionSchema: "public", FunctionName: "record_order_change", Enabled: true},
+				},
+				CanManage: true,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/triggers?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Triggers  []map[string]interface{} `json:"triggers"`
+			CanManage bool                     `json:"can_manage"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.True(t, response.CanManage)
+		require.Len(t, response.Triggers, 1)
+		require.Equal(t, "AFTER", response.Triggers[0]["timing"])
+		require.Equal(t, []interface{}{"INSERT", "UPDATE"}, response.Triggers[0]["events"])
+		require.Equal(t, "record_order_change", response.Triggers[0]["function_name"])
+	})
+
+	t.Run("Enable and disable trigger routes set the toggle direction", func(t *testing.T) {
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "name": {"orders_audit"}}
+		mockSchema.EXPECT().
+			SetTriggerEnabled(gomock.Any(), "owner", domain.TriggerToggle{Database: "shop", Schema: "public", Table: "orders", Name: "orders_audit"}, "").
+			Return(&domain.SchemaChange{Statement: `ALTER TABLE "public"."orders" DISABLE TRIGGER "orders_audit"`}, nil)
+		mockSchema.EXPECT().
+			SetTriggerEnabled(gomock.Any(), "owner", domain.TriggerToggle{Database: "shop", Schema: "public", Table: "orders", Name: "orders_audit", Enable: true}, "").
+			Return(&domain.SchemaChange{Statement: `ALTER TABLE "public"."orders" ENABLE TRIGGER "orders_audit"`}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/triggers/disable", form))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), "DISABLE TRIGGER")
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/triggers/enable", form))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), "ENABLE TRIGGER")
+	})
+
+	t.Run("Trigger toggle is forbidden to users who do not own the table and requires POST", func(t *testing.T) {
+		mockSchema.EXPECT().
+			SetTriggerEnabled(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only the table's owner can change its structure"})
+
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "name": {"orders_audit"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/triggers/disable", form))
+		require.Equal(t, http.StatusForbidden, w.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/triggers/enable?database=shop&schema=public&table=orders&name=orders_audit", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("DDL API returns the reconstructed statement", func(t *testing.T) {
+		mockSchema.EXPECT().
+			GetTableDDL(gomock.Any(), "owner", "shop", "public", "orders").
+			Return(&domain.TableDDL{Database: "shop", Schema: "public", Table: "orders", Statement: "CREATE TABLE \"public\".\"orders\" (\n);\n"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/ddl?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, "CREATE TABLE \"public\".\"orders\" (\n);\n", response["statement"])
+	})
+
+	t.Run("DDL API downloads the script as SQL", func(t *testing.T) {
+		mockSchema.EXPECT().
+			GetTableDDL(gomock.Any(), "owner", "shop", "public", "orders").
+			Return(&domain.TableDDL{Statement: "CREATE TABLE \"public\".\"orders\" (\n);\n"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/ddl?database=shop&schema=public&table=orders&format=sql", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/sql", w.Header().Get("Content-Type"))
+		require.Equal(t, `attachment; filename="orders.sql"`, w.Header().Get("Content-Disposition"))
+		require.Equal(t, "CREATE TABLE \"public\".\"orders\" (\n);\n", w.Body.String())
+	})
+
+	t.Run("DDL API reports a missing table", func(t *testing.T) {
+		mockSchema.EXPECT().
+			GetTableDDL(gomock.Any(), "owner", "shop", "public", "gone").
+			Return(nil, domain.ErrTableNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/ddl?database=shop&schema=public&table=gone", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	newJSONRequest := func(path, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	t.Run("Alter table API passes the designer's changes in order", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders" RENAME COLUMN "total" TO "amount"`
+		mockSchema.EXPECT().
+			AlterTable(gomock.Any(), "owner", domain.TableAlteration{Database: "shop", Schema: "public", Table: "orders", Changes: []domain.ColumnChange{
+				{Operation: domain.ColumnOperationRename, Column: "total", NewName: "amount"},
+				{Operation: domain.ColumnOperationSetPrimaryKey, Columns: []string{"id"}},
+			}}, statement).
+			Return(&domain.SchemaChange{Statement: statement, Applied: true}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newJSONRequest("/api/table/alter", `{"database":"shop","schema":"public","table":"orders","changes":[
+			{"operation":"rename_column","column":"total","new_name":"amount"},
+			{"operation":"set_primary_key","columns":["id"]}],"confirm":`+strconv.Quote(statement)+`}`))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, true, response["applied"])
+	})
+
+	t.Run("Alter table API previews the objects a drop affects", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders"
+    DROP COLUMN "total"`
+		mockSchema.EXPECT().
+			AlterTable(gomock.Any(), "owner", gomock.Any(), "").
+			Return(&domain.SchemaChange{Statement: statement, Impact: []domain.ColumnDependent{
+				{Kind: domain.ColumnDependentView, Schema: "reporting", Name: "order_totals", Column: "total", Definition: "SELECT sum(total) FROM orders"},
+				{Kind: domain.ColumnDependentFunction, Schema: "public", Name: "order_total(integer)", Column: "total", Inferred: true},
+			}}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newJSONRequest("/api/table/alter", `{"database":"shop","schema":"public","table":"orders","changes":[{"operation":"drop_column","column":"total"}]}`))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Applied bool `json:"applied"`
+			Impact  []struct {
+				Kind     string `json:"kind"`
+				Schema   string `json:"schema"`
+				Name     string `json:"name"`
+				Column   string `json:"column"`
+				Inferred bool   `json:"inferred"`
+			} `json:"impact"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.False(t, response.Applied)
+		require.Len(t, response.Impact, 2)
+		require.Equal(t, "view", response.Impact[0].Kind)
+		require.Equal(t, "order_totals", response.Impact[0].Name)
+		require.True(t, response.Impact[1].Inferred)
+	})
+
+	t.Run("Alter table API maps permission and validation errors", func(t *testing.T) {
+		mockSchema.EXPECT().
+			AlterTable(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only the table's owner can change its structure"})
+		mockSchema.EXPECT().
+			AlterTable(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "changes[0]", Message: "column missing does not exist on orders"})
+
+		body := `{"database":"shop","schema":"public","table":"orders","changes":[{"operation":"drop_column","column":"missing"}]}`
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newJSONRequest("/api/table/alter", body))
+		require.Equal(t, http.StatusForbidden, w.Code)
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newJSONRequest("/api/table/alter", body))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newJSONRequest("/api/table/alter", `not json`))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Create table API previews the designed table", func(t *testing.T) {
+		statement := "CREATE TABLE \"public\".\"invoices\" (\n    \"id\" bigint NOT NULL,\n    PRIMARY KEY (\"id\")\n)"
+		mockSchema.EXPECT().
+			CreateTable(gomock.Any(), "owner", domain.TableCreation{Database: "shop", Schema: "public", Table: "invoices",
+				Columns:    []domain.ColumnDesign{{Name: "id", DataType: "bigint", NotNull: true}},
+				PrimaryKey: []string{"id"},
+			}, "").
+			Return(&domain.SchemaChange{Statement: statement}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newJSONRequest("/api/table/create", `{"database":"shop","schema":"public","table":"invoices",
+			"columns":[{"name":"id","data_type":"bigint","not_null":true}],"primary_key":["id"]}`))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, statement, response["statement"])
+		require.Equal(t, false, response["applied"])
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/create", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Create database API previews the statement until it is confirmed", func(t *testing.T) {
+		statement := `CREATE DATABASE "reports" OWNER "analyst" ENCODING 'UTF8'`
+		creation := domain.DatabaseCreation{Name: "reports", Owner: "analyst", Encoding: "UTF8"}
+		mockSchema.EXPECT().
+			CreateDatabase(gomock.Any(), "owner", creation, "").
+			Return(&domain.SchemaChange{Statement: statement}, nil)
+		mockSchema.EXPECT().
+			CreateDatabase(gomock.Any(), "owner", creation, statement).
+			Return(&domain.SchemaChange{Statement: statement, Applied: true}, nil)
+
+		form := url.Values{"name": {"reports"}, "owner": {"analyst"}, "encoding": {"UTF8"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/databases/create", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var preview map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &preview))
+		require.Equal(t, statement, preview["statement"])
+		require.Equal(t, false, preview["applied"])
+
+		form.Set("confirm", statement)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/databases/create", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"applied":true`)
+	})
+
+	t.Run("Create database API is forbidden without CREATEDB", func(t *testing.T) {
+		mockSchema.EXPECT().
+			CreateDatabase(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only superusers and roles with CREATEDB may create databases"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/databases/create", url.Values{"name": {"reports"}}))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Create schema API passes the database and maps validation errors", func(t *testing.T) {
+		statement := `CREATE SCHEMA "staging"`
+		mockSchema.EXPECT().
+			CreateSchema(gomock.Any(), "owner", domain.SchemaCreation{Database: "shop", Name: "staging"}, "").
+			Return(&domain.SchemaChange{Statement: statement}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/schemas/create", url.Values{"database": {"shop"}, "name": {"staging"}}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"applied":false`)
+
+		mockSchema.EXPECT().
+			CreateSchema(gomock.Any(), "owner", domain.SchemaCreation{Database: "shop", Name: "<x>"}, "").
+			Return(nil, domain.ValidationError{Field: "name", Message: "schema name <x> is invalid"})
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/schemas/create", url.Values{"database": {"shop"}, "name": {"<x>"}}))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.NotContains(t, w.Body.String(), "<x>")
+	})
+
+	t.Run("Create schema API requires the database and POST", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/schemas/create", url.Values{"name": {"staging"}}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/schemas/create", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Comments API lists the table and column comments", func(t *testing.T) {
+		mockSchema.EXPECT().
+			ListComments(gomock.Any(), "owner", "shop", "public", "orders").
+			Return(&domain.TableComments{
+				Comment: "Customer orders",
+				Columns: []domain.ColumnComment{{Name: "status", Type: "text", Comment: "new, paid or shipped"}},
+				CanEdit: true,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table/comments?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.Equal(t, "Customer orders", body["comment"])
+		require.Equal(t, true, body["can_edit"])
+		columns := body["columns"].([]interface{})
+		require.Len(t, columns, 1)
+		require.Equal(t, "new, paid or shipped", columns[0].(map[string]interface{})["comment"])
+	})
+
+	t.Run("Set comment previews a column comment and maps permission errors", func(t *testing.T) {
+		statement := `COMMENT ON COLUMN "public"."orders"."status" IS 'Order state'`
+		change := domain.CommentChange{Database: "shop", Schema: "public", Table: "orders", Column: "status", Comment: "Order state"}
+		mockSchema.EXPECT().
+			SetComment(gomock.Any(), "owner", change, "").
+			Return(&domain.SchemaChange{Statement: statement}, nil)
+
+		form := url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "column": {"status"}, "comment": {"Order state"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/comments/set", form))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var preview map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &preview))
+		require.Equal(t, statement, preview["statement"])
+
+		mockSchema.EXPECT().
+			SetComment(gomock.Any(), "owner", gomock.Any(), "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only the table's owner can change its structure"})
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/comments/set", form))
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Set comment requires the table", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table/comments/set", url.Values{"comment": {"x"}}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -49,6 +49,19 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 
 	ctx := context.Background()
 	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+	// Password logins act as the role named by the login
+	mockAuth.EXPECT().
+		ResolveLoginRole(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, username, _ string) (string, error) { return username, nil }).
+		AnyTimes()
+	mockAuth.EXPECT().
+		BindLoginConnection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, _ string) (context.Context, error) { return ctx, nil }).
+		AnyTimes()
+	mockAuth.EXPECT().
+		TouchSession(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
 	mockSecurity := mockUsecase.NewMockSecurityUseCase(ctrl)
 	mockQuery := mockUsecase.NewMockQueryUseCase(ctrl)
 	mockDataView := mockUsecase.NewMockDataViewUseCase(ctrl)
@@ -141,7 +154,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -168,7 +181,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -227,7 +240,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -254,7 +267,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -340,7 +353,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -367,7 +380,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
@@ -442,7 +455,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return([]domain.ValidationError{}, nil)
 
 		mockAuth.EXPECT().
-			ProbeConnection(gomock.Any(), "testuser", "password123").
+			ProbeConnection(gomock.Any(), "", "testuser", "password123").
 			Return(true, nil)
 
 		mockAuth.EXPECT().
@@ -469,7 +482,7 @@ func SecurityHandlerRunner(t *testing.T, constructor SecurityHandlerConstructorF
 			Return("users", nil)
 
 		mockAuth.EXPECT().
-			CreateSession(gomock.Any(), "testuser", "password123", "testdb", "public", "users").
+			CreateSession(gomock.Any(), "", "testuser", "password123", "testdb", "public", "users").
 			Return(&domain.Session{
 				ID:       "session_123",
 				Username: "testuser",
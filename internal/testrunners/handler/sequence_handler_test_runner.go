@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// SequenceHandlerConstructor is a function type that creates a SequenceHandler
+type SequenceHandlerConstructor func(
+	sequenceUC usecase.SequenceUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.SequenceHandler
+
+// SequenceHandlerRunner runs all sequence handler tests
+func SequenceHandlerRunner(t *testing.T, constructor SequenceHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSequence := mockUsecase.NewMockSequenceUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockSequence, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "owner"}, nil).
+		AnyTimes()
+
+	newFormRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	t.Run("Sequences API lists the schema's sequences", func(t *testing.T) {
+		lastValue := int64(41)
+		mockSequence.EXPECT().
+			ListSequences(gomock.Any(), "owner", "shop", "public").
+			Return([]domain.Sequence{
+				{Schema: "public", Name: "orders_id_seq", DataType: "integer", LastValue: &lastValue, StartValue: 1, Increment: 1, MinValue: 1, MaxValue: 2147483647,
+					OwnedByTable: "orders", OwnedByColumn: "id", CanSet: true},
+				{Schema: "public", Name: "invoice_number_seq", DataType: "bigint", StartValue: 1000, Increment: 1, MinValue: 1, MaxValue: 9999},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/sequences?database=shop&schema=public", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Sequences []map[string]interface{} `json:"sequences"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Sequences, 2)
+		require.Equal(t, float64(41), response.Sequences[0]["last_value"])
+		require.Equal(t, "id", response.Sequences[0]["owned_by_column"])
+		require.Equal(t, true, response.Sequences[0]["can_set"])
+		require.Nil(t, response.Sequences[1]["last_value"])
+	})
+
+	t.Run("Sequences API requires the schema and a session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/sequences?database=shop", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/sequences?database=shop&schema=public", nil)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Setval API previews the statement without confirm", func(t *testing.T) {
+		mockSequence.EXPECT().
+			SetSequenceValue(gomock.Any(), "owner", domain.SequenceValue{Database: "shop", Schema: "public", Name: "orders_id_seq", Value: 500, IsCalled: true}, "").
+			Return(&domain.SchemaChange{Statement: `SELECT setval('"public"."orders_id_seq"', 500, true)`}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/sequences/setval", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"orders_id_seq"}, "value": {"500"},
+		}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, false, response["applied"])
+		require.Contains(t, response["statement"], "setval")
+	})
+
+	t.Run("Setval API rejects a non-numeric value and GET", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/sequences/setval", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"orders_id_seq"}, "value": {"ten"},
+		}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/sequences/setval", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("Restart API applies the confirmed statement", func(t *testing.T) {
+		statement := `ALTER SEQUENCE "public"."invoice_number_seq" RESTART WITH 2000`
+		mockSequence.EXPECT().
+			RestartSequence(gomock.Any(), "owner", gomock.Any(), statement).
+			DoAndReturn(func(_ interface{}, _ string, restart domain.SequenceRestart, confirm string) (*domain.SchemaChange, error) {
+				require.NotNil(t, restart.Value)
+				require.Equal(t, int64(2000), *restart.Value)
+				return &domain.SchemaChange{Statement: statement, Applied: true}, nil
+			})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/sequences/restart", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"invoice_number_seq"}, "value": {"2000"}, "confirm": {statement},
+		}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, true, response["applied"])
+	})
+
+	t.Run("Restart API refuses users who do not own the sequence", func(t *testing.T) {
+		mockSequence.EXPECT().
+			RestartSequence(gomock.Any(), "owner", domain.SequenceRestart{Database: "shop", Schema: "public", Name: "orders_id_seq"}, "").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "only the sequence's owner can restart it"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/sequences/restart", url.Values{
+			"database": {"shop"}, "schema": {"public"}, "name": {"orders_id_seq"},
+		}))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// ShortcutHandlerConstructor is a function type that creates a ShortcutHandler
+type ShortcutHandlerConstructor func(
+	shortcutUC usecase.ShortcutUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.ShortcutHandler
+
+// ShortcutHandlerRunner runs all keyboard shortcut handler tests
+func ShortcutHandlerRunner(t *testing.T, constructor ShortcutHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShortcut := mockUsecase.NewMockShortcutUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockShortcut, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "alice"}, nil).
+		AnyTimes()
+
+	newFormRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	t.Run("Shortcuts API lists the user's bindings", func(t *testing.T) {
+		mockShortcut.EXPECT().
+			ListShortcuts(gomock.Any(), "alice").
+			Return([]domain.KeyboardShortcut{
+				{Action: domain.ShortcutRunQuery, Description: "Run the query", Keys: "Ctrl+R", DefaultKeys: "Ctrl+Enter", Customized: true},
+				{Action: domain.ShortcutCommitTransaction, Description: "Commit the transaction", DefaultKeys: "Ctrl+Shift+Enter", Disabled: true},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/shortcuts", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response struct {
+			Shortcuts []map[string]interface{} `json:"shortcuts"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Shortcuts, 2)
+		require.Equal(t, "Ctrl+R", response.Shortcuts[0]["keys"])
+		require.Equal(t, "Ctrl+Enter", response.Shortcuts[0]["default_keys"])
+		require.Equal(t, true, response.Shortcuts[0]["customized"])
+		require.Equal(t, true, response.Shortcuts[1]["disabled"])
+	})
+
+	t.Run("Shortcuts API requires a session", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/shortcuts", nil))
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Remap API stores the new binding", func(t *testing.T) {
+		mockShortcut.EXPECT().
+			RemapShortcut(gomock.Any(), "alice", domain.ShortcutRunQuery, "Ctrl+R").
+			Return(&domain.KeyboardShortcut{Action: domain.ShortcutRunQuery, Keys: "Ctrl+R", DefaultKeys: "Ctrl+Enter", Customized: true}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/shortcuts/remap", url.Values{"action": {domain.ShortcutRunQuery}, "keys": {"Ctrl+R"}}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, "Ctrl+R", response["keys"])
+	})
+
+	t.Run("Remap API reports conflicts and disabled actions", func(t *testing.T) {
+		mockShortcut.EXPECT().
+			RemapShortcut(gomock.Any(), "alice", domain.ShortcutRunQuery, "Ctrl+S").
+			Return(nil, domain.ValidationError{Field: "keys", Message: "Ctrl+S is already bound to save_query; remap that shortcut first"})
+		mockShortcut.EXPECT().
+			RemapShortcut(gomock.Any(), "alice", domain.ShortcutCommitTransaction, "Ctrl+K").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "the commit_transaction shortcut is disabled by an administrator"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/shortcuts/remap", url.Values{"action": {domain.ShortcutRunQuery}, "keys": {"Ctrl+S"}}))
+		require.Equal(t, http.StatusConflict, w.Code)
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/shortcuts/remap", url.Values{"action": {domain.ShortcutCommitTransaction}, "keys": {"Ctrl+K"}}))
+		require.Equal(t, http.StatusForbidden, w.Code)
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/shortcuts/remap", url.Values{"action": {domain.ShortcutRunQuery}}))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Reset API restores the default binding", func(t *testing.T) {
+		mockShortcut.EXPECT().
+			ResetShortcut(gomock.Any(), "alice", domain.ShortcutRunQuery).
+			Return(&domain.KeyboardShortcut{Action: domain.ShortcutRunQuery, Keys: "Ctrl+Enter", DefaultKeys: "Ctrl+Enter"}, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/shortcuts/reset", url.Values{"action": {domain.ShortcutRunQuery}}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Equal(t, "Ctrl+Enter", response["keys"])
+		require.Equal(t, false, response["customized"])
+
+		req := httptest.NewRequest(http.MethodGet, "/api/shortcuts/reset?action=run_query", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/handler"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// TableViewHandlerConstructor is a function type that creates a TableViewHandler
+type TableViewHandlerConstructor func(
+	tableViewUC usecase.TableViewUseCase,
+	authUC usecase.AuthenticationUseCase,
+) handler.TableViewHandler
+
+// TableViewHandlerRunner runs all saved table view handler tests
+func TableViewHandlerRunner(t *testing.T, constructor TableViewHandlerConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTableView := mockUsecase.NewMockTableViewUseCase(ctrl)
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	h := constructor(mockTableView, mockAuth)
+
+	mockAuth.EXPECT().
+		ValidateSession(gomock.Any(), "session_123").
+		Return(&domain.Session{ID: "session_123", Username: "alice"}, nil).
+		AnyTimes()
+
+	newFormRequest := func(path string, form url.Values) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		return req
+	}
+
+	t.Run("Table views API lists the user's views of a table", func(t *testing.T) {
+		mockTableView.EXPECT().
+			ListTableViews(gomock.Any(), "alice", "shop", "public", "orders").
+			Return([]domain.SavedTableView{
+				{Name: "Open", Database: "shop", Schema: "public", Table: "orders", WhereClause: "status = 'open'", IsDefault: true},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/table-views?database=shop&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		w := httptest.NewRecorder()
+
+		h.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"views":[{"name":"Open","database":"shop","schema":"public","table":"orders","where":"status = 'open'",
+			"order_by":"","order_dir":"","hidden_columns":[],"default":true}]}`, w.Body.String())
+	})
+
+	t.Run("Table views API saves the filter, sort and hidden columns", func(t *testing.T) {
+		saved := domain.SavedTableView{
+			Name:          "Recent",
+			Database:      "shop",
+			Schema:        "public",
+			Table:         "orders",
+			WhereClause:   "total > 100",
+			OrderBy:       "created_at",
+			OrderDir:      "DESC",
+			HiddenColumns: []string{"notes", "internal_ref"},
+			IsDefault:     true,
+		}
+		mockTableView.EXPECT().
+			SaveTableView(gomock.Any(), "alice", saved).
+			Return(&saved, nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table-views/save", url.Values{
+			"name": {"Recent"}, "database": {"shop"}, "schema": {"public"}, "table": {"orders"},
+			"where": {"total > 100"}, "order_by": {"created_at"}, "order_dir": {"DESC"},
+			"hidden": {"notes", "internal_ref"}, "default": {"true"},
+		}))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), `"hidden_columns":["notes","internal_ref"]`)
+	})
+
+	t.Run("Table views API refuses views of tables the user cannot read", func(t *testing.T) {
+		mockTableView.EXPECT().
+			SaveTableView(gomock.Any(), "alice", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "permission", Message: "user does not have SELECT permission on this table"})
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table-views/save", url.Values{"name": {"Mine"}, "database": {"shop"}, "schema": {"hr"}, "table": {"salaries"}}))
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Table views API sets and clears the default view", func(t *testing.T) {
+		mockTableView.EXPECT().
+			SetDefaultTableView(gomock.Any(), "alice", "shop", "public", "orders", "Open").
+			Return(nil)
+		mockTableView.EXPECT().
+			SetDefaultTableView(gomock.Any(), "alice", "shop", "public", "orders", "").
+			Return(nil)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table-views/default", url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "name": {"Open"}}))
+		require.Equal(t, http.StatusNoContent, w.Code)
+
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table-views/default", url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}}))
+		require.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Table views API reports deleting an unknown view as not found", func(t *testing.T) {
+		mockTableView.EXPECT().
+			DeleteTableView(gomock.Any(), "alice", "shop", "public", "orders", "Missing").
+			Return(domain.ErrTableViewNotFound)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newFormRequest("/api/table-views/delete", url.Values{"database": {"shop"}, "schema": {"public"}, "table": {"orders"}, "name": {"Missing"}}))
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -2,11 +2,13 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -61,6 +63,10 @@ func TransactionHandlerRunner(t *testing.T, constructor TransactionHandlerConstr
 			CheckActiveTransaction(gomock.Any(), "testuser").
 			Return(false, nil)
 
+		mockTxn.EXPECT().
+			CheckTableLocks(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(nil, nil)
+
 		mockTxn.EXPECT().
 			StartTransaction(gomock.Any(), "testuser", "testdb", "public", "users").
 			Return(&domain.TransactionState{
@@ -193,7 +199,7 @@ func TransactionHandlerRunner(t *testing.T, constructor TransactionHandlerConstr
 			Return(true, nil)
 
 		mockTxn.EXPECT().
-			CommitTransaction(gomock.Any(), "testuser").
+			CommitTransaction(gomock.Any(), "testuser", "").
 			Return(nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/transaction/commit", nil)
@@ -555,4 +561,982 @@ func TransactionHandlerRunner(t *testing.T, constructor TransactionHandlerConstr
 		require.Contains(t, body, "active")
 		require.Contains(t, body, "txn_123")
 	})
+
+	t.Run("Commit Requires Change Reason", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			IsTransactionExpired(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			CommitTransaction(gomock.Any(), "testuser", "typo fix").
+			Return(domain.ErrChangeReasonRequired)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/commit", strings.NewReader("reason=typo+fix"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleCommitTransaction(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "change reason")
+	})
+
+	t.Run("Commit Held For Approval", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			IsTransactionExpired(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			CommitTransaction(gomock.Any(), "testuser", "").
+			Return(domain.ErrCommitPendingApproval)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/commit", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleCommitTransaction(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		require.Contains(t, rec.Body.String(), "pending approval")
+	})
+
+	t.Run("Approve Held Commit", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_456").
+			Return(&domain.Session{
+				ID:       "session_456",
+				Username: "lead",
+			}, nil)
+
+		mockTxn.EXPECT().
+			ApproveTransaction(gomock.Any(), "lead", "testuser").
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/approve", strings.NewReader("username=testuser"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_456",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleApproveTransaction(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "approved")
+	})
+
+	t.Run("Approve Own Commit Forbidden", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			ApproveTransaction(gomock.Any(), "testuser", "testuser").
+			Return(domain.ErrSelfApproval)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/approve", strings.NewReader("username=testuser"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleApproveTransaction(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Delete Rows Buffers Selected Keys", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			DeleteRows(gomock.Any(), "testuser", domain.BulkDeleteParams{
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "orders",
+				Keys:     []map[string]interface{}{{"id": json.Number("1")}, {"id": json.Number("2")}},
+			}).
+			Return(&domain.BulkDeleteResult{Buffered: 2, PendingDeletes: 2}, nil)
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("keys", `[{"id":1},{"id":2}]`)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/delete-rows", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"PendingDeletes":2`)
+	})
+
+	t.Run("Delete Rows Matching Filter Reports Limit", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			DeleteRows(gomock.Any(), "testuser", domain.BulkDeleteParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "orders",
+				MatchFilter: true,
+				WhereClause: "status = 'cancelled'",
+			}).
+			Return(nil, domain.ValidationError{Field: "whereClause", Message: "filter matches 5000 rows; bulk delete is limited to 1000 rows"})
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("all", "true")
+		form.Add("where", "status = 'cancelled'")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/delete-rows", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "limited to 1000 rows")
+	})
+
+	t.Run("Delete Rows Rejects Malformed Keys", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("keys", `[1,2]`)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/delete-rows", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Update Rows Previews Matching Count", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			PreviewBulkUpdate(gomock.Any(), "testuser", domain.BulkUpdate{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "orders",
+				Column:      "status",
+				Value:       "cancelled",
+				WhereClause: "status = 'pending'",
+			}).
+			Return(&domain.BulkUpdateResult{MatchingRows: 12}, nil)
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("column", "status")
+		form.Add("value", "cancelled")
+		form.Add("where", "status = 'pending'")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/update-rows", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"MatchingRows":12`)
+		require.Contains(t, rec.Body.String(), `"Buffered":false`)
+	})
+
+	t.Run("Update Rows Buffers Confirmed NULL Update", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			UpdateRowsByFilter(gomock.Any(), "testuser", domain.BulkUpdate{
+				Database:      "testdb",
+				Schema:        "public",
+				Table:         "orders",
+				Column:        "shipped_at",
+				WhereClause:   "status = 'pending'",
+				ExpectedCount: 12,
+			}).
+			Return(&domain.BulkUpdateResult{MatchingRows: 12, Buffered: true}, nil)
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("column", "shipped_at")
+		form.Add("null", "true")
+		form.Add("where", "status = 'pending'")
+		form.Add("confirm", "12")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/update-rows", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), `"Buffered":true`)
+	})
+
+	t.Run("Update Rows Reports Stale Confirmation", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			UpdateRowsByFilter(gomock.Any(), "testuser", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "confirm", Message: "filter now matches 13 rows instead of the confirmed 12; preview again"})
+
+		form := url.Values{}
+		form.Add("database", "testdb")
+		form.Add("schema", "public")
+		form.Add("table", "orders")
+		form.Add("column", "status")
+		form.Add("value", "cancelled")
+		form.Add("confirm", "12")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/update-rows", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusConflict, rec.Code)
+		require.Contains(t, rec.Body.String(), "preview again")
+	})
+
+	t.Run("Insert Row Passes Conflict Handling", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("sku", "A-1")
+		form.Add("stock", "5")
+		form.Add("on_conflict", "update")
+		form.Add("conflict_target", "sku")
+		form.Add("conflict_update", "stock, ")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			UpsertRow(gomock.Any(), "testuser", "testdb", "public", "products",
+				map[string]interface{}{"sku": "A-1", "stock": "5"},
+				domain.ConflictAction{Mode: "update", Target: []string{"sku"}, UpdateColumns: []string{"stock"}}).
+			Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/insert-row?database=testdb&schema=public&table=products", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleInsertRow(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "inserted")
+	})
+
+	t.Run("Insert Row Rejects Invalid Conflict Handling", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("sku", "A-1")
+		form.Add("on_conflict", "replace")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			UpsertRow(gomock.Any(), "testuser", "testdb", "public", "products", gomock.Any(), gomock.Any()).
+			Return(domain.ValidationError{Field: "on_conflict", Message: "unknown conflict mode: replace"})
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/insert-row?database=testdb&schema=public&table=products", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleInsertRow(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "unknown conflict mode")
+	})
+
+	t.Run("Insert Row Form Offers Generated Keys And Default Placeholders", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			NewRowDefaults(gomock.Any(), "testuser", "testdb", "public", "devices").
+			Return(&domain.NewRowDefaults{
+				Values: map[string]interface{}{"id": "6f1c2a4e-0a4b-4f7e-9a51-3c2d1e0f9b8a"},
+				Placeholders: map[string]domain.ColumnDefaultPreview{
+					"created_at": {Expression: "now()", Value: "2026-10-17 09:30:00+00", Approximate: true},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/transaction/insert-row?database=testdb&schema=public&table=devices", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		require.Contains(t, rec.Body.String(), `"id":"6f1c2a4e-0a4b-4f7e-9a51-3c2d1e0f9b8a"`)
+		require.Contains(t, rec.Body.String(), `"Expression":"now()"`)
+	})
+
+	t.Run("Cell Edit Violating A Check Constraint Is Rejected", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("row_index", "0")
+		form.Add("column", "quantity")
+		form.Add("value", "150")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			EditCell(gomock.Any(), "testuser", "testdb", "public", "orders", 0, "quantity", "150").
+			Return(domain.ValidationError{
+				Field:   "quantity",
+				Message: "value 150 violates check constraint orders_quantity_check: CHECK ((quantity <= 100))",
+			})
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/edit-cell?database=testdb&schema=public&table=orders", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.HandleEditCell(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "orders_quantity_check")
+		require.Contains(t, rec.Body.String(), "&lt;= 100")
+	})
+
+	t.Run("Edit Cell Offers The Allowed Values Of An Enum Column", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			CellValueOptions(gomock.Any(), "testuser", "testdb", "public", "orders", "status").
+			Return(&domain.CellValueOptions{
+				Column:        "status",
+				AllowedValues: []string{"draft", "paid", "shipped"},
+				Source:        domain.CellValueSourceEnum,
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/transaction/edit-cell?database=testdb&schema=public&table=orders&column=status", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"column":"status","allowed_values":["draft","paid","shipped"],"source":"enum"}`, rec.Body.String())
+	})
+
+	t.Run("Edit Cell Options Without A Transaction Conflict", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			CellValueOptions(gomock.Any(), "testuser", "testdb", "public", "orders", "note").
+			Return(nil, domain.ErrNoActiveTransaction)
+
+		req := httptest.NewRequest(http.MethodGet, "/transaction/edit-cell?database=testdb&schema=public&table=orders&column=note", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("Edit JSON Cell Buffers The Patched Document", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		ops := []domain.JSONPatchOp{
+			{Op: domain.JSONPatchOpSet, Path: []string{"theme"}, Value: `{"color":"dark"}`},
+			{Op: domain.JSONPatchOpRemove, Path: []string{"legacy"}},
+		}
+		mockTxn.EXPECT().
+			EditJSONCell(gomock.Any(), "testuser", "testdb", "public", "profiles", 2, "settings", `{"legacy":true}`, ops).
+			Return(&domain.RowEdit{RowIndex: 2, ColumnName: "settings", NewValue: `{"theme":{"color":"dark"}}`, JSONPatches: ops}, nil)
+
+		body := `{"database":"testdb","schema":"public","table":"profiles","row_index":2,"column":"settings",
+			"current":"{\"legacy\":true}",
+			"ops":[{"op":"set","path":["theme"],"value":{"color":"dark"}},{"op":"remove","path":["legacy"]}]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/edit-json", strings.NewReader(body))
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"row_index":2,"column":"settings","value":"{\"theme\":{\"color\":\"dark\"}}"}`, rec.Body.String())
+	})
+
+	t.Run("Edit JSON Cell Rejects An Invalid Operation", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{
+				ID:       "session_123",
+				Username: "testuser",
+			}, nil)
+
+		mockTxn.EXPECT().
+			EditJSONCell(gomock.Any(), "testuser", "testdb", "public", "profiles", 0, "settings", "", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "ops", Message: `path {"a"} does not exist`})
+
+		body := `{"database":"testdb","schema":"public","table":"profiles","column":"settings","ops":[{"op":"remove","path":["a","b"]}]}`
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/edit-json", strings.NewReader(body))
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: "session_123",
+		})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "does not exist")
+	})
+
+	t.Run("Restore Cell Returns The Previous Value", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("row_index", "3")
+		form.Add("column", "status")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			RestoreCellValue(gomock.Any(), "testuser", 3, "status").
+			Return(&domain.RowEdit{RowIndex: 3, ColumnName: "status", NewValue: "paid", History: []interface{}{"open"}}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/restore-cell", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, `"original":false`)
+		require.Contains(t, body, `"NewValue":"paid"`)
+		require.Contains(t, body, `"History":["open"]`)
+	})
+
+	t.Run("Restore Cell Without A Transaction Conflicts", func(t *testing.T) {
+		form := url.Values{}
+		form.Add("row_index", "3")
+		form.Add("column", "status")
+
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			RestoreCellValue(gomock.Any(), "testuser", 3, "status").
+			Return(nil, domain.ErrNoActiveTransaction)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/restore-cell", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("Export Transaction Downloads A SQL Script", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			ExportTransaction(gomock.Any(), "testuser", domain.TransactionExportSQL).
+			Return(&domain.TransactionExport{
+				Format:      domain.TransactionExportSQL,
+				ContentType: "application/sql",
+				Filename:    "orders_changes.sql",
+				Content:     []byte("BEGIN;\nCOMMIT;\n"),
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/transaction/export", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/sql", rec.Header().Get("Content-Type"))
+		require.Equal(t, `attachment; filename="orders_changes.sql"`, rec.Header().Get("Content-Disposition"))
+		require.Equal(t, "BEGIN;\nCOMMIT;\n", rec.Body.String())
+	})
+
+	t.Run("Export Transaction Passes The Requested Format", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			ExportTransaction(gomock.Any(), "testuser", domain.TransactionExportJSONPatch).
+			Return(&domain.TransactionExport{
+				Format:      domain.TransactionExportJSONPatch,
+				ContentType: "application/json",
+				Filename:    "orders_changes.json",
+				Content:     []byte(`{"operations":[]}`),
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/transaction/export?format=json-patch", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	})
+
+	t.Run("Export Transaction Without A Transaction Conflicts", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			ExportTransaction(gomock.Any(), "testuser", domain.TransactionExportSQL).
+			Return(nil, domain.ErrNoActiveTransaction)
+
+		req := httptest.NewRequest(http.MethodGet, "/transaction/export", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("Start Transaction Warns About Blocking Locks", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockRBAC.EXPECT().
+			CheckUpdatePermission(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		mockTxn.EXPECT().
+			CheckTableLocks(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return([]domain.TableLock{
+				{PID: 4242, Username: "migrator", Mode: "AccessExclusiveLock", Granted: true, HeldSince: time.Now().Add(-10 * time.Minute), Query: "ALTER TABLE orders ADD COLUMN <x> int"},
+				{PID: 4243, Username: "analyst", Mode: "RowExclusiveLock", Granted: false},
+			}, nil)
+
+		mockTxn.EXPECT().
+			StartTransaction(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(&domain.TransactionState{ID: "txn_locked", Username: "testuser"}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/start?database=testdb&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "Transaction Active")
+		require.Contains(t, body, "lock-warning")
+		require.Contains(t, body, "migrator holds AccessExclusiveLock for 10m")
+		require.Contains(t, body, "ADD COLUMN &lt;x&gt; int")
+		require.Contains(t, body, "analyst awaits RowExclusiveLock")
+	})
+
+	t.Run("Table Locks Reports Blocking Sessions", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			CheckTableLocks(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return([]domain.TableLock{
+				{PID: 4242, Username: "migrator", Mode: "AccessExclusiveLock", Granted: true},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/transaction/locks?database=testdb&schema=public&table=orders", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			MayBlock bool                     `json:"may_block"`
+			Locks    []map[string]interface{} `json:"locks"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.True(t, response.MayBlock)
+		require.Len(t, response.Locks, 1)
+		require.Equal(t, "migrator", response.Locks[0]["username"])
+		require.NotContains(t, response.Locks[0], "query")
+	})
+
+	t.Run("Start Transaction Shows The Maintenance Message", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockRBAC.EXPECT().
+			CheckUpdatePermission(gomock.Any(), "testuser", "testdb", "public", "invoices").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		mockTxn.EXPECT().
+			CheckTableLocks(gomock.Any(), "testuser", "testdb", "public", "invoices").
+			Return(nil, nil)
+
+		mockTxn.EXPECT().
+			StartTransaction(gomock.Any(), "testuser", "testdb", "public", "invoices").
+			Return(nil, domain.ValidationError{Field: "maintenance", Message: "Upgrading <db> until 10:00"})
+
+		req := httptest.NewRequest(http.MethodPost, "/transaction/start?database=testdb&schema=public&table=invoices", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		require.Contains(t, rec.Body.String(), "class='maintenance'")
+		require.Contains(t, rec.Body.String(), "Upgrading &lt;db&gt; until 10:00")
+	})
+
+	t.Run("Commit Transaction Refused After The Maintenance Grace Period", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			IsTransactionExpired(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		mockTxn.EXPECT().
+			CheckActiveTransaction(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		mockTxn.EXPECT().
+			CommitTransaction(gomock.Any(), "testuser", "late fix").
+			Return(domain.ValidationError{Field: "maintenance", Message: domain.DefaultMaintenanceMessage})
+
+		form := url.Values{"reason": {"late fix"}}
+		req := httptest.NewRequest(http.MethodPost, "/transaction/commit", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.HandleCommitTransaction(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		require.Contains(t, rec.Body.String(), "maintenance mode")
+	})
+
+	t.Run("Restore Deleted Rows Previews The Insert", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			RestoreDeletedRows(gomock.Any(), "testuser", "audit_1", "").
+			Return(&domain.RowRestore{
+				EntryID:   "audit_1",
+				Schema:    "public",
+				Table:     "users",
+				Statement: `INSERT INTO "public"."users" ("id") OVERRIDING SYSTEM VALUE VALUES (7)`,
+				Rows:      1,
+			}, nil)
+
+		form := url.Values{"entry_id": {"audit_1"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/restore-deleted", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Equal(t, `INSERT INTO "public"."users" ("id") OVERRIDING SYSTEM VALUE VALUES (7)`, body["statement"])
+		require.Equal(t, float64(1), body["rows"])
+		require.Equal(t, false, body["applied"])
+	})
+
+	t.Run("Restore Deleted Rows Without INSERT Privilege Is Forbidden", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			RestoreDeletedRows(gomock.Any(), "testuser", "audit_1", "INSERT ...").
+			Return(nil, domain.ValidationError{Field: "permission", Message: "INSERT privilege on public.users is required to restore its rows"})
+
+		form := url.Values{"entry_id": {"audit_1"}, "confirm": {"INSERT ..."}}
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/restore-deleted", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Restore Deleted Rows No Longer Kept Is Not Found", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+
+		mockTxn.EXPECT().
+			RestoreDeletedRows(gomock.Any(), "testuser", "audit_old", "").
+			Return(nil, domain.ErrDeletedRowsNotFound)
+
+		form := url.Values{"entry_id": {"audit_old"}}
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/restore-deleted", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Transaction Heartbeat Keeps The Transaction Open", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockTxn.EXPECT().Heartbeat(gomock.Any(), "testuser").Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/heartbeat", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Transaction Heartbeat Without A Transaction Is Not Found", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		mockTxn.EXPECT().Heartbeat(gomock.Any(), "testuser").Return(domain.ErrNoActiveTransaction)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/transaction/heartbeat", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Transaction Events Stream Idle Warnings And Timeouts", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(&domain.Session{ID: "session_123", Username: "testuser"}, nil)
+		at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockTxn.EXPECT().
+			WatchTransaction(gomock.Any(), "testuser", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, username string, onEvent func(*domain.TransactionEvent) error) error {
+				require.NoError(t, onEvent(&domain.TransactionEvent{Type: domain.TransactionEventIdleWarning, TransactionID: "txn_1", At: at, RemainingSeconds: 120}))
+				return onEvent(&domain.TransactionEvent{Type: domain.TransactionEventTimeout, TransactionID: "txn_1", At: at.Add(2 * time.Minute)})
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/transaction/events", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		body := rec.Body.String()
+		require.Contains(t, body, "id: 1\nevent: idle_warning\ndata: ")
+		require.Contains(t, body, `"remaining_seconds":120`)
+		require.Contains(t, body, "id: 2\nevent: transaction_timeout\ndata: ")
+	})
+
+	t.Run("Transaction Events Require A Session", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/transaction/events", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
 }
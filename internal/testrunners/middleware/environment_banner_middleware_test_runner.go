@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/middleware"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// EnvironmentBannerMiddlewareConstructor is a function type that creates an EnvironmentBannerMiddleware
+type EnvironmentBannerMiddlewareConstructor func(
+	sessionAdminUC usecase.SessionAdminUseCase,
+) middleware.EnvironmentBannerMiddleware
+
+// EnvironmentBannerMiddlewareRunner runs all environment banner middleware tests
+func EnvironmentBannerMiddlewareRunner(t *testing.T, constructor EnvironmentBannerMiddlewareConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAdmin := mockUsecase.NewMockSessionAdminUseCase(ctrl)
+
+	mw := constructor(mockAdmin)
+
+	page := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<!DOCTYPE html>\n<html>\n<head>\n\t<title>Query Editor</title>\n</head>\n<body class=\"editor\">\n\t<h1>Query Editor</h1>\n</body>\n</html>"))
+	})
+
+	t.Run("RenderBanner adds the banner and tags the title", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			GetEnvironmentBanner(gomock.Any()).
+			Return(&domain.EnvironmentBanner{Name: "PRODUCTION", Color: "#c62828"}, nil)
+
+		rec := httptest.NewRecorder()
+		mw.RenderBanner(page).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query-editor", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		require.Contains(t, body, "<title>[PRODUCTION] Query Editor</title>")
+		require.Contains(t, body, "<body class=\"editor\">\n\t<div id=\"environment-banner\"")
+		require.Contains(t, body, "background: #c62828;")
+		require.Contains(t, body, ">PRODUCTION</div>")
+	})
+
+	t.Run("RenderBanner leaves pages alone without an environment", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			GetEnvironmentBanner(gomock.Any()).
+			Return(&domain.EnvironmentBanner{}, nil)
+
+		rec := httptest.NewRecorder()
+		mw.RenderBanner(page).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query-editor", nil))
+
+		require.NotContains(t, rec.Body.String(), "environment-banner")
+		require.Contains(t, rec.Body.String(), "<title>Query Editor</title>")
+	})
+
+	t.Run("RenderBanner passes JSON and fragments through", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			GetEnvironmentBanner(gomock.Any()).
+			Return(&domain.EnvironmentBanner{Name: "STAGING", Color: "#ef6c00"}, nil).
+			Times(2)
+
+		api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"ok":true}`))
+		})
+		rec := httptest.NewRecorder()
+		mw.RenderBanner(api).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/tables", nil))
+		require.Equal(t, http.StatusCreated, rec.Code)
+		require.Equal(t, `{"ok":true}`, rec.Body.String())
+
+		fragment := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte("<tr><td>1</td></tr>"))
+		})
+		rec = httptest.NewRecorder()
+		mw.RenderBanner(fragment).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/main/table-data", nil))
+		require.Equal(t, "<tr><td>1</td></tr>", rec.Body.String())
+	})
+
+	t.Run("RenderBanner escapes the environment name", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			GetEnvironmentBanner(gomock.Any()).
+			Return(&domain.EnvironmentBanner{Name: "<SCRIPT>", Color: "#546e7a"}, nil)
+
+		rec := httptest.NewRecorder()
+		mw.RenderBanner(page).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query-editor", nil))
+
+		require.NotContains(t, rec.Body.String(), "<SCRIPT>")
+		require.Contains(t, rec.Body.String(), "[&lt;SCRIPT&gt;] Query Editor")
+	})
+
+	t.Run("RenderBanner still serves the page when the banner cannot be read", func(t *testing.T) {
+		mockAdmin.EXPECT().
+			GetEnvironmentBanner(gomock.Any()).
+			Return(nil, errors.New("config unavailable"))
+
+		rec := httptest.NewRecorder()
+		mw.RenderBanner(page).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query-editor", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "<title>Query Editor</title>")
+	})
+
+	t.Run("RenderBanner does not touch form posts", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		mw.RenderBanner(page).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/query-editor", nil))
+
+		require.Contains(t, rec.Body.String(), "<title>Query Editor</title>")
+	})
+}
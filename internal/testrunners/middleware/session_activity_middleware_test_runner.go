@@ -0,0 +1,200 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/middleware"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockUsecase "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/usecase"
+)
+
+// SessionActivityMiddlewareConstructor is a function type that creates a SessionActivityMiddleware
+type SessionActivityMiddlewareConstructor func(
+	authUC usecase.AuthenticationUseCase,
+	timeoutConfig domain.SessionTimeoutConfig,
+) middleware.SessionActivityMiddleware
+
+// SessionActivityMiddlewareRunner runs all session activity middleware tests
+func SessionActivityMiddlewareRunner(t *testing.T, constructor SessionActivityMiddlewareConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mockUsecase.NewMockAuthenticationUseCase(ctrl)
+
+	mw := constructor(mockAuth, domain.SessionTimeoutConfig{IdleTimeout: 15 * time.Minute})
+
+	t.Run("TrackActivity reissues the cookie with a fresh idle lifetime", func(t *testing.T) {
+		session := &domain.Session{ID: "session_123", Username: "testuser"}
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(session, nil)
+		mockAuth.EXPECT().
+			BindSessionConnection(gomock.Any(), session).
+			DoAndReturn(func(ctx context.Context, _ *domain.Session) (context.Context, error) { return ctx, nil })
+
+		called := false
+		wrapped := mw.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.True(t, called)
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		require.Equal(t, "session_id", cookies[0].Name)
+		require.Equal(t, "session_123", cookies[0].Value)
+		require.Equal(t, 900, cookies[0].MaxAge)
+		require.True(t, cookies[0].HttpOnly)
+	})
+
+	t.Run("TrackActivity runs the handler on the session's connection pool", func(t *testing.T) {
+		session := &domain.Session{ID: "session_123", Username: "testuser"}
+		pool := &sql.DB{}
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(session, nil)
+		mockAuth.EXPECT().
+			BindSessionConnection(gomock.Any(), session).
+			DoAndReturn(func(ctx context.Context, _ *domain.Session) (context.Context, error) {
+				return domain.WithSessionConnection(ctx, pool), nil
+			})
+
+		var got *sql.DB
+		wrapped := mw.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = domain.SessionConnection(r.Context(), nil)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.Same(t, pool, got)
+	})
+
+	t.Run("TrackActivity answers 503 when the session's pool cannot be opened", func(t *testing.T) {
+		session := &domain.Session{ID: "session_123", Username: "testuser"}
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(session, nil)
+		mockAuth.EXPECT().
+			BindSessionConnection(gomock.Any(), session).
+			Return(nil, domain.ErrServerBusy)
+
+		called := false
+		wrapped := mw.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.False(t, called)
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+
+	t.Run("TrackActivity clears the cookie of a session destroyed for idling", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_idle").
+			Return(nil, fmt.Errorf("failed to validate session: %w", domain.ErrSessionExpired))
+
+		called := false
+		wrapped := mw.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_idle"})
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.True(t, called)
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		require.True(t, cookies[0].MaxAge < 0)
+	})
+
+	t.Run("TrackActivity leaves the cookie alone on other validation errors", func(t *testing.T) {
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_unknown").
+			Return(nil, fmt.Errorf("session not found"))
+
+		wrapped := mw.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_unknown"})
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		require.Empty(t, rec.Result().Cookies())
+	})
+
+	t.Run("TrackActivity passes requests without a session through", func(t *testing.T) {
+		called := false
+		wrapped := mw.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/login", nil)
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.True(t, called)
+		require.Empty(t, rec.Result().Cookies())
+	})
+
+	t.Run("TrackActivity keeps the login cookie lifetime without an idle timeout", func(t *testing.T) {
+		unlimited := constructor(mockAuth, domain.SessionTimeoutConfig{})
+
+		session := &domain.Session{ID: "session_123", Username: "testuser"}
+		mockAuth.EXPECT().
+			ValidateSession(gomock.Any(), "session_123").
+			Return(session, nil)
+		mockAuth.EXPECT().
+			BindSessionConnection(gomock.Any(), session).
+			DoAndReturn(func(ctx context.Context, _ *domain.Session) (context.Context, error) { return ctx, nil })
+
+		wrapped := unlimited.TrackActivity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/main", nil)
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: "session_123"})
+		rec := httptest.NewRecorder()
+
+		wrapped.ServeHTTP(rec, req)
+
+		require.Empty(t, rec.Result().Cookies())
+	})
+}
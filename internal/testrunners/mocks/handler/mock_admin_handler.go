@@ -0,0 +1,251 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/admin_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAdminHandler is a mock of AdminHandler interface.
+type MockAdminHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminHandlerMockRecorder
+}
+
+// MockAdminHandlerMockRecorder is the mock recorder for MockAdminHandler.
+type MockAdminHandlerMockRecorder struct {
+	mock *MockAdminHandler
+}
+
+// NewMockAdminHandler creates a new mock instance.
+func NewMockAdminHandler(ctrl *gomock.Controller) *MockAdminHandler {
+	mock := &MockAdminHandler{ctrl: ctrl}
+	mock.recorder = &MockAdminHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminHandler) EXPECT() *MockAdminHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleAuditLog mocks base method.
+func (m *MockAdminHandler) HandleAuditLog(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleAuditLog", w, r)
+}
+
+// HandleAuditLog indicates an expected call of HandleAuditLog.
+func (mr *MockAdminHandlerMockRecorder) HandleAuditLog(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAuditLog", reflect.TypeOf((*MockAdminHandler)(nil).HandleAuditLog), w, r)
+}
+
+// HandleAuditPage mocks base method.
+func (m *MockAdminHandler) HandleAuditPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleAuditPage", w, r)
+}
+
+// HandleAuditPage indicates an expected call of HandleAuditPage.
+func (mr *MockAdminHandlerMockRecorder) HandleAuditPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAuditPage", reflect.TypeOf((*MockAdminHandler)(nil).HandleAuditPage), w, r)
+}
+
+// HandleCheckRolePassword mocks base method.
+func (m *MockAdminHandler) HandleCheckRolePassword(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCheckRolePassword", w, r)
+}
+
+// HandleCheckRolePassword indicates an expected call of HandleCheckRolePassword.
+func (mr *MockAdminHandlerMockRecorder) HandleCheckRolePassword(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCheckRolePassword", reflect.TypeOf((*MockAdminHandler)(nil).HandleCheckRolePassword), w, r)
+}
+
+// HandleConnectionProfiles mocks base method.
+func (m *MockAdminHandler) HandleConnectionProfiles(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleConnectionProfiles", w, r)
+}
+
+// HandleConnectionProfiles indicates an expected call of HandleConnectionProfiles.
+func (mr *MockAdminHandlerMockRecorder) HandleConnectionProfiles(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleConnectionProfiles", reflect.TypeOf((*MockAdminHandler)(nil).HandleConnectionProfiles), w, r)
+}
+
+// HandleCreateRole mocks base method.
+func (m *MockAdminHandler) HandleCreateRole(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateRole", w, r)
+}
+
+// HandleCreateRole indicates an expected call of HandleCreateRole.
+func (mr *MockAdminHandlerMockRecorder) HandleCreateRole(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateRole", reflect.TypeOf((*MockAdminHandler)(nil).HandleCreateRole), w, r)
+}
+
+// HandleDeleteConnectionProfile mocks base method.
+func (m *MockAdminHandler) HandleDeleteConnectionProfile(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDeleteConnectionProfile", w, r)
+}
+
+// HandleDeleteConnectionProfile indicates an expected call of HandleDeleteConnectionProfile.
+func (mr *MockAdminHandlerMockRecorder) HandleDeleteConnectionProfile(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteConnectionProfile", reflect.TypeOf((*MockAdminHandler)(nil).HandleDeleteConnectionProfile), w, r)
+}
+
+// HandleDisabledShortcuts mocks base method.
+func (m *MockAdminHandler) HandleDisabledShortcuts(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDisabledShortcuts", w, r)
+}
+
+// HandleDisabledShortcuts indicates an expected call of HandleDisabledShortcuts.
+func (mr *MockAdminHandlerMockRecorder) HandleDisabledShortcuts(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDisabledShortcuts", reflect.TypeOf((*MockAdminHandler)(nil).HandleDisabledShortcuts), w, r)
+}
+
+// HandleEnvironment mocks base method.
+func (m *MockAdminHandler) HandleEnvironment(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleEnvironment", w, r)
+}
+
+// HandleEnvironment indicates an expected call of HandleEnvironment.
+func (mr *MockAdminHandlerMockRecorder) HandleEnvironment(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEnvironment", reflect.TypeOf((*MockAdminHandler)(nil).HandleEnvironment), w, r)
+}
+
+// HandleExportAuditLog mocks base method.
+func (m *MockAdminHandler) HandleExportAuditLog(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportAuditLog", w, r)
+}
+
+// HandleExportAuditLog indicates an expected call of HandleExportAuditLog.
+func (mr *MockAdminHandlerMockRecorder) HandleExportAuditLog(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportAuditLog", reflect.TypeOf((*MockAdminHandler)(nil).HandleExportAuditLog), w, r)
+}
+
+// HandleExportConnectionProfiles mocks base method.
+func (m *MockAdminHandler) HandleExportConnectionProfiles(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportConnectionProfiles", w, r)
+}
+
+// HandleExportConnectionProfiles indicates an expected call of HandleExportConnectionProfiles.
+func (mr *MockAdminHandlerMockRecorder) HandleExportConnectionProfiles(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportConnectionProfiles", reflect.TypeOf((*MockAdminHandler)(nil).HandleExportConnectionProfiles), w, r)
+}
+
+// HandleImportConnectionProfiles mocks base method.
+func (m *MockAdminHandler) HandleImportConnectionProfiles(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleImportConnectionProfiles", w, r)
+}
+
+// HandleImportConnectionProfiles indicates an expected call of HandleImportConnectionProfiles.
+func (mr *MockAdminHandlerMockRecorder) HandleImportConnectionProfiles(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleImportConnectionProfiles", reflect.TypeOf((*MockAdminHandler)(nil).HandleImportConnectionProfiles), w, r)
+}
+
+// HandleListSessions mocks base method.
+func (m *MockAdminHandler) HandleListSessions(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListSessions", w, r)
+}
+
+// HandleListSessions indicates an expected call of HandleListSessions.
+func (mr *MockAdminHandlerMockRecorder) HandleListSessions(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListSessions", reflect.TypeOf((*MockAdminHandler)(nil).HandleListSessions), w, r)
+}
+
+// HandleMaintenance mocks base method.
+func (m *MockAdminHandler) HandleMaintenance(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleMaintenance", w, r)
+}
+
+// HandleMaintenance indicates an expected call of HandleMaintenance.
+func (mr *MockAdminHandlerMockRecorder) HandleMaintenance(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleMaintenance", reflect.TypeOf((*MockAdminHandler)(nil).HandleMaintenance), w, r)
+}
+
+// HandleRejectionStats mocks base method.
+func (m *MockAdminHandler) HandleRejectionStats(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRejectionStats", w, r)
+}
+
+// HandleRejectionStats indicates an expected call of HandleRejectionStats.
+func (mr *MockAdminHandlerMockRecorder) HandleRejectionStats(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRejectionStats", reflect.TypeOf((*MockAdminHandler)(nil).HandleRejectionStats), w, r)
+}
+
+// HandleRevokeSession mocks base method.
+func (m *MockAdminHandler) HandleRevokeSession(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRevokeSession", w, r)
+}
+
+// HandleRevokeSession indicates an expected call of HandleRevokeSession.
+func (mr *MockAdminHandlerMockRecorder) HandleRevokeSession(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRevokeSession", reflect.TypeOf((*MockAdminHandler)(nil).HandleRevokeSession), w, r)
+}
+
+// HandleSessionSettings mocks base method.
+func (m *MockAdminHandler) HandleSessionSettings(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSessionSettings", w, r)
+}
+
+// HandleSessionSettings indicates an expected call of HandleSessionSettings.
+func (mr *MockAdminHandlerMockRecorder) HandleSessionSettings(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSessionSettings", reflect.TypeOf((*MockAdminHandler)(nil).HandleSessionSettings), w, r)
+}
+
+// HandleSessionsPage mocks base method.
+func (m *MockAdminHandler) HandleSessionsPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSessionsPage", w, r)
+}
+
+// HandleSessionsPage indicates an expected call of HandleSessionsPage.
+func (mr *MockAdminHandlerMockRecorder) HandleSessionsPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSessionsPage", reflect.TypeOf((*MockAdminHandler)(nil).HandleSessionsPage), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockAdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockAdminHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockAdminHandler)(nil).ServeHTTP), w, r)
+}
@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/dashboard_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDashboardHandler is a mock of DashboardHandler interface.
+type MockDashboardHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockDashboardHandlerMockRecorder
+}
+
+// MockDashboardHandlerMockRecorder is the mock recorder for MockDashboardHandler.
+type MockDashboardHandlerMockRecorder struct {
+	mock *MockDashboardHandler
+}
+
+// NewMockDashboardHandler creates a new mock instance.
+func NewMockDashboardHandler(ctrl *gomock.Controller) *MockDashboardHandler {
+	mock := &MockDashboardHandler{ctrl: ctrl}
+	mock.recorder = &MockDashboardHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDashboardHandler) EXPECT() *MockDashboardHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleDashboardPage mocks base method.
+func (m *MockDashboardHandler) HandleDashboardPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDashboardPage", w, r)
+}
+
+// HandleDashboardPage indicates an expected call of HandleDashboardPage.
+func (mr *MockDashboardHandlerMockRecorder) HandleDashboardPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDashboardPage", reflect.TypeOf((*MockDashboardHandler)(nil).HandleDashboardPage), w, r)
+}
+
+// HandleListDashboardTiles mocks base method.
+func (m *MockDashboardHandler) HandleListDashboardTiles(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListDashboardTiles", w, r)
+}
+
+// HandleListDashboardTiles indicates an expected call of HandleListDashboardTiles.
+func (mr *MockDashboardHandlerMockRecorder) HandleListDashboardTiles(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListDashboardTiles", reflect.TypeOf((*MockDashboardHandler)(nil).HandleListDashboardTiles), w, r)
+}
+
+// HandlePinQueryResult mocks base method.
+func (m *MockDashboardHandler) HandlePinQueryResult(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandlePinQueryResult", w, r)
+}
+
+// HandlePinQueryResult indicates an expected call of HandlePinQueryResult.
+func (mr *MockDashboardHandlerMockRecorder) HandlePinQueryResult(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePinQueryResult", reflect.TypeOf((*MockDashboardHandler)(nil).HandlePinQueryResult), w, r)
+}
+
+// HandleRefreshDashboardTile mocks base method.
+func (m *MockDashboardHandler) HandleRefreshDashboardTile(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRefreshDashboardTile", w, r)
+}
+
+// HandleRefreshDashboardTile indicates an expected call of HandleRefreshDashboardTile.
+func (mr *MockDashboardHandlerMockRecorder) HandleRefreshDashboardTile(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRefreshDashboardTile", reflect.TypeOf((*MockDashboardHandler)(nil).HandleRefreshDashboardTile), w, r)
+}
+
+// HandleUnpinDashboardTile mocks base method.
+func (m *MockDashboardHandler) HandleUnpinDashboardTile(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleUnpinDashboardTile", w, r)
+}
+
+// HandleUnpinDashboardTile indicates an expected call of HandleUnpinDashboardTile.
+func (mr *MockDashboardHandlerMockRecorder) HandleUnpinDashboardTile(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUnpinDashboardTile", reflect.TypeOf((*MockDashboardHandler)(nil).HandleUnpinDashboardTile), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockDashboardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockDashboardHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockDashboardHandler)(nil).ServeHTTP), w, r)
+}
@@ -82,6 +82,18 @@ func (mr *MockERDViewerHandlerMockRecorder) HandleGenerateERD(w, r interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleGenerateERD", reflect.TypeOf((*MockERDViewerHandler)(nil).HandleGenerateERD), w, r)
 }
 
+// HandleSchemaGraph mocks base method.
+func (m *MockERDViewerHandler) HandleSchemaGraph(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSchemaGraph", w, r)
+}
+
+// HandleSchemaGraph indicates an expected call of HandleSchemaGraph.
+func (mr *MockERDViewerHandlerMockRecorder) HandleSchemaGraph(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSchemaGraph", reflect.TypeOf((*MockERDViewerHandler)(nil).HandleSchemaGraph), w, r)
+}
+
 // HandleTableClickInERD mocks base method.
 func (m *MockERDViewerHandler) HandleTableClickInERD(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
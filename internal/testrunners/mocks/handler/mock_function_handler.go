@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/function_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFunctionHandler is a mock of FunctionHandler interface.
+type MockFunctionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockFunctionHandlerMockRecorder
+}
+
+// MockFunctionHandlerMockRecorder is the mock recorder for MockFunctionHandler.
+type MockFunctionHandlerMockRecorder struct {
+	mock *MockFunctionHandler
+}
+
+// NewMockFunctionHandler creates a new mock instance.
+func NewMockFunctionHandler(ctrl *gomock.Controller) *MockFunctionHandler {
+	mock := &MockFunctionHandler{ctrl: ctrl}
+	mock.recorder = &MockFunctionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFunctionHandler) EXPECT() *MockFunctionHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleExecuteFunction mocks base method.
+func (m *MockFunctionHandler) HandleExecuteFunction(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExecuteFunction", w, r)
+}
+
+// HandleExecuteFunction indicates an expected call of HandleExecuteFunction.
+func (mr *MockFunctionHandlerMockRecorder) HandleExecuteFunction(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExecuteFunction", reflect.TypeOf((*MockFunctionHandler)(nil).HandleExecuteFunction), w, r)
+}
+
+// HandleFunctionSource mocks base method.
+func (m *MockFunctionHandler) HandleFunctionSource(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleFunctionSource", w, r)
+}
+
+// HandleFunctionSource indicates an expected call of HandleFunctionSource.
+func (mr *MockFunctionHandlerMockRecorder) HandleFunctionSource(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleFunctionSource", reflect.TypeOf((*MockFunctionHandler)(nil).HandleFunctionSource), w, r)
+}
+
+// HandleListFunctions mocks base method.
+func (m *MockFunctionHandler) HandleListFunctions(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListFunctions", w, r)
+}
+
+// HandleListFunctions indicates an expected call of HandleListFunctions.
+func (mr *MockFunctionHandlerMockRecorder) HandleListFunctions(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListFunctions", reflect.TypeOf((*MockFunctionHandler)(nil).HandleListFunctions), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockFunctionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockFunctionHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockFunctionHandler)(nil).ServeHTTP), w, r)
+}
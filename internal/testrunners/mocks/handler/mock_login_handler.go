@@ -34,6 +34,18 @@ func (m *MockLoginHandler) EXPECT() *MockLoginHandlerMockRecorder {
 	return m.recorder
 }
 
+// HandleAccountActivity mocks base method.
+func (m *MockLoginHandler) HandleAccountActivity(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleAccountActivity", w, r)
+}
+
+// HandleAccountActivity indicates an expected call of HandleAccountActivity.
+func (mr *MockLoginHandlerMockRecorder) HandleAccountActivity(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAccountActivity", reflect.TypeOf((*MockLoginHandler)(nil).HandleAccountActivity), w, r)
+}
+
 // HandleLogin mocks base method.
 func (m *MockLoginHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -70,6 +82,30 @@ func (mr *MockLoginHandlerMockRecorder) HandleLogout(w, r interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleLogout", reflect.TypeOf((*MockLoginHandler)(nil).HandleLogout), w, r)
 }
 
+// HandleSSOCallback mocks base method.
+func (m *MockLoginHandler) HandleSSOCallback(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSSOCallback", w, r)
+}
+
+// HandleSSOCallback indicates an expected call of HandleSSOCallback.
+func (mr *MockLoginHandlerMockRecorder) HandleSSOCallback(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSSOCallback", reflect.TypeOf((*MockLoginHandler)(nil).HandleSSOCallback), w, r)
+}
+
+// HandleSSOLogin mocks base method.
+func (m *MockLoginHandler) HandleSSOLogin(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSSOLogin", w, r)
+}
+
+// HandleSSOLogin indicates an expected call of HandleSSOLogin.
+func (mr *MockLoginHandlerMockRecorder) HandleSSOLogin(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSSOLogin", reflect.TypeOf((*MockLoginHandler)(nil).HandleSSOLogin), w, r)
+}
+
 // ServeHTTP mocks base method.
 func (m *MockLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -34,6 +34,90 @@ func (m *MockMainViewHandler) EXPECT() *MockMainViewHandlerMockRecorder {
 	return m.recorder
 }
 
+// HandleCellContent mocks base method.
+func (m *MockMainViewHandler) HandleCellContent(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCellContent", w, r)
+}
+
+// HandleCellContent indicates an expected call of HandleCellContent.
+func (mr *MockMainViewHandlerMockRecorder) HandleCellContent(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCellContent", reflect.TypeOf((*MockMainViewHandler)(nil).HandleCellContent), w, r)
+}
+
+// HandleColumnAggregate mocks base method.
+func (m *MockMainViewHandler) HandleColumnAggregate(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleColumnAggregate", w, r)
+}
+
+// HandleColumnAggregate indicates an expected call of HandleColumnAggregate.
+func (mr *MockMainViewHandlerMockRecorder) HandleColumnAggregate(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleColumnAggregate", reflect.TypeOf((*MockMainViewHandler)(nil).HandleColumnAggregate), w, r)
+}
+
+// HandleCompareTableSnapshot mocks base method.
+func (m *MockMainViewHandler) HandleCompareTableSnapshot(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCompareTableSnapshot", w, r)
+}
+
+// HandleCompareTableSnapshot indicates an expected call of HandleCompareTableSnapshot.
+func (mr *MockMainViewHandlerMockRecorder) HandleCompareTableSnapshot(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCompareTableSnapshot", reflect.TypeOf((*MockMainViewHandler)(nil).HandleCompareTableSnapshot), w, r)
+}
+
+// HandleDataQuality mocks base method.
+func (m *MockMainViewHandler) HandleDataQuality(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDataQuality", w, r)
+}
+
+// HandleDataQuality indicates an expected call of HandleDataQuality.
+func (mr *MockMainViewHandlerMockRecorder) HandleDataQuality(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDataQuality", reflect.TypeOf((*MockMainViewHandler)(nil).HandleDataQuality), w, r)
+}
+
+// HandleDeleteTableSnapshot mocks base method.
+func (m *MockMainViewHandler) HandleDeleteTableSnapshot(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDeleteTableSnapshot", w, r)
+}
+
+// HandleDeleteTableSnapshot indicates an expected call of HandleDeleteTableSnapshot.
+func (mr *MockMainViewHandlerMockRecorder) HandleDeleteTableSnapshot(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteTableSnapshot", reflect.TypeOf((*MockMainViewHandler)(nil).HandleDeleteTableSnapshot), w, r)
+}
+
+// HandleDuplicateRows mocks base method.
+func (m *MockMainViewHandler) HandleDuplicateRows(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDuplicateRows", w, r)
+}
+
+// HandleDuplicateRows indicates an expected call of HandleDuplicateRows.
+func (mr *MockMainViewHandlerMockRecorder) HandleDuplicateRows(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDuplicateRows", reflect.TypeOf((*MockMainViewHandler)(nil).HandleDuplicateRows), w, r)
+}
+
+// HandleExportTable mocks base method.
+func (m *MockMainViewHandler) HandleExportTable(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportTable", w, r)
+}
+
+// HandleExportTable indicates an expected call of HandleExportTable.
+func (mr *MockMainViewHandlerMockRecorder) HandleExportTable(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportTable", reflect.TypeOf((*MockMainViewHandler)(nil).HandleExportTable), w, r)
+}
+
 // HandleFilterTable mocks base method.
 func (m *MockMainViewHandler) HandleFilterTable(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -46,6 +130,102 @@ func (mr *MockMainViewHandlerMockRecorder) HandleFilterTable(w, r interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleFilterTable", reflect.TypeOf((*MockMainViewHandler)(nil).HandleFilterTable), w, r)
 }
 
+// HandleForeignKeyLookup mocks base method.
+func (m *MockMainViewHandler) HandleForeignKeyLookup(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleForeignKeyLookup", w, r)
+}
+
+// HandleForeignKeyLookup indicates an expected call of HandleForeignKeyLookup.
+func (mr *MockMainViewHandlerMockRecorder) HandleForeignKeyLookup(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleForeignKeyLookup", reflect.TypeOf((*MockMainViewHandler)(nil).HandleForeignKeyLookup), w, r)
+}
+
+// HandleFreezeView mocks base method.
+func (m *MockMainViewHandler) HandleFreezeView(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleFreezeView", w, r)
+}
+
+// HandleFreezeView indicates an expected call of HandleFreezeView.
+func (mr *MockMainViewHandlerMockRecorder) HandleFreezeView(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleFreezeView", reflect.TypeOf((*MockMainViewHandler)(nil).HandleFreezeView), w, r)
+}
+
+// HandleGroupBy mocks base method.
+func (m *MockMainViewHandler) HandleGroupBy(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleGroupBy", w, r)
+}
+
+// HandleGroupBy indicates an expected call of HandleGroupBy.
+func (mr *MockMainViewHandlerMockRecorder) HandleGroupBy(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleGroupBy", reflect.TypeOf((*MockMainViewHandler)(nil).HandleGroupBy), w, r)
+}
+
+// HandleHistogram mocks base method.
+func (m *MockMainViewHandler) HandleHistogram(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleHistogram", w, r)
+}
+
+// HandleHistogram indicates an expected call of HandleHistogram.
+func (mr *MockMainViewHandlerMockRecorder) HandleHistogram(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleHistogram", reflect.TypeOf((*MockMainViewHandler)(nil).HandleHistogram), w, r)
+}
+
+// HandleImportTable mocks base method.
+func (m *MockMainViewHandler) HandleImportTable(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleImportTable", w, r)
+}
+
+// HandleImportTable indicates an expected call of HandleImportTable.
+func (mr *MockMainViewHandlerMockRecorder) HandleImportTable(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleImportTable", reflect.TypeOf((*MockMainViewHandler)(nil).HandleImportTable), w, r)
+}
+
+// HandleJSONCell mocks base method.
+func (m *MockMainViewHandler) HandleJSONCell(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleJSONCell", w, r)
+}
+
+// HandleJSONCell indicates an expected call of HandleJSONCell.
+func (mr *MockMainViewHandlerMockRecorder) HandleJSONCell(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleJSONCell", reflect.TypeOf((*MockMainViewHandler)(nil).HandleJSONCell), w, r)
+}
+
+// HandleJoinView mocks base method.
+func (m *MockMainViewHandler) HandleJoinView(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleJoinView", w, r)
+}
+
+// HandleJoinView indicates an expected call of HandleJoinView.
+func (mr *MockMainViewHandlerMockRecorder) HandleJoinView(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleJoinView", reflect.TypeOf((*MockMainViewHandler)(nil).HandleJoinView), w, r)
+}
+
+// HandleLiveTableData mocks base method.
+func (m *MockMainViewHandler) HandleLiveTableData(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleLiveTableData", w, r)
+}
+
+// HandleLiveTableData indicates an expected call of HandleLiveTableData.
+func (mr *MockMainViewHandlerMockRecorder) HandleLiveTableData(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleLiveTableData", reflect.TypeOf((*MockMainViewHandler)(nil).HandleLiveTableData), w, r)
+}
+
 // HandleLoadTableData mocks base method.
 func (m *MockMainViewHandler) HandleLoadTableData(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -70,6 +250,18 @@ func (mr *MockMainViewHandlerMockRecorder) HandleMainViewPage(w, r interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleMainViewPage", reflect.TypeOf((*MockMainViewHandler)(nil).HandleMainViewPage), w, r)
 }
 
+// HandleObjectSearch mocks base method.
+func (m *MockMainViewHandler) HandleObjectSearch(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleObjectSearch", w, r)
+}
+
+// HandleObjectSearch indicates an expected call of HandleObjectSearch.
+func (mr *MockMainViewHandlerMockRecorder) HandleObjectSearch(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleObjectSearch", reflect.TypeOf((*MockMainViewHandler)(nil).HandleObjectSearch), w, r)
+}
+
 // HandlePaginationNext mocks base method.
 func (m *MockMainViewHandler) HandlePaginationNext(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -94,6 +286,66 @@ func (mr *MockMainViewHandlerMockRecorder) HandlePaginationPrevious(w, r interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePaginationPrevious", reflect.TypeOf((*MockMainViewHandler)(nil).HandlePaginationPrevious), w, r)
 }
 
+// HandleReferentialIntegrity mocks base method.
+func (m *MockMainViewHandler) HandleReferentialIntegrity(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleReferentialIntegrity", w, r)
+}
+
+// HandleReferentialIntegrity indicates an expected call of HandleReferentialIntegrity.
+func (mr *MockMainViewHandlerMockRecorder) HandleReferentialIntegrity(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleReferentialIntegrity", reflect.TypeOf((*MockMainViewHandler)(nil).HandleReferentialIntegrity), w, r)
+}
+
+// HandleReleaseSnapshot mocks base method.
+func (m *MockMainViewHandler) HandleReleaseSnapshot(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleReleaseSnapshot", w, r)
+}
+
+// HandleReleaseSnapshot indicates an expected call of HandleReleaseSnapshot.
+func (mr *MockMainViewHandlerMockRecorder) HandleReleaseSnapshot(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleReleaseSnapshot", reflect.TypeOf((*MockMainViewHandler)(nil).HandleReleaseSnapshot), w, r)
+}
+
+// HandleRowDetail mocks base method.
+func (m *MockMainViewHandler) HandleRowDetail(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRowDetail", w, r)
+}
+
+// HandleRowDetail indicates an expected call of HandleRowDetail.
+func (mr *MockMainViewHandlerMockRecorder) HandleRowDetail(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRowDetail", reflect.TypeOf((*MockMainViewHandler)(nil).HandleRowDetail), w, r)
+}
+
+// HandleRowTimeline mocks base method.
+func (m *MockMainViewHandler) HandleRowTimeline(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRowTimeline", w, r)
+}
+
+// HandleRowTimeline indicates an expected call of HandleRowTimeline.
+func (mr *MockMainViewHandlerMockRecorder) HandleRowTimeline(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRowTimeline", reflect.TypeOf((*MockMainViewHandler)(nil).HandleRowTimeline), w, r)
+}
+
+// HandleSnapshotStatus mocks base method.
+func (m *MockMainViewHandler) HandleSnapshotStatus(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSnapshotStatus", w, r)
+}
+
+// HandleSnapshotStatus indicates an expected call of HandleSnapshotStatus.
+func (mr *MockMainViewHandlerMockRecorder) HandleSnapshotStatus(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSnapshotStatus", reflect.TypeOf((*MockMainViewHandler)(nil).HandleSnapshotStatus), w, r)
+}
+
 // HandleSortTable mocks base method.
 func (m *MockMainViewHandler) HandleSortTable(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -106,6 +358,66 @@ func (mr *MockMainViewHandlerMockRecorder) HandleSortTable(w, r interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSortTable", reflect.TypeOf((*MockMainViewHandler)(nil).HandleSortTable), w, r)
 }
 
+// HandleSpatialCell mocks base method.
+func (m *MockMainViewHandler) HandleSpatialCell(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSpatialCell", w, r)
+}
+
+// HandleSpatialCell indicates an expected call of HandleSpatialCell.
+func (mr *MockMainViewHandlerMockRecorder) HandleSpatialCell(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSpatialCell", reflect.TypeOf((*MockMainViewHandler)(nil).HandleSpatialCell), w, r)
+}
+
+// HandleStructuredFilter mocks base method.
+func (m *MockMainViewHandler) HandleStructuredFilter(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleStructuredFilter", w, r)
+}
+
+// HandleStructuredFilter indicates an expected call of HandleStructuredFilter.
+func (mr *MockMainViewHandlerMockRecorder) HandleStructuredFilter(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStructuredFilter", reflect.TypeOf((*MockMainViewHandler)(nil).HandleStructuredFilter), w, r)
+}
+
+// HandleSuggestJoin mocks base method.
+func (m *MockMainViewHandler) HandleSuggestJoin(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSuggestJoin", w, r)
+}
+
+// HandleSuggestJoin indicates an expected call of HandleSuggestJoin.
+func (mr *MockMainViewHandlerMockRecorder) HandleSuggestJoin(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSuggestJoin", reflect.TypeOf((*MockMainViewHandler)(nil).HandleSuggestJoin), w, r)
+}
+
+// HandleTableAsOf mocks base method.
+func (m *MockMainViewHandler) HandleTableAsOf(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableAsOf", w, r)
+}
+
+// HandleTableAsOf indicates an expected call of HandleTableAsOf.
+func (mr *MockMainViewHandlerMockRecorder) HandleTableAsOf(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableAsOf", reflect.TypeOf((*MockMainViewHandler)(nil).HandleTableAsOf), w, r)
+}
+
+// HandleTableSearch mocks base method.
+func (m *MockMainViewHandler) HandleTableSearch(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableSearch", w, r)
+}
+
+// HandleTableSearch indicates an expected call of HandleTableSearch.
+func (mr *MockMainViewHandlerMockRecorder) HandleTableSearch(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableSearch", reflect.TypeOf((*MockMainViewHandler)(nil).HandleTableSearch), w, r)
+}
+
 // HandleTableSelect mocks base method.
 func (m *MockMainViewHandler) HandleTableSelect(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -118,6 +430,54 @@ func (mr *MockMainViewHandlerMockRecorder) HandleTableSelect(w, r interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableSelect", reflect.TypeOf((*MockMainViewHandler)(nil).HandleTableSelect), w, r)
 }
 
+// HandleTableSizes mocks base method.
+func (m *MockMainViewHandler) HandleTableSizes(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableSizes", w, r)
+}
+
+// HandleTableSizes indicates an expected call of HandleTableSizes.
+func (mr *MockMainViewHandlerMockRecorder) HandleTableSizes(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableSizes", reflect.TypeOf((*MockMainViewHandler)(nil).HandleTableSizes), w, r)
+}
+
+// HandleTableSnapshots mocks base method.
+func (m *MockMainViewHandler) HandleTableSnapshots(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableSnapshots", w, r)
+}
+
+// HandleTableSnapshots indicates an expected call of HandleTableSnapshots.
+func (mr *MockMainViewHandlerMockRecorder) HandleTableSnapshots(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableSnapshots", reflect.TypeOf((*MockMainViewHandler)(nil).HandleTableSnapshots), w, r)
+}
+
+// HandleTableStats mocks base method.
+func (m *MockMainViewHandler) HandleTableStats(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableStats", w, r)
+}
+
+// HandleTableStats indicates an expected call of HandleTableStats.
+func (mr *MockMainViewHandlerMockRecorder) HandleTableStats(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableStats", reflect.TypeOf((*MockMainViewHandler)(nil).HandleTableStats), w, r)
+}
+
+// HandleWatchRows mocks base method.
+func (m *MockMainViewHandler) HandleWatchRows(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleWatchRows", w, r)
+}
+
+// HandleWatchRows indicates an expected call of HandleWatchRows.
+func (mr *MockMainViewHandlerMockRecorder) HandleWatchRows(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleWatchRows", reflect.TypeOf((*MockMainViewHandler)(nil).HandleWatchRows), w, r)
+}
+
 // ServeHTTP mocks base method.
 func (m *MockMainViewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
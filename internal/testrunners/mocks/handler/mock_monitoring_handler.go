@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/monitoring_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMonitoringHandler is a mock of MonitoringHandler interface.
+type MockMonitoringHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonitoringHandlerMockRecorder
+}
+
+// MockMonitoringHandlerMockRecorder is the mock recorder for MockMonitoringHandler.
+type MockMonitoringHandlerMockRecorder struct {
+	mock *MockMonitoringHandler
+}
+
+// NewMockMonitoringHandler creates a new mock instance.
+func NewMockMonitoringHandler(ctrl *gomock.Controller) *MockMonitoringHandler {
+	mock := &MockMonitoringHandler{ctrl: ctrl}
+	mock.recorder = &MockMonitoringHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonitoringHandler) EXPECT() *MockMonitoringHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleActivityPage mocks base method.
+func (m *MockMonitoringHandler) HandleActivityPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleActivityPage", w, r)
+}
+
+// HandleActivityPage indicates an expected call of HandleActivityPage.
+func (mr *MockMonitoringHandlerMockRecorder) HandleActivityPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleActivityPage", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleActivityPage), w, r)
+}
+
+// HandleListActivity mocks base method.
+func (m *MockMonitoringHandler) HandleListActivity(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListActivity", w, r)
+}
+
+// HandleListActivity indicates an expected call of HandleListActivity.
+func (mr *MockMonitoringHandlerMockRecorder) HandleListActivity(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListActivity", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleListActivity), w, r)
+}
+
+// HandleListLocks mocks base method.
+func (m *MockMonitoringHandler) HandleListLocks(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListLocks", w, r)
+}
+
+// HandleListLocks indicates an expected call of HandleListLocks.
+func (mr *MockMonitoringHandlerMockRecorder) HandleListLocks(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListLocks", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleListLocks), w, r)
+}
+
+// HandleListSlowOperations mocks base method.
+func (m *MockMonitoringHandler) HandleListSlowOperations(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListSlowOperations", w, r)
+}
+
+// HandleListSlowOperations indicates an expected call of HandleListSlowOperations.
+func (mr *MockMonitoringHandlerMockRecorder) HandleListSlowOperations(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListSlowOperations", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleListSlowOperations), w, r)
+}
+
+// HandleListSlowQueries mocks base method.
+func (m *MockMonitoringHandler) HandleListSlowQueries(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListSlowQueries", w, r)
+}
+
+// HandleListSlowQueries indicates an expected call of HandleListSlowQueries.
+func (mr *MockMonitoringHandlerMockRecorder) HandleListSlowQueries(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListSlowQueries", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleListSlowQueries), w, r)
+}
+
+// HandleLocksPage mocks base method.
+func (m *MockMonitoringHandler) HandleLocksPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleLocksPage", w, r)
+}
+
+// HandleLocksPage indicates an expected call of HandleLocksPage.
+func (mr *MockMonitoringHandlerMockRecorder) HandleLocksPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleLocksPage", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleLocksPage), w, r)
+}
+
+// HandleServerStats mocks base method.
+func (m *MockMonitoringHandler) HandleServerStats(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleServerStats", w, r)
+}
+
+// HandleServerStats indicates an expected call of HandleServerStats.
+func (mr *MockMonitoringHandlerMockRecorder) HandleServerStats(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleServerStats", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleServerStats), w, r)
+}
+
+// HandleSlowOperationsPage mocks base method.
+func (m *MockMonitoringHandler) HandleSlowOperationsPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSlowOperationsPage", w, r)
+}
+
+// HandleSlowOperationsPage indicates an expected call of HandleSlowOperationsPage.
+func (mr *MockMonitoringHandlerMockRecorder) HandleSlowOperationsPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSlowOperationsPage", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleSlowOperationsPage), w, r)
+}
+
+// HandleSlowQueriesPage mocks base method.
+func (m *MockMonitoringHandler) HandleSlowQueriesPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSlowQueriesPage", w, r)
+}
+
+// HandleSlowQueriesPage indicates an expected call of HandleSlowQueriesPage.
+func (mr *MockMonitoringHandlerMockRecorder) HandleSlowQueriesPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSlowQueriesPage", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleSlowQueriesPage), w, r)
+}
+
+// HandleStatsPage mocks base method.
+func (m *MockMonitoringHandler) HandleStatsPage(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleStatsPage", w, r)
+}
+
+// HandleStatsPage indicates an expected call of HandleStatsPage.
+func (mr *MockMonitoringHandlerMockRecorder) HandleStatsPage(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStatsPage", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleStatsPage), w, r)
+}
+
+// HandleTerminateBackend mocks base method.
+func (m *MockMonitoringHandler) HandleTerminateBackend(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTerminateBackend", w, r)
+}
+
+// HandleTerminateBackend indicates an expected call of HandleTerminateBackend.
+func (mr *MockMonitoringHandlerMockRecorder) HandleTerminateBackend(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTerminateBackend", reflect.TypeOf((*MockMonitoringHandler)(nil).HandleTerminateBackend), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockMonitoringHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockMonitoringHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockMonitoringHandler)(nil).ServeHTTP), w, r)
+}
@@ -34,6 +34,66 @@ func (m *MockQueryEditorHandler) EXPECT() *MockQueryEditorHandlerMockRecorder {
 	return m.recorder
 }
 
+// HandleCancelQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleCancelQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCancelQuery", w, r)
+}
+
+// HandleCancelQuery indicates an expected call of HandleCancelQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleCancelQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCancelQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleCancelQuery), w, r)
+}
+
+// HandleCreateNotebook mocks base method.
+func (m *MockQueryEditorHandler) HandleCreateNotebook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateNotebook", w, r)
+}
+
+// HandleCreateNotebook indicates an expected call of HandleCreateNotebook.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleCreateNotebook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateNotebook", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleCreateNotebook), w, r)
+}
+
+// HandleCreateSavedQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleCreateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateSavedQuery", w, r)
+}
+
+// HandleCreateSavedQuery indicates an expected call of HandleCreateSavedQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleCreateSavedQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateSavedQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleCreateSavedQuery), w, r)
+}
+
+// HandleDeleteNotebook mocks base method.
+func (m *MockQueryEditorHandler) HandleDeleteNotebook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDeleteNotebook", w, r)
+}
+
+// HandleDeleteNotebook indicates an expected call of HandleDeleteNotebook.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleDeleteNotebook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteNotebook", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleDeleteNotebook), w, r)
+}
+
+// HandleDeleteSavedQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleDeleteSavedQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDeleteSavedQuery", w, r)
+}
+
+// HandleDeleteSavedQuery indicates an expected call of HandleDeleteSavedQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleDeleteSavedQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteSavedQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleDeleteSavedQuery), w, r)
+}
+
 // HandleExecuteMultipleQueries mocks base method.
 func (m *MockQueryEditorHandler) HandleExecuteMultipleQueries(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -58,6 +118,126 @@ func (mr *MockQueryEditorHandlerMockRecorder) HandleExecuteQuery(w, r interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExecuteQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleExecuteQuery), w, r)
 }
 
+// HandleExplainQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleExplainQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExplainQuery", w, r)
+}
+
+// HandleExplainQuery indicates an expected call of HandleExplainQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleExplainQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExplainQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleExplainQuery), w, r)
+}
+
+// HandleExportNotebook mocks base method.
+func (m *MockQueryEditorHandler) HandleExportNotebook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportNotebook", w, r)
+}
+
+// HandleExportNotebook indicates an expected call of HandleExportNotebook.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleExportNotebook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportNotebook", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleExportNotebook), w, r)
+}
+
+// HandleExportQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleExportQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportQuery", w, r)
+}
+
+// HandleExportQuery indicates an expected call of HandleExportQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleExportQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleExportQuery), w, r)
+}
+
+// HandleExportWorkspace mocks base method.
+func (m *MockQueryEditorHandler) HandleExportWorkspace(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportWorkspace", w, r)
+}
+
+// HandleExportWorkspace indicates an expected call of HandleExportWorkspace.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleExportWorkspace(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportWorkspace", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleExportWorkspace), w, r)
+}
+
+// HandleGetNotebook mocks base method.
+func (m *MockQueryEditorHandler) HandleGetNotebook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleGetNotebook", w, r)
+}
+
+// HandleGetNotebook indicates an expected call of HandleGetNotebook.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleGetNotebook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleGetNotebook", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleGetNotebook), w, r)
+}
+
+// HandleImportWorkspace mocks base method.
+func (m *MockQueryEditorHandler) HandleImportWorkspace(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleImportWorkspace", w, r)
+}
+
+// HandleImportWorkspace indicates an expected call of HandleImportWorkspace.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleImportWorkspace(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleImportWorkspace", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleImportWorkspace), w, r)
+}
+
+// HandleListNotebooks mocks base method.
+func (m *MockQueryEditorHandler) HandleListNotebooks(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListNotebooks", w, r)
+}
+
+// HandleListNotebooks indicates an expected call of HandleListNotebooks.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleListNotebooks(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListNotebooks", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleListNotebooks), w, r)
+}
+
+// HandleListSavedQueries mocks base method.
+func (m *MockQueryEditorHandler) HandleListSavedQueries(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListSavedQueries", w, r)
+}
+
+// HandleListSavedQueries indicates an expected call of HandleListSavedQueries.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleListSavedQueries(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListSavedQueries", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleListSavedQueries), w, r)
+}
+
+// HandleLiveQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleLiveQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleLiveQuery", w, r)
+}
+
+// HandleLiveQuery indicates an expected call of HandleLiveQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleLiveQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleLiveQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleLiveQuery), w, r)
+}
+
+// HandleQueryChart mocks base method.
+func (m *MockQueryEditorHandler) HandleQueryChart(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleQueryChart", w, r)
+}
+
+// HandleQueryChart indicates an expected call of HandleQueryChart.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleQueryChart(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleQueryChart", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleQueryChart), w, r)
+}
+
 // HandleQueryEditorPage mocks base method.
 func (m *MockQueryEditorHandler) HandleQueryEditorPage(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -70,6 +250,114 @@ func (mr *MockQueryEditorHandlerMockRecorder) HandleQueryEditorPage(w, r interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleQueryEditorPage", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleQueryEditorPage), w, r)
 }
 
+// HandleRunNotebook mocks base method.
+func (m *MockQueryEditorHandler) HandleRunNotebook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRunNotebook", w, r)
+}
+
+// HandleRunNotebook indicates an expected call of HandleRunNotebook.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleRunNotebook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRunNotebook", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleRunNotebook), w, r)
+}
+
+// HandleRunSavedQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleRunSavedQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRunSavedQuery", w, r)
+}
+
+// HandleRunSavedQuery indicates an expected call of HandleRunSavedQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleRunSavedQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRunSavedQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleRunSavedQuery), w, r)
+}
+
+// HandleSearchPath mocks base method.
+func (m *MockQueryEditorHandler) HandleSearchPath(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSearchPath", w, r)
+}
+
+// HandleSearchPath indicates an expected call of HandleSearchPath.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleSearchPath(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSearchPath", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleSearchPath), w, r)
+}
+
+// HandleSessionSettings mocks base method.
+func (m *MockQueryEditorHandler) HandleSessionSettings(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSessionSettings", w, r)
+}
+
+// HandleSessionSettings indicates an expected call of HandleSessionSettings.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleSessionSettings(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSessionSettings", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleSessionSettings), w, r)
+}
+
+// HandleShareEditor mocks base method.
+func (m *MockQueryEditorHandler) HandleShareEditor(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleShareEditor", w, r)
+}
+
+// HandleShareEditor indicates an expected call of HandleShareEditor.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleShareEditor(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleShareEditor", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleShareEditor), w, r)
+}
+
+// HandleSharedEditor mocks base method.
+func (m *MockQueryEditorHandler) HandleSharedEditor(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSharedEditor", w, r)
+}
+
+// HandleSharedEditor indicates an expected call of HandleSharedEditor.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleSharedEditor(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSharedEditor", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleSharedEditor), w, r)
+}
+
+// HandleUpdateNotebook mocks base method.
+func (m *MockQueryEditorHandler) HandleUpdateNotebook(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleUpdateNotebook", w, r)
+}
+
+// HandleUpdateNotebook indicates an expected call of HandleUpdateNotebook.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleUpdateNotebook(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUpdateNotebook", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleUpdateNotebook), w, r)
+}
+
+// HandleUpdateSavedQuery mocks base method.
+func (m *MockQueryEditorHandler) HandleUpdateSavedQuery(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleUpdateSavedQuery", w, r)
+}
+
+// HandleUpdateSavedQuery indicates an expected call of HandleUpdateSavedQuery.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleUpdateSavedQuery(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUpdateSavedQuery", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleUpdateSavedQuery), w, r)
+}
+
+// HandleViewResult mocks base method.
+func (m *MockQueryEditorHandler) HandleViewResult(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleViewResult", w, r)
+}
+
+// HandleViewResult indicates an expected call of HandleViewResult.
+func (mr *MockQueryEditorHandlerMockRecorder) HandleViewResult(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleViewResult", reflect.TypeOf((*MockQueryEditorHandler)(nil).HandleViewResult), w, r)
+}
+
 // ServeHTTP mocks base method.
 func (m *MockQueryEditorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/report_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportHandler is a mock of ReportHandler interface.
+type MockReportHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportHandlerMockRecorder
+}
+
+// MockReportHandlerMockRecorder is the mock recorder for MockReportHandler.
+type MockReportHandlerMockRecorder struct {
+	mock *MockReportHandler
+}
+
+// NewMockReportHandler creates a new mock instance.
+func NewMockReportHandler(ctrl *gomock.Controller) *MockReportHandler {
+	mock := &MockReportHandler{ctrl: ctrl}
+	mock.recorder = &MockReportHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportHandler) EXPECT() *MockReportHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleQueryReport mocks base method.
+func (m *MockReportHandler) HandleQueryReport(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleQueryReport", w, r)
+}
+
+// HandleQueryReport indicates an expected call of HandleQueryReport.
+func (mr *MockReportHandlerMockRecorder) HandleQueryReport(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleQueryReport", reflect.TypeOf((*MockReportHandler)(nil).HandleQueryReport), w, r)
+}
+
+// HandleTableReport mocks base method.
+func (m *MockReportHandler) HandleTableReport(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableReport", w, r)
+}
+
+// HandleTableReport indicates an expected call of HandleTableReport.
+func (mr *MockReportHandlerMockRecorder) HandleTableReport(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableReport", reflect.TypeOf((*MockReportHandler)(nil).HandleTableReport), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockReportHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockReportHandler)(nil).ServeHTTP), w, r)
+}
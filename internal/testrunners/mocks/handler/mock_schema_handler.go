@@ -0,0 +1,239 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/schema_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSchemaHandler is a mock of SchemaHandler interface.
+type MockSchemaHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaHandlerMockRecorder
+}
+
+// MockSchemaHandlerMockRecorder is the mock recorder for MockSchemaHandler.
+type MockSchemaHandlerMockRecorder struct {
+	mock *MockSchemaHandler
+}
+
+// NewMockSchemaHandler creates a new mock instance.
+func NewMockSchemaHandler(ctrl *gomock.Controller) *MockSchemaHandler {
+	mock := &MockSchemaHandler{ctrl: ctrl}
+	mock.recorder = &MockSchemaHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaHandler) EXPECT() *MockSchemaHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleAddConstraint mocks base method.
+func (m *MockSchemaHandler) HandleAddConstraint(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleAddConstraint", w, r)
+}
+
+// HandleAddConstraint indicates an expected call of HandleAddConstraint.
+func (mr *MockSchemaHandlerMockRecorder) HandleAddConstraint(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAddConstraint", reflect.TypeOf((*MockSchemaHandler)(nil).HandleAddConstraint), w, r)
+}
+
+// HandleAlterTable mocks base method.
+func (m *MockSchemaHandler) HandleAlterTable(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleAlterTable", w, r)
+}
+
+// HandleAlterTable indicates an expected call of HandleAlterTable.
+func (mr *MockSchemaHandlerMockRecorder) HandleAlterTable(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAlterTable", reflect.TypeOf((*MockSchemaHandler)(nil).HandleAlterTable), w, r)
+}
+
+// HandleCreateDatabase mocks base method.
+func (m *MockSchemaHandler) HandleCreateDatabase(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateDatabase", w, r)
+}
+
+// HandleCreateDatabase indicates an expected call of HandleCreateDatabase.
+func (mr *MockSchemaHandlerMockRecorder) HandleCreateDatabase(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateDatabase", reflect.TypeOf((*MockSchemaHandler)(nil).HandleCreateDatabase), w, r)
+}
+
+// HandleCreateIndex mocks base method.
+func (m *MockSchemaHandler) HandleCreateIndex(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateIndex", w, r)
+}
+
+// HandleCreateIndex indicates an expected call of HandleCreateIndex.
+func (mr *MockSchemaHandlerMockRecorder) HandleCreateIndex(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateIndex", reflect.TypeOf((*MockSchemaHandler)(nil).HandleCreateIndex), w, r)
+}
+
+// HandleCreateSchema mocks base method.
+func (m *MockSchemaHandler) HandleCreateSchema(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateSchema", w, r)
+}
+
+// HandleCreateSchema indicates an expected call of HandleCreateSchema.
+func (mr *MockSchemaHandlerMockRecorder) HandleCreateSchema(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateSchema", reflect.TypeOf((*MockSchemaHandler)(nil).HandleCreateSchema), w, r)
+}
+
+// HandleCreateTable mocks base method.
+func (m *MockSchemaHandler) HandleCreateTable(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCreateTable", w, r)
+}
+
+// HandleCreateTable indicates an expected call of HandleCreateTable.
+func (mr *MockSchemaHandlerMockRecorder) HandleCreateTable(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCreateTable", reflect.TypeOf((*MockSchemaHandler)(nil).HandleCreateTable), w, r)
+}
+
+// HandleDisableTrigger mocks base method.
+func (m *MockSchemaHandler) HandleDisableTrigger(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDisableTrigger", w, r)
+}
+
+// HandleDisableTrigger indicates an expected call of HandleDisableTrigger.
+func (mr *MockSchemaHandlerMockRecorder) HandleDisableTrigger(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDisableTrigger", reflect.TypeOf((*MockSchemaHandler)(nil).HandleDisableTrigger), w, r)
+}
+
+// HandleDropConstraint mocks base method.
+func (m *MockSchemaHandler) HandleDropConstraint(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDropConstraint", w, r)
+}
+
+// HandleDropConstraint indicates an expected call of HandleDropConstraint.
+func (mr *MockSchemaHandlerMockRecorder) HandleDropConstraint(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDropConstraint", reflect.TypeOf((*MockSchemaHandler)(nil).HandleDropConstraint), w, r)
+}
+
+// HandleDropIndex mocks base method.
+func (m *MockSchemaHandler) HandleDropIndex(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDropIndex", w, r)
+}
+
+// HandleDropIndex indicates an expected call of HandleDropIndex.
+func (mr *MockSchemaHandlerMockRecorder) HandleDropIndex(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDropIndex", reflect.TypeOf((*MockSchemaHandler)(nil).HandleDropIndex), w, r)
+}
+
+// HandleEnableTrigger mocks base method.
+func (m *MockSchemaHandler) HandleEnableTrigger(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleEnableTrigger", w, r)
+}
+
+// HandleEnableTrigger indicates an expected call of HandleEnableTrigger.
+func (mr *MockSchemaHandlerMockRecorder) HandleEnableTrigger(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEnableTrigger", reflect.TypeOf((*MockSchemaHandler)(nil).HandleEnableTrigger), w, r)
+}
+
+// HandleListComments mocks base method.
+func (m *MockSchemaHandler) HandleListComments(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListComments", w, r)
+}
+
+// HandleListComments indicates an expected call of HandleListComments.
+func (mr *MockSchemaHandlerMockRecorder) HandleListComments(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListComments", reflect.TypeOf((*MockSchemaHandler)(nil).HandleListComments), w, r)
+}
+
+// HandleListConstraints mocks base method.
+func (m *MockSchemaHandler) HandleListConstraints(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListConstraints", w, r)
+}
+
+// HandleListConstraints indicates an expected call of HandleListConstraints.
+func (mr *MockSchemaHandlerMockRecorder) HandleListConstraints(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListConstraints", reflect.TypeOf((*MockSchemaHandler)(nil).HandleListConstraints), w, r)
+}
+
+// HandleListIndexes mocks base method.
+func (m *MockSchemaHandler) HandleListIndexes(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListIndexes", w, r)
+}
+
+// HandleListIndexes indicates an expected call of HandleListIndexes.
+func (mr *MockSchemaHandlerMockRecorder) HandleListIndexes(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListIndexes", reflect.TypeOf((*MockSchemaHandler)(nil).HandleListIndexes), w, r)
+}
+
+// HandleListTriggers mocks base method.
+func (m *MockSchemaHandler) HandleListTriggers(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListTriggers", w, r)
+}
+
+// HandleListTriggers indicates an expected call of HandleListTriggers.
+func (mr *MockSchemaHandlerMockRecorder) HandleListTriggers(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListTriggers", reflect.TypeOf((*MockSchemaHandler)(nil).HandleListTriggers), w, r)
+}
+
+// HandleSetComment mocks base method.
+func (m *MockSchemaHandler) HandleSetComment(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSetComment", w, r)
+}
+
+// HandleSetComment indicates an expected call of HandleSetComment.
+func (mr *MockSchemaHandlerMockRecorder) HandleSetComment(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSetComment", reflect.TypeOf((*MockSchemaHandler)(nil).HandleSetComment), w, r)
+}
+
+// HandleTableDDL mocks base method.
+func (m *MockSchemaHandler) HandleTableDDL(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableDDL", w, r)
+}
+
+// HandleTableDDL indicates an expected call of HandleTableDDL.
+func (mr *MockSchemaHandlerMockRecorder) HandleTableDDL(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableDDL", reflect.TypeOf((*MockSchemaHandler)(nil).HandleTableDDL), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockSchemaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockSchemaHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockSchemaHandler)(nil).ServeHTTP), w, r)
+}
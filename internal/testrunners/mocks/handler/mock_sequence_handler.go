@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/sequence_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSequenceHandler is a mock of SequenceHandler interface.
+type MockSequenceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSequenceHandlerMockRecorder
+}
+
+// MockSequenceHandlerMockRecorder is the mock recorder for MockSequenceHandler.
+type MockSequenceHandlerMockRecorder struct {
+	mock *MockSequenceHandler
+}
+
+// NewMockSequenceHandler creates a new mock instance.
+func NewMockSequenceHandler(ctrl *gomock.Controller) *MockSequenceHandler {
+	mock := &MockSequenceHandler{ctrl: ctrl}
+	mock.recorder = &MockSequenceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSequenceHandler) EXPECT() *MockSequenceHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleListSequences mocks base method.
+func (m *MockSequenceHandler) HandleListSequences(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListSequences", w, r)
+}
+
+// HandleListSequences indicates an expected call of HandleListSequences.
+func (mr *MockSequenceHandlerMockRecorder) HandleListSequences(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListSequences", reflect.TypeOf((*MockSequenceHandler)(nil).HandleListSequences), w, r)
+}
+
+// HandleRestartSequence mocks base method.
+func (m *MockSequenceHandler) HandleRestartSequence(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRestartSequence", w, r)
+}
+
+// HandleRestartSequence indicates an expected call of HandleRestartSequence.
+func (mr *MockSequenceHandlerMockRecorder) HandleRestartSequence(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRestartSequence", reflect.TypeOf((*MockSequenceHandler)(nil).HandleRestartSequence), w, r)
+}
+
+// HandleSetSequenceValue mocks base method.
+func (m *MockSequenceHandler) HandleSetSequenceValue(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSetSequenceValue", w, r)
+}
+
+// HandleSetSequenceValue indicates an expected call of HandleSetSequenceValue.
+func (mr *MockSequenceHandlerMockRecorder) HandleSetSequenceValue(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSetSequenceValue", reflect.TypeOf((*MockSequenceHandler)(nil).HandleSetSequenceValue), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockSequenceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockSequenceHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockSequenceHandler)(nil).ServeHTTP), w, r)
+}
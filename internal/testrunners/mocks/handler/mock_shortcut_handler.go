@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/shortcut_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShortcutHandler is a mock of ShortcutHandler interface.
+type MockShortcutHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockShortcutHandlerMockRecorder
+}
+
+// MockShortcutHandlerMockRecorder is the mock recorder for MockShortcutHandler.
+type MockShortcutHandlerMockRecorder struct {
+	mock *MockShortcutHandler
+}
+
+// NewMockShortcutHandler creates a new mock instance.
+func NewMockShortcutHandler(ctrl *gomock.Controller) *MockShortcutHandler {
+	mock := &MockShortcutHandler{ctrl: ctrl}
+	mock.recorder = &MockShortcutHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShortcutHandler) EXPECT() *MockShortcutHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleListShortcuts mocks base method.
+func (m *MockShortcutHandler) HandleListShortcuts(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListShortcuts", w, r)
+}
+
+// HandleListShortcuts indicates an expected call of HandleListShortcuts.
+func (mr *MockShortcutHandlerMockRecorder) HandleListShortcuts(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListShortcuts", reflect.TypeOf((*MockShortcutHandler)(nil).HandleListShortcuts), w, r)
+}
+
+// HandleRemapShortcut mocks base method.
+func (m *MockShortcutHandler) HandleRemapShortcut(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRemapShortcut", w, r)
+}
+
+// HandleRemapShortcut indicates an expected call of HandleRemapShortcut.
+func (mr *MockShortcutHandlerMockRecorder) HandleRemapShortcut(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRemapShortcut", reflect.TypeOf((*MockShortcutHandler)(nil).HandleRemapShortcut), w, r)
+}
+
+// HandleResetShortcut mocks base method.
+func (m *MockShortcutHandler) HandleResetShortcut(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleResetShortcut", w, r)
+}
+
+// HandleResetShortcut indicates an expected call of HandleResetShortcut.
+func (mr *MockShortcutHandlerMockRecorder) HandleResetShortcut(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleResetShortcut", reflect.TypeOf((*MockShortcutHandler)(nil).HandleResetShortcut), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockShortcutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockShortcutHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockShortcutHandler)(nil).ServeHTTP), w, r)
+}
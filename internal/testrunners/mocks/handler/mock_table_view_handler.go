@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/handler/table_view_handler.go
+
+// Package mockhandler is a generated GoMock package.
+package mockhandler
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTableViewHandler is a mock of TableViewHandler interface.
+type MockTableViewHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockTableViewHandlerMockRecorder
+}
+
+// MockTableViewHandlerMockRecorder is the mock recorder for MockTableViewHandler.
+type MockTableViewHandlerMockRecorder struct {
+	mock *MockTableViewHandler
+}
+
+// NewMockTableViewHandler creates a new mock instance.
+func NewMockTableViewHandler(ctrl *gomock.Controller) *MockTableViewHandler {
+	mock := &MockTableViewHandler{ctrl: ctrl}
+	mock.recorder = &MockTableViewHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTableViewHandler) EXPECT() *MockTableViewHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleDeleteTableView mocks base method.
+func (m *MockTableViewHandler) HandleDeleteTableView(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDeleteTableView", w, r)
+}
+
+// HandleDeleteTableView indicates an expected call of HandleDeleteTableView.
+func (mr *MockTableViewHandlerMockRecorder) HandleDeleteTableView(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteTableView", reflect.TypeOf((*MockTableViewHandler)(nil).HandleDeleteTableView), w, r)
+}
+
+// HandleListTableViews mocks base method.
+func (m *MockTableViewHandler) HandleListTableViews(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleListTableViews", w, r)
+}
+
+// HandleListTableViews indicates an expected call of HandleListTableViews.
+func (mr *MockTableViewHandlerMockRecorder) HandleListTableViews(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleListTableViews", reflect.TypeOf((*MockTableViewHandler)(nil).HandleListTableViews), w, r)
+}
+
+// HandleSaveTableView mocks base method.
+func (m *MockTableViewHandler) HandleSaveTableView(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSaveTableView", w, r)
+}
+
+// HandleSaveTableView indicates an expected call of HandleSaveTableView.
+func (mr *MockTableViewHandlerMockRecorder) HandleSaveTableView(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSaveTableView", reflect.TypeOf((*MockTableViewHandler)(nil).HandleSaveTableView), w, r)
+}
+
+// HandleSetDefaultTableView mocks base method.
+func (m *MockTableViewHandler) HandleSetDefaultTableView(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleSetDefaultTableView", w, r)
+}
+
+// HandleSetDefaultTableView indicates an expected call of HandleSetDefaultTableView.
+func (mr *MockTableViewHandlerMockRecorder) HandleSetDefaultTableView(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSetDefaultTableView", reflect.TypeOf((*MockTableViewHandler)(nil).HandleSetDefaultTableView), w, r)
+}
+
+// ServeHTTP mocks base method.
+func (m *MockTableViewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ServeHTTP", w, r)
+}
+
+// ServeHTTP indicates an expected call of ServeHTTP.
+func (mr *MockTableViewHandlerMockRecorder) ServeHTTP(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeHTTP", reflect.TypeOf((*MockTableViewHandler)(nil).ServeHTTP), w, r)
+}
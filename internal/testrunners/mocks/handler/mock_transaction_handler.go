@@ -34,6 +34,30 @@ func (m *MockTransactionHandler) EXPECT() *MockTransactionHandlerMockRecorder {
 	return m.recorder
 }
 
+// HandleApproveTransaction mocks base method.
+func (m *MockTransactionHandler) HandleApproveTransaction(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleApproveTransaction", w, r)
+}
+
+// HandleApproveTransaction indicates an expected call of HandleApproveTransaction.
+func (mr *MockTransactionHandlerMockRecorder) HandleApproveTransaction(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleApproveTransaction", reflect.TypeOf((*MockTransactionHandler)(nil).HandleApproveTransaction), w, r)
+}
+
+// HandleCellValueOptions mocks base method.
+func (m *MockTransactionHandler) HandleCellValueOptions(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleCellValueOptions", w, r)
+}
+
+// HandleCellValueOptions indicates an expected call of HandleCellValueOptions.
+func (mr *MockTransactionHandlerMockRecorder) HandleCellValueOptions(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleCellValueOptions", reflect.TypeOf((*MockTransactionHandler)(nil).HandleCellValueOptions), w, r)
+}
+
 // HandleCommitTransaction mocks base method.
 func (m *MockTransactionHandler) HandleCommitTransaction(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -58,6 +82,18 @@ func (mr *MockTransactionHandlerMockRecorder) HandleDeleteRow(w, r interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteRow", reflect.TypeOf((*MockTransactionHandler)(nil).HandleDeleteRow), w, r)
 }
 
+// HandleDeleteRows mocks base method.
+func (m *MockTransactionHandler) HandleDeleteRows(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleDeleteRows", w, r)
+}
+
+// HandleDeleteRows indicates an expected call of HandleDeleteRows.
+func (mr *MockTransactionHandlerMockRecorder) HandleDeleteRows(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeleteRows", reflect.TypeOf((*MockTransactionHandler)(nil).HandleDeleteRows), w, r)
+}
+
 // HandleEditCell mocks base method.
 func (m *MockTransactionHandler) HandleEditCell(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -70,6 +106,30 @@ func (mr *MockTransactionHandlerMockRecorder) HandleEditCell(w, r interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEditCell", reflect.TypeOf((*MockTransactionHandler)(nil).HandleEditCell), w, r)
 }
 
+// HandleEditJSONCell mocks base method.
+func (m *MockTransactionHandler) HandleEditJSONCell(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleEditJSONCell", w, r)
+}
+
+// HandleEditJSONCell indicates an expected call of HandleEditJSONCell.
+func (mr *MockTransactionHandlerMockRecorder) HandleEditJSONCell(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEditJSONCell", reflect.TypeOf((*MockTransactionHandler)(nil).HandleEditJSONCell), w, r)
+}
+
+// HandleExportTransaction mocks base method.
+func (m *MockTransactionHandler) HandleExportTransaction(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleExportTransaction", w, r)
+}
+
+// HandleExportTransaction indicates an expected call of HandleExportTransaction.
+func (mr *MockTransactionHandlerMockRecorder) HandleExportTransaction(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleExportTransaction", reflect.TypeOf((*MockTransactionHandler)(nil).HandleExportTransaction), w, r)
+}
+
 // HandleGetTransactionStatus mocks base method.
 func (m *MockTransactionHandler) HandleGetTransactionStatus(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -94,6 +154,42 @@ func (mr *MockTransactionHandlerMockRecorder) HandleInsertRow(w, r interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleInsertRow", reflect.TypeOf((*MockTransactionHandler)(nil).HandleInsertRow), w, r)
 }
 
+// HandleNewRowDefaults mocks base method.
+func (m *MockTransactionHandler) HandleNewRowDefaults(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleNewRowDefaults", w, r)
+}
+
+// HandleNewRowDefaults indicates an expected call of HandleNewRowDefaults.
+func (mr *MockTransactionHandlerMockRecorder) HandleNewRowDefaults(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleNewRowDefaults", reflect.TypeOf((*MockTransactionHandler)(nil).HandleNewRowDefaults), w, r)
+}
+
+// HandleRestoreCell mocks base method.
+func (m *MockTransactionHandler) HandleRestoreCell(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRestoreCell", w, r)
+}
+
+// HandleRestoreCell indicates an expected call of HandleRestoreCell.
+func (mr *MockTransactionHandlerMockRecorder) HandleRestoreCell(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRestoreCell", reflect.TypeOf((*MockTransactionHandler)(nil).HandleRestoreCell), w, r)
+}
+
+// HandleRestoreDeletedRows mocks base method.
+func (m *MockTransactionHandler) HandleRestoreDeletedRows(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleRestoreDeletedRows", w, r)
+}
+
+// HandleRestoreDeletedRows indicates an expected call of HandleRestoreDeletedRows.
+func (mr *MockTransactionHandlerMockRecorder) HandleRestoreDeletedRows(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRestoreDeletedRows", reflect.TypeOf((*MockTransactionHandler)(nil).HandleRestoreDeletedRows), w, r)
+}
+
 // HandleRollbackTransaction mocks base method.
 func (m *MockTransactionHandler) HandleRollbackTransaction(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -118,6 +214,54 @@ func (mr *MockTransactionHandlerMockRecorder) HandleStartTransaction(w, r interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStartTransaction", reflect.TypeOf((*MockTransactionHandler)(nil).HandleStartTransaction), w, r)
 }
 
+// HandleTableLocks mocks base method.
+func (m *MockTransactionHandler) HandleTableLocks(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTableLocks", w, r)
+}
+
+// HandleTableLocks indicates an expected call of HandleTableLocks.
+func (mr *MockTransactionHandlerMockRecorder) HandleTableLocks(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTableLocks", reflect.TypeOf((*MockTransactionHandler)(nil).HandleTableLocks), w, r)
+}
+
+// HandleTransactionEvents mocks base method.
+func (m *MockTransactionHandler) HandleTransactionEvents(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTransactionEvents", w, r)
+}
+
+// HandleTransactionEvents indicates an expected call of HandleTransactionEvents.
+func (mr *MockTransactionHandlerMockRecorder) HandleTransactionEvents(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTransactionEvents", reflect.TypeOf((*MockTransactionHandler)(nil).HandleTransactionEvents), w, r)
+}
+
+// HandleTransactionHeartbeat mocks base method.
+func (m *MockTransactionHandler) HandleTransactionHeartbeat(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleTransactionHeartbeat", w, r)
+}
+
+// HandleTransactionHeartbeat indicates an expected call of HandleTransactionHeartbeat.
+func (mr *MockTransactionHandlerMockRecorder) HandleTransactionHeartbeat(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleTransactionHeartbeat", reflect.TypeOf((*MockTransactionHandler)(nil).HandleTransactionHeartbeat), w, r)
+}
+
+// HandleUpdateRows mocks base method.
+func (m *MockTransactionHandler) HandleUpdateRows(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleUpdateRows", w, r)
+}
+
+// HandleUpdateRows indicates an expected call of HandleUpdateRows.
+func (mr *MockTransactionHandlerMockRecorder) HandleUpdateRows(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUpdateRows", reflect.TypeOf((*MockTransactionHandler)(nil).HandleUpdateRows), w, r)
+}
+
 // ServeHTTP mocks base method.
 func (m *MockTransactionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
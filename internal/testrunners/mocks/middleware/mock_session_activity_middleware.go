@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/middleware/session_activity_middleware.go
+
+// Package mockmiddleware is a generated GoMock package.
+package mockmiddleware
+
+import (
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSessionActivityMiddleware is a mock of SessionActivityMiddleware interface.
+type MockSessionActivityMiddleware struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionActivityMiddlewareMockRecorder
+}
+
+// MockSessionActivityMiddlewareMockRecorder is the mock recorder for MockSessionActivityMiddleware.
+type MockSessionActivityMiddlewareMockRecorder struct {
+	mock *MockSessionActivityMiddleware
+}
+
+// NewMockSessionActivityMiddleware creates a new mock instance.
+func NewMockSessionActivityMiddleware(ctrl *gomock.Controller) *MockSessionActivityMiddleware {
+	mock := &MockSessionActivityMiddleware{ctrl: ctrl}
+	mock.recorder = &MockSessionActivityMiddlewareMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionActivityMiddleware) EXPECT() *MockSessionActivityMiddlewareMockRecorder {
+	return m.recorder
+}
+
+// TrackActivity mocks base method.
+func (m *MockSessionActivityMiddleware) TrackActivity(next http.Handler) http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackActivity", next)
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// TrackActivity indicates an expected call of TrackActivity.
+func (mr *MockSessionActivityMiddlewareMockRecorder) TrackActivity(next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackActivity", reflect.TypeOf((*MockSessionActivityMiddleware)(nil).TrackActivity), next)
+}
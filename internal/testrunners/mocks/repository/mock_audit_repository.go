@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/audit_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetDeletedRows mocks base method.
+func (m *MockAuditRepository) GetDeletedRows(ctx context.Context, entryID string) (*domain.DeletedRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedRows", ctx, entryID)
+	ret0, _ := ret[0].(*domain.DeletedRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedRows indicates an expected call of GetDeletedRows.
+func (mr *MockAuditRepositoryMockRecorder) GetDeletedRows(ctx, entryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedRows", reflect.TypeOf((*MockAuditRepository)(nil).GetDeletedRows), ctx, entryID)
+}
+
+// GetEntries mocks base method.
+func (m *MockAuditRepository) GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntries", ctx, filter)
+	ret0, _ := ret[0].([]domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntries indicates an expected call of GetEntries.
+func (mr *MockAuditRepositoryMockRecorder) GetEntries(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntries", reflect.TypeOf((*MockAuditRepository)(nil).GetEntries), ctx, filter)
+}
+
+// RecordDeletedRows mocks base method.
+func (m *MockAuditRepository) RecordDeletedRows(ctx context.Context, rows *domain.DeletedRows) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDeletedRows", ctx, rows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordDeletedRows indicates an expected call of RecordDeletedRows.
+func (mr *MockAuditRepositoryMockRecorder) RecordDeletedRows(ctx, rows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDeletedRows", reflect.TypeOf((*MockAuditRepository)(nil).RecordDeletedRows), ctx, rows)
+}
+
+// RecordEntry mocks base method.
+func (m *MockAuditRepository) RecordEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEntry indicates an expected call of RecordEntry.
+func (mr *MockAuditRepositoryMockRecorder) RecordEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEntry", reflect.TypeOf((*MockAuditRepository)(nil).RecordEntry), ctx, entry)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/config_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockConfigRepository is a mock of ConfigRepository interface.
+type MockConfigRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigRepositoryMockRecorder
+}
+
+// MockConfigRepositoryMockRecorder is the mock recorder for MockConfigRepository.
+type MockConfigRepositoryMockRecorder struct {
+	mock *MockConfigRepository
+}
+
+// NewMockConfigRepository creates a new mock instance.
+func NewMockConfigRepository(ctrl *gomock.Controller) *MockConfigRepository {
+	mock := &MockConfigRepository{ctrl: ctrl}
+	mock.recorder = &MockConfigRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigRepository) EXPECT() *MockConfigRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetConfig mocks base method.
+func (m *MockConfigRepository) GetConfig(ctx context.Context) (*domain.AppConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig", ctx)
+	ret0, _ := ret[0].(*domain.AppConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockConfigRepositoryMockRecorder) GetConfig(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockConfigRepository)(nil).GetConfig), ctx)
+}
+
+// UpdateConfig mocks base method.
+func (m *MockConfigRepository) UpdateConfig(ctx context.Context, config *domain.AppConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfig", ctx, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConfig indicates an expected call of UpdateConfig.
+func (mr *MockConfigRepositoryMockRecorder) UpdateConfig(ctx, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfig", reflect.TypeOf((*MockConfigRepository)(nil).UpdateConfig), ctx, config)
+}
@@ -7,7 +7,9 @@ package mockrepository
 import (
 	context "context"
 	sql "database/sql"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/kamil5b/lumen-pg/internal/domain"
@@ -51,6 +53,35 @@ func (mr *MockDatabaseRepositoryMockRecorder) BeginTransaction(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTransaction", reflect.TypeOf((*MockDatabaseRepository)(nil).BeginTransaction), ctx)
 }
 
+// CancelTrackedQuery mocks base method.
+func (m *MockDatabaseRepository) CancelTrackedQuery(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTrackedQuery", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTrackedQuery indicates an expected call of CancelTrackedQuery.
+func (mr *MockDatabaseRepositoryMockRecorder) CancelTrackedQuery(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTrackedQuery", reflect.TypeOf((*MockDatabaseRepository)(nil).CancelTrackedQuery), ctx, key)
+}
+
+// CloseSessionPool mocks base method.
+func (m *MockDatabaseRepository) CloseSessionPool(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSessionPool", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSessionPool indicates an expected call of CloseSessionPool.
+func (mr *MockDatabaseRepositoryMockRecorder) CloseSessionPool(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSessionPool", reflect.TypeOf((*MockDatabaseRepository)(nil).CloseSessionPool), key)
+}
+
 // CommitTransaction mocks base method.
 func (m *MockDatabaseRepository) CommitTransaction(ctx context.Context, tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -79,6 +110,36 @@ func (mr *MockDatabaseRepositoryMockRecorder) Connect(ctx, connString interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockDatabaseRepository)(nil).Connect), ctx, connString)
 }
 
+// CopyQueryCSV mocks base method.
+func (m *MockDatabaseRepository) CopyQueryCSV(ctx context.Context, params domain.QueryParams, w io.Writer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyQueryCSV", ctx, params, w)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyQueryCSV indicates an expected call of CopyQueryCSV.
+func (mr *MockDatabaseRepositoryMockRecorder) CopyQueryCSV(ctx, params, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyQueryCSV", reflect.TypeOf((*MockDatabaseRepository)(nil).CopyQueryCSV), ctx, params, w)
+}
+
+// CopyRows mocks base method.
+func (m *MockDatabaseRepository) CopyRows(ctx context.Context, key, schema, table string, columns []string, rows [][]interface{}) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyRows", ctx, key, schema, table, columns, rows)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyRows indicates an expected call of CopyRows.
+func (mr *MockDatabaseRepositoryMockRecorder) CopyRows(ctx, key, schema, table, columns, rows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyRows", reflect.TypeOf((*MockDatabaseRepository)(nil).CopyRows), ctx, key, schema, table, columns, rows)
+}
+
 // DeleteRow mocks base method.
 func (m *MockDatabaseRepository) DeleteRow(ctx context.Context, database, schema, table string, pkValues map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -93,6 +154,21 @@ func (mr *MockDatabaseRepositoryMockRecorder) DeleteRow(ctx, database, schema, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRow", reflect.TypeOf((*MockDatabaseRepository)(nil).DeleteRow), ctx, database, schema, table, pkValues)
 }
 
+// DeleteRowsByKey mocks base method.
+func (m *MockDatabaseRepository) DeleteRowsByKey(ctx context.Context, tx *sql.Tx, database, schema, table string, keys []map[string]interface{}) ([]map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRowsByKey", ctx, tx, database, schema, table, keys)
+	ret0, _ := ret[0].([]map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRowsByKey indicates an expected call of DeleteRowsByKey.
+func (mr *MockDatabaseRepositoryMockRecorder) DeleteRowsByKey(ctx, tx, database, schema, table, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRowsByKey", reflect.TypeOf((*MockDatabaseRepository)(nil).DeleteRowsByKey), ctx, tx, database, schema, table, keys)
+}
+
 // Disconnect mocks base method.
 func (m *MockDatabaseRepository) Disconnect(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -157,6 +233,161 @@ func (mr *MockDatabaseRepositoryMockRecorder) ExecuteQueryWithPagination(ctx, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQueryWithPagination", reflect.TypeOf((*MockDatabaseRepository)(nil).ExecuteQueryWithPagination), ctx, params)
 }
 
+// ExecuteTrackedQuery mocks base method.
+func (m *MockDatabaseRepository) ExecuteTrackedQuery(ctx context.Context, key, query string, args ...interface{}) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteTrackedQuery", varargs...)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteTrackedQuery indicates an expected call of ExecuteTrackedQuery.
+func (mr *MockDatabaseRepositoryMockRecorder) ExecuteTrackedQuery(ctx, key, query interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteTrackedQuery", reflect.TypeOf((*MockDatabaseRepository)(nil).ExecuteTrackedQuery), varargs...)
+}
+
+// ExplainQuery mocks base method.
+func (m *MockDatabaseRepository) ExplainQuery(ctx context.Context, query string, analyze bool) (*domain.QueryPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, query, analyze)
+	ret0, _ := ret[0].(*domain.QueryPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockDatabaseRepositoryMockRecorder) ExplainQuery(ctx, query, analyze interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockDatabaseRepository)(nil).ExplainQuery), ctx, query, analyze)
+}
+
+// FindDuplicateRows mocks base method.
+func (m *MockDatabaseRepository) FindDuplicateRows(ctx context.Context, params domain.DuplicateParams) ([]domain.DuplicateGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicateRows", ctx, params)
+	ret0, _ := ret[0].([]domain.DuplicateGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicateRows indicates an expected call of FindDuplicateRows.
+func (mr *MockDatabaseRepositoryMockRecorder) FindDuplicateRows(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicateRows", reflect.TypeOf((*MockDatabaseRepository)(nil).FindDuplicateRows), ctx, params)
+}
+
+// FindOrphanedRows mocks base method.
+func (m *MockDatabaseRepository) FindOrphanedRows(ctx context.Context, params domain.OrphanCheckParams) (*domain.OrphanedReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrphanedRows", ctx, params)
+	ret0, _ := ret[0].(*domain.OrphanedReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrphanedRows indicates an expected call of FindOrphanedRows.
+func (mr *MockDatabaseRepositoryMockRecorder) FindOrphanedRows(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrphanedRows", reflect.TypeOf((*MockDatabaseRepository)(nil).FindOrphanedRows), ctx, params)
+}
+
+// GenerateUUID mocks base method.
+func (m *MockDatabaseRepository) GenerateUUID(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateUUID", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateUUID indicates an expected call of GenerateUUID.
+func (mr *MockDatabaseRepositoryMockRecorder) GenerateUUID(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUUID", reflect.TypeOf((*MockDatabaseRepository)(nil).GenerateUUID), ctx)
+}
+
+// GetCheckConstraints mocks base method.
+func (m *MockDatabaseRepository) GetCheckConstraints(ctx context.Context, database, schema, table string) ([]domain.CheckConstraint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckConstraints", ctx, database, schema, table)
+	ret0, _ := ret[0].([]domain.CheckConstraint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckConstraints indicates an expected call of GetCheckConstraints.
+func (mr *MockDatabaseRepositoryMockRecorder) GetCheckConstraints(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckConstraints", reflect.TypeOf((*MockDatabaseRepository)(nil).GetCheckConstraints), ctx, database, schema, table)
+}
+
+// GetColumnAggregate mocks base method.
+func (m *MockDatabaseRepository) GetColumnAggregate(ctx context.Context, database, schema, table, column, whereClause string) (*domain.ColumnAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnAggregate", ctx, database, schema, table, column, whereClause)
+	ret0, _ := ret[0].(*domain.ColumnAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnAggregate indicates an expected call of GetColumnAggregate.
+func (mr *MockDatabaseRepositoryMockRecorder) GetColumnAggregate(ctx, database, schema, table, column, whereClause interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnAggregate", reflect.TypeOf((*MockDatabaseRepository)(nil).GetColumnAggregate), ctx, database, schema, table, column, whereClause)
+}
+
+// GetColumnDependents mocks base method.
+func (m *MockDatabaseRepository) GetColumnDependents(ctx context.Context, database, schema, table, column string) ([]domain.ColumnDependent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnDependents", ctx, database, schema, table, column)
+	ret0, _ := ret[0].([]domain.ColumnDependent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnDependents indicates an expected call of GetColumnDependents.
+func (mr *MockDatabaseRepositoryMockRecorder) GetColumnDependents(ctx, database, schema, table, column interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnDependents", reflect.TypeOf((*MockDatabaseRepository)(nil).GetColumnDependents), ctx, database, schema, table, column)
+}
+
+// GetColumnHistogram mocks base method.
+func (m *MockDatabaseRepository) GetColumnHistogram(ctx context.Context, params domain.HistogramParams) ([]domain.HistogramBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnHistogram", ctx, params)
+	ret0, _ := ret[0].([]domain.HistogramBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnHistogram indicates an expected call of GetColumnHistogram.
+func (mr *MockDatabaseRepositoryMockRecorder) GetColumnHistogram(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnHistogram", reflect.TypeOf((*MockDatabaseRepository)(nil).GetColumnHistogram), ctx, params)
+}
+
+// GetColumnQualityStats mocks base method.
+func (m *MockDatabaseRepository) GetColumnQualityStats(ctx context.Context, database, schema, table string, columns []string, sampleLimit int) (*domain.DataQualityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnQualityStats", ctx, database, schema, table, columns, sampleLimit)
+	ret0, _ := ret[0].(*domain.DataQualityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnQualityStats indicates an expected call of GetColumnQualityStats.
+func (mr *MockDatabaseRepositoryMockRecorder) GetColumnQualityStats(ctx, database, schema, table, columns, sampleLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnQualityStats", reflect.TypeOf((*MockDatabaseRepository)(nil).GetColumnQualityStats), ctx, database, schema, table, columns, sampleLimit)
+}
+
 // GetConnection mocks base method.
 func (m *MockDatabaseRepository) GetConnection() *sql.DB {
 	m.ctrl.T.Helper()
@@ -201,6 +432,51 @@ func (mr *MockDatabaseRepositoryMockRecorder) GetDatabases(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabases", reflect.TypeOf((*MockDatabaseRepository)(nil).GetDatabases), ctx)
 }
 
+// GetFunctionDefinition mocks base method.
+func (m *MockDatabaseRepository) GetFunctionDefinition(ctx context.Context, database, schema, name, arguments string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFunctionDefinition", ctx, database, schema, name, arguments)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunctionDefinition indicates an expected call of GetFunctionDefinition.
+func (mr *MockDatabaseRepositoryMockRecorder) GetFunctionDefinition(ctx, database, schema, name, arguments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionDefinition", reflect.TypeOf((*MockDatabaseRepository)(nil).GetFunctionDefinition), ctx, database, schema, name, arguments)
+}
+
+// GetGroupedCounts mocks base method.
+func (m *MockDatabaseRepository) GetGroupedCounts(ctx context.Context, params domain.GroupByParams) ([]domain.GroupByBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupedCounts", ctx, params)
+	ret0, _ := ret[0].([]domain.GroupByBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupedCounts indicates an expected call of GetGroupedCounts.
+func (mr *MockDatabaseRepositoryMockRecorder) GetGroupedCounts(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupedCounts", reflect.TypeOf((*MockDatabaseRepository)(nil).GetGroupedCounts), ctx, params)
+}
+
+// GetJoinedTableData mocks base method.
+func (m *MockDatabaseRepository) GetJoinedTableData(ctx context.Context, params domain.JoinViewParams) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinedTableData", ctx, params)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinedTableData indicates an expected call of GetJoinedTableData.
+func (mr *MockDatabaseRepositoryMockRecorder) GetJoinedTableData(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinedTableData", reflect.TypeOf((*MockDatabaseRepository)(nil).GetJoinedTableData), ctx, params)
+}
+
 // GetRowCount mocks base method.
 func (m *MockDatabaseRepository) GetRowCount(ctx context.Context, database, schema, table, whereClause string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +492,21 @@ func (mr *MockDatabaseRepositoryMockRecorder) GetRowCount(ctx, database, schema,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowCount", reflect.TypeOf((*MockDatabaseRepository)(nil).GetRowCount), ctx, database, schema, table, whereClause)
 }
 
+// GetRowHistory mocks base method.
+func (m *MockDatabaseRepository) GetRowHistory(ctx context.Context, history domain.HistoryTableConfig, pkValues map[string]interface{}) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowHistory", ctx, history, pkValues)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowHistory indicates an expected call of GetRowHistory.
+func (mr *MockDatabaseRepositoryMockRecorder) GetRowHistory(ctx, history, pkValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowHistory", reflect.TypeOf((*MockDatabaseRepository)(nil).GetRowHistory), ctx, history, pkValues)
+}
+
 // GetSchemas mocks base method.
 func (m *MockDatabaseRepository) GetSchemas(ctx context.Context, database string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +537,51 @@ func (mr *MockDatabaseRepositoryMockRecorder) GetTableData(ctx, params interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableData", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableData), ctx, params)
 }
 
+// GetTableDataAsOf mocks base method.
+func (m *MockDatabaseRepository) GetTableDataAsOf(ctx context.Context, history domain.HistoryTableConfig, asOf time.Time, offset, limit int) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableDataAsOf", ctx, history, asOf, offset, limit)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableDataAsOf indicates an expected call of GetTableDataAsOf.
+func (mr *MockDatabaseRepositoryMockRecorder) GetTableDataAsOf(ctx, history, asOf, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableDataAsOf", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableDataAsOf), ctx, history, asOf, offset, limit)
+}
+
+// GetTableDefinition mocks base method.
+func (m *MockDatabaseRepository) GetTableDefinition(ctx context.Context, database, schema, table string) (*domain.TableDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableDefinition", ctx, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableDefinition indicates an expected call of GetTableDefinition.
+func (mr *MockDatabaseRepositoryMockRecorder) GetTableDefinition(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableDefinition", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableDefinition), ctx, database, schema, table)
+}
+
+// GetTableLocks mocks base method.
+func (m *MockDatabaseRepository) GetTableLocks(ctx context.Context, database, schema, table string) ([]domain.TableLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableLocks", ctx, database, schema, table)
+	ret0, _ := ret[0].([]domain.TableLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableLocks indicates an expected call of GetTableLocks.
+func (mr *MockDatabaseRepositoryMockRecorder) GetTableLocks(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableLocks", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableLocks), ctx, database, schema, table)
+}
+
 // GetTableMetadata mocks base method.
 func (m *MockDatabaseRepository) GetTableMetadata(ctx context.Context, database, schema, table string) (*domain.TableMetadata, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +597,36 @@ func (mr *MockDatabaseRepositoryMockRecorder) GetTableMetadata(ctx, database, sc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableMetadata", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableMetadata), ctx, database, schema, table)
 }
 
+// GetTableSizeEstimates mocks base method.
+func (m *MockDatabaseRepository) GetTableSizeEstimates(ctx context.Context, database, schema string, tables []string) ([]domain.TableSizeEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableSizeEstimates", ctx, database, schema, tables)
+	ret0, _ := ret[0].([]domain.TableSizeEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableSizeEstimates indicates an expected call of GetTableSizeEstimates.
+func (mr *MockDatabaseRepositoryMockRecorder) GetTableSizeEstimates(ctx, database, schema, tables interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableSizeEstimates", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableSizeEstimates), ctx, database, schema, tables)
+}
+
+// GetTableStatistics mocks base method.
+func (m *MockDatabaseRepository) GetTableStatistics(ctx context.Context, database, schema, table string) (*domain.TableStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableStatistics", ctx, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableStatistics indicates an expected call of GetTableStatistics.
+func (mr *MockDatabaseRepositoryMockRecorder) GetTableStatistics(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableStatistics", reflect.TypeOf((*MockDatabaseRepository)(nil).GetTableStatistics), ctx, database, schema, table)
+}
+
 // GetTables mocks base method.
 func (m *MockDatabaseRepository) GetTables(ctx context.Context, database, schema string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +656,197 @@ func (mr *MockDatabaseRepositoryMockRecorder) InsertRow(ctx, database, schema, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRow", reflect.TypeOf((*MockDatabaseRepository)(nil).InsertRow), ctx, database, schema, table, values)
 }
 
+// InstallChangeTrigger mocks base method.
+func (m *MockDatabaseRepository) InstallChangeTrigger(ctx context.Context, channel, schema, table string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallChangeTrigger", ctx, channel, schema, table)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallChangeTrigger indicates an expected call of InstallChangeTrigger.
+func (mr *MockDatabaseRepositoryMockRecorder) InstallChangeTrigger(ctx, channel, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallChangeTrigger", reflect.TypeOf((*MockDatabaseRepository)(nil).InstallChangeTrigger), ctx, channel, schema, table)
+}
+
+// ListFunctions mocks base method.
+func (m *MockDatabaseRepository) ListFunctions(ctx context.Context, database, schema string) ([]domain.StoredFunction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFunctions", ctx, database, schema)
+	ret0, _ := ret[0].([]domain.StoredFunction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctions indicates an expected call of ListFunctions.
+func (mr *MockDatabaseRepositoryMockRecorder) ListFunctions(ctx, database, schema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctions", reflect.TypeOf((*MockDatabaseRepository)(nil).ListFunctions), ctx, database, schema)
+}
+
+// ListSequences mocks base method.
+func (m *MockDatabaseRepository) ListSequences(ctx context.Context, database, schema string) ([]domain.Sequence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSequences", ctx, database, schema)
+	ret0, _ := ret[0].([]domain.Sequence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSequences indicates an expected call of ListSequences.
+func (mr *MockDatabaseRepositoryMockRecorder) ListSequences(ctx, database, schema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSequences", reflect.TypeOf((*MockDatabaseRepository)(nil).ListSequences), ctx, database, schema)
+}
+
+// ListTableConstraints mocks base method.
+func (m *MockDatabaseRepository) ListTableConstraints(ctx context.Context, database, schema, table string) ([]domain.TableConstraint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableConstraints", ctx, database, schema, table)
+	ret0, _ := ret[0].([]domain.TableConstraint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableConstraints indicates an expected call of ListTableConstraints.
+func (mr *MockDatabaseRepositoryMockRecorder) ListTableConstraints(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableConstraints", reflect.TypeOf((*MockDatabaseRepository)(nil).ListTableConstraints), ctx, database, schema, table)
+}
+
+// ListTableIndexes mocks base method.
+func (m *MockDatabaseRepository) ListTableIndexes(ctx context.Context, database, schema, table string) ([]domain.TableIndex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableIndexes", ctx, database, schema, table)
+	ret0, _ := ret[0].([]domain.TableIndex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableIndexes indicates an expected call of ListTableIndexes.
+func (mr *MockDatabaseRepositoryMockRecorder) ListTableIndexes(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableIndexes", reflect.TypeOf((*MockDatabaseRepository)(nil).ListTableIndexes), ctx, database, schema, table)
+}
+
+// ListTableTriggers mocks base method.
+func (m *MockDatabaseRepository) ListTableTriggers(ctx context.Context, database, schema, table string) ([]domain.TableTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableTriggers", ctx, database, schema, table)
+	ret0, _ := ret[0].([]domain.TableTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableTriggers indicates an expected call of ListTableTriggers.
+func (mr *MockDatabaseRepositoryMockRecorder) ListTableTriggers(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableTriggers", reflect.TypeOf((*MockDatabaseRepository)(nil).ListTableTriggers), ctx, database, schema, table)
+}
+
+// ListenNotifications mocks base method.
+func (m *MockDatabaseRepository) ListenNotifications(ctx context.Context, channel string, onNotify func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenNotifications", ctx, channel, onNotify)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListenNotifications indicates an expected call of ListenNotifications.
+func (mr *MockDatabaseRepositoryMockRecorder) ListenNotifications(ctx, channel, onNotify interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenNotifications", reflect.TypeOf((*MockDatabaseRepository)(nil).ListenNotifications), ctx, channel, onNotify)
+}
+
+// LookupSessionPool mocks base method.
+func (m *MockDatabaseRepository) LookupSessionPool(key string) (*sql.DB, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupSessionPool", key)
+	ret0, _ := ret[0].(*sql.DB)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// LookupSessionPool indicates an expected call of LookupSessionPool.
+func (mr *MockDatabaseRepositoryMockRecorder) LookupSessionPool(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupSessionPool", reflect.TypeOf((*MockDatabaseRepository)(nil).LookupSessionPool), key)
+}
+
+// OpenSnapshot mocks base method.
+func (m *MockDatabaseRepository) OpenSnapshot(ctx context.Context, key string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenSnapshot", ctx, key)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenSnapshot indicates an expected call of OpenSnapshot.
+func (mr *MockDatabaseRepositoryMockRecorder) OpenSnapshot(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenSnapshot", reflect.TypeOf((*MockDatabaseRepository)(nil).OpenSnapshot), ctx, key)
+}
+
+// PreviewColumnDefaults mocks base method.
+func (m *MockDatabaseRepository) PreviewColumnDefaults(ctx context.Context, database, schema, table string) (map[string]domain.ColumnDefaultPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewColumnDefaults", ctx, database, schema, table)
+	ret0, _ := ret[0].(map[string]domain.ColumnDefaultPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewColumnDefaults indicates an expected call of PreviewColumnDefaults.
+func (mr *MockDatabaseRepositoryMockRecorder) PreviewColumnDefaults(ctx, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewColumnDefaults", reflect.TypeOf((*MockDatabaseRepository)(nil).PreviewColumnDefaults), ctx, database, schema, table)
+}
+
+// ReleaseSnapshot mocks base method.
+func (m *MockDatabaseRepository) ReleaseSnapshot(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSnapshot", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSnapshot indicates an expected call of ReleaseSnapshot.
+func (mr *MockDatabaseRepositoryMockRecorder) ReleaseSnapshot(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSnapshot", reflect.TypeOf((*MockDatabaseRepository)(nil).ReleaseSnapshot), key)
+}
+
+// RemoveChangeTrigger mocks base method.
+func (m *MockDatabaseRepository) RemoveChangeTrigger(ctx context.Context, channel, schema, table string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChangeTrigger", ctx, channel, schema, table)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChangeTrigger indicates an expected call of RemoveChangeTrigger.
+func (mr *MockDatabaseRepositoryMockRecorder) RemoveChangeTrigger(ctx, channel, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChangeTrigger", reflect.TypeOf((*MockDatabaseRepository)(nil).RemoveChangeTrigger), ctx, channel, schema, table)
+}
+
+// RemoveStaleChangeTriggers mocks base method.
+func (m *MockDatabaseRepository) RemoveStaleChangeTriggers(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveStaleChangeTriggers", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveStaleChangeTriggers indicates an expected call of RemoveStaleChangeTriggers.
+func (mr *MockDatabaseRepositoryMockRecorder) RemoveStaleChangeTriggers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveStaleChangeTriggers", reflect.TypeOf((*MockDatabaseRepository)(nil).RemoveStaleChangeTriggers), ctx)
+}
+
 // RollbackTransaction mocks base method.
 func (m *MockDatabaseRepository) RollbackTransaction(ctx context.Context, tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -304,6 +861,89 @@ func (mr *MockDatabaseRepositoryMockRecorder) RollbackTransaction(ctx, tx interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTransaction", reflect.TypeOf((*MockDatabaseRepository)(nil).RollbackTransaction), ctx, tx)
 }
 
+// SearchReferencedRows mocks base method.
+func (m *MockDatabaseRepository) SearchReferencedRows(ctx context.Context, params domain.ForeignKeyLookupParams) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchReferencedRows", ctx, params)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchReferencedRows indicates an expected call of SearchReferencedRows.
+func (mr *MockDatabaseRepositoryMockRecorder) SearchReferencedRows(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchReferencedRows", reflect.TypeOf((*MockDatabaseRepository)(nil).SearchReferencedRows), ctx, params)
+}
+
+// SessionPool mocks base method.
+func (m *MockDatabaseRepository) SessionPool(ctx context.Context, key, connString string) (*sql.DB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionPool", ctx, key, connString)
+	ret0, _ := ret[0].(*sql.DB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SessionPool indicates an expected call of SessionPool.
+func (mr *MockDatabaseRepositoryMockRecorder) SessionPool(ctx, key, connString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionPool", reflect.TypeOf((*MockDatabaseRepository)(nil).SessionPool), ctx, key, connString)
+}
+
+// SetApplicationNamePrefix mocks base method.
+func (m *MockDatabaseRepository) SetApplicationNamePrefix(prefix string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetApplicationNamePrefix", prefix)
+}
+
+// SetApplicationNamePrefix indicates an expected call of SetApplicationNamePrefix.
+func (mr *MockDatabaseRepositoryMockRecorder) SetApplicationNamePrefix(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationNamePrefix", reflect.TypeOf((*MockDatabaseRepository)(nil).SetApplicationNamePrefix), prefix)
+}
+
+// SetPoolConfig mocks base method.
+func (m *MockDatabaseRepository) SetPoolConfig(config domain.ConnectionPoolConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPoolConfig", config)
+}
+
+// SetPoolConfig indicates an expected call of SetPoolConfig.
+func (mr *MockDatabaseRepositoryMockRecorder) SetPoolConfig(config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPoolConfig", reflect.TypeOf((*MockDatabaseRepository)(nil).SetPoolConfig), config)
+}
+
+// SnapshotTakenAt mocks base method.
+func (m *MockDatabaseRepository) SnapshotTakenAt(key string) (time.Time, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotTakenAt", key)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// SnapshotTakenAt indicates an expected call of SnapshotTakenAt.
+func (mr *MockDatabaseRepositoryMockRecorder) SnapshotTakenAt(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotTakenAt", reflect.TypeOf((*MockDatabaseRepository)(nil).SnapshotTakenAt), key)
+}
+
+// StreamQuery mocks base method.
+func (m *MockDatabaseRepository) StreamQuery(ctx context.Context, params domain.QueryParams, onColumns func([]string) error, onRow func([]interface{}) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamQuery", ctx, params, onColumns, onRow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamQuery indicates an expected call of StreamQuery.
+func (mr *MockDatabaseRepositoryMockRecorder) StreamQuery(ctx, params, onColumns, onRow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamQuery", reflect.TypeOf((*MockDatabaseRepository)(nil).StreamQuery), ctx, params, onColumns, onRow)
+}
+
 // TestConnection mocks base method.
 func (m *MockDatabaseRepository) TestConnection(ctx context.Context, connString string) error {
 	m.ctrl.T.Helper()
@@ -331,3 +971,33 @@ func (mr *MockDatabaseRepositoryMockRecorder) UpdateRow(ctx, database, schema, t
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRow", reflect.TypeOf((*MockDatabaseRepository)(nil).UpdateRow), ctx, database, schema, table, pkValues, values)
 }
+
+// UpdateRowsByFilter mocks base method.
+func (m *MockDatabaseRepository) UpdateRowsByFilter(ctx context.Context, tx *sql.Tx, update domain.BulkUpdate) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRowsByFilter", ctx, tx, update)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRowsByFilter indicates an expected call of UpdateRowsByFilter.
+func (mr *MockDatabaseRepositoryMockRecorder) UpdateRowsByFilter(ctx, tx, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRowsByFilter", reflect.TypeOf((*MockDatabaseRepository)(nil).UpdateRowsByFilter), ctx, tx, update)
+}
+
+// UpsertRow mocks base method.
+func (m *MockDatabaseRepository) UpsertRow(ctx context.Context, tx *sql.Tx, database, schema, table string, insert domain.RowInsert) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRow", ctx, tx, database, schema, table, insert)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRow indicates an expected call of UpsertRow.
+func (mr *MockDatabaseRepositoryMockRecorder) UpsertRow(ctx, tx, database, schema, table, insert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRow", reflect.TypeOf((*MockDatabaseRepository)(nil).UpsertRow), ctx, tx, database, schema, table, insert)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/geoip_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockGeoIPRepository is a mock of GeoIPRepository interface.
+type MockGeoIPRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeoIPRepositoryMockRecorder
+}
+
+// MockGeoIPRepositoryMockRecorder is the mock recorder for MockGeoIPRepository.
+type MockGeoIPRepositoryMockRecorder struct {
+	mock *MockGeoIPRepository
+}
+
+// NewMockGeoIPRepository creates a new mock instance.
+func NewMockGeoIPRepository(ctrl *gomock.Controller) *MockGeoIPRepository {
+	mock := &MockGeoIPRepository{ctrl: ctrl}
+	mock.recorder = &MockGeoIPRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGeoIPRepository) EXPECT() *MockGeoIPRepositoryMockRecorder {
+	return m.recorder
+}
+
+// LookupLocation mocks base method.
+func (m *MockGeoIPRepository) LookupLocation(ctx context.Context, databasePath, ip string) (*domain.GeoLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupLocation", ctx, databasePath, ip)
+	ret0, _ := ret[0].(*domain.GeoLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupLocation indicates an expected call of LookupLocation.
+func (mr *MockGeoIPRepositoryMockRecorder) LookupLocation(ctx, databasePath, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupLocation", reflect.TypeOf((*MockGeoIPRepository)(nil).LookupLocation), ctx, databasePath, ip)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/ldap_authenticator.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockLDAPAuthenticator is a mock of LDAPAuthenticator interface.
+type MockLDAPAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockLDAPAuthenticatorMockRecorder
+}
+
+// MockLDAPAuthenticatorMockRecorder is the mock recorder for MockLDAPAuthenticator.
+type MockLDAPAuthenticatorMockRecorder struct {
+	mock *MockLDAPAuthenticator
+}
+
+// NewMockLDAPAuthenticator creates a new mock instance.
+func NewMockLDAPAuthenticator(ctrl *gomock.Controller) *MockLDAPAuthenticator {
+	mock := &MockLDAPAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockLDAPAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLDAPAuthenticator) EXPECT() *MockLDAPAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockLDAPAuthenticator) Authenticate(ctx context.Context, username, password string) (*domain.LDAPEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, username, password)
+	ret0, _ := ret[0].(*domain.LDAPEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockLDAPAuthenticatorMockRecorder) Authenticate(ctx, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockLDAPAuthenticator)(nil).Authenticate), ctx, username, password)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
 )
 
 // MockLoggerRepository is a mock of LoggerRepository interface.
@@ -34,6 +35,21 @@ func (m *MockLoggerRepository) EXPECT() *MockLoggerRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetSlowQueries mocks base method.
+func (m *MockLoggerRepository) GetSlowQueries(ctx context.Context, limit int) ([]domain.SlowQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlowQueries", ctx, limit)
+	ret0, _ := ret[0].([]domain.SlowQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlowQueries indicates an expected call of GetSlowQueries.
+func (mr *MockLoggerRepositoryMockRecorder) GetSlowQueries(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlowQueries", reflect.TypeOf((*MockLoggerRepository)(nil).GetSlowQueries), ctx, limit)
+}
+
 // LogDebug mocks base method.
 func (m *MockLoggerRepository) LogDebug(ctx context.Context, message string, fields map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -104,6 +120,20 @@ func (mr *MockLoggerRepositoryMockRecorder) LogSecurityEvent(ctx, eventType, use
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogSecurityEvent", reflect.TypeOf((*MockLoggerRepository)(nil).LogSecurityEvent), ctx, eventType, username, details)
 }
 
+// LogSlowQuery mocks base method.
+func (m *MockLoggerRepository) LogSlowQuery(ctx context.Context, query *domain.SlowQuery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogSlowQuery", ctx, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogSlowQuery indicates an expected call of LogSlowQuery.
+func (mr *MockLoggerRepositoryMockRecorder) LogSlowQuery(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogSlowQuery", reflect.TypeOf((*MockLoggerRepository)(nil).LogSlowQuery), ctx, query)
+}
+
 // LogTransactionEvent mocks base method.
 func (m *MockLoggerRepository) LogTransactionEvent(ctx context.Context, username, eventType string, details map[string]interface{}) error {
 	m.ctrl.T.Helper()
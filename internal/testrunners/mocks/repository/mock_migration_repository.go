@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/migration_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMigrationRepository is a mock of MigrationRepository interface.
+type MockMigrationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMigrationRepositoryMockRecorder
+}
+
+// MockMigrationRepositoryMockRecorder is the mock recorder for MockMigrationRepository.
+type MockMigrationRepositoryMockRecorder struct {
+	mock *MockMigrationRepository
+}
+
+// NewMockMigrationRepository creates a new mock instance.
+func NewMockMigrationRepository(ctrl *gomock.Controller) *MockMigrationRepository {
+	mock := &MockMigrationRepository{ctrl: ctrl}
+	mock.recorder = &MockMigrationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMigrationRepository) EXPECT() *MockMigrationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CurrentVersion mocks base method.
+func (m *MockMigrationRepository) CurrentVersion(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentVersion", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentVersion indicates an expected call of CurrentVersion.
+func (mr *MockMigrationRepositoryMockRecorder) CurrentVersion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentVersion", reflect.TypeOf((*MockMigrationRepository)(nil).CurrentVersion), ctx)
+}
+
+// LatestVersion mocks base method.
+func (m *MockMigrationRepository) LatestVersion() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestVersion")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// LatestVersion indicates an expected call of LatestVersion.
+func (mr *MockMigrationRepositoryMockRecorder) LatestVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestVersion", reflect.TypeOf((*MockMigrationRepository)(nil).LatestVersion))
+}
+
+// Migrate mocks base method.
+func (m *MockMigrationRepository) Migrate(ctx context.Context) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockMigrationRepositoryMockRecorder) Migrate(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockMigrationRepository)(nil).Migrate), ctx)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/monitoring_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockMonitoringRepository is a mock of MonitoringRepository interface.
+type MockMonitoringRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonitoringRepositoryMockRecorder
+}
+
+// MockMonitoringRepositoryMockRecorder is the mock recorder for MockMonitoringRepository.
+type MockMonitoringRepositoryMockRecorder struct {
+	mock *MockMonitoringRepository
+}
+
+// NewMockMonitoringRepository creates a new mock instance.
+func NewMockMonitoringRepository(ctrl *gomock.Controller) *MockMonitoringRepository {
+	mock := &MockMonitoringRepository{ctrl: ctrl}
+	mock.recorder = &MockMonitoringRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonitoringRepository) EXPECT() *MockMonitoringRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBackendActivity mocks base method.
+func (m *MockMonitoringRepository) GetBackendActivity(ctx context.Context) ([]domain.BackendActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackendActivity", ctx)
+	ret0, _ := ret[0].([]domain.BackendActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackendActivity indicates an expected call of GetBackendActivity.
+func (mr *MockMonitoringRepositoryMockRecorder) GetBackendActivity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackendActivity", reflect.TypeOf((*MockMonitoringRepository)(nil).GetBackendActivity), ctx)
+}
+
+// GetLockWaits mocks base method.
+func (m *MockMonitoringRepository) GetLockWaits(ctx context.Context) ([]domain.LockWait, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLockWaits", ctx)
+	ret0, _ := ret[0].([]domain.LockWait)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLockWaits indicates an expected call of GetLockWaits.
+func (mr *MockMonitoringRepositoryMockRecorder) GetLockWaits(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLockWaits", reflect.TypeOf((*MockMonitoringRepository)(nil).GetLockWaits), ctx)
+}
+
+// GetServerStats mocks base method.
+func (m *MockMonitoringRepository) GetServerStats(ctx context.Context) (*domain.ServerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerStats", ctx)
+	ret0, _ := ret[0].(*domain.ServerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerStats indicates an expected call of GetServerStats.
+func (mr *MockMonitoringRepositoryMockRecorder) GetServerStats(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerStats", reflect.TypeOf((*MockMonitoringRepository)(nil).GetServerStats), ctx)
+}
+
+// TerminateBackend mocks base method.
+func (m *MockMonitoringRepository) TerminateBackend(ctx context.Context, pid int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateBackend", ctx, pid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateBackend indicates an expected call of TerminateBackend.
+func (mr *MockMonitoringRepositoryMockRecorder) TerminateBackend(ctx, pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateBackend", reflect.TypeOf((*MockMonitoringRepository)(nil).TerminateBackend), ctx, pid)
+}
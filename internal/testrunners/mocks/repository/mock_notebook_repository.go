@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/notebook_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockNotebookRepository is a mock of NotebookRepository interface.
+type MockNotebookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotebookRepositoryMockRecorder
+}
+
+// MockNotebookRepositoryMockRecorder is the mock recorder for MockNotebookRepository.
+type MockNotebookRepositoryMockRecorder struct {
+	mock *MockNotebookRepository
+}
+
+// NewMockNotebookRepository creates a new mock instance.
+func NewMockNotebookRepository(ctrl *gomock.Controller) *MockNotebookRepository {
+	mock := &MockNotebookRepository{ctrl: ctrl}
+	mock.recorder = &MockNotebookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotebookRepository) EXPECT() *MockNotebookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateNotebook mocks base method.
+func (m *MockNotebookRepository) CreateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotebook", ctx, notebook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotebook indicates an expected call of CreateNotebook.
+func (mr *MockNotebookRepositoryMockRecorder) CreateNotebook(ctx, notebook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotebook", reflect.TypeOf((*MockNotebookRepository)(nil).CreateNotebook), ctx, notebook)
+}
+
+// DeleteNotebook mocks base method.
+func (m *MockNotebookRepository) DeleteNotebook(ctx context.Context, username, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotebook", ctx, username, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotebook indicates an expected call of DeleteNotebook.
+func (mr *MockNotebookRepositoryMockRecorder) DeleteNotebook(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotebook", reflect.TypeOf((*MockNotebookRepository)(nil).DeleteNotebook), ctx, username, id)
+}
+
+// GetNotebook mocks base method.
+func (m *MockNotebookRepository) GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotebook", ctx, username, id)
+	ret0, _ := ret[0].(*domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotebook indicates an expected call of GetNotebook.
+func (mr *MockNotebookRepositoryMockRecorder) GetNotebook(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotebook", reflect.TypeOf((*MockNotebookRepository)(nil).GetNotebook), ctx, username, id)
+}
+
+// ListNotebooks mocks base method.
+func (m *MockNotebookRepository) ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotebooks", ctx, username)
+	ret0, _ := ret[0].([]domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotebooks indicates an expected call of ListNotebooks.
+func (mr *MockNotebookRepositoryMockRecorder) ListNotebooks(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotebooks", reflect.TypeOf((*MockNotebookRepository)(nil).ListNotebooks), ctx, username)
+}
+
+// UpdateNotebook mocks base method.
+func (m *MockNotebookRepository) UpdateNotebook(ctx context.Context, notebook *domain.QueryNotebook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotebook", ctx, notebook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotebook indicates an expected call of UpdateNotebook.
+func (mr *MockNotebookRepositoryMockRecorder) UpdateNotebook(ctx, notebook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotebook", reflect.TypeOf((*MockNotebookRepository)(nil).UpdateNotebook), ctx, notebook)
+}
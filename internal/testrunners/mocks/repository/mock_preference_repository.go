@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/preference_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPreferenceRepository is a mock of PreferenceRepository interface.
+type MockPreferenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferenceRepositoryMockRecorder
+}
+
+// MockPreferenceRepositoryMockRecorder is the mock recorder for MockPreferenceRepository.
+type MockPreferenceRepositoryMockRecorder struct {
+	mock *MockPreferenceRepository
+}
+
+// NewMockPreferenceRepository creates a new mock instance.
+func NewMockPreferenceRepository(ctrl *gomock.Controller) *MockPreferenceRepository {
+	mock := &MockPreferenceRepository{ctrl: ctrl}
+	mock.recorder = &MockPreferenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferenceRepository) EXPECT() *MockPreferenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeletePreference mocks base method.
+func (m *MockPreferenceRepository) DeletePreference(ctx context.Context, username, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePreference", ctx, username, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePreference indicates an expected call of DeletePreference.
+func (mr *MockPreferenceRepositoryMockRecorder) DeletePreference(ctx, username, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePreference", reflect.TypeOf((*MockPreferenceRepository)(nil).DeletePreference), ctx, username, key)
+}
+
+// GetPreferences mocks base method.
+func (m *MockPreferenceRepository) GetPreferences(ctx context.Context, username string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx, username)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockPreferenceRepositoryMockRecorder) GetPreferences(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockPreferenceRepository)(nil).GetPreferences), ctx, username)
+}
+
+// SetPreference mocks base method.
+func (m *MockPreferenceRepository) SetPreference(ctx context.Context, username, key, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPreference", ctx, username, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPreference indicates an expected call of SetPreference.
+func (mr *MockPreferenceRepositoryMockRecorder) SetPreference(ctx, username, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockPreferenceRepository)(nil).SetPreference), ctx, username, key, value)
+}
@@ -50,6 +50,35 @@ func (mr *MockRBACRepositoryMockRecorder) CanAccessTable(ctx, role, database, sc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanAccessTable", reflect.TypeOf((*MockRBACRepository)(nil).CanAccessTable), ctx, role, database, schema, table)
 }
 
+// CanCreateDatabase mocks base method.
+func (m *MockRBACRepository) CanCreateDatabase(ctx context.Context, role string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanCreateDatabase", ctx, role)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanCreateDatabase indicates an expected call of CanCreateDatabase.
+func (mr *MockRBACRepositoryMockRecorder) CanCreateDatabase(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanCreateDatabase", reflect.TypeOf((*MockRBACRepository)(nil).CanCreateDatabase), ctx, role)
+}
+
+// CreateRole mocks base method.
+func (m *MockRBACRepository) CreateRole(ctx context.Context, role domain.NewRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockRBACRepositoryMockRecorder) CreateRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockRBACRepository)(nil).CreateRole), ctx, role)
+}
+
 // GetAccessibleDatabases mocks base method.
 func (m *MockRBACRepository) GetAccessibleDatabases(ctx context.Context, role string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +169,21 @@ func (mr *MockRBACRepositoryMockRecorder) GetRolePermissions(ctx, role, database
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolePermissions", reflect.TypeOf((*MockRBACRepository)(nil).GetRolePermissions), ctx, role, database, schema, table)
 }
 
+// GetSequencePermissions mocks base method.
+func (m *MockRBACRepository) GetSequencePermissions(ctx context.Context, role, database, schema, sequence string) (*domain.SequencePermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSequencePermissions", ctx, role, database, schema, sequence)
+	ret0, _ := ret[0].(*domain.SequencePermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSequencePermissions indicates an expected call of GetSequencePermissions.
+func (mr *MockRBACRepositoryMockRecorder) GetSequencePermissions(ctx, role, database, schema, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSequencePermissions", reflect.TypeOf((*MockRBACRepository)(nil).GetSequencePermissions), ctx, role, database, schema, sequence)
+}
+
 // GetUserRole mocks base method.
 func (m *MockRBACRepository) GetUserRole(ctx context.Context, username string) (string, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +214,21 @@ func (mr *MockRBACRepositoryMockRecorder) HasDatabaseConnectPermission(ctx, role
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDatabaseConnectPermission", reflect.TypeOf((*MockRBACRepository)(nil).HasDatabaseConnectPermission), ctx, role, database)
 }
 
+// HasDatabaseCreatePermission mocks base method.
+func (m *MockRBACRepository) HasDatabaseCreatePermission(ctx context.Context, role, database string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDatabaseCreatePermission", ctx, role, database)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDatabaseCreatePermission indicates an expected call of HasDatabaseCreatePermission.
+func (mr *MockRBACRepositoryMockRecorder) HasDatabaseCreatePermission(ctx, role, database interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDatabaseCreatePermission", reflect.TypeOf((*MockRBACRepository)(nil).HasDatabaseCreatePermission), ctx, role, database)
+}
+
 // HasDeletePermission mocks base method.
 func (m *MockRBACRepository) HasDeletePermission(ctx context.Context, role, database, schema, table string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +244,21 @@ func (mr *MockRBACRepositoryMockRecorder) HasDeletePermission(ctx, role, databas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeletePermission", reflect.TypeOf((*MockRBACRepository)(nil).HasDeletePermission), ctx, role, database, schema, table)
 }
 
+// HasFunctionExecutePermission mocks base method.
+func (m *MockRBACRepository) HasFunctionExecutePermission(ctx context.Context, role, database, schema, name, arguments string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasFunctionExecutePermission", ctx, role, database, schema, name, arguments)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasFunctionExecutePermission indicates an expected call of HasFunctionExecutePermission.
+func (mr *MockRBACRepositoryMockRecorder) HasFunctionExecutePermission(ctx, role, database, schema, name, arguments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFunctionExecutePermission", reflect.TypeOf((*MockRBACRepository)(nil).HasFunctionExecutePermission), ctx, role, database, schema, name, arguments)
+}
+
 // HasInsertPermission mocks base method.
 func (m *MockRBACRepository) HasInsertPermission(ctx context.Context, role, database, schema, table string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +274,21 @@ func (mr *MockRBACRepositoryMockRecorder) HasInsertPermission(ctx, role, databas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasInsertPermission", reflect.TypeOf((*MockRBACRepository)(nil).HasInsertPermission), ctx, role, database, schema, table)
 }
 
+// HasSchemaCreatePermission mocks base method.
+func (m *MockRBACRepository) HasSchemaCreatePermission(ctx context.Context, role, database, schema string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSchemaCreatePermission", ctx, role, database, schema)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSchemaCreatePermission indicates an expected call of HasSchemaCreatePermission.
+func (mr *MockRBACRepositoryMockRecorder) HasSchemaCreatePermission(ctx, role, database, schema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSchemaCreatePermission", reflect.TypeOf((*MockRBACRepository)(nil).HasSchemaCreatePermission), ctx, role, database, schema)
+}
+
 // HasSchemaUsagePermission mocks base method.
 func (m *MockRBACRepository) HasSchemaUsagePermission(ctx context.Context, role, database, schema string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +349,36 @@ func (mr *MockRBACRepositoryMockRecorder) IsReadOnlyRole(ctx, role, database, sc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReadOnlyRole", reflect.TypeOf((*MockRBACRepository)(nil).IsReadOnlyRole), ctx, role, database, schema, table)
 }
 
+// IsSuperuser mocks base method.
+func (m *MockRBACRepository) IsSuperuser(ctx context.Context, role string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSuperuser", ctx, role)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSuperuser indicates an expected call of IsSuperuser.
+func (mr *MockRBACRepositoryMockRecorder) IsSuperuser(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuperuser", reflect.TypeOf((*MockRBACRepository)(nil).IsSuperuser), ctx, role)
+}
+
+// IsTableOwner mocks base method.
+func (m *MockRBACRepository) IsTableOwner(ctx context.Context, role, database, schema, table string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTableOwner", ctx, role, database, schema, table)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTableOwner indicates an expected call of IsTableOwner.
+func (mr *MockRBACRepositoryMockRecorder) IsTableOwner(ctx, role, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableOwner", reflect.TypeOf((*MockRBACRepository)(nil).IsTableOwner), ctx, role, database, schema, table)
+}
+
 // ValidateUserAccessToResource mocks base method.
 func (m *MockRBACRepository) ValidateUserAccessToResource(ctx context.Context, username, resourceType, database, schema, table string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/result_cache_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockResultCacheRepository is a mock of ResultCacheRepository interface.
+type MockResultCacheRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockResultCacheRepositoryMockRecorder
+}
+
+// MockResultCacheRepositoryMockRecorder is the mock recorder for MockResultCacheRepository.
+type MockResultCacheRepositoryMockRecorder struct {
+	mock *MockResultCacheRepository
+}
+
+// NewMockResultCacheRepository creates a new mock instance.
+func NewMockResultCacheRepository(ctrl *gomock.Controller) *MockResultCacheRepository {
+	mock := &MockResultCacheRepository{ctrl: ctrl}
+	mock.recorder = &MockResultCacheRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResultCacheRepository) EXPECT() *MockResultCacheRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetResult mocks base method.
+func (m *MockResultCacheRepository) GetResult(ctx context.Context, id string) (*domain.CachedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResult", ctx, id)
+	ret0, _ := ret[0].(*domain.CachedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResult indicates an expected call of GetResult.
+func (mr *MockResultCacheRepositoryMockRecorder) GetResult(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResult", reflect.TypeOf((*MockResultCacheRepository)(nil).GetResult), ctx, id)
+}
+
+// StoreResult mocks base method.
+func (m *MockResultCacheRepository) StoreResult(ctx context.Context, result *domain.CachedResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreResult", ctx, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreResult indicates an expected call of StoreResult.
+func (mr *MockResultCacheRepositoryMockRecorder) StoreResult(ctx, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreResult", reflect.TypeOf((*MockResultCacheRepository)(nil).StoreResult), ctx, result)
+}
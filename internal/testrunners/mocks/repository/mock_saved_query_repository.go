@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/saved_query_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockSavedQueryRepository is a mock of SavedQueryRepository interface.
+type MockSavedQueryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSavedQueryRepositoryMockRecorder
+}
+
+// MockSavedQueryRepositoryMockRecorder is the mock recorder for MockSavedQueryRepository.
+type MockSavedQueryRepositoryMockRecorder struct {
+	mock *MockSavedQueryRepository
+}
+
+// NewMockSavedQueryRepository creates a new mock instance.
+func NewMockSavedQueryRepository(ctrl *gomock.Controller) *MockSavedQueryRepository {
+	mock := &MockSavedQueryRepository{ctrl: ctrl}
+	mock.recorder = &MockSavedQueryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSavedQueryRepository) EXPECT() *MockSavedQueryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSavedQuery mocks base method.
+func (m *MockSavedQueryRepository) CreateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSavedQuery", ctx, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSavedQuery indicates an expected call of CreateSavedQuery.
+func (mr *MockSavedQueryRepositoryMockRecorder) CreateSavedQuery(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSavedQuery", reflect.TypeOf((*MockSavedQueryRepository)(nil).CreateSavedQuery), ctx, query)
+}
+
+// DeleteSavedQuery mocks base method.
+func (m *MockSavedQueryRepository) DeleteSavedQuery(ctx context.Context, username, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedQuery", ctx, username, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedQuery indicates an expected call of DeleteSavedQuery.
+func (mr *MockSavedQueryRepositoryMockRecorder) DeleteSavedQuery(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedQuery", reflect.TypeOf((*MockSavedQueryRepository)(nil).DeleteSavedQuery), ctx, username, id)
+}
+
+// GetSavedQuery mocks base method.
+func (m *MockSavedQueryRepository) GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedQuery", ctx, username, id)
+	ret0, _ := ret[0].(*domain.SavedQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedQuery indicates an expected call of GetSavedQuery.
+func (mr *MockSavedQueryRepositoryMockRecorder) GetSavedQuery(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedQuery", reflect.TypeOf((*MockSavedQueryRepository)(nil).GetSavedQuery), ctx, username, id)
+}
+
+// ListSavedQueries mocks base method.
+func (m *MockSavedQueryRepository) ListSavedQueries(ctx context.Context, username string) ([]domain.SavedQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedQueries", ctx, username)
+	ret0, _ := ret[0].([]domain.SavedQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedQueries indicates an expected call of ListSavedQueries.
+func (mr *MockSavedQueryRepositoryMockRecorder) ListSavedQueries(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedQueries", reflect.TypeOf((*MockSavedQueryRepository)(nil).ListSavedQueries), ctx, username)
+}
+
+// UpdateSavedQuery mocks base method.
+func (m *MockSavedQueryRepository) UpdateSavedQuery(ctx context.Context, query *domain.SavedQuery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSavedQuery", ctx, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSavedQuery indicates an expected call of UpdateSavedQuery.
+func (mr *MockSavedQueryRepositoryMockRecorder) UpdateSavedQuery(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSavedQuery", reflect.TypeOf((*MockSavedQueryRepository)(nil).UpdateSavedQuery), ctx, query)
+}
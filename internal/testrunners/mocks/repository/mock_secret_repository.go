@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/secret_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSecretRepository is a mock of SecretRepository interface.
+type MockSecretRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretRepositoryMockRecorder
+}
+
+// MockSecretRepositoryMockRecorder is the mock recorder for MockSecretRepository.
+type MockSecretRepositoryMockRecorder struct {
+	mock *MockSecretRepository
+}
+
+// NewMockSecretRepository creates a new mock instance.
+func NewMockSecretRepository(ctrl *gomock.Controller) *MockSecretRepository {
+	mock := &MockSecretRepository{ctrl: ctrl}
+	mock.recorder = &MockSecretRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretRepository) EXPECT() *MockSecretRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ResolveSecret mocks base method.
+func (m *MockSecretRepository) ResolveSecret(ctx context.Context, reference string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSecret", ctx, reference)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSecret indicates an expected call of ResolveSecret.
+func (mr *MockSecretRepositoryMockRecorder) ResolveSecret(ctx, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSecret", reflect.TypeOf((*MockSecretRepository)(nil).ResolveSecret), ctx, reference)
+}
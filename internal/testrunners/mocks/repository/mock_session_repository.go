@@ -121,6 +121,21 @@ func (mr *MockSessionRepositoryMockRecorder) InvalidateUserSessions(ctx, usernam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateUserSessions", reflect.TypeOf((*MockSessionRepository)(nil).InvalidateUserSessions), ctx, username)
 }
 
+// ListSessions mocks base method.
+func (m *MockSessionRepository) ListSessions(ctx context.Context) ([]*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx)
+	ret0, _ := ret[0].([]*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionRepositoryMockRecorder) ListSessions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionRepository)(nil).ListSessions), ctx)
+}
+
 // SessionExists mocks base method.
 func (m *MockSessionRepository) SessionExists(ctx context.Context, sessionID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/slow_operation_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockSlowOperationRepository is a mock of SlowOperationRepository interface.
+type MockSlowOperationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlowOperationRepositoryMockRecorder
+}
+
+// MockSlowOperationRepositoryMockRecorder is the mock recorder for MockSlowOperationRepository.
+type MockSlowOperationRepositoryMockRecorder struct {
+	mock *MockSlowOperationRepository
+}
+
+// NewMockSlowOperationRepository creates a new mock instance.
+func NewMockSlowOperationRepository(ctrl *gomock.Controller) *MockSlowOperationRepository {
+	mock := &MockSlowOperationRepository{ctrl: ctrl}
+	mock.recorder = &MockSlowOperationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlowOperationRepository) EXPECT() *MockSlowOperationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSlowOperations mocks base method.
+func (m *MockSlowOperationRepository) GetSlowOperations(ctx context.Context, limit int) ([]domain.SlowOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlowOperations", ctx, limit)
+	ret0, _ := ret[0].([]domain.SlowOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlowOperations indicates an expected call of GetSlowOperations.
+func (mr *MockSlowOperationRepositoryMockRecorder) GetSlowOperations(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlowOperations", reflect.TypeOf((*MockSlowOperationRepository)(nil).GetSlowOperations), ctx, limit)
+}
+
+// RecordSlowOperation mocks base method.
+func (m *MockSlowOperationRepository) RecordSlowOperation(ctx context.Context, operation *domain.SlowOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSlowOperation", ctx, operation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSlowOperation indicates an expected call of RecordSlowOperation.
+func (mr *MockSlowOperationRepositoryMockRecorder) RecordSlowOperation(ctx, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSlowOperation", reflect.TypeOf((*MockSlowOperationRepository)(nil).RecordSlowOperation), ctx, operation)
+}
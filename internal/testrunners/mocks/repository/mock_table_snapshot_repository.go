@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/repository/table_snapshot_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockTableSnapshotRepository is a mock of TableSnapshotRepository interface.
+type MockTableSnapshotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTableSnapshotRepositoryMockRecorder
+}
+
+// MockTableSnapshotRepositoryMockRecorder is the mock recorder for MockTableSnapshotRepository.
+type MockTableSnapshotRepositoryMockRecorder struct {
+	mock *MockTableSnapshotRepository
+}
+
+// NewMockTableSnapshotRepository creates a new mock instance.
+func NewMockTableSnapshotRepository(ctrl *gomock.Controller) *MockTableSnapshotRepository {
+	mock := &MockTableSnapshotRepository{ctrl: ctrl}
+	mock.recorder = &MockTableSnapshotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTableSnapshotRepository) EXPECT() *MockTableSnapshotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockTableSnapshotRepository) DeleteSnapshot(ctx context.Context, username, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, username, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockTableSnapshotRepositoryMockRecorder) DeleteSnapshot(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockTableSnapshotRepository)(nil).DeleteSnapshot), ctx, username, id)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockTableSnapshotRepository) GetSnapshot(ctx context.Context, username, id string) (*domain.TableSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx, username, id)
+	ret0, _ := ret[0].(*domain.TableSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockTableSnapshotRepositoryMockRecorder) GetSnapshot(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockTableSnapshotRepository)(nil).GetSnapshot), ctx, username, id)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockTableSnapshotRepository) ListSnapshots(ctx context.Context, username string) ([]domain.TableSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", ctx, username)
+	ret0, _ := ret[0].([]domain.TableSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockTableSnapshotRepositoryMockRecorder) ListSnapshots(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockTableSnapshotRepository)(nil).ListSnapshots), ctx, username)
+}
+
+// StoreSnapshot mocks base method.
+func (m *MockTableSnapshotRepository) StoreSnapshot(ctx context.Context, snapshot *domain.TableSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreSnapshot", ctx, snapshot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreSnapshot indicates an expected call of StoreSnapshot.
+func (mr *MockTableSnapshotRepositoryMockRecorder) StoreSnapshot(ctx, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSnapshot", reflect.TypeOf((*MockTableSnapshotRepository)(nil).StoreSnapshot), ctx, snapshot)
+}
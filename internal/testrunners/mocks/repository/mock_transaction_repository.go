@@ -35,6 +35,20 @@ func (m *MockTransactionRepository) EXPECT() *MockTransactionRepositoryMockRecor
 	return m.recorder
 }
 
+// AddBulkUpdate mocks base method.
+func (m *MockTransactionRepository) AddBulkUpdate(ctx context.Context, transactionID string, update domain.BulkUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBulkUpdate", ctx, transactionID, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBulkUpdate indicates an expected call of AddBulkUpdate.
+func (mr *MockTransactionRepositoryMockRecorder) AddBulkUpdate(ctx, transactionID, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBulkUpdate", reflect.TypeOf((*MockTransactionRepository)(nil).AddBulkUpdate), ctx, transactionID, update)
+}
+
 // AddRowDelete mocks base method.
 func (m *MockTransactionRepository) AddRowDelete(ctx context.Context, transactionID string, rowIndex int) error {
 	m.ctrl.T.Helper()
@@ -77,6 +91,20 @@ func (mr *MockTransactionRepositoryMockRecorder) AddRowInsert(ctx, transactionID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRowInsert", reflect.TypeOf((*MockTransactionRepository)(nil).AddRowInsert), ctx, transactionID, insert)
 }
 
+// AddRowKeyDeletes mocks base method.
+func (m *MockTransactionRepository) AddRowKeyDeletes(ctx context.Context, transactionID string, keys []map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRowKeyDeletes", ctx, transactionID, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRowKeyDeletes indicates an expected call of AddRowKeyDeletes.
+func (mr *MockTransactionRepositoryMockRecorder) AddRowKeyDeletes(ctx, transactionID, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRowKeyDeletes", reflect.TypeOf((*MockTransactionRepository)(nil).AddRowKeyDeletes), ctx, transactionID, keys)
+}
+
 // ClearRowDeletes mocks base method.
 func (m *MockTransactionRepository) ClearRowDeletes(ctx context.Context, transactionID string) error {
 	m.ctrl.T.Helper()
@@ -147,6 +175,21 @@ func (mr *MockTransactionRepositoryMockRecorder) DeleteTransaction(ctx, transact
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransactionRepository)(nil).DeleteTransaction), ctx, transactionID)
 }
 
+// GetBulkUpdates mocks base method.
+func (m *MockTransactionRepository) GetBulkUpdates(ctx context.Context, transactionID string) ([]domain.BulkUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkUpdates", ctx, transactionID)
+	ret0, _ := ret[0].([]domain.BulkUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBulkUpdates indicates an expected call of GetBulkUpdates.
+func (mr *MockTransactionRepositoryMockRecorder) GetBulkUpdates(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkUpdates", reflect.TypeOf((*MockTransactionRepository)(nil).GetBulkUpdates), ctx, transactionID)
+}
+
 // GetRowDeletes mocks base method.
 func (m *MockTransactionRepository) GetRowDeletes(ctx context.Context, transactionID string) ([]int, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +235,21 @@ func (mr *MockTransactionRepositoryMockRecorder) GetRowInserts(ctx, transactionI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowInserts", reflect.TypeOf((*MockTransactionRepository)(nil).GetRowInserts), ctx, transactionID)
 }
 
+// GetRowKeyDeletes mocks base method.
+func (m *MockTransactionRepository) GetRowKeyDeletes(ctx context.Context, transactionID string) ([]map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowKeyDeletes", ctx, transactionID)
+	ret0, _ := ret[0].([]map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowKeyDeletes indicates an expected call of GetRowKeyDeletes.
+func (mr *MockTransactionRepositoryMockRecorder) GetRowKeyDeletes(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowKeyDeletes", reflect.TypeOf((*MockTransactionRepository)(nil).GetRowKeyDeletes), ctx, transactionID)
+}
+
 // GetTransaction mocks base method.
 func (m *MockTransactionRepository) GetTransaction(ctx context.Context, transactionID string) (*domain.TransactionState, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +294,21 @@ func (mr *MockTransactionRepositoryMockRecorder) InvalidateExpiredTransactions(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateExpiredTransactions", reflect.TypeOf((*MockTransactionRepository)(nil).InvalidateExpiredTransactions), ctx)
 }
 
+// ListTransactions mocks base method.
+func (m *MockTransactionRepository) ListTransactions(ctx context.Context) ([]*domain.TransactionState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx)
+	ret0, _ := ret[0].([]*domain.TransactionState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockTransactionRepositoryMockRecorder) ListTransactions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockTransactionRepository)(nil).ListTransactions), ctx)
+}
+
 // TransactionExists mocks base method.
 func (m *MockTransactionRepository) TransactionExists(ctx context.Context, transactionID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/audit_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockAuditUseCase is a mock of AuditUseCase interface.
+type MockAuditUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditUseCaseMockRecorder
+}
+
+// MockAuditUseCaseMockRecorder is the mock recorder for MockAuditUseCase.
+type MockAuditUseCaseMockRecorder struct {
+	mock *MockAuditUseCase
+}
+
+// NewMockAuditUseCase creates a new mock instance.
+func NewMockAuditUseCase(ctrl *gomock.Controller) *MockAuditUseCase {
+	mock := &MockAuditUseCase{ctrl: ctrl}
+	mock.recorder = &MockAuditUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditUseCase) EXPECT() *MockAuditUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ExportEntries mocks base method.
+func (m *MockAuditUseCase) ExportEntries(ctx context.Context, adminUsername string, filter domain.AuditFilter, format string) (*domain.AuditExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEntries", ctx, adminUsername, filter, format)
+	ret0, _ := ret[0].(*domain.AuditExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEntries indicates an expected call of ExportEntries.
+func (mr *MockAuditUseCaseMockRecorder) ExportEntries(ctx, adminUsername, filter, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEntries", reflect.TypeOf((*MockAuditUseCase)(nil).ExportEntries), ctx, adminUsername, filter, format)
+}
+
+// ListAccountActivity mocks base method.
+func (m *MockAuditUseCase) ListAccountActivity(ctx context.Context, username string) (*domain.AccountActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountActivity", ctx, username)
+	ret0, _ := ret[0].(*domain.AccountActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountActivity indicates an expected call of ListAccountActivity.
+func (mr *MockAuditUseCaseMockRecorder) ListAccountActivity(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountActivity", reflect.TypeOf((*MockAuditUseCase)(nil).ListAccountActivity), ctx, username)
+}
+
+// ListEntries mocks base method.
+func (m *MockAuditUseCase) ListEntries(ctx context.Context, adminUsername string, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, adminUsername, filter)
+	ret0, _ := ret[0].([]domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockAuditUseCaseMockRecorder) ListEntries(ctx, adminUsername, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockAuditUseCase)(nil).ListEntries), ctx, adminUsername, filter)
+}
+
+// RecordAction mocks base method.
+func (m *MockAuditUseCase) RecordAction(ctx context.Context, entry *domain.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAction", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAction indicates an expected call of RecordAction.
+func (mr *MockAuditUseCaseMockRecorder) RecordAction(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAction", reflect.TypeOf((*MockAuditUseCase)(nil).RecordAction), ctx, entry)
+}
+
+// RecordLogin mocks base method.
+func (m *MockAuditUseCase) RecordLogin(ctx context.Context, username, clientIP string) (*domain.LoginEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLogin", ctx, username, clientIP)
+	ret0, _ := ret[0].(*domain.LoginEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordLogin indicates an expected call of RecordLogin.
+func (mr *MockAuditUseCaseMockRecorder) RecordLogin(ctx, username, clientIP interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLogin", reflect.TypeOf((*MockAuditUseCase)(nil).RecordLogin), ctx, username, clientIP)
+}
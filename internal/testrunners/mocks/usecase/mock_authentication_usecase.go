@@ -35,19 +35,94 @@ func (m *MockAuthenticationUseCase) EXPECT() *MockAuthenticationUseCaseMockRecor
 	return m.recorder
 }
 
+// BeginSSOLogin mocks base method.
+func (m *MockAuthenticationUseCase) BeginSSOLogin(ctx context.Context) (*domain.SSOAuthRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginSSOLogin", ctx)
+	ret0, _ := ret[0].(*domain.SSOAuthRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginSSOLogin indicates an expected call of BeginSSOLogin.
+func (mr *MockAuthenticationUseCaseMockRecorder) BeginSSOLogin(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginSSOLogin", reflect.TypeOf((*MockAuthenticationUseCase)(nil).BeginSSOLogin), ctx)
+}
+
+// BindLoginConnection mocks base method.
+func (m *MockAuthenticationUseCase) BindLoginConnection(ctx context.Context, profileName, username, password string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindLoginConnection", ctx, profileName, username, password)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindLoginConnection indicates an expected call of BindLoginConnection.
+func (mr *MockAuthenticationUseCaseMockRecorder) BindLoginConnection(ctx, profileName, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindLoginConnection", reflect.TypeOf((*MockAuthenticationUseCase)(nil).BindLoginConnection), ctx, profileName, username, password)
+}
+
+// BindSessionConnection mocks base method.
+func (m *MockAuthenticationUseCase) BindSessionConnection(ctx context.Context, session *domain.Session) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindSessionConnection", ctx, session)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BindSessionConnection indicates an expected call of BindSessionConnection.
+func (mr *MockAuthenticationUseCaseMockRecorder) BindSessionConnection(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindSessionConnection", reflect.TypeOf((*MockAuthenticationUseCase)(nil).BindSessionConnection), ctx, session)
+}
+
+// CompleteSSOLogin mocks base method.
+func (m *MockAuthenticationUseCase) CompleteSSOLogin(ctx context.Context, code, nonce string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSSOLogin", ctx, code, nonce)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSSOLogin indicates an expected call of CompleteSSOLogin.
+func (mr *MockAuthenticationUseCaseMockRecorder) CompleteSSOLogin(ctx, code, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSSOLogin", reflect.TypeOf((*MockAuthenticationUseCase)(nil).CompleteSSOLogin), ctx, code, nonce)
+}
+
 // CreateSession mocks base method.
-func (m *MockAuthenticationUseCase) CreateSession(ctx context.Context, username, password, database, schema, table string) (*domain.Session, error) {
+func (m *MockAuthenticationUseCase) CreateSession(ctx context.Context, profileName, username, password, database, schema, table string) (*domain.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, username, password, database, schema, table)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, profileName, username, password, database, schema, table)
 	ret0, _ := ret[0].(*domain.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockAuthenticationUseCaseMockRecorder) CreateSession(ctx, username, password, database, schema, table interface{}) *gomock.Call {
+func (mr *MockAuthenticationUseCaseMockRecorder) CreateSession(ctx, profileName, username, password, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuthenticationUseCase)(nil).CreateSession), ctx, profileName, username, password, database, schema, table)
+}
+
+// ExpireIdleSessions mocks base method.
+func (m *MockAuthenticationUseCase) ExpireIdleSessions(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireIdleSessions", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireIdleSessions indicates an expected call of ExpireIdleSessions.
+func (mr *MockAuthenticationUseCaseMockRecorder) ExpireIdleSessions(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuthenticationUseCase)(nil).CreateSession), ctx, username, password, database, schema, table)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireIdleSessions", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ExpireIdleSessions), ctx)
 }
 
 // GetFirstAccessibleDatabase mocks base method.
@@ -140,6 +215,21 @@ func (mr *MockAuthenticationUseCaseMockRecorder) IsUserAuthenticated(ctx, sessio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserAuthenticated", reflect.TypeOf((*MockAuthenticationUseCase)(nil).IsUserAuthenticated), ctx, sessionID)
 }
 
+// ListConnectionProfiles mocks base method.
+func (m *MockAuthenticationUseCase) ListConnectionProfiles(ctx context.Context) ([]domain.ConnectionProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectionProfiles", ctx)
+	ret0, _ := ret[0].([]domain.ConnectionProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectionProfiles indicates an expected call of ListConnectionProfiles.
+func (mr *MockAuthenticationUseCaseMockRecorder) ListConnectionProfiles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectionProfiles", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ListConnectionProfiles), ctx)
+}
+
 // Login mocks base method.
 func (m *MockAuthenticationUseCase) Login(ctx context.Context, req domain.LoginRequest) (*domain.LoginResponse, error) {
 	m.ctrl.T.Helper()
@@ -170,33 +260,33 @@ func (mr *MockAuthenticationUseCaseMockRecorder) Logout(ctx, sessionID interface
 }
 
 // ProbeConnection mocks base method.
-func (m *MockAuthenticationUseCase) ProbeConnection(ctx context.Context, username, password string) (bool, error) {
+func (m *MockAuthenticationUseCase) ProbeConnection(ctx context.Context, profileName, username, password string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProbeConnection", ctx, username, password)
+	ret := m.ctrl.Call(m, "ProbeConnection", ctx, profileName, username, password)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ProbeConnection indicates an expected call of ProbeConnection.
-func (mr *MockAuthenticationUseCaseMockRecorder) ProbeConnection(ctx, username, password interface{}) *gomock.Call {
+func (mr *MockAuthenticationUseCaseMockRecorder) ProbeConnection(ctx, profileName, username, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProbeConnection", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ProbeConnection), ctx, username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProbeConnection", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ProbeConnection), ctx, profileName, username, password)
 }
 
 // ReAuthenticateWithPassword mocks base method.
-func (m *MockAuthenticationUseCase) ReAuthenticateWithPassword(ctx context.Context, username, encryptedPassword string) (bool, error) {
+func (m *MockAuthenticationUseCase) ReAuthenticateWithPassword(ctx context.Context, profileName, username, encryptedPassword string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReAuthenticateWithPassword", ctx, username, encryptedPassword)
+	ret := m.ctrl.Call(m, "ReAuthenticateWithPassword", ctx, profileName, username, encryptedPassword)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReAuthenticateWithPassword indicates an expected call of ReAuthenticateWithPassword.
-func (mr *MockAuthenticationUseCaseMockRecorder) ReAuthenticateWithPassword(ctx, username, encryptedPassword interface{}) *gomock.Call {
+func (mr *MockAuthenticationUseCaseMockRecorder) ReAuthenticateWithPassword(ctx, profileName, username, encryptedPassword interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReAuthenticateWithPassword", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ReAuthenticateWithPassword), ctx, username, encryptedPassword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReAuthenticateWithPassword", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ReAuthenticateWithPassword), ctx, profileName, username, encryptedPassword)
 }
 
 // RefreshSession mocks base method.
@@ -214,6 +304,65 @@ func (mr *MockAuthenticationUseCaseMockRecorder) RefreshSession(ctx, sessionID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSession", reflect.TypeOf((*MockAuthenticationUseCase)(nil).RefreshSession), ctx, sessionID)
 }
 
+// ResolveLoginRole mocks base method.
+func (m *MockAuthenticationUseCase) ResolveLoginRole(ctx context.Context, username, password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveLoginRole", ctx, username, password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveLoginRole indicates an expected call of ResolveLoginRole.
+func (mr *MockAuthenticationUseCaseMockRecorder) ResolveLoginRole(ctx, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveLoginRole", reflect.TypeOf((*MockAuthenticationUseCase)(nil).ResolveLoginRole), ctx, username, password)
+}
+
+// SetSessionSearchPath mocks base method.
+func (m *MockAuthenticationUseCase) SetSessionSearchPath(ctx context.Context, sessionID string, schemas []string) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSessionSearchPath", ctx, sessionID, schemas)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSessionSearchPath indicates an expected call of SetSessionSearchPath.
+func (mr *MockAuthenticationUseCaseMockRecorder) SetSessionSearchPath(ctx, sessionID, schemas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionSearchPath", reflect.TypeOf((*MockAuthenticationUseCase)(nil).SetSessionSearchPath), ctx, sessionID, schemas)
+}
+
+// SetSessionSettings mocks base method.
+func (m *MockAuthenticationUseCase) SetSessionSettings(ctx context.Context, sessionID string, settings domain.SessionSettings) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSessionSettings", ctx, sessionID, settings)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSessionSettings indicates an expected call of SetSessionSettings.
+func (mr *MockAuthenticationUseCaseMockRecorder) SetSessionSettings(ctx, sessionID, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionSettings", reflect.TypeOf((*MockAuthenticationUseCase)(nil).SetSessionSettings), ctx, sessionID, settings)
+}
+
+// TouchSession mocks base method.
+func (m *MockAuthenticationUseCase) TouchSession(ctx context.Context, sessionID, clientIP string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, sessionID, clientIP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockAuthenticationUseCaseMockRecorder) TouchSession(ctx, sessionID, clientIP interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockAuthenticationUseCase)(nil).TouchSession), ctx, sessionID, clientIP)
+}
+
 // ValidateLoginForm mocks base method.
 func (m *MockAuthenticationUseCase) ValidateLoginForm(ctx context.Context, req domain.LoginRequest) ([]domain.ValidationError, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/dashboard_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockDashboardUseCase is a mock of DashboardUseCase interface.
+type MockDashboardUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDashboardUseCaseMockRecorder
+}
+
+// MockDashboardUseCaseMockRecorder is the mock recorder for MockDashboardUseCase.
+type MockDashboardUseCaseMockRecorder struct {
+	mock *MockDashboardUseCase
+}
+
+// NewMockDashboardUseCase creates a new mock instance.
+func NewMockDashboardUseCase(ctrl *gomock.Controller) *MockDashboardUseCase {
+	mock := &MockDashboardUseCase{ctrl: ctrl}
+	mock.recorder = &MockDashboardUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDashboardUseCase) EXPECT() *MockDashboardUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListDashboardTiles mocks base method.
+func (m *MockDashboardUseCase) ListDashboardTiles(ctx context.Context, username string) ([]domain.DashboardTile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDashboardTiles", ctx, username)
+	ret0, _ := ret[0].([]domain.DashboardTile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDashboardTiles indicates an expected call of ListDashboardTiles.
+func (mr *MockDashboardUseCaseMockRecorder) ListDashboardTiles(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDashboardTiles", reflect.TypeOf((*MockDashboardUseCase)(nil).ListDashboardTiles), ctx, username)
+}
+
+// PinQueryResult mocks base method.
+func (m *MockDashboardUseCase) PinQueryResult(ctx context.Context, username string, tile domain.DashboardTile) (*domain.DashboardTile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinQueryResult", ctx, username, tile)
+	ret0, _ := ret[0].(*domain.DashboardTile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinQueryResult indicates an expected call of PinQueryResult.
+func (mr *MockDashboardUseCaseMockRecorder) PinQueryResult(ctx, username, tile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinQueryResult", reflect.TypeOf((*MockDashboardUseCase)(nil).PinQueryResult), ctx, username, tile)
+}
+
+// RefreshDashboardTile mocks base method.
+func (m *MockDashboardUseCase) RefreshDashboardTile(ctx context.Context, username, tileID string) (*domain.DashboardTileResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDashboardTile", ctx, username, tileID)
+	ret0, _ := ret[0].(*domain.DashboardTileResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshDashboardTile indicates an expected call of RefreshDashboardTile.
+func (mr *MockDashboardUseCaseMockRecorder) RefreshDashboardTile(ctx, username, tileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDashboardTile", reflect.TypeOf((*MockDashboardUseCase)(nil).RefreshDashboardTile), ctx, username, tileID)
+}
+
+// UnpinDashboardTile mocks base method.
+func (m *MockDashboardUseCase) UnpinDashboardTile(ctx context.Context, username, tileID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinDashboardTile", ctx, username, tileID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinDashboardTile indicates an expected call of UnpinDashboardTile.
+func (mr *MockDashboardUseCaseMockRecorder) UnpinDashboardTile(ctx, username, tileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinDashboardTile", reflect.TypeOf((*MockDashboardUseCase)(nil).UnpinDashboardTile), ctx, username, tileID)
+}
@@ -6,7 +6,9 @@ package mockusecase
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/kamil5b/lumen-pg/internal/domain"
@@ -35,6 +37,79 @@ func (m *MockDataViewUseCase) EXPECT() *MockDataViewUseCaseMockRecorder {
 	return m.recorder
 }
 
+// BuildTableReport mocks base method.
+func (m *MockDataViewUseCase) BuildTableReport(ctx context.Context, username string, params domain.TableDataParams, title string) (*domain.ResultReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildTableReport", ctx, username, params, title)
+	ret0, _ := ret[0].(*domain.ResultReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildTableReport indicates an expected call of BuildTableReport.
+func (mr *MockDataViewUseCaseMockRecorder) BuildTableReport(ctx, username, params, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildTableReport", reflect.TypeOf((*MockDataViewUseCase)(nil).BuildTableReport), ctx, username, params, title)
+}
+
+// CheckReferentialIntegrity mocks base method.
+func (m *MockDataViewUseCase) CheckReferentialIntegrity(ctx context.Context, username, database, schema, table string, references []domain.ForeignKeyMetadata, limit int) (*domain.ReferentialIntegrityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReferentialIntegrity", ctx, username, database, schema, table, references, limit)
+	ret0, _ := ret[0].(*domain.ReferentialIntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckReferentialIntegrity indicates an expected call of CheckReferentialIntegrity.
+func (mr *MockDataViewUseCaseMockRecorder) CheckReferentialIntegrity(ctx, username, database, schema, table, references, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReferentialIntegrity", reflect.TypeOf((*MockDataViewUseCase)(nil).CheckReferentialIntegrity), ctx, username, database, schema, table, references, limit)
+}
+
+// CompareTableSnapshot mocks base method.
+func (m *MockDataViewUseCase) CompareTableSnapshot(ctx context.Context, username, snapshotID string) (*domain.TableSnapshotDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareTableSnapshot", ctx, username, snapshotID)
+	ret0, _ := ret[0].(*domain.TableSnapshotDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareTableSnapshot indicates an expected call of CompareTableSnapshot.
+func (mr *MockDataViewUseCaseMockRecorder) CompareTableSnapshot(ctx, username, snapshotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareTableSnapshot", reflect.TypeOf((*MockDataViewUseCase)(nil).CompareTableSnapshot), ctx, username, snapshotID)
+}
+
+// DeleteTableSnapshot mocks base method.
+func (m *MockDataViewUseCase) DeleteTableSnapshot(ctx context.Context, username, snapshotID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTableSnapshot", ctx, username, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTableSnapshot indicates an expected call of DeleteTableSnapshot.
+func (mr *MockDataViewUseCaseMockRecorder) DeleteTableSnapshot(ctx, username, snapshotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTableSnapshot", reflect.TypeOf((*MockDataViewUseCase)(nil).DeleteTableSnapshot), ctx, username, snapshotID)
+}
+
+// ExportTableData mocks base method.
+func (m *MockDataViewUseCase) ExportTableData(ctx context.Context, username string, params domain.TableDataParams, format string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTableData", ctx, username, params, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportTableData indicates an expected call of ExportTableData.
+func (mr *MockDataViewUseCaseMockRecorder) ExportTableData(ctx, username, params, format, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).ExportTableData), ctx, username, params, format, w)
+}
+
 // FilterTableData mocks base method.
 func (m *MockDataViewUseCase) FilterTableData(ctx context.Context, username, database, schema, table, whereClause string, offset, limit int) (*domain.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +125,66 @@ func (mr *MockDataViewUseCaseMockRecorder) FilterTableData(ctx, username, databa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).FilterTableData), ctx, username, database, schema, table, whereClause, offset, limit)
 }
 
+// FilterTableDataByPredicates mocks base method.
+func (m *MockDataViewUseCase) FilterTableDataByPredicates(ctx context.Context, username, database, schema, table string, filter domain.FilterPredicate, offset, limit int) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterTableDataByPredicates", ctx, username, database, schema, table, filter, offset, limit)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterTableDataByPredicates indicates an expected call of FilterTableDataByPredicates.
+func (mr *MockDataViewUseCaseMockRecorder) FilterTableDataByPredicates(ctx, username, database, schema, table, filter, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterTableDataByPredicates", reflect.TypeOf((*MockDataViewUseCase)(nil).FilterTableDataByPredicates), ctx, username, database, schema, table, filter, offset, limit)
+}
+
+// FindDuplicateRows mocks base method.
+func (m *MockDataViewUseCase) FindDuplicateRows(ctx context.Context, username string, params domain.DuplicateParams) (*domain.DuplicateReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicateRows", ctx, username, params)
+	ret0, _ := ret[0].(*domain.DuplicateReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicateRows indicates an expected call of FindDuplicateRows.
+func (mr *MockDataViewUseCaseMockRecorder) FindDuplicateRows(ctx, username, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicateRows", reflect.TypeOf((*MockDataViewUseCase)(nil).FindDuplicateRows), ctx, username, params)
+}
+
+// FreezeView mocks base method.
+func (m *MockDataViewUseCase) FreezeView(ctx context.Context, snapshotKey string) (*domain.SnapshotStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeView", ctx, snapshotKey)
+	ret0, _ := ret[0].(*domain.SnapshotStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeView indicates an expected call of FreezeView.
+func (mr *MockDataViewUseCaseMockRecorder) FreezeView(ctx, snapshotKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeView", reflect.TypeOf((*MockDataViewUseCase)(nil).FreezeView), ctx, snapshotKey)
+}
+
+// GetCellContent mocks base method.
+func (m *MockDataViewUseCase) GetCellContent(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.CellContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCellContent", ctx, username, database, schema, table, column, pkValues)
+	ret0, _ := ret[0].(*domain.CellContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCellContent indicates an expected call of GetCellContent.
+func (mr *MockDataViewUseCaseMockRecorder) GetCellContent(ctx, username, database, schema, table, column, pkValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellContent", reflect.TypeOf((*MockDataViewUseCase)(nil).GetCellContent), ctx, username, database, schema, table, column, pkValues)
+}
+
 // GetChildTableReferences mocks base method.
 func (m *MockDataViewUseCase) GetChildTableReferences(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) ([]domain.ChildTableReference, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +215,51 @@ func (mr *MockDataViewUseCaseMockRecorder) GetChildTableRowCount(ctx, username,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildTableRowCount", reflect.TypeOf((*MockDataViewUseCase)(nil).GetChildTableRowCount), ctx, username, database, schema, childTable, parentTable, fkColumn, pkValue)
 }
 
+// GetColumnAggregate mocks base method.
+func (m *MockDataViewUseCase) GetColumnAggregate(ctx context.Context, username, database, schema, table, column, whereClause string) (*domain.ColumnAggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnAggregate", ctx, username, database, schema, table, column, whereClause)
+	ret0, _ := ret[0].(*domain.ColumnAggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnAggregate indicates an expected call of GetColumnAggregate.
+func (mr *MockDataViewUseCaseMockRecorder) GetColumnAggregate(ctx, username, database, schema, table, column, whereClause interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnAggregate", reflect.TypeOf((*MockDataViewUseCase)(nil).GetColumnAggregate), ctx, username, database, schema, table, column, whereClause)
+}
+
+// GetColumnHistogram mocks base method.
+func (m *MockDataViewUseCase) GetColumnHistogram(ctx context.Context, username string, params domain.HistogramParams) (*domain.HistogramResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetColumnHistogram", ctx, username, params)
+	ret0, _ := ret[0].(*domain.HistogramResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetColumnHistogram indicates an expected call of GetColumnHistogram.
+func (mr *MockDataViewUseCaseMockRecorder) GetColumnHistogram(ctx, username, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumnHistogram", reflect.TypeOf((*MockDataViewUseCase)(nil).GetColumnHistogram), ctx, username, params)
+}
+
+// GetDataQualityReport mocks base method.
+func (m *MockDataViewUseCase) GetDataQualityReport(ctx context.Context, username, database, schema, table string, sampleLimit int) (*domain.DataQualityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataQualityReport", ctx, username, database, schema, table, sampleLimit)
+	ret0, _ := ret[0].(*domain.DataQualityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataQualityReport indicates an expected call of GetDataQualityReport.
+func (mr *MockDataViewUseCaseMockRecorder) GetDataQualityReport(ctx, username, database, schema, table, sampleLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataQualityReport", reflect.TypeOf((*MockDataViewUseCase)(nil).GetDataQualityReport), ctx, username, database, schema, table, sampleLimit)
+}
+
 // GetForeignKeyInfo mocks base method.
 func (m *MockDataViewUseCase) GetForeignKeyInfo(ctx context.Context, username, database, schema, table string) ([]domain.ForeignKeyInfo, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +275,36 @@ func (mr *MockDataViewUseCaseMockRecorder) GetForeignKeyInfo(ctx, username, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForeignKeyInfo", reflect.TypeOf((*MockDataViewUseCase)(nil).GetForeignKeyInfo), ctx, username, database, schema, table)
 }
 
+// GetHistoryConfig mocks base method.
+func (m *MockDataViewUseCase) GetHistoryConfig(ctx context.Context, username, database, schema, table string) (*domain.HistoryTableConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryConfig", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.HistoryTableConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryConfig indicates an expected call of GetHistoryConfig.
+func (mr *MockDataViewUseCaseMockRecorder) GetHistoryConfig(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryConfig", reflect.TypeOf((*MockDataViewUseCase)(nil).GetHistoryConfig), ctx, username, database, schema, table)
+}
+
+// GetJSONCell mocks base method.
+func (m *MockDataViewUseCase) GetJSONCell(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.JSONCellDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJSONCell", ctx, username, database, schema, table, column, pkValues)
+	ret0, _ := ret[0].(*domain.JSONCellDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJSONCell indicates an expected call of GetJSONCell.
+func (mr *MockDataViewUseCaseMockRecorder) GetJSONCell(ctx, username, database, schema, table, column, pkValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJSONCell", reflect.TypeOf((*MockDataViewUseCase)(nil).GetJSONCell), ctx, username, database, schema, table, column, pkValues)
+}
+
 // GetPrimaryKeyInfo mocks base method.
 func (m *MockDataViewUseCase) GetPrimaryKeyInfo(ctx context.Context, username, database, schema, table string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +320,66 @@ func (mr *MockDataViewUseCaseMockRecorder) GetPrimaryKeyInfo(ctx, username, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryKeyInfo", reflect.TypeOf((*MockDataViewUseCase)(nil).GetPrimaryKeyInfo), ctx, username, database, schema, table)
 }
 
+// GetRowDetail mocks base method.
+func (m *MockDataViewUseCase) GetRowDetail(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) (*domain.RowDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowDetail", ctx, username, database, schema, table, pkValues)
+	ret0, _ := ret[0].(*domain.RowDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowDetail indicates an expected call of GetRowDetail.
+func (mr *MockDataViewUseCaseMockRecorder) GetRowDetail(ctx, username, database, schema, table, pkValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowDetail", reflect.TypeOf((*MockDataViewUseCase)(nil).GetRowDetail), ctx, username, database, schema, table, pkValues)
+}
+
+// GetRowTimeline mocks base method.
+func (m *MockDataViewUseCase) GetRowTimeline(ctx context.Context, username, database, schema, table string, pkValues map[string]interface{}) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRowTimeline", ctx, username, database, schema, table, pkValues)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRowTimeline indicates an expected call of GetRowTimeline.
+func (mr *MockDataViewUseCaseMockRecorder) GetRowTimeline(ctx, username, database, schema, table, pkValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRowTimeline", reflect.TypeOf((*MockDataViewUseCase)(nil).GetRowTimeline), ctx, username, database, schema, table, pkValues)
+}
+
+// GetSnapshotStatus mocks base method.
+func (m *MockDataViewUseCase) GetSnapshotStatus(ctx context.Context, snapshotKey string) (*domain.SnapshotStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotStatus", ctx, snapshotKey)
+	ret0, _ := ret[0].(*domain.SnapshotStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotStatus indicates an expected call of GetSnapshotStatus.
+func (mr *MockDataViewUseCaseMockRecorder) GetSnapshotStatus(ctx, snapshotKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotStatus", reflect.TypeOf((*MockDataViewUseCase)(nil).GetSnapshotStatus), ctx, snapshotKey)
+}
+
+// GetSpatialCell mocks base method.
+func (m *MockDataViewUseCase) GetSpatialCell(ctx context.Context, username, database, schema, table, column string, pkValues map[string]interface{}) (*domain.SpatialCellDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpatialCell", ctx, username, database, schema, table, column, pkValues)
+	ret0, _ := ret[0].(*domain.SpatialCellDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpatialCell indicates an expected call of GetSpatialCell.
+func (mr *MockDataViewUseCaseMockRecorder) GetSpatialCell(ctx, username, database, schema, table, column, pkValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpatialCell", reflect.TypeOf((*MockDataViewUseCase)(nil).GetSpatialCell), ctx, username, database, schema, table, column, pkValues)
+}
+
 // GetTableDataWithCursorPagination mocks base method.
 func (m *MockDataViewUseCase) GetTableDataWithCursorPagination(ctx context.Context, username, database, schema, table, cursor string, limit int) (*domain.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +425,51 @@ func (mr *MockDataViewUseCaseMockRecorder) GetTableRowCountWithFilter(ctx, usern
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableRowCountWithFilter", reflect.TypeOf((*MockDataViewUseCase)(nil).GetTableRowCountWithFilter), ctx, username, database, schema, table, whereClause)
 }
 
+// GetTableSizeEstimates mocks base method.
+func (m *MockDataViewUseCase) GetTableSizeEstimates(ctx context.Context, username, database, schema string, tables []string) ([]domain.TableSizeEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableSizeEstimates", ctx, username, database, schema, tables)
+	ret0, _ := ret[0].([]domain.TableSizeEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableSizeEstimates indicates an expected call of GetTableSizeEstimates.
+func (mr *MockDataViewUseCaseMockRecorder) GetTableSizeEstimates(ctx, username, database, schema, tables interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableSizeEstimates", reflect.TypeOf((*MockDataViewUseCase)(nil).GetTableSizeEstimates), ctx, username, database, schema, tables)
+}
+
+// GetTableStatistics mocks base method.
+func (m *MockDataViewUseCase) GetTableStatistics(ctx context.Context, username, database, schema, table string) (*domain.TableStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableStatistics", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableStatistics indicates an expected call of GetTableStatistics.
+func (mr *MockDataViewUseCaseMockRecorder) GetTableStatistics(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableStatistics", reflect.TypeOf((*MockDataViewUseCase)(nil).GetTableStatistics), ctx, username, database, schema, table)
+}
+
+// GroupTableData mocks base method.
+func (m *MockDataViewUseCase) GroupTableData(ctx context.Context, username string, params domain.GroupByParams) (*domain.GroupByResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupTableData", ctx, username, params)
+	ret0, _ := ret[0].(*domain.GroupByResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupTableData indicates an expected call of GroupTableData.
+func (mr *MockDataViewUseCaseMockRecorder) GroupTableData(ctx, username, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).GroupTableData), ctx, username, params)
+}
+
 // IsTableReadOnly mocks base method.
 func (m *MockDataViewUseCase) IsTableReadOnly(ctx context.Context, username, database, schema, table string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +485,36 @@ func (mr *MockDataViewUseCaseMockRecorder) IsTableReadOnly(ctx, username, databa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableReadOnly", reflect.TypeOf((*MockDataViewUseCase)(nil).IsTableReadOnly), ctx, username, database, schema, table)
 }
 
+// ListTableSnapshots mocks base method.
+func (m *MockDataViewUseCase) ListTableSnapshots(ctx context.Context, username string) ([]domain.TableSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableSnapshots", ctx, username)
+	ret0, _ := ret[0].([]domain.TableSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableSnapshots indicates an expected call of ListTableSnapshots.
+func (mr *MockDataViewUseCaseMockRecorder) ListTableSnapshots(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableSnapshots", reflect.TypeOf((*MockDataViewUseCase)(nil).ListTableSnapshots), ctx, username)
+}
+
+// LoadJoinedTableData mocks base method.
+func (m *MockDataViewUseCase) LoadJoinedTableData(ctx context.Context, username string, params domain.JoinViewParams) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadJoinedTableData", ctx, username, params)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadJoinedTableData indicates an expected call of LoadJoinedTableData.
+func (mr *MockDataViewUseCaseMockRecorder) LoadJoinedTableData(ctx, username, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadJoinedTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).LoadJoinedTableData), ctx, username, params)
+}
+
 // LoadTableData mocks base method.
 func (m *MockDataViewUseCase) LoadTableData(ctx context.Context, username string, params domain.TableDataParams) (*domain.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +530,36 @@ func (mr *MockDataViewUseCaseMockRecorder) LoadTableData(ctx, username, params i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).LoadTableData), ctx, username, params)
 }
 
+// LoadTableDataAsOf mocks base method.
+func (m *MockDataViewUseCase) LoadTableDataAsOf(ctx context.Context, username, database, schema, table string, asOf time.Time, offset, limit int) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadTableDataAsOf", ctx, username, database, schema, table, asOf, offset, limit)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadTableDataAsOf indicates an expected call of LoadTableDataAsOf.
+func (mr *MockDataViewUseCaseMockRecorder) LoadTableDataAsOf(ctx, username, database, schema, table, asOf, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTableDataAsOf", reflect.TypeOf((*MockDataViewUseCase)(nil).LoadTableDataAsOf), ctx, username, database, schema, table, asOf, offset, limit)
+}
+
+// LookupForeignKeyValues mocks base method.
+func (m *MockDataViewUseCase) LookupForeignKeyValues(ctx context.Context, username, database, schema, table, column, term string) (*domain.ForeignKeyLookup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupForeignKeyValues", ctx, username, database, schema, table, column, term)
+	ret0, _ := ret[0].(*domain.ForeignKeyLookup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupForeignKeyValues indicates an expected call of LookupForeignKeyValues.
+func (mr *MockDataViewUseCaseMockRecorder) LookupForeignKeyValues(ctx, username, database, schema, table, column, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupForeignKeyValues", reflect.TypeOf((*MockDataViewUseCase)(nil).LookupForeignKeyValues), ctx, username, database, schema, table, column, term)
+}
+
 // NavigateToChildRows mocks base method.
 func (m *MockDataViewUseCase) NavigateToChildRows(ctx context.Context, username, database, schema, childTable, parentTable, fkColumn, pkColumn, pkValue string) (*domain.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +590,50 @@ func (mr *MockDataViewUseCaseMockRecorder) NavigateToParentRow(ctx, username, da
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NavigateToParentRow", reflect.TypeOf((*MockDataViewUseCase)(nil).NavigateToParentRow), ctx, username, database, schema, table, columnName, value)
 }
 
+// ReleaseSnapshot mocks base method.
+func (m *MockDataViewUseCase) ReleaseSnapshot(ctx context.Context, snapshotKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSnapshot", ctx, snapshotKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSnapshot indicates an expected call of ReleaseSnapshot.
+func (mr *MockDataViewUseCaseMockRecorder) ReleaseSnapshot(ctx, snapshotKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSnapshot", reflect.TypeOf((*MockDataViewUseCase)(nil).ReleaseSnapshot), ctx, snapshotKey)
+}
+
+// SearchObjects mocks base method.
+func (m *MockDataViewUseCase) SearchObjects(ctx context.Context, username, term string) ([]domain.ObjectSearchMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchObjects", ctx, username, term)
+	ret0, _ := ret[0].([]domain.ObjectSearchMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchObjects indicates an expected call of SearchObjects.
+func (mr *MockDataViewUseCaseMockRecorder) SearchObjects(ctx, username, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchObjects", reflect.TypeOf((*MockDataViewUseCase)(nil).SearchObjects), ctx, username, term)
+}
+
+// SearchTableData mocks base method.
+func (m *MockDataViewUseCase) SearchTableData(ctx context.Context, username, database, schema, table, term string) (*domain.TableSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTableData", ctx, username, database, schema, table, term)
+	ret0, _ := ret[0].(*domain.TableSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTableData indicates an expected call of SearchTableData.
+func (mr *MockDataViewUseCaseMockRecorder) SearchTableData(ctx, username, database, schema, table, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).SearchTableData), ctx, username, database, schema, table, term)
+}
+
 // SortTableData mocks base method.
 func (m *MockDataViewUseCase) SortTableData(ctx context.Context, username, database, schema, table, orderBy, orderDir string, offset, limit int) (*domain.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +649,36 @@ func (mr *MockDataViewUseCaseMockRecorder) SortTableData(ctx, username, database
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SortTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).SortTableData), ctx, username, database, schema, table, orderBy, orderDir, offset, limit)
 }
 
+// SuggestJoinConditions mocks base method.
+func (m *MockDataViewUseCase) SuggestJoinConditions(ctx context.Context, username, database, leftSchema, leftTable, rightSchema, rightTable string) ([]domain.JoinCondition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestJoinConditions", ctx, username, database, leftSchema, leftTable, rightSchema, rightTable)
+	ret0, _ := ret[0].([]domain.JoinCondition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestJoinConditions indicates an expected call of SuggestJoinConditions.
+func (mr *MockDataViewUseCaseMockRecorder) SuggestJoinConditions(ctx, username, database, leftSchema, leftTable, rightSchema, rightTable interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestJoinConditions", reflect.TypeOf((*MockDataViewUseCase)(nil).SuggestJoinConditions), ctx, username, database, leftSchema, leftTable, rightSchema, rightTable)
+}
+
+// TakeTableSnapshot mocks base method.
+func (m *MockDataViewUseCase) TakeTableSnapshot(ctx context.Context, username, database, schema, table string) (*domain.TableSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeTableSnapshot", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeTableSnapshot indicates an expected call of TakeTableSnapshot.
+func (mr *MockDataViewUseCaseMockRecorder) TakeTableSnapshot(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeTableSnapshot", reflect.TypeOf((*MockDataViewUseCase)(nil).TakeTableSnapshot), ctx, username, database, schema, table)
+}
+
 // ValidateWhereClause mocks base method.
 func (m *MockDataViewUseCase) ValidateWhereClause(ctx context.Context, whereClause string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -244,3 +693,31 @@ func (mr *MockDataViewUseCaseMockRecorder) ValidateWhereClause(ctx, whereClause
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateWhereClause", reflect.TypeOf((*MockDataViewUseCase)(nil).ValidateWhereClause), ctx, whereClause)
 }
+
+// WatchRows mocks base method.
+func (m *MockDataViewUseCase) WatchRows(ctx context.Context, username string, params domain.RowWatchParams, onEvent func(*domain.RowWatchEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRows", ctx, username, params, onEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchRows indicates an expected call of WatchRows.
+func (mr *MockDataViewUseCaseMockRecorder) WatchRows(ctx, username, params, onEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRows", reflect.TypeOf((*MockDataViewUseCase)(nil).WatchRows), ctx, username, params, onEvent)
+}
+
+// WatchTableData mocks base method.
+func (m *MockDataViewUseCase) WatchTableData(ctx context.Context, username string, params domain.TableDataParams, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTableData", ctx, username, params, interval, onUpdate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTableData indicates an expected call of WatchTableData.
+func (mr *MockDataViewUseCaseMockRecorder) WatchTableData(ctx, username, params, interval, onUpdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTableData", reflect.TypeOf((*MockDataViewUseCase)(nil).WatchTableData), ctx, username, params, interval, onUpdate)
+}
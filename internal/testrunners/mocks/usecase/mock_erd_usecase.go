@@ -110,6 +110,21 @@ func (mr *MockERDUseCaseMockRecorder) GetRelationshipLines(ctx, username, databa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationshipLines", reflect.TypeOf((*MockERDUseCase)(nil).GetRelationshipLines), ctx, username, database, schema)
 }
 
+// GetSchemaGraph mocks base method.
+func (m *MockERDUseCase) GetSchemaGraph(ctx context.Context, username, database, schema, root string, depth int) (*domain.SchemaGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchemaGraph", ctx, username, database, schema, root, depth)
+	ret0, _ := ret[0].(*domain.SchemaGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchemaGraph indicates an expected call of GetSchemaGraph.
+func (mr *MockERDUseCaseMockRecorder) GetSchemaGraph(ctx, username, database, schema, root, depth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchemaGraph", reflect.TypeOf((*MockERDUseCase)(nil).GetSchemaGraph), ctx, username, database, schema, root, depth)
+}
+
 // GetTableBoxData mocks base method.
 func (m *MockERDUseCase) GetTableBoxData(ctx context.Context, username, database, schema, table string) (*domain.TableMetadata, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/function_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockFunctionUseCase is a mock of FunctionUseCase interface.
+type MockFunctionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFunctionUseCaseMockRecorder
+}
+
+// MockFunctionUseCaseMockRecorder is the mock recorder for MockFunctionUseCase.
+type MockFunctionUseCaseMockRecorder struct {
+	mock *MockFunctionUseCase
+}
+
+// NewMockFunctionUseCase creates a new mock instance.
+func NewMockFunctionUseCase(ctrl *gomock.Controller) *MockFunctionUseCase {
+	mock := &MockFunctionUseCase{ctrl: ctrl}
+	mock.recorder = &MockFunctionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFunctionUseCase) EXPECT() *MockFunctionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ExecuteFunction mocks base method.
+func (m *MockFunctionUseCase) ExecuteFunction(ctx context.Context, username string, call domain.FunctionCall, params domain.QueryParams) (*domain.FunctionCallResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteFunction", ctx, username, call, params)
+	ret0, _ := ret[0].(*domain.FunctionCallResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteFunction indicates an expected call of ExecuteFunction.
+func (mr *MockFunctionUseCaseMockRecorder) ExecuteFunction(ctx, username, call, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteFunction", reflect.TypeOf((*MockFunctionUseCase)(nil).ExecuteFunction), ctx, username, call, params)
+}
+
+// GetFunctionSource mocks base method.
+func (m *MockFunctionUseCase) GetFunctionSource(ctx context.Context, username, database, schema, name, arguments string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFunctionSource", ctx, username, database, schema, name, arguments)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunctionSource indicates an expected call of GetFunctionSource.
+func (mr *MockFunctionUseCaseMockRecorder) GetFunctionSource(ctx, username, database, schema, name, arguments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionSource", reflect.TypeOf((*MockFunctionUseCase)(nil).GetFunctionSource), ctx, username, database, schema, name, arguments)
+}
+
+// ListFunctions mocks base method.
+func (m *MockFunctionUseCase) ListFunctions(ctx context.Context, username, database, schema string) ([]domain.StoredFunction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFunctions", ctx, username, database, schema)
+	ret0, _ := ret[0].([]domain.StoredFunction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctions indicates an expected call of ListFunctions.
+func (mr *MockFunctionUseCaseMockRecorder) ListFunctions(ctx, username, database, schema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctions", reflect.TypeOf((*MockFunctionUseCase)(nil).ListFunctions), ctx, username, database, schema)
+}
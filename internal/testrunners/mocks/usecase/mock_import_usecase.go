@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/import_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockImportUseCase is a mock of ImportUseCase interface.
+type MockImportUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportUseCaseMockRecorder
+}
+
+// MockImportUseCaseMockRecorder is the mock recorder for MockImportUseCase.
+type MockImportUseCaseMockRecorder struct {
+	mock *MockImportUseCase
+}
+
+// NewMockImportUseCase creates a new mock instance.
+func NewMockImportUseCase(ctrl *gomock.Controller) *MockImportUseCase {
+	mock := &MockImportUseCase{ctrl: ctrl}
+	mock.recorder = &MockImportUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportUseCase) EXPECT() *MockImportUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ImportCSV mocks base method.
+func (m *MockImportUseCase) ImportCSV(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCSV", ctx, username, params, csvFile)
+	ret0, _ := ret[0].(*domain.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCSV indicates an expected call of ImportCSV.
+func (mr *MockImportUseCaseMockRecorder) ImportCSV(ctx, username, params, csvFile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCSV", reflect.TypeOf((*MockImportUseCase)(nil).ImportCSV), ctx, username, params, csvFile)
+}
+
+// PreviewCSVImport mocks base method.
+func (m *MockImportUseCase) PreviewCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewCSVImport", ctx, username, params, csvFile)
+	ret0, _ := ret[0].(*domain.ImportPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewCSVImport indicates an expected call of PreviewCSVImport.
+func (mr *MockImportUseCaseMockRecorder) PreviewCSVImport(ctx, username, params, csvFile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewCSVImport", reflect.TypeOf((*MockImportUseCase)(nil).PreviewCSVImport), ctx, username, params, csvFile)
+}
+
+// ValidateCSVImport mocks base method.
+func (m *MockImportUseCase) ValidateCSVImport(ctx context.Context, username string, params domain.ImportParams, csvFile io.Reader) (*domain.ImportValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCSVImport", ctx, username, params, csvFile)
+	ret0, _ := ret[0].(*domain.ImportValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateCSVImport indicates an expected call of ValidateCSVImport.
+func (mr *MockImportUseCaseMockRecorder) ValidateCSVImport(ctx, username, params, csvFile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCSVImport", reflect.TypeOf((*MockImportUseCase)(nil).ValidateCSVImport), ctx, username, params, csvFile)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/locks_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockLocksUseCase is a mock of LocksUseCase interface.
+type MockLocksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocksUseCaseMockRecorder
+}
+
+// MockLocksUseCaseMockRecorder is the mock recorder for MockLocksUseCase.
+type MockLocksUseCaseMockRecorder struct {
+	mock *MockLocksUseCase
+}
+
+// NewMockLocksUseCase creates a new mock instance.
+func NewMockLocksUseCase(ctrl *gomock.Controller) *MockLocksUseCase {
+	mock := &MockLocksUseCase{ctrl: ctrl}
+	mock.recorder = &MockLocksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocksUseCase) EXPECT() *MockLocksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetBlockingChains mocks base method.
+func (m *MockLocksUseCase) GetBlockingChains(ctx context.Context, username string) (*domain.LockReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockingChains", ctx, username)
+	ret0, _ := ret[0].(*domain.LockReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockingChains indicates an expected call of GetBlockingChains.
+func (mr *MockLocksUseCaseMockRecorder) GetBlockingChains(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockingChains", reflect.TypeOf((*MockLocksUseCase)(nil).GetBlockingChains), ctx, username)
+}
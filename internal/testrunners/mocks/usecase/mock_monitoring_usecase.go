@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/monitoring_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockMonitoringUseCase is a mock of MonitoringUseCase interface.
+type MockMonitoringUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonitoringUseCaseMockRecorder
+}
+
+// MockMonitoringUseCaseMockRecorder is the mock recorder for MockMonitoringUseCase.
+type MockMonitoringUseCaseMockRecorder struct {
+	mock *MockMonitoringUseCase
+}
+
+// NewMockMonitoringUseCase creates a new mock instance.
+func NewMockMonitoringUseCase(ctrl *gomock.Controller) *MockMonitoringUseCase {
+	mock := &MockMonitoringUseCase{ctrl: ctrl}
+	mock.recorder = &MockMonitoringUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonitoringUseCase) EXPECT() *MockMonitoringUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetActivity mocks base method.
+func (m *MockMonitoringUseCase) GetActivity(ctx context.Context, username string) (*domain.ActivitySnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, username)
+	ret0, _ := ret[0].(*domain.ActivitySnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockMonitoringUseCaseMockRecorder) GetActivity(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockMonitoringUseCase)(nil).GetActivity), ctx, username)
+}
+
+// GetServerDashboard mocks base method.
+func (m *MockMonitoringUseCase) GetServerDashboard(ctx context.Context, adminUsername string) (*domain.ServerDashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerDashboard", ctx, adminUsername)
+	ret0, _ := ret[0].(*domain.ServerDashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerDashboard indicates an expected call of GetServerDashboard.
+func (mr *MockMonitoringUseCaseMockRecorder) GetServerDashboard(ctx, adminUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerDashboard", reflect.TypeOf((*MockMonitoringUseCase)(nil).GetServerDashboard), ctx, adminUsername)
+}
+
+// ListSlowOperations mocks base method.
+func (m *MockMonitoringUseCase) ListSlowOperations(ctx context.Context, adminUsername string, limit int) ([]domain.SlowOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlowOperations", ctx, adminUsername, limit)
+	ret0, _ := ret[0].([]domain.SlowOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSlowOperations indicates an expected call of ListSlowOperations.
+func (mr *MockMonitoringUseCaseMockRecorder) ListSlowOperations(ctx, adminUsername, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlowOperations", reflect.TypeOf((*MockMonitoringUseCase)(nil).ListSlowOperations), ctx, adminUsername, limit)
+}
+
+// ListSlowQueries mocks base method.
+func (m *MockMonitoringUseCase) ListSlowQueries(ctx context.Context, adminUsername string, limit int) ([]domain.SlowQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlowQueries", ctx, adminUsername, limit)
+	ret0, _ := ret[0].([]domain.SlowQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSlowQueries indicates an expected call of ListSlowQueries.
+func (mr *MockMonitoringUseCaseMockRecorder) ListSlowQueries(ctx, adminUsername, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlowQueries", reflect.TypeOf((*MockMonitoringUseCase)(nil).ListSlowQueries), ctx, adminUsername, limit)
+}
+
+// TerminateBackend mocks base method.
+func (m *MockMonitoringUseCase) TerminateBackend(ctx context.Context, adminUsername string, pid int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateBackend", ctx, adminUsername, pid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateBackend indicates an expected call of TerminateBackend.
+func (mr *MockMonitoringUseCaseMockRecorder) TerminateBackend(ctx, adminUsername, pid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateBackend", reflect.TypeOf((*MockMonitoringUseCase)(nil).TerminateBackend), ctx, adminUsername, pid)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/query_notebook_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockQueryNotebookUseCase is a mock of QueryNotebookUseCase interface.
+type MockQueryNotebookUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockQueryNotebookUseCaseMockRecorder
+}
+
+// MockQueryNotebookUseCaseMockRecorder is the mock recorder for MockQueryNotebookUseCase.
+type MockQueryNotebookUseCaseMockRecorder struct {
+	mock *MockQueryNotebookUseCase
+}
+
+// NewMockQueryNotebookUseCase creates a new mock instance.
+func NewMockQueryNotebookUseCase(ctrl *gomock.Controller) *MockQueryNotebookUseCase {
+	mock := &MockQueryNotebookUseCase{ctrl: ctrl}
+	mock.recorder = &MockQueryNotebookUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryNotebookUseCase) EXPECT() *MockQueryNotebookUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateNotebook mocks base method.
+func (m *MockQueryNotebookUseCase) CreateNotebook(ctx context.Context, username, title string, cells []domain.NotebookCell) (*domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotebook", ctx, username, title, cells)
+	ret0, _ := ret[0].(*domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotebook indicates an expected call of CreateNotebook.
+func (mr *MockQueryNotebookUseCaseMockRecorder) CreateNotebook(ctx, username, title, cells interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotebook", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).CreateNotebook), ctx, username, title, cells)
+}
+
+// DeleteNotebook mocks base method.
+func (m *MockQueryNotebookUseCase) DeleteNotebook(ctx context.Context, username, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotebook", ctx, username, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotebook indicates an expected call of DeleteNotebook.
+func (mr *MockQueryNotebookUseCaseMockRecorder) DeleteNotebook(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotebook", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).DeleteNotebook), ctx, username, id)
+}
+
+// ExportNotebook mocks base method.
+func (m *MockQueryNotebookUseCase) ExportNotebook(ctx context.Context, username, id string) (*domain.NotebookExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportNotebook", ctx, username, id)
+	ret0, _ := ret[0].(*domain.NotebookExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportNotebook indicates an expected call of ExportNotebook.
+func (mr *MockQueryNotebookUseCaseMockRecorder) ExportNotebook(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportNotebook", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).ExportNotebook), ctx, username, id)
+}
+
+// GetNotebook mocks base method.
+func (m *MockQueryNotebookUseCase) GetNotebook(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotebook", ctx, username, id)
+	ret0, _ := ret[0].(*domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotebook indicates an expected call of GetNotebook.
+func (mr *MockQueryNotebookUseCaseMockRecorder) GetNotebook(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotebook", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).GetNotebook), ctx, username, id)
+}
+
+// ListNotebooks mocks base method.
+func (m *MockQueryNotebookUseCase) ListNotebooks(ctx context.Context, username string) ([]domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotebooks", ctx, username)
+	ret0, _ := ret[0].([]domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotebooks indicates an expected call of ListNotebooks.
+func (mr *MockQueryNotebookUseCaseMockRecorder) ListNotebooks(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotebooks", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).ListNotebooks), ctx, username)
+}
+
+// RunAllCells mocks base method.
+func (m *MockQueryNotebookUseCase) RunAllCells(ctx context.Context, username, id string) (*domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAllCells", ctx, username, id)
+	ret0, _ := ret[0].(*domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAllCells indicates an expected call of RunAllCells.
+func (mr *MockQueryNotebookUseCaseMockRecorder) RunAllCells(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAllCells", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).RunAllCells), ctx, username, id)
+}
+
+// RunCell mocks base method.
+func (m *MockQueryNotebookUseCase) RunCell(ctx context.Context, username, id, cellID string) (*domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCell", ctx, username, id, cellID)
+	ret0, _ := ret[0].(*domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCell indicates an expected call of RunCell.
+func (mr *MockQueryNotebookUseCaseMockRecorder) RunCell(ctx, username, id, cellID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCell", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).RunCell), ctx, username, id, cellID)
+}
+
+// UpdateNotebook mocks base method.
+func (m *MockQueryNotebookUseCase) UpdateNotebook(ctx context.Context, username, id, title string, cells []domain.NotebookCell) (*domain.QueryNotebook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotebook", ctx, username, id, title, cells)
+	ret0, _ := ret[0].(*domain.QueryNotebook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotebook indicates an expected call of UpdateNotebook.
+func (mr *MockQueryNotebookUseCaseMockRecorder) UpdateNotebook(ctx, username, id, title, cells interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotebook", reflect.TypeOf((*MockQueryNotebookUseCase)(nil).UpdateNotebook), ctx, username, id, title, cells)
+}
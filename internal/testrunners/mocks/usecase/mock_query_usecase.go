@@ -6,7 +6,9 @@ package mockusecase
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	domain "github.com/kamil5b/lumen-pg/internal/domain"
@@ -35,6 +37,51 @@ func (m *MockQueryUseCase) EXPECT() *MockQueryUseCaseMockRecorder {
 	return m.recorder
 }
 
+// BuildChartData mocks base method.
+func (m *MockQueryUseCase) BuildChartData(ctx context.Context, username, query string) (*domain.ChartData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildChartData", ctx, username, query)
+	ret0, _ := ret[0].(*domain.ChartData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildChartData indicates an expected call of BuildChartData.
+func (mr *MockQueryUseCaseMockRecorder) BuildChartData(ctx, username, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildChartData", reflect.TypeOf((*MockQueryUseCase)(nil).BuildChartData), ctx, username, query)
+}
+
+// BuildQueryReport mocks base method.
+func (m *MockQueryUseCase) BuildQueryReport(ctx context.Context, username string, params domain.QueryParams, title string) (*domain.ResultReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildQueryReport", ctx, username, params, title)
+	ret0, _ := ret[0].(*domain.ResultReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildQueryReport indicates an expected call of BuildQueryReport.
+func (mr *MockQueryUseCaseMockRecorder) BuildQueryReport(ctx, username, params, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildQueryReport", reflect.TypeOf((*MockQueryUseCase)(nil).BuildQueryReport), ctx, username, params, title)
+}
+
+// CancelQuery mocks base method.
+func (m *MockQueryUseCase) CancelQuery(ctx context.Context, username string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelQuery", ctx, username)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelQuery indicates an expected call of CancelQuery.
+func (mr *MockQueryUseCaseMockRecorder) CancelQuery(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelQuery", reflect.TypeOf((*MockQueryUseCase)(nil).CancelQuery), ctx, username)
+}
+
 // ExecuteMultipleQueries mocks base method.
 func (m *MockQueryUseCase) ExecuteMultipleQueries(ctx context.Context, username, queries string) ([]domain.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +127,35 @@ func (mr *MockQueryUseCaseMockRecorder) ExecuteQueryWithPagination(ctx, username
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQueryWithPagination", reflect.TypeOf((*MockQueryUseCase)(nil).ExecuteQueryWithPagination), ctx, username, params)
 }
 
+// ExplainQuery mocks base method.
+func (m *MockQueryUseCase) ExplainQuery(ctx context.Context, username, query string, analyze bool) (*domain.QueryPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainQuery", ctx, username, query, analyze)
+	ret0, _ := ret[0].(*domain.QueryPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainQuery indicates an expected call of ExplainQuery.
+func (mr *MockQueryUseCaseMockRecorder) ExplainQuery(ctx, username, query, analyze interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainQuery", reflect.TypeOf((*MockQueryUseCase)(nil).ExplainQuery), ctx, username, query, analyze)
+}
+
+// ExportQueryCSV mocks base method.
+func (m *MockQueryUseCase) ExportQueryCSV(ctx context.Context, username string, params domain.QueryParams, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportQueryCSV", ctx, username, params, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportQueryCSV indicates an expected call of ExportQueryCSV.
+func (mr *MockQueryUseCaseMockRecorder) ExportQueryCSV(ctx, username, params, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportQueryCSV", reflect.TypeOf((*MockQueryUseCase)(nil).ExportQueryCSV), ctx, username, params, w)
+}
+
 // GetQueryAffectedRowCount mocks base method.
 func (m *MockQueryUseCase) GetQueryAffectedRowCount(ctx context.Context, result *domain.QueryResult) int64 {
 	m.ctrl.T.Helper()
@@ -139,6 +215,21 @@ func (mr *MockQueryUseCaseMockRecorder) IsSelectQuery(ctx, query interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSelectQuery", reflect.TypeOf((*MockQueryUseCase)(nil).IsSelectQuery), ctx, query)
 }
 
+// ShareEditor mocks base method.
+func (m *MockQueryUseCase) ShareEditor(ctx context.Context, username string) (*domain.EditorShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareEditor", ctx, username)
+	ret0, _ := ret[0].(*domain.EditorShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareEditor indicates an expected call of ShareEditor.
+func (mr *MockQueryUseCaseMockRecorder) ShareEditor(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareEditor", reflect.TypeOf((*MockQueryUseCase)(nil).ShareEditor), ctx, username)
+}
+
 // SplitQueries mocks base method.
 func (m *MockQueryUseCase) SplitQueries(ctx context.Context, queries string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +245,20 @@ func (mr *MockQueryUseCaseMockRecorder) SplitQueries(ctx, queries interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitQueries", reflect.TypeOf((*MockQueryUseCase)(nil).SplitQueries), ctx, queries)
 }
 
+// StopSharingEditor mocks base method.
+func (m *MockQueryUseCase) StopSharingEditor(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopSharingEditor", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopSharingEditor indicates an expected call of StopSharingEditor.
+func (mr *MockQueryUseCaseMockRecorder) StopSharingEditor(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSharingEditor", reflect.TypeOf((*MockQueryUseCase)(nil).StopSharingEditor), ctx, username)
+}
+
 // ValidateQuery mocks base method.
 func (m *MockQueryUseCase) ValidateQuery(ctx context.Context, query string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -168,3 +273,46 @@ func (mr *MockQueryUseCaseMockRecorder) ValidateQuery(ctx, query interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateQuery", reflect.TypeOf((*MockQueryUseCase)(nil).ValidateQuery), ctx, query)
 }
+
+// ViewResult mocks base method.
+func (m *MockQueryUseCase) ViewResult(ctx context.Context, username, resultID string, view domain.ResultView) (*domain.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewResult", ctx, username, resultID, view)
+	ret0, _ := ret[0].(*domain.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewResult indicates an expected call of ViewResult.
+func (mr *MockQueryUseCaseMockRecorder) ViewResult(ctx, username, resultID, view interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewResult", reflect.TypeOf((*MockQueryUseCase)(nil).ViewResult), ctx, username, resultID, view)
+}
+
+// WatchQuery mocks base method.
+func (m *MockQueryUseCase) WatchQuery(ctx context.Context, username, query string, interval time.Duration, onUpdate func(*domain.LiveResultUpdate) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchQuery", ctx, username, query, interval, onUpdate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchQuery indicates an expected call of WatchQuery.
+func (mr *MockQueryUseCaseMockRecorder) WatchQuery(ctx, username, query, interval, onUpdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchQuery", reflect.TypeOf((*MockQueryUseCase)(nil).WatchQuery), ctx, username, query, interval, onUpdate)
+}
+
+// WatchSharedEditor mocks base method.
+func (m *MockQueryUseCase) WatchSharedEditor(ctx context.Context, viewer, token string, onUpdate func(*domain.EditorShareUpdate) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSharedEditor", ctx, viewer, token, onUpdate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchSharedEditor indicates an expected call of WatchSharedEditor.
+func (mr *MockQueryUseCaseMockRecorder) WatchSharedEditor(ctx, viewer, token, onUpdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSharedEditor", reflect.TypeOf((*MockQueryUseCase)(nil).WatchSharedEditor), ctx, viewer, token, onUpdate)
+}
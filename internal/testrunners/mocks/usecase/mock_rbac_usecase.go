@@ -65,6 +65,21 @@ func (mr *MockRBACUseCaseMockRecorder) CheckDeletePermission(ctx, username, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDeletePermission", reflect.TypeOf((*MockRBACUseCase)(nil).CheckDeletePermission), ctx, username, database, schema, table)
 }
 
+// CheckExportPermission mocks base method.
+func (m *MockRBACUseCase) CheckExportPermission(ctx context.Context, username, database, schema, table string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckExportPermission", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckExportPermission indicates an expected call of CheckExportPermission.
+func (mr *MockRBACUseCaseMockRecorder) CheckExportPermission(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckExportPermission", reflect.TypeOf((*MockRBACUseCase)(nil).CheckExportPermission), ctx, username, database, schema, table)
+}
+
 // CheckInsertPermission mocks base method.
 func (m *MockRBACUseCase) CheckInsertPermission(ctx context.Context, username, database, schema, table string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/saved_query_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockSavedQueryUseCase is a mock of SavedQueryUseCase interface.
+type MockSavedQueryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSavedQueryUseCaseMockRecorder
+}
+
+// MockSavedQueryUseCaseMockRecorder is the mock recorder for MockSavedQueryUseCase.
+type MockSavedQueryUseCaseMockRecorder struct {
+	mock *MockSavedQueryUseCase
+}
+
+// NewMockSavedQueryUseCase creates a new mock instance.
+func NewMockSavedQueryUseCase(ctrl *gomock.Controller) *MockSavedQueryUseCase {
+	mock := &MockSavedQueryUseCase{ctrl: ctrl}
+	mock.recorder = &MockSavedQueryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSavedQueryUseCase) EXPECT() *MockSavedQueryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// BindTemplate mocks base method.
+func (m *MockSavedQueryUseCase) BindTemplate(ctx context.Context, username, id string, values map[string]string) (string, []interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindTemplate", ctx, username, id, values)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]interface{})
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BindTemplate indicates an expected call of BindTemplate.
+func (mr *MockSavedQueryUseCaseMockRecorder) BindTemplate(ctx, username, id, values interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindTemplate", reflect.TypeOf((*MockSavedQueryUseCase)(nil).BindTemplate), ctx, username, id, values)
+}
+
+// DeleteSavedQuery mocks base method.
+func (m *MockSavedQueryUseCase) DeleteSavedQuery(ctx context.Context, username, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedQuery", ctx, username, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedQuery indicates an expected call of DeleteSavedQuery.
+func (mr *MockSavedQueryUseCaseMockRecorder) DeleteSavedQuery(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedQuery", reflect.TypeOf((*MockSavedQueryUseCase)(nil).DeleteSavedQuery), ctx, username, id)
+}
+
+// ExportWorkspace mocks base method.
+func (m *MockSavedQueryUseCase) ExportWorkspace(ctx context.Context, username string, searchPath []string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportWorkspace", ctx, username, searchPath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkspace indicates an expected call of ExportWorkspace.
+func (mr *MockSavedQueryUseCaseMockRecorder) ExportWorkspace(ctx, username, searchPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkspace", reflect.TypeOf((*MockSavedQueryUseCase)(nil).ExportWorkspace), ctx, username, searchPath)
+}
+
+// GetSavedQuery mocks base method.
+func (m *MockSavedQueryUseCase) GetSavedQuery(ctx context.Context, username, id string) (*domain.SavedQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedQuery", ctx, username, id)
+	ret0, _ := ret[0].(*domain.SavedQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedQuery indicates an expected call of GetSavedQuery.
+func (mr *MockSavedQueryUseCaseMockRecorder) GetSavedQuery(ctx, username, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedQuery", reflect.TypeOf((*MockSavedQueryUseCase)(nil).GetSavedQuery), ctx, username, id)
+}
+
+// ImportWorkspace mocks base method.
+func (m *MockSavedQueryUseCase) ImportWorkspace(ctx context.Context, username string, document []byte) (*domain.WorkspaceImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkspace", ctx, username, document)
+	ret0, _ := ret[0].(*domain.WorkspaceImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkspace indicates an expected call of ImportWorkspace.
+func (mr *MockSavedQueryUseCaseMockRecorder) ImportWorkspace(ctx, username, document interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkspace", reflect.TypeOf((*MockSavedQueryUseCase)(nil).ImportWorkspace), ctx, username, document)
+}
+
+// ListSavedQueries mocks base method.
+func (m *MockSavedQueryUseCase) ListSavedQueries(ctx context.Context, username, tag string) ([]domain.SavedQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedQueries", ctx, username, tag)
+	ret0, _ := ret[0].([]domain.SavedQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedQueries indicates an expected call of ListSavedQueries.
+func (mr *MockSavedQueryUseCaseMockRecorder) ListSavedQueries(ctx, username, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedQueries", reflect.TypeOf((*MockSavedQueryUseCase)(nil).ListSavedQueries), ctx, username, tag)
+}
+
+// SaveQuery mocks base method.
+func (m *MockSavedQueryUseCase) SaveQuery(ctx context.Context, username, name, query string, tags []string) (*domain.SavedQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveQuery", ctx, username, name, query, tags)
+	ret0, _ := ret[0].(*domain.SavedQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveQuery indicates an expected call of SaveQuery.
+func (mr *MockSavedQueryUseCaseMockRecorder) SaveQuery(ctx, username, name, query, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveQuery", reflect.TypeOf((*MockSavedQueryUseCase)(nil).SaveQuery), ctx, username, name, query, tags)
+}
+
+// UpdateSavedQuery mocks base method.
+func (m *MockSavedQueryUseCase) UpdateSavedQuery(ctx context.Context, username, id, name, query string, tags []string) (*domain.SavedQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSavedQuery", ctx, username, id, name, query, tags)
+	ret0, _ := ret[0].(*domain.SavedQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSavedQuery indicates an expected call of UpdateSavedQuery.
+func (mr *MockSavedQueryUseCaseMockRecorder) UpdateSavedQuery(ctx, username, id, name, query, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSavedQuery", reflect.TypeOf((*MockSavedQueryUseCase)(nil).UpdateSavedQuery), ctx, username, id, name, query, tags)
+}
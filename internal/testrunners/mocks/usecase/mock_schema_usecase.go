@@ -0,0 +1,261 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/schema_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockSchemaUseCase is a mock of SchemaUseCase interface.
+type MockSchemaUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaUseCaseMockRecorder
+}
+
+// MockSchemaUseCaseMockRecorder is the mock recorder for MockSchemaUseCase.
+type MockSchemaUseCaseMockRecorder struct {
+	mock *MockSchemaUseCase
+}
+
+// NewMockSchemaUseCase creates a new mock instance.
+func NewMockSchemaUseCase(ctrl *gomock.Controller) *MockSchemaUseCase {
+	mock := &MockSchemaUseCase{ctrl: ctrl}
+	mock.recorder = &MockSchemaUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaUseCase) EXPECT() *MockSchemaUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddConstraint mocks base method.
+func (m *MockSchemaUseCase) AddConstraint(ctx context.Context, username string, definition domain.ConstraintDefinition, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddConstraint", ctx, username, definition, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddConstraint indicates an expected call of AddConstraint.
+func (mr *MockSchemaUseCaseMockRecorder) AddConstraint(ctx, username, definition, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConstraint", reflect.TypeOf((*MockSchemaUseCase)(nil).AddConstraint), ctx, username, definition, confirm)
+}
+
+// AlterTable mocks base method.
+func (m *MockSchemaUseCase) AlterTable(ctx context.Context, username string, alteration domain.TableAlteration, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlterTable", ctx, username, alteration, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AlterTable indicates an expected call of AlterTable.
+func (mr *MockSchemaUseCaseMockRecorder) AlterTable(ctx, username, alteration, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlterTable", reflect.TypeOf((*MockSchemaUseCase)(nil).AlterTable), ctx, username, alteration, confirm)
+}
+
+// CreateDatabase mocks base method.
+func (m *MockSchemaUseCase) CreateDatabase(ctx context.Context, username string, creation domain.DatabaseCreation, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabase", ctx, username, creation, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabase indicates an expected call of CreateDatabase.
+func (mr *MockSchemaUseCaseMockRecorder) CreateDatabase(ctx, username, creation, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabase", reflect.TypeOf((*MockSchemaUseCase)(nil).CreateDatabase), ctx, username, creation, confirm)
+}
+
+// CreateIndex mocks base method.
+func (m *MockSchemaUseCase) CreateIndex(ctx context.Context, username string, definition domain.IndexDefinition, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndex", ctx, username, definition, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndex indicates an expected call of CreateIndex.
+func (mr *MockSchemaUseCaseMockRecorder) CreateIndex(ctx, username, definition, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndex", reflect.TypeOf((*MockSchemaUseCase)(nil).CreateIndex), ctx, username, definition, confirm)
+}
+
+// CreateSchema mocks base method.
+func (m *MockSchemaUseCase) CreateSchema(ctx context.Context, username string, creation domain.SchemaCreation, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchema", ctx, username, creation, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchema indicates an expected call of CreateSchema.
+func (mr *MockSchemaUseCaseMockRecorder) CreateSchema(ctx, username, creation, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchema", reflect.TypeOf((*MockSchemaUseCase)(nil).CreateSchema), ctx, username, creation, confirm)
+}
+
+// CreateTable mocks base method.
+func (m *MockSchemaUseCase) CreateTable(ctx context.Context, username string, creation domain.TableCreation, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTable", ctx, username, creation, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTable indicates an expected call of CreateTable.
+func (mr *MockSchemaUseCaseMockRecorder) CreateTable(ctx, username, creation, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockSchemaUseCase)(nil).CreateTable), ctx, username, creation, confirm)
+}
+
+// DropConstraint mocks base method.
+func (m *MockSchemaUseCase) DropConstraint(ctx context.Context, username string, drop domain.ConstraintDrop, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropConstraint", ctx, username, drop, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DropConstraint indicates an expected call of DropConstraint.
+func (mr *MockSchemaUseCaseMockRecorder) DropConstraint(ctx, username, drop, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropConstraint", reflect.TypeOf((*MockSchemaUseCase)(nil).DropConstraint), ctx, username, drop, confirm)
+}
+
+// DropIndex mocks base method.
+func (m *MockSchemaUseCase) DropIndex(ctx context.Context, username string, drop domain.IndexDrop, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropIndex", ctx, username, drop, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DropIndex indicates an expected call of DropIndex.
+func (mr *MockSchemaUseCaseMockRecorder) DropIndex(ctx, username, drop, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropIndex", reflect.TypeOf((*MockSchemaUseCase)(nil).DropIndex), ctx, username, drop, confirm)
+}
+
+// GetTableDDL mocks base method.
+func (m *MockSchemaUseCase) GetTableDDL(ctx context.Context, username, database, schema, table string) (*domain.TableDDL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableDDL", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableDDL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableDDL indicates an expected call of GetTableDDL.
+func (mr *MockSchemaUseCaseMockRecorder) GetTableDDL(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableDDL", reflect.TypeOf((*MockSchemaUseCase)(nil).GetTableDDL), ctx, username, database, schema, table)
+}
+
+// ListComments mocks base method.
+func (m *MockSchemaUseCase) ListComments(ctx context.Context, username, database, schema, table string) (*domain.TableComments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableComments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockSchemaUseCaseMockRecorder) ListComments(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockSchemaUseCase)(nil).ListComments), ctx, username, database, schema, table)
+}
+
+// ListConstraints mocks base method.
+func (m *MockSchemaUseCase) ListConstraints(ctx context.Context, username, database, schema, table string) (*domain.TableConstraintList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConstraints", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableConstraintList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConstraints indicates an expected call of ListConstraints.
+func (mr *MockSchemaUseCaseMockRecorder) ListConstraints(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConstraints", reflect.TypeOf((*MockSchemaUseCase)(nil).ListConstraints), ctx, username, database, schema, table)
+}
+
+// ListIndexes mocks base method.
+func (m *MockSchemaUseCase) ListIndexes(ctx context.Context, username, database, schema, table string) (*domain.TableIndexList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIndexes", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableIndexList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIndexes indicates an expected call of ListIndexes.
+func (mr *MockSchemaUseCaseMockRecorder) ListIndexes(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIndexes", reflect.TypeOf((*MockSchemaUseCase)(nil).ListIndexes), ctx, username, database, schema, table)
+}
+
+// ListTriggers mocks base method.
+func (m *MockSchemaUseCase) ListTriggers(ctx context.Context, username, database, schema, table string) (*domain.TableTriggerList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTriggers", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.TableTriggerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggers indicates an expected call of ListTriggers.
+func (mr *MockSchemaUseCaseMockRecorder) ListTriggers(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggers", reflect.TypeOf((*MockSchemaUseCase)(nil).ListTriggers), ctx, username, database, schema, table)
+}
+
+// SetComment mocks base method.
+func (m *MockSchemaUseCase) SetComment(ctx context.Context, username string, change domain.CommentChange, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetComment", ctx, username, change, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetComment indicates an expected call of SetComment.
+func (mr *MockSchemaUseCaseMockRecorder) SetComment(ctx, username, change, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetComment", reflect.TypeOf((*MockSchemaUseCase)(nil).SetComment), ctx, username, change, confirm)
+}
+
+// SetTriggerEnabled mocks base method.
+func (m *MockSchemaUseCase) SetTriggerEnabled(ctx context.Context, username string, toggle domain.TriggerToggle, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTriggerEnabled", ctx, username, toggle, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTriggerEnabled indicates an expected call of SetTriggerEnabled.
+func (mr *MockSchemaUseCaseMockRecorder) SetTriggerEnabled(ctx, username, toggle, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTriggerEnabled", reflect.TypeOf((*MockSchemaUseCase)(nil).SetTriggerEnabled), ctx, username, toggle, confirm)
+}
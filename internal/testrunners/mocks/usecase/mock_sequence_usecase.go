@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/sequence_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockSequenceUseCase is a mock of SequenceUseCase interface.
+type MockSequenceUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSequenceUseCaseMockRecorder
+}
+
+// MockSequenceUseCaseMockRecorder is the mock recorder for MockSequenceUseCase.
+type MockSequenceUseCaseMockRecorder struct {
+	mock *MockSequenceUseCase
+}
+
+// NewMockSequenceUseCase creates a new mock instance.
+func NewMockSequenceUseCase(ctrl *gomock.Controller) *MockSequenceUseCase {
+	mock := &MockSequenceUseCase{ctrl: ctrl}
+	mock.recorder = &MockSequenceUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSequenceUseCase) EXPECT() *MockSequenceUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListSequences mocks base method.
+func (m *MockSequenceUseCase) ListSequences(ctx context.Context, username, database, schema string) ([]domain.Sequence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSequences", ctx, username, database, schema)
+	ret0, _ := ret[0].([]domain.Sequence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSequences indicates an expected call of ListSequences.
+func (mr *MockSequenceUseCaseMockRecorder) ListSequences(ctx, username, database, schema interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSequences", reflect.TypeOf((*MockSequenceUseCase)(nil).ListSequences), ctx, username, database, schema)
+}
+
+// RestartSequence mocks base method.
+func (m *MockSequenceUseCase) RestartSequence(ctx context.Context, username string, restart domain.SequenceRestart, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartSequence", ctx, username, restart, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartSequence indicates an expected call of RestartSequence.
+func (mr *MockSequenceUseCaseMockRecorder) RestartSequence(ctx, username, restart, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartSequence", reflect.TypeOf((*MockSequenceUseCase)(nil).RestartSequence), ctx, username, restart, confirm)
+}
+
+// SetSequenceValue mocks base method.
+func (m *MockSequenceUseCase) SetSequenceValue(ctx context.Context, username string, value domain.SequenceValue, confirm string) (*domain.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSequenceValue", ctx, username, value, confirm)
+	ret0, _ := ret[0].(*domain.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSequenceValue indicates an expected call of SetSequenceValue.
+func (mr *MockSequenceUseCaseMockRecorder) SetSequenceValue(ctx, username, value, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSequenceValue", reflect.TypeOf((*MockSequenceUseCase)(nil).SetSequenceValue), ctx, username, value, confirm)
+}
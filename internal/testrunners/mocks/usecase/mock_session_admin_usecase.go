@@ -0,0 +1,258 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/session_admin_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockSessionAdminUseCase is a mock of SessionAdminUseCase interface.
+type MockSessionAdminUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionAdminUseCaseMockRecorder
+}
+
+// MockSessionAdminUseCaseMockRecorder is the mock recorder for MockSessionAdminUseCase.
+type MockSessionAdminUseCaseMockRecorder struct {
+	mock *MockSessionAdminUseCase
+}
+
+// NewMockSessionAdminUseCase creates a new mock instance.
+func NewMockSessionAdminUseCase(ctrl *gomock.Controller) *MockSessionAdminUseCase {
+	mock := &MockSessionAdminUseCase{ctrl: ctrl}
+	mock.recorder = &MockSessionAdminUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionAdminUseCase) EXPECT() *MockSessionAdminUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CheckRolePassword mocks base method.
+func (m *MockSessionAdminUseCase) CheckRolePassword(ctx context.Context, adminUsername, roleName, password string) (*domain.PasswordCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckRolePassword", ctx, adminUsername, roleName, password)
+	ret0, _ := ret[0].(*domain.PasswordCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckRolePassword indicates an expected call of CheckRolePassword.
+func (mr *MockSessionAdminUseCaseMockRecorder) CheckRolePassword(ctx, adminUsername, roleName, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRolePassword", reflect.TypeOf((*MockSessionAdminUseCase)(nil).CheckRolePassword), ctx, adminUsername, roleName, password)
+}
+
+// CreateRole mocks base method.
+func (m *MockSessionAdminUseCase) CreateRole(ctx context.Context, adminUsername string, role domain.NewRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, adminUsername, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockSessionAdminUseCaseMockRecorder) CreateRole(ctx, adminUsername, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockSessionAdminUseCase)(nil).CreateRole), ctx, adminUsername, role)
+}
+
+// DeleteConnectionProfile mocks base method.
+func (m *MockSessionAdminUseCase) DeleteConnectionProfile(ctx context.Context, adminUsername, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnectionProfile", ctx, adminUsername, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnectionProfile indicates an expected call of DeleteConnectionProfile.
+func (mr *MockSessionAdminUseCaseMockRecorder) DeleteConnectionProfile(ctx, adminUsername, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnectionProfile", reflect.TypeOf((*MockSessionAdminUseCase)(nil).DeleteConnectionProfile), ctx, adminUsername, name)
+}
+
+// ExportConnectionProfiles mocks base method.
+func (m *MockSessionAdminUseCase) ExportConnectionProfiles(ctx context.Context, adminUsername string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportConnectionProfiles", ctx, adminUsername)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportConnectionProfiles indicates an expected call of ExportConnectionProfiles.
+func (mr *MockSessionAdminUseCaseMockRecorder) ExportConnectionProfiles(ctx, adminUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportConnectionProfiles", reflect.TypeOf((*MockSessionAdminUseCase)(nil).ExportConnectionProfiles), ctx, adminUsername)
+}
+
+// GetEnvironmentBanner mocks base method.
+func (m *MockSessionAdminUseCase) GetEnvironmentBanner(ctx context.Context) (*domain.EnvironmentBanner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironmentBanner", ctx)
+	ret0, _ := ret[0].(*domain.EnvironmentBanner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironmentBanner indicates an expected call of GetEnvironmentBanner.
+func (mr *MockSessionAdminUseCaseMockRecorder) GetEnvironmentBanner(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironmentBanner", reflect.TypeOf((*MockSessionAdminUseCase)(nil).GetEnvironmentBanner), ctx)
+}
+
+// GetMaintenanceStatus mocks base method.
+func (m *MockSessionAdminUseCase) GetMaintenanceStatus(ctx context.Context, adminUsername string) (*domain.MaintenanceStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceStatus", ctx, adminUsername)
+	ret0, _ := ret[0].(*domain.MaintenanceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceStatus indicates an expected call of GetMaintenanceStatus.
+func (mr *MockSessionAdminUseCaseMockRecorder) GetMaintenanceStatus(ctx, adminUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceStatus", reflect.TypeOf((*MockSessionAdminUseCase)(nil).GetMaintenanceStatus), ctx, adminUsername)
+}
+
+// GetRejectionStats mocks base method.
+func (m *MockSessionAdminUseCase) GetRejectionStats(ctx context.Context, adminUsername string, since time.Time) ([]domain.RejectionStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRejectionStats", ctx, adminUsername, since)
+	ret0, _ := ret[0].([]domain.RejectionStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRejectionStats indicates an expected call of GetRejectionStats.
+func (mr *MockSessionAdminUseCaseMockRecorder) GetRejectionStats(ctx, adminUsername, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRejectionStats", reflect.TypeOf((*MockSessionAdminUseCase)(nil).GetRejectionStats), ctx, adminUsername, since)
+}
+
+// ImportConnectionProfiles mocks base method.
+func (m *MockSessionAdminUseCase) ImportConnectionProfiles(ctx context.Context, adminUsername string, document []byte) (*domain.ConnectionProfileImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportConnectionProfiles", ctx, adminUsername, document)
+	ret0, _ := ret[0].(*domain.ConnectionProfileImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportConnectionProfiles indicates an expected call of ImportConnectionProfiles.
+func (mr *MockSessionAdminUseCaseMockRecorder) ImportConnectionProfiles(ctx, adminUsername, document interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportConnectionProfiles", reflect.TypeOf((*MockSessionAdminUseCase)(nil).ImportConnectionProfiles), ctx, adminUsername, document)
+}
+
+// ListSessions mocks base method.
+func (m *MockSessionAdminUseCase) ListSessions(ctx context.Context, adminUsername string) ([]*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, adminUsername)
+	ret0, _ := ret[0].([]*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionAdminUseCaseMockRecorder) ListSessions(ctx, adminUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionAdminUseCase)(nil).ListSessions), ctx, adminUsername)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionAdminUseCase) RevokeSession(ctx context.Context, adminUsername, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, adminUsername, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionAdminUseCaseMockRecorder) RevokeSession(ctx, adminUsername, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionAdminUseCase)(nil).RevokeSession), ctx, adminUsername, sessionID)
+}
+
+// SaveConnectionProfile mocks base method.
+func (m *MockSessionAdminUseCase) SaveConnectionProfile(ctx context.Context, adminUsername string, profile domain.ConnectionProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConnectionProfile", ctx, adminUsername, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConnectionProfile indicates an expected call of SaveConnectionProfile.
+func (mr *MockSessionAdminUseCaseMockRecorder) SaveConnectionProfile(ctx, adminUsername, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConnectionProfile", reflect.TypeOf((*MockSessionAdminUseCase)(nil).SaveConnectionProfile), ctx, adminUsername, profile)
+}
+
+// SetDisabledShortcuts mocks base method.
+func (m *MockSessionAdminUseCase) SetDisabledShortcuts(ctx context.Context, adminUsername string, actions []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDisabledShortcuts", ctx, adminUsername, actions)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDisabledShortcuts indicates an expected call of SetDisabledShortcuts.
+func (mr *MockSessionAdminUseCaseMockRecorder) SetDisabledShortcuts(ctx, adminUsername, actions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDisabledShortcuts", reflect.TypeOf((*MockSessionAdminUseCase)(nil).SetDisabledShortcuts), ctx, adminUsername, actions)
+}
+
+// SetEnvironmentBanner mocks base method.
+func (m *MockSessionAdminUseCase) SetEnvironmentBanner(ctx context.Context, adminUsername, name, color string) (*domain.EnvironmentBanner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnvironmentBanner", ctx, adminUsername, name, color)
+	ret0, _ := ret[0].(*domain.EnvironmentBanner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEnvironmentBanner indicates an expected call of SetEnvironmentBanner.
+func (mr *MockSessionAdminUseCaseMockRecorder) SetEnvironmentBanner(ctx, adminUsername, name, color interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnvironmentBanner", reflect.TypeOf((*MockSessionAdminUseCase)(nil).SetEnvironmentBanner), ctx, adminUsername, name, color)
+}
+
+// SetMaintenanceMode mocks base method.
+func (m *MockSessionAdminUseCase) SetMaintenanceMode(ctx context.Context, adminUsername string, enabled bool, message string) (*domain.MaintenanceStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaintenanceMode", ctx, adminUsername, enabled, message)
+	ret0, _ := ret[0].(*domain.MaintenanceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaintenanceMode indicates an expected call of SetMaintenanceMode.
+func (mr *MockSessionAdminUseCaseMockRecorder) SetMaintenanceMode(ctx, adminUsername, enabled, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenanceMode", reflect.TypeOf((*MockSessionAdminUseCase)(nil).SetMaintenanceMode), ctx, adminUsername, enabled, message)
+}
+
+// SetSessionSettings mocks base method.
+func (m *MockSessionAdminUseCase) SetSessionSettings(ctx context.Context, adminUsername, sessionID string, settings domain.SessionSettings) (*domain.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSessionSettings", ctx, adminUsername, sessionID, settings)
+	ret0, _ := ret[0].(*domain.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSessionSettings indicates an expected call of SetSessionSettings.
+func (mr *MockSessionAdminUseCaseMockRecorder) SetSessionSettings(ctx, adminUsername, sessionID, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSessionSettings", reflect.TypeOf((*MockSessionAdminUseCase)(nil).SetSessionSettings), ctx, adminUsername, sessionID, settings)
+}
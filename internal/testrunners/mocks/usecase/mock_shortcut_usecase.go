@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/shortcut_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockShortcutUseCase is a mock of ShortcutUseCase interface.
+type MockShortcutUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockShortcutUseCaseMockRecorder
+}
+
+// MockShortcutUseCaseMockRecorder is the mock recorder for MockShortcutUseCase.
+type MockShortcutUseCaseMockRecorder struct {
+	mock *MockShortcutUseCase
+}
+
+// NewMockShortcutUseCase creates a new mock instance.
+func NewMockShortcutUseCase(ctrl *gomock.Controller) *MockShortcutUseCase {
+	mock := &MockShortcutUseCase{ctrl: ctrl}
+	mock.recorder = &MockShortcutUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShortcutUseCase) EXPECT() *MockShortcutUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListShortcuts mocks base method.
+func (m *MockShortcutUseCase) ListShortcuts(ctx context.Context, username string) ([]domain.KeyboardShortcut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShortcuts", ctx, username)
+	ret0, _ := ret[0].([]domain.KeyboardShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShortcuts indicates an expected call of ListShortcuts.
+func (mr *MockShortcutUseCaseMockRecorder) ListShortcuts(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShortcuts", reflect.TypeOf((*MockShortcutUseCase)(nil).ListShortcuts), ctx, username)
+}
+
+// RemapShortcut mocks base method.
+func (m *MockShortcutUseCase) RemapShortcut(ctx context.Context, username, action, keys string) (*domain.KeyboardShortcut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemapShortcut", ctx, username, action, keys)
+	ret0, _ := ret[0].(*domain.KeyboardShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemapShortcut indicates an expected call of RemapShortcut.
+func (mr *MockShortcutUseCaseMockRecorder) RemapShortcut(ctx, username, action, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemapShortcut", reflect.TypeOf((*MockShortcutUseCase)(nil).RemapShortcut), ctx, username, action, keys)
+}
+
+// ResetShortcut mocks base method.
+func (m *MockShortcutUseCase) ResetShortcut(ctx context.Context, username, action string) (*domain.KeyboardShortcut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetShortcut", ctx, username, action)
+	ret0, _ := ret[0].(*domain.KeyboardShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetShortcut indicates an expected call of ResetShortcut.
+func (mr *MockShortcutUseCaseMockRecorder) ResetShortcut(ctx, username, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetShortcut", reflect.TypeOf((*MockShortcutUseCase)(nil).ResetShortcut), ctx, username, action)
+}
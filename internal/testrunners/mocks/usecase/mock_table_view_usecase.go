@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/kamil5b/REDIKRU/lumen-pg/internal/interfaces/usecase/table_view_usecase.go
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	domain "github.com/kamil5b/lumen-pg/internal/domain"
+)
+
+// MockTableViewUseCase is a mock of TableViewUseCase interface.
+type MockTableViewUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTableViewUseCaseMockRecorder
+}
+
+// MockTableViewUseCaseMockRecorder is the mock recorder for MockTableViewUseCase.
+type MockTableViewUseCaseMockRecorder struct {
+	mock *MockTableViewUseCase
+}
+
+// NewMockTableViewUseCase creates a new mock instance.
+func NewMockTableViewUseCase(ctrl *gomock.Controller) *MockTableViewUseCase {
+	mock := &MockTableViewUseCase{ctrl: ctrl}
+	mock.recorder = &MockTableViewUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTableViewUseCase) EXPECT() *MockTableViewUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteTableView mocks base method.
+func (m *MockTableViewUseCase) DeleteTableView(ctx context.Context, username, database, schema, table, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTableView", ctx, username, database, schema, table, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTableView indicates an expected call of DeleteTableView.
+func (mr *MockTableViewUseCaseMockRecorder) DeleteTableView(ctx, username, database, schema, table, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTableView", reflect.TypeOf((*MockTableViewUseCase)(nil).DeleteTableView), ctx, username, database, schema, table, name)
+}
+
+// GetDefaultTableView mocks base method.
+func (m *MockTableViewUseCase) GetDefaultTableView(ctx context.Context, username, database, schema, table string) (*domain.SavedTableView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultTableView", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.SavedTableView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultTableView indicates an expected call of GetDefaultTableView.
+func (mr *MockTableViewUseCaseMockRecorder) GetDefaultTableView(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultTableView", reflect.TypeOf((*MockTableViewUseCase)(nil).GetDefaultTableView), ctx, username, database, schema, table)
+}
+
+// ListTableViews mocks base method.
+func (m *MockTableViewUseCase) ListTableViews(ctx context.Context, username, database, schema, table string) ([]domain.SavedTableView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableViews", ctx, username, database, schema, table)
+	ret0, _ := ret[0].([]domain.SavedTableView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableViews indicates an expected call of ListTableViews.
+func (mr *MockTableViewUseCaseMockRecorder) ListTableViews(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableViews", reflect.TypeOf((*MockTableViewUseCase)(nil).ListTableViews), ctx, username, database, schema, table)
+}
+
+// SaveTableView mocks base method.
+func (m *MockTableViewUseCase) SaveTableView(ctx context.Context, username string, view domain.SavedTableView) (*domain.SavedTableView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTableView", ctx, username, view)
+	ret0, _ := ret[0].(*domain.SavedTableView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTableView indicates an expected call of SaveTableView.
+func (mr *MockTableViewUseCaseMockRecorder) SaveTableView(ctx, username, view interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTableView", reflect.TypeOf((*MockTableViewUseCase)(nil).SaveTableView), ctx, username, view)
+}
+
+// SetDefaultTableView mocks base method.
+func (m *MockTableViewUseCase) SetDefaultTableView(ctx context.Context, username, database, schema, table, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultTableView", ctx, username, database, schema, table, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultTableView indicates an expected call of SetDefaultTableView.
+func (mr *MockTableViewUseCaseMockRecorder) SetDefaultTableView(ctx, username, database, schema, table, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultTableView", reflect.TypeOf((*MockTableViewUseCase)(nil).SetDefaultTableView), ctx, username, database, schema, table, name)
+}
@@ -35,6 +35,20 @@ func (m *MockTransactionUseCase) EXPECT() *MockTransactionUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ApproveTransaction mocks base method.
+func (m *MockTransactionUseCase) ApproveTransaction(ctx context.Context, approver, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveTransaction", ctx, approver, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveTransaction indicates an expected call of ApproveTransaction.
+func (mr *MockTransactionUseCaseMockRecorder) ApproveTransaction(ctx, approver, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).ApproveTransaction), ctx, approver, username)
+}
+
 // CancelExpiredTransactions mocks base method.
 func (m *MockTransactionUseCase) CancelExpiredTransactions(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -49,6 +63,21 @@ func (mr *MockTransactionUseCaseMockRecorder) CancelExpiredTransactions(ctx inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelExpiredTransactions", reflect.TypeOf((*MockTransactionUseCase)(nil).CancelExpiredTransactions), ctx)
 }
 
+// CellValueOptions mocks base method.
+func (m *MockTransactionUseCase) CellValueOptions(ctx context.Context, username, database, schema, table, column string) (*domain.CellValueOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CellValueOptions", ctx, username, database, schema, table, column)
+	ret0, _ := ret[0].(*domain.CellValueOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CellValueOptions indicates an expected call of CellValueOptions.
+func (mr *MockTransactionUseCaseMockRecorder) CellValueOptions(ctx, username, database, schema, table, column interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CellValueOptions", reflect.TypeOf((*MockTransactionUseCase)(nil).CellValueOptions), ctx, username, database, schema, table, column)
+}
+
 // CheckActiveTransaction mocks base method.
 func (m *MockTransactionUseCase) CheckActiveTransaction(ctx context.Context, username string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -64,18 +93,33 @@ func (mr *MockTransactionUseCaseMockRecorder) CheckActiveTransaction(ctx, userna
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckActiveTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).CheckActiveTransaction), ctx, username)
 }
 
+// CheckTableLocks mocks base method.
+func (m *MockTransactionUseCase) CheckTableLocks(ctx context.Context, username, database, schema, table string) ([]domain.TableLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckTableLocks", ctx, username, database, schema, table)
+	ret0, _ := ret[0].([]domain.TableLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckTableLocks indicates an expected call of CheckTableLocks.
+func (mr *MockTransactionUseCaseMockRecorder) CheckTableLocks(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTableLocks", reflect.TypeOf((*MockTransactionUseCase)(nil).CheckTableLocks), ctx, username, database, schema, table)
+}
+
 // CommitTransaction mocks base method.
-func (m *MockTransactionUseCase) CommitTransaction(ctx context.Context, username string) error {
+func (m *MockTransactionUseCase) CommitTransaction(ctx context.Context, username, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CommitTransaction", ctx, username)
+	ret := m.ctrl.Call(m, "CommitTransaction", ctx, username, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CommitTransaction indicates an expected call of CommitTransaction.
-func (mr *MockTransactionUseCaseMockRecorder) CommitTransaction(ctx, username interface{}) *gomock.Call {
+func (mr *MockTransactionUseCaseMockRecorder) CommitTransaction(ctx, username, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).CommitTransaction), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).CommitTransaction), ctx, username, reason)
 }
 
 // DeleteRow mocks base method.
@@ -92,6 +136,21 @@ func (mr *MockTransactionUseCaseMockRecorder) DeleteRow(ctx, username, database,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRow", reflect.TypeOf((*MockTransactionUseCase)(nil).DeleteRow), ctx, username, database, schema, table, rowIndex)
 }
 
+// DeleteRows mocks base method.
+func (m *MockTransactionUseCase) DeleteRows(ctx context.Context, username string, params domain.BulkDeleteParams) (*domain.BulkDeleteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRows", ctx, username, params)
+	ret0, _ := ret[0].(*domain.BulkDeleteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRows indicates an expected call of DeleteRows.
+func (mr *MockTransactionUseCaseMockRecorder) DeleteRows(ctx, username, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRows", reflect.TypeOf((*MockTransactionUseCase)(nil).DeleteRows), ctx, username, params)
+}
+
 // EditCell mocks base method.
 func (m *MockTransactionUseCase) EditCell(ctx context.Context, username, database, schema, table string, rowIndex int, columnName string, newValue interface{}) error {
 	m.ctrl.T.Helper()
@@ -106,6 +165,36 @@ func (mr *MockTransactionUseCaseMockRecorder) EditCell(ctx, username, database,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditCell", reflect.TypeOf((*MockTransactionUseCase)(nil).EditCell), ctx, username, database, schema, table, rowIndex, columnName, newValue)
 }
 
+// EditJSONCell mocks base method.
+func (m *MockTransactionUseCase) EditJSONCell(ctx context.Context, username, database, schema, table string, rowIndex int, columnName, currentValue string, ops []domain.JSONPatchOp) (*domain.RowEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditJSONCell", ctx, username, database, schema, table, rowIndex, columnName, currentValue, ops)
+	ret0, _ := ret[0].(*domain.RowEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditJSONCell indicates an expected call of EditJSONCell.
+func (mr *MockTransactionUseCaseMockRecorder) EditJSONCell(ctx, username, database, schema, table, rowIndex, columnName, currentValue, ops interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditJSONCell", reflect.TypeOf((*MockTransactionUseCase)(nil).EditJSONCell), ctx, username, database, schema, table, rowIndex, columnName, currentValue, ops)
+}
+
+// ExportTransaction mocks base method.
+func (m *MockTransactionUseCase) ExportTransaction(ctx context.Context, username, format string) (*domain.TransactionExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTransaction", ctx, username, format)
+	ret0, _ := ret[0].(*domain.TransactionExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTransaction indicates an expected call of ExportTransaction.
+func (mr *MockTransactionUseCaseMockRecorder) ExportTransaction(ctx, username, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).ExportTransaction), ctx, username, format)
+}
+
 // GetActiveTransaction mocks base method.
 func (m *MockTransactionUseCase) GetActiveTransaction(ctx context.Context, username string) (*domain.TransactionState, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +270,20 @@ func (mr *MockTransactionUseCaseMockRecorder) GetTransactionRemainingTime(ctx, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionRemainingTime", reflect.TypeOf((*MockTransactionUseCase)(nil).GetTransactionRemainingTime), ctx, username)
 }
 
+// Heartbeat mocks base method.
+func (m *MockTransactionUseCase) Heartbeat(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockTransactionUseCaseMockRecorder) Heartbeat(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockTransactionUseCase)(nil).Heartbeat), ctx, username)
+}
+
 // InsertRow mocks base method.
 func (m *MockTransactionUseCase) InsertRow(ctx context.Context, username, database, schema, table string, values map[string]interface{}) error {
 	m.ctrl.T.Helper()
@@ -210,6 +313,81 @@ func (mr *MockTransactionUseCaseMockRecorder) IsTransactionExpired(ctx, username
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTransactionExpired", reflect.TypeOf((*MockTransactionUseCase)(nil).IsTransactionExpired), ctx, username)
 }
 
+// NewRowDefaults mocks base method.
+func (m *MockTransactionUseCase) NewRowDefaults(ctx context.Context, username, database, schema, table string) (*domain.NewRowDefaults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRowDefaults", ctx, username, database, schema, table)
+	ret0, _ := ret[0].(*domain.NewRowDefaults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRowDefaults indicates an expected call of NewRowDefaults.
+func (mr *MockTransactionUseCaseMockRecorder) NewRowDefaults(ctx, username, database, schema, table interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRowDefaults", reflect.TypeOf((*MockTransactionUseCase)(nil).NewRowDefaults), ctx, username, database, schema, table)
+}
+
+// PreviewBulkUpdate mocks base method.
+func (m *MockTransactionUseCase) PreviewBulkUpdate(ctx context.Context, username string, update domain.BulkUpdate) (*domain.BulkUpdateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewBulkUpdate", ctx, username, update)
+	ret0, _ := ret[0].(*domain.BulkUpdateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewBulkUpdate indicates an expected call of PreviewBulkUpdate.
+func (mr *MockTransactionUseCaseMockRecorder) PreviewBulkUpdate(ctx, username, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewBulkUpdate", reflect.TypeOf((*MockTransactionUseCase)(nil).PreviewBulkUpdate), ctx, username, update)
+}
+
+// ReapIdleTransactions mocks base method.
+func (m *MockTransactionUseCase) ReapIdleTransactions(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReapIdleTransactions", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReapIdleTransactions indicates an expected call of ReapIdleTransactions.
+func (mr *MockTransactionUseCaseMockRecorder) ReapIdleTransactions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapIdleTransactions", reflect.TypeOf((*MockTransactionUseCase)(nil).ReapIdleTransactions), ctx)
+}
+
+// RestoreCellValue mocks base method.
+func (m *MockTransactionUseCase) RestoreCellValue(ctx context.Context, username string, rowIndex int, columnName string) (*domain.RowEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCellValue", ctx, username, rowIndex, columnName)
+	ret0, _ := ret[0].(*domain.RowEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCellValue indicates an expected call of RestoreCellValue.
+func (mr *MockTransactionUseCaseMockRecorder) RestoreCellValue(ctx, username, rowIndex, columnName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCellValue", reflect.TypeOf((*MockTransactionUseCase)(nil).RestoreCellValue), ctx, username, rowIndex, columnName)
+}
+
+// RestoreDeletedRows mocks base method.
+func (m *MockTransactionUseCase) RestoreDeletedRows(ctx context.Context, username, entryID, confirm string) (*domain.RowRestore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDeletedRows", ctx, username, entryID, confirm)
+	ret0, _ := ret[0].(*domain.RowRestore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreDeletedRows indicates an expected call of RestoreDeletedRows.
+func (mr *MockTransactionUseCaseMockRecorder) RestoreDeletedRows(ctx, username, entryID, confirm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDeletedRows", reflect.TypeOf((*MockTransactionUseCase)(nil).RestoreDeletedRows), ctx, username, entryID, confirm)
+}
+
 // RollbackTransaction mocks base method.
 func (m *MockTransactionUseCase) RollbackTransaction(ctx context.Context, username string) error {
 	m.ctrl.T.Helper()
@@ -238,3 +416,46 @@ func (mr *MockTransactionUseCaseMockRecorder) StartTransaction(ctx, username, da
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).StartTransaction), ctx, username, database, schema, table)
 }
+
+// UpdateRowsByFilter mocks base method.
+func (m *MockTransactionUseCase) UpdateRowsByFilter(ctx context.Context, username string, update domain.BulkUpdate) (*domain.BulkUpdateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRowsByFilter", ctx, username, update)
+	ret0, _ := ret[0].(*domain.BulkUpdateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRowsByFilter indicates an expected call of UpdateRowsByFilter.
+func (mr *MockTransactionUseCaseMockRecorder) UpdateRowsByFilter(ctx, username, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRowsByFilter", reflect.TypeOf((*MockTransactionUseCase)(nil).UpdateRowsByFilter), ctx, username, update)
+}
+
+// UpsertRow mocks base method.
+func (m *MockTransactionUseCase) UpsertRow(ctx context.Context, username, database, schema, table string, values map[string]interface{}, onConflict domain.ConflictAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRow", ctx, username, database, schema, table, values, onConflict)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRow indicates an expected call of UpsertRow.
+func (mr *MockTransactionUseCaseMockRecorder) UpsertRow(ctx, username, database, schema, table, values, onConflict interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRow", reflect.TypeOf((*MockTransactionUseCase)(nil).UpsertRow), ctx, username, database, schema, table, values, onConflict)
+}
+
+// WatchTransaction mocks base method.
+func (m *MockTransactionUseCase) WatchTransaction(ctx context.Context, username string, onEvent func(*domain.TransactionEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTransaction", ctx, username, onEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTransaction indicates an expected call of WatchTransaction.
+func (mr *MockTransactionUseCaseMockRecorder) WatchTransaction(ctx, username, onEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTransaction", reflect.TypeOf((*MockTransactionUseCase)(nil).WatchTransaction), ctx, username, onEvent)
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// AuditRepositoryConstructor is a function type that creates an AuditRepository
+type AuditRepositoryConstructor func() repository.AuditRepository
+
+// AuditPostgresRepositoryConstructor is a function type that creates an AuditRepository over lumen-pg's schema
+type AuditPostgresRepositoryConstructor func(db *sql.DB) repository.AuditRepository
+
+// AuditRepositoryRunner runs all audit repository tests against an implementation
+func AuditRepositoryRunner(t *testing.T, constructor AuditRepositoryConstructor) {
+	t.Helper()
+
+	runAuditRepositoryTests(t, context.Background(), constructor())
+}
+
+// AuditPostgresRepositoryRunner runs the audit repository tests against an implementation over
+// lumen-pg's own schema in a PostgreSQL container
+func AuditPostgresRepositoryRunner(t *testing.T, migrationConstructor MigrationRepositoryConstructor, constructor AuditPostgresRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	db := startAppSchemaDatabase(t, ctx, migrationConstructor)
+
+	runAuditRepositoryTests(t, ctx, constructor(db))
+
+	t.Run("Affected rows round-trip", func(t *testing.T) {
+		repo := constructor(db)
+		err := repo.RecordEntry(ctx, &domain.AuditEntry{
+			Username:     "rows_user",
+			Action:       domain.AuditActionCommit,
+			AffectedRows: []map[string]interface{}{{"row_index": float64(3)}},
+			ApprovedBy:   "approver",
+		})
+		require.NoError(t, err)
+
+		entries, err := repo.GetEntries(ctx, domain.AuditFilter{Username: "rows_user"})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, []map[string]interface{}{{"row_index": float64(3)}}, entries[0].AffectedRows)
+		require.Equal(t, "approver", entries[0].ApprovedBy)
+	})
+
+	t.Run("Entries cannot be modified", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "UPDATE "+domain.AppSchema+".audit_log SET reason = 'rewritten'")
+		require.Error(t, err)
+
+		_, err = db.ExecContext(ctx, "DELETE FROM "+domain.AppSchema+".audit_log")
+		require.Error(t, err)
+	})
+
+	t.Run("Before and after values round-trip", func(t *testing.T) {
+		repo := constructor(db)
+		err := repo.RecordEntry(ctx, &domain.AuditEntry{
+			Username: "admin_user",
+			Action:   domain.AuditActionMaintenance,
+			Before:   map[string]interface{}{"enabled": false},
+			After:    map[string]interface{}{"enabled": true},
+		})
+		require.NoError(t, err)
+
+		entries, err := repo.GetEntries(ctx, domain.AuditFilter{Username: "admin_user"})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, map[string]interface{}{"enabled": false}, entries[0].Before)
+		require.Equal(t, map[string]interface{}{"enabled": true}, entries[0].After)
+	})
+}
+
+// runAuditRepositoryTests runs the audit behaviour every implementation shares
+func runAuditRepositoryTests(t *testing.T, ctx context.Context, repo repository.AuditRepository) {
+	t.Helper()
+
+	t.Run("RecordEntry assigns ID and timestamp", func(t *testing.T) {
+		entry := &domain.AuditEntry{
+			Username: "testuser",
+			Action:   domain.AuditActionCommit,
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+			Reason:   "fix typo in names",
+		}
+
+		err := repo.RecordEntry(ctx, entry)
+		require.NoError(t, err)
+		require.NotEmpty(t, entry.ID)
+		require.False(t, entry.CreatedAt.IsZero())
+	})
+
+	t.Run("RecordEntry rejects nil entry", func(t *testing.T) {
+		err := repo.RecordEntry(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("GetEntries filters and returns newest first", func(t *testing.T) {
+		require.NoError(t, repo.RecordEntry(ctx, &domain.AuditEntry{Username: "otheruser", Action: domain.AuditActionCommit, Table: "posts"}))
+		require.NoError(t, repo.RecordEntry(ctx, &domain.AuditEntry{Username: "testuser", Action: domain.AuditActionCommit, Table: "posts"}))
+
+		entries, err := repo.GetEntries(ctx, domain.AuditFilter{Username: "testuser"})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "posts", entries[0].Table)
+		require.Equal(t, "users", entries[1].Table)
+	})
+
+	t.Run("GetEntries respects limit", func(t *testing.T) {
+		entries, err := repo.GetEntries(ctx, domain.AuditFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+
+	t.Run("GetEntries filters by time range", func(t *testing.T) {
+		now := time.Now()
+		require.NoError(t, repo.RecordEntry(ctx, &domain.AuditEntry{Username: "timeuser", Action: domain.AuditActionCommit, Table: "old", CreatedAt: now.Add(-2 * time.Hour)}))
+		require.NoError(t, repo.RecordEntry(ctx, &domain.AuditEntry{Username: "timeuser", Action: domain.AuditActionCommit, Table: "recent"}))
+
+		recent, err := repo.GetEntries(ctx, domain.AuditFilter{Username: "timeuser", Since: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, recent, 1)
+		require.Equal(t, "recent", recent[0].Table)
+
+		old, err := repo.GetEntries(ctx, domain.AuditFilter{Username: "timeuser", Until: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, old, 1)
+		require.Equal(t, "old", old[0].Table)
+	})
+
+	t.Run("Deleted rows are kept under their audit entry", func(t *testing.T) {
+		entry := &domain.AuditEntry{Username: "deleteuser", Action: domain.AuditActionCommit, Database: "testdb", Schema: "public", Table: "users"}
+		require.NoError(t, repo.RecordEntry(ctx, entry))
+
+		err := repo.RecordDeletedRows(ctx, &domain.DeletedRows{
+			EntryID:   entry.ID,
+			Database:  "testdb",
+			Schema:    "public",
+			Table:     "users",
+			Rows:      []map[string]interface{}{{"id": 9007199254740993, "name": "Ann"}},
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		rows, err := repo.GetDeletedRows(ctx, entry.ID)
+		require.NoError(t, err)
+		require.Equal(t, "users", rows.Table)
+		require.Len(t, rows.Rows, 1)
+		require.Equal(t, "9007199254740993", fmt.Sprint(rows.Rows[0]["id"]))
+		require.Equal(t, "Ann", rows.Rows[0]["name"])
+	})
+
+	t.Run("Expired deleted rows are not returned", func(t *testing.T) {
+		entry := &domain.AuditEntry{Username: "deleteuser", Action: domain.AuditActionCommit, Table: "users"}
+		require.NoError(t, repo.RecordEntry(ctx, entry))
+		require.NoError(t, repo.RecordDeletedRows(ctx, &domain.DeletedRows{
+			EntryID:   entry.ID,
+			Table:     "users",
+			Rows:      []map[string]interface{}{{"id": 1}},
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}))
+
+		_, err := repo.GetDeletedRows(ctx, entry.ID)
+		require.ErrorIs(t, err, domain.ErrDeletedRowsNotFound)
+
+		_, err = repo.GetDeletedRows(ctx, "audit_missing")
+		require.ErrorIs(t, err, domain.ErrDeletedRowsNotFound)
+	})
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// ConfigRepositoryConstructor is a function type that creates a ConfigRepository
+type ConfigRepositoryConstructor func(config domain.AppConfig) repository.ConfigRepository
+
+// ConfigRepositoryRunner runs all config repository tests against an implementation
+func ConfigRepositoryRunner(t *testing.T, constructor ConfigRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	repo := constructor(domain.AppConfig{RequireChangeReason: true})
+
+	t.Run("GetConfig returns initial settings", func(t *testing.T) {
+		config, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		require.True(t, config.RequireChangeReason)
+	})
+
+	t.Run("GetConfig returns a copy", func(t *testing.T) {
+		config, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		config.RequireChangeReason = false
+
+		stored, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		require.True(t, stored.RequireChangeReason)
+	})
+
+	t.Run("UpdateConfig replaces settings", func(t *testing.T) {
+		err := repo.UpdateConfig(ctx, &domain.AppConfig{RequireChangeReason: false})
+		require.NoError(t, err)
+
+		config, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		require.False(t, config.RequireChangeReason)
+	})
+
+	t.Run("UpdateConfig rejects nil config", func(t *testing.T) {
+		err := repo.UpdateConfig(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("GetConfig copies sensitive tables and approvers", func(t *testing.T) {
+		err := repo.UpdateConfig(ctx, &domain.AppConfig{
+			SensitiveTables: []string{"public.payments"},
+			Approvers:       []string{"lead"},
+		})
+		require.NoError(t, err)
+
+		config, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		config.SensitiveTables[0] = "public.users"
+		config.Approvers[0] = "intern"
+
+		stored, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"public.payments"}, stored.SensitiveTables)
+		require.Equal(t, []string{"lead"}, stored.Approvers)
+	})
+
+	t.Run("GetConfig copies connection profiles", func(t *testing.T) {
+		err := repo.UpdateConfig(ctx, &domain.AppConfig{
+			ConnectionProfiles: []domain.ConnectionProfile{{Name: "primary", Host: "db1", Port: 5432}},
+		})
+		require.NoError(t, err)
+
+		config, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		config.ConnectionProfiles[0].Host = "db2"
+
+		stored, err := repo.GetConfig(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "db1", stored.ConnectionProfiles[0].Host)
+	})
+}
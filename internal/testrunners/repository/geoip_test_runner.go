@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// GeoIPRepositoryConstructor is a function type that creates a GeoIPRepository
+type GeoIPRepositoryConstructor func() repository.GeoIPRepository
+
+// GeoIPRepositoryRunner runs all GeoIP repository tests against an implementation
+func GeoIPRepositoryRunner(t *testing.T, constructor GeoIPRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	repo := constructor()
+
+	path := filepath.Join(t.TempDir(), "geoip.csv")
+	require.NoError(t, os.WriteFile(path, []byte(`network,country_code,country,region,city
+# Documentation ranges
+192.0.2.0/24,nl,Netherlands,North Holland,Amsterdam
+198.51.100.0/22,ID,Indonesia,Jakarta,
+198.51.100.128/25,ID,Indonesia,West Java,Bandung
+2001:db8::/32,DE,Germany,Berlin,Berlin
+`), 0o600))
+
+	t.Run("LookupLocation finds the network holding the address", func(t *testing.T) {
+		location, err := repo.LookupLocation(ctx, path, "192.0.2.17")
+		require.NoError(t, err)
+		require.Equal(t, &domain.GeoLocation{CountryCode: "NL", Country: "Netherlands", Region: "North Holland", City: "Amsterdam"}, location)
+	})
+
+	t.Run("LookupLocation prefers the most specific network", func(t *testing.T) {
+		location, err := repo.LookupLocation(ctx, path, "198.51.100.200")
+		require.NoError(t, err)
+		require.Equal(t, "West Java", location.Region)
+
+		location, err = repo.LookupLocation(ctx, path, "198.51.101.1")
+		require.NoError(t, err)
+		require.Equal(t, "Jakarta", location.Region)
+	})
+
+	t.Run("LookupLocation handles IPv6 and IPv4-mapped addresses", func(t *testing.T) {
+		location, err := repo.LookupLocation(ctx, path, "2001:db8::1")
+		require.NoError(t, err)
+		require.Equal(t, "DE", location.CountryCode)
+
+		location, err = repo.LookupLocation(ctx, path, "::ffff:192.0.2.1")
+		require.NoError(t, err)
+		require.Equal(t, "NL", location.CountryCode)
+	})
+
+	t.Run("LookupLocation returns nil for uncovered addresses", func(t *testing.T) {
+		location, err := repo.LookupLocation(ctx, path, "203.0.113.9")
+		require.NoError(t, err)
+		require.Nil(t, location)
+	})
+
+	t.Run("LookupLocation rejects invalid addresses and unreadable databases", func(t *testing.T) {
+		_, err := repo.LookupLocation(ctx, path, "not-an-ip")
+		require.Error(t, err)
+
+		_, err = repo.LookupLocation(ctx, filepath.Join(t.TempDir(), "missing.csv"), "192.0.2.1")
+		require.Error(t, err)
+
+		broken := filepath.Join(t.TempDir(), "broken.csv")
+		require.NoError(t, os.WriteFile(broken, []byte("192.0.2/24,NL,Netherlands,,\n"), 0o600))
+		_, err = repo.LookupLocation(ctx, broken, "192.0.2.1")
+		require.Error(t, err)
+	})
+
+	t.Run("LookupLocation reloads a replaced database", func(t *testing.T) {
+		replaced := filepath.Join(t.TempDir(), "geoip.csv")
+		require.NoError(t, os.WriteFile(replaced, []byte("203.0.113.0/24,FR,France,Ile-de-France,Paris\n"), 0o600))
+		location, err := repo.LookupLocation(ctx, replaced, "203.0.113.9")
+		require.NoError(t, err)
+		require.Equal(t, "FR", location.CountryCode)
+
+		require.NoError(t, os.WriteFile(replaced, []byte("203.0.113.0/24,BE,Belgium,Brussels,Brussels\n"), 0o600))
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(replaced, later, later))
+		location, err = repo.LookupLocation(ctx, replaced, "203.0.113.9")
+		require.NoError(t, err)
+		require.Equal(t, "BE", location.CountryCode)
+	})
+}
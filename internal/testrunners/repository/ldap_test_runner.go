@@ -0,0 +1,358 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// LDAPAuthenticatorConstructor is a function type that creates an LDAPAuthenticator
+type LDAPAuthenticatorConstructor func(config domain.LDAPConfig) repository.LDAPAuthenticator
+
+// fakeLDAPEntry is a directory entry of the fake server; attribute names are lower-cased
+type fakeLDAPEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+// fakeLDAPServer answers simple binds and equality searches over an in-memory directory, enough to
+// exercise an LDAP client without a directory server; with a TLS config it also answers StartTLS
+type fakeLDAPServer struct {
+	listener  net.Listener
+	entries   []fakeLDAPEntry
+	tlsConfig *tls.Config
+
+	mu             sync.Mutex
+	binds          []string
+	plaintextBinds int
+}
+
+type fakeBER struct {
+	tag      byte
+	value    []byte
+	children []fakeBER
+}
+
+func newFakeLDAPServer(t *testing.T, entries []fakeLDAPEntry, tlsConfig *tls.Config) *fakeLDAPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeLDAPServer{listener: listener, entries: entries, tlsConfig: tlsConfig}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *fakeLDAPServer) url() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *fakeLDAPServer) boundDNs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+func (s *fakeLDAPServer) plaintextBindCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.plaintextBinds
+}
+
+// fakeLDAPCertificate returns a server certificate for 127.0.0.1 and a file holding its CA in PEM
+func fakeLDAPCertificate(t *testing.T) (*tls.Config, string) {
+	issuer := httptest.NewUnstartedServer(nil)
+	issuer.StartTLS()
+	defer issuer.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ldap-ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: issuer.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+	return &tls.Config{Certificates: issuer.TLS.Certificates}, caFile
+}
+
+func (s *fakeLDAPServer) serve(conn net.Conn) {
+	defer func() { conn.Close() }()
+	reader := bufio.NewReader(conn)
+	bound := ""
+	secure := false
+
+	for {
+		message, err := fakeReadBER(reader)
+		if err != nil || len(message.children) < 2 {
+			return
+		}
+		id := message.children[0].value
+		op := message.children[1]
+
+		switch op.tag {
+		case 0x60: // BindRequest
+			dn := string(op.children[1].value)
+			password := string(op.children[2].value)
+			s.mu.Lock()
+			s.binds = append(s.binds, dn)
+			if !secure {
+				s.plaintextBinds++
+			}
+			s.mu.Unlock()
+
+			code := 49
+			if dn == "" {
+				code = 0
+			}
+			for _, entry := range s.entries {
+				if entry.dn == dn && entry.password == password && password != "" {
+					code = 0
+				}
+			}
+			if code == 0 {
+				bound = dn
+			}
+			conn.Write(fakeMessage(id, fakeTLV(0x61, fakeResult(code))))
+		case 0x63: // SearchRequest
+			if bound == "" {
+				conn.Write(fakeMessage(id, fakeTLV(0x65, fakeResult(50))))
+				continue
+			}
+			sizeLimit := int(op.children[3].value[0])
+			filter := op.children[6]
+			attribute := strings.ToLower(string(filter.children[0].value))
+			value := string(filter.children[1].value)
+
+			var matches []fakeLDAPEntry
+			for _, entry := range s.entries {
+				for _, v := range entry.attributes[attribute] {
+					if strings.EqualFold(v, value) {
+						matches = append(matches, entry)
+						break
+					}
+				}
+			}
+			code := 0
+			if sizeLimit > 0 && len(matches) > sizeLimit {
+				matches = matches[:sizeLimit]
+				code = 4
+			}
+			for _, entry := range matches {
+				var attributes []byte
+				for _, requested := range op.children[7].children {
+					name := string(requested.value)
+					var values []byte
+					for _, v := range entry.attributes[strings.ToLower(name)] {
+						values = append(values, fakeTLV(0x04, []byte(v))...)
+					}
+					attributes = append(attributes, fakeTLV(0x30, append(fakeTLV(0x04, []byte(name)), fakeTLV(0x31, values)...))...)
+				}
+				conn.Write(fakeMessage(id, fakeTLV(0x64, append(fakeTLV(0x04, []byte(entry.dn)), fakeTLV(0x30, attributes)...))))
+			}
+			conn.Write(fakeMessage(id, fakeTLV(0x65, fakeResult(code))))
+		case 0x77: // ExtendedRequest, only StartTLS
+			if s.tlsConfig == nil || secure || string(op.children[0].value) != "1.3.6.1.4.1.1466.20037" {
+				conn.Write(fakeMessage(id, fakeTLV(0x78, fakeResult(2))))
+				continue
+			}
+			conn.Write(fakeMessage(id, fakeTLV(0x78, fakeResult(0))))
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			reader = bufio.NewReader(conn)
+			secure = true
+		case 0x42: // UnbindRequest
+			return
+		}
+	}
+}
+
+func fakeTLV(tag byte, value []byte) []byte {
+	n := len(value)
+	if n < 0x80 {
+		return append([]byte{tag, byte(n)}, value...)
+	}
+	return append([]byte{tag, 0x82, byte(n >> 8), byte(n)}, value...)
+}
+
+func fakeResult(code int) []byte {
+	result := fakeTLV(0x0a, []byte{byte(code)})
+	result = append(result, fakeTLV(0x04, nil)...)
+	return append(result, fakeTLV(0x04, nil)...)
+}
+
+func fakeMessage(id []byte, op []byte) []byte {
+	return fakeTLV(0x30, append(fakeTLV(0x02, id), op...))
+}
+
+func fakeReadBER(r *bufio.Reader) (fakeBER, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return fakeBER{}, err
+	}
+	first, err := r.ReadByte()
+	if err != nil {
+		return fakeBER{}, err
+	}
+	length := int(first)
+	if first >= 0x80 {
+		length = 0
+		for i := 0; i < int(first&0x7f); i++ {
+			b, err := r.ReadByte()
+			if err != nil {
+				return fakeBER{}, err
+			}
+			length = length<<8 | int(b)
+		}
+	}
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return fakeBER{}, err
+	}
+
+	element := fakeBER{tag: tag, value: value}
+	if tag&0x20 != 0 {
+		inner := bufio.NewReader(strings.NewReader(string(value)))
+		for {
+			child, err := fakeReadBER(inner)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fakeBER{}, err
+			}
+			element.children = append(element.children, child)
+		}
+	}
+	return element, nil
+}
+
+// LDAPAuthenticatorRunner runs the LDAPAuthenticator tests against an in-process directory
+func LDAPAuthenticatorRunner(t *testing.T, constructor LDAPAuthenticatorConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	serverTLS, caFile := fakeLDAPCertificate(t)
+	server := newFakeLDAPServer(t, []fakeLDAPEntry{
+		{dn: "cn=search,dc=example,dc=com", password: "search-secret"},
+		{
+			dn:       "uid=alice,ou=people,dc=example,dc=com",
+			password: "alice-secret",
+			attributes: map[string][]string{
+				"uid":      {"alice"},
+				"memberof": {"cn=staff,ou=groups,dc=example,dc=com", "cn=dba,ou=groups,dc=example,dc=com"},
+			},
+		},
+		{dn: "uid=sam,ou=people,dc=example,dc=com", password: "sam-1", attributes: map[string][]string{"uid": {"sam"}}},
+		{dn: "uid=sam,ou=contractors,dc=example,dc=com", password: "sam-2", attributes: map[string][]string{"uid": {"sam"}}},
+	}, serverTLS)
+
+	config := domain.LDAPConfig{
+		URL:          server.url(),
+		CACertFile:   caFile,
+		BindDN:       "cn=search,dc=example,dc=com",
+		BindPassword: "search-secret",
+		BaseDN:       "dc=example,dc=com",
+		RoleMappings: []domain.SSORoleMapping{
+			{Claim: "memberOf", Value: "cn=dba,ou=groups,dc=example,dc=com", Role: "lumen_admin"},
+		},
+	}
+	auth := constructor(config)
+
+	t.Run("Authenticate returns the entry with its mapping attributes", func(t *testing.T) {
+		entry, err := auth.Authenticate(ctx, "alice", "alice-secret")
+		require.NoError(t, err)
+		require.Equal(t, "uid=alice,ou=people,dc=example,dc=com", entry.DN)
+		require.Equal(t, []string{"alice"}, entry.Attributes["uid"])
+		require.Contains(t, entry.Attributes["memberof"], "cn=dba,ou=groups,dc=example,dc=com")
+	})
+
+	t.Run("Authenticate rejects a wrong password", func(t *testing.T) {
+		_, err := auth.Authenticate(ctx, "alice", "guess")
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("Authenticate rejects an unknown user", func(t *testing.T) {
+		_, err := auth.Authenticate(ctx, "mallory", "anything")
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("Authenticate never binds with an empty password", func(t *testing.T) {
+		before := len(server.boundDNs())
+
+		_, err := auth.Authenticate(ctx, "alice", "")
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+		require.Len(t, server.boundDNs(), before)
+	})
+
+	t.Run("Authenticate rejects a login name matching several entries", func(t *testing.T) {
+		_, err := auth.Authenticate(ctx, "sam", "sam-1")
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("Authenticate reports a misconfigured search account", func(t *testing.T) {
+		misconfigured := config
+		misconfigured.BindPassword = "stale"
+
+		_, err := constructor(misconfigured).Authenticate(ctx, "alice", "alice-secret")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("Authenticate upgrades ldap:// with StartTLS before any bind", func(t *testing.T) {
+		_, err := auth.Authenticate(ctx, "alice", "alice-secret")
+		require.NoError(t, err)
+		require.NotEmpty(t, server.boundDNs())
+		require.Zero(t, server.plaintextBindCount())
+	})
+
+	t.Run("Authenticate refuses a server without StartTLS unless plaintext is allowed", func(t *testing.T) {
+		plain := newFakeLDAPServer(t, []fakeLDAPEntry{
+			{dn: "cn=search,dc=example,dc=com", password: "search-secret"},
+			{dn: "uid=alice,ou=people,dc=example,dc=com", password: "alice-secret", attributes: map[string][]string{"uid": {"alice"}}},
+		}, nil)
+		plainConfig := config
+		plainConfig.URL = plain.url()
+
+		_, err := constructor(plainConfig).Authenticate(ctx, "alice", "alice-secret")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, domain.ErrInvalidCredentials)
+		require.Empty(t, plain.boundDNs())
+
+		plainConfig.AllowPlaintext = true
+		entry, err := constructor(plainConfig).Authenticate(ctx, "alice", "alice-secret")
+		require.NoError(t, err)
+		require.Equal(t, "uid=alice,ou=people,dc=example,dc=com", entry.DN)
+	})
+
+	t.Run("Authenticate rejects a directory certificate not signed by the configured CA", func(t *testing.T) {
+		untrusted := config
+		untrusted.CACertFile = ""
+
+		_, err := constructor(untrusted).Authenticate(ctx, "alice", "alice-secret")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+}
@@ -3,10 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 )
 
@@ -487,4 +490,42 @@ func LoggerRepositoryRunner(t *testing.T, constructor LoggerRepositoryConstructo
 		})
 		require.NoError(t, err)
 	})
+
+	t.Run("LogSlowQuery keeps queries newest first", func(t *testing.T) {
+		slowRepo := constructor()
+		first := &domain.SlowQuery{Username: "alice", Statement: "SELECT * FROM orders WHERE id = $1", Duration: 2 * time.Second}
+		require.NoError(t, slowRepo.LogSlowQuery(ctx, first))
+		require.NotEmpty(t, first.ID)
+		require.False(t, first.RecordedAt.IsZero())
+
+		require.NoError(t, slowRepo.LogSlowQuery(ctx, &domain.SlowQuery{Username: "bob", Statement: "SELECT pg_sleep($1)", Duration: 3 * time.Second}))
+
+		queries, err := slowRepo.GetSlowQueries(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, queries, 2)
+		require.Equal(t, "bob", queries[0].Username)
+		require.Equal(t, "alice", queries[1].Username)
+
+		limited, err := slowRepo.GetSlowQueries(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, limited, 1)
+		require.Equal(t, "bob", limited[0].Username)
+	})
+
+	t.Run("LogSlowQuery drops the oldest past the log size", func(t *testing.T) {
+		slowRepo := constructor()
+		for i := 0; i < domain.SlowQueryLogSize+5; i++ {
+			require.NoError(t, slowRepo.LogSlowQuery(ctx, &domain.SlowQuery{Statement: fmt.Sprintf("SELECT %d", i)}))
+		}
+
+		queries, err := slowRepo.GetSlowQueries(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, queries, domain.SlowQueryLogSize)
+		require.Equal(t, fmt.Sprintf("SELECT %d", domain.SlowQueryLogSize+4), queries[0].Statement)
+		require.Equal(t, "SELECT 5", queries[len(queries)-1].Statement)
+	})
+
+	t.Run("LogSlowQuery rejects nil", func(t *testing.T) {
+		require.Error(t, repo.LogSlowQuery(ctx, nil))
+	})
 }
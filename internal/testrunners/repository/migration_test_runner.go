@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// MigrationRepositoryConstructor is a function type that creates a MigrationRepository
+type MigrationRepositoryConstructor func(db *sql.DB) repository.MigrationRepository
+
+// MigrationRepositoryRunner runs all migration repository tests against an implementation
+func MigrationRepositoryRunner(t *testing.T, constructor MigrationRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	container, err := postgres.RunContainer(ctx,
+		testcontainers.WithImage("postgres:15"),
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("testuser"),
+		postgres.WithPassword("testpass"),
+	)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	connStr, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = db.PingContext(ctx)
+	require.NoError(t, err)
+
+	repo := constructor(db)
+
+	t.Run("CurrentVersion is zero before any migration", func(t *testing.T) {
+		version, err := repo.CurrentVersion(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 0, version)
+	})
+
+	t.Run("Migrate creates lumen-pg's tables in their own schema", func(t *testing.T) {
+		applied, err := repo.Migrate(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, applied, repo.LatestVersion())
+
+		version, err := repo.CurrentVersion(ctx)
+		require.NoError(t, err)
+		require.Equal(t, repo.LatestVersion(), version)
+
+		for _, table := range []string{"audit_log", "user_preferences", "saved_queries", "jobs", "query_notebooks"} {
+			var found sql.NullString
+			err := db.QueryRowContext(ctx, "SELECT to_regclass($1)::text", domain.AppSchema+"."+table).Scan(&found)
+			require.NoError(t, err)
+			require.True(t, found.Valid, "missing table %s", table)
+		}
+	})
+
+	t.Run("Migrate applies nothing once up to date", func(t *testing.T) {
+		applied, err := repo.Migrate(ctx)
+
+		require.NoError(t, err)
+		require.Empty(t, applied)
+	})
+
+	t.Run("Migrate refuses a schema newer than the build", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "INSERT INTO "+domain.AppSchema+".schema_migrations (version, name) VALUES ($1, 'from_the_future')", repo.LatestVersion()+1)
+		require.NoError(t, err)
+
+		_, err = repo.Migrate(ctx)
+
+		require.ErrorIs(t, err, domain.ErrAppSchemaTooNew)
+	})
+}
+
+// startAppSchemaDatabase starts a PostgreSQL container with lumen-pg's schema migrated, for the
+// repositories that keep app data there; the container stops when the test ends
+func startAppSchemaDatabase(t *testing.T, ctx context.Context, migrationConstructor MigrationRepositoryConstructor) *sql.DB {
+	t.Helper()
+
+	container, err := postgres.RunContainer(ctx,
+		testcontainers.WithImage("postgres:15"),
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("testuser"),
+		postgres.WithPassword("testpass"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { container.Terminate(ctx) })
+
+	connStr, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, db.PingContext(ctx))
+
+	_, err = migrationConstructor(db).Migrate(ctx)
+	require.NoError(t, err)
+	return db
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// MonitoringRepositoryConstructor is a function type that creates a MonitoringRepository
+type MonitoringRepositoryConstructor func(db *sql.DB) repository.MonitoringRepository
+
+// MonitoringRepositoryRunner runs all monitoring repository tests against an implementation
+func MonitoringRepositoryRunner(t *testing.T, constructor MonitoringRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	container, err := postgres.RunContainer(ctx,
+		testcontainers.WithImage("postgres:15"),
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("testuser"),
+		postgres.WithPassword("testpass"),
+	)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	connStr, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.PingContext(ctx))
+
+	repo := constructor(db)
+
+	// A second pool stands in for another client, so its backend is not the repository's own
+	other, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer other.Close()
+
+	t.Run("GetBackendActivity lists other client backends with their query", func(t *testing.T) {
+		sleeping := make(chan error, 1)
+		go func() {
+			_, err := other.ExecContext(ctx, "SELECT pg_sleep(5)")
+			sleeping <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			backends, err := repo.GetBackendActivity(ctx)
+			require.NoError(t, err)
+			for _, backend := range backends {
+				if backend.Query == "SELECT pg_sleep(5)" {
+					return backend.State == "active" &&
+						backend.Database == "testdb" &&
+						backend.Username == "testuser" &&
+						backend.WaitEventType == "Timeout" &&
+						!backend.QueryStart.IsZero() &&
+						backend.Duration > 0
+				}
+			}
+			return false
+		}, 4*time.Second, 100*time.Millisecond)
+
+		require.NoError(t, <-sleeping)
+	})
+
+	t.Run("GetLockWaits pairs a blocked backend with the one holding its lock", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "CREATE TABLE lock_items (id INT PRIMARY KEY, name TEXT); INSERT INTO lock_items VALUES (1, 'first')")
+		require.NoError(t, err)
+
+		holder, err := other.Conn(ctx)
+		require.NoError(t, err)
+		defer holder.Close()
+		waiter, err := other.Conn(ctx)
+		require.NoError(t, err)
+		defer waiter.Close()
+
+		var holderPID, waiterPID int
+		require.NoError(t, holder.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&holderPID))
+		require.NoError(t, waiter.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&waiterPID))
+
+		_, err = holder.ExecContext(ctx, "BEGIN; UPDATE lock_items SET name = 'held' WHERE id = 1")
+		require.NoError(t, err)
+
+		waiting := make(chan error, 1)
+		go func() {
+			_, err := waiter.ExecContext(ctx, "UPDATE lock_items SET name = 'waiting' WHERE id = 1")
+			waiting <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			waits, err := repo.GetLockWaits(ctx)
+			require.NoError(t, err)
+			for _, wait := range waits {
+				if wait.Blocked.PID == waiterPID {
+					return wait.Blocking.PID == holderPID &&
+						wait.Blocking.State == "idle in transaction" &&
+						wait.Blocked.Database == "testdb" &&
+						wait.LockType == "transactionid" &&
+						wait.Mode == "ShareLock"
+				}
+			}
+			return false
+		}, 4*time.Second, 100*time.Millisecond)
+
+		_, err = holder.ExecContext(ctx, "COMMIT")
+		require.NoError(t, err)
+		require.NoError(t, <-waiting)
+
+		waits, err := repo.GetLockWaits(ctx)
+		require.NoError(t, err)
+		require.Empty(t, waits)
+	})
+
+	t.Run("TerminateBackend ends another backend", func(t *testing.T) {
+		conn, err := other.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var pid int
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid))
+
+		terminated, err := repo.TerminateBackend(ctx, pid)
+		require.NoError(t, err)
+		require.True(t, terminated)
+
+		require.Error(t, conn.PingContext(ctx))
+	})
+
+	t.Run("TerminateBackend reports a PID with no backend", func(t *testing.T) {
+		terminated, err := repo.TerminateBackend(ctx, 1)
+		require.NoError(t, err)
+		require.False(t, terminated)
+	})
+
+	t.Run("GetServerStats reports each database with its size and the background writer", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "SELECT 1")
+		require.NoError(t, err)
+
+		stats, err := repo.GetServerStats(ctx)
+		require.NoError(t, err)
+		require.False(t, stats.TakenAt.IsZero())
+		require.Greater(t, stats.MaxConnections, 0)
+		require.False(t, stats.BackgroundWriter.StatsReset.IsZero())
+
+		var found bool
+		for _, database := range stats.Databases {
+			if database.Name != "testdb" {
+				continue
+			}
+			found = true
+			require.GreaterOrEqual(t, database.Connections, 1)
+			require.Greater(t, database.XactCommit, int64(0))
+			require.Greater(t, database.BlocksHit, int64(0))
+			require.NotNil(t, database.SizeBytes)
+			require.Greater(t, *database.SizeBytes, int64(0))
+		}
+		require.True(t, found)
+	})
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// NotebookRepositoryConstructor is a function type that creates a NotebookRepository
+type NotebookRepositoryConstructor func() repository.NotebookRepository
+
+// NotebookPostgresRepositoryConstructor is a function type that creates a NotebookRepository over lumen-pg's schema
+type NotebookPostgresRepositoryConstructor func(db *sql.DB) repository.NotebookRepository
+
+// NotebookRepositoryRunner runs all notebook repository tests against an implementation
+func NotebookRepositoryRunner(t *testing.T, constructor NotebookRepositoryConstructor) {
+	t.Helper()
+
+	runNotebookRepositoryTests(t, context.Background(), constructor())
+}
+
+// NotebookPostgresRepositoryRunner runs the notebook repository tests against an implementation over
+// lumen-pg's own schema in a PostgreSQL container
+func NotebookPostgresRepositoryRunner(t *testing.T, migrationConstructor MigrationRepositoryConstructor, constructor NotebookPostgresRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	db := startAppSchemaDatabase(t, ctx, migrationConstructor)
+
+	runNotebookRepositoryTests(t, ctx, constructor(db))
+}
+
+// runNotebookRepositoryTests runs the notebook behaviour every implementation shares
+func runNotebookRepositoryTests(t *testing.T, ctx context.Context, repo repository.NotebookRepository) {
+	t.Helper()
+
+	notebook := &domain.QueryNotebook{
+		Username: "testuser",
+		Title:    "Weekly signups",
+		Cells: []domain.NotebookCell{
+			{ID: "cell_1", Kind: domain.NotebookCellMarkdown, Source: "Signups by week"},
+			{ID: "cell_2", Kind: domain.NotebookCellSQL, Source: "SELECT * FROM signups"},
+		},
+	}
+
+	t.Run("CreateNotebook assigns ID and timestamps", func(t *testing.T) {
+		err := repo.CreateNotebook(ctx, notebook)
+		require.NoError(t, err)
+		require.NotEmpty(t, notebook.ID)
+		require.False(t, notebook.CreatedAt.IsZero())
+		require.Equal(t, notebook.CreatedAt, notebook.UpdatedAt)
+	})
+
+	t.Run("GetNotebook returns notebook owned by user with its cells in order", func(t *testing.T) {
+		retrieved, err := repo.GetNotebook(ctx, "testuser", notebook.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Weekly signups", retrieved.Title)
+		require.Len(t, retrieved.Cells, 2)
+		require.Equal(t, "cell_1", retrieved.Cells[0].ID)
+		require.Equal(t, domain.NotebookCellSQL, retrieved.Cells[1].Kind)
+	})
+
+	t.Run("GetNotebook hides other users' notebooks", func(t *testing.T) {
+		_, err := repo.GetNotebook(ctx, "otheruser", notebook.ID)
+		require.ErrorIs(t, err, domain.ErrNotebookNotFound)
+	})
+
+	t.Run("ListNotebooks orders by title", func(t *testing.T) {
+		err := repo.CreateNotebook(ctx, &domain.QueryNotebook{Username: "testuser", Title: "Audit checks"})
+		require.NoError(t, err)
+
+		notebooks, err := repo.ListNotebooks(ctx, "testuser")
+		require.NoError(t, err)
+		require.Len(t, notebooks, 2)
+		require.Equal(t, "Audit checks", notebooks[0].Title)
+		require.Equal(t, "Weekly signups", notebooks[1].Title)
+
+		others, err := repo.ListNotebooks(ctx, "otheruser")
+		require.NoError(t, err)
+		require.Empty(t, others)
+	})
+
+	t.Run("UpdateNotebook keeps creation time and saves results", func(t *testing.T) {
+		updated := &domain.QueryNotebook{
+			ID:       notebook.ID,
+			Username: "testuser",
+			Title:    "Daily signups",
+			Cells: []domain.NotebookCell{
+				{ID: "cell_2", Kind: domain.NotebookCellSQL, Source: "SELECT * FROM signups", Error: "relation \"signups\" does not exist"},
+			},
+		}
+		err := repo.UpdateNotebook(ctx, updated)
+		require.NoError(t, err)
+		require.True(t, notebook.CreatedAt.Equal(updated.CreatedAt))
+
+		retrieved, err := repo.GetNotebook(ctx, "testuser", notebook.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Daily signups", retrieved.Title)
+		require.Len(t, retrieved.Cells, 1)
+		require.Contains(t, retrieved.Cells[0].Error, "does not exist")
+	})
+
+	t.Run("UpdateNotebook rejects unknown notebook", func(t *testing.T) {
+		err := repo.UpdateNotebook(ctx, &domain.QueryNotebook{ID: notebook.ID, Username: "otheruser"})
+		require.ErrorIs(t, err, domain.ErrNotebookNotFound)
+	})
+
+	t.Run("DeleteNotebook removes notebook", func(t *testing.T) {
+		err := repo.DeleteNotebook(ctx, "testuser", notebook.ID)
+		require.NoError(t, err)
+
+		_, err = repo.GetNotebook(ctx, "testuser", notebook.ID)
+		require.ErrorIs(t, err, domain.ErrNotebookNotFound)
+
+		err = repo.DeleteNotebook(ctx, "testuser", notebook.ID)
+		require.ErrorIs(t, err, domain.ErrNotebookNotFound)
+	})
+}
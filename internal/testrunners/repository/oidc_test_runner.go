@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// OIDCRepositoryConstructor is a function type that creates an OIDCRepository
+type OIDCRepositoryConstructor func(config domain.SSOConfig, client *http.Client) repository.OIDCRepository
+
+// fakeOIDCProvider is an in-process OpenID Connect provider that answers every authorization code with
+// the ID token claims queued for it
+type fakeOIDCProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+
+	mu     sync.Mutex
+	claims map[string]interface{}
+	// signer overrides the key used to sign the next ID token
+	signer *rsa.PrivateKey
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &fakeOIDCProvider{key: key, kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": p.kid,
+				"kty": "RSA",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, ok := r.BasicAuth()
+		if !ok || clientID != "lumen-pg" || secret != "client-secret" || r.PostFormValue("code") != "valid-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": p.idToken(t)})
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// issue queues the claims for the next ID token, filling in valid defaults for anything not given
+func (p *fakeOIDCProvider) issue(claims map[string]interface{}, signer *rsa.PrivateKey) {
+	full := map[string]interface{}{
+		"iss":   p.server.URL,
+		"aud":   "lumen-pg",
+		"sub":   "user-123",
+		"email": "alice@example.com",
+		"nonce": "nonce-token",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+	}
+	for name, value := range claims {
+		full[name] = value
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.claims = full
+	p.signer = signer
+}
+
+func (p *fakeOIDCProvider) idToken(t *testing.T) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	signer := p.key
+	if p.signer != nil {
+		signer = p.signer
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": p.kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(p.claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, signer, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// OIDCRepositoryRunner runs the OIDCRepository tests against an in-process provider
+func OIDCRepositoryRunner(t *testing.T, constructor OIDCRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	provider := newFakeOIDCProvider(t)
+
+	repo := constructor(domain.SSOConfig{
+		IssuerURL:    provider.server.URL,
+		ClientID:     "lumen-pg",
+		ClientSecret: "client-secret",
+		RedirectURL:  "http://localhost:8080/login/sso/callback",
+		Scopes:       []string{"email", "groups"},
+	}, provider.server.Client())
+
+	t.Run("AuthCodeURL points at the discovered authorization endpoint", func(t *testing.T) {
+		authURL, err := repo.AuthCodeURL(ctx, "state-token", "nonce-token")
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(authURL)
+		require.NoError(t, err)
+		require.Equal(t, provider.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+
+		query := parsed.Query()
+		require.Equal(t, "code", query.Get("response_type"))
+		require.Equal(t, "lumen-pg", query.Get("client_id"))
+		require.Equal(t, "http://localhost:8080/login/sso/callback", query.Get("redirect_uri"))
+		require.Equal(t, "openid email groups", query.Get("scope"))
+		require.Equal(t, "state-token", query.Get("state"))
+		require.Equal(t, "nonce-token", query.Get("nonce"))
+	})
+
+	t.Run("ExchangeCode returns the verified identity", func(t *testing.T) {
+		provider.issue(map[string]interface{}{"groups": []string{"staff", "dba"}}, nil)
+
+		identity, err := repo.ExchangeCode(ctx, "valid-code", "nonce-token")
+		require.NoError(t, err)
+		require.Equal(t, "user-123", identity.Subject)
+		require.Equal(t, "alice@example.com", identity.Email)
+		require.Equal(t, []interface{}{"staff", "dba"}, identity.Claims["groups"])
+	})
+
+	t.Run("ExchangeCode accepts an audience list naming the client", func(t *testing.T) {
+		provider.issue(map[string]interface{}{"aud": []string{"other-app", "lumen-pg"}}, nil)
+
+		_, err := repo.ExchangeCode(ctx, "valid-code", "nonce-token")
+		require.NoError(t, err)
+	})
+
+	t.Run("ExchangeCode rejects a code the provider refuses", func(t *testing.T) {
+		provider.issue(nil, nil)
+
+		_, err := repo.ExchangeCode(ctx, "stolen-code", "nonce-token")
+		require.Error(t, err)
+	})
+
+	t.Run("ExchangeCode rejects a token from another login request", func(t *testing.T) {
+		provider.issue(nil, nil)
+
+		_, err := repo.ExchangeCode(ctx, "valid-code", "other-nonce")
+		require.Error(t, err)
+	})
+
+	t.Run("ExchangeCode rejects a token signed by another key", func(t *testing.T) {
+		forger, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		provider.issue(nil, forger)
+
+		_, err = repo.ExchangeCode(ctx, "valid-code", "nonce-token")
+		require.Error(t, err)
+	})
+
+	t.Run("ExchangeCode rejects an expired token", func(t *testing.T) {
+		provider.issue(map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()}, nil)
+
+		_, err := repo.ExchangeCode(ctx, "valid-code", "nonce-token")
+		require.Error(t, err)
+	})
+
+	t.Run("ExchangeCode rejects a token for another client", func(t *testing.T) {
+		provider.issue(map[string]interface{}{"aud": "other-app"}, nil)
+
+		_, err := repo.ExchangeCode(ctx, "valid-code", "nonce-token")
+		require.Error(t, err)
+	})
+
+	t.Run("ExchangeCode rejects a token from another issuer", func(t *testing.T) {
+		provider.issue(map[string]interface{}{"iss": "https://evil.example.com"}, nil)
+
+		_, err := repo.ExchangeCode(ctx, "valid-code", "nonce-token")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// PreferenceRepositoryConstructor is a function type that creates a PreferenceRepository
+type PreferenceRepositoryConstructor func() repository.PreferenceRepository
+
+// PreferencePostgresRepositoryConstructor is a function type that creates a PreferenceRepository over lumen-pg's schema
+type PreferencePostgresRepositoryConstructor func(db *sql.DB) repository.PreferenceRepository
+
+// PreferenceRepositoryRunner runs all preference repository tests against an implementation
+func PreferenceRepositoryRunner(t *testing.T, constructor PreferenceRepositoryConstructor) {
+	t.Helper()
+
+	runPreferenceRepositoryTests(t, context.Background(), constructor())
+}
+
+// PreferencePostgresRepositoryRunner runs the preference repository tests against an implementation
+// over lumen-pg's own schema in a PostgreSQL container
+func PreferencePostgresRepositoryRunner(t *testing.T, migrationConstructor MigrationRepositoryConstructor, constructor PreferencePostgresRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	db := startAppSchemaDatabase(t, ctx, migrationConstructor)
+
+	runPreferenceRepositoryTests(t, ctx, constructor(db))
+}
+
+// runPreferenceRepositoryTests runs the preference behaviour every implementation shares
+func runPreferenceRepositoryTests(t *testing.T, ctx context.Context, repo repository.PreferenceRepository) {
+	t.Helper()
+
+	t.Run("GetPreferences is empty for a new user", func(t *testing.T) {
+		preferences, err := repo.GetPreferences(ctx, "testuser")
+		require.NoError(t, err)
+		require.Empty(t, preferences)
+	})
+
+	t.Run("SetPreference stores and overwrites values", func(t *testing.T) {
+		require.NoError(t, repo.SetPreference(ctx, "testuser", "theme", "light"))
+		require.NoError(t, repo.SetPreference(ctx, "testuser", "theme", "dark"))
+		require.NoError(t, repo.SetPreference(ctx, "testuser", "page_size", "100"))
+
+		preferences, err := repo.GetPreferences(ctx, "testuser")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"theme": "dark", "page_size": "100"}, preferences)
+	})
+
+	t.Run("SetPreference rejects an empty key", func(t *testing.T) {
+		err := repo.SetPreference(ctx, "testuser", "", "value")
+		require.Error(t, err)
+	})
+
+	t.Run("Preferences are kept per user", func(t *testing.T) {
+		preferences, err := repo.GetPreferences(ctx, "otheruser")
+		require.NoError(t, err)
+		require.Empty(t, preferences)
+	})
+
+	t.Run("DeletePreference removes a key and ignores missing ones", func(t *testing.T) {
+		require.NoError(t, repo.DeletePreference(ctx, "testuser", "theme"))
+		require.NoError(t, repo.DeletePreference(ctx, "testuser", "theme"))
+
+		preferences, err := repo.GetPreferences(ctx, "testuser")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"page_size": "100"}, preferences)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 
+	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 )
 
@@ -168,6 +169,76 @@ func RBACRepositoryRunner(t *testing.T, constructor RBACRepositoryConstructor) {
 		require.False(t, has)
 	})
 
+	t.Run("HasSchemaCreatePermission follows CREATE grants on the schema", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `CREATE SCHEMA designer; GRANT USAGE ON SCHEMA designer TO test_role`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `DROP SCHEMA designer`)
+
+		has, err := repo.HasSchemaCreatePermission(ctx, "testuser", "testdb", "designer")
+		require.NoError(t, err)
+		require.True(t, has)
+
+		has, err = repo.HasSchemaCreatePermission(ctx, "test_role", "testdb", "designer")
+		require.NoError(t, err)
+		require.False(t, has)
+
+		_, err = db.ExecContext(ctx, `GRANT CREATE ON SCHEMA designer TO test_role`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `REVOKE CREATE ON SCHEMA designer FROM test_role`)
+
+		has, err = repo.HasSchemaCreatePermission(ctx, "test_role", "testdb", "designer")
+		require.NoError(t, err)
+		require.True(t, has)
+
+		has, err = repo.HasSchemaCreatePermission(ctx, "test_role", "testdb", "nonexistent_schema")
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
+	t.Run("CanCreateDatabase follows superuser and CREATEDB", func(t *testing.T) {
+		can, err := repo.CanCreateDatabase(ctx, "testuser")
+		require.NoError(t, err)
+		require.True(t, can)
+
+		can, err = repo.CanCreateDatabase(ctx, "test_role")
+		require.NoError(t, err)
+		require.False(t, can)
+
+		_, err = db.ExecContext(ctx, `ALTER ROLE test_role CREATEDB`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `ALTER ROLE test_role NOCREATEDB`)
+
+		can, err = repo.CanCreateDatabase(ctx, "test_role")
+		require.NoError(t, err)
+		require.True(t, can)
+
+		can, err = repo.CanCreateDatabase(ctx, "nonexistent_role")
+		require.NoError(t, err)
+		require.False(t, can)
+	})
+
+	t.Run("HasDatabaseCreatePermission follows CREATE grants on the database", func(t *testing.T) {
+		has, err := repo.HasDatabaseCreatePermission(ctx, "testuser", "testdb")
+		require.NoError(t, err)
+		require.True(t, has)
+
+		has, err = repo.HasDatabaseCreatePermission(ctx, "test_role", "testdb")
+		require.NoError(t, err)
+		require.False(t, has)
+
+		_, err = db.ExecContext(ctx, `GRANT CREATE ON DATABASE testdb TO test_role`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `REVOKE CREATE ON DATABASE testdb FROM test_role`)
+
+		has, err = repo.HasDatabaseCreatePermission(ctx, "test_role", "testdb")
+		require.NoError(t, err)
+		require.True(t, has)
+
+		has, err = repo.HasDatabaseCreatePermission(ctx, "test_role", "nonexistent_db")
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+
 	// UC-S1-07: RBAC Initialization with User Accessibility
 	// IT-S2-03: Real Role-Based Resource Access
 	// UC-S6-02: Transaction Isolation (role-based access)
@@ -295,4 +366,98 @@ func RBACRepositoryRunner(t *testing.T, constructor RBACRepositoryConstructor) {
 		require.NoError(t, err)
 		require.Equal(t, can1, can2)
 	})
+
+	t.Run("IsSuperuser distinguishes superusers from ordinary roles", func(t *testing.T) {
+		superuser, err := repo.IsSuperuser(ctx, "testuser")
+		require.NoError(t, err)
+		require.True(t, superuser)
+
+		superuser, err = repo.IsSuperuser(ctx, "test_role")
+		require.NoError(t, err)
+		require.False(t, superuser)
+
+		superuser, err = repo.IsSuperuser(ctx, "nonexistent_role")
+		require.NoError(t, err)
+		require.False(t, superuser)
+	})
+
+	t.Run("CreateRole creates a login role once", func(t *testing.T) {
+		role := domain.NewRole{Name: "created_reporter", Password: "it's-Tide-Harbor-42", CreateDB: true}
+		require.NoError(t, repo.CreateRole(ctx, role))
+
+		superuser, err := repo.IsSuperuser(ctx, "created_reporter")
+		require.NoError(t, err)
+		require.False(t, superuser)
+		canCreate, err := repo.CanCreateDatabase(ctx, "created_reporter")
+		require.NoError(t, err)
+		require.True(t, canCreate)
+
+		require.ErrorIs(t, repo.CreateRole(ctx, role), domain.ErrRoleExists)
+	})
+
+	t.Run("IsTableOwner follows ownership through role membership", func(t *testing.T) {
+		owner, err := repo.IsTableOwner(ctx, "testuser", "testdb", "public", "test_table")
+		require.NoError(t, err)
+		require.True(t, owner)
+
+		owner, err = repo.IsTableOwner(ctx, "test_role", "testdb", "public", "test_table")
+		require.NoError(t, err)
+		require.False(t, owner)
+
+		_, err = db.ExecContext(ctx, `CREATE ROLE test_owners; ALTER TABLE test_table OWNER TO test_owners; GRANT test_owners TO test_role`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `ALTER TABLE test_table OWNER TO testuser; DROP ROLE test_owners`)
+
+		owner, err = repo.IsTableOwner(ctx, "test_role", "testdb", "public", "test_table")
+		require.NoError(t, err)
+		require.True(t, owner)
+
+		owner, err = repo.IsTableOwner(ctx, "test_role", "testdb", "public", "missing_table")
+		require.NoError(t, err)
+		require.False(t, owner)
+	})
+
+	t.Run("GetSequencePermissions reports grants and ownership", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `CREATE SEQUENCE test_rbac_seq; GRANT USAGE ON SEQUENCE test_rbac_seq TO test_role`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `DROP SEQUENCE test_rbac_seq`)
+
+		permissions, err := repo.GetSequencePermissions(ctx, "testuser", "testdb", "public", "test_rbac_seq")
+		require.NoError(t, err)
+		require.True(t, permissions.CanUpdate)
+		require.True(t, permissions.IsOwner)
+
+		permissions, err = repo.GetSequencePermissions(ctx, "test_role", "testdb", "public", "test_rbac_seq")
+		require.NoError(t, err)
+		require.True(t, permissions.CanUsage)
+		require.False(t, permissions.CanSelect)
+		require.False(t, permissions.CanUpdate)
+		require.False(t, permissions.IsOwner)
+
+		permissions, err = repo.GetSequencePermissions(ctx, "test_role", "testdb", "public", "missing_seq")
+		require.NoError(t, err)
+		require.False(t, permissions.CanUsage)
+		require.False(t, permissions.IsOwner)
+	})
+
+	t.Run("HasFunctionExecutePermission follows EXECUTE grants", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `
+			CREATE FUNCTION test_rbac_fn(n integer) RETURNS integer LANGUAGE sql AS 'SELECT n';
+			REVOKE EXECUTE ON FUNCTION test_rbac_fn(integer) FROM PUBLIC;
+		`)
+		require.NoError(t, err)
+		defer db.ExecContext(ctx, `DROP FUNCTION test_rbac_fn`)
+
+		allowed, err := repo.HasFunctionExecutePermission(ctx, "testuser", "testdb", "public", "test_rbac_fn", "n integer")
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, err = repo.HasFunctionExecutePermission(ctx, "test_role", "testdb", "public", "test_rbac_fn", "n integer")
+		require.NoError(t, err)
+		require.False(t, allowed)
+
+		allowed, err = repo.HasFunctionExecutePermission(ctx, "test_role", "testdb", "public", "missing_fn", "")
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
 }
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// ResultCacheRepositoryConstructor is a function type that creates a ResultCacheRepository
+type ResultCacheRepositoryConstructor func() repository.ResultCacheRepository
+
+// ResultCacheRepositoryRunner runs all result cache repository tests against an implementation
+func ResultCacheRepositoryRunner(t *testing.T, constructor ResultCacheRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("Stores a result and retrieves it by ID", func(t *testing.T) {
+		repo := constructor()
+
+		result := &domain.CachedResult{
+			Username: "alice",
+			Query:    "SELECT id FROM orders",
+			Columns:  []string{"id"},
+			Rows:     []map[string]interface{}{{"id": int64(1)}, {"id": int64(2)}},
+		}
+		require.NoError(t, repo.StoreResult(ctx, result))
+		require.NotEmpty(t, result.ID)
+		require.False(t, result.CachedAt.IsZero())
+
+		cached, err := repo.GetResult(ctx, result.ID)
+		require.NoError(t, err)
+		require.Equal(t, "alice", cached.Username)
+		require.Len(t, cached.Rows, 2)
+	})
+
+	t.Run("Unknown and expired results are not found", func(t *testing.T) {
+		repo := constructor()
+
+		_, err := repo.GetResult(ctx, "result_missing")
+		require.True(t, errors.Is(err, domain.ErrResultNotFound))
+
+		expired := &domain.CachedResult{Username: "alice", CachedAt: time.Now().Add(-domain.ResultCacheTTL - time.Minute)}
+		require.NoError(t, repo.StoreResult(ctx, expired))
+
+		_, err = repo.GetResult(ctx, expired.ID)
+		require.True(t, errors.Is(err, domain.ErrResultNotFound))
+	})
+
+	t.Run("Keeps only the latest results of each user", func(t *testing.T) {
+		repo := constructor()
+
+		var ids []string
+		for i := 0; i < domain.ResultCachePerUser+1; i++ {
+			result := &domain.CachedResult{Username: "alice"}
+			require.NoError(t, repo.StoreResult(ctx, result))
+			ids = append(ids, result.ID)
+		}
+		other := &domain.CachedResult{Username: "bob"}
+		require.NoError(t, repo.StoreResult(ctx, other))
+
+		_, err := repo.GetResult(ctx, ids[0])
+		require.True(t, errors.Is(err, domain.ErrResultNotFound))
+		for _, id := range ids[1:] {
+			_, err := repo.GetResult(ctx, id)
+			require.NoError(t, err)
+		}
+		_, err = repo.GetResult(ctx, other.ID)
+		require.NoError(t, err)
+	})
+}
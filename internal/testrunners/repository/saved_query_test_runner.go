@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SavedQueryRepositoryConstructor is a function type that creates a SavedQueryRepository
+type SavedQueryRepositoryConstructor func() repository.SavedQueryRepository
+
+// SavedQueryPostgresRepositoryConstructor is a function type that creates a SavedQueryRepository over lumen-pg's schema
+type SavedQueryPostgresRepositoryConstructor func(db *sql.DB) repository.SavedQueryRepository
+
+// SavedQueryRepositoryRunner runs all saved query repository tests against an implementation
+func SavedQueryRepositoryRunner(t *testing.T, constructor SavedQueryRepositoryConstructor) {
+	t.Helper()
+
+	runSavedQueryRepositoryTests(t, context.Background(), constructor())
+}
+
+// SavedQueryPostgresRepositoryRunner runs the saved query repository tests against an implementation
+// over lumen-pg's own schema in a PostgreSQL container
+func SavedQueryPostgresRepositoryRunner(t *testing.T, migrationConstructor MigrationRepositoryConstructor, constructor SavedQueryPostgresRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	db := startAppSchemaDatabase(t, ctx, migrationConstructor)
+
+	runSavedQueryRepositoryTests(t, ctx, constructor(db))
+
+	t.Run("Template variables and tags round-trip", func(t *testing.T) {
+		repo := constructor(db)
+		query := &domain.SavedQuery{
+			Username:  "template_user",
+			Name:      "Orders by status",
+			Query:     "SELECT * FROM orders WHERE status = {{status}}",
+			Tags:      []string{"orders", "reports"},
+			Variables: []domain.TemplateVariable{{Name: "status"}},
+		}
+		require.NoError(t, repo.CreateSavedQuery(ctx, query))
+
+		retrieved, err := repo.GetSavedQuery(ctx, "template_user", query.ID)
+		require.NoError(t, err)
+		require.Equal(t, query.Tags, retrieved.Tags)
+		require.Equal(t, query.Variables, retrieved.Variables)
+	})
+}
+
+// runSavedQueryRepositoryTests runs the saved query behaviour every implementation shares
+func runSavedQueryRepositoryTests(t *testing.T, ctx context.Context, repo repository.SavedQueryRepository) {
+	t.Helper()
+
+	saved := &domain.SavedQuery{
+		Username: "testuser",
+		Name:     "Active users",
+		Query:    "SELECT * FROM users WHERE active",
+		Tags:     []string{"users"},
+	}
+
+	t.Run("CreateSavedQuery assigns ID and timestamps", func(t *testing.T) {
+		err := repo.CreateSavedQuery(ctx, saved)
+		require.NoError(t, err)
+		require.NotEmpty(t, saved.ID)
+		require.False(t, saved.CreatedAt.IsZero())
+		require.Equal(t, saved.CreatedAt, saved.UpdatedAt)
+	})
+
+	t.Run("GetSavedQuery returns query owned by user", func(t *testing.T) {
+		query, err := repo.GetSavedQuery(ctx, "testuser", saved.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Active users", query.Name)
+		require.Equal(t, []string{"users"}, query.Tags)
+	})
+
+	t.Run("GetSavedQuery hides other users' queries", func(t *testing.T) {
+		_, err := repo.GetSavedQuery(ctx, "otheruser", saved.ID)
+		require.ErrorIs(t, err, domain.ErrSavedQueryNotFound)
+	})
+
+	t.Run("ListSavedQueries orders by name", func(t *testing.T) {
+		err := repo.CreateSavedQuery(ctx, &domain.SavedQuery{
+			Username: "testuser",
+			Name:     "Accounts",
+			Query:    "SELECT * FROM accounts",
+		})
+		require.NoError(t, err)
+
+		queries, err := repo.ListSavedQueries(ctx, "testuser")
+		require.NoError(t, err)
+		require.Len(t, queries, 2)
+		require.Equal(t, "Accounts", queries[0].Name)
+		require.Equal(t, "Active users", queries[1].Name)
+
+		others, err := repo.ListSavedQueries(ctx, "otheruser")
+		require.NoError(t, err)
+		require.Empty(t, others)
+	})
+
+	t.Run("UpdateSavedQuery keeps creation time", func(t *testing.T) {
+		updated := &domain.SavedQuery{
+			ID:       saved.ID,
+			Username: "testuser",
+			Name:     "Inactive users",
+			Query:    "SELECT * FROM users WHERE NOT active",
+		}
+		err := repo.UpdateSavedQuery(ctx, updated)
+		require.NoError(t, err)
+		require.True(t, saved.CreatedAt.Equal(updated.CreatedAt))
+
+		query, err := repo.GetSavedQuery(ctx, "testuser", saved.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Inactive users", query.Name)
+	})
+
+	t.Run("UpdateSavedQuery rejects unknown query", func(t *testing.T) {
+		err := repo.UpdateSavedQuery(ctx, &domain.SavedQuery{ID: saved.ID, Username: "otheruser"})
+		require.ErrorIs(t, err, domain.ErrSavedQueryNotFound)
+	})
+
+	t.Run("DeleteSavedQuery removes query", func(t *testing.T) {
+		err := repo.DeleteSavedQuery(ctx, "testuser", saved.ID)
+		require.NoError(t, err)
+
+		_, err = repo.GetSavedQuery(ctx, "testuser", saved.ID)
+		require.ErrorIs(t, err, domain.ErrSavedQueryNotFound)
+
+		err = repo.DeleteSavedQuery(ctx, "testuser", saved.ID)
+		require.ErrorIs(t, err, domain.ErrSavedQueryNotFound)
+	})
+}
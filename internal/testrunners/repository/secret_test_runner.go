@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SecretRepositoryConstructor is a function type that creates a SecretRepository
+type SecretRepositoryConstructor func() repository.SecretRepository
+
+// SecretRepositoryRunner runs all secret repository tests against an implementation
+func SecretRepositoryRunner(t *testing.T, constructor SecretRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	repo := constructor()
+
+	t.Run("ResolveSecret reads an environment variable", func(t *testing.T) {
+		t.Setenv("LUMEN_TEST_SERVICE_PASSWORD", "s3cret")
+
+		secret, err := repo.ResolveSecret(ctx, "env:LUMEN_TEST_SERVICE_PASSWORD")
+		require.NoError(t, err)
+		require.Equal(t, "s3cret", secret)
+	})
+
+	t.Run("ResolveSecret fails for an unset environment variable", func(t *testing.T) {
+		_, err := repo.ResolveSecret(ctx, "env:LUMEN_TEST_NEVER_SET")
+		require.Error(t, err)
+	})
+
+	t.Run("ResolveSecret reads a file without its trailing newline", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "service_password")
+		require.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0o600))
+
+		secret, err := repo.ResolveSecret(ctx, "file:"+path)
+		require.NoError(t, err)
+		require.Equal(t, "from-file", secret)
+	})
+
+	t.Run("ResolveSecret fails for a missing file", func(t *testing.T) {
+		_, err := repo.ResolveSecret(ctx, "file:"+filepath.Join(t.TempDir(), "missing"))
+		require.Error(t, err)
+	})
+
+	t.Run("ResolveSecret rejects unknown providers and malformed references", func(t *testing.T) {
+		for _, reference := range []string{"vault:secret/db", "plain-password", "env:"} {
+			_, err := repo.ResolveSecret(ctx, reference)
+			require.Error(t, err, reference)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SessionRedisRepositoryConstructor is a function type that creates a Redis-backed SessionRepository
+type SessionRedisRepositoryConstructor func(config domain.SessionStoreConfig) repository.SessionRepository
+
+// SessionRedisRepositoryRunner runs the session repository tests against a Redis-backed implementation,
+// plus the persistence a shared store adds
+func SessionRedisRepositoryRunner(t *testing.T, constructor SessionRedisRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	container, err := testcontainers.Run(ctx, "redis:7",
+		testcontainers.WithExposedPorts("6379/tcp"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("6379/tcp")),
+	)
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	addr, err := container.PortEndpoint(ctx, "6379/tcp", "")
+	require.NoError(t, err)
+
+	config := domain.SessionStoreConfig{
+		Backend:   domain.SessionStoreRedis,
+		RedisAddr: addr,
+	}
+
+	runSessionRepositoryTests(t, ctx, constructor(config))
+
+	t.Run("Sessions survive a new repository instance", func(t *testing.T) {
+		now := time.Now()
+		session := &domain.Session{
+			ID:                "restart_session",
+			Username:          "restart_user",
+			CreatedAt:         now,
+			ExpiresAt:         now.Add(time.Hour),
+			EncryptedPassword: "ciphertext",
+		}
+
+		err := constructor(config).CreateSession(ctx, session)
+		require.NoError(t, err)
+
+		retrieved, err := constructor(config).ValidateSession(ctx, "restart_session")
+		require.NoError(t, err)
+		require.Equal(t, "restart_user", retrieved.Username)
+		require.Equal(t, "ciphertext", retrieved.EncryptedPassword)
+	})
+
+	t.Run("Sessions expire with their TTL", func(t *testing.T) {
+		now := time.Now()
+		session := &domain.Session{
+			ID:        "short_session",
+			Username:  "short_user",
+			CreatedAt: now,
+			ExpiresAt: now.Add(1500 * time.Millisecond),
+		}
+
+		repo := constructor(config)
+		err := repo.CreateSession(ctx, session)
+		require.NoError(t, err)
+
+		exists, err := repo.SessionExists(ctx, "short_session")
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		time.Sleep(2 * time.Second)
+
+		exists, err = repo.SessionExists(ctx, "short_session")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("Key prefix isolates session stores", func(t *testing.T) {
+		now := time.Now()
+		session := &domain.Session{
+			ID:        "prefixed_session",
+			Username:  "prefixed_user",
+			CreatedAt: now,
+			ExpiresAt: now.Add(time.Hour),
+		}
+
+		otherConfig := config
+		otherConfig.KeyPrefix = "other:"
+
+		err := constructor(otherConfig).CreateSession(ctx, session)
+		require.NoError(t, err)
+
+		exists, err := constructor(config).SessionExists(ctx, "prefixed_session")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("Concurrent requests each get a connection", func(t *testing.T) {
+		repo := constructor(config)
+		now := time.Now()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 32)
+		for i := 0; i < 32; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				id := fmt.Sprintf("concurrent_session_%d", i)
+				if err := repo.CreateSession(ctx, &domain.Session{ID: id, Username: "concurrent_user", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := repo.ValidateSession(ctx, id); err != nil {
+					errs <- err
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		sessions, err := repo.ListSessions(ctx)
+		require.NoError(t, err)
+		created := 0
+		for _, session := range sessions {
+			if session.Username == "concurrent_user" {
+				created++
+			}
+		}
+		require.Equal(t, 32, created)
+	})
+}
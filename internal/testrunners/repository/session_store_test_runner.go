@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SessionStoreConstructor is a function type that opens a SessionStore kept at path
+type SessionStoreConstructor func(path string) (repository.SessionStore, error)
+
+// StoredSessionRepositoryConstructor is a function type that creates a SessionRepository over a SessionStore
+type StoredSessionRepositoryConstructor func(store repository.SessionStore) repository.SessionRepository
+
+// StoredTransactionRepositoryConstructor is a function type that creates a TransactionRepository over a SessionStore
+type StoredTransactionRepositoryConstructor func(store repository.SessionStore) repository.TransactionRepository
+
+// StoredSavedQueryRepositoryConstructor is a function type that creates a SavedQueryRepository over a SessionStore
+type StoredSavedQueryRepositoryConstructor func(store repository.SessionStore) repository.SavedQueryRepository
+
+// StoredNotebookRepositoryConstructor is a function type that creates a NotebookRepository over a SessionStore
+type StoredNotebookRepositoryConstructor func(store repository.SessionStore) repository.NotebookRepository
+
+// StoredAuditRepositoryConstructor is a function type that creates an AuditRepository over a SessionStore
+type StoredAuditRepositoryConstructor func(store repository.SessionStore) repository.AuditRepository
+
+// StoredPreferenceRepositoryConstructor is a function type that creates a PreferenceRepository over a SessionStore
+type StoredPreferenceRepositoryConstructor func(store repository.SessionStore) repository.PreferenceRepository
+
+// SessionStoreRunner runs the SessionStore tests against an implementation, reopening the store to check
+// what survives a restart
+func SessionStoreRunner(t *testing.T, constructor SessionStoreConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "sessions.db")
+
+	store, err := constructor(path)
+	require.NoError(t, err)
+
+	t.Run("Put and Get round-trip a value", func(t *testing.T) {
+		err := store.Put(ctx, "sessions", "session_1", []byte("value"), time.Time{})
+		require.NoError(t, err)
+
+		value, err := store.Get(ctx, "sessions", "session_1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+	})
+
+	t.Run("Get returns ErrStoreKeyNotFound for a missing key", func(t *testing.T) {
+		_, err := store.Get(ctx, "sessions", "missing")
+		require.ErrorIs(t, err, domain.ErrStoreKeyNotFound)
+	})
+
+	t.Run("Expired keys are not returned", func(t *testing.T) {
+		err := store.Put(ctx, "sessions", "expired", []byte("old"), time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = store.Get(ctx, "sessions", "expired")
+		require.ErrorIs(t, err, domain.ErrStoreKeyNotFound)
+
+		keys, err := store.Keys(ctx, "sessions")
+		require.NoError(t, err)
+		require.NotContains(t, keys, "expired")
+	})
+
+	t.Run("Buckets keep keys apart", func(t *testing.T) {
+		err := store.Put(ctx, "transactions", "session_1", []byte("other"), time.Time{})
+		require.NoError(t, err)
+
+		value, err := store.Get(ctx, "sessions", "session_1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+	})
+
+	t.Run("Delete removes a key and ignores missing ones", func(t *testing.T) {
+		err := store.Put(ctx, "sessions", "deleted", []byte("gone"), time.Time{})
+		require.NoError(t, err)
+
+		require.NoError(t, store.Delete(ctx, "sessions", "deleted"))
+		require.NoError(t, store.Delete(ctx, "sessions", "never_stored"))
+
+		_, err = store.Get(ctx, "sessions", "deleted")
+		require.ErrorIs(t, err, domain.ErrStoreKeyNotFound)
+	})
+
+	t.Run("Values survive reopening the store", func(t *testing.T) {
+		err := store.Put(ctx, "sessions", "session_1", []byte("updated"), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := constructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		value, err := reopened.Get(ctx, "sessions", "session_1")
+		require.NoError(t, err)
+		require.Equal(t, []byte("updated"), value)
+
+		keys, err := reopened.Keys(ctx, "sessions")
+		require.NoError(t, err)
+		require.Equal(t, []string{"session_1"}, keys)
+	})
+
+	t.Run("A torn final write loses only that change", func(t *testing.T) {
+		tornPath := filepath.Join(t.TempDir(), "torn.db")
+
+		torn, err := constructor(tornPath)
+		require.NoError(t, err)
+		require.NoError(t, torn.Put(ctx, "sessions", "kept", []byte("kept"), time.Time{}))
+		require.NoError(t, torn.Close())
+
+		file, err := os.OpenFile(tornPath, os.O_APPEND|os.O_WRONLY, 0o600)
+		require.NoError(t, err)
+		_, err = file.WriteString(`{"b":"sessions","k":"half`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		reopened, err := constructor(tornPath)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		value, err := reopened.Get(ctx, "sessions", "kept")
+		require.NoError(t, err)
+		require.Equal(t, []byte("kept"), value)
+	})
+}
+
+// StoredSessionRepositoryRunner runs the session repository tests against an implementation over a
+// SessionStore, plus a restart of the store underneath it
+func StoredSessionRepositoryRunner(t *testing.T, storeConstructor SessionStoreConstructor, constructor StoredSessionRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "sessions.db")
+
+	store, err := storeConstructor(path)
+	require.NoError(t, err)
+
+	runSessionRepositoryTests(t, ctx, constructor(store))
+
+	t.Run("Sessions survive a restart", func(t *testing.T) {
+		now := time.Now()
+		session := &domain.Session{
+			ID:                "restart_session",
+			Username:          "restart_user",
+			CreatedAt:         now,
+			ExpiresAt:         now.Add(time.Hour),
+			EncryptedPassword: "ciphertext",
+		}
+
+		err := constructor(store).CreateSession(ctx, session)
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := storeConstructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		retrieved, err := constructor(reopened).ValidateSession(ctx, "restart_session")
+		require.NoError(t, err)
+		require.Equal(t, "restart_user", retrieved.Username)
+		require.Equal(t, "ciphertext", retrieved.EncryptedPassword)
+	})
+}
+
+// StoredTransactionRepositoryRunner runs the transaction repository tests against an implementation over
+// a SessionStore, plus a restart of the store underneath it
+func StoredTransactionRepositoryRunner(t *testing.T, storeConstructor SessionStoreConstructor, constructor StoredTransactionRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "transactions.db")
+
+	store, err := storeConstructor(path)
+	require.NoError(t, err)
+
+	runTransactionRepositoryTests(t, ctx, constructor(store))
+
+	t.Run("Buffers addressed by username reach the user's transaction", func(t *testing.T) {
+		now := time.Now()
+		repo := constructor(store)
+
+		err := repo.CreateTransaction(ctx, &domain.TransactionState{
+			ID:        "txn_by_user",
+			Username:  "buffer_user",
+			StartedAt: now,
+			ExpiresAt: now.Add(30 * time.Minute),
+		})
+		require.NoError(t, err)
+
+		err = repo.AddRowKeyDeletes(ctx, "buffer_user", []map[string]interface{}{{"id": "7"}})
+		require.NoError(t, err)
+
+		keyDeletes, err := repo.GetRowKeyDeletes(ctx, "txn_by_user")
+		require.NoError(t, err)
+		require.Equal(t, []map[string]interface{}{{"id": "7"}}, keyDeletes)
+	})
+
+	t.Run("Transaction buffers survive a restart", func(t *testing.T) {
+		now := time.Now()
+		repo := constructor(store)
+
+		err := repo.CreateTransaction(ctx, &domain.TransactionState{
+			ID:        "restart_txn",
+			Username:  "restart_user",
+			Table:     "orders",
+			StartedAt: now,
+			ExpiresAt: now.Add(30 * time.Minute),
+		})
+		require.NoError(t, err)
+
+		err = repo.AddRowEdit(ctx, "restart_user", domain.RowEdit{RowIndex: 3, ColumnName: "status", OldValue: "new", NewValue: "paid"})
+		require.NoError(t, err)
+
+		err = repo.AddRowInsert(ctx, "restart_user", domain.RowInsert{
+			Values:     map[string]interface{}{"id": "9"},
+			OnConflict: domain.ConflictAction{Mode: domain.ConflictModeSkip},
+		})
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := storeConstructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		restored, err := constructor(reopened).GetUserTransaction(ctx, "restart_user")
+		require.NoError(t, err)
+		require.Equal(t, "orders", restored.Table)
+		require.Equal(t, "paid", restored.Edits[3].NewValue)
+		require.Len(t, restored.Inserts, 1)
+		require.Equal(t, domain.ConflictModeSkip, restored.Inserts[0].OnConflict.Mode)
+	})
+}
+
+// StoredSavedQueryRepositoryRunner runs the saved query repository tests against an implementation over a
+// SessionStore, plus a restart of the store underneath it
+func StoredSavedQueryRepositoryRunner(t *testing.T, storeConstructor SessionStoreConstructor, constructor StoredSavedQueryRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "app_data.db")
+
+	store, err := storeConstructor(path)
+	require.NoError(t, err)
+
+	runSavedQueryRepositoryTests(t, ctx, constructor(store))
+
+	t.Run("Saved queries survive a restart", func(t *testing.T) {
+		query := &domain.SavedQuery{
+			Username:  "restart_user",
+			Name:      "Orders by status",
+			Query:     "SELECT * FROM orders WHERE status = {{status}}",
+			Tags:      []string{"orders"},
+			Variables: []domain.TemplateVariable{{Name: "status"}},
+		}
+
+		err := constructor(store).CreateSavedQuery(ctx, query)
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := storeConstructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		retrieved, err := constructor(reopened).GetSavedQuery(ctx, "restart_user", query.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Orders by status", retrieved.Name)
+		require.Equal(t, []string{"orders"}, retrieved.Tags)
+		require.Equal(t, query.Variables, retrieved.Variables)
+	})
+}
+
+// StoredNotebookRepositoryRunner runs the notebook repository tests against an implementation over a
+// SessionStore, plus a restart of the store underneath it
+func StoredNotebookRepositoryRunner(t *testing.T, storeConstructor SessionStoreConstructor, constructor StoredNotebookRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "app_data.db")
+
+	store, err := storeConstructor(path)
+	require.NoError(t, err)
+
+	runNotebookRepositoryTests(t, ctx, constructor(store))
+
+	t.Run("Notebooks survive a restart with their saved results", func(t *testing.T) {
+		notebook := &domain.QueryNotebook{
+			Username: "restart_user",
+			Title:    "Order review",
+			Cells: []domain.NotebookCell{
+				{ID: "cell_1", Kind: domain.NotebookCellMarkdown, Source: "# Orders"},
+				{ID: "cell_2", Kind: domain.NotebookCellSQL, Source: "SELECT count(*) FROM orders", Result: &domain.QueryResult{Columns: []string{"count"}, RowCount: 1}},
+			},
+		}
+
+		err := constructor(store).CreateNotebook(ctx, notebook)
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := storeConstructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		retrieved, err := constructor(reopened).GetNotebook(ctx, "restart_user", notebook.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Order review", retrieved.Title)
+		require.Len(t, retrieved.Cells, 2)
+		require.Equal(t, []string{"count"}, retrieved.Cells[1].Result.Columns)
+	})
+}
+
+// StoredAuditRepositoryRunner runs the audit repository tests against an implementation over a
+// SessionStore, plus a restart of the store underneath it
+func StoredAuditRepositoryRunner(t *testing.T, storeConstructor SessionStoreConstructor, constructor StoredAuditRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "app_data.db")
+
+	store, err := storeConstructor(path)
+	require.NoError(t, err)
+
+	runAuditRepositoryTests(t, ctx, constructor(store))
+
+	t.Run("Audit entries survive a restart in order", func(t *testing.T) {
+		repo := constructor(store)
+		older := time.Now().Add(-time.Minute)
+		require.NoError(t, repo.RecordEntry(ctx, &domain.AuditEntry{Username: "restart_user", Action: domain.AuditActionCommit, Table: "older", CreatedAt: older}))
+		require.NoError(t, repo.RecordEntry(ctx, &domain.AuditEntry{Username: "restart_user", Action: domain.AuditActionCommit, Table: "newer"}))
+		require.NoError(t, store.Close())
+
+		reopened, err := storeConstructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		entries, err := constructor(reopened).GetEntries(ctx, domain.AuditFilter{Username: "restart_user"})
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "newer", entries[0].Table)
+		require.Equal(t, "older", entries[1].Table)
+	})
+}
+
+// StoredPreferenceRepositoryRunner runs the preference repository tests against an implementation over a
+// SessionStore, plus a restart of the store underneath it
+func StoredPreferenceRepositoryRunner(t *testing.T, storeConstructor SessionStoreConstructor, constructor StoredPreferenceRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "app_data.db")
+
+	store, err := storeConstructor(path)
+	require.NoError(t, err)
+
+	runPreferenceRepositoryTests(t, ctx, constructor(store))
+
+	t.Run("Preferences survive a restart", func(t *testing.T) {
+		err := constructor(store).SetPreference(ctx, "restart_user", "theme", "dark")
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := storeConstructor(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+
+		preferences, err := constructor(reopened).GetPreferences(ctx, "restart_user")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"theme": "dark"}, preferences)
+	})
+}
@@ -47,7 +47,12 @@ func SessionRepositoryRunner(t *testing.T, constructor SessionRepositoryConstruc
 	err = db.PingContext(ctx)
 	require.NoError(t, err)
 
-	repo := constructor(db)
+	runSessionRepositoryTests(t, ctx, constructor(db))
+}
+
+// runSessionRepositoryTests exercises the SessionRepository contract shared by every session store
+func runSessionRepositoryTests(t *testing.T, ctx context.Context, repo repository.SessionRepository) {
+	t.Helper()
 
 	// UC-S2-06: Session Cookie Creation - Username
 	// UC-S2-08: Session Validation - Valid Session
@@ -279,6 +284,41 @@ func SessionRepositoryRunner(t *testing.T, constructor SessionRepositoryConstruc
 		require.NotNil(t, retrieved)
 	})
 
+	t.Run("ListSessions returns unexpired sessions with their activity", func(t *testing.T) {
+		now := time.Now()
+
+		active := &domain.Session{
+			ID:             "list_active",
+			Username:       "list_user",
+			CreatedAt:      now,
+			ExpiresAt:      now.Add(time.Hour),
+			LastActivityAt: now,
+			ClientIP:       "203.0.113.7",
+		}
+		expired := &domain.Session{
+			ID:        "list_expired",
+			Username:  "list_user",
+			CreatedAt: now.Add(-2 * time.Hour),
+			ExpiresAt: now.Add(-time.Hour),
+		}
+		require.NoError(t, repo.CreateSession(ctx, active))
+		require.NoError(t, repo.CreateSession(ctx, expired))
+
+		sessions, err := repo.ListSessions(ctx)
+		require.NoError(t, err)
+
+		var found *domain.Session
+		for _, session := range sessions {
+			require.NotEqual(t, "list_expired", session.ID)
+			if session.ID == "list_active" {
+				found = session
+			}
+		}
+		require.NotNil(t, found)
+		require.Equal(t, "203.0.113.7", found.ClientIP)
+		require.WithinDuration(t, now, found.LastActivityAt, time.Second)
+	})
+
 	// UC-S2-08: Session Validation - Valid Session
 	// IT-S2-04: Session Persistence After Probe
 	t.Run("SessionExists returns true for existing session", func(t *testing.T) {
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// SlowOperationRepositoryConstructor is a function type that creates a SlowOperationRepository
+type SlowOperationRepositoryConstructor func() repository.SlowOperationRepository
+
+// SlowOperationRepositoryRunner runs all slow operation repository tests against an implementation
+func SlowOperationRepositoryRunner(t *testing.T, constructor SlowOperationRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("Records operations and lists them newest first", func(t *testing.T) {
+		repo := constructor()
+
+		first := &domain.SlowOperation{
+			Username:    "alice",
+			Operation:   domain.SlowOperationFilter,
+			Database:    "shop",
+			Schema:      "public",
+			Table:       "orders",
+			WhereClause: "customer_id = 42",
+			Duration:    2 * time.Second,
+			Suggestions: []domain.IndexSuggestion{{Schema: "public", Table: "orders", Columns: []string{"customer_id"}}},
+		}
+		require.NoError(t, repo.RecordSlowOperation(ctx, first))
+		require.NotEmpty(t, first.ID)
+		require.False(t, first.RecordedAt.IsZero())
+
+		require.NoError(t, repo.RecordSlowOperation(ctx, &domain.SlowOperation{Username: "bob", Operation: domain.SlowOperationFilter}))
+
+		operations, err := repo.GetSlowOperations(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, operations, 2)
+		require.Equal(t, "bob", operations[0].Username)
+		require.Equal(t, *first, operations[1])
+
+		latest, err := repo.GetSlowOperations(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, latest, 1)
+		require.Equal(t, "bob", latest[0].Username)
+	})
+
+	t.Run("Keeps only the latest operations", func(t *testing.T) {
+		repo := constructor()
+
+		for i := 0; i < domain.SlowOperationLogSize+5; i++ {
+			require.NoError(t, repo.RecordSlowOperation(ctx, &domain.SlowOperation{WhereClause: fmt.Sprintf("id = %d", i)}))
+		}
+
+		operations, err := repo.GetSlowOperations(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, operations, domain.SlowOperationLogSize)
+		require.Equal(t, fmt.Sprintf("id = %d", domain.SlowOperationLogSize+4), operations[0].WhereClause)
+		require.Equal(t, "id = 5", operations[len(operations)-1].WhereClause)
+	})
+
+	t.Run("Rejects a nil operation", func(t *testing.T) {
+		require.Error(t, constructor().RecordSlowOperation(ctx, nil))
+	})
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+)
+
+// TableSnapshotRepositoryConstructor is a function type that creates a TableSnapshotRepository
+type TableSnapshotRepositoryConstructor func() repository.TableSnapshotRepository
+
+// TableSnapshotRepositoryRunner runs all table snapshot repository tests against an implementation
+func TableSnapshotRepositoryRunner(t *testing.T, constructor TableSnapshotRepositoryConstructor) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	t.Run("Stores a snapshot and retrieves it for its owner", func(t *testing.T) {
+		repo := constructor()
+
+		snapshot := &domain.TableSnapshot{
+			Username:    "alice",
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			PrimaryKeys: []string{"id"},
+			Columns:     []string{"id", "status"},
+			Rows:        []map[string]interface{}{{"id": int64(1), "status": "new"}},
+		}
+		require.NoError(t, repo.StoreSnapshot(ctx, snapshot))
+		require.NotEmpty(t, snapshot.ID)
+		require.False(t, snapshot.TakenAt.IsZero())
+
+		kept, err := repo.GetSnapshot(ctx, "alice", snapshot.ID)
+		require.NoError(t, err)
+		require.Equal(t, "orders", kept.Table)
+		require.Len(t, kept.Rows, 1)
+
+		_, err = repo.GetSnapshot(ctx, "bob", snapshot.ID)
+		require.True(t, errors.Is(err, domain.ErrTableSnapshotNotFound))
+	})
+
+	t.Run("Expired snapshots are not found", func(t *testing.T) {
+		repo := constructor()
+
+		expired := &domain.TableSnapshot{Username: "alice", TakenAt: time.Now().Add(-domain.TableSnapshotTTL - time.Minute)}
+		require.NoError(t, repo.StoreSnapshot(ctx, expired))
+
+		_, err := repo.GetSnapshot(ctx, "alice", expired.ID)
+		require.True(t, errors.Is(err, domain.ErrTableSnapshotNotFound))
+	})
+
+	t.Run("Keeps only the latest snapshots of each user, listed newest first", func(t *testing.T) {
+		repo := constructor()
+
+		var ids []string
+		for i := 0; i < domain.TableSnapshotPerUser+1; i++ {
+			snapshot := &domain.TableSnapshot{Username: "alice", TakenAt: time.Now().Add(time.Duration(i) * time.Second)}
+			require.NoError(t, repo.StoreSnapshot(ctx, snapshot))
+			ids = append(ids, snapshot.ID)
+		}
+
+		_, err := repo.GetSnapshot(ctx, "alice", ids[0])
+		require.True(t, errors.Is(err, domain.ErrTableSnapshotNotFound))
+
+		snapshots, err := repo.ListSnapshots(ctx, "alice")
+		require.NoError(t, err)
+		require.Len(t, snapshots, domain.TableSnapshotPerUser)
+		require.Equal(t, ids[len(ids)-1], snapshots[0].ID)
+	})
+
+	t.Run("Deletes a snapshot", func(t *testing.T) {
+		repo := constructor()
+
+		snapshot := &domain.TableSnapshot{Username: "alice"}
+		require.NoError(t, repo.StoreSnapshot(ctx, snapshot))
+		require.NoError(t, repo.DeleteSnapshot(ctx, "alice", snapshot.ID))
+
+		_, err := repo.GetSnapshot(ctx, "alice", snapshot.ID)
+		require.True(t, errors.Is(err, domain.ErrTableSnapshotNotFound))
+		require.True(t, errors.Is(repo.DeleteSnapshot(ctx, "alice", snapshot.ID), domain.ErrTableSnapshotNotFound))
+	})
+}
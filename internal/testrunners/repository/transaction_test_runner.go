@@ -46,7 +46,12 @@ func TransactionRepositoryRunner(t *testing.T, constructor TransactionRepository
 	err = db.PingContext(ctx)
 	require.NoError(t, err)
 
-	repo := constructor(db)
+	runTransactionRepositoryTests(t, ctx, constructor(db))
+}
+
+// runTransactionRepositoryTests exercises the TransactionRepository contract shared by every implementation
+func runTransactionRepositoryTests(t *testing.T, ctx context.Context, repo repository.TransactionRepository) {
+	t.Helper()
 
 	// UC-S5-09: Transaction Start
 	// UC-S5-12: Transaction Commit (foundation)
@@ -197,6 +202,25 @@ func TransactionRepositoryRunner(t *testing.T, constructor TransactionRepository
 		require.Len(t, edits, 1)
 	})
 
+	t.Run("AddRowEdit keeps the cell's value history", func(t *testing.T) {
+		now := time.Now()
+		txn := &domain.TransactionState{
+			ID:        "history_txn",
+			Username:  "historyuser",
+			StartedAt: now,
+			ExpiresAt: now.Add(30 * time.Minute),
+			Edits:     make(map[int]domain.RowEdit),
+		}
+		require.NoError(t, repo.CreateTransaction(ctx, txn))
+
+		edit := domain.RowEdit{RowIndex: 2, ColumnName: "status", NewValue: "shipped", History: []interface{}{"open", "paid"}}
+		require.NoError(t, repo.AddRowEdit(ctx, "history_txn", edit))
+
+		edits, err := repo.GetRowEdits(ctx, "history_txn")
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{"open", "paid"}, edits[2].History)
+	})
+
 	// UC-S5-15: Row Deletion Buffering
 	// E2E-S5-12: Transaction Row Delete Button
 	t.Run("AddRowDelete buffers row deletion", func(t *testing.T) {
@@ -548,6 +572,35 @@ func TransactionRepositoryRunner(t *testing.T, constructor TransactionRepository
 		require.NotNil(t, retrieved)
 	})
 
+	t.Run("ListTransactions returns every open transaction with its heartbeat", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+
+		err := repo.CreateTransaction(ctx, &domain.TransactionState{
+			ID:              "listed_txn",
+			Username:        "listed_user",
+			StartedAt:       now.Add(-20 * time.Minute),
+			ExpiresAt:       now.Add(40 * time.Minute),
+			LastHeartbeatAt: now.Add(-14 * time.Minute),
+			IdleWarnedAt:    now.Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		defer repo.DeleteTransaction(ctx, "listed_txn")
+
+		transactions, err := repo.ListTransactions(ctx)
+		require.NoError(t, err)
+
+		var listed *domain.TransactionState
+		for _, transaction := range transactions {
+			if transaction.ID == "listed_txn" {
+				listed = transaction
+			}
+		}
+		require.NotNil(t, listed)
+		require.Equal(t, "listed_user", listed.Username)
+		require.True(t, listed.LastHeartbeatAt.Equal(now.Add(-14*time.Minute)))
+		require.True(t, listed.IdleWarnedAt.Equal(now.Add(-time.Minute)))
+	})
+
 	// UC-S5-11: Cell Edit Buffering
 	// UC-S5-15: Row Deletion Buffering
 	// UC-S5-16: Row Insertion Buffering
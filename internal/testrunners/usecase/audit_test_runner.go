@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// AuditUsecaseConstructor is a function type that creates an AuditUseCase
+type AuditUsecaseConstructor func(
+	auditRepo repository.AuditRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	geoIPRepo repository.GeoIPRepository,
+) usecase.AuditUseCase
+
+// AuditUsecaseRunner runs all audit usecase tests against an implementation
+func AuditUsecaseRunner(t *testing.T, constructor AuditUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+	mockGeoIP := mockRepository.NewMockGeoIPRepository(ctrl)
+
+	uc := constructor(mockAudit, mockRBAC, mockConfig, mockGeoIP)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	entries := []domain.AuditEntry{
+		{
+			ID:           "audit_2",
+			Username:     "alice",
+			Action:       domain.AuditActionCommit,
+			Database:     "shop",
+			Schema:       "public",
+			Table:        "users",
+			Reason:       "fix, typo",
+			AffectedRows: []map[string]interface{}{{"operation": "update", "row_index": 2, "old_value": "Alcie", "new_value": "Alice"}},
+			CreatedAt:    now,
+		},
+		{
+			ID:        "audit_1",
+			Username:  "postgres",
+			Action:    domain.AuditActionMaintenance,
+			Before:    map[string]interface{}{"enabled": false},
+			After:     map[string]interface{}{"enabled": true},
+			CreatedAt: now.Add(-time.Hour),
+		},
+	}
+
+	t.Run("RecordAction stamps a fresh ID and time", func(t *testing.T) {
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Empty(t, entry.ID)
+				require.WithinDuration(t, time.Now(), entry.CreatedAt, time.Minute)
+				return nil
+			})
+
+		err := uc.RecordAction(ctx, &domain.AuditEntry{
+			ID:        "chosen_by_caller",
+			Username:  "alice",
+			Action:    domain.AuditActionImport,
+			CreatedAt: now.Add(-24 * time.Hour),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("RecordLogin flags a login from a new location", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{GeoIPDatabasePath: "/etc/lumen-pg/geoip.csv"}, nil)
+		mockGeoIP.EXPECT().
+			LookupLocation(gomock.Any(), "/etc/lumen-pg/geoip.csv", "203.0.113.9").
+			Return(&domain.GeoLocation{CountryCode: "FR", Country: "France", Region: "Ile-de-France", City: "Paris"}, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Username: "alice", Action: domain.AuditActionLogin, Limit: domain.LoginLocationHistory}).
+			Return([]domain.AuditEntry{
+				{Username: "alice", Action: domain.AuditActionLogin, After: map[string]interface{}{"client_ip": "10.0.0.1"}},
+				{Username: "alice", Action: domain.AuditActionLogin, After: map[string]interface{}{"country_code": "NL", "region": "North Holland"}},
+			}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionLogin, entry.Action)
+				require.Equal(t, "203.0.113.9", entry.Target)
+				require.Equal(t, "FR", entry.After["country_code"])
+				require.Equal(t, "Paris", entry.After["city"])
+				require.Equal(t, true, entry.After["new_location"])
+				return nil
+			})
+
+		event, err := uc.RecordLogin(ctx, "alice", "203.0.113.9")
+
+		require.NoError(t, err)
+		require.True(t, event.NewLocation)
+		require.Equal(t, "France", event.Location.Country)
+	})
+
+	t.Run("RecordLogin does not flag a known location or a first located login", func(t *testing.T) {
+		location := &domain.GeoLocation{CountryCode: "NL", Country: "Netherlands", Region: "North Holland"}
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{GeoIPDatabasePath: "geoip.csv"}, nil).Times(2)
+		mockGeoIP.EXPECT().LookupLocation(gomock.Any(), "geoip.csv", gomock.Any()).Return(location, nil).Times(2)
+		gomock.InOrder(
+			mockAudit.EXPECT().GetEntries(gomock.Any(), gomock.Any()).Return([]domain.AuditEntry{
+				{After: map[string]interface{}{"country_code": "NL", "region": "North Holland"}},
+			}, nil),
+			mockAudit.EXPECT().GetEntries(gomock.Any(), gomock.Any()).Return([]domain.AuditEntry{}, nil),
+		)
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		event, err := uc.RecordLogin(ctx, "alice", "192.0.2.1")
+		require.NoError(t, err)
+		require.False(t, event.NewLocation)
+
+		event, err = uc.RecordLogin(ctx, "bob", "192.0.2.1")
+		require.NoError(t, err)
+		require.False(t, event.NewLocation)
+		require.NotNil(t, event.Location)
+	})
+
+	t.Run("RecordLogin records without a location when enrichment is off or fails", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.NotContains(t, entry.After, "country_code")
+				require.Equal(t, false, entry.After["new_location"])
+				return nil
+			})
+
+		event, err := uc.RecordLogin(ctx, "alice", "192.0.2.1")
+		require.NoError(t, err)
+		require.Nil(t, event.Location)
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{GeoIPDatabasePath: "missing.csv"}, nil)
+		mockGeoIP.EXPECT().LookupLocation(gomock.Any(), "missing.csv", "192.0.2.1").Return(nil, errors.New("failed to open GeoIP database"))
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		event, err = uc.RecordLogin(ctx, "alice", "192.0.2.1")
+		require.NoError(t, err)
+		require.Nil(t, event.Location)
+	})
+
+	t.Run("ListAccountActivity lists the user's own logins, queries and exports", func(t *testing.T) {
+		login := domain.AuditEntry{ID: "audit_login", Username: "alice", Action: domain.AuditActionLogin, Target: "192.0.2.1"}
+		query := domain.AuditEntry{ID: "audit_query", Username: "alice", Action: domain.AuditActionQuery, After: map[string]interface{}{"statement": "SELECT $1"}}
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Username: "alice", Action: domain.AuditActionLogin, Limit: domain.AccountActivityLimit}).
+			Return([]domain.AuditEntry{login}, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Username: "alice", Action: domain.AuditActionQuery, Limit: domain.AccountActivityLimit}).
+			Return([]domain.AuditEntry{query}, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Username: "alice", Action: domain.AuditActionExport, Limit: domain.AccountActivityLimit}).
+			Return(nil, nil)
+
+		activity, err := uc.ListAccountActivity(ctx, "alice")
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.AuditEntry{login}, activity.Logins)
+		require.Equal(t, []domain.AuditEntry{query}, activity.Queries)
+		require.Empty(t, activity.Exports)
+	})
+
+	t.Run("ListAccountActivity requires a username", func(t *testing.T) {
+		_, err := uc.ListAccountActivity(ctx, "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "username", validationErr.Field)
+	})
+
+	t.Run("RecordAction requires an actor and an action", func(t *testing.T) {
+		err := uc.RecordAction(ctx, &domain.AuditEntry{Action: domain.AuditActionImport})
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "username", validationErr.Field)
+
+		err = uc.RecordAction(ctx, &domain.AuditEntry{Username: "alice"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "action", validationErr.Field)
+	})
+
+	t.Run("ListEntries passes the filter to the log", func(t *testing.T) {
+		filter := domain.AuditFilter{Username: "alice", Since: now.Add(-2 * time.Hour), Limit: 50}
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), filter).
+			Return(entries[:1], nil)
+
+		listed, err := uc.ListEntries(ctx, "postgres", filter)
+
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, "audit_2", listed[0].ID)
+	})
+
+	t.Run("ListEntries rejects non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.ListEntries(ctx, "alice", domain.AuditFilter{})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ListEntries rejects a time range ending before it starts", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		_, err := uc.ListEntries(ctx, "postgres", domain.AuditFilter{Since: now, Until: now.Add(-time.Hour)})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "until", validationErr.Field)
+	})
+
+	t.Run("ExportEntries writes CSV with structured columns as JSON", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Limit: domain.AuditExportLimit}).
+			Return(entries, nil)
+
+		export, err := uc.ExportEntries(ctx, "postgres", domain.AuditFilter{}, domain.AuditExportCSV)
+
+		require.NoError(t, err)
+		require.Equal(t, "text/csv", export.ContentType)
+		require.True(t, strings.HasSuffix(export.Filename, ".csv"))
+
+		records, err := csv.NewReader(strings.NewReader(string(export.Content))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "username", records[0][2])
+		require.Equal(t, "alice", records[1][2])
+		require.Equal(t, "fix, typo", records[1][8])
+		require.JSONEq(t, `[{"operation":"update","row_index":2,"old_value":"Alcie","new_value":"Alice"}]`, records[1][10])
+		require.Empty(t, records[1][11])
+		require.JSONEq(t, `{"enabled":true}`, records[2][12])
+	})
+
+	t.Run("ExportEntries writes JSON", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Action: domain.AuditActionMaintenance, Limit: 10}).
+			Return(entries[1:], nil)
+
+		export, err := uc.ExportEntries(ctx, "postgres", domain.AuditFilter{Action: domain.AuditActionMaintenance, Limit: 10}, domain.AuditExportJSON)
+
+		require.NoError(t, err)
+		require.Equal(t, "application/json", export.ContentType)
+
+		var records []map[string]interface{}
+		require.NoError(t, json.Unmarshal(export.Content, &records))
+		require.Len(t, records, 1)
+		require.Equal(t, "maintenance", records[0]["action"])
+		require.Equal(t, map[string]interface{}{"enabled": false}, records[0]["before"])
+	})
+
+	t.Run("ExportEntries rejects an unknown format", func(t *testing.T) {
+		_, err := uc.ExportEntries(ctx, "postgres", domain.AuditFilter{}, "xml")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "format", validationErr.Field)
+	})
+}
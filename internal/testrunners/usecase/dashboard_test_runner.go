@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// DashboardUsecaseConstructor is a function type that creates a DashboardUseCase
+type DashboardUsecaseConstructor func(
+	preferenceRepo repository.PreferenceRepository,
+	savedQueryRepo repository.SavedQueryRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+) usecase.DashboardUseCase
+
+// DashboardUsecaseRunner runs all dashboard tile usecase tests against an implementation
+func DashboardUsecaseRunner(t *testing.T, constructor DashboardUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPreference := mockRepository.NewMockPreferenceRepository(ctrl)
+	mockSavedQuery := mockRepository.NewMockSavedQueryRepository(ctrl)
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+
+	uc := constructor(mockPreference, mockSavedQuery, mockDatabase, mockRBAC)
+
+	ctx := context.Background()
+	const tilesKey = "dashboard_tiles"
+	const storedTiles = `[{"id":"tile_orders","saved_query_id":"query_orders","title":"Open orders","kind":"row_count","refresh_seconds":30},` +
+		`{"id":"tile_revenue","saved_query_id":"query_revenue","title":"Revenue","kind":"scalar","refresh_seconds":300}]`
+
+	t.Run("PinQueryResult appends a tile named after the saved query", func(t *testing.T) {
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_queue").Return(&domain.SavedQuery{
+			ID: "query_queue", Name: "Queue depth", Query: "select count(*) from jobs",
+		}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+		mockPreference.EXPECT().
+			SetPreference(gomock.Any(), "alice", tilesKey, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, value string) error {
+				var tiles []map[string]interface{}
+				require.NoError(t, json.Unmarshal([]byte(value), &tiles))
+				require.Len(t, tiles, 3)
+				require.Equal(t, "tile_orders", tiles[0]["id"])
+				require.Equal(t, "Queue depth", tiles[2]["title"])
+				require.Equal(t, "scalar", tiles[2]["kind"])
+				require.Equal(t, float64(domain.LiveRefreshMinInterval), tiles[2]["refresh_seconds"])
+				return nil
+			})
+
+		tile, err := uc.PinQueryResult(ctx, "alice", domain.DashboardTile{
+			SavedQueryID:   "query_queue",
+			Kind:           domain.DashboardTileScalar,
+			RefreshSeconds: 1,
+		})
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(tile.ID, "tile_"))
+		require.Equal(t, "Queue depth", tile.Title)
+		require.Equal(t, domain.LiveRefreshMinInterval, tile.RefreshSeconds)
+	})
+
+	t.Run("PinQueryResult defaults the kind and refresh interval", func(t *testing.T) {
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_queue").Return(&domain.SavedQuery{
+			ID: "query_queue", Name: "Queue depth", Query: "SELECT * FROM jobs",
+		}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{}, nil)
+		mockPreference.EXPECT().SetPreference(gomock.Any(), "alice", tilesKey, gomock.Any()).Return(nil)
+
+		tile, err := uc.PinQueryResult(ctx, "alice", domain.DashboardTile{SavedQueryID: "query_queue", Title: " Jobs "})
+
+		require.NoError(t, err)
+		require.Equal(t, "Jobs", tile.Title)
+		require.Equal(t, domain.DashboardTileRowCount, tile.Kind)
+		require.Equal(t, domain.DashboardTileDefaultRefresh, tile.RefreshSeconds)
+	})
+
+	t.Run("PinQueryResult refuses unknown kinds, writes and templates", func(t *testing.T) {
+		var validationErr domain.ValidationError
+
+		_, err := uc.PinQueryResult(ctx, "alice", domain.DashboardTile{SavedQueryID: "query_queue", Kind: "chart"})
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, "kind", validationErr.Field)
+
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_purge").Return(&domain.SavedQuery{
+			ID: "query_purge", Query: "DELETE FROM jobs",
+		}, nil)
+		_, err = uc.PinQueryResult(ctx, "alice", domain.DashboardTile{SavedQueryID: "query_purge"})
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, "query", validationErr.Field)
+
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_by_status").Return(&domain.SavedQuery{
+			ID:        "query_by_status",
+			Query:     "SELECT * FROM jobs WHERE status = {{status:text}}",
+			Variables: []domain.TemplateVariable{{Name: "status", Type: "text"}},
+		}, nil)
+		_, err = uc.PinQueryResult(ctx, "alice", domain.DashboardTile{SavedQueryID: "query_by_status"})
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, "query", validationErr.Field)
+	})
+
+	t.Run("PinQueryResult passes on a missing saved query", func(t *testing.T) {
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_gone").Return(nil, domain.ErrSavedQueryNotFound)
+
+		_, err := uc.PinQueryResult(ctx, "alice", domain.DashboardTile{SavedQueryID: "query_gone"})
+
+		require.ErrorIs(t, err, domain.ErrSavedQueryNotFound)
+	})
+
+	t.Run("ListDashboardTiles returns the tiles in pinned order", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+
+		tiles, err := uc.ListDashboardTiles(ctx, "alice")
+
+		require.NoError(t, err)
+		require.Len(t, tiles, 2)
+		require.Equal(t, "Open orders", tiles[0].Title)
+		require.Equal(t, domain.DashboardTileScalar, tiles[1].Kind)
+		require.Equal(t, 300, tiles[1].RefreshSeconds)
+	})
+
+	t.Run("UnpinDashboardTile removes the preference with the last tile", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			tilesKey: `[{"id":"tile_orders","saved_query_id":"query_orders","title":"Open orders","kind":"row_count","refresh_seconds":30}]`,
+		}, nil)
+		mockPreference.EXPECT().DeletePreference(gomock.Any(), "alice", tilesKey).Return(nil)
+
+		require.NoError(t, uc.UnpinDashboardTile(ctx, "alice", "tile_orders"))
+	})
+
+	t.Run("UnpinDashboardTile reports an unknown tile", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+
+		err := uc.UnpinDashboardTile(ctx, "alice", "tile_missing")
+
+		require.ErrorIs(t, err, domain.ErrDashboardTileNotFound)
+	})
+
+	t.Run("RefreshDashboardTile counts the rows of the saved query", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_orders").Return(&domain.SavedQuery{
+			ID: "query_orders", Query: "SELECT id FROM orders WHERE status = 'open'",
+		}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "", "", "").Return(true, nil)
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "alice", "SELECT id FROM orders WHERE status = 'open'").
+			DoAndReturn(func(ctx context.Context, _, _ string, _ ...interface{}) (*domain.QueryResult, error) {
+				_, hasDeadline := ctx.Deadline()
+				require.True(t, hasDeadline)
+				return &domain.QueryResult{
+					Columns: []string{"id"},
+					Rows:    []map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 3}},
+				}, nil
+			})
+
+		result, err := uc.RefreshDashboardTile(ctx, "alice", "tile_orders")
+
+		require.NoError(t, err)
+		require.Equal(t, "tile_orders", result.TileID)
+		require.Equal(t, 3, result.RowCount)
+		require.Nil(t, result.Value)
+		require.False(t, result.RefreshedAt.IsZero())
+	})
+
+	t.Run("RefreshDashboardTile reads the single value of a scalar tile", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_revenue").Return(&domain.SavedQuery{
+			ID: "query_revenue", Query: "SELECT sum(total) FROM orders",
+		}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "", "", "").Return(true, nil)
+		mockDatabase.EXPECT().ExecuteTrackedQuery(gomock.Any(), "alice", "SELECT sum(total) FROM orders").Return(&domain.QueryResult{
+			Columns: []string{"sum"},
+			Rows:    []map[string]interface{}{{"sum": "1520.50"}},
+		}, nil)
+
+		result, err := uc.RefreshDashboardTile(ctx, "alice", "tile_revenue")
+
+		require.NoError(t, err)
+		require.Equal(t, "1520.50", result.Value)
+		require.Equal(t, 1, result.RowCount)
+	})
+
+	t.Run("RefreshDashboardTile refuses a scalar tile whose query returns several values", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_revenue").Return(&domain.SavedQuery{
+			ID: "query_revenue", Query: "SELECT region, sum(total) FROM orders GROUP BY region",
+		}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "", "", "").Return(true, nil)
+		mockDatabase.EXPECT().ExecuteTrackedQuery(gomock.Any(), "alice", gomock.Any()).Return(&domain.QueryResult{
+			Columns: []string{"region", "sum"},
+			Rows:    []map[string]interface{}{{"region": "eu", "sum": 10}, {"region": "us", "sum": 20}},
+		}, nil)
+
+		_, err := uc.RefreshDashboardTile(ctx, "alice", "tile_revenue")
+
+		var validationErr domain.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, "query", validationErr.Field)
+	})
+
+	t.Run("RefreshDashboardTile refuses users without SELECT permission", func(t *testing.T) {
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{tilesKey: storedTiles}, nil)
+		mockSavedQuery.EXPECT().GetSavedQuery(gomock.Any(), "alice", "query_orders").Return(&domain.SavedQuery{
+			ID: "query_orders", Query: "SELECT id FROM orders",
+		}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "", "", "").Return(false, nil)
+
+		_, err := uc.RefreshDashboardTile(ctx, "alice", "tile_orders")
+
+		var validationErr domain.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		require.Equal(t, "permission", validationErr.Field)
+	})
+}
@@ -1,8 +1,17 @@
 package usecase
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -18,6 +27,10 @@ type DataViewUsecaseConstructor func(
 	metadataRepo repository.MetadataRepository,
 	databaseRepo repository.DatabaseRepository,
 	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	slowOperationRepo repository.SlowOperationRepository,
+	auditRepo repository.AuditRepository,
+	snapshotRepo repository.TableSnapshotRepository,
 ) usecase.DataViewUseCase
 
 // DataViewUsecaseRunner runs all DataView usecase tests against an implementation
@@ -33,8 +46,26 @@ func DataViewUsecaseRunner(t *testing.T, constructor DataViewUsecaseConstructor)
 	mockMetadata := mockrepository.NewMockMetadataRepository(ctrl)
 	mockDatabase := mockrepository.NewMockDatabaseRepository(ctrl)
 	mockRBAC := mockrepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockrepository.NewMockConfigRepository(ctrl)
 
-	uc := constructor(mockMetadata, mockDatabase, mockRBAC)
+	mockSlowOperation := mockrepository.NewMockSlowOperationRepository(ctrl)
+
+	// Exports are kept for account activity; the test that checks it uses its own log
+	quietAudit := func(ctrl *gomock.Controller) repository.AuditRepository {
+		audit := mockrepository.NewMockAuditRepository(ctrl)
+		audit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		return audit
+	}
+
+	mockSnapshot := mockrepository.NewMockTableSnapshotRepository(ctrl)
+
+	uc := constructor(mockMetadata, mockDatabase, mockRBAC, mockConfig, mockSlowOperation, quietAudit(ctrl), mockSnapshot)
+
+	// Tables have no encrypted columns unless a test configures them
+	mockConfig.EXPECT().
+		GetConfig(gomock.Any()).
+		Return(&domain.AppConfig{}, nil).
+		AnyTimes()
 
 	// UC-S5-01: Table Data Loading
 	// IT-S5-01: Real Table Data Loading
@@ -108,6 +139,249 @@ func DataViewUsecaseRunner(t *testing.T, constructor DataViewUsecaseConstructor)
 		require.NotNil(t, result)
 	})
 
+	t.Run("SearchObjects ranks readable tables, views, columns and functions by how closely they match", func(t *testing.T) {
+		objectsCtrl := gomock.NewController(t)
+		defer objectsCtrl.Finish()
+
+		objectsMetadata := mockrepository.NewMockMetadataRepository(objectsCtrl)
+		objectsUC := constructor(objectsMetadata, mockrepository.NewMockDatabaseRepository(objectsCtrl), mockrepository.NewMockRBACRepository(objectsCtrl), mockrepository.NewMockConfigRepository(objectsCtrl), mockrepository.NewMockSlowOperationRepository(objectsCtrl), quietAudit(objectsCtrl), mockrepository.NewMockTableSnapshotRepository(objectsCtrl))
+
+		objectsMetadata.EXPECT().
+			GetRoleMetadata(gomock.Any(), "testuser").
+			Return(&domain.RoleMetadata{
+				AccessibleDatabases: []string{"testdb", "coldb"},
+				AccessibleSchemas:   []string{"public"},
+				AccessibleTables: []domain.AccessibleTable{
+					{Database: "testdb", Schema: "public", Name: "orders", HasSelect: true},
+					{Database: "testdb", Schema: "public", Name: "customer_orders", HasSelect: true},
+					{Database: "testdb", Schema: "public", Name: "order_totals", HasSelect: true},
+					{Database: "testdb", Schema: "public", Name: "payroll"},
+				},
+			}, nil).
+			AnyTimes()
+		objectsMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{Name: "orders", Columns: []domain.ColumnMetadata{{Name: "id"}, {Name: "reorder_flag"}}},
+							{Name: "customer_orders", Columns: []domain.ColumnMetadata{{Name: "order_id"}}},
+							{Name: "payroll", Columns: []domain.ColumnMetadata{{Name: "order_bonus"}}},
+						},
+						Views:     []domain.TableMetadata{{Name: "order_totals"}},
+						Functions: []string{"place_order"},
+					},
+					{
+						Name:   "audit",
+						Tables: []domain.TableMetadata{{Name: "order_log"}},
+					},
+				},
+			}, nil).
+			AnyTimes()
+		objectsMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "coldb").
+			Return(nil, errors.New("metadata not cached")).
+			AnyTimes()
+
+		matches, err := objectsUC.SearchObjects(ctx, "testuser", " Order ")
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.ObjectSearchMatch{
+			{Kind: domain.ObjectKindTable, Database: "testdb", Schema: "public", Name: "orders", Rank: domain.ObjectMatchPrefix},
+			{Kind: domain.ObjectKindView, Database: "testdb", Schema: "public", Name: "order_totals", Rank: domain.ObjectMatchPrefix},
+			{Kind: domain.ObjectKindColumn, Database: "testdb", Schema: "public", Table: "customer_orders", Name: "order_id", Rank: domain.ObjectMatchPrefix},
+			{Kind: domain.ObjectKindTable, Database: "testdb", Schema: "public", Name: "customer_orders", Rank: domain.ObjectMatchWord},
+			{Kind: domain.ObjectKindFunction, Database: "testdb", Schema: "public", Name: "place_order", Rank: domain.ObjectMatchWord},
+			{Kind: domain.ObjectKindColumn, Database: "testdb", Schema: "public", Table: "orders", Name: "reorder_flag", Rank: domain.ObjectMatchContains},
+		}, matches)
+
+		// A qualified term narrows the search to one relation's columns
+		matches, err = objectsUC.SearchObjects(ctx, "testuser", "orders.id")
+		require.NoError(t, err)
+		require.Equal(t, []domain.ObjectSearchMatch{
+			{Kind: domain.ObjectKindColumn, Database: "testdb", Schema: "public", Table: "orders", Name: "id", Rank: domain.ObjectMatchExact},
+		}, matches)
+
+		_, err = objectsUC.SearchObjects(ctx, "testuser", "   ")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "search", validationErr.Field)
+	})
+
+	t.Run("SearchTableData matches the term across text columns and reports where it matched", func(t *testing.T) {
+		searchCtrl := gomock.NewController(t)
+		defer searchCtrl.Finish()
+
+		searchMetadata := mockrepository.NewMockMetadataRepository(searchCtrl)
+		searchDatabase := mockrepository.NewMockDatabaseRepository(searchCtrl)
+		searchRBAC := mockrepository.NewMockRBACRepository(searchCtrl)
+		searchConfig := mockrepository.NewMockConfigRepository(searchCtrl)
+		searchUC := constructor(searchMetadata, searchDatabase, searchRBAC, searchConfig, mockrepository.NewMockSlowOperationRepository(searchCtrl), quietAudit(searchCtrl), mockrepository.NewMockTableSnapshotRepository(searchCtrl))
+
+		searchRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "customers").
+			Return(true, nil)
+		searchMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name: "customers",
+						Columns: []domain.ColumnMetadata{
+							{Name: "id", DataType: "integer"},
+							{Name: "name", DataType: "character varying"},
+							{Name: "notes", DataType: "text", IsNullable: true},
+							{Name: "tier", DataType: "USER-DEFINED", EnumValues: []string{"gold", "silver"}},
+							{Name: "ssn", DataType: "text"},
+						},
+					}},
+				}},
+			}, nil).
+			AnyTimes()
+		searchConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EncryptedColumns: []string{"public.customers.ssn"}, ColumnEncryptionKey: "key"}, nil).
+			AnyTimes()
+		searchRBAC.EXPECT().
+			HasUpdatePermission(gomock.Any(), "testuser", "testdb", "public", "customers").
+			Return(true, nil)
+		searchDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				_, hasDeadline := ctx.Deadline()
+				require.True(t, hasDeadline)
+				require.Equal(t, `"name"::text ILIKE $1 OR "notes"::text ILIKE $1 OR "tier"::text ILIKE $1`, params.WhereClause)
+				require.Equal(t, []interface{}{`%50\%\_off%`}, params.WhereArgs)
+				require.Equal(t, domain.TableSearchMaxRows, params.Limit)
+				return &domain.QueryResult{
+					Columns: []string{"id", "name", "notes", "tier", "ssn"},
+					Rows: []map[string]interface{}{
+						{"id": int64(1), "name": "50%_OFF club", "notes": []byte("asked for 50%_off again"), "tier": "gold", "ssn": "x"},
+						{"id": int64(2), "name": "Bob", "notes": []byte("50%_Off coupon"), "tier": "silver", "ssn": "y"},
+					},
+					RowCount:   2,
+					TotalCount: 340,
+				}, nil
+			})
+
+		result, err := searchUC.SearchTableData(ctx, "testuser", "testdb", "public", "customers", " 50%_off ")
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"name", "notes", "tier"}, result.SearchedColumns)
+		require.Equal(t, [][]string{{"name", "notes"}, {"notes"}}, result.MatchedColumns)
+		require.Len(t, result.Rows, 2)
+		require.Equal(t, int64(340), result.TotalMatches)
+	})
+
+	t.Run("SearchTableData refuses empty terms and tables without text columns", func(t *testing.T) {
+		_, err := uc.SearchTableData(ctx, "testuser", "testdb", "public", "users", "  ")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "search", validationErr.Field)
+
+		searchCtrl := gomock.NewController(t)
+		defer searchCtrl.Finish()
+
+		searchMetadata := mockrepository.NewMockMetadataRepository(searchCtrl)
+		searchRBAC := mockrepository.NewMockRBACRepository(searchCtrl)
+		searchConfig := mockrepository.NewMockConfigRepository(searchCtrl)
+		searchUC := constructor(searchMetadata, mockrepository.NewMockDatabaseRepository(searchCtrl), searchRBAC, searchConfig, mockrepository.NewMockSlowOperationRepository(searchCtrl), quietAudit(searchCtrl), mockrepository.NewMockTableSnapshotRepository(searchCtrl))
+
+		searchRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "readings").
+			Return(true, nil)
+		searchMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name:    "readings",
+						Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "value", DataType: "numeric"}},
+					}},
+				}},
+			}, nil)
+		searchConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+
+		_, err = searchUC.SearchTableData(ctx, "testuser", "testdb", "public", "readings", "42")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "search", validationErr.Field)
+	})
+
+	t.Run("FilterTableDataByPredicates binds every value as a parameter", func(t *testing.T) {
+		filterCtrl := gomock.NewController(t)
+		defer filterCtrl.Finish()
+
+		filterMetadata := mockrepository.NewMockMetadataRepository(filterCtrl)
+		filterDatabase := mockrepository.NewMockDatabaseRepository(filterCtrl)
+		filterRBAC := mockrepository.NewMockRBACRepository(filterCtrl)
+		filterConfig := mockrepository.NewMockConfigRepository(filterCtrl)
+		filterUC := constructor(filterMetadata, filterDatabase, filterRBAC, filterConfig, mockrepository.NewMockSlowOperationRepository(filterCtrl), quietAudit(filterCtrl), mockrepository.NewMockTableSnapshotRepository(filterCtrl))
+
+		filterRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(true, nil).
+			AnyTimes()
+		filterMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name: "orders",
+						Columns: []domain.ColumnMetadata{
+							{Name: "id", DataType: "integer"},
+							{Name: "status", DataType: "text"},
+							{Name: "shipped_at", DataType: "timestamp", IsNullable: true},
+						},
+					}},
+				}},
+			}, nil).
+			AnyTimes()
+		filterConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+		filterDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				require.Equal(t, `("status" = $1 AND ("id" IN ($2, $3) OR "status"::text ILIKE $4) AND "shipped_at" IS NULL)`, params.WhereClause)
+				require.Equal(t, []interface{}{"open' OR '1'='1", float64(4), float64(7), "%rush%"}, params.WhereArgs)
+				return &domain.QueryResult{Columns: []string{"id", "status", "shipped_at"}, RowCount: 1}, nil
+			})
+
+		result, err := filterUC.FilterTableDataByPredicates(ctx, "testuser", "testdb", "public", "orders", domain.FilterPredicate{
+			Group: domain.FilterGroupAnd,
+			Predicates: []domain.FilterPredicate{
+				{Column: "status", Operator: domain.FilterOperatorEqual, Value: "open' OR '1'='1"},
+				{Group: domain.FilterGroupOr, Predicates: []domain.FilterPredicate{
+					{Column: "id", Operator: domain.FilterOperatorIn, Value: []interface{}{float64(4), float64(7)}},
+					{Column: "status", Operator: domain.FilterOperatorILike, Value: "%rush%"},
+				}},
+				{Column: "shipped_at", Operator: domain.FilterOperatorIsNull},
+			},
+		}, 0, 50)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.RowCount)
+
+		// Unknown columns, operators and empty groups are refused before any read
+		var validationErr domain.ValidationError
+		for _, filter := range []domain.FilterPredicate{
+			{Column: "id; DROP TABLE orders", Operator: domain.FilterOperatorEqual, Value: "1"},
+			{Column: "id", Operator: "~", Value: "1"},
+			{Column: "id", Operator: domain.FilterOperatorEqual},
+			{Column: "id", Operator: domain.FilterOperatorIn, Value: []interface{}{}},
+			{Group: domain.FilterGroupOr},
+			{Group: "xor", Predicates: []domain.FilterPredicate{{Column: "id", Operator: domain.FilterOperatorIsNull}}},
+		} {
+			_, err := filterUC.FilterTableDataByPredicates(ctx, "testuser", "testdb", "public", "orders", filter, 0, 50)
+			require.ErrorAs(t, err, &validationErr)
+			require.Equal(t, "filter", validationErr.Field)
+		}
+	})
+
 	// UC-S5-04: WHERE Clause Injection Prevention
 	t.Run("ValidateWhereClause rejects injection", func(t *testing.T) {
 		valid, err := uc.ValidateWhereClause(ctx, "1' OR '1'='1")
@@ -123,6 +397,40 @@ func DataViewUsecaseRunner(t *testing.T, constructor DataViewUsecaseConstructor)
 		require.True(t, valid)
 	})
 
+	t.Run("ValidateWhereClause audits the rule and route of a rejected clause", func(t *testing.T) {
+		rejectCtrl := gomock.NewController(t)
+		defer rejectCtrl.Finish()
+
+		rejectAudit := mockrepository.NewMockAuditRepository(rejectCtrl)
+		rejectUC := constructor(mockrepository.NewMockMetadataRepository(rejectCtrl), mockrepository.NewMockDatabaseRepository(rejectCtrl),
+			mockrepository.NewMockRBACRepository(rejectCtrl), mockrepository.NewMockConfigRepository(rejectCtrl),
+			mockrepository.NewMockSlowOperationRepository(rejectCtrl), rejectAudit, mockrepository.NewMockTableSnapshotRepository(rejectCtrl))
+
+		rejectAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionRequestRejected, entry.Action)
+				require.Equal(t, "sql_validator:union_select", entry.Target)
+				require.Equal(t, "union_select", entry.After["rule"])
+				require.Equal(t, "/main/filter", entry.After["route"])
+				require.Equal(t, "POST", entry.After["method"])
+				return nil
+			})
+
+		originCtx := domain.WithRequestOrigin(ctx, domain.RequestOrigin{Method: "POST", Route: "/main/filter", RemoteAddr: "10.0.0.5:4242"})
+		valid, err := rejectUC.ValidateWhereClause(originCtx, "id = 1 UNION SELECT passwd FROM pg_shadow")
+		require.NoError(t, err)
+		require.False(t, valid)
+
+		// Safe and empty clauses are not rule matches and leave no entry
+		valid, err = rejectUC.ValidateWhereClause(originCtx, "id > 10")
+		require.NoError(t, err)
+		require.True(t, valid)
+		valid, err = rejectUC.ValidateWhereClause(originCtx, "  ")
+		require.NoError(t, err)
+		require.False(t, valid)
+	})
+
 	// UC-S5-05: Column Sorting ASC
 	t.Run("SortTableData sorts ascending", func(t *testing.T) {
 		mockDatabase.EXPECT().
@@ -373,4 +681,2283 @@ func DataViewUsecaseRunner(t *testing.T, constructor DataViewUsecaseConstructor)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 	})
+
+	// Column aggregate footer
+	t.Run("GetColumnAggregate computes aggregates for numeric column", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "orders",
+								Columns: []domain.ColumnMetadata{
+									{Name: "id", DataType: "integer", IsPrimary: true},
+									{Name: "amount", DataType: "numeric", IsNullable: true},
+								},
+								PrimaryKeys: []string{"id"},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		sum, avg, min, max := 60.0, 20.0, 10.0, 30.0
+		mockDatabase.EXPECT().
+			GetColumnAggregate(gomock.Any(), "testdb", "public", "orders", "amount", "id > 0").
+			Return(&domain.ColumnAggregate{Column: "amount", Count: 3, Sum: &sum, Avg: &avg, Min: &min, Max: &max}, nil)
+
+		aggregate, err := uc.GetColumnAggregate(ctx, "testuser", "testdb", "public", "orders", "amount", "id > 0")
+
+		require.NoError(t, err)
+		require.NotNil(t, aggregate)
+		require.Equal(t, int64(3), aggregate.Count)
+		require.Equal(t, 60.0, *aggregate.Sum)
+	})
+
+	t.Run("GetColumnAggregate rejects non-numeric column", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "users",
+								Columns: []domain.ColumnMetadata{
+									{Name: "name", DataType: "text", IsNullable: true},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		aggregate, err := uc.GetColumnAggregate(ctx, "testuser", "testdb", "public", "users", "name", "")
+
+		require.Error(t, err)
+		require.Nil(t, aggregate)
+	})
+
+	// Group-by pivot preview
+	t.Run("GroupTableData returns groups with drill-down filters", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "orders",
+								Columns: []domain.ColumnMetadata{
+									{Name: "status", DataType: "text", IsNullable: true},
+									{Name: "amount", DataType: "numeric", IsNullable: true},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		total := 150.0
+		mockDatabase.EXPECT().
+			GetGroupedCounts(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.GroupByParams) ([]domain.GroupByBucket, error) {
+				require.Equal(t, "SUM", params.AggregateFunc)
+				require.Equal(t, domain.GroupByDefaultLimit, params.Limit)
+				return []domain.GroupByBucket{
+					{Value: "paid", Count: 2, Aggregate: &total},
+					{Value: nil, Count: 1},
+				}, nil
+			})
+
+		result, err := uc.GroupTableData(ctx, "testuser", domain.GroupByParams{
+			Database:        "testdb",
+			Schema:          "public",
+			Table:           "orders",
+			GroupColumn:     "status",
+			AggregateColumn: "amount",
+			AggregateFunc:   "sum",
+			WhereClause:     "amount > 0",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Groups, 2)
+		require.Equal(t, `(amount > 0) AND "status" = 'paid'`, result.Groups[0].DrillDownFilter)
+		require.Equal(t, `(amount > 0) AND "status" IS NULL`, result.Groups[1].DrillDownFilter)
+	})
+
+	t.Run("GroupTableData rejects unknown aggregate function", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "orders",
+								Columns: []domain.ColumnMetadata{
+									{Name: "status", DataType: "text"},
+									{Name: "amount", DataType: "numeric"},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		result, err := uc.GroupTableData(ctx, "testuser", domain.GroupByParams{
+			Database:        "testdb",
+			Schema:          "public",
+			Table:           "orders",
+			GroupColumn:     "status",
+			AggregateColumn: "amount",
+			AggregateFunc:   "STDDEV",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	// Histogram for date and numeric columns
+	t.Run("GetColumnHistogram detects temporal column and defaults bucket count", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "orders",
+								Columns: []domain.ColumnMetadata{
+									{Name: "created_at", DataType: "timestamp with time zone"},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		mockDatabase.EXPECT().
+			GetColumnHistogram(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.HistogramParams) ([]domain.HistogramBucket, error) {
+				require.True(t, params.IsTemporal)
+				require.Equal(t, domain.HistogramDefaultBuckets, params.Buckets)
+				return make([]domain.HistogramBucket, params.Buckets), nil
+			})
+
+		result, err := uc.GetColumnHistogram(ctx, "testuser", domain.HistogramParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "orders",
+			Column:   "created_at",
+		})
+
+		require.NoError(t, err)
+		require.True(t, result.IsTemporal)
+		require.Len(t, result.Buckets, domain.HistogramDefaultBuckets)
+	})
+
+	t.Run("GetColumnHistogram rejects text column", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "users",
+								Columns: []domain.ColumnMetadata{
+									{Name: "name", DataType: "text"},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		result, err := uc.GetColumnHistogram(ctx, "testuser", domain.HistogramParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+			Column:   "name",
+			Buckets:  5,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	// Duplicate row detection
+	t.Run("FindDuplicateRows uses table primary key for samples", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "users",
+								Columns: []domain.ColumnMetadata{
+									{Name: "id", DataType: "integer", IsPrimary: true},
+									{Name: "email", DataType: "text"},
+								},
+								PrimaryKeys: []string{"id"},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		mockDatabase.EXPECT().
+			FindDuplicateRows(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.DuplicateParams) ([]domain.DuplicateGroup, error) {
+				require.Equal(t, []string{"id"}, params.PrimaryKeys)
+				require.Equal(t, domain.DuplicateSampleSize, params.SampleSize)
+				return []domain.DuplicateGroup{
+					{
+						Values:            map[string]interface{}{"email": "a@example.com"},
+						Count:             2,
+						SamplePrimaryKeys: []map[string]interface{}{{"id": 1}, {"id": 7}},
+					},
+				}, nil
+			})
+
+		report, err := uc.FindDuplicateRows(ctx, "testuser", domain.DuplicateParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+			Columns:  []string{"email"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, report.Groups, 1)
+		require.Equal(t, int64(2), report.Groups[0].Count)
+	})
+
+	t.Run("FindDuplicateRows requires columns", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		report, err := uc.FindDuplicateRows(ctx, "testuser", domain.DuplicateParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, report)
+	})
+
+	// Null and empty-value audit
+	t.Run("GetDataQualityReport audits every column with default sample", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "users",
+								Columns: []domain.ColumnMetadata{
+									{Name: "id", DataType: "integer", IsPrimary: true},
+									{Name: "email", DataType: "text", IsNullable: true},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		mockDatabase.EXPECT().
+			GetColumnQualityStats(gomock.Any(), "testdb", "public", "users", []string{"id", "email"}, domain.DataQualitySampleLimit).
+			Return(&domain.DataQualityReport{
+				Table:       "users",
+				SampledRows: 4,
+				Columns: []domain.ColumnQualityStats{
+					{Column: "id"},
+					{Column: "email", NullCount: 1, EmptyCount: 1, NullPercent: 25, EmptyPercent: 25},
+				},
+			}, nil)
+
+		report, err := uc.GetDataQualityReport(ctx, "testuser", "testdb", "public", "users", 0)
+
+		require.NoError(t, err)
+		require.Len(t, report.Columns, 2)
+		require.Equal(t, "text", report.Columns[1].DataType)
+		require.Equal(t, 25.0, report.Columns[1].NullPercent)
+	})
+
+	// Table statistics and bloat report
+	t.Run("GetTableStatistics derives usage percentages, bloat and unused indexes", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		// 36-byte rows pack 120 to an 8kB page, so 10000 rows need 84 pages of the 100 the table holds
+		width := int64(36)
+		mockDatabase.EXPECT().
+			GetTableStatistics(gomock.Any(), "testdb", "public", "users").
+			Return(&domain.TableStatistics{
+				Table:         "users",
+				LiveTuples:    9000,
+				DeadTuples:    1000,
+				SeqScans:      30,
+				IndexScans:    90,
+				Pages:         100,
+				EstimatedRows: 10000,
+				RowWidth:      &width,
+				FillFactor:    100,
+				BlockSize:     8192,
+				Indexes: []domain.IndexUsageStats{
+					{Name: "users_pkey", Unique: true},
+					{Name: "users_email_idx", Scans: 90},
+					{Name: "users_created_idx"},
+				},
+			}, nil)
+
+		stats, err := uc.GetTableStatistics(ctx, "testuser", "testdb", "public", "users")
+
+		require.NoError(t, err)
+		require.Equal(t, 10.0, stats.DeadTuplePercent)
+		require.NotNil(t, stats.IndexScanPercent)
+		require.Equal(t, 75.0, *stats.IndexScanPercent)
+		require.NotNil(t, stats.EstimatedBloatBytes)
+		require.Equal(t, int64(16*8192), *stats.EstimatedBloatBytes)
+		require.Equal(t, 16.0, *stats.EstimatedBloatPercent)
+		require.Equal(t, []string{"users_created_idx"}, stats.UnusedIndexes)
+	})
+
+	t.Run("GetTableStatistics leaves bloat unknown before the table is analyzed", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+		mockDatabase.EXPECT().
+			GetTableStatistics(gomock.Any(), "testdb", "public", "users").
+			Return(&domain.TableStatistics{Table: "users", Pages: 10, EstimatedRows: -1, BlockSize: 8192}, nil)
+
+		stats, err := uc.GetTableStatistics(ctx, "testuser", "testdb", "public", "users")
+
+		require.NoError(t, err)
+		require.Nil(t, stats.EstimatedBloatBytes)
+		require.Nil(t, stats.IndexScanPercent)
+		require.Zero(t, stats.DeadTuplePercent)
+	})
+
+	t.Run("GetTableStatistics requires SELECT permission", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "salaries").
+			Return(false, nil)
+
+		_, err := uc.GetTableStatistics(ctx, "testuser", "testdb", "public", "salaries")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	t.Run("GetTableSizeEstimates sizes only the tables the user can read", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(true, nil)
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "salaries").
+			Return(false, nil)
+		rows := int64(1200)
+		mockDatabase.EXPECT().
+			GetTableSizeEstimates(gomock.Any(), "testdb", "public", []string{"orders"}).
+			Return([]domain.TableSizeEstimate{{Schema: "public", Table: "orders", EstimatedRows: &rows, TotalSizeBytes: 98304}}, nil)
+
+		estimates, err := uc.GetTableSizeEstimates(ctx, "testuser", "testdb", "public", []string{"orders", "salaries"})
+
+		require.NoError(t, err)
+		require.Len(t, estimates, 1)
+		require.Equal(t, "orders", estimates[0].Table)
+
+		// Oversized batches are refused before any lookup
+		_, err = uc.GetTableSizeEstimates(ctx, "testuser", "testdb", "public", make([]string, domain.TableSizeBatchLimit+1))
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "tables", validationErr.Field)
+	})
+
+	// Referential integrity checker
+	t.Run("LookupForeignKeyValues searches the referenced table by its display columns", func(t *testing.T) {
+		lookupCtrl := gomock.NewController(t)
+		defer lookupCtrl.Finish()
+
+		lookupMetadata := mockrepository.NewMockMetadataRepository(lookupCtrl)
+		lookupDatabase := mockrepository.NewMockDatabaseRepository(lookupCtrl)
+		lookupRBAC := mockrepository.NewMockRBACRepository(lookupCtrl)
+		lookupConfig := mockrepository.NewMockConfigRepository(lookupCtrl)
+		lookupUC := constructor(lookupMetadata, lookupDatabase, lookupRBAC, lookupConfig, mockrepository.NewMockSlowOperationRepository(lookupCtrl), quietAudit(lookupCtrl), mockrepository.NewMockTableSnapshotRepository(lookupCtrl))
+
+		lookupMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name:        "orders",
+								Columns:     []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "user_id", DataType: "integer"}, {Name: "product_id", DataType: "integer"}},
+								PrimaryKeys: []string{"id"},
+								ForeignKeys: []domain.ForeignKeyMetadata{
+									{ColumnName: "user_id", ReferencedTable: "users", ReferencedColumn: "id"},
+									{ColumnName: "product_id", ReferencedSchema: "public", ReferencedTable: "products", ReferencedColumn: "id"},
+								},
+							},
+							{
+								Name:    "users",
+								Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "email", DataType: "text"}, {Name: "name", DataType: "text"}},
+							},
+							{
+								Name:    "products",
+								Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "sku", DataType: "text"}, {Name: "title", DataType: "text"}},
+							},
+						},
+					},
+				},
+			}, nil).
+			AnyTimes()
+		lookupConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{LookupDisplayColumns: map[string][]string{"public.products": {"sku", "title"}}}, nil).
+			AnyTimes()
+		lookupRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", gomock.Any()).
+			Return(true, nil).
+			Times(2)
+
+		// Without configured columns the parent table is shown by its name column
+		lookupDatabase.EXPECT().
+			SearchReferencedRows(gomock.Any(), domain.ForeignKeyLookupParams{
+				Database:       "testdb",
+				Schema:         "public",
+				Table:          "users",
+				KeyColumn:      "id",
+				DisplayColumns: []string{"name"},
+				Term:           "ali",
+				Limit:          domain.ForeignKeyLookupLimit,
+			}).
+			Return(&domain.QueryResult{
+				Columns: []string{"id", "name"},
+				Rows:    []map[string]interface{}{{"id": int64(7), "name": []byte("Alice")}},
+			}, nil)
+
+		lookup, err := lookupUC.LookupForeignKeyValues(ctx, "testuser", "testdb", "public", "orders", "user_id", " ali ")
+		require.NoError(t, err)
+		require.Equal(t, "users", lookup.Reference.ReferencedTable)
+		require.Equal(t, "public", lookup.Reference.ReferencedSchema)
+		require.Equal(t, []domain.ForeignKeyMatch{{Value: int64(7), Label: "Alice"}}, lookup.Matches)
+
+		lookupDatabase.EXPECT().
+			SearchReferencedRows(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.ForeignKeyLookupParams) (*domain.QueryResult, error) {
+				require.Equal(t, []string{"sku", "title"}, params.DisplayColumns)
+				return &domain.QueryResult{
+					Columns: []string{"id", "sku", "title"},
+					Rows:    []map[string]interface{}{{"id": int64(3), "sku": "KB-01", "title": nil}},
+				}, nil
+			})
+
+		lookup, err = lookupUC.LookupForeignKeyValues(ctx, "testuser", "testdb", "public", "orders", "product_id", "kb")
+		require.NoError(t, err)
+		require.Equal(t, []domain.ForeignKeyMatch{{Value: int64(3), Label: "KB-01"}}, lookup.Matches)
+
+		// Columns without a foreign key have nothing to look up
+		_, err = lookupUC.LookupForeignKeyValues(ctx, "testuser", "testdb", "public", "orders", "id", "")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "column", validationErr.Field)
+	})
+
+	t.Run("LookupForeignKeyValues requires SELECT on the referenced table", func(t *testing.T) {
+		deniedCtrl := gomock.NewController(t)
+		defer deniedCtrl.Finish()
+
+		deniedMetadata := mockrepository.NewMockMetadataRepository(deniedCtrl)
+		deniedRBAC := mockrepository.NewMockRBACRepository(deniedCtrl)
+		deniedUC := constructor(deniedMetadata, mockrepository.NewMockDatabaseRepository(deniedCtrl), deniedRBAC, mockrepository.NewMockConfigRepository(deniedCtrl), mockrepository.NewMockSlowOperationRepository(deniedCtrl), quietAudit(deniedCtrl), mockrepository.NewMockTableSnapshotRepository(deniedCtrl))
+
+		deniedMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name:        "orders",
+						ForeignKeys: []domain.ForeignKeyMetadata{{ColumnName: "user_id", ReferencedTable: "users", ReferencedColumn: "id"}},
+					}},
+				}},
+			}, nil)
+		deniedRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "clerk", "testdb", "public", "users").
+			Return(false, nil)
+
+		_, err := deniedUC.LookupForeignKeyValues(ctx, "clerk", "testdb", "public", "orders", "user_id", "a")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("GetCellContent returns the keyed cell with its size, hash and detected type", func(t *testing.T) {
+		cellCtrl := gomock.NewController(t)
+		defer cellCtrl.Finish()
+
+		cellMetadata := mockrepository.NewMockMetadataRepository(cellCtrl)
+		cellDatabase := mockrepository.NewMockDatabaseRepository(cellCtrl)
+		cellRBAC := mockrepository.NewMockRBACRepository(cellCtrl)
+		cellConfig := mockrepository.NewMockConfigRepository(cellCtrl)
+		cellUC := constructor(cellMetadata, cellDatabase, cellRBAC, cellConfig, mockrepository.NewMockSlowOperationRepository(cellCtrl), quietAudit(cellCtrl), mockrepository.NewMockTableSnapshotRepository(cellCtrl))
+
+		cellRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "attachments").
+			Return(true, nil).
+			Times(3)
+		cellMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name:        "attachments",
+						Columns:     []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "data", DataType: "bytea"}, {Name: "notes", DataType: "text"}},
+						PrimaryKeys: []string{"id"},
+					}},
+				}},
+			}, nil).
+			Times(3)
+		cellConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil).
+			Times(2)
+
+		png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				require.Equal(t, `"id" = $1`, params.WhereClause)
+				require.Equal(t, []interface{}{"1"}, params.WhereArgs)
+				return &domain.QueryResult{Rows: []map[string]interface{}{{"id": int64(1), "data": png, "notes": "hello"}}}, nil
+			})
+
+		content, err := cellUC.GetCellContent(ctx, "testuser", "testdb", "public", "attachments", "data", map[string]interface{}{"id": "1"})
+		require.NoError(t, err)
+		require.Equal(t, "image/png", content.ContentType)
+		require.Equal(t, int64(len(png)), content.Size)
+		require.Equal(t, png, content.Content)
+		digest := sha256.Sum256(png)
+		require.Equal(t, hex.EncodeToString(digest[:]), content.SHA256)
+
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{Rows: []map[string]interface{}{{"id": int64(1), "data": nil, "notes": "hello"}}}, nil)
+		content, err = cellUC.GetCellContent(ctx, "testuser", "testdb", "public", "attachments", "notes", map[string]interface{}{"id": "1"})
+		require.NoError(t, err)
+		require.Equal(t, "text/plain; charset=utf-8", content.ContentType)
+		require.Equal(t, []byte("hello"), content.Content)
+
+		_, err = cellUC.GetCellContent(ctx, "testuser", "testdb", "public", "attachments", "missing", map[string]interface{}{"id": "1"})
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "column", validationErr.Field)
+	})
+
+	t.Run("TakeTableSnapshot and CompareTableSnapshot report rows changed by primary key", func(t *testing.T) {
+		snapCtrl := gomock.NewController(t)
+		defer snapCtrl.Finish()
+
+		snapMetadata := mockrepository.NewMockMetadataRepository(snapCtrl)
+		snapDatabase := mockrepository.NewMockDatabaseRepository(snapCtrl)
+		snapRBAC := mockrepository.NewMockRBACRepository(snapCtrl)
+		snapConfig := mockrepository.NewMockConfigRepository(snapCtrl)
+		snapRepo := mockrepository.NewMockTableSnapshotRepository(snapCtrl)
+		snapUC := constructor(snapMetadata, snapDatabase, snapRBAC, snapConfig, mockrepository.NewMockSlowOperationRepository(snapCtrl), quietAudit(snapCtrl), snapRepo)
+
+		snapRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(true, nil).
+			Times(2)
+		snapMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name:        "orders",
+						Columns:     []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "status", DataType: "text"}},
+						PrimaryKeys: []string{"id"},
+					}},
+				}},
+			}, nil).
+			Times(2)
+		snapConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).Times(2)
+
+		gomock.InOrder(
+			snapDatabase.EXPECT().
+				GetTableData(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+					require.Equal(t, domain.TableSnapshotMaxRows+1, params.Limit)
+					require.Equal(t, "id", params.OrderBy)
+					return &domain.QueryResult{
+						Columns: []string{"id", "status"},
+						Rows: []map[string]interface{}{
+							{"id": int64(1), "status": []byte("new")},
+							{"id": int64(2), "status": []byte("new")},
+							{"id": int64(3), "status": []byte("paid")},
+						},
+					}, nil
+				}),
+			snapDatabase.EXPECT().
+				GetTableData(gomock.Any(), gomock.Any()).
+				Return(&domain.QueryResult{
+					Columns: []string{"id", "status"},
+					Rows: []map[string]interface{}{
+						{"id": int64(1), "status": []byte("new")},
+						{"id": int64(2), "status": []byte("shipped")},
+						{"id": int64(4), "status": []byte("new")},
+					},
+				}, nil),
+		)
+
+		var stored domain.TableSnapshot
+		snapRepo.EXPECT().
+			StoreSnapshot(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, snapshot *domain.TableSnapshot) error {
+				snapshot.ID = "snapshot_1"
+				stored = *snapshot
+				return nil
+			})
+		snapRepo.EXPECT().
+			GetSnapshot(gomock.Any(), "testuser", "snapshot_1").
+			DoAndReturn(func(context.Context, string, string) (*domain.TableSnapshot, error) {
+				return &stored, nil
+			})
+
+		snapshot, err := snapUC.TakeTableSnapshot(ctx, "testuser", "testdb", "public", "orders")
+		require.NoError(t, err)
+		require.Equal(t, "snapshot_1", snapshot.ID)
+		require.Equal(t, []string{"id"}, snapshot.PrimaryKeys)
+		require.Len(t, snapshot.Rows, 3)
+
+		diff, err := snapUC.CompareTableSnapshot(ctx, "testuser", "snapshot_1")
+		require.NoError(t, err)
+		require.Equal(t, 1, diff.Unchanged)
+		require.Len(t, diff.Inserted, 1)
+		require.Equal(t, int64(4), diff.Inserted[0]["id"])
+		require.Len(t, diff.Deleted, 1)
+		require.Equal(t, int64(3), diff.Deleted[0]["id"])
+		require.Len(t, diff.Updated, 1)
+		require.Equal(t, map[string]interface{}{"id": int64(2)}, diff.Updated[0].Key)
+		require.Equal(t, []string{"status"}, diff.Updated[0].Changed)
+		require.Equal(t, "new", diff.Updated[0].Before["status"])
+		require.Equal(t, "shipped", diff.Updated[0].After["status"])
+	})
+
+	t.Run("TakeTableSnapshot refuses tables without a primary key or with too many rows", func(t *testing.T) {
+		snapCtrl := gomock.NewController(t)
+		defer snapCtrl.Finish()
+
+		snapMetadata := mockrepository.NewMockMetadataRepository(snapCtrl)
+		snapDatabase := mockrepository.NewMockDatabaseRepository(snapCtrl)
+		snapRBAC := mockrepository.NewMockRBACRepository(snapCtrl)
+		snapConfig := mockrepository.NewMockConfigRepository(snapCtrl)
+		snapUC := constructor(snapMetadata, snapDatabase, snapRBAC, snapConfig, mockrepository.NewMockSlowOperationRepository(snapCtrl), quietAudit(snapCtrl), mockrepository.NewMockTableSnapshotRepository(snapCtrl))
+
+		snapRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", gomock.Any()).Return(true, nil).Times(2)
+		snapMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{
+						{Name: "events", Columns: []domain.ColumnMetadata{{Name: "payload", DataType: "text"}}},
+						{Name: "orders", Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer"}}, PrimaryKeys: []string{"id"}},
+					},
+				}},
+			}, nil).
+			Times(2)
+
+		_, err := snapUC.TakeTableSnapshot(ctx, "testuser", "testdb", "public", "events")
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+
+		snapConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		rows := make([]map[string]interface{}, domain.TableSnapshotMaxRows+1)
+		for i := range rows {
+			rows[i] = map[string]interface{}{"id": int64(i)}
+		}
+		snapDatabase.EXPECT().GetTableData(gomock.Any(), gomock.Any()).Return(&domain.QueryResult{Columns: []string{"id"}, Rows: rows}, nil)
+
+		_, err = snapUC.TakeTableSnapshot(ctx, "testuser", "testdb", "public", "orders")
+		require.Error(t, err)
+		validationErr, ok = err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Contains(t, validationErr.Message, "too many to snapshot")
+	})
+
+	t.Run("GetJSONCell pretty-prints the JSON cell of the keyed row", func(t *testing.T) {
+		cellCtrl := gomock.NewController(t)
+		defer cellCtrl.Finish()
+
+		cellMetadata := mockrepository.NewMockMetadataRepository(cellCtrl)
+		cellDatabase := mockrepository.NewMockDatabaseRepository(cellCtrl)
+		cellRBAC := mockrepository.NewMockRBACRepository(cellCtrl)
+		cellConfig := mockrepository.NewMockConfigRepository(cellCtrl)
+		cellUC := constructor(cellMetadata, cellDatabase, cellRBAC, cellConfig, mockrepository.NewMockSlowOperationRepository(cellCtrl), quietAudit(cellCtrl), mockrepository.NewMockTableSnapshotRepository(cellCtrl))
+
+		cellRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "profiles").
+			Return(true, nil).
+			Times(4)
+		cellMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name:        "profiles",
+						Columns:     []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "settings", DataType: "jsonb"}, {Name: "bio", DataType: "text"}},
+						PrimaryKeys: []string{"id"},
+					}},
+				}},
+			}, nil).
+			Times(4)
+		cellConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil).
+			Times(2)
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				require.Equal(t, `"id" = $1`, params.WhereClause)
+				require.Equal(t, []interface{}{"7"}, params.WhereArgs)
+				require.Equal(t, 1, params.Limit)
+				return &domain.QueryResult{Rows: []map[string]interface{}{{"id": int64(7), "settings": []byte(`{"theme":{"color":"dark"},"tags":[1,2]}`)}}}, nil
+			})
+
+		detail, err := cellUC.GetJSONCell(ctx, "testuser", "testdb", "public", "profiles", "settings", map[string]interface{}{"id": "7"})
+		require.NoError(t, err)
+		require.Equal(t, "jsonb", detail.DataType)
+		require.Equal(t, "{\n  \"theme\": {\n    \"color\": \"dark\"\n  },\n  \"tags\": [\n    1,\n    2\n  ]\n}", detail.Value)
+
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{Rows: []map[string]interface{}{{"id": int64(8), "settings": nil}}}, nil)
+		detail, err = cellUC.GetJSONCell(ctx, "testuser", "testdb", "public", "profiles", "settings", map[string]interface{}{"id": "8"})
+		require.NoError(t, err)
+		require.True(t, detail.IsNull)
+
+		// Non-JSON columns and incomplete keys are refused before any read
+		var validationErr domain.ValidationError
+		_, err = cellUC.GetJSONCell(ctx, "testuser", "testdb", "public", "profiles", "bio", map[string]interface{}{"id": "7"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "column", validationErr.Field)
+		_, err = cellUC.GetJSONCell(ctx, "testuser", "testdb", "public", "profiles", "settings", map[string]interface{}{"name": "x"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "pk", validationErr.Field)
+	})
+
+	t.Run("GetSpatialCell returns the keyed geometry as GeoJSON and WKT with its raw value", func(t *testing.T) {
+		cellCtrl := gomock.NewController(t)
+		defer cellCtrl.Finish()
+
+		cellMetadata := mockrepository.NewMockMetadataRepository(cellCtrl)
+		cellDatabase := mockrepository.NewMockDatabaseRepository(cellCtrl)
+		cellRBAC := mockrepository.NewMockRBACRepository(cellCtrl)
+		cellConfig := mockrepository.NewMockConfigRepository(cellCtrl)
+		cellUC := constructor(cellMetadata, cellDatabase, cellRBAC, cellConfig, mockrepository.NewMockSlowOperationRepository(cellCtrl), quietAudit(cellCtrl), mockrepository.NewMockTableSnapshotRepository(cellCtrl))
+
+		cellRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "places").
+			Return(true, nil).
+			Times(4)
+		cellMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name:        "places",
+						Columns:     []domain.ColumnMetadata{{Name: "id", DataType: "integer"}, {Name: "location", DataType: "USER-DEFINED"}, {Name: "name", DataType: "text"}},
+						PrimaryKeys: []string{"id"},
+					}},
+				}},
+			}, nil).
+			Times(4)
+		cellConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil).
+			Times(3)
+		spatialColumns := []domain.SpatialColumn{{Name: "location", Type: "geometry"}}
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				require.Equal(t, `"id" = $1`, params.WhereClause)
+				require.Equal(t, []interface{}{"3"}, params.WhereArgs)
+				require.Equal(t, 1, params.Limit)
+				return &domain.QueryResult{
+					Columns:        []string{"id", "location", "name"},
+					Rows:           []map[string]interface{}{{"id": int64(3), "location": []byte("0101000000000000000000F03F0000000000000040"), "name": "Depot"}},
+					SpatialColumns: spatialColumns,
+					Spatial:        []map[string]domain.SpatialValue{{"location": {GeoJSON: `{"type":"Point","coordinates":[1,2]}`, WKT: "POINT(1 2)"}}},
+				}, nil
+			})
+
+		detail, err := cellUC.GetSpatialCell(ctx, "testuser", "testdb", "public", "places", "location", map[string]interface{}{"id": "3"})
+		require.NoError(t, err)
+		require.Equal(t, "geometry", detail.DataType)
+		require.Equal(t, `{"type":"Point","coordinates":[1,2]}`, detail.GeoJSON)
+		require.Equal(t, "POINT(1 2)", detail.WKT)
+		require.Equal(t, "0101000000000000000000F03F0000000000000040", detail.Raw)
+
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{
+				Rows:           []map[string]interface{}{{"id": int64(4), "location": nil, "name": "Unknown"}},
+				SpatialColumns: spatialColumns,
+				Spatial:        []map[string]domain.SpatialValue{{}},
+			}, nil)
+		detail, err = cellUC.GetSpatialCell(ctx, "testuser", "testdb", "public", "places", "location", map[string]interface{}{"id": "4"})
+		require.NoError(t, err)
+		require.True(t, detail.IsNull)
+
+		// Columns PostGIS does not report as spatial are refused
+		var validationErr domain.ValidationError
+		cellDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{Rows: []map[string]interface{}{{"id": int64(3), "name": "Depot"}}, SpatialColumns: spatialColumns}, nil)
+		_, err = cellUC.GetSpatialCell(ctx, "testuser", "testdb", "public", "places", "name", map[string]interface{}{"id": "3"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "column", validationErr.Field)
+		_, err = cellUC.GetSpatialCell(ctx, "testuser", "testdb", "public", "places", "location", map[string]interface{}{"name": "x"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "pk", validationErr.Field)
+	})
+
+	t.Run("GetRowDetail returns the keyed row with each column's metadata", func(t *testing.T) {
+		rowCtrl := gomock.NewController(t)
+		defer rowCtrl.Finish()
+
+		rowMetadata := mockrepository.NewMockMetadataRepository(rowCtrl)
+		rowDatabase := mockrepository.NewMockDatabaseRepository(rowCtrl)
+		rowRBAC := mockrepository.NewMockRBACRepository(rowCtrl)
+		rowConfig := mockrepository.NewMockConfigRepository(rowCtrl)
+		rowUC := constructor(rowMetadata, rowDatabase, rowRBAC, rowConfig, mockrepository.NewMockSlowOperationRepository(rowCtrl), quietAudit(rowCtrl), mockrepository.NewMockTableSnapshotRepository(rowCtrl))
+
+		rowRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "orders").
+			Return(true, nil).
+			Times(2)
+		rowMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{{
+						Name: "orders",
+						Columns: []domain.ColumnMetadata{
+							{Name: "id", DataType: "integer"},
+							{Name: "customer_id", DataType: "integer"},
+							{Name: "status", DataType: "character varying", IsNullable: true},
+						},
+						PrimaryKeys: []string{"id"},
+						ForeignKeys: []domain.ForeignKeyMetadata{{ColumnName: "customer_id", ReferencedSchema: "public", ReferencedTable: "customers", ReferencedColumn: "id"}},
+					}},
+				}},
+			}, nil).
+			Times(2)
+		rowConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+		rowDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				require.Equal(t, `"id" = $1`, params.WhereClause)
+				require.Equal(t, []interface{}{"5"}, params.WhereArgs)
+				require.Equal(t, 1, params.Limit)
+				return &domain.QueryResult{Rows: []map[string]interface{}{{"id": int64(5), "customer_id": int64(9), "status": []byte("shipped")}}}, nil
+			})
+		rowDatabase.EXPECT().
+			GetTableDefinition(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableDefinition{Columns: []domain.TableColumnDefinition{
+				{Name: "id", Type: "integer", NotNull: true},
+				{Name: "customer_id", Type: "integer", NotNull: true},
+				{Name: "status", Type: "character varying(20)", Default: "'new'::character varying", Comment: "Fulfilment state"},
+			}}, nil)
+
+		detail, err := rowUC.GetRowDetail(ctx, "testuser", "testdb", "public", "orders", map[string]interface{}{"id": "5"})
+		require.NoError(t, err)
+		require.Len(t, detail.Columns, 3)
+		require.True(t, detail.Columns[0].IsPrimary)
+		require.Equal(t, int64(5), detail.Columns[0].Value)
+		require.NotNil(t, detail.Columns[1].ForeignKey)
+		require.Equal(t, "customers", detail.Columns[1].ForeignKey.ReferencedTable)
+		require.Equal(t, domain.RowDetailColumn{
+			Name:       "status",
+			DataType:   "character varying(20)",
+			IsNullable: true,
+			Default:    "'new'::character varying",
+			Comment:    "Fulfilment state",
+			Value:      "shipped",
+		}, detail.Columns[2])
+
+		// An incomplete key is refused before any read
+		var validationErr domain.ValidationError
+		_, err = rowUC.GetRowDetail(ctx, "testuser", "testdb", "public", "orders", map[string]interface{}{"status": "x"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "pk", validationErr.Field)
+	})
+
+	t.Run("CheckReferentialIntegrity scans declared foreign keys", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil).Times(2)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "posts",
+								Columns: []domain.ColumnMetadata{
+									{Name: "id", DataType: "integer", IsPrimary: true},
+									{Name: "user_id", DataType: "integer", IsNullable: true},
+								},
+								PrimaryKeys: []string{"id"},
+								ForeignKeys: []domain.ForeignKeyMetadata{
+									{ColumnName: "user_id", ReferencedTable: "users", ReferencedColumn: "id"},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+		mockDatabase.EXPECT().
+			FindOrphanedRows(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.OrphanCheckParams) (*domain.OrphanedReference, error) {
+				require.Equal(t, "public", params.Reference.ReferencedSchema)
+				require.Equal(t, domain.OrphanedRowsPreviewSize, params.Limit)
+				return &domain.OrphanedReference{
+					Reference:   params.Reference,
+					OrphanCount: 1,
+					Rows: &domain.QueryResult{
+						Columns:  []string{"id", "user_id"},
+						Rows:     []map[string]interface{}{{"id": 9, "user_id": 404}},
+						RowCount: 1,
+					},
+				}, nil
+			})
+
+		report, err := uc.CheckReferentialIntegrity(ctx, "testuser", "testdb", "public", "posts", nil, 0)
+
+		require.NoError(t, err)
+		require.Len(t, report.References, 1)
+		require.Equal(t, int64(1), report.References[0].OrphanCount)
+	})
+
+	// Ad hoc join view builder
+	t.Run("LoadJoinedTableData uses FK-suggested condition", func(t *testing.T) {
+		joinMetadata := &domain.DatabaseMetadata{
+			Name: "testdb",
+			Schemas: []domain.SchemaMetadata{
+				{
+					Name: "public",
+					Tables: []domain.TableMetadata{
+						{
+							Name: "users",
+							Columns: []domain.ColumnMetadata{
+								{Name: "id", DataType: "integer", IsPrimary: true},
+								{Name: "name", DataType: "text"},
+							},
+							PrimaryKeys: []string{"id"},
+						},
+						{
+							Name: "posts",
+							Columns: []domain.ColumnMetadata{
+								{Name: "id", DataType: "integer", IsPrimary: true},
+								{Name: "user_id", DataType: "integer"},
+							},
+							PrimaryKeys: []string{"id"},
+							ForeignKeys: []domain.ForeignKeyMetadata{
+								{ColumnName: "user_id", ReferencedTable: "users", ReferencedColumn: "id", ReferencedSchema: "public"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil).Times(2)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(joinMetadata, nil).Times(4)
+
+		mockDatabase.EXPECT().
+			GetJoinedTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.JoinViewParams) (*domain.QueryResult, error) {
+				require.Equal(t, []domain.JoinCondition{{LeftColumn: "id", RightColumn: "user_id"}}, params.Conditions)
+				require.Equal(t, "INNER", params.JoinType)
+				require.Equal(t, []string{"id", "name"}, params.LeftColumns)
+				require.Equal(t, domain.QueryResultPageSize, params.Limit)
+				return &domain.QueryResult{
+					Columns:    []string{"users.id", "users.name", "posts.id", "posts.user_id"},
+					Rows:       make([]map[string]interface{}, 3),
+					RowCount:   3,
+					TotalCount: 3,
+				}, nil
+			})
+
+		result, err := uc.LoadJoinedTableData(ctx, "testuser", domain.JoinViewParams{
+			Database:    "testdb",
+			LeftSchema:  "public",
+			LeftTable:   "users",
+			RightSchema: "public",
+			RightTable:  "posts",
+			OrderBy:     "users.name",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), result.TotalCount)
+	})
+
+	t.Run("LoadJoinedTableData rejects sort column outside the join", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil).Times(2)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{Name: "users", Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer"}}},
+							{Name: "posts", Columns: []domain.ColumnMetadata{{Name: "user_id", DataType: "integer"}}},
+						},
+					},
+				},
+			}, nil).Times(2)
+
+		result, err := uc.LoadJoinedTableData(ctx, "testuser", domain.JoinViewParams{
+			Database:    "testdb",
+			LeftSchema:  "public",
+			LeftTable:   "users",
+			RightSchema: "public",
+			RightTable:  "posts",
+			Conditions:  []domain.JoinCondition{{LeftColumn: "id", RightColumn: "user_id"}},
+			OrderBy:     "users.password",
+		})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	// Temporal "as of" browsing
+	t.Run("LoadTableDataAsOf detects system versioned history companion", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil).Times(2)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{
+								Name: "prices",
+								Columns: []domain.ColumnMetadata{
+									{Name: "id", DataType: "integer", IsPrimary: true},
+									{Name: "amount", DataType: "numeric"},
+									{Name: "sys_period", DataType: "tstzrange"},
+								},
+								PrimaryKeys: []string{"id"},
+							},
+							{
+								Name: "prices_history",
+								Columns: []domain.ColumnMetadata{
+									{Name: "id", DataType: "integer"},
+									{Name: "amount", DataType: "numeric"},
+									{Name: "sys_period", DataType: "tstzrange"},
+								},
+							},
+						},
+					},
+				},
+			}, nil).Times(2)
+
+		asOf := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockDatabase.EXPECT().
+			GetTableDataAsOf(gomock.Any(), gomock.Any(), asOf, 0, domain.QueryResultPageSize).
+			DoAndReturn(func(ctx context.Context, history domain.HistoryTableConfig, asOf time.Time, offset, limit int) (*domain.QueryResult, error) {
+				require.Equal(t, "prices_history", history.HistoryTable)
+				require.Equal(t, "sys_period", history.PeriodColumn)
+				require.Equal(t, []string{"id", "amount", "sys_period"}, history.Columns)
+				return &domain.QueryResult{
+					Columns:  history.Columns,
+					Rows:     []map[string]interface{}{{"id": 1, "amount": 10}},
+					RowCount: 1,
+				}, nil
+			})
+
+		result, err := uc.LoadTableDataAsOf(ctx, "testuser", "testdb", "public", "prices", asOf, 0, 0)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.RowCount)
+	})
+
+	t.Run("LoadTableDataAsOf caps masked reads and leaves a read receipt", func(t *testing.T) {
+		encCtrl := gomock.NewController(t)
+		defer encCtrl.Finish()
+
+		encMetadata := mockrepository.NewMockMetadataRepository(encCtrl)
+		encDatabase := mockrepository.NewMockDatabaseRepository(encCtrl)
+		encRBAC := mockrepository.NewMockRBACRepository(encCtrl)
+		encConfig := mockrepository.NewMockConfigRepository(encCtrl)
+		encAudit := mockrepository.NewMockAuditRepository(encCtrl)
+		encUC := constructor(encMetadata, encDatabase, encRBAC, encConfig, mockrepository.NewMockSlowOperationRepository(encCtrl), encAudit, mockrepository.NewMockTableSnapshotRepository(encCtrl))
+
+		encRBAC.EXPECT().HasSelectPermission(gomock.Any(), "viewer", "testdb", "public", "cards").Return(true, nil)
+		encRBAC.EXPECT().HasSelectPermission(gomock.Any(), "viewer", "testdb", "public", "cards_history").Return(true, nil)
+		columns := []domain.ColumnMetadata{
+			{Name: "id", DataType: "integer"},
+			{Name: "number", DataType: "bytea"},
+			{Name: "sys_period", DataType: "tstzrange"},
+		}
+		encMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{{
+					Name: "public",
+					Tables: []domain.TableMetadata{
+						{Name: "cards", Columns: columns, PrimaryKeys: []string{"id"}},
+						{Name: "cards_history", Columns: columns},
+					},
+				}},
+			}, nil).
+			AnyTimes()
+		// No key is configured, so the column stays masked for everyone
+		encConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EncryptedColumns: []string{"public.cards.number"}, MaskedRowLimit: 20}, nil)
+
+		asOf := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		encDatabase.EXPECT().
+			GetTableDataAsOf(gomock.Any(), gomock.Any(), asOf, 0, 20).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "number", "sys_period"},
+				Rows:     []map[string]interface{}{{"id": 1, "number": []byte{0xc3}}},
+				RowCount: 1,
+			}, nil)
+		encAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionMaskedRead, entry.Action)
+				require.Equal(t, "cards", entry.Table)
+				require.Equal(t, []string{"number"}, entry.After["masked_columns"])
+				return nil
+			})
+
+		result, err := encUC.LoadTableDataAsOf(ctx, "viewer", "testdb", "public", "cards", asOf, 0, 500)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.EncryptedValueMask, result.Rows[0]["number"])
+	})
+
+	t.Run("GetRowTimeline rejects tables without history companion", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil).Times(2)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), gomock.Any()).
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []domain.TableMetadata{
+							{Name: "users", Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer"}}, PrimaryKeys: []string{"id"}},
+						},
+					},
+				},
+			}, nil).Times(2)
+
+		result, err := uc.GetRowTimeline(ctx, "testuser", "testdb", "public", "users", map[string]interface{}{"id": "1"})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("LoadTableData decrypts encrypted columns for editors", func(t *testing.T) {
+		encCtrl := gomock.NewController(t)
+		defer encCtrl.Finish()
+
+		encDatabase := mockrepository.NewMockDatabaseRepository(encCtrl)
+		encRBAC := mockrepository.NewMockRBACRepository(encCtrl)
+		encConfig := mockrepository.NewMockConfigRepository(encCtrl)
+		encUC := constructor(mockrepository.NewMockMetadataRepository(encCtrl), encDatabase, encRBAC, encConfig, mockrepository.NewMockSlowOperationRepository(encCtrl), quietAudit(encCtrl), mockrepository.NewMockTableSnapshotRepository(encCtrl))
+
+		encConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{
+				EncryptedColumns:    []string{"public.customers.ssn", "public.orders.note"},
+				ColumnEncryptionKey: "deploy-key",
+			}, nil)
+
+		encRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "editor", "testdb", "public", "customers").
+			Return(true, nil)
+
+		encRBAC.EXPECT().
+			HasUpdatePermission(gomock.Any(), "editor", "testdb", "public", "customers").
+			Return(true, nil)
+
+		encDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:         "testdb",
+				Schema:           "public",
+				Table:            "customers",
+				Limit:            50,
+				EncryptedColumns: []string{"ssn"},
+				EncryptionKey:    "deploy-key",
+			}).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "ssn"},
+				Rows:     []map[string]interface{}{{"id": 1, "ssn": "123-45-6789"}},
+				RowCount: 1,
+			}, nil)
+
+		result, err := encUC.LoadTableData(ctx, "editor", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "customers",
+			Limit:    50,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "123-45-6789", result.Rows[0]["ssn"])
+	})
+
+	t.Run("LoadTableData masks encrypted columns for read-only users", func(t *testing.T) {
+		encCtrl := gomock.NewController(t)
+		defer encCtrl.Finish()
+
+		encDatabase := mockrepository.NewMockDatabaseRepository(encCtrl)
+		encRBAC := mockrepository.NewMockRBACRepository(encCtrl)
+		encConfig := mockrepository.NewMockConfigRepository(encCtrl)
+		encUC := constructor(mockrepository.NewMockMetadataRepository(encCtrl), encDatabase, encRBAC, encConfig, mockrepository.NewMockSlowOperationRepository(encCtrl), quietAudit(encCtrl), mockrepository.NewMockTableSnapshotRepository(encCtrl))
+
+		encConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{
+				EncryptedColumns:    []string{"public.customers.ssn"},
+				ColumnEncryptionKey: "deploy-key",
+			}, nil)
+
+		encRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "viewer", "testdb", "public", "customers").
+			Return(true, nil)
+
+		encRBAC.EXPECT().
+			HasUpdatePermission(gomock.Any(), "viewer", "testdb", "public", "customers").
+			Return(false, nil)
+
+		encDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:         "testdb",
+				Schema:           "public",
+				Table:            "customers",
+				Limit:            50,
+				EncryptedColumns: []string{"ssn"},
+			}).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "ssn"},
+				Rows:     []map[string]interface{}{{"id": 1, "ssn": []byte{0xc3, 0x0d}}, {"id": 2, "ssn": nil}},
+				RowCount: 2,
+			}, nil)
+
+		result, err := encUC.LoadTableData(ctx, "viewer", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "customers",
+			Limit:    50,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, domain.EncryptedValueMask, result.Rows[0]["ssn"])
+		require.Nil(t, result.Rows[1]["ssn"])
+	})
+
+	t.Run("LoadTableData caps masked reads and leaves a read receipt", func(t *testing.T) {
+		encCtrl := gomock.NewController(t)
+		defer encCtrl.Finish()
+
+		encDatabase := mockrepository.NewMockDatabaseRepository(encCtrl)
+		encRBAC := mockrepository.NewMockRBACRepository(encCtrl)
+		encConfig := mockrepository.NewMockConfigRepository(encCtrl)
+		encAudit := mockrepository.NewMockAuditRepository(encCtrl)
+		encUC := constructor(mockrepository.NewMockMetadataRepository(encCtrl), encDatabase, encRBAC, encConfig, mockrepository.NewMockSlowOperationRepository(encCtrl), encAudit, mockrepository.NewMockTableSnapshotRepository(encCtrl))
+
+		encConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{
+				EncryptedColumns:    []string{"public.customers.ssn"},
+				ColumnEncryptionKey: "deploy-key",
+				MaskedRowLimit:      20,
+			}, nil)
+		encRBAC.EXPECT().HasSelectPermission(gomock.Any(), "viewer", "testdb", "public", "customers").Return(true, nil)
+		encRBAC.EXPECT().HasUpdatePermission(gomock.Any(), "viewer", "testdb", "public", "customers").Return(false, nil)
+		encDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:         "testdb",
+				Schema:           "public",
+				Table:            "customers",
+				Offset:           40,
+				Limit:            20,
+				EncryptedColumns: []string{"ssn"},
+			}).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "ssn"},
+				Rows:     []map[string]interface{}{{"id": 41, "ssn": []byte{0xc3}}, {"id": 42, "ssn": []byte{0xc4}}},
+				RowCount: 2,
+			}, nil)
+		encAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "viewer", entry.Username)
+				require.Equal(t, domain.AuditActionMaskedRead, entry.Action)
+				require.Equal(t, "customers", entry.Table)
+				require.Equal(t, 2, entry.After["rows"])
+				require.Equal(t, 40, entry.After["offset"])
+				require.Equal(t, []string{"ssn"}, entry.After["masked_columns"])
+				return nil
+			})
+
+		result, err := encUC.LoadTableData(ctx, "viewer", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "customers",
+			Offset:   40,
+			Limit:    500,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Rows, 2)
+	})
+
+	t.Run("ExportTableData refuses masked exports over the masked row limit", func(t *testing.T) {
+		encCtrl := gomock.NewController(t)
+		defer encCtrl.Finish()
+
+		encDatabase := mockrepository.NewMockDatabaseRepository(encCtrl)
+		encRBAC := mockrepository.NewMockRBACRepository(encCtrl)
+		encConfig := mockrepository.NewMockConfigRepository(encCtrl)
+		encUC := constructor(mockrepository.NewMockMetadataRepository(encCtrl), encDatabase, encRBAC, encConfig, mockrepository.NewMockSlowOperationRepository(encCtrl), quietAudit(encCtrl), mockrepository.NewMockTableSnapshotRepository(encCtrl))
+
+		// No key is configured, so the column stays masked for everyone
+		encConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EncryptedColumns: []string{"public.customers.ssn"}}, nil).
+			Times(2)
+		encRBAC.EXPECT().HasSelectPermission(gomock.Any(), "viewer", "testdb", "public", "customers").Return(true, nil)
+		encDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:         "testdb",
+				Schema:           "public",
+				Table:            "customers",
+				Limit:            domain.DefaultMaskedRowLimit,
+				EncryptedColumns: []string{"ssn"},
+			}).
+			Return(&domain.QueryResult{Columns: []string{"id", "ssn"}, TotalCount: domain.DefaultMaskedRowLimit + 1}, nil)
+
+		err := encUC.ExportTableData(ctx, "viewer", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "customers",
+		}, domain.ExportFormatJSON, &bytes.Buffer{})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "limit", validationErr.Field)
+	})
+
+	t.Run("ExportTableData writes JSON with the main view filter and sort", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "users",
+				WhereClause: "active = true",
+				OrderBy:     "name",
+				OrderDir:    "DESC",
+				Limit:       domain.ExportDefaultRowLimit,
+			}).
+			Return(&domain.QueryResult{
+				Columns:    []string{"name", "id"},
+				Rows:       []map[string]interface{}{{"id": int64(2), "name": "Bob"}, {"id": int64(1), "name": []byte("Alice")}},
+				RowCount:   2,
+				TotalCount: 2,
+			}, nil)
+
+		var out bytes.Buffer
+		err := uc.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "users",
+			WhereClause: "active = true",
+			OrderBy:     "name",
+			OrderDir:    "desc",
+		}, domain.ExportFormatJSON, &out)
+
+		require.NoError(t, err)
+		require.Equal(t, `[{"name":"Bob","id":2},{"name":"Alice","id":1}]`, out.String())
+	})
+
+	t.Run("ExportTableData writes one object per line for NDJSON", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id"},
+				Rows:       []map[string]interface{}{{"id": int64(1)}, {"id": nil}},
+				RowCount:   2,
+				TotalCount: 2,
+			}, nil)
+
+		var out bytes.Buffer
+		err := uc.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+		}, domain.ExportFormatNDJSON, &out)
+
+		require.NoError(t, err)
+		require.Equal(t, "{\"id\":1}\n{\"id\":null}\n", out.String())
+	})
+
+	t.Run("ExportTableData writes an xlsx workbook", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id", "name"},
+				Rows:       []map[string]interface{}{{"id": int64(1), "name": "Alice & Co"}},
+				RowCount:   1,
+				TotalCount: 1,
+			}, nil)
+
+		var out bytes.Buffer
+		err := uc.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+		}, domain.ExportFormatXLSX, &out)
+		require.NoError(t, err)
+
+		archive, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+		require.NoError(t, err)
+
+		var sheet string
+		for _, f := range archive.File {
+			if f.Name == "xl/worksheets/sheet1.xml" {
+				rc, err := f.Open()
+				require.NoError(t, err)
+				content, err := io.ReadAll(rc)
+				rc.Close()
+				require.NoError(t, err)
+				sheet = string(content)
+			}
+		}
+		require.Contains(t, sheet, `<c r="A2"><v>1</v></c>`)
+		require.Contains(t, sheet, `Alice &amp; Co`)
+	})
+
+	t.Run("ExportTableData is kept for account activity without its filter", func(t *testing.T) {
+		activityCtrl := gomock.NewController(t)
+		activityDatabase := mockrepository.NewMockDatabaseRepository(activityCtrl)
+		activityRBAC := mockrepository.NewMockRBACRepository(activityCtrl)
+		activityConfig := mockrepository.NewMockConfigRepository(activityCtrl)
+		activityAudit := mockrepository.NewMockAuditRepository(activityCtrl)
+		activityUC := constructor(mockrepository.NewMockMetadataRepository(activityCtrl), activityDatabase, activityRBAC, activityConfig,
+			mockrepository.NewMockSlowOperationRepository(activityCtrl), activityAudit, mockrepository.NewMockTableSnapshotRepository(activityCtrl))
+
+		activityRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").Return(true, nil)
+		activityConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).AnyTimes()
+		activityDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{Columns: []string{"id"}, Rows: []map[string]interface{}{{"id": int64(1)}}, RowCount: 1, TotalCount: 1}, nil)
+		activityAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "testuser", entry.Username)
+				require.Equal(t, domain.AuditActionExport, entry.Action)
+				require.Equal(t, "users", entry.Table)
+				require.Equal(t, domain.ExportFormatNDJSON, entry.After["format"])
+				require.Equal(t, 1, entry.After["rows"])
+				require.Equal(t, true, entry.After["filtered"])
+				require.NotContains(t, fmt.Sprint(entry.After), "alice@example.com")
+				return nil
+			})
+
+		err := activityUC.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "users",
+			WhereClause: "email = 'alice@example.com'",
+		}, domain.ExportFormatNDJSON, &bytes.Buffer{})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("ExportTableData refuses tables the export policy denies", func(t *testing.T) {
+		policyCtrl := gomock.NewController(t)
+		defer policyCtrl.Finish()
+
+		policyRBAC := mockrepository.NewMockRBACRepository(policyCtrl)
+		policyConfig := mockrepository.NewMockConfigRepository(policyCtrl)
+		policyUC := constructor(mockrepository.NewMockMetadataRepository(policyCtrl), mockrepository.NewMockDatabaseRepository(policyCtrl), policyRBAC, policyConfig, mockrepository.NewMockSlowOperationRepository(policyCtrl), quietAudit(policyCtrl), mockrepository.NewMockTableSnapshotRepository(policyCtrl))
+
+		policyRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "salaries").
+			Return(true, nil)
+		policyConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ExportRules: []domain.ExportRule{{Role: "testuser", Table: "public.salaries", Allow: false}}}, nil)
+
+		var out bytes.Buffer
+		err := policyUC.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "salaries",
+		}, domain.ExportFormatJSON, &out)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+		require.Empty(t, out.String())
+	})
+
+	t.Run("ExportTableData rejects unsupported formats", func(t *testing.T) {
+		err := uc.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+		}, "pdf", io.Discard)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "export format")
+	})
+
+	t.Run("BuildTableReport lays out the filtered and sorted table for printing", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "users",
+				WhereClause: "active = true",
+				OrderBy:     "name",
+				OrderDir:    "ASC",
+				Limit:       domain.ReportMaxRows,
+			}).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id", "name"},
+				Rows:       []map[string]interface{}{{"id": int64(1), "name": []byte("Alice")}, {"id": int64(2), "name": nil}, {"id": int64(3), "name": "NULL"}},
+				RowCount:   3,
+				TotalCount: 3,
+			}, nil)
+
+		report, err := uc.BuildTableReport(ctx, "testuser", domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "users",
+			WhereClause: "active = true",
+			OrderBy:     "name",
+		}, " ")
+
+		require.NoError(t, err)
+		require.Equal(t, "public.users", report.Title)
+		require.Equal(t, "testdb.public.users", report.Source)
+		require.Equal(t, "active = true", report.Filter)
+		require.Equal(t, "name ASC", report.Sort)
+		require.Equal(t, "testuser", report.GeneratedBy)
+		require.False(t, report.GeneratedAt.IsZero())
+		require.Equal(t, []string{"id", "name"}, report.Columns)
+		text := func(s string) *string { return &s }
+		require.Equal(t, [][]*string{{text("1"), text("Alice")}, {text("2"), nil}, {text("3"), text("NULL")}}, report.Rows)
+	})
+
+	t.Run("BuildTableReport refuses tables over the report row limit", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "events").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "events",
+				Limit:    domain.ReportMaxRows,
+			}).
+			Return(&domain.QueryResult{Columns: []string{"id"}, TotalCount: domain.ReportMaxRows + 1}, nil)
+
+		_, err := uc.BuildTableReport(ctx, "testuser", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "events",
+		}, "Events")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "limit", validationErr.Field)
+	})
+
+	t.Run("ExportTableData refuses tables over the configured row limit", func(t *testing.T) {
+		capCtrl := gomock.NewController(t)
+		defer capCtrl.Finish()
+
+		capDatabase := mockrepository.NewMockDatabaseRepository(capCtrl)
+		capRBAC := mockrepository.NewMockRBACRepository(capCtrl)
+		capConfig := mockrepository.NewMockConfigRepository(capCtrl)
+		capUC := constructor(mockrepository.NewMockMetadataRepository(capCtrl), capDatabase, capRBAC, capConfig, mockrepository.NewMockSlowOperationRepository(capCtrl), quietAudit(capCtrl), mockrepository.NewMockTableSnapshotRepository(capCtrl))
+
+		capConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ExportRowLimit: 2}, nil).
+			Times(2)
+
+		capRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		capDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "users",
+				Limit:    2,
+			}).
+			Return(&domain.QueryResult{
+				Columns:    []string{"id"},
+				Rows:       []map[string]interface{}{{"id": 1}, {"id": 2}},
+				RowCount:   2,
+				TotalCount: 5,
+			}, nil)
+
+		var out bytes.Buffer
+		err := capUC.ExportTableData(ctx, "testuser", domain.TableDataParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+		}, domain.ExportFormatJSON, &out)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "exceeds the limit of 2 rows")
+		require.Zero(t, out.Len())
+	})
+
+	t.Run("FreezeView opens a snapshot under the session key", func(t *testing.T) {
+		takenAt := time.Now()
+		mockDatabase.EXPECT().
+			SnapshotTakenAt("session_123").
+			Return(time.Time{}, false)
+		mockDatabase.EXPECT().
+			OpenSnapshot(gomock.Any(), "session_123").
+			Return(takenAt, nil)
+
+		status, err := uc.FreezeView(ctx, "session_123")
+
+		require.NoError(t, err)
+		require.True(t, status.Active)
+		require.Equal(t, takenAt, status.TakenAt)
+	})
+
+	t.Run("FreezeView keeps a snapshot already open", func(t *testing.T) {
+		takenAt := time.Now().Add(-time.Minute)
+		mockDatabase.EXPECT().
+			SnapshotTakenAt("session_123").
+			Return(takenAt, true)
+
+		status, err := uc.FreezeView(ctx, "session_123")
+
+		require.NoError(t, err)
+		require.True(t, status.Active)
+		require.Equal(t, takenAt, status.TakenAt)
+	})
+
+	t.Run("FreezeView replaces a snapshot past its maximum age", func(t *testing.T) {
+		takenAt := time.Now()
+		mockDatabase.EXPECT().
+			SnapshotTakenAt("session_123").
+			Return(time.Now().Add(-(domain.SnapshotMaxAgeSeconds+60)*time.Second), true)
+		mockDatabase.EXPECT().
+			ReleaseSnapshot("session_123").
+			Return(nil)
+		mockDatabase.EXPECT().
+			OpenSnapshot(gomock.Any(), "session_123").
+			Return(takenAt, nil)
+
+		status, err := uc.FreezeView(ctx, "session_123")
+
+		require.NoError(t, err)
+		require.True(t, status.Active)
+		require.Equal(t, takenAt, status.TakenAt)
+	})
+
+	t.Run("FreezeView requires a snapshot key", func(t *testing.T) {
+		_, err := uc.FreezeView(ctx, "")
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "session")
+	})
+
+	t.Run("GetSnapshotStatus releases an expired snapshot", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			SnapshotTakenAt("session_123").
+			Return(time.Now().Add(-time.Hour), true)
+		mockDatabase.EXPECT().
+			ReleaseSnapshot("session_123").
+			Return(nil)
+
+		status, err := uc.GetSnapshotStatus(ctx, "session_123")
+
+		require.NoError(t, err)
+		require.False(t, status.Active)
+		require.True(t, status.Expired)
+	})
+
+	t.Run("LoadTableData reads through an open snapshot", func(t *testing.T) {
+		params := domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "users",
+			Limit:       50,
+			SnapshotKey: "session_123",
+		}
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+		mockDatabase.EXPECT().
+			SnapshotTakenAt("session_123").
+			Return(time.Now(), true)
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), params).
+			Return(&domain.QueryResult{Columns: []string{"id"}, TotalCount: 3}, nil)
+
+		result, err := uc.LoadTableData(ctx, "testuser", params)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), result.TotalCount)
+	})
+
+	t.Run("ReleaseSnapshot ends the snapshot", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			ReleaseSnapshot("session_123").
+			Return(nil)
+
+		require.NoError(t, uc.ReleaseSnapshot(ctx, "session_123"))
+	})
+
+	t.Run("WatchTableData follows the live table rather than a frozen snapshot", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "jobs").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				require.Empty(t, params.SnapshotKey)
+				return &domain.QueryResult{Columns: []string{"id", "state"}, RowCount: 2}, nil
+			})
+
+		watchCtx, disconnect := context.WithCancel(ctx)
+		var updates []*domain.LiveResultUpdate
+		err := uc.WatchTableData(watchCtx, "testuser", domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "jobs",
+			Limit:       50,
+			SnapshotKey: "session_1",
+		}, 30*time.Second, func(update *domain.LiveResultUpdate) error {
+			updates = append(updates, update)
+			disconnect()
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		require.Equal(t, 30*time.Second, updates[0].Interval)
+		require.Equal(t, int64(2), updates[0].Result.RowCount)
+	})
+
+	t.Run("WatchTableData refuses a table the user cannot read before streaming", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "secrets").
+			Return(false, nil)
+
+		err := uc.WatchTableData(ctx, "testuser", domain.TableDataParams{Database: "testdb", Schema: "public", Table: "secrets"}, time.Minute,
+			func(update *domain.LiveResultUpdate) error {
+				t.Fatal("no update expected")
+				return nil
+			})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	// newWatchUseCase builds a usecase on its own mocks, since a watch loads the same table repeatedly
+	newWatchUseCase := func(t *testing.T) (usecase.DataViewUseCase, *mockrepository.MockDatabaseRepository, *mockrepository.MockRBACRepository) {
+		watchCtrl := gomock.NewController(t)
+		watchMetadata := mockrepository.NewMockMetadataRepository(watchCtrl)
+		watchDatabase := mockrepository.NewMockDatabaseRepository(watchCtrl)
+		watchRBAC := mockrepository.NewMockRBACRepository(watchCtrl)
+		watchConfig := mockrepository.NewMockConfigRepository(watchCtrl)
+
+		watchConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).AnyTimes()
+		watchRBAC.EXPECT().CanAccessTable(gomock.Any(), "testuser", "testdb", "public", "incidents").Return(true, nil).AnyTimes()
+		watchMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{{
+					Name:   "public",
+					Tables: []domain.TableMetadata{{Name: "incidents", PrimaryKeys: []string{"id"}}},
+				}},
+			}, nil).
+			AnyTimes()
+
+		return constructor(watchMetadata, watchDatabase, watchRBAC, watchConfig, mockrepository.NewMockSlowOperationRepository(watchCtrl), quietAudit(watchCtrl), mockrepository.NewMockTableSnapshotRepository(watchCtrl)), watchDatabase, watchRBAC
+	}
+
+	t.Run("WatchRows reports changes to matching rows when the trigger notifies", func(t *testing.T) {
+		watchUC, watchDatabase, watchRBAC := newWatchUseCase(t)
+
+		watchRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "incidents").
+			Return(true, nil).
+			Times(2)
+
+		gomock.InOrder(
+			watchDatabase.EXPECT().
+				GetTableData(gomock.Any(), domain.TableDataParams{
+					Database:    "testdb",
+					Schema:      "public",
+					Table:       "incidents",
+					WhereClause: "severity = 'high'",
+					OrderBy:     "id",
+					OrderDir:    "ASC",
+					Limit:       domain.RowWatchMaxRows + 1,
+				}).
+				Return(&domain.QueryResult{
+					Columns: []string{"id", "state"},
+					Rows:    []map[string]interface{}{{"id": 1, "state": "open"}, {"id": 2, "state": "open"}},
+				}, nil),
+			watchDatabase.EXPECT().
+				GetTableData(gomock.Any(), gomock.Any()).
+				Return(&domain.QueryResult{
+					Columns: []string{"id", "state"},
+					Rows:    []map[string]interface{}{{"id": 1, "state": "resolved"}, {"id": 3, "state": "open"}},
+				}, nil),
+		)
+
+		watchDatabase.EXPECT().RemoveStaleChangeTriggers(gomock.Any()).Return(1, nil)
+		var channel string
+		watchDatabase.EXPECT().
+			InstallChangeTrigger(gomock.Any(), gomock.Any(), "public", "incidents").
+			DoAndReturn(func(ctx context.Context, name, schema, table string) error {
+				channel = name
+				return nil
+			})
+		watchDatabase.EXPECT().
+			ListenNotifications(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, onNotify func(string) error) error {
+				require.Equal(t, channel, name)
+				if err := onNotify("UPDATE"); err != nil {
+					return err
+				}
+				<-ctx.Done()
+				return nil
+			})
+		removed := make(chan string, 1)
+		watchDatabase.EXPECT().
+			RemoveChangeTrigger(gomock.Any(), gomock.Any(), "public", "incidents").
+			DoAndReturn(func(ctx context.Context, name, schema, table string) error {
+				removed <- name
+				return nil
+			})
+
+		watchCtx, disconnect := context.WithCancel(ctx)
+		var events []*domain.RowWatchEvent
+		err := watchUC.WatchRows(watchCtx, "testuser", domain.RowWatchParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "incidents",
+			WhereClause: "severity = 'high'",
+		}, func(event *domain.RowWatchEvent) error {
+			events = append(events, event)
+			if len(events) == 2 {
+				disconnect()
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(channel, domain.RowWatchChannelPrefix))
+		require.Equal(t, channel, <-removed)
+		require.Len(t, events, 2)
+		require.Equal(t, domain.RowWatchModeNotify, events[0].Mode)
+		require.Equal(t, 2, events[0].MatchingRows)
+		require.Empty(t, events[0].Changes)
+		require.Equal(t, []domain.RowChange{
+			{
+				Operation: domain.RowChangeUpdate,
+				Key:       map[string]interface{}{"id": 1},
+				Before:    map[string]interface{}{"id": 1, "state": "open"},
+				After:     map[string]interface{}{"id": 1, "state": "resolved"},
+			},
+			{
+				Operation: domain.RowChangeDelete,
+				Key:       map[string]interface{}{"id": 2},
+				Before:    map[string]interface{}{"id": 2, "state": "open"},
+			},
+			{
+				Operation: domain.RowChangeInsert,
+				Key:       map[string]interface{}{"id": 3},
+				After:     map[string]interface{}{"id": 3, "state": "open"},
+			},
+		}, events[1].Changes)
+	})
+
+	t.Run("WatchRows leaves one read receipt per watch of a masked table", func(t *testing.T) {
+		maskedCtrl := gomock.NewController(t)
+		maskedMetadata := mockrepository.NewMockMetadataRepository(maskedCtrl)
+		maskedDatabase := mockrepository.NewMockDatabaseRepository(maskedCtrl)
+		maskedRBAC := mockrepository.NewMockRBACRepository(maskedCtrl)
+		maskedConfig := mockrepository.NewMockConfigRepository(maskedCtrl)
+		maskedAudit := mockrepository.NewMockAuditRepository(maskedCtrl)
+		maskedUC := constructor(maskedMetadata, maskedDatabase, maskedRBAC, maskedConfig, mockrepository.NewMockSlowOperationRepository(maskedCtrl), maskedAudit, mockrepository.NewMockTableSnapshotRepository(maskedCtrl))
+
+		// No key is configured, so the column stays masked on every check
+		maskedConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EncryptedColumns: []string{"public.incidents.reporter"}}, nil).
+			AnyTimes()
+		maskedRBAC.EXPECT().CanAccessTable(gomock.Any(), "testuser", "testdb", "public", "incidents").Return(true, nil).AnyTimes()
+		maskedRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "incidents").Return(true, nil).Times(2)
+		maskedMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(&domain.DatabaseMetadata{
+				Name: "testdb",
+				Schemas: []domain.SchemaMetadata{{
+					Name:   "public",
+					Tables: []domain.TableMetadata{{Name: "incidents", PrimaryKeys: []string{"id"}}},
+				}},
+			}, nil).
+			AnyTimes()
+		gomock.InOrder(
+			maskedDatabase.EXPECT().
+				GetTableData(gomock.Any(), gomock.Any()).
+				Return(&domain.QueryResult{Columns: []string{"id", "state", "reporter"}, Rows: []map[string]interface{}{{"id": 1, "state": "open", "reporter": []byte{0xc3}}}}, nil),
+			maskedDatabase.EXPECT().
+				GetTableData(gomock.Any(), gomock.Any()).
+				Return(&domain.QueryResult{Columns: []string{"id", "state", "reporter"}, Rows: []map[string]interface{}{{"id": 1, "state": "resolved", "reporter": []byte{0xc3}}}}, nil),
+		)
+		maskedDatabase.EXPECT().RemoveStaleChangeTriggers(gomock.Any()).Return(0, nil)
+		maskedDatabase.EXPECT().InstallChangeTrigger(gomock.Any(), gomock.Any(), "public", "incidents").Return(nil)
+		maskedDatabase.EXPECT().
+			ListenNotifications(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, onNotify func(string) error) error {
+				if err := onNotify("UPDATE"); err != nil {
+					return err
+				}
+				<-ctx.Done()
+				return nil
+			})
+		maskedDatabase.EXPECT().RemoveChangeTrigger(gomock.Any(), gomock.Any(), "public", "incidents").Return(nil)
+		maskedAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionMaskedRead, entry.Action)
+				require.Equal(t, "incidents", entry.Table)
+				return nil
+			}).
+			Times(1)
+
+		watchCtx, disconnect := context.WithCancel(ctx)
+		checks := 0
+		err := maskedUC.WatchRows(watchCtx, "testuser", domain.RowWatchParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "incidents",
+		}, func(event *domain.RowWatchEvent) error {
+			checks++
+			if checks == 2 {
+				disconnect()
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 2, checks)
+	})
+
+	t.Run("WatchRows falls back to polling when the trigger cannot be installed", func(t *testing.T) {
+		watchUC, watchDatabase, watchRBAC := newWatchUseCase(t)
+
+		watchRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "incidents").
+			Return(true, nil)
+		watchDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{Columns: []string{"id"}, Rows: []map[string]interface{}{{"id": 1}}}, nil)
+		watchDatabase.EXPECT().
+			RemoveStaleChangeTriggers(gomock.Any()).
+			Return(0, errors.New("permission denied for function lumen_watch_left"))
+		watchDatabase.EXPECT().
+			InstallChangeTrigger(gomock.Any(), gomock.Any(), "public", "incidents").
+			Return(errors.New("must be owner of table incidents"))
+
+		watchCtx, disconnect := context.WithCancel(ctx)
+		var events []*domain.RowWatchEvent
+		err := watchUC.WatchRows(watchCtx, "testuser", domain.RowWatchParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "incidents",
+			Interval: time.Second,
+		}, func(event *domain.RowWatchEvent) error {
+			events = append(events, event)
+			disconnect()
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, domain.RowWatchModePoll, events[0].Mode)
+		require.Contains(t, events[0].Notice, "checking every 5s")
+		require.Contains(t, events[0].Notice, "must be owner")
+	})
+
+	t.Run("WatchRows refuses a filter matching too many rows before installing anything", func(t *testing.T) {
+		watchUC, watchDatabase, watchRBAC := newWatchUseCase(t)
+
+		rows := make([]map[string]interface{}, domain.RowWatchMaxRows+1)
+		for i := range rows {
+			rows[i] = map[string]interface{}{"id": i}
+		}
+		watchRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "incidents").
+			Return(true, nil)
+		watchDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{Columns: []string{"id"}, Rows: rows}, nil)
+
+		err := watchUC.WatchRows(ctx, "testuser", domain.RowWatchParams{Database: "testdb", Schema: "public", Table: "incidents"},
+			func(event *domain.RowWatchEvent) error {
+				t.Fatal("no event expected")
+				return nil
+			})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "whereClause", validationErr.Field)
+	})
+
+	t.Run("WatchRows rejects an unknown mode", func(t *testing.T) {
+		err := uc.WatchRows(ctx, "testuser", domain.RowWatchParams{Database: "testdb", Schema: "public", Table: "incidents", Mode: "webhook"},
+			func(event *domain.RowWatchEvent) error { return nil })
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "mode", validationErr.Field)
+	})
+
+	t.Run("LoadTableData logs a slow filter with an index suggestion", func(t *testing.T) {
+		slowCtrl := gomock.NewController(t)
+		slowDatabase := mockrepository.NewMockDatabaseRepository(slowCtrl)
+		slowRBAC := mockrepository.NewMockRBACRepository(slowCtrl)
+		slowConfig := mockrepository.NewMockConfigRepository(slowCtrl)
+		slowOperations := mockrepository.NewMockSlowOperationRepository(slowCtrl)
+		slowUC := constructor(mockrepository.NewMockMetadataRepository(slowCtrl), slowDatabase, slowRBAC, slowConfig, slowOperations, quietAudit(slowCtrl), mockrepository.NewMockTableSnapshotRepository(slowCtrl))
+
+		slowConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{SlowFilterThreshold: time.Nanosecond}, nil)
+		slowRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "orders").Return(true, nil)
+		slowDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				time.Sleep(time.Millisecond)
+				return &domain.QueryResult{Columns: []string{"id", "region", "amount"}}, nil
+			})
+
+		filter := "(((region)::text = 'eu'::text) AND (amount > '100'::numeric))"
+		slowDatabase.EXPECT().
+			ExplainQuery(gomock.Any(), `SELECT * FROM "public"."orders" WHERE region = 'eu' AND amount > 100`, false).
+			Return(&domain.QueryPlan{Root: domain.QueryPlanNode{
+				NodeType: "Gather",
+				PlanRows: 120,
+				Children: []domain.QueryPlanNode{{
+					NodeType:     "Seq Scan",
+					RelationName: "orders",
+					PlanRows:     120,
+					Details:      map[string]interface{}{"Filter": filter},
+				}},
+			}}, nil)
+		slowDatabase.EXPECT().
+			ExplainQuery(gomock.Any(), `SELECT * FROM "public"."orders"`, false).
+			Return(&domain.QueryPlan{Root: domain.QueryPlanNode{NodeType: "Seq Scan", RelationName: "orders", PlanRows: 200000}}, nil)
+
+		var recorded *domain.SlowOperation
+		slowOperations.EXPECT().
+			RecordSlowOperation(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, operation *domain.SlowOperation) error {
+				recorded = operation
+				return nil
+			})
+
+		_, err := slowUC.LoadTableData(ctx, "testuser", domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			WhereClause: "region = 'eu' AND amount > 100",
+			Limit:       50,
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, recorded)
+		require.Equal(t, "testuser", recorded.Username)
+		require.Equal(t, domain.SlowOperationFilter, recorded.Operation)
+		require.Equal(t, "region = 'eu' AND amount > 100", recorded.WhereClause)
+		require.GreaterOrEqual(t, recorded.Duration, time.Millisecond)
+		require.Len(t, recorded.Suggestions, 1)
+		require.Equal(t, []string{"region", "amount"}, recorded.Suggestions[0].Columns)
+		require.Equal(t, `CREATE INDEX ON "public"."orders" ("region", "amount")`, recorded.Suggestions[0].Statement)
+		require.Contains(t, recorded.Suggestions[0].Reason, "120 of 200000 rows")
+	})
+
+	t.Run("LoadTableData logs a slow filter without suggestions when the scan is not selective", func(t *testing.T) {
+		slowCtrl := gomock.NewController(t)
+		slowDatabase := mockrepository.NewMockDatabaseRepository(slowCtrl)
+		slowRBAC := mockrepository.NewMockRBACRepository(slowCtrl)
+		slowConfig := mockrepository.NewMockConfigRepository(slowCtrl)
+		slowOperations := mockrepository.NewMockSlowOperationRepository(slowCtrl)
+		slowUC := constructor(mockrepository.NewMockMetadataRepository(slowCtrl), slowDatabase, slowRBAC, slowConfig, slowOperations, quietAudit(slowCtrl), mockrepository.NewMockTableSnapshotRepository(slowCtrl))
+
+		slowConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{SlowFilterThreshold: time.Nanosecond}, nil)
+		slowRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "testdb", "public", "orders").Return(true, nil)
+		slowDatabase.EXPECT().
+			GetTableData(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.TableDataParams) (*domain.QueryResult, error) {
+				time.Sleep(time.Millisecond)
+				return &domain.QueryResult{Columns: []string{"id", "state"}}, nil
+			})
+		slowDatabase.EXPECT().
+			ExplainQuery(gomock.Any(), `SELECT * FROM "public"."orders" WHERE state <> 'void'`, false).
+			Return(&domain.QueryPlan{Root: domain.QueryPlanNode{
+				NodeType:     "Seq Scan",
+				RelationName: "orders",
+				PlanRows:     190000,
+				Details:      map[string]interface{}{"Filter": "(state <> 'void'::text)"},
+			}}, nil)
+		slowDatabase.EXPECT().
+			ExplainQuery(gomock.Any(), `SELECT * FROM "public"."orders"`, false).
+			Return(&domain.QueryPlan{Root: domain.QueryPlanNode{NodeType: "Seq Scan", RelationName: "orders", PlanRows: 200000}}, nil)
+		slowOperations.EXPECT().
+			RecordSlowOperation(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, operation *domain.SlowOperation) error {
+				require.Empty(t, operation.Suggestions)
+				return nil
+			})
+
+		_, err := slowUC.LoadTableData(ctx, "testuser", domain.TableDataParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			WhereClause: "state <> 'void'",
+		})
+		require.NoError(t, err)
+	})
 }
@@ -442,4 +442,96 @@ func ERDUsecaseRunner(t *testing.T, constructor ERDUsecaseConstructor) {
 		require.NoError(t, err)
 		require.NotNil(t, erdData)
 	})
+
+	// customers <- orders <- order_lines -> products, invoices -> orders one-to-one, audit_log unrelated
+	graphMetadata := &domain.DatabaseMetadata{
+		Name: "shop",
+		Schemas: []domain.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []domain.TableMetadata{
+					{Name: "customers", PrimaryKeys: []string{"id"}, Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer", IsPrimary: true}}},
+					{Name: "orders", PrimaryKeys: []string{"id"},
+						Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer", IsPrimary: true}, {Name: "customer_id", DataType: "integer", IsNullable: true}},
+						ForeignKeys: []domain.ForeignKeyMetadata{
+							{ColumnName: "customer_id", ReferencedTable: "customers", ReferencedColumn: "id", ReferencedSchema: "public"},
+						}},
+					{Name: "order_lines", PrimaryKeys: []string{"id"},
+						Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer", IsPrimary: true}, {Name: "order_id", DataType: "integer"}, {Name: "product_id", DataType: "integer"}},
+						ForeignKeys: []domain.ForeignKeyMetadata{
+							{ColumnName: "order_id", ReferencedTable: "orders", ReferencedColumn: "id", ReferencedSchema: "public"},
+							{ColumnName: "product_id", ReferencedTable: "products", ReferencedColumn: "id", ReferencedSchema: "public"},
+						}},
+					{Name: "products", PrimaryKeys: []string{"id"}, Columns: []domain.ColumnMetadata{{Name: "id", DataType: "integer", IsPrimary: true}},
+						ForeignKeys: []domain.ForeignKeyMetadata{
+							{ColumnName: "id", ReferencedTable: "catalog_items", ReferencedColumn: "id", ReferencedSchema: "catalog"},
+						}},
+					{Name: "invoices", PrimaryKeys: []string{"order_id"}, Columns: []domain.ColumnMetadata{{Name: "order_id", DataType: "integer", IsPrimary: true}},
+						ForeignKeys: []domain.ForeignKeyMetadata{
+							{ColumnName: "order_id", ReferencedTable: "orders", ReferencedColumn: "id", ReferencedSchema: "public"},
+						}},
+					{Name: "audit_log", PrimaryKeys: []string{"id"}, Columns: []domain.ColumnMetadata{{Name: "id", DataType: "bigint", IsPrimary: true}}},
+				},
+			},
+		},
+	}
+
+	tableNames := func(graph *domain.SchemaGraph) []string {
+		var names []string
+		for _, table := range graph.Tables {
+			names = append(names, table.Name)
+		}
+		return names
+	}
+
+	t.Run("GetSchemaGraph returns every accessible table with cardinality hints", func(t *testing.T) {
+		mockMetadata.EXPECT().GetMetadata(gomock.Any(), "shop").Return(graphMetadata, nil)
+		mockRBAC.EXPECT().
+			CanAccessTable(gomock.Any(), "grapher", "shop", "public", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _, table string) (bool, error) {
+				return table != "customers", nil
+			}).
+			Times(6)
+
+		graph, err := uc.GetSchemaGraph(ctx, "grapher", "shop", "public", "", 0)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"orders", "order_lines", "products", "invoices", "audit_log"}, tableNames(graph))
+		// The edge to the inaccessible customers table and the one into another schema are left out
+		require.Len(t, graph.Relationships, 3)
+		require.Equal(t, domain.ERDRelationship{FromTable: "order_lines", FromColumn: "order_id", ToTable: "orders", ToColumn: "id",
+			RelationType: domain.RelationTypeManyToOne}, graph.Relationships[0])
+		require.Equal(t, domain.RelationTypeOneToOne, graph.Relationships[2].RelationType)
+		require.Equal(t, "invoices", graph.Relationships[2].FromTable)
+	})
+
+	t.Run("GetSchemaGraph limits the graph to the depth around the root", func(t *testing.T) {
+		mockMetadata.EXPECT().GetMetadata(gomock.Any(), "shop").Return(graphMetadata, nil).Times(2)
+		mockRBAC.EXPECT().CanAccessTable(gomock.Any(), "grapher", "shop", "public", gomock.Any()).Return(true, nil).Times(12)
+
+		graph, err := uc.GetSchemaGraph(ctx, "grapher", "shop", "public", "customers", 1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"customers", "orders"}, tableNames(graph))
+		require.Len(t, graph.Relationships, 1)
+		require.True(t, graph.Relationships[0].Optional)
+
+		// Depth 0 follows references as far as they go, but never reaches unrelated tables
+		graph, err = uc.GetSchemaGraph(ctx, "grapher", "shop", "public", "customers", 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{"customers", "orders", "order_lines", "products", "invoices"}, tableNames(graph))
+		require.Len(t, graph.Relationships, 4)
+	})
+
+	t.Run("GetSchemaGraph rejects an unknown root and a negative depth", func(t *testing.T) {
+		mockMetadata.EXPECT().GetMetadata(gomock.Any(), "shop").Return(graphMetadata, nil)
+		mockRBAC.EXPECT().CanAccessTable(gomock.Any(), "grapher", "shop", "public", gomock.Any()).Return(true, nil).Times(6)
+
+		_, err := uc.GetSchemaGraph(ctx, "grapher", "shop", "public", "ghosts", 1)
+		require.ErrorIs(t, err, domain.ErrTableNotFound)
+
+		_, err = uc.GetSchemaGraph(ctx, "grapher", "shop", "public", "customers", -1)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "depth", validationErr.Field)
+	})
 }
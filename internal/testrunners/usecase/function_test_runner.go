@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// FunctionUsecaseConstructor is a function type that creates a FunctionUseCase
+type FunctionUsecaseConstructor func(
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+) usecase.FunctionUseCase
+
+// FunctionUsecaseRunner runs all function usecase tests against an implementation
+func FunctionUsecaseRunner(t *testing.T, constructor FunctionUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+
+	uc := constructor(mockDatabase, mockRBAC, mockAudit)
+
+	ctx := context.Background()
+
+	functions := []domain.StoredFunction{
+		{Schema: "public", Name: "order_total", Kind: domain.FunctionKindFunction, Arguments: "order_id integer, with_tax boolean",
+			Result: "numeric", Language: "sql", Parameters: []domain.FunctionParameter{
+				{Name: "order_id", DataType: "integer", Mode: domain.FunctionParameterModeIn},
+				{Name: "with_tax", DataType: "boolean", Mode: domain.FunctionParameterModeIn, HasDefault: true},
+			}},
+		{Schema: "public", Name: "archive_orders", Kind: domain.FunctionKindProcedure, Arguments: "IN before date, OUT archived integer",
+			Language: "plpgsql", Parameters: []domain.FunctionParameter{
+				{Name: "before", DataType: "date", Mode: domain.FunctionParameterModeIn},
+				{Name: "archived", DataType: "integer", Mode: domain.FunctionParameterModeOut},
+			}},
+		{Schema: "public", Name: "tag_orders", Kind: domain.FunctionKindFunction, Arguments: "VARIADIC tags text[]",
+			Result: "SETOF integer", Language: "sql", Parameters: []domain.FunctionParameter{
+				{Name: "tags", DataType: "text[]", Mode: domain.FunctionParameterModeVariadic},
+			}},
+	}
+
+	t.Run("ListFunctions reports which routines the user may execute", func(t *testing.T) {
+		mockDatabase.EXPECT().ListFunctions(gomock.Any(), "shop", "public").Return(functions, nil)
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "order_total", "order_id integer, with_tax boolean").Return(true, nil)
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "archive_orders", "IN before date, OUT archived integer").Return(false, nil)
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "tag_orders", "VARIADIC tags text[]").Return(true, nil)
+
+		list, err := uc.ListFunctions(ctx, "alice", "shop", "public")
+
+		require.NoError(t, err)
+		require.Len(t, list, 3)
+		require.True(t, list[0].CanExecute)
+		require.False(t, list[1].CanExecute)
+	})
+
+	t.Run("GetFunctionSource returns the routine's definition", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			GetFunctionDefinition(gomock.Any(), "shop", "public", "order_total", "order_id integer, with_tax boolean").
+			Return("CREATE OR REPLACE FUNCTION public.order_total(...)", nil)
+
+		source, err := uc.GetFunctionSource(ctx, "alice", "shop", "public", "order_total", "order_id integer, with_tax boolean")
+
+		require.NoError(t, err)
+		require.Contains(t, source, "CREATE OR REPLACE FUNCTION")
+	})
+
+	t.Run("ExecuteFunction binds each argument as a typed parameter", func(t *testing.T) {
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "order_total", "order_id integer, with_tax boolean").Return(true, nil)
+		mockDatabase.EXPECT().ListFunctions(gomock.Any(), "shop", "public").Return(functions, nil)
+		mockDatabase.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, `SELECT * FROM "public"."order_total"($1::integer, $2::boolean)`, params.Query)
+				require.Equal(t, []interface{}{"42'; DROP TABLE orders; --", nil}, params.Args)
+				require.Equal(t, "alice", params.TrackingKey)
+				require.Equal(t, []string{"sales"}, params.SearchPath)
+				require.Equal(t, 50, params.Limit)
+				return &domain.QueryResult{Columns: []string{"order_total"}, Rows: []map[string]interface{}{{"order_total": "10.00"}}, RowCount: 1}, nil
+			})
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionQuery, entry.Action)
+				require.Equal(t, true, entry.After["succeeded"])
+				require.NotContains(t, entry.After["statement"], "DROP TABLE")
+				return nil
+			})
+
+		call := domain.FunctionCall{Database: "shop", Schema: "public", Name: "order_total", Arguments: "order_id integer, with_tax boolean",
+			Values: []domain.FunctionArgument{{Value: "42'; DROP TABLE orders; --"}, {Null: true}}}
+		result, err := uc.ExecuteFunction(ctx, "alice", call, domain.QueryParams{SearchPath: []string{"sales"}, Limit: 50})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.Result.RowCount)
+	})
+
+	t.Run("ExecuteFunction leaves trailing arguments to their defaults", func(t *testing.T) {
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "order_total", "order_id integer, with_tax boolean").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ListFunctions(gomock.Any(), "shop", "public").Return(functions, nil).Times(2)
+		mockDatabase.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, `SELECT * FROM "public"."order_total"($1::integer)`, params.Query)
+				return &domain.QueryResult{}, nil
+			})
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		call := domain.FunctionCall{Database: "shop", Schema: "public", Name: "order_total", Arguments: "order_id integer, with_tax boolean",
+			Values: []domain.FunctionArgument{{Value: "42"}, {Default: true}}}
+		_, err := uc.ExecuteFunction(ctx, "alice", call, domain.QueryParams{})
+		require.NoError(t, err)
+
+		call.Values = []domain.FunctionArgument{{Default: true}, {Value: "true"}}
+		_, err = uc.ExecuteFunction(ctx, "alice", call, domain.QueryParams{})
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "arguments", validationErr.Field)
+	})
+
+	t.Run("ExecuteFunction calls procedures with NULL for output parameters", func(t *testing.T) {
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "archive_orders", "IN before date, OUT archived integer").Return(true, nil)
+		mockDatabase.EXPECT().ListFunctions(gomock.Any(), "shop", "public").Return(functions, nil)
+		mockDatabase.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, `CALL "public"."archive_orders"($1::date, NULL::integer)`, params.Query)
+				require.Equal(t, []interface{}{"2024-01-01"}, params.Args)
+				return nil, errors.New("permission denied for table orders")
+			})
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, false, entry.After["succeeded"])
+				return nil
+			})
+
+		call := domain.FunctionCall{Database: "shop", Schema: "public", Name: "archive_orders", Arguments: "IN before date, OUT archived integer",
+			Values: []domain.FunctionArgument{{Value: "2024-01-01"}}}
+		_, err := uc.ExecuteFunction(ctx, "alice", call, domain.QueryParams{})
+
+		require.Error(t, err)
+	})
+
+	t.Run("ExecuteFunction passes variadic arguments as an array", func(t *testing.T) {
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "tag_orders", "VARIADIC tags text[]").Return(true, nil)
+		mockDatabase.EXPECT().ListFunctions(gomock.Any(), "shop", "public").Return(functions, nil)
+		mockDatabase.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, `SELECT * FROM "public"."tag_orders"(VARIADIC $1::text[])`, params.Query)
+				return &domain.QueryResult{}, nil
+			})
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		call := domain.FunctionCall{Database: "shop", Schema: "public", Name: "tag_orders", Arguments: "VARIADIC tags text[]",
+			Values: []domain.FunctionArgument{{Value: "{rush,gift}"}}}
+		_, err := uc.ExecuteFunction(ctx, "alice", call, domain.QueryParams{})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("ExecuteFunction requires EXECUTE and the right number of arguments", func(t *testing.T) {
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "bob", "shop", "public", "order_total", "order_id integer, with_tax boolean").Return(false, nil)
+
+		call := domain.FunctionCall{Database: "shop", Schema: "public", Name: "order_total", Arguments: "order_id integer, with_tax boolean",
+			Values: []domain.FunctionArgument{{Value: "42"}}}
+		_, err := uc.ExecuteFunction(ctx, "bob", call, domain.QueryParams{})
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+
+		mockRBAC.EXPECT().HasFunctionExecutePermission(gomock.Any(), "alice", "shop", "public", "order_total", "order_id integer, with_tax boolean").Return(true, nil)
+		mockDatabase.EXPECT().ListFunctions(gomock.Any(), "shop", "public").Return(functions, nil)
+
+		_, err = uc.ExecuteFunction(ctx, "alice", call, domain.QueryParams{})
+		validationErr, ok = err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Contains(t, validationErr.Message, "takes 2 input arguments")
+	})
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// ImportUsecaseConstructor is a function type that creates an ImportUseCase
+type ImportUsecaseConstructor func(
+	metadataRepo repository.MetadataRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	auditRepo repository.AuditRepository,
+) usecase.ImportUseCase
+
+// ImportUsecaseRunner runs all CSV import usecase tests against an implementation
+func ImportUsecaseRunner(t *testing.T, constructor ImportUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetadata := mockRepository.NewMockMetadataRepository(ctrl)
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+
+	uc := constructor(mockMetadata, mockDatabase, mockRBAC, mockConfig, mockAudit)
+
+	mockConfig.EXPECT().
+		GetConfig(gomock.Any()).
+		Return(&domain.AppConfig{}, nil).
+		AnyTimes()
+
+	ctx := context.Background()
+
+	usersMetadata := &domain.DatabaseMetadata{
+		Name: "testdb",
+		Schemas: []domain.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []domain.TableMetadata{
+					{
+						Name: "users",
+						Columns: []domain.ColumnMetadata{
+							{Name: "id", DataType: "integer", IsPrimary: true},
+							{Name: "name", DataType: "text"},
+							{Name: "active", DataType: "boolean", IsNullable: true},
+							{Name: "joined_on", DataType: "date", IsNullable: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	params := domain.ImportParams{Database: "testdb", Schema: "public", Table: "users"}
+
+	t.Run("PreviewCSVImport maps headers and coerces values", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(usersMetadata, nil)
+
+		csvFile := "user_id,name,active,notes,joined_on\n1,Alice,yes,vip,2024-05-01\nx,Bob,no,,\n3,Carol,,,\n"
+		preview, err := uc.PreviewCSVImport(ctx, "testuser", domain.ImportParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+			Mapping:  map[string]string{"user_id": "id"},
+		}, strings.NewReader(csvFile))
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.ImportColumnMapping{
+			{CSVColumn: "user_id", TableColumn: "id", DataType: "integer"},
+			{CSVColumn: "name", TableColumn: "name", DataType: "text"},
+			{CSVColumn: "active", TableColumn: "active", DataType: "boolean"},
+			{CSVColumn: "notes"},
+			{CSVColumn: "joined_on", TableColumn: "joined_on", DataType: "date"},
+		}, preview.Columns)
+		require.Equal(t, 3, preview.RowCount)
+		require.Equal(t, [][]interface{}{
+			{int64(1), "Alice", true, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+			{int64(3), "Carol", nil, nil},
+		}, preview.Rows)
+		require.Equal(t, []domain.ImportRowError{
+			{Line: 3, Column: "id", Message: `"x" is not a valid integer`},
+		}, preview.Errors)
+	})
+
+	t.Run("PreviewCSVImport rejects mappings to unknown columns", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(usersMetadata, nil)
+
+		_, err := uc.PreviewCSVImport(ctx, "testuser", domain.ImportParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "users",
+			Mapping:  map[string]string{"email": "email_address"},
+		}, strings.NewReader("id,email\n1,a@example.com\n"))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "email_address does not exist")
+	})
+
+	t.Run("ImportCSV requires INSERT permission", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "viewer", "testdb", "public", "users").
+			Return(false, nil)
+
+		_, err := uc.ImportCSV(ctx, "viewer", params, strings.NewReader("id,name\n1,Alice\n"))
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ImportCSV loads nothing when a value does not fit", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(usersMetadata, nil)
+
+		_, err := uc.ImportCSV(ctx, "testuser", params, strings.NewReader("id,name\n1,Alice\n2,\n"))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "line 3, column name: value is required")
+	})
+
+	t.Run("ImportCSV copies rows tracked under the username", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(usersMetadata, nil)
+
+		mockDatabase.EXPECT().
+			CopyRows(gomock.Any(), "testuser", "public", "users", []string{"id", "name"}, [][]interface{}{
+				{int64(1), "Alice"},
+				{int64(2), "Bob"},
+			}).
+			Return(int64(2), nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "testuser", entry.Username)
+				require.Equal(t, domain.AuditActionImport, entry.Action)
+				require.Equal(t, "users", entry.Table)
+				require.Equal(t, int64(2), entry.After["rows"])
+				return nil
+			})
+
+		result, err := uc.ImportCSV(ctx, "testuser", params, strings.NewReader("\ufeffid,name\n1,Alice\n2,Bob\n"))
+
+		require.NoError(t, err)
+		require.Equal(t, int64(2), result.RowsImported)
+	})
+
+	t.Run("ImportCSV reports a cancelled load", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(usersMetadata, nil)
+
+		mockDatabase.EXPECT().
+			CopyRows(gomock.Any(), "testuser", "public", "users", []string{"id", "name"}, gomock.Any()).
+			Return(int64(0), domain.ErrQueryCancelled)
+
+		_, err := uc.ImportCSV(ctx, "testuser", params, strings.NewReader("id,name\n1,Alice\n"))
+
+		require.ErrorIs(t, err, domain.ErrQueryCancelled)
+	})
+
+	t.Run("ImportCSV is refused in maintenance mode", func(t *testing.T) {
+		maintenanceConfig := mockRepository.NewMockConfigRepository(ctrl)
+		maintenanceUC := constructor(mockMetadata, mockDatabase, mockRBAC, maintenanceConfig, mockAudit)
+
+		maintenanceConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceSince: time.Now()}, nil)
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		_, err := maintenanceUC.ImportCSV(ctx, "testuser", params, strings.NewReader("id,name\n1,Alice\n"))
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "maintenance", validationErr.Field)
+		require.Equal(t, domain.DefaultMaintenanceMessage, validationErr.Message)
+	})
+
+	t.Run("ValidateCSVImport reports every bad value by row and column without loading", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(true, nil)
+
+		mockMetadata.EXPECT().
+			GetMetadata(gomock.Any(), "testdb").
+			Return(usersMetadata, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "users").
+			Return(&domain.TableMetadata{
+				Name: "users",
+				Columns: []domain.ColumnMetadata{
+					{Name: "id", DataType: "integer", IsPrimary: true, HasDefault: true},
+					{Name: "name", DataType: "text"},
+					{Name: "active", DataType: "boolean", IsNullable: true},
+					{Name: "joined_on", DataType: "date", IsNullable: true},
+				},
+			}, nil)
+
+		csvFile := "id,active,joined_on\n1,yes,2024-05-01\nx,maybe,2024-13-01\n3,no\n99999999999,,\n"
+		validation, err := uc.ValidateCSVImport(ctx, "testuser", params, strings.NewReader(csvFile))
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"name"}, validation.MissingColumns, "name is required and has no default")
+		require.Equal(t, 4, validation.RowCount)
+		require.Equal(t, 3, validation.InvalidRowCount)
+		require.Equal(t, 5, validation.ErrorCount)
+		require.False(t, validation.Truncated)
+		require.Len(t, validation.Rows, 3)
+
+		require.Equal(t, 3, validation.Rows[0].Line)
+		require.Len(t, validation.Rows[0].Columns, 3)
+		require.Contains(t, validation.Rows[0].Columns["id"], "not a valid integer")
+		require.Contains(t, validation.Rows[0].Columns["active"], "not a valid boolean")
+		require.Contains(t, validation.Rows[0].Columns["joined_on"], "not a valid date")
+
+		require.Equal(t, 4, validation.Rows[1].Line)
+		require.Equal(t, "expected 3 fields, got 2", validation.Rows[1].Message)
+
+		require.Equal(t, 5, validation.Rows[2].Line)
+		require.Contains(t, validation.Rows[2].Columns["id"], "out of range for integer")
+	})
+}
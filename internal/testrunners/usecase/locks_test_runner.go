@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// LocksUsecaseConstructor is a function type that creates a LocksUseCase
+type LocksUsecaseConstructor func(
+	monitoringRepo repository.MonitoringRepository,
+	rbacRepo repository.RBACRepository,
+) usecase.LocksUseCase
+
+// LocksUsecaseRunner runs all locks usecase tests against an implementation
+func LocksUsecaseRunner(t *testing.T, constructor LocksUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMonitoring := mockRepository.NewMockMonitoringRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+
+	uc := constructor(mockMonitoring, mockRBAC)
+
+	ctx := context.Background()
+
+	holder := domain.BackendActivity{PID: 100, Database: "shop", Username: "alice", ClientAddr: "10.0.0.5", State: "idle in transaction", Query: "UPDATE orders SET state = 'paid' WHERE id = 1"}
+	first := domain.BackendActivity{PID: 101, Database: "shop", Username: "bob", ClientAddr: "10.0.0.6", State: "active", Query: "UPDATE orders SET state = 'void' WHERE id = 1"}
+	second := domain.BackendActivity{PID: 102, Database: "shop", Username: "carol", ClientAddr: "10.0.0.7", State: "active", Query: "LOCK TABLE orders"}
+	otherHolder := domain.BackendActivity{PID: 200, Database: "billing", Username: "dave", State: "idle in transaction", Query: "DELETE FROM invoices"}
+	otherWaiter := domain.BackendActivity{PID: 201, Database: "billing", Username: "erin", State: "active", Query: "SELECT * FROM invoices FOR UPDATE"}
+
+	// carol waits on both alice, whose table lock she needs, and bob, who is queued ahead of her
+	waits := []domain.LockWait{
+		{Blocked: first, Blocking: holder, LockType: "transactionid", Mode: "ShareLock"},
+		{Blocked: second, Blocking: holder, LockType: "relation", Mode: "AccessExclusiveLock", Relation: "orders"},
+		{Blocked: second, Blocking: first, LockType: "relation", Mode: "AccessExclusiveLock", Relation: "orders"},
+		{Blocked: otherWaiter, Blocking: otherHolder, LockType: "tuple", Mode: "ExclusiveLock", Relation: "invoices"},
+	}
+
+	t.Run("GetBlockingChains groups waiters behind the backend holding the lock", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockMonitoring.EXPECT().GetLockWaits(gomock.Any()).Return(waits, nil)
+
+		report, err := uc.GetBlockingChains(ctx, "postgres")
+		require.NoError(t, err)
+		require.True(t, report.CanTerminate)
+		require.Len(t, report.Chains, 2)
+
+		shop := report.Chains[0]
+		require.Equal(t, "shop", shop.Database)
+		require.Equal(t, 100, shop.Root.PID)
+		require.Equal(t, holder.Query, shop.Root.Query)
+		require.Len(t, shop.Waiters, 2)
+		require.Equal(t, 101, shop.Waiters[0].Backend.PID)
+		require.Equal(t, 100, shop.Waiters[0].BlockedBy)
+		require.Equal(t, 1, shop.Waiters[0].Depth)
+		require.Equal(t, "ShareLock", shop.Waiters[0].Mode)
+		require.Equal(t, 102, shop.Waiters[1].Backend.PID)
+		require.Equal(t, 1, shop.Waiters[1].Depth)
+		require.Equal(t, "orders", shop.Waiters[1].Relation)
+
+		require.Equal(t, "billing", report.Chains[1].Database)
+		require.Equal(t, 200, report.Chains[1].Root.PID)
+	})
+
+	t.Run("GetBlockingChains follows waits more than one backend deep", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockMonitoring.EXPECT().GetLockWaits(gomock.Any()).Return([]domain.LockWait{
+			{Blocked: second, Blocking: first, LockType: "transactionid", Mode: "ShareLock"},
+			{Blocked: first, Blocking: holder, LockType: "transactionid", Mode: "ShareLock"},
+		}, nil)
+
+		report, err := uc.GetBlockingChains(ctx, "postgres")
+		require.NoError(t, err)
+		require.Len(t, report.Chains, 1)
+		require.Equal(t, 100, report.Chains[0].Root.PID)
+		require.Len(t, report.Chains[0].Waiters, 2)
+		require.Equal(t, 101, report.Chains[0].Waiters[0].Backend.PID)
+		require.Equal(t, 1, report.Chains[0].Waiters[0].Depth)
+		require.Equal(t, 102, report.Chains[0].Waiters[1].Backend.PID)
+		require.Equal(t, 101, report.Chains[0].Waiters[1].BlockedBy)
+		require.Equal(t, 2, report.Chains[0].Waiters[1].Depth)
+	})
+
+	t.Run("GetBlockingChains leaves out backends deadlocked on each other", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockMonitoring.EXPECT().GetLockWaits(gomock.Any()).Return([]domain.LockWait{
+			{Blocked: first, Blocking: second},
+			{Blocked: second, Blocking: first},
+		}, nil)
+
+		report, err := uc.GetBlockingChains(ctx, "postgres")
+		require.NoError(t, err)
+		require.Empty(t, report.Chains)
+	})
+
+	t.Run("GetBlockingChains limits other users to their databases and hides others' queries", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "bob").Return(false, nil)
+		mockMonitoring.EXPECT().GetLockWaits(gomock.Any()).Return(waits, nil)
+		mockRBAC.EXPECT().GetAccessibleDatabases(gomock.Any(), "bob").Return([]string{"shop"}, nil)
+
+		report, err := uc.GetBlockingChains(ctx, "bob")
+		require.NoError(t, err)
+		require.False(t, report.CanTerminate)
+		require.Len(t, report.Chains, 1)
+
+		shop := report.Chains[0]
+		require.Equal(t, "alice", shop.Root.Username)
+		require.Empty(t, shop.Root.Query)
+		require.Empty(t, shop.Root.ClientAddr)
+		require.Equal(t, first.Query, shop.Waiters[0].Backend.Query)
+		require.Empty(t, shop.Waiters[1].Backend.Query)
+	})
+}
@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// MonitoringUsecaseConstructor is a function type that creates a MonitoringUseCase
+type MonitoringUsecaseConstructor func(
+	monitoringRepo repository.MonitoringRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+	slowOpRepo repository.SlowOperationRepository,
+	loggerRepo repository.LoggerRepository,
+) usecase.MonitoringUseCase
+
+// MonitoringUsecaseRunner runs all monitoring usecase tests against an implementation
+func MonitoringUsecaseRunner(t *testing.T, constructor MonitoringUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMonitoring := mockRepository.NewMockMonitoringRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+	mockSlowOp := mockRepository.NewMockSlowOperationRepository(ctrl)
+	mockLogger := mockRepository.NewMockLoggerRepository(ctrl)
+
+	uc := constructor(mockMonitoring, mockRBAC, mockAudit, mockSlowOp, mockLogger)
+
+	ctx := context.Background()
+	queryStart := time.Now().Add(-time.Minute)
+
+	backends := []domain.BackendActivity{
+		{
+			PID:           101,
+			Database:      "shop",
+			Username:      "alice",
+			ClientAddr:    "10.0.0.5",
+			State:         "active",
+			Query:         "UPDATE orders SET state = 'paid'",
+			QueryStart:    queryStart,
+			Duration:      time.Minute,
+			WaitEventType: "Lock",
+			WaitEvent:     "transactionid",
+		},
+		{
+			PID:        102,
+			Database:   "shop",
+			Username:   "bob",
+			ClientAddr: "10.0.0.6",
+			State:      "idle in transaction",
+			Query:      "SELECT * FROM orders FOR UPDATE",
+			QueryStart: queryStart,
+			Duration:   time.Minute,
+		},
+		{
+			PID:      103,
+			Database: "payroll",
+			Username: "carol",
+			State:    "active",
+			Query:    "SELECT * FROM salaries",
+		},
+	}
+
+	t.Run("GetActivity shows superusers every backend and lets them terminate", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockMonitoring.EXPECT().GetBackendActivity(gomock.Any()).Return(backends, nil)
+
+		snapshot, err := uc.GetActivity(ctx, "postgres")
+		require.NoError(t, err)
+		require.True(t, snapshot.CanTerminate)
+		require.Equal(t, backends, snapshot.Backends)
+		require.False(t, snapshot.TakenAt.IsZero())
+	})
+
+	t.Run("GetActivity limits other users to their databases and hides others' queries", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "alice").Return(false, nil)
+		mockMonitoring.EXPECT().GetBackendActivity(gomock.Any()).Return(backends, nil)
+		mockRBAC.EXPECT().GetAccessibleDatabases(gomock.Any(), "alice").Return([]string{"shop"}, nil)
+
+		snapshot, err := uc.GetActivity(ctx, "alice")
+		require.NoError(t, err)
+		require.False(t, snapshot.CanTerminate)
+		require.Len(t, snapshot.Backends, 2)
+
+		require.Equal(t, backends[0], snapshot.Backends[0])
+
+		require.Equal(t, 102, snapshot.Backends[1].PID)
+		require.Equal(t, "bob", snapshot.Backends[1].Username)
+		require.Equal(t, "idle in transaction", snapshot.Backends[1].State)
+		require.Empty(t, snapshot.Backends[1].Query)
+		require.Empty(t, snapshot.Backends[1].ClientAddr)
+
+		// The repository's slice is left as it was
+		require.Equal(t, "SELECT * FROM orders FOR UPDATE", backends[1].Query)
+	})
+
+	t.Run("TerminateBackend ends the backend and records who it belonged to", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockMonitoring.EXPECT().GetBackendActivity(gomock.Any()).Return(backends, nil)
+		mockMonitoring.EXPECT().TerminateBackend(gomock.Any(), 102).Return(true, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), &domain.AuditEntry{
+				Username: "postgres",
+				Action:   domain.AuditActionTerminateBackend,
+				Target:   "102",
+				Database: "shop",
+				Before: map[string]interface{}{
+					"username": "bob",
+					"state":    "idle in transaction",
+					"query":    "SELECT * FROM orders FOR UPDATE",
+				},
+			}).
+			Return(nil)
+
+		require.NoError(t, uc.TerminateBackend(ctx, "postgres", 102))
+	})
+
+	t.Run("TerminateBackend reports a backend that is already gone", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockMonitoring.EXPECT().GetBackendActivity(gomock.Any()).Return(backends, nil)
+
+		err := uc.TerminateBackend(ctx, "postgres", 999)
+		require.ErrorIs(t, err, domain.ErrBackendNotFound)
+	})
+
+	t.Run("TerminateBackend is refused to non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "alice").Return(false, nil)
+
+		err := uc.TerminateBackend(ctx, "alice", 102)
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ListSlowOperations returns the log with its index suggestions", func(t *testing.T) {
+		operations := []domain.SlowOperation{{
+			ID:          "op-1",
+			Username:    "alice",
+			Operation:   domain.SlowOperationFilter,
+			Database:    "shop",
+			Schema:      "public",
+			Table:       "orders",
+			WhereClause: "region = 'eu'",
+			Duration:    2 * time.Second,
+			Suggestions: []domain.IndexSuggestion{{
+				Schema:    "public",
+				Table:     "orders",
+				Columns:   []string{"region"},
+				Statement: `CREATE INDEX ON "public"."orders" ("region")`,
+			}},
+		}}
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockSlowOp.EXPECT().GetSlowOperations(gomock.Any(), 50).Return(operations, nil)
+
+		result, err := uc.ListSlowOperations(ctx, "postgres", 50)
+		require.NoError(t, err)
+		require.Equal(t, operations, result)
+	})
+
+	t.Run("ListSlowOperations is refused to non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "alice").Return(false, nil)
+
+		_, err := uc.ListSlowOperations(ctx, "alice", 50)
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ListSlowQueries returns the slow-query log with plans", func(t *testing.T) {
+		queries := []domain.SlowQuery{{
+			ID:        "slowq-1",
+			Username:  "alice",
+			Statement: "SELECT * FROM orders WHERE region = $1",
+			Duration:  3 * time.Second,
+			Plan:      &domain.QueryPlan{Root: domain.QueryPlanNode{NodeType: "Seq Scan", RelationName: "orders"}},
+		}}
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+		mockLogger.EXPECT().GetSlowQueries(gomock.Any(), 20).Return(queries, nil)
+
+		result, err := uc.ListSlowQueries(ctx, "postgres", 20)
+		require.NoError(t, err)
+		require.Equal(t, queries, result)
+	})
+
+	t.Run("ListSlowQueries is refused to non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "alice").Return(false, nil)
+
+		_, err := uc.ListSlowQueries(ctx, "alice", 20)
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ListSlowQueries rejects a negative limit", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil)
+
+		_, err := uc.ListSlowQueries(ctx, "postgres", -1)
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "limit", validationErr.Field)
+	})
+
+	t.Run("GetServerDashboard measures transaction rates against the previous sample", func(t *testing.T) {
+		dashboardUC := constructor(mockMonitoring, mockRBAC, mockAudit, mockSlowOp, mockLogger)
+		sampledAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		shopSize, analyticsSize := int64(8<<20), int64(2<<20)
+
+		first := &domain.ServerStats{
+			TakenAt:        sampledAt,
+			MaxConnections: 100,
+			Databases: []domain.DatabaseStats{
+				{Name: "analytics", Connections: 1, XactCommit: 50, BlocksHit: 0, BlocksRead: 0, SizeBytes: &analyticsSize},
+				{Name: "shop", Connections: 3, XactCommit: 1000, XactRollback: 10, BlocksHit: 900, BlocksRead: 100, SizeBytes: &shopSize},
+			},
+		}
+		second := &domain.ServerStats{
+			TakenAt:        sampledAt.Add(10 * time.Second),
+			MaxConnections: 100,
+			Databases: []domain.DatabaseStats{
+				{Name: "analytics", Connections: 1, XactCommit: 60, SizeBytes: &analyticsSize},
+				{Name: "restricted", Connections: 2, XactCommit: 5},
+				{Name: "shop", Connections: 4, XactCommit: 1190, XactRollback: 20, BlocksHit: 2700, BlocksRead: 300, SizeBytes: &shopSize},
+			},
+		}
+
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil).Times(2)
+		mockMonitoring.EXPECT().GetServerStats(gomock.Any()).Return(first, nil)
+		mockMonitoring.EXPECT().GetServerStats(gomock.Any()).Return(second, nil)
+
+		initial, err := dashboardUC.GetServerDashboard(ctx, "postgres")
+		require.NoError(t, err)
+		require.Nil(t, initial.TransactionsPerSecond)
+		require.Nil(t, initial.Databases[0].TransactionsPerSecond)
+		require.Nil(t, initial.Databases[0].CacheHitRatio)
+		require.InDelta(t, 0.9, *initial.Databases[1].CacheHitRatio, 0.0001)
+
+		dashboard, err := dashboardUC.GetServerDashboard(ctx, "postgres")
+		require.NoError(t, err)
+		require.Equal(t, 7, dashboard.TotalConnections)
+		require.Equal(t, 100, dashboard.MaxConnections)
+		require.Equal(t, shopSize+analyticsSize, dashboard.TotalSizeBytes)
+		require.Len(t, dashboard.Databases, 3)
+
+		require.InDelta(t, 1.0, *dashboard.Databases[0].TransactionsPerSecond, 0.0001)
+		// A database absent from the previous sample has no rate, so neither has the server as a whole
+		require.Nil(t, dashboard.Databases[1].TransactionsPerSecond)
+		require.Nil(t, dashboard.TransactionsPerSecond)
+		require.InDelta(t, 20.0, *dashboard.Databases[2].TransactionsPerSecond, 0.0001)
+		require.InDelta(t, 0.9, *dashboard.CacheHitRatio, 0.0001)
+	})
+
+	t.Run("GetServerDashboard gives the server-wide rate when every database was measured", func(t *testing.T) {
+		dashboardUC := constructor(mockMonitoring, mockRBAC, mockAudit, mockSlowOp, mockLogger)
+		sampledAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "postgres").Return(true, nil).Times(2)
+		mockMonitoring.EXPECT().GetServerStats(gomock.Any()).Return(&domain.ServerStats{
+			TakenAt:   sampledAt,
+			Databases: []domain.DatabaseStats{{Name: "shop", XactCommit: 100}, {Name: "crm", XactCommit: 40}},
+		}, nil)
+		mockMonitoring.EXPECT().GetServerStats(gomock.Any()).Return(&domain.ServerStats{
+			TakenAt:   sampledAt.Add(5 * time.Second),
+			Databases: []domain.DatabaseStats{{Name: "shop", XactCommit: 150}, {Name: "crm", XactCommit: 45}},
+		}, nil)
+
+		_, err := dashboardUC.GetServerDashboard(ctx, "postgres")
+		require.NoError(t, err)
+		dashboard, err := dashboardUC.GetServerDashboard(ctx, "postgres")
+		require.NoError(t, err)
+		require.NotNil(t, dashboard.TransactionsPerSecond)
+		require.InDelta(t, 11.0, *dashboard.TransactionsPerSecond, 0.0001)
+	})
+
+	t.Run("GetServerDashboard is refused to non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "alice").Return(false, nil)
+
+		_, err := uc.GetServerDashboard(ctx, "alice")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+}
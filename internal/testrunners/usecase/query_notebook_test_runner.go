@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// QueryNotebookUsecaseConstructor is a function type that creates a QueryNotebookUseCase
+type QueryNotebookUsecaseConstructor func(
+	notebookRepo repository.NotebookRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+) usecase.QueryNotebookUseCase
+
+// QueryNotebookUsecaseRunner runs all query notebook usecase tests against an implementation
+func QueryNotebookUsecaseRunner(t *testing.T, constructor QueryNotebookUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotebook := mockRepository.NewMockNotebookRepository(ctrl)
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+
+	uc := constructor(mockNotebook, mockDatabase, mockRBAC, mockConfig)
+
+	ctx := context.Background()
+
+	t.Run("CreateNotebook gives new cells IDs and drops given results", func(t *testing.T) {
+		mockNotebook.EXPECT().
+			CreateNotebook(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, notebook *domain.QueryNotebook) error {
+				notebook.ID = "notebook_1"
+				return nil
+			})
+
+		notebook, err := uc.CreateNotebook(ctx, "testuser", "  Weekly report ", []domain.NotebookCell{
+			{Kind: domain.NotebookCellMarkdown, Source: "# Signups"},
+			{ID: "count", Kind: domain.NotebookCellSQL, Source: "SELECT COUNT(*) FROM users", Error: "stale"},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "notebook_1", notebook.ID)
+		require.Equal(t, "testuser", notebook.Username)
+		require.Equal(t, "Weekly report", notebook.Title)
+		require.Len(t, notebook.Cells, 2)
+		require.NotEmpty(t, notebook.Cells[0].ID)
+		require.Equal(t, "count", notebook.Cells[1].ID)
+		require.Empty(t, notebook.Cells[1].Error)
+	})
+
+	t.Run("CreateNotebook rejects empty title", func(t *testing.T) {
+		_, err := uc.CreateNotebook(ctx, "testuser", "  ", nil)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "title", validationErr.Field)
+	})
+
+	t.Run("CreateNotebook rejects unknown cell kinds and repeated IDs", func(t *testing.T) {
+		_, err := uc.CreateNotebook(ctx, "testuser", "Report", []domain.NotebookCell{{Kind: "python", Source: "print(1)"}})
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "cells", validationErr.Field)
+
+		_, err = uc.CreateNotebook(ctx, "testuser", "Report", []domain.NotebookCell{
+			{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT 1"},
+			{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT 2"},
+		})
+		require.Error(t, err)
+		validationErr, ok = err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "cells", validationErr.Field)
+	})
+
+	t.Run("UpdateNotebook keeps results of unchanged cells", func(t *testing.T) {
+		saved := &domain.QueryResult{Columns: []string{"count"}, Rows: []map[string]interface{}{{"count": 3}}, RowCount: 1, TotalCount: 1}
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Username: "testuser", Title: "Report", Cells: []domain.NotebookCell{
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT COUNT(*) FROM users", Result: saved},
+				{ID: "b", Kind: domain.NotebookCellSQL, Source: "SELECT 1", Result: saved},
+			}}, nil)
+		mockNotebook.EXPECT().UpdateNotebook(gomock.Any(), gomock.Any()).Return(nil)
+
+		notebook, err := uc.UpdateNotebook(ctx, "testuser", "notebook_1", "Renamed", []domain.NotebookCell{
+			{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT COUNT(*) FROM users"},
+			{ID: "b", Kind: domain.NotebookCellSQL, Source: "SELECT 2"},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "Renamed", notebook.Title)
+		require.Equal(t, saved, notebook.Cells[0].Result)
+		require.Nil(t, notebook.Cells[1].Result)
+	})
+
+	t.Run("UpdateNotebook returns not found for another user's notebook", func(t *testing.T) {
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_2").
+			Return(nil, domain.ErrNotebookNotFound)
+
+		_, err := uc.UpdateNotebook(ctx, "testuser", "notebook_2", "Report", nil)
+
+		require.ErrorIs(t, err, domain.ErrNotebookNotFound)
+	})
+
+	t.Run("RunCell saves the result capped to the notebook row limit", func(t *testing.T) {
+		rows := make([]map[string]interface{}, domain.NotebookResultMaxRows+5)
+		for i := range rows {
+			rows[i] = map[string]interface{}{"id": i}
+		}
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Username: "testuser", Cells: []domain.NotebookCell{
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT id FROM users"},
+			}}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "", "", "").Return(true, nil)
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT id FROM users").
+			Return(&domain.QueryResult{Columns: []string{"id"}, Rows: rows, RowCount: int64(len(rows))}, nil)
+		mockNotebook.EXPECT().
+			UpdateNotebook(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, notebook *domain.QueryNotebook) error {
+				require.NotNil(t, notebook.Cells[0].Result)
+				return nil
+			})
+
+		notebook, err := uc.RunCell(ctx, "testuser", "notebook_1", "a")
+
+		require.NoError(t, err)
+		result := notebook.Cells[0].Result
+		require.Len(t, result.Rows, domain.NotebookResultMaxRows)
+		require.Equal(t, int64(domain.NotebookResultMaxRows), result.RowCount)
+		require.Equal(t, int64(domain.NotebookResultMaxRows+5), result.TotalCount)
+		require.False(t, notebook.Cells[0].RanAt.IsZero())
+	})
+
+	t.Run("RunCell saves a query failure on the cell", func(t *testing.T) {
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Cells: []domain.NotebookCell{
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT * FROM missing", Result: &domain.QueryResult{}},
+			}}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "", "", "").Return(true, nil)
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", gomock.Any()).
+			Return(nil, errors.New(`relation "missing" does not exist`))
+		mockNotebook.EXPECT().UpdateNotebook(gomock.Any(), gomock.Any()).Return(nil)
+
+		notebook, err := uc.RunCell(ctx, "testuser", "notebook_1", "a")
+
+		require.NoError(t, err)
+		require.Nil(t, notebook.Cells[0].Result)
+		require.Contains(t, notebook.Cells[0].Error, "does not exist")
+	})
+
+	t.Run("RunCell refuses statements other than SELECT", func(t *testing.T) {
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Cells: []domain.NotebookCell{
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "DELETE FROM users"},
+			}}, nil)
+		mockNotebook.EXPECT().UpdateNotebook(gomock.Any(), gomock.Any()).Return(nil)
+
+		notebook, err := uc.RunCell(ctx, "testuser", "notebook_1", "a")
+
+		require.NoError(t, err)
+		require.Equal(t, "only SELECT queries are allowed", notebook.Cells[0].Error)
+	})
+
+	t.Run("RunCell rejects markdown and unknown cells", func(t *testing.T) {
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Cells: []domain.NotebookCell{
+				{ID: "note", Kind: domain.NotebookCellMarkdown, Source: "# Notes"},
+			}}, nil).
+			Times(2)
+
+		_, err := uc.RunCell(ctx, "testuser", "notebook_1", "note")
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "cell", validationErr.Field)
+
+		_, err = uc.RunCell(ctx, "testuser", "notebook_1", "missing")
+		require.Error(t, err)
+		validationErr, ok = err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "cell", validationErr.Field)
+	})
+
+	t.Run("RunAllCells runs SQL cells in order and stops at the first failure", func(t *testing.T) {
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Cells: []domain.NotebookCell{
+				{ID: "note", Kind: domain.NotebookCellMarkdown, Source: "# Notes"},
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT 1"},
+				{ID: "b", Kind: domain.NotebookCellSQL, Source: "SELECT * FROM missing"},
+				{ID: "c", Kind: domain.NotebookCellSQL, Source: "SELECT 3"},
+			}}, nil)
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "testuser", "", "", "").Return(true, nil).Times(2)
+		gomock.InOrder(
+			mockDatabase.EXPECT().
+				ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT 1").
+				Return(&domain.QueryResult{Columns: []string{"?column?"}, Rows: []map[string]interface{}{{"?column?": 1}}}, nil),
+			mockDatabase.EXPECT().
+				ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT * FROM missing").
+				Return(nil, fmt.Errorf(`relation "missing" does not exist`)),
+		)
+		mockNotebook.EXPECT().UpdateNotebook(gomock.Any(), gomock.Any()).Return(nil)
+
+		notebook, err := uc.RunAllCells(ctx, "testuser", "notebook_1")
+
+		require.NoError(t, err)
+		require.True(t, notebook.Cells[0].RanAt.IsZero())
+		require.NotNil(t, notebook.Cells[1].Result)
+		require.NotEmpty(t, notebook.Cells[2].Error)
+		require.True(t, notebook.Cells[3].RanAt.IsZero())
+	})
+
+	t.Run("ExportNotebook writes cells and results as a single JSON file", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "testuser", "notebook_1").
+			Return(&domain.QueryNotebook{ID: "notebook_1", Title: "Weekly Report: Q3", Cells: []domain.NotebookCell{
+				{ID: "note", Kind: domain.NotebookCellMarkdown, Source: "# Signups"},
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT 1 AS one", Result: &domain.QueryResult{
+					Columns: []string{"one"}, Rows: []map[string]interface{}{{"one": 1}}, RowCount: 1, TotalCount: 1,
+				}},
+			}}, nil)
+
+		export, err := uc.ExportNotebook(ctx, "testuser", "notebook_1")
+
+		require.NoError(t, err)
+		require.Equal(t, "weekly_report_q3.json", export.Filename)
+		require.Equal(t, "application/json", export.ContentType)
+
+		var document struct {
+			Version int    `json:"version"`
+			Title   string `json:"title"`
+			Cells   []struct {
+				Kind   string `json:"kind"`
+				Source string `json:"source"`
+				Result *struct {
+					Columns []string `json:"columns"`
+				} `json:"result"`
+			} `json:"cells"`
+		}
+		require.NoError(t, json.Unmarshal(export.Content, &document))
+		require.Equal(t, domain.NotebookFormatVersion, document.Version)
+		require.Equal(t, "Weekly Report: Q3", document.Title)
+		require.Len(t, document.Cells, 2)
+		require.Equal(t, domain.NotebookCellMarkdown, document.Cells[0].Kind)
+		require.Nil(t, document.Cells[0].Result)
+		require.Equal(t, []string{"one"}, document.Cells[1].Result.Columns)
+	})
+
+	t.Run("ExportNotebook leaves out saved results when the export policy denies the user", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ExportRules: []domain.ExportRule{
+				{Role: "intern", Table: "hr.salaries", Allow: false},
+			}}, nil)
+		mockNotebook.EXPECT().
+			GetNotebook(gomock.Any(), "intern", "notebook_2").
+			Return(&domain.QueryNotebook{ID: "notebook_2", Title: "Pay", Cells: []domain.NotebookCell{
+				{ID: "a", Kind: domain.NotebookCellSQL, Source: "SELECT * FROM hr.salaries", Result: &domain.QueryResult{
+					Columns: []string{"salary"}, Rows: []map[string]interface{}{{"salary": 90000}}, RowCount: 1, TotalCount: 1,
+				}},
+			}}, nil)
+
+		export, err := uc.ExportNotebook(ctx, "intern", "notebook_2")
+
+		require.NoError(t, err)
+		require.NotContains(t, string(export.Content), "90000")
+
+		var document struct {
+			ResultsOmitted bool `json:"results_omitted"`
+			Cells          []struct {
+				Source string          `json:"source"`
+				Result json.RawMessage `json:"result"`
+			} `json:"cells"`
+		}
+		require.NoError(t, json.Unmarshal(export.Content, &document))
+		require.True(t, document.ResultsOmitted)
+		require.Equal(t, "SELECT * FROM hr.salaries", document.Cells[0].Source)
+		require.Nil(t, document.Cells[0].Result)
+	})
+}
@@ -1,8 +1,13 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -17,6 +22,10 @@ import (
 type QueryUsecaseConstructor func(
 	databaseRepo repository.DatabaseRepository,
 	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	loggerRepo repository.LoggerRepository,
+	auditRepo repository.AuditRepository,
+	resultCache repository.ResultCacheRepository,
 ) usecase.QueryUseCase
 
 // QueryUsecaseRunner runs all query usecase tests against an implementation
@@ -30,8 +39,19 @@ func QueryUsecaseRunner(t *testing.T, constructor QueryUsecaseConstructor) {
 
 	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
 	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+	mockLogger := mockRepository.NewMockLoggerRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+	mockResultCache := mockRepository.NewMockResultCacheRepository(ctrl)
 
-	uc := constructor(mockDatabase, mockRBAC)
+	// Queries in these tests finish well inside the default slow-query threshold
+	mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).AnyTimes()
+	// Queries and exports are kept for account activity; the tests below that check it use their own log
+	mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	// Fresh runs keep their rows for re-sorting; the tests below that check it use their own cache
+	mockResultCache.EXPECT().StoreResult(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	uc := constructor(mockDatabase, mockRBAC, mockConfig, mockLogger, mockAudit, mockResultCache)
 
 	ctx := context.Background()
 
@@ -40,7 +60,7 @@ func QueryUsecaseRunner(t *testing.T, constructor QueryUsecaseConstructor) {
 	// E2E-S4-02: Execute Single Query
 	t.Run("ExecuteQuery executes single SELECT query", func(t *testing.T) {
 		mockDatabase.EXPECT().
-			ExecuteQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			ExecuteTrackedQuery(gomock.Any(), "testuser", gomock.Any()).
 			Return(&domain.QueryResult{
 				Columns:  []string{"id", "name"},
 				Rows:     []map[string]interface{}{{"id": 1, "name": "test"}},
@@ -266,7 +286,7 @@ func QueryUsecaseRunner(t *testing.T, constructor QueryUsecaseConstructor) {
 	// E2E-S4-02: Execute Single Query
 	t.Run("ExecuteQuery handles parameterized queries", func(t *testing.T) {
 		mockDatabase.EXPECT().
-			ExecuteQuery(gomock.Any(), gomock.Any(), gomock.Any()).
+			ExecuteTrackedQuery(gomock.Any(), "testuser", gomock.Any()).
 			Return(&domain.QueryResult{
 				Columns:  []string{"id", "name"},
 				Rows:     []map[string]interface{}{{"id": 5, "name": "specific"}},
@@ -323,4 +343,778 @@ func QueryUsecaseRunner(t *testing.T, constructor QueryUsecaseConstructor) {
 			require.True(t, valid, "query should be valid: %s", query)
 		}
 	})
+
+	t.Run("ExplainQuery returns plan tree", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExplainQuery(gomock.Any(), "SELECT * FROM users", false).
+			Return(&domain.QueryPlan{
+				Root: domain.QueryPlanNode{
+					NodeType:     "Seq Scan",
+					RelationName: "users",
+					TotalCost:    12.5,
+				},
+			}, nil)
+
+		plan, err := uc.ExplainQuery(ctx, "testuser", "SELECT * FROM users;", false)
+
+		require.NoError(t, err)
+		require.Equal(t, "Seq Scan", plan.Root.NodeType)
+		require.Equal(t, "users", plan.Root.RelationName)
+	})
+
+	t.Run("ExplainQuery rejects multiple statements", func(t *testing.T) {
+		_, err := uc.ExplainQuery(ctx, "testuser", "SELECT 1; SELECT 2", false)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "query", validationErr.Field)
+	})
+
+	t.Run("ExplainQuery ANALYZE requires write permission", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "readonly", "", "", "").
+			Return(true, nil)
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "readonly", "", "", "").
+			Return(false, nil)
+		mockRBAC.EXPECT().
+			HasUpdatePermission(gomock.Any(), "readonly", "", "", "").
+			Return(false, nil)
+		mockRBAC.EXPECT().
+			HasDeletePermission(gomock.Any(), "readonly", "", "", "").
+			Return(false, nil)
+
+		_, err := uc.ExplainQuery(ctx, "readonly", "SELECT * FROM users", true)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ExplainQuery ANALYZE allowed for writers", func(t *testing.T) {
+		executionTime := 0.42
+
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "writer", "", "", "").
+			Return(true, nil)
+		mockRBAC.EXPECT().
+			HasInsertPermission(gomock.Any(), "writer", "", "", "").
+			Return(false, nil)
+		mockRBAC.EXPECT().
+			HasUpdatePermission(gomock.Any(), "writer", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExplainQuery(gomock.Any(), "UPDATE users SET active = true", true).
+			Return(&domain.QueryPlan{
+				Root:          domain.QueryPlanNode{NodeType: "ModifyTable"},
+				Analyzed:      true,
+				ExecutionTime: &executionTime,
+			}, nil)
+
+		plan, err := uc.ExplainQuery(ctx, "writer", "UPDATE users SET active = true", true)
+
+		require.NoError(t, err)
+		require.True(t, plan.Analyzed)
+		require.Equal(t, 0.42, *plan.ExecutionTime)
+	})
+
+	t.Run("BuildChartData returns label and numeric series", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT status, COUNT(*), SUM(total) FROM orders GROUP BY status").
+			Return(&domain.QueryResult{
+				Columns: []string{"status", "count", "sum"},
+				Rows: []map[string]interface{}{
+					{"status": "open", "count": int64(3), "sum": []byte("12.50")},
+					{"status": "closed", "count": int64(5), "sum": nil},
+				},
+				RowCount: 2,
+			}, nil)
+
+		chart, err := uc.BuildChartData(ctx, "testuser", "SELECT status, COUNT(*), SUM(total) FROM orders GROUP BY status")
+
+		require.NoError(t, err)
+		require.Equal(t, "status", chart.LabelColumn)
+		require.Equal(t, []string{"open", "closed"}, chart.Labels)
+		require.Len(t, chart.Series, 2)
+		require.Equal(t, 3.0, *chart.Series[0].Values[0])
+		require.Equal(t, 12.5, *chart.Series[1].Values[0])
+		require.Nil(t, chart.Series[1].Values[1])
+		require.Equal(t, domain.ChartTypeBar, chart.SuggestedType)
+	})
+
+	t.Run("BuildChartData suggests line chart for dates", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT day, visits FROM traffic").
+			Return(&domain.QueryResult{
+				Columns: []string{"day", "visits"},
+				Rows: []map[string]interface{}{
+					{"day": "2024-01-01", "visits": int64(10)},
+					{"day": "2024-01-02", "visits": int64(12)},
+				},
+				RowCount: 2,
+			}, nil)
+
+		chart, err := uc.BuildChartData(ctx, "testuser", "SELECT day, visits FROM traffic")
+
+		require.NoError(t, err)
+		require.Equal(t, domain.ChartTypeLine, chart.SuggestedType)
+	})
+
+	t.Run("BuildChartData rejects non-numeric series", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT id, name FROM users").
+			Return(&domain.QueryResult{
+				Columns:  []string{"id", "name"},
+				Rows:     []map[string]interface{}{{"id": int64(1), "name": "Alice"}},
+				RowCount: 1,
+			}, nil)
+
+		_, err := uc.BuildChartData(ctx, "testuser", "SELECT id, name FROM users")
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "name is not numeric")
+	})
+
+	t.Run("ExecuteQueryWithPagination tracks query under username", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, "testuser", params.TrackingKey)
+				return &domain.QueryResult{}, nil
+			})
+
+		_, err := uc.ExecuteQueryWithPagination(ctx, "testuser", domain.QueryParams{Query: "SELECT pg_sleep(60)"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CancelQuery cancels the user's running query", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			CancelTrackedQuery(gomock.Any(), "testuser").
+			Return(true, nil)
+
+		cancelled, err := uc.CancelQuery(ctx, "testuser")
+
+		require.NoError(t, err)
+		require.True(t, cancelled)
+	})
+
+	t.Run("ExecuteQuery reports cancellation", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT pg_sleep(60)").
+			Return(nil, domain.ErrQueryCancelled)
+
+		_, err := uc.ExecuteQuery(ctx, "testuser", "SELECT pg_sleep(60)", 0, 0)
+
+		require.ErrorIs(t, err, domain.ErrQueryCancelled)
+	})
+
+	t.Run("ExportQueryCSV copies the result with filter and sort", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			CopyQueryCSV(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams, w io.Writer) (int64, error) {
+				require.Equal(t, "id > 1", params.WhereClause)
+				require.Equal(t, "name", params.OrderBy)
+				require.Equal(t, "testuser", params.TrackingKey)
+
+				_, err := io.WriteString(w, "id,name\n2,\"Bob, Jr.\"\n3,\n")
+				return 2, err
+			})
+
+		var buf bytes.Buffer
+		err := uc.ExportQueryCSV(ctx, "testuser", domain.QueryParams{
+			Query:       "SELECT id, name FROM users",
+			WhereClause: "id > 1",
+			OrderBy:     "name",
+			OrderDir:    "ASC",
+		}, &buf)
+
+		require.NoError(t, err)
+		require.Equal(t, "id,name\n2,\"Bob, Jr.\"\n3,\n", buf.String())
+	})
+
+	t.Run("ExportQueryCSV streams rows when COPY is unavailable", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			CopyQueryCSV(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(int64(0), domain.ErrCopyUnavailable)
+		mockDatabase.EXPECT().
+			StreamQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams, onColumns func([]string) error, onRow func([]interface{}) error) error {
+				require.Equal(t, "id > 1", params.WhereClause)
+				require.Equal(t, "name", params.OrderBy)
+				require.Equal(t, "testuser", params.TrackingKey)
+
+				require.NoError(t, onColumns([]string{"id", "name"}))
+				require.NoError(t, onRow([]interface{}{int64(2), []byte("Bob, Jr.")}))
+				return onRow([]interface{}{int64(3), nil})
+			})
+
+		var buf bytes.Buffer
+		err := uc.ExportQueryCSV(ctx, "testuser", domain.QueryParams{
+			Query:       "SELECT id, name FROM users",
+			WhereClause: "id > 1",
+			OrderBy:     "name",
+			OrderDir:    "ASC",
+		}, &buf)
+
+		require.NoError(t, err)
+		require.Equal(t, "id,name\n2,\"Bob, Jr.\"\n3,\n", buf.String())
+	})
+
+	t.Run("ExportQueryCSV does not fall back when the copied query fails", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			CopyQueryCSV(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(int64(0), domain.ErrQueryCancelled)
+
+		var buf bytes.Buffer
+		err := uc.ExportQueryCSV(ctx, "testuser", domain.QueryParams{Query: "SELECT pg_sleep(60)"}, &buf)
+
+		require.ErrorIs(t, err, domain.ErrQueryCancelled)
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("ExportQueryCSV rejects non-SELECT statements", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := uc.ExportQueryCSV(ctx, "testuser", domain.QueryParams{Query: "DELETE FROM users"}, &buf)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "query", validationErr.Field)
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("ExportQueryCSV refuses users denied exporting any one table", func(t *testing.T) {
+		policyCtrl := gomock.NewController(t)
+		defer policyCtrl.Finish()
+
+		policyRBAC := mockRepository.NewMockRBACRepository(policyCtrl)
+		policyConfig := mockRepository.NewMockConfigRepository(policyCtrl)
+		policyUC := constructor(mockRepository.NewMockDatabaseRepository(policyCtrl), policyRBAC, policyConfig, mockRepository.NewMockLoggerRepository(policyCtrl), mockRepository.NewMockAuditRepository(policyCtrl), mockRepository.NewMockResultCacheRepository(policyCtrl))
+
+		policyRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), "", "", "").
+			Return(true, nil).
+			Times(2)
+		policyConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ExportRules: []domain.ExportRule{
+				{Role: "analyst", Table: "hr.salaries", Allow: false},
+				{Role: domain.ExportRuleWildcard, Table: "hr.reviews", Allow: false},
+				{Role: "manager", Table: domain.ExportRuleWildcard, Allow: true},
+			}}, nil).
+			Times(2)
+
+		// The deny names one table, but a query can read it, so no query export is allowed
+		var buf bytes.Buffer
+		err := policyUC.ExportQueryCSV(ctx, "analyst", domain.QueryParams{Query: "SELECT * FROM hr.salaries"}, &buf)
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+		require.Empty(t, buf.String())
+
+		// A role-wide allow outranks the table rule naming any role, as it does for table exports
+		policyDatabase := mockRepository.NewMockDatabaseRepository(policyCtrl)
+		policyAudit := mockRepository.NewMockAuditRepository(policyCtrl)
+		managerUC := constructor(policyDatabase, policyRBAC, policyConfig, mockRepository.NewMockLoggerRepository(policyCtrl), policyAudit, mockRepository.NewMockResultCacheRepository(policyCtrl))
+		policyDatabase.EXPECT().CopyQueryCSV(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		policyAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, managerUC.ExportQueryCSV(ctx, "manager", domain.QueryParams{Query: "SELECT * FROM hr.reviews"}, &buf))
+	})
+
+	t.Run("ExportQueryCSV rejects stacked WHERE clause", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := uc.ExportQueryCSV(ctx, "testuser", domain.QueryParams{
+			Query:       "SELECT * FROM users",
+			WhereClause: "true; DROP TABLE users",
+		}, &buf)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "whereClause", validationErr.Field)
+	})
+
+	t.Run("ExportQueryCSV refuses users the export policy denies", func(t *testing.T) {
+		policyCtrl := gomock.NewController(t)
+		defer policyCtrl.Finish()
+
+		policyRBAC := mockRepository.NewMockRBACRepository(policyCtrl)
+		policyConfig := mockRepository.NewMockConfigRepository(policyCtrl)
+		policyUC := constructor(mockRepository.NewMockDatabaseRepository(policyCtrl), policyRBAC, policyConfig, mockRepository.NewMockLoggerRepository(policyCtrl), mockRepository.NewMockAuditRepository(policyCtrl), mockRepository.NewMockResultCacheRepository(policyCtrl))
+
+		policyRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "intern", "", "", "").
+			Return(true, nil)
+		policyConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ExportRules: []domain.ExportRule{
+				{Role: "intern", Table: domain.ExportRuleWildcard, Allow: false},
+				{Role: "intern", Table: "public.users", Allow: true},
+			}}, nil)
+
+		var buf bytes.Buffer
+		err := policyUC.ExportQueryCSV(ctx, "intern", domain.QueryParams{Query: "SELECT * FROM users"}, &buf)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("BuildQueryReport collects the rows with filter and sort", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			StreamQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams, onColumns func([]string) error, onRow func([]interface{}) error) error {
+				require.Equal(t, "id > 1", params.WhereClause)
+				require.Equal(t, "testuser", params.TrackingKey)
+
+				require.NoError(t, onColumns([]string{"id", "name"}))
+				require.NoError(t, onRow([]interface{}{int64(2), []byte("Bob")}))
+				require.NoError(t, onRow([]interface{}{int64(3), nil}))
+				return onRow([]interface{}{int64(4), "NULL"})
+			})
+
+		report, err := uc.BuildQueryReport(ctx, "testuser", domain.QueryParams{
+			Query:       "SELECT id, name FROM users",
+			WhereClause: "id > 1",
+			OrderBy:     "name",
+			OrderDir:    "desc",
+		}, "Handoff")
+
+		require.NoError(t, err)
+		require.Equal(t, "Handoff", report.Title)
+		require.Equal(t, "SELECT id, name FROM users", report.Source)
+		require.Equal(t, "id > 1", report.Filter)
+		require.Equal(t, "name DESC", report.Sort)
+		require.Equal(t, "testuser", report.GeneratedBy)
+		require.Equal(t, []string{"id", "name"}, report.Columns)
+		text := func(s string) *string { return &s }
+		require.Equal(t, [][]*string{{text("2"), text("Bob")}, {text("3"), nil}, {text("4"), text("NULL")}}, report.Rows)
+	})
+
+	t.Run("BuildQueryReport refuses results over the report row limit", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "testuser", "", "", "").
+			Return(true, nil)
+
+		mockDatabase.EXPECT().
+			StreamQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams, onColumns func([]string) error, onRow func([]interface{}) error) error {
+				require.NoError(t, onColumns([]string{"id"}))
+				for i := 0; ; i++ {
+					if err := onRow([]interface{}{int64(i)}); err != nil {
+						require.Equal(t, domain.ReportMaxRows, i)
+						return err
+					}
+				}
+			})
+
+		_, err := uc.BuildQueryReport(ctx, "testuser", domain.QueryParams{Query: "SELECT id FROM events"}, "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "limit", validationErr.Field)
+	})
+
+	t.Run("WatchQuery sends the first result at once, holding the interval to the minimum", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", "SELECT * FROM jobs WHERE state = 'queued'").
+			Return(&domain.QueryResult{Columns: []string{"id"}, RowCount: 3}, nil)
+
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		stop := errors.New("stop watching")
+		var updates []*domain.LiveResultUpdate
+		err := uc.WatchQuery(ctx, "testuser", "SELECT * FROM jobs WHERE state = 'queued'", time.Second, func(update *domain.LiveResultUpdate) error {
+			updates = append(updates, update)
+			return stop
+		})
+
+		require.ErrorIs(t, err, stop)
+		require.Len(t, updates, 1)
+		require.Equal(t, 1, updates[0].Sequence)
+		require.Equal(t, time.Duration(domain.LiveRefreshMinInterval)*time.Second, updates[0].Interval)
+		require.Equal(t, int64(3), updates[0].Result.RowCount)
+	})
+
+	t.Run("WatchQuery ends quietly when the watcher disconnects", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			ExecuteTrackedQuery(gomock.Any(), "testuser", gomock.Any()).
+			Return(&domain.QueryResult{Columns: []string{"id"}}, nil)
+
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		watchCtx, disconnect := context.WithCancel(ctx)
+		err := uc.WatchQuery(watchCtx, "testuser", "SELECT * FROM jobs", time.Minute, func(update *domain.LiveResultUpdate) error {
+			disconnect()
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("WatchQuery refuses a non-SELECT query before streaming", func(t *testing.T) {
+		err := uc.WatchQuery(ctx, "testuser", "DELETE FROM jobs", time.Minute, func(update *domain.LiveResultUpdate) error {
+			t.Fatal("no update expected")
+			return nil
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("WatchSharedEditor pushes the owner's runs to a viewer until sharing stops", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			Return(&domain.QueryResult{
+				Columns:  []string{"id"},
+				Rows:     []map[string]interface{}{{"id": 1}},
+				RowCount: 1,
+			}, nil)
+		mockRBAC.EXPECT().
+			HasSelectPermission(gomock.Any(), "pairowner", gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, nil)
+
+		share, err := uc.ShareEditor(ctx, "pairowner")
+		require.NoError(t, err)
+		require.NotEmpty(t, share.Token)
+		require.Equal(t, domain.EditorShareTTL, share.ExpiresAt.Sub(share.CreatedAt))
+
+		var updates []domain.EditorShareUpdate
+		err = uc.WatchSharedEditor(ctx, "pairviewer", share.Token, func(update *domain.EditorShareUpdate) error {
+			updates = append(updates, *update)
+			switch len(updates) {
+			case 1:
+				// The viewer joins before the owner has run anything
+				_, err := uc.ExecuteQueryWithPagination(ctx, "pairowner", domain.QueryParams{Query: "SELECT id FROM jobs", Offset: 10, Limit: 10})
+				return err
+			default:
+				return uc.StopSharingEditor(ctx, "pairowner")
+			}
+		})
+
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+		require.Equal(t, 0, updates[0].Sequence)
+		require.Equal(t, "pairowner", updates[0].Owner)
+		require.Equal(t, 1, updates[1].Sequence)
+		require.Equal(t, "SELECT id FROM jobs", updates[1].Query)
+		require.Equal(t, int64(1), updates[1].Result.RowCount)
+	})
+
+	t.Run("WatchSharedEditor refuses unknown and replaced invites", func(t *testing.T) {
+		noUpdate := func(update *domain.EditorShareUpdate) error {
+			t.Fatal("no update expected")
+			return nil
+		}
+
+		err := uc.WatchSharedEditor(ctx, "pairviewer", "no-such-token", noUpdate)
+		require.ErrorIs(t, err, domain.ErrEditorShareNotFound)
+
+		first, err := uc.ShareEditor(ctx, "pairowner")
+		require.NoError(t, err)
+		second, err := uc.ShareEditor(ctx, "pairowner")
+		require.NoError(t, err)
+		require.NotEqual(t, first.Token, second.Token)
+
+		err = uc.WatchSharedEditor(ctx, "pairviewer", first.Token, noUpdate)
+		require.ErrorIs(t, err, domain.ErrEditorShareNotFound)
+		require.NoError(t, uc.StopSharingEditor(ctx, "pairowner"))
+	})
+
+	slowQueryUseCase := func(t *testing.T, threshold time.Duration) (usecase.QueryUseCase, *mockRepository.MockDatabaseRepository, *mockRepository.MockLoggerRepository) {
+		slowCtrl := gomock.NewController(t)
+		database := mockRepository.NewMockDatabaseRepository(slowCtrl)
+		rbac := mockRepository.NewMockRBACRepository(slowCtrl)
+		config := mockRepository.NewMockConfigRepository(slowCtrl)
+		logger := mockRepository.NewMockLoggerRepository(slowCtrl)
+		audit := mockRepository.NewMockAuditRepository(slowCtrl)
+		audit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		rbac.EXPECT().HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+		config.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{SlowQueryThreshold: threshold}, nil).AnyTimes()
+		resultCache := mockRepository.NewMockResultCacheRepository(slowCtrl)
+		resultCache.EXPECT().StoreResult(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		return constructor(database, rbac, config, logger, audit, resultCache), database, logger
+	}
+
+	t.Run("ExecuteQueryWithPagination logs a slow query with its normalized statement and plan", func(t *testing.T) {
+		slowUC, database, logger := slowQueryUseCase(t, time.Nanosecond)
+		query := "SELECT *\n  FROM orders -- recent only\n WHERE region = 'it''s' AND amount > 100.5 AND id = $1"
+		plan := &domain.QueryPlan{Root: domain.QueryPlanNode{NodeType: "Seq Scan", RelationName: "orders"}}
+
+		database.EXPECT().ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				time.Sleep(time.Millisecond)
+				return &domain.QueryResult{Columns: []string{"id"}}, nil
+			})
+		database.EXPECT().ExplainQuery(gomock.Any(), query, false).Return(plan, nil)
+
+		var logged *domain.SlowQuery
+		logger.EXPECT().LogSlowQuery(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, slow *domain.SlowQuery) error {
+				logged = slow
+				return nil
+			})
+
+		_, err := slowUC.ExecuteQueryWithPagination(ctx, "alice", domain.QueryParams{Query: query})
+
+		require.NoError(t, err)
+		require.NotNil(t, logged)
+		require.Equal(t, "alice", logged.Username)
+		require.Equal(t, "SELECT * FROM orders WHERE region = $2 AND amount > $3 AND id = $1", logged.Statement)
+		require.GreaterOrEqual(t, logged.Duration, time.Millisecond)
+		require.Empty(t, logged.Error)
+		require.Equal(t, plan, logged.Plan)
+	})
+
+	t.Run("ExecuteQueryWithPagination logs a failed slow query without a plan when explain fails", func(t *testing.T) {
+		slowUC, database, logger := slowQueryUseCase(t, time.Nanosecond)
+		query := `SELECT "Total 2" FROM t1 WHERE note = E'a\'b' AND body = $tag$ 42 $tag$ AND n IN (1, 2e3)`
+
+		database.EXPECT().ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).Return(nil, errors.New("canceling statement due to statement timeout"))
+		database.EXPECT().ExplainQuery(gomock.Any(), query, false).Return(nil, errors.New("relation does not exist"))
+
+		var logged *domain.SlowQuery
+		logger.EXPECT().LogSlowQuery(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, slow *domain.SlowQuery) error {
+				logged = slow
+				return nil
+			})
+
+		_, err := slowUC.ExecuteQueryWithPagination(ctx, "alice", domain.QueryParams{Query: query})
+
+		require.Error(t, err)
+		require.NotNil(t, logged)
+		require.Equal(t, `SELECT "Total 2" FROM t1 WHERE note = $1 AND body = $2 AND n IN ($3, $4)`, logged.Statement)
+		require.Contains(t, logged.Error, "statement timeout")
+		require.Nil(t, logged.Plan)
+	})
+
+	t.Run("ExecuteQueryWithPagination does not log a query under the threshold", func(t *testing.T) {
+		slowUC, database, _ := slowQueryUseCase(t, time.Hour)
+		database.EXPECT().ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).Return(&domain.QueryResult{}, nil)
+
+		_, err := slowUC.ExecuteQueryWithPagination(ctx, "alice", domain.QueryParams{Query: "SELECT 1"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Queries and exports are kept for account activity without their constants", func(t *testing.T) {
+		activityCtrl := gomock.NewController(t)
+		database := mockRepository.NewMockDatabaseRepository(activityCtrl)
+		rbac := mockRepository.NewMockRBACRepository(activityCtrl)
+		config := mockRepository.NewMockConfigRepository(activityCtrl)
+		audit := mockRepository.NewMockAuditRepository(activityCtrl)
+		resultCache := mockRepository.NewMockResultCacheRepository(activityCtrl)
+		resultCache.EXPECT().StoreResult(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		activityUC := constructor(database, rbac, config, mockRepository.NewMockLoggerRepository(activityCtrl), audit, resultCache)
+
+		rbac.EXPECT().HasSelectPermission(gomock.Any(), "alice", "", "", "").Return(true, nil).Times(2)
+		config.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).AnyTimes()
+		database.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("invalid input syntax for type integer: \"hunter2\""))
+		database.EXPECT().
+			CopyQueryCSV(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(int64(2), nil)
+
+		var entries []*domain.AuditEntry
+		audit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				entries = append(entries, entry)
+				return nil
+			}).
+			Times(2)
+
+		_, err := activityUC.ExecuteQueryWithPagination(ctx, "alice", domain.QueryParams{Query: "SELECT * FROM users WHERE password = 'hunter2'"})
+		require.Error(t, err)
+		err = activityUC.ExportQueryCSV(ctx, "alice", domain.QueryParams{Query: "SELECT id FROM users WHERE id > 10"}, &bytes.Buffer{})
+		require.NoError(t, err)
+
+		require.Len(t, entries, 2)
+		require.Equal(t, "alice", entries[0].Username)
+		require.Equal(t, domain.AuditActionQuery, entries[0].Action)
+		require.Equal(t, "SELECT * FROM users WHERE password = $1", entries[0].After["statement"])
+		require.Equal(t, false, entries[0].After["succeeded"])
+		require.NotContains(t, fmt.Sprint(entries[0].After), "hunter2")
+
+		require.Equal(t, domain.AuditActionExport, entries[1].Action)
+		require.Equal(t, "SELECT id FROM users WHERE id > $1", entries[1].After["statement"])
+		require.Equal(t, 2, entries[1].After["rows"])
+	})
+
+	resultUseCase := func(t *testing.T) (usecase.QueryUseCase, *mockRepository.MockDatabaseRepository, *mockRepository.MockResultCacheRepository) {
+		resultCtrl := gomock.NewController(t)
+		database := mockRepository.NewMockDatabaseRepository(resultCtrl)
+		rbac := mockRepository.NewMockRBACRepository(resultCtrl)
+		config := mockRepository.NewMockConfigRepository(resultCtrl)
+		audit := mockRepository.NewMockAuditRepository(resultCtrl)
+		resultCache := mockRepository.NewMockResultCacheRepository(resultCtrl)
+		audit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		rbac.EXPECT().HasSelectPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+		config.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).AnyTimes()
+		return constructor(database, rbac, config, mockRepository.NewMockLoggerRepository(resultCtrl), audit, resultCache), database, resultCache
+	}
+
+	t.Run("ExecuteQueryWithPagination keeps the accessible window of a fresh run", func(t *testing.T) {
+		resultUC, database, resultCache := resultUseCase(t)
+		rows := make([]map[string]interface{}, 120)
+		for i := range rows {
+			rows[i] = map[string]interface{}{"id": int64(i)}
+		}
+
+		database.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, 0, params.Offset)
+				require.Equal(t, domain.ResultCacheRows, params.Limit)
+				return &domain.QueryResult{Columns: []string{"id"}, Rows: rows, RowCount: 120, TotalCount: 120}, nil
+			})
+		resultCache.EXPECT().
+			StoreResult(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, result *domain.CachedResult) error {
+				require.Equal(t, "alice", result.Username)
+				require.Len(t, result.Rows, 120)
+				result.ID = "result_1"
+				return nil
+			})
+
+		result, err := resultUC.ExecuteQueryWithPagination(ctx, "alice", domain.QueryParams{Query: "SELECT id FROM orders", Limit: 50})
+
+		require.NoError(t, err)
+		require.Equal(t, "result_1", result.ResultID)
+		require.Equal(t, int64(50), result.RowCount)
+		require.Len(t, result.Rows, 50)
+		require.Equal(t, int64(120), result.TotalCount)
+	})
+
+	t.Run("ExecuteQueryWithPagination does not keep later pages", func(t *testing.T) {
+		resultUC, database, _ := resultUseCase(t)
+		database.EXPECT().
+			ExecuteQueryWithPagination(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params domain.QueryParams) (*domain.QueryResult, error) {
+				require.Equal(t, 50, params.Limit)
+				return &domain.QueryResult{Columns: []string{"id"}, Rows: make([]map[string]interface{}, 50), RowCount: 50, TotalCount: 120}, nil
+			})
+
+		result, err := resultUC.ExecuteQueryWithPagination(ctx, "alice", domain.QueryParams{Query: "SELECT id FROM orders", Offset: 50, Limit: 50})
+
+		require.NoError(t, err)
+		require.Empty(t, result.ResultID)
+	})
+
+	cachedOrders := &domain.CachedResult{
+		ID:       "result_orders",
+		Username: "alice",
+		Query:    "SELECT id, customer, amount FROM orders",
+		Columns:  []string{"id", "customer", "amount"},
+		Rows: []map[string]interface{}{
+			{"id": int64(1), "customer": "Zoe", "amount": []byte("9.50")},
+			{"id": int64(2), "customer": "adam", "amount": nil},
+			{"id": int64(3), "customer": "Mia", "amount": []byte("120.00")},
+			{"id": int64(4), "customer": "Adele", "amount": []byte("15.25")},
+		},
+	}
+
+	t.Run("ViewResult sorts the kept rows without running the query", func(t *testing.T) {
+		resultUC, _, resultCache := resultUseCase(t)
+		resultCache.EXPECT().GetResult(gomock.Any(), "result_orders").Return(cachedOrders, nil).Times(2)
+
+		result, err := resultUC.ViewResult(ctx, "alice", "result_orders", domain.ResultView{SortColumn: "amount", SortDir: domain.SortDirectionASC, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, "result_orders", result.ResultID)
+		require.Equal(t, int64(4), result.TotalCount)
+		ids := make([]interface{}, 0, len(result.Rows))
+		for _, row := range result.Rows {
+			ids = append(ids, row["id"])
+		}
+		require.Equal(t, []interface{}{int64(1), int64(4), int64(3), int64(2)}, ids, "numbers sort numerically with NULL last")
+
+		result, err = resultUC.ViewResult(ctx, "alice", "result_orders", domain.ResultView{SortColumn: "amount", SortDir: domain.SortDirectionDESC, Offset: 1, Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), result.RowCount)
+		require.Equal(t, int64(3), result.Rows[0]["id"])
+		require.Equal(t, int64(4), result.Rows[1]["id"])
+
+		require.Equal(t, int64(1), cachedOrders.Rows[0]["id"], "the kept rows stay in the query's order")
+	})
+
+	t.Run("ViewResult filters the kept rows ignoring case", func(t *testing.T) {
+		resultUC, _, resultCache := resultUseCase(t)
+		resultCache.EXPECT().GetResult(gomock.Any(), "result_orders").Return(cachedOrders, nil)
+
+		result, err := resultUC.ViewResult(ctx, "alice", "result_orders", domain.ResultView{Filter: "AD", SortColumn: "customer"})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(2), result.TotalCount)
+		require.Equal(t, "Adele", result.Rows[0]["customer"])
+		require.Equal(t, "adam", result.Rows[1]["customer"])
+	})
+
+	t.Run("ViewResult refuses unknown columns and other users' results", func(t *testing.T) {
+		resultUC, _, resultCache := resultUseCase(t)
+		resultCache.EXPECT().GetResult(gomock.Any(), "result_orders").Return(cachedOrders, nil).Times(2)
+		resultCache.EXPECT().GetResult(gomock.Any(), "result_gone").Return(nil, domain.ErrResultNotFound)
+
+		_, err := resultUC.ViewResult(ctx, "alice", "result_orders", domain.ResultView{SortColumn: "secret"})
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "sort", validationErr.Field)
+
+		_, err = resultUC.ViewResult(ctx, "bob", "result_orders", domain.ResultView{})
+		require.True(t, errors.Is(err, domain.ErrResultNotFound))
+
+		_, err = resultUC.ViewResult(ctx, "alice", "result_gone", domain.ResultView{})
+		require.True(t, errors.Is(err, domain.ErrResultNotFound))
+	})
 }
@@ -16,6 +16,7 @@ import (
 type RBACUsecaseConstructor func(
 	metadataRepo repository.MetadataRepository,
 	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
 ) usecase.RBACUseCase
 
 // RBACUsecaseRunner runs all RBAC usecase tests against an implementation
@@ -32,8 +33,13 @@ func RBACUsecaseRunner(t *testing.T, constructor RBACUsecaseConstructor) {
 
 	mockMetadata := mockRepository.NewMockMetadataRepository(ctrl)
 	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
 
-	uc := constructor(mockMetadata, mockRBAC)
+	uc := constructor(mockMetadata, mockRBAC, mockConfig)
+
+	// Without export rules every table the user can SELECT from can be exported
+	config := &domain.AppConfig{}
+	mockConfig.EXPECT().GetConfig(gomock.Any()).Return(config, nil).AnyTimes()
 
 	ctx := context.Background()
 
@@ -283,6 +289,58 @@ func RBACUsecaseRunner(t *testing.T, constructor RBACUsecaseConstructor) {
 		require.NoError(t, err)
 		require.NotNil(t, perms)
 		require.True(t, perms.CanSelect)
+		require.True(t, perms.CanExport)
+	})
+
+	t.Run("CheckExportPermission takes SELECT and the most specific export rule", func(t *testing.T) {
+		config.ExportRules = []domain.ExportRule{
+			{Role: domain.ExportRuleWildcard, Table: domain.ExportRuleWildcard, Allow: false},
+			{Role: domain.ExportRuleWildcard, Table: "public.users", Allow: true},
+			{Role: "analyst", Table: domain.ExportRuleWildcard, Allow: true},
+			{Role: "analyst", Table: "public.salaries", Allow: false},
+		}
+		defer func() { config.ExportRules = nil }()
+
+		selectable := &domain.AccessibleTable{HasSelect: true}
+		cases := []struct {
+			username string
+			table    string
+			allowed  bool
+		}{
+			{"analyst", "orders", true},
+			{"analyst", "salaries", false},
+			{"clerk", "users", true},
+			{"clerk", "orders", false},
+		}
+		for _, c := range cases {
+			mockMetadata.EXPECT().GetTablePermissions(gomock.Any(), c.username, "testdb", "public", c.table).Return(selectable, nil)
+
+			allowed, err := uc.CheckExportPermission(ctx, c.username, "testdb", "public", c.table)
+			require.NoError(t, err)
+			require.Equal(t, c.allowed, allowed, "%s exporting %s", c.username, c.table)
+		}
+
+		// Export never goes beyond SELECT
+		mockMetadata.EXPECT().
+			GetTablePermissions(gomock.Any(), "analyst", "testdb", "public", "audit").
+			Return(&domain.AccessibleTable{HasSelect: false}, nil)
+		allowed, err := uc.CheckExportPermission(ctx, "analyst", "testdb", "public", "audit")
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
+
+	t.Run("VerifyUserPermissions shows an export the policy denies", func(t *testing.T) {
+		config.ExportRules = []domain.ExportRule{{Role: "testuser", Table: "public.users", Allow: false}}
+		defer func() { config.ExportRules = nil }()
+
+		mockMetadata.EXPECT().
+			GetTablePermissions(gomock.Any(), "testuser", "testdb", "public", "users").
+			Return(&domain.AccessibleTable{HasSelect: true}, nil)
+
+		perms, err := uc.VerifyUserPermissions(ctx, "testuser", "testdb", "public", "users")
+		require.NoError(t, err)
+		require.True(t, perms.CanSelect)
+		require.False(t, perms.CanExport)
 	})
 
 	// UC-S6-01: Session Isolation
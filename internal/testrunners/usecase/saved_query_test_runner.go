@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// SavedQueryUsecaseConstructor is a function type that creates a SavedQueryUseCase
+type SavedQueryUsecaseConstructor func(
+	savedQueryRepo repository.SavedQueryRepository,
+) usecase.SavedQueryUseCase
+
+// SavedQueryUsecaseRunner runs all saved query usecase tests against an implementation
+func SavedQueryUsecaseRunner(t *testing.T, constructor SavedQueryUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSavedQuery := mockRepository.NewMockSavedQueryRepository(ctrl)
+
+	uc := constructor(mockSavedQuery)
+
+	ctx := context.Background()
+
+	t.Run("SaveQuery normalizes name and tags", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			CreateSavedQuery(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query *domain.SavedQuery) error {
+				query.ID = "query_1"
+				return nil
+			})
+
+		saved, err := uc.SaveQuery(ctx, "testuser", "  Active users ", "SELECT * FROM users", []string{" Reports", "reports", "", "users"})
+
+		require.NoError(t, err)
+		require.Equal(t, "query_1", saved.ID)
+		require.Equal(t, "testuser", saved.Username)
+		require.Equal(t, "Active users", saved.Name)
+		require.Equal(t, []string{"reports", "users"}, saved.Tags)
+	})
+
+	t.Run("SaveQuery rejects empty name", func(t *testing.T) {
+		_, err := uc.SaveQuery(ctx, "testuser", "  ", "SELECT 1", nil)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "name", validationErr.Field)
+	})
+
+	t.Run("SaveQuery rejects empty query", func(t *testing.T) {
+		_, err := uc.SaveQuery(ctx, "testuser", "Empty", "   ", nil)
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "query", validationErr.Field)
+	})
+
+	t.Run("ListSavedQueries filters by tag", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			ListSavedQueries(gomock.Any(), "testuser").
+			Return([]domain.SavedQuery{
+				{ID: "query_1", Name: "Accounts", Tags: []string{"finance"}},
+				{ID: "query_2", Name: "Active users", Tags: []string{"reports", "users"}},
+			}, nil).
+			Times(2)
+
+		all, err := uc.ListSavedQueries(ctx, "testuser", "")
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+
+		tagged, err := uc.ListSavedQueries(ctx, "testuser", " Users ")
+		require.NoError(t, err)
+		require.Len(t, tagged, 1)
+		require.Equal(t, "query_2", tagged[0].ID)
+	})
+
+	t.Run("UpdateSavedQuery replaces fields", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			UpdateSavedQuery(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query *domain.SavedQuery) error {
+				require.Equal(t, "query_1", query.ID)
+				require.Equal(t, "testuser", query.Username)
+				return nil
+			})
+
+		saved, err := uc.UpdateSavedQuery(ctx, "testuser", "query_1", "Renamed", "SELECT 2", []string{"Misc"})
+
+		require.NoError(t, err)
+		require.Equal(t, "Renamed", saved.Name)
+		require.Equal(t, []string{"misc"}, saved.Tags)
+	})
+
+	t.Run("DeleteSavedQuery returns not found", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			DeleteSavedQuery(gomock.Any(), "testuser", "missing").
+			Return(domain.ErrSavedQueryNotFound)
+
+		err := uc.DeleteSavedQuery(ctx, "testuser", "missing")
+
+		require.ErrorIs(t, err, domain.ErrSavedQueryNotFound)
+	})
+
+	t.Run("SaveQuery records template variables", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			CreateSavedQuery(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		saved, err := uc.SaveQuery(ctx, "testuser", "Orders by user", "SELECT * FROM orders WHERE user_id = {{user_id:int}} AND status = {{status}} OR owner_id = {{user_id:int}}", nil)
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.TemplateVariable{
+			{Name: "user_id", Type: domain.TemplateVariableInt},
+			{Name: "status", Type: domain.TemplateVariableText},
+		}, saved.Variables)
+	})
+
+	t.Run("SaveQuery rejects conflicting variable types", func(t *testing.T) {
+		_, err := uc.SaveQuery(ctx, "testuser", "Broken", "SELECT {{id:int}}, {{id:date}}", nil)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "declared as both")
+	})
+
+	t.Run("BindTemplate binds typed values as parameters", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			GetSavedQuery(gomock.Any(), "testuser", "query_2").
+			Return(&domain.SavedQuery{
+				ID:    "query_2",
+				Query: "SELECT * FROM orders WHERE user_id = {{user_id:int}} AND placed_on >= {{since:date}} OR owner_id = {{ user_id : int }}",
+			}, nil)
+
+		query, args, err := uc.BindTemplate(ctx, "testuser", "query_2", map[string]string{"user_id": "42", "since": "2024-05-01"})
+
+		require.NoError(t, err)
+		require.Equal(t, "SELECT * FROM orders WHERE user_id = $1 AND placed_on >= $2 OR owner_id = $1", query)
+		require.Equal(t, int64(42), args[0])
+		require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), args[1])
+	})
+
+	t.Run("BindTemplate rejects values of the wrong type", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			GetSavedQuery(gomock.Any(), "testuser", "query_2").
+			Return(&domain.SavedQuery{ID: "query_2", Query: "SELECT * FROM orders WHERE user_id = {{user_id:int}}"}, nil)
+
+		_, _, err := uc.BindTemplate(ctx, "testuser", "query_2", map[string]string{"user_id": "1; DROP TABLE orders"})
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "user_id", validationErr.Field)
+	})
+
+	t.Run("BindTemplate requires every variable", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			GetSavedQuery(gomock.Any(), "testuser", "query_2").
+			Return(&domain.SavedQuery{ID: "query_2", Query: "SELECT * FROM orders WHERE user_id = {{user_id:int}}"}, nil)
+
+		_, _, err := uc.BindTemplate(ctx, "testuser", "query_2", map[string]string{})
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "user_id (int) is required")
+	})
+
+	t.Run("ExportWorkspace writes saved queries and preferences without owners or IDs", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			ListSavedQueries(gomock.Any(), "testuser").
+			Return([]domain.SavedQuery{
+				{ID: "query_1", Username: "testuser", Name: "Active users", Query: "SELECT * FROM users WHERE active", Tags: []string{"reports"}},
+			}, nil)
+
+		content, err := uc.ExportWorkspace(ctx, "testuser", []string{"reporting", "public"})
+
+		require.NoError(t, err)
+		var document map[string]interface{}
+		require.NoError(t, json.Unmarshal(content, &document))
+		require.Equal(t, float64(domain.WorkspaceFormatVersion), document["version"])
+		require.Equal(t, "testuser", document["exported_by"])
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Active users", "query": "SELECT * FROM users WHERE active", "tags": []interface{}{"reports"}},
+		}, document["saved_queries"])
+		require.Equal(t, map[string]interface{}{"search_path": []interface{}{"reporting", "public"}}, document["preferences"])
+		require.NotContains(t, string(content), "query_1")
+	})
+
+	t.Run("ImportWorkspace adds new queries, skips copies and renames clashes", func(t *testing.T) {
+		mockSavedQuery.EXPECT().
+			ListSavedQueries(gomock.Any(), "newuser").
+			Return([]domain.SavedQuery{
+				{ID: "query_9", Username: "newuser", Name: "Active users", Query: "SELECT * FROM users WHERE active"},
+				{ID: "query_10", Username: "newuser", Name: "Orders", Query: "SELECT * FROM orders"},
+			}, nil)
+
+		var created []*domain.SavedQuery
+		mockSavedQuery.EXPECT().
+			CreateSavedQuery(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query *domain.SavedQuery) error {
+				created = append(created, query)
+				return nil
+			}).
+			Times(2)
+
+		result, err := uc.ImportWorkspace(ctx, "newuser", []byte(`{
+			"version": 1,
+			"exported_by": "testuser",
+			"saved_queries": [
+				{"name": "Active users", "query": "SELECT * FROM users WHERE active"},
+				{"name": "Orders", "query": "SELECT * FROM orders WHERE total > {{min:int}}", "tags": ["Sales"]},
+				{"name": "Products", "query": "SELECT * FROM products"}
+			],
+			"preferences": {"search_path": ["reporting"]}
+		}`))
+
+		require.NoError(t, err)
+		require.Equal(t, 2, result.Imported)
+		require.Equal(t, 1, result.Renamed)
+		require.Equal(t, 1, result.Skipped)
+		require.Equal(t, []string{"reporting"}, result.SearchPath)
+		require.Len(t, created, 2)
+		require.Equal(t, "Orders (imported)", created[0].Name)
+		require.Equal(t, "newuser", created[0].Username)
+		require.Equal(t, []string{"sales"}, created[0].Tags)
+		require.Equal(t, []domain.TemplateVariable{{Name: "min", Type: "int"}}, created[0].Variables)
+		require.Equal(t, "Products", created[1].Name)
+	})
+
+	t.Run("ImportWorkspace imports nothing from a file with an invalid query", func(t *testing.T) {
+		_, err := uc.ImportWorkspace(ctx, "newuser", []byte(`{
+			"version": 1,
+			"saved_queries": [
+				{"name": "Products", "query": "SELECT * FROM products"},
+				{"name": "", "query": "SELECT 1"}
+			]
+		}`))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "saved query 2")
+	})
+
+	t.Run("ImportWorkspace rejects an unsupported version", func(t *testing.T) {
+		_, err := uc.ImportWorkspace(ctx, "newuser", []byte(`{"version": 2, "saved_queries": []}`))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported workspace version")
+	})
+}
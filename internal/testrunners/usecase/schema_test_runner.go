@@ -0,0 +1,907 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// SchemaUsecaseConstructor is a function type that creates a SchemaUseCase
+type SchemaUsecaseConstructor func(
+	databaseRepo repository.DatabaseRepository,
+	metadataRepo repository.MetadataRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+	configRepo repository.ConfigRepository,
+) usecase.SchemaUseCase
+
+// SchemaUsecaseRunner runs all schema usecase tests against an implementation
+func SchemaUsecaseRunner(t *testing.T, constructor SchemaUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockMetadata := mockRepository.NewMockMetadataRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+
+	uc := constructor(mockDatabase, mockMetadata, mockRBAC, mockAudit, mockConfig)
+
+	ctx := context.Background()
+
+	mockMetadata.EXPECT().
+		GetMetadata(gomock.Any(), "shop").
+		Return(&domain.DatabaseMetadata{
+			Name: "shop",
+			Schemas: []domain.SchemaMetadata{
+				{
+					Name: "public",
+					Tables: []domain.TableMetadata{
+						{
+							Name: "orders",
+							Columns: []domain.ColumnMetadata{
+								{Name: "id", DataType: "integer", IsPrimary: true},
+								{Name: "customer_id", DataType: "integer"},
+								{Name: "Placed At", DataType: "timestamp"},
+								{Name: "tags", DataType: "ARRAY"},
+							},
+						},
+						{
+							Name: "customers",
+							Columns: []domain.ColumnMetadata{
+								{Name: "id", DataType: "integer", IsPrimary: true},
+								{Name: "email", DataType: "text"},
+							},
+							PrimaryKeys: []string{"id"},
+						},
+					},
+				},
+			},
+		}, nil).
+		AnyTimes()
+
+	// Tests switch maintenance mode on through appConfig and back off when they finish
+	appConfig := &domain.AppConfig{}
+	mockConfig.EXPECT().GetConfig(gomock.Any()).Return(appConfig, nil).AnyTimes()
+
+	indexes := []domain.TableIndex{
+		{Name: "orders_customer_idx", Definition: "CREATE INDEX orders_customer_idx ON public.orders USING btree (customer_id)", Method: "btree", Valid: true, Scans: 12},
+		{Name: "orders_pkey", Definition: "CREATE UNIQUE INDEX orders_pkey ON public.orders USING btree (id)", Method: "btree", Unique: true, Primary: true, Valid: true, Constraint: "orders_pkey"},
+	}
+
+	t.Run("ListIndexes lists the indexes and whether the user may manage them", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableIndexes(gomock.Any(), "shop", "public", "orders").Return(indexes, nil)
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "alice", "shop", "public", "orders").Return(false, nil)
+
+		list, err := uc.ListIndexes(ctx, "alice", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Equal(t, indexes, list.Indexes)
+		require.False(t, list.CanManage)
+	})
+
+	t.Run("ListIndexes requires SELECT permission", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "salaries").Return(false, nil)
+
+		_, err := uc.ListIndexes(ctx, "alice", "shop", "public", "salaries")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	definition := domain.IndexDefinition{
+		Database:     "shop",
+		Schema:       "public",
+		Table:        "orders",
+		Name:         "orders_placed_idx",
+		Columns:      []string{"customer_id", "Placed At"},
+		Concurrently: true,
+	}
+	createStatement := `CREATE INDEX CONCURRENTLY "orders_placed_idx" ON "public"."orders" ("customer_id", "Placed At")`
+
+	t.Run("CreateIndex previews the generated statement without running it", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+
+		change, err := uc.CreateIndex(ctx, "owner", definition, "")
+
+		require.NoError(t, err)
+		require.Equal(t, createStatement, change.Statement)
+		require.False(t, change.Applied)
+	})
+
+	t.Run("CreateIndex runs and audits the confirmed statement", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), createStatement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "owner", entry.Username)
+				require.Equal(t, domain.AuditActionCreateIndex, entry.Action)
+				require.Equal(t, "public.orders", entry.Target)
+				require.Equal(t, createStatement, entry.After["statement"])
+				return nil
+			})
+
+		change, err := uc.CreateIndex(ctx, "owner", definition, createStatement)
+
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("CreateIndex refuses a confirmation for a different statement", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+
+		unique := definition
+		unique.Unique = true
+		_, err := uc.CreateIndex(ctx, "owner", unique, createStatement)
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "confirm", validationErr.Field)
+	})
+
+	t.Run("CreateIndex refuses the confirmed statement during maintenance mode", func(t *testing.T) {
+		appConfig.MaintenanceMode = true
+		appConfig.MaintenanceSince = time.Now()
+		defer func() { appConfig.MaintenanceMode = false }()
+
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockRBAC.EXPECT().IsSuperuser(gomock.Any(), "owner").Return(false, nil)
+
+		_, err := uc.CreateIndex(ctx, "owner", definition, createStatement)
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "maintenance", validationErr.Field)
+		require.Equal(t, domain.DefaultMaintenanceMessage, validationErr.Message)
+	})
+
+	t.Run("CreateIndex generates non-btree and unnamed indexes", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+
+		change, err := uc.CreateIndex(ctx, "owner", domain.IndexDefinition{
+			Database: "shop",
+			Schema:   "public",
+			Table:    "orders",
+			Columns:  []string{"tags"},
+			Method:   "GIN",
+		}, "")
+
+		require.NoError(t, err)
+		require.Equal(t, `CREATE INDEX ON "public"."orders" USING gin ("tags")`, change.Statement)
+	})
+
+	t.Run("CreateIndex rejects invalid definitions", func(t *testing.T) {
+		invalid := map[string]domain.IndexDefinition{
+			"columns": {Database: "shop", Schema: "public", Table: "orders"},
+			"method":  {Database: "shop", Schema: "public", Table: "orders", Columns: []string{"id"}, Method: "bloom"},
+			"unique":  {Database: "shop", Schema: "public", Table: "orders", Columns: []string{"id"}, Method: "hash", Unique: true},
+			"name":    {Database: "shop", Schema: "public", Table: "orders", Columns: []string{"id"}, Name: string(make([]byte, domain.MaxIdentifierLength+1))},
+		}
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(len(invalid) + 2)
+
+		for field, invalidDefinition := range invalid {
+			_, err := uc.CreateIndex(ctx, "owner", invalidDefinition, "")
+			validationErr, ok := err.(domain.ValidationError)
+			require.True(t, ok, field)
+			require.Equal(t, field, validationErr.Field)
+		}
+
+		_, err := uc.CreateIndex(ctx, "owner", domain.IndexDefinition{Database: "shop", Schema: "public", Table: "orders", Columns: []string{"missing"}}, "")
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Contains(t, validationErr.Message, "missing")
+
+		_, err = uc.CreateIndex(ctx, "owner", domain.IndexDefinition{Database: "shop", Schema: "public", Table: "orders", Columns: []string{"id", "id"}}, "")
+		validationErr, ok = err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "columns", validationErr.Field)
+	})
+
+	t.Run("CreateIndex is refused to users who do not own the table", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "alice", "shop", "public", "orders").Return(false, nil)
+
+		_, err := uc.CreateIndex(ctx, "alice", definition, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("DropIndex runs and audits the confirmed statement", func(t *testing.T) {
+		dropStatement := `DROP INDEX "public"."orders_customer_idx"`
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ListTableIndexes(gomock.Any(), "shop", "public", "orders").Return(indexes, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), dropStatement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionDropIndex, entry.Action)
+				require.Equal(t, indexes[0].Definition, entry.Before["definition"])
+				return nil
+			})
+
+		drop := domain.IndexDrop{Database: "shop", Schema: "public", Table: "orders", Name: "orders_customer_idx"}
+		preview, err := uc.DropIndex(ctx, "owner", drop, "")
+		require.NoError(t, err)
+		require.Equal(t, dropStatement, preview.Statement)
+		require.False(t, preview.Applied)
+
+		change, err := uc.DropIndex(ctx, "owner", drop, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("DropIndex refuses constraint indexes and indexes of other tables", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ListTableIndexes(gomock.Any(), "shop", "public", "orders").Return(indexes, nil).Times(2)
+
+		_, err := uc.DropIndex(ctx, "owner", domain.IndexDrop{Database: "shop", Schema: "public", Table: "orders", Name: "orders_pkey"}, "")
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Contains(t, validationErr.Message, "constraint orders_pkey")
+
+		_, err = uc.DropIndex(ctx, "owner", domain.IndexDrop{Database: "shop", Schema: "public", Table: "orders", Name: "customers_pkey"}, "")
+		validationErr, ok = err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "index", validationErr.Field)
+	})
+
+	t.Run("DropIndex reports a failed statement", func(t *testing.T) {
+		dropStatement := `DROP INDEX CONCURRENTLY "public"."orders_customer_idx"`
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableIndexes(gomock.Any(), "shop", "public", "orders").Return(indexes, nil)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), dropStatement).Return(nil, errors.New("lock timeout"))
+
+		_, err := uc.DropIndex(ctx, "owner", domain.IndexDrop{Database: "shop", Schema: "public", Table: "orders", Name: "orders_customer_idx", Concurrently: true}, dropStatement)
+
+		require.Error(t, err)
+	})
+
+	constraints := []domain.TableConstraint{
+		{Name: "orders_pkey", Type: domain.ConstraintTypePrimaryKey, Columns: []string{"id"}, Definition: "PRIMARY KEY (id)", Validated: true},
+		{Name: "orders_customer_id_fkey", Type: domain.ConstraintTypeForeignKey, Columns: []string{"customer_id"}, Definition: "FOREIGN KEY (customer_id) REFERENCES customers(id)",
+			ReferencedSchema: "public", ReferencedTable: "customers", ReferencedColumns: []string{"id"}, Validated: true},
+	}
+
+	t.Run("ListConstraints lists the constraints and whether the user may manage them", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableConstraints(gomock.Any(), "shop", "public", "orders").Return(constraints, nil)
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+
+		list, err := uc.ListConstraints(ctx, "owner", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Equal(t, constraints, list.Constraints)
+		require.True(t, list.CanManage)
+	})
+
+	t.Run("ListConstraints requires SELECT permission", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "salaries").Return(false, nil)
+
+		_, err := uc.ListConstraints(ctx, "alice", "shop", "public", "salaries")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	t.Run("AddConstraint generates each constraint type", func(t *testing.T) {
+		cases := map[string]domain.ConstraintDefinition{
+			`ALTER TABLE "public"."orders" ADD CONSTRAINT "orders_ref_key" UNIQUE ("customer_id", "Placed At")`: {
+				Database: "shop", Schema: "public", Table: "orders", Name: "orders_ref_key",
+				Type: domain.ConstraintTypeUnique, Columns: []string{"customer_id", "Placed At"},
+			},
+			`ALTER TABLE "public"."customers" ADD PRIMARY KEY ("id")`: {
+				Database: "shop", Schema: "public", Table: "customers",
+				Type: domain.ConstraintTypePrimaryKey, Columns: []string{"id"},
+			},
+			`ALTER TABLE "public"."orders" ADD CHECK (customer_id > 0)`: {
+				Database: "shop", Schema: "public", Table: "orders",
+				Type: domain.ConstraintTypeCheck, Expression: "  customer_id > 0 ",
+			},
+			`ALTER TABLE "public"."orders" ADD FOREIGN KEY ("customer_id") REFERENCES "public"."customers" ("id") ON DELETE SET NULL`: {
+				Database: "shop", Schema: "public", Table: "orders",
+				Type: domain.ConstraintTypeForeignKey, Columns: []string{"customer_id"},
+				ReferencedTable: "customers", OnDelete: "set null", OnUpdate: "NO ACTION",
+			},
+		}
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", gomock.Any()).Return(true, nil).Times(len(cases))
+
+		for statement, definition := range cases {
+			change, err := uc.AddConstraint(ctx, "owner", definition, "")
+			require.NoError(t, err)
+			require.Equal(t, statement, change.Statement)
+			require.False(t, change.Applied)
+		}
+	})
+
+	t.Run("AddConstraint runs and audits the confirmed statement", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders" ADD CONSTRAINT "orders_customer_check" CHECK (customer_id > 0)`
+		definition := domain.ConstraintDefinition{
+			Database: "shop", Schema: "public", Table: "orders", Name: "orders_customer_check",
+			Type: domain.ConstraintTypeCheck, Expression: "customer_id > 0",
+		}
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionAddConstraint, entry.Action)
+				require.Equal(t, "public.orders", entry.Target)
+				require.Equal(t, statement, entry.After["statement"])
+				return nil
+			})
+
+		change, err := uc.AddConstraint(ctx, "owner", definition, statement)
+
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("AddConstraint rejects invalid definitions", func(t *testing.T) {
+		invalid := map[string]domain.ConstraintDefinition{
+			"type":       {Database: "shop", Schema: "public", Table: "orders", Type: "exclusion"},
+			"columns":    {Database: "shop", Schema: "public", Table: "orders", Type: domain.ConstraintTypeUnique},
+			"expression": {Database: "shop", Schema: "public", Table: "orders", Type: domain.ConstraintTypeCheck, Expression: "true); DROP TABLE orders; --"},
+			"referenced_table": {Database: "shop", Schema: "public", Table: "orders", Type: domain.ConstraintTypeForeignKey,
+				Columns: []string{"customer_id"}, ReferencedTable: "vendors"},
+			"referenced_columns": {Database: "shop", Schema: "public", Table: "orders", Type: domain.ConstraintTypeForeignKey,
+				Columns: []string{"id", "customer_id"}, ReferencedTable: "customers"},
+			"on_delete": {Database: "shop", Schema: "public", Table: "orders", Type: domain.ConstraintTypeForeignKey,
+				Columns: []string{"customer_id"}, ReferencedTable: "customers", OnDelete: "explode"},
+		}
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(len(invalid))
+
+		for field, invalidDefinition := range invalid {
+			_, err := uc.AddConstraint(ctx, "owner", invalidDefinition, "")
+			validationErr, ok := err.(domain.ValidationError)
+			require.True(t, ok, field)
+			require.Equal(t, field, validationErr.Field)
+		}
+	})
+
+	t.Run("AddConstraint is refused to users who do not own the table", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "alice", "shop", "public", "orders").Return(false, nil)
+
+		_, err := uc.AddConstraint(ctx, "alice", domain.ConstraintDefinition{Database: "shop", Schema: "public", Table: "orders", Type: domain.ConstraintTypeUnique, Columns: []string{"id"}}, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("DropConstraint runs and audits the confirmed statement", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders" DROP CONSTRAINT "orders_customer_id_fkey"`
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ListTableConstraints(gomock.Any(), "shop", "public", "orders").Return(constraints, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionDropConstraint, entry.Action)
+				require.Equal(t, constraints[1].Definition, entry.Before["definition"])
+				return nil
+			})
+
+		drop := domain.ConstraintDrop{Database: "shop", Schema: "public", Table: "orders", Name: "orders_customer_id_fkey"}
+		preview, err := uc.DropConstraint(ctx, "owner", drop, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+		require.False(t, preview.Applied)
+
+		change, err := uc.DropConstraint(ctx, "owner", drop, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("DropConstraint refuses constraints of other tables", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableConstraints(gomock.Any(), "shop", "public", "orders").Return(constraints, nil)
+
+		_, err := uc.DropConstraint(ctx, "owner", domain.ConstraintDrop{Database: "shop", Schema: "public", Table: "orders", Name: "customers_pkey"}, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "constraint", validationErr.Field)
+	})
+
+	triggers := []domain.TableTrigger{
+		{Name: "orders_audit", Timing: domain.TriggerTimingAfter, Events: []string{"INSERT", "UPDATE"}, ForEachRow: true,
+			FunctionSchema: "public", FunctionName: "record_order_change", Enabled: true},
+		{Name: "orders_touch", Timing: domain.TriggerTimingBefore, Events: []string{"UPDATE"}, ForEachRow: true,
+			FunctionSchema: "public", FunctionName: "touch_updated_at"},
+	}
+
+	t.Run("ListTriggers reports whether the user may toggle them", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "reader", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableTriggers(gomock.Any(), "shop", "public", "orders").Return(triggers, nil)
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "reader", "shop", "public", "orders").Return(false, nil)
+
+		list, err := uc.ListTriggers(ctx, "reader", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Len(t, list.Triggers, 2)
+		require.False(t, list.CanManage)
+	})
+
+	t.Run("ListTriggers requires SELECT permission", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "stranger", "shop", "public", "orders").Return(false, nil)
+
+		_, err := uc.ListTriggers(ctx, "stranger", "shop", "public", "orders")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	t.Run("SetTriggerEnabled previews, then runs and audits the confirmed statement", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders" DISABLE TRIGGER "orders_audit"`
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ListTableTriggers(gomock.Any(), "shop", "public", "orders").Return(triggers, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionDisableTrigger, entry.Action)
+				require.Equal(t, true, entry.Before["enabled"])
+				return nil
+			})
+
+		toggle := domain.TriggerToggle{Database: "shop", Schema: "public", Table: "orders", Name: "orders_audit"}
+		preview, err := uc.SetTriggerEnabled(ctx, "owner", toggle, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+		require.False(t, preview.Applied)
+
+		change, err := uc.SetTriggerEnabled(ctx, "owner", toggle, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("SetTriggerEnabled generates ENABLE TRIGGER and requires ownership", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableTriggers(gomock.Any(), "shop", "public", "orders").Return(triggers, nil)
+
+		preview, err := uc.SetTriggerEnabled(ctx, "owner", domain.TriggerToggle{Database: "shop", Schema: "public", Table: "orders", Name: "orders_touch", Enable: true}, "")
+		require.NoError(t, err)
+		require.Equal(t, `ALTER TABLE "public"."orders" ENABLE TRIGGER "orders_touch"`, preview.Statement)
+
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "reader", "shop", "public", "orders").Return(false, nil)
+
+		_, err = uc.SetTriggerEnabled(ctx, "reader", domain.TriggerToggle{Database: "shop", Schema: "public", Table: "orders", Name: "orders_touch"}, "")
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("SetTriggerEnabled refuses triggers of other tables", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().ListTableTriggers(gomock.Any(), "shop", "public", "orders").Return(triggers, nil)
+
+		_, err := uc.SetTriggerEnabled(ctx, "owner", domain.TriggerToggle{Database: "shop", Schema: "public", Table: "orders", Name: "customers_audit"}, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "trigger", validationErr.Field)
+	})
+
+	t.Run("GetTableDDL reconstructs columns, constraints, indexes and comments", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "reader", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().
+			GetTableDefinition(gomock.Any(), "shop", "public", "orders").
+			Return(&domain.TableDefinition{
+				Comment: "Customer's orders",
+				Columns: []domain.TableColumnDefinition{
+					{Name: "id", Type: "bigint", NotNull: true, Identity: "ALWAYS"},
+					{Name: "code", Type: "text", Collation: `"C"`, Comment: "External code"},
+					{Name: "total", Type: "numeric(10,2)", NotNull: true, Default: "0"},
+					{Name: "tax", Type: "numeric", Generated: "(total * 0.2)"},
+				},
+			}, nil)
+		mockDatabase.EXPECT().
+			ListTableConstraints(gomock.Any(), "shop", "public", "orders").
+			Return([]domain.TableConstraint{
+				{Name: "orders_pkey", Type: domain.ConstraintTypePrimaryKey, Definition: "PRIMARY KEY (id)"},
+				{Name: "orders_total_check", Type: domain.ConstraintTypeCheck, Definition: "CHECK ((total >= (0)::numeric))"},
+			}, nil)
+		mockDatabase.EXPECT().
+			ListTableIndexes(gomock.Any(), "shop", "public", "orders").
+			Return([]domain.TableIndex{
+				{Name: "orders_code_idx", Definition: "CREATE INDEX orders_code_idx ON public.orders USING btree (code)", Valid: true},
+				{Name: "orders_pkey", Definition: "CREATE UNIQUE INDEX orders_pkey ON public.orders USING btree (id)", Valid: true, Primary: true, Constraint: "orders_pkey"},
+				{Name: "orders_broken_idx", Definition: "CREATE INDEX orders_broken_idx ON public.orders USING btree (tax)", Valid: false},
+			}, nil)
+
+		ddl, err := uc.GetTableDDL(ctx, "reader", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Equal(t, `CREATE TABLE "public"."orders" (
+    "id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL,
+    "code" text COLLATE "C",
+    "total" numeric(10,2) DEFAULT 0 NOT NULL,
+    "tax" numeric GENERATED ALWAYS AS ((total * 0.2)) STORED,
+    CONSTRAINT "orders_pkey" PRIMARY KEY (id),
+    CONSTRAINT "orders_total_check" CHECK ((total >= (0)::numeric))
+);
+
+CREATE INDEX orders_code_idx ON public.orders USING btree (code);
+
+COMMENT ON TABLE "public"."orders" IS 'Customer''s orders';
+COMMENT ON COLUMN "public"."orders"."code" IS 'External code';
+`, ddl.Statement)
+	})
+
+	t.Run("GetTableDDL requires SELECT permission", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "stranger", "shop", "public", "orders").Return(false, nil)
+
+		_, err := uc.GetTableDDL(ctx, "stranger", "shop", "public", "orders")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	ordersTable := &domain.TableMetadata{
+		Name: "orders",
+		Columns: []domain.ColumnMetadata{
+			{Name: "id", DataType: "integer", IsPrimary: true},
+			{Name: "customer_id", DataType: "integer"},
+			{Name: "Placed At", DataType: "timestamp without time zone"},
+			{Name: "total", DataType: "text", IsNullable: true},
+		},
+	}
+
+	t.Run("AlterTable batches column changes and splits renames into their own statement", func(t *testing.T) {
+		statement := `ALTER TABLE "public"."orders"
+    ADD COLUMN "status" text DEFAULT 'draft' NOT NULL,
+    ALTER COLUMN "total" TYPE numeric(12, 2) USING total::numeric,
+    ALTER COLUMN "total" SET NOT NULL;
+ALTER TABLE "public"."orders" RENAME COLUMN "Placed At" TO "placed_at";
+ALTER TABLE "public"."orders"
+    ALTER COLUMN "placed_at" SET DEFAULT now(),
+    DROP CONSTRAINT "orders_pkey",
+    ADD PRIMARY KEY ("id", "customer_id")`
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().GetTableMetadata(gomock.Any(), "shop", "public", "orders").Return(ordersTable, nil).Times(2)
+		mockDatabase.EXPECT().ListTableConstraints(gomock.Any(), "shop", "public", "orders").Return(constraints, nil).Times(2)
+		placedAtView := domain.ColumnDependent{
+			Kind: domain.ColumnDependentView, Schema: "reporting", Name: "daily_orders", Column: "Placed At",
+			Definition: `SELECT date_trunc('day', orders."Placed At") AS day FROM orders`,
+		}
+		mockDatabase.EXPECT().
+			GetColumnDependents(gomock.Any(), "shop", "public", "orders", "Placed At").
+			Return([]domain.ColumnDependent{placedAtView}, nil).
+			Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockMetadata.EXPECT().InvalidateMetadata(gomock.Any(), "shop").Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionAlterTable, entry.Action)
+				require.Equal(t, "public.orders", entry.Target)
+				require.Equal(t, []string{"id", "customer_id", "Placed At", "total"}, entry.Before["columns"])
+				return nil
+			})
+
+		alteration := domain.TableAlteration{Database: "shop", Schema: "public", Table: "orders", Changes: []domain.ColumnChange{
+			{Operation: domain.ColumnOperationAdd, Column: "status", DataType: "text", Default: "'draft'", NotNull: true},
+			{Operation: domain.ColumnOperationSetType, Column: "total", DataType: "numeric(12,  2)", Using: "total::numeric"},
+			{Operation: domain.ColumnOperationSetNotNull, Column: "total"},
+			{Operation: domain.ColumnOperationRename, Column: "Placed At", NewName: "placed_at"},
+			{Operation: domain.ColumnOperationSetDefault, Column: "placed_at", Default: "now()"},
+			{Operation: domain.ColumnOperationSetPrimaryKey, Columns: []string{"id", "customer_id"}},
+		}}
+		preview, err := uc.AlterTable(ctx, "owner", alteration, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+		require.False(t, preview.Applied)
+		require.Equal(t, []domain.ColumnDependent{placedAtView}, preview.Impact)
+
+		change, err := uc.AlterTable(ctx, "owner", alteration, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("AlterTable previews the objects referencing renamed and dropped columns", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().GetTableMetadata(gomock.Any(), "shop", "public", "orders").Return(ordersTable, nil)
+		totalIndex := domain.ColumnDependent{Kind: domain.ColumnDependentIndex, Schema: "public", Name: "orders_total_idx", Column: "total"}
+		totalFunction := domain.ColumnDependent{Kind: domain.ColumnDependentFunction, Schema: "public", Name: "order_total(integer)", Column: "total", Inferred: true}
+		// total is renamed and the new name dropped, so it is looked up once
+		mockDatabase.EXPECT().
+			GetColumnDependents(gomock.Any(), "shop", "public", "orders", "total").
+			Return([]domain.ColumnDependent{totalIndex, totalFunction}, nil)
+		mockDatabase.EXPECT().
+			GetColumnDependents(gomock.Any(), "shop", "public", "orders", "customer_id").
+			Return(nil, nil)
+
+		preview, err := uc.AlterTable(ctx, "owner", domain.TableAlteration{Database: "shop", Schema: "public", Table: "orders", Changes: []domain.ColumnChange{
+			{Operation: domain.ColumnOperationRename, Column: "total", NewName: "amount"},
+			{Operation: domain.ColumnOperationDrop, Column: "amount"},
+			{Operation: domain.ColumnOperationDrop, Column: "customer_id"},
+			{Operation: domain.ColumnOperationSetNotNull, Column: "id"},
+		}}, "")
+		require.NoError(t, err)
+		require.False(t, preview.Applied)
+		require.Equal(t, []domain.ColumnDependent{totalIndex, totalFunction}, preview.Impact)
+	})
+
+	t.Run("AlterTable checks each change against the table as earlier changes leave it", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "owner", "shop", "public", "orders").Return(true, nil).AnyTimes()
+		mockDatabase.EXPECT().GetTableMetadata(gomock.Any(), "shop", "public", "orders").Return(ordersTable, nil).AnyTimes()
+
+		for name, changes := range map[string][]domain.ColumnChange{
+			"unknown column":        {{Operation: domain.ColumnOperationDrop, Column: "missing"}},
+			"dropped then used":     {{Operation: domain.ColumnOperationDrop, Column: "total"}, {Operation: domain.ColumnOperationSetNotNull, Column: "total"}},
+			"old name after rename": {{Operation: domain.ColumnOperationRename, Column: "total", NewName: "amount"}, {Operation: domain.ColumnOperationDropDefault, Column: "total"}},
+			"existing column added": {{Operation: domain.ColumnOperationAdd, Column: "id", DataType: "integer"}},
+			"type with SQL":         {{Operation: domain.ColumnOperationSetType, Column: "total", DataType: "text; DROP TABLE orders"}},
+			"default with SQL":      {{Operation: domain.ColumnOperationSetDefault, Column: "total", Default: "0; DROP TABLE orders"}},
+			"unknown operation":     {{Operation: "truncate", Column: "total"}},
+		} {
+			_, err := uc.AlterTable(ctx, "owner", domain.TableAlteration{Database: "shop", Schema: "public", Table: "orders", Changes: changes}, "")
+			var validationErr domain.ValidationError
+			require.ErrorAs(t, err, &validationErr, name)
+		}
+
+		_, err := uc.AlterTable(ctx, "owner", domain.TableAlteration{Database: "shop", Schema: "public", Table: "orders"}, "")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "changes", validationErr.Field)
+	})
+
+	t.Run("AlterTable is refused to users who do not own the table", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "viewer", "shop", "public", "orders").Return(false, nil)
+
+		_, err := uc.AlterTable(ctx, "viewer", domain.TableAlteration{Database: "shop", Schema: "public", Table: "orders", Changes: []domain.ColumnChange{
+			{Operation: domain.ColumnOperationDrop, Column: "total"},
+		}}, "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("CreateTable previews, then runs and audits the designed table", func(t *testing.T) {
+		statement := `CREATE TABLE "public"."invoices" (
+    "id" bigint NOT NULL,
+    "issued_at" timestamp(3) with time zone DEFAULT now(),
+    "lines" text[],
+    PRIMARY KEY ("id")
+)`
+		mockRBAC.EXPECT().HasSchemaCreatePermission(gomock.Any(), "owner", "shop", "public").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().GetTableMetadata(gomock.Any(), "shop", "public", "invoices").Return(nil, domain.ErrTableNotFound).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockMetadata.EXPECT().InvalidateMetadata(gomock.Any(), "shop").Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionCreateTable, entry.Action)
+				require.Equal(t, "public.invoices", entry.Target)
+				return nil
+			})
+
+		creation := domain.TableCreation{Database: "shop", Schema: "public", Table: "invoices",
+			Columns: []domain.ColumnDesign{
+				{Name: "id", DataType: "bigint", NotNull: true},
+				{Name: "issued_at", DataType: "timestamp(3) with time zone", Default: "now()"},
+				{Name: "lines", DataType: "text[]"},
+			},
+			PrimaryKey: []string{"id"},
+		}
+		preview, err := uc.CreateTable(ctx, "owner", creation, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+
+		change, err := uc.CreateTable(ctx, "owner", creation, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("CreateTable requires CREATE on the schema and a new table name", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSchemaCreatePermission(gomock.Any(), "viewer", "shop", "public").Return(false, nil)
+
+		creation := domain.TableCreation{Database: "shop", Schema: "public", Table: "orders",
+			Columns: []domain.ColumnDesign{{Name: "id", DataType: "integer"}}}
+		_, err := uc.CreateTable(ctx, "viewer", creation, "")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+
+		mockRBAC.EXPECT().HasSchemaCreatePermission(gomock.Any(), "owner", "shop", "public").Return(true, nil)
+		_, err = uc.CreateTable(ctx, "owner", creation, "")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	t.Run("CreateDatabase previews, then runs, audits and refreshes the creator's resources", func(t *testing.T) {
+		statement := `CREATE DATABASE "reports" OWNER "analyst" ENCODING 'UTF8' TEMPLATE "template0"`
+		mockRBAC.EXPECT().CanCreateDatabase(gomock.Any(), "admin").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionCreateDatabase, entry.Action)
+				require.Equal(t, "reports", entry.Target)
+				return nil
+			})
+		adminMetadata := &domain.RoleMetadata{Name: "admin", AccessibleDatabases: []string{"shop", "reports"}}
+		analystMetadata := &domain.RoleMetadata{Name: "analyst", AccessibleDatabases: []string{"reports"}}
+		mockRBAC.EXPECT().GetRoleMetadata(gomock.Any(), "admin").Return(adminMetadata, nil)
+		mockRBAC.EXPECT().GetRoleMetadata(gomock.Any(), "analyst").Return(analystMetadata, nil)
+		mockMetadata.EXPECT().StoreRoleMetadata(gomock.Any(), "admin", adminMetadata).Return(nil)
+		mockMetadata.EXPECT().StoreRoleMetadata(gomock.Any(), "analyst", analystMetadata).Return(nil)
+
+		creation := domain.DatabaseCreation{Name: " reports ", Owner: "analyst", Encoding: "utf8", Template: "template0"}
+		preview, err := uc.CreateDatabase(ctx, "admin", creation, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+		require.False(t, preview.Applied)
+
+		change, err := uc.CreateDatabase(ctx, "admin", creation, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("CreateDatabase requires CREATEDB and a valid name and encoding", func(t *testing.T) {
+		mockRBAC.EXPECT().CanCreateDatabase(gomock.Any(), "viewer").Return(false, nil)
+		_, err := uc.CreateDatabase(ctx, "viewer", domain.DatabaseCreation{Name: "reports"}, "")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+
+		mockRBAC.EXPECT().CanCreateDatabase(gomock.Any(), "admin").Return(true, nil).Times(3)
+		_, err = uc.CreateDatabase(ctx, "admin", domain.DatabaseCreation{Name: "  "}, "")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "name", validationErr.Field)
+
+		_, err = uc.CreateDatabase(ctx, "admin", domain.DatabaseCreation{Name: strings.Repeat("r", domain.MaxIdentifierLength+1)}, "")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "name", validationErr.Field)
+
+		_, err = uc.CreateDatabase(ctx, "admin", domain.DatabaseCreation{Name: "reports", Encoding: "UTF8'; DROP DATABASE shop; --"}, "")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "encoding", validationErr.Field)
+	})
+
+	t.Run("CreateSchema previews, then runs, audits and refreshes the database and creator", func(t *testing.T) {
+		statement := `CREATE SCHEMA "staging" AUTHORIZATION "owner"`
+		mockRBAC.EXPECT().HasDatabaseCreatePermission(gomock.Any(), "owner", "shop").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionCreateSchema, entry.Action)
+				require.Equal(t, "shop.staging", entry.Target)
+				return nil
+			})
+		mockMetadata.EXPECT().InvalidateMetadata(gomock.Any(), "shop").Return(nil)
+		ownerMetadata := &domain.RoleMetadata{Name: "owner", AccessibleSchemas: []string{"public", "staging"}}
+		mockRBAC.EXPECT().GetRoleMetadata(gomock.Any(), "owner").Return(ownerMetadata, nil)
+		mockMetadata.EXPECT().StoreRoleMetadata(gomock.Any(), "owner", ownerMetadata).Return(nil)
+
+		creation := domain.SchemaCreation{Database: "shop", Name: "staging", Owner: "owner"}
+		preview, err := uc.CreateSchema(ctx, "owner", creation, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+
+		change, err := uc.CreateSchema(ctx, "owner", creation, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("CreateSchema requires CREATE on the database and keeps a failed refresh quiet", func(t *testing.T) {
+		mockRBAC.EXPECT().HasDatabaseCreatePermission(gomock.Any(), "viewer", "shop").Return(false, nil)
+		_, err := uc.CreateSchema(ctx, "viewer", domain.SchemaCreation{Database: "shop", Name: "staging"}, "")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+
+		statement := `CREATE SCHEMA "archive"`
+		mockRBAC.EXPECT().HasDatabaseCreatePermission(gomock.Any(), "owner", "shop").Return(true, nil)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+		mockMetadata.EXPECT().InvalidateMetadata(gomock.Any(), "shop").Return(errors.New("cache unavailable"))
+		mockRBAC.EXPECT().GetRoleMetadata(gomock.Any(), "owner").Return(nil, errors.New("connection refused"))
+
+		change, err := uc.CreateSchema(ctx, "owner", domain.SchemaCreation{Database: "shop", Name: "archive"}, statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	commentedTable := &domain.TableDefinition{
+		Comment: "Customer orders",
+		Columns: []domain.TableColumnDefinition{
+			{Name: "id", Type: "bigint", NotNull: true},
+			{Name: "status", Type: "text", Comment: "new, paid or shipped"},
+		},
+	}
+
+	t.Run("ListComments returns the table and column comments and whether they are editable", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().GetTableDefinition(gomock.Any(), "shop", "public", "orders").Return(commentedTable, nil)
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "alice", "shop", "public", "orders").Return(false, nil)
+
+		comments, err := uc.ListComments(ctx, "alice", "shop", "public", "orders")
+		require.NoError(t, err)
+		require.Equal(t, "Customer orders", comments.Comment)
+		require.Equal(t, []domain.ColumnComment{
+			{Name: "id", Type: "bigint"},
+			{Name: "status", Type: "text", Comment: "new, paid or shipped"},
+		}, comments.Columns)
+		require.False(t, comments.CanEdit)
+	})
+
+	t.Run("ListComments requires SELECT on the table", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "salaries").Return(false, nil)
+
+		_, err := uc.ListComments(ctx, "alice", "shop", "public", "salaries")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	t.Run("SetComment previews, then runs and audits a column comment", func(t *testing.T) {
+		statement := `COMMENT ON COLUMN "public"."orders"."status" IS 'new, paid, shipped or the customer''s return'`
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "editor", "shop", "public", "orders").Return(true, nil).Times(2)
+		mockDatabase.EXPECT().GetTableDefinition(gomock.Any(), "shop", "public", "orders").Return(commentedTable, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionSetComment, entry.Action)
+				require.Equal(t, "public.orders.status", entry.Target)
+				require.Equal(t, "new, paid or shipped", entry.Before["comment"])
+				return nil
+			})
+
+		change := domain.CommentChange{Database: "shop", Schema: "public", Table: "orders", Column: "status",
+			Comment: " new, paid, shipped or the customer's return "}
+		preview, err := uc.SetComment(ctx, "editor", change, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+		require.False(t, preview.Applied)
+
+		applied, err := uc.SetComment(ctx, "editor", change, statement)
+		require.NoError(t, err)
+		require.True(t, applied.Applied)
+	})
+
+	t.Run("SetComment removes a table comment when the comment is empty", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "editor", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().GetTableDefinition(gomock.Any(), "shop", "public", "orders").Return(commentedTable, nil)
+
+		preview, err := uc.SetComment(ctx, "editor", domain.CommentChange{Database: "shop", Schema: "public", Table: "orders"}, "")
+		require.NoError(t, err)
+		require.Equal(t, `COMMENT ON TABLE "public"."orders" IS NULL`, preview.Statement)
+	})
+
+	t.Run("SetComment requires the table owner and an existing column", func(t *testing.T) {
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "viewer", "shop", "public", "orders").Return(false, nil)
+		_, err := uc.SetComment(ctx, "viewer", domain.CommentChange{Database: "shop", Schema: "public", Table: "orders", Comment: "x"}, "")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+
+		mockRBAC.EXPECT().IsTableOwner(gomock.Any(), "editor", "shop", "public", "orders").Return(true, nil)
+		mockDatabase.EXPECT().GetTableDefinition(gomock.Any(), "shop", "public", "orders").Return(commentedTable, nil)
+		_, err = uc.SetComment(ctx, "editor", domain.CommentChange{Database: "shop", Schema: "public", Table: "orders", Column: "missing", Comment: "x"}, "")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "column", validationErr.Field)
+	})
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"expvar"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ type SecurityUsecaseConstructor func(
 	encryptionRepo repository.EncryptionRepository,
 	sessionRepo repository.SessionRepository,
 	clockRepo repository.ClockRepository,
+	auditRepo repository.AuditRepository,
 ) usecase.SecurityUseCase
 
 // SecurityUsecaseRunner runs all security usecase tests against an implementation
@@ -32,8 +34,9 @@ func SecurityUsecaseRunner(t *testing.T, constructor SecurityUsecaseConstructor)
 	mockEncryption := mockRepository.NewMockEncryptionRepository(ctrl)
 	mockSession := mockRepository.NewMockSessionRepository(ctrl)
 	mockClock := mockRepository.NewMockClockRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
 
-	uc := constructor(mockEncryption, mockSession, mockClock)
+	uc := constructor(mockEncryption, mockSession, mockClock, mockAudit)
 
 	ctx := context.Background()
 
@@ -148,10 +151,25 @@ func SecurityUsecaseRunner(t *testing.T, constructor SecurityUsecaseConstructor)
 			ValidateSignature(gomock.Any(), "SELECT * FROM users; DROP TABLE users; --", gomock.Any()).
 			Return(false, nil)
 
-		hasInjection, err := uc.ValidateQueryForInjection(ctx, "SELECT * FROM users; DROP TABLE users; --")
+		var recorded *domain.AuditEntry
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entry *domain.AuditEntry) error {
+				recorded = entry
+				return nil
+			})
+
+		routed := domain.WithRequestOrigin(ctx, domain.RequestOrigin{Method: "POST", Route: "/query"})
+		hasInjection, err := uc.ValidateQueryForInjection(routed, "SELECT * FROM users; DROP TABLE users; --")
 
 		require.NoError(t, err)
 		require.True(t, hasInjection)
+		require.Equal(t, domain.AuditActionRequestRejected, recorded.Action)
+		require.Equal(t, "sql_validator:line_comment", recorded.Target)
+		require.Equal(t, "/query", recorded.After["route"])
+
+		counts := expvar.Get(domain.RejectionMetricsName).(*expvar.Map)
+		require.Equal(t, "1", counts.Get("sql_validator:line_comment:/query").String())
 	})
 
 	t.Run("ValidateQueryForInjection accepts safe queries", func(t *testing.T) {
@@ -275,6 +293,11 @@ func SecurityUsecaseRunner(t *testing.T, constructor SecurityUsecaseConstructor)
 			mockEncryption.EXPECT().
 				ValidateSignature(gomock.Any(), tc.input, gomock.Any()).
 				Return(!tc.expectInjection, nil)
+			if tc.expectInjection {
+				mockAudit.EXPECT().
+					RecordEntry(gomock.Any(), gomock.Any()).
+					Return(nil)
+			}
 
 			hasInjection, err := uc.ValidateQueryForInjection(ctx, tc.input)
 
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// SequenceUsecaseConstructor is a function type that creates a SequenceUseCase
+type SequenceUsecaseConstructor func(
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+) usecase.SequenceUseCase
+
+// SequenceUsecaseRunner runs all sequence usecase tests against an implementation
+func SequenceUsecaseRunner(t *testing.T, constructor SequenceUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+
+	uc := constructor(mockDatabase, mockRBAC, mockAudit)
+
+	ctx := context.Background()
+
+	lastValue := int64(41)
+	sequences := []domain.Sequence{
+		{Schema: "public", Name: "invoice_number_seq", DataType: "bigint", StartValue: 1000, Increment: 1, MinValue: 1, MaxValue: 9999},
+		{Schema: "public", Name: "orders_id_seq", DataType: "integer", LastValue: &lastValue, StartValue: 1, Increment: 1, MinValue: 1, MaxValue: 2147483647,
+			OwnedByTable: "orders", OwnedByColumn: "id"},
+		{Schema: "public", Name: "payroll_seq", DataType: "bigint", StartValue: 1, Increment: 1, MinValue: 1, MaxValue: 9223372036854775807},
+	}
+
+	t.Run("ListSequences shows the sequences the user holds privileges on", func(t *testing.T) {
+		mockDatabase.EXPECT().ListSequences(gomock.Any(), "shop", "public").Return(sequences, nil)
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "alice", "shop", "public", "invoice_number_seq").
+			Return(&domain.SequencePermissions{CanSelect: true, CanUsage: true, CanUpdate: true, IsOwner: true}, nil)
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "alice", "shop", "public", "orders_id_seq").
+			Return(&domain.SequencePermissions{CanUsage: true}, nil)
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "alice", "shop", "public", "payroll_seq").
+			Return(&domain.SequencePermissions{}, nil)
+
+		list, err := uc.ListSequences(ctx, "alice", "shop", "public")
+
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, "invoice_number_seq", list[0].Name)
+		require.True(t, list[0].CanSet)
+		require.True(t, list[0].CanRestart)
+		require.Equal(t, "orders_id_seq", list[1].Name)
+		require.Equal(t, "id", list[1].OwnedByColumn)
+		require.Nil(t, list[1].LastValue, "USAGE alone does not reveal the last value")
+		require.False(t, list[1].CanSet)
+		require.False(t, list[1].CanRestart)
+	})
+
+	t.Run("SetSequenceValue previews, then runs and audits the confirmed setval", func(t *testing.T) {
+		statement := `SELECT setval('"public"."orders_id_seq"', 500, true)`
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "alice", "shop", "public", "orders_id_seq").
+			Return(&domain.SequencePermissions{CanUpdate: true}, nil).Times(2)
+		mockDatabase.EXPECT().ListSequences(gomock.Any(), "shop", "public").Return(sequences, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionSetSequence, entry.Action)
+				require.Equal(t, "public.orders_id_seq", entry.Target)
+				require.Equal(t, int64(41), entry.Before["last_value"])
+				return nil
+			})
+
+		value := domain.SequenceValue{Database: "shop", Schema: "public", Name: "orders_id_seq", Value: 500, IsCalled: true}
+		preview, err := uc.SetSequenceValue(ctx, "alice", value, "")
+		require.NoError(t, err)
+		require.Equal(t, statement, preview.Statement)
+		require.False(t, preview.Applied)
+
+		change, err := uc.SetSequenceValue(ctx, "alice", value, preview.Statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+	})
+
+	t.Run("SetSequenceValue requires UPDATE on the sequence", func(t *testing.T) {
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "bob", "shop", "public", "orders_id_seq").
+			Return(&domain.SequencePermissions{CanSelect: true, CanUsage: true}, nil)
+
+		_, err := uc.SetSequenceValue(ctx, "bob", domain.SequenceValue{Database: "shop", Schema: "public", Name: "orders_id_seq", Value: 1}, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("SetSequenceValue refuses values outside the sequence's bounds", func(t *testing.T) {
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "alice", "shop", "public", "invoice_number_seq").
+			Return(&domain.SequencePermissions{CanUpdate: true}, nil)
+		mockDatabase.EXPECT().ListSequences(gomock.Any(), "shop", "public").Return(sequences, nil)
+
+		_, err := uc.SetSequenceValue(ctx, "alice", domain.SequenceValue{Database: "shop", Schema: "public", Name: "invoice_number_seq", Value: 10000}, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "value", validationErr.Field)
+	})
+
+	t.Run("RestartSequence restarts at the given value only for the owner", func(t *testing.T) {
+		restartAt := int64(2000)
+		statement := `ALTER SEQUENCE "public"."invoice_number_seq" RESTART WITH 2000`
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "owner", "shop", "public", "invoice_number_seq").
+			Return(&domain.SequencePermissions{CanUpdate: true, IsOwner: true}, nil)
+		mockDatabase.EXPECT().ListSequences(gomock.Any(), "shop", "public").Return(sequences, nil)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionRestartSequence, entry.Action)
+				require.Nil(t, entry.Before["last_value"])
+				return nil
+			})
+
+		change, err := uc.RestartSequence(ctx, "owner", domain.SequenceRestart{Database: "shop", Schema: "public", Name: "invoice_number_seq", Value: &restartAt}, statement)
+		require.NoError(t, err)
+		require.True(t, change.Applied)
+
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "alice", "shop", "public", "invoice_number_seq").
+			Return(&domain.SequencePermissions{CanUpdate: true}, nil)
+
+		_, err = uc.RestartSequence(ctx, "alice", domain.SequenceRestart{Database: "shop", Schema: "public", Name: "invoice_number_seq"}, "")
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("RestartSequence rejects a changed statement and reports failures", func(t *testing.T) {
+		statement := `ALTER SEQUENCE "public"."orders_id_seq" RESTART`
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "owner", "shop", "public", "orders_id_seq").
+			Return(&domain.SequencePermissions{IsOwner: true}, nil).Times(2)
+		mockDatabase.EXPECT().ListSequences(gomock.Any(), "shop", "public").Return(sequences, nil).Times(2)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), statement).Return(nil, errors.New("permission denied"))
+
+		restart := domain.SequenceRestart{Database: "shop", Schema: "public", Name: "orders_id_seq"}
+		_, err := uc.RestartSequence(ctx, "owner", restart, statement+" WITH 1")
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "confirm", validationErr.Field)
+
+		_, err = uc.RestartSequence(ctx, "owner", restart, statement)
+		require.Error(t, err)
+	})
+
+	t.Run("RestartSequence reports unknown sequences", func(t *testing.T) {
+		mockRBAC.EXPECT().GetSequencePermissions(gomock.Any(), "owner", "shop", "public", "missing_seq").
+			Return(&domain.SequencePermissions{IsOwner: true}, nil)
+		mockDatabase.EXPECT().ListSequences(gomock.Any(), "shop", "public").Return(sequences, nil)
+
+		_, err := uc.RestartSequence(ctx, "owner", domain.SequenceRestart{Database: "shop", Schema: "public", Name: "missing_seq"}, "")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "sequence", validationErr.Field)
+	})
+}
@@ -0,0 +1,837 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// SessionAdminUsecaseConstructor is a function type that creates a SessionAdminUseCase
+type SessionAdminUsecaseConstructor func(
+	sessionRepo repository.SessionRepository,
+	transactionRepo repository.TransactionRepository,
+	databaseRepo repository.DatabaseRepository,
+	rbacRepo repository.RBACRepository,
+	configRepo repository.ConfigRepository,
+	auditRepo repository.AuditRepository,
+	secretRepo repository.SecretRepository,
+) usecase.SessionAdminUseCase
+
+// SessionAdminUsecaseRunner runs all session admin usecase tests against an implementation
+func SessionAdminUsecaseRunner(t *testing.T, constructor SessionAdminUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSession := mockRepository.NewMockSessionRepository(ctrl)
+	mockTransaction := mockRepository.NewMockTransactionRepository(ctrl)
+	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+	mockSecret := mockRepository.NewMockSecretRepository(ctrl)
+
+	uc := constructor(mockSession, mockTransaction, mockDatabase, mockRBAC, mockConfig, mockAudit, mockSecret)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("ListSessions returns sessions without stored credentials", func(t *testing.T) {
+		stored := &domain.Session{
+			ID:                "session_1",
+			Username:          "alice",
+			EncryptedPassword: "ciphertext",
+			CreatedAt:         now.Add(-time.Hour),
+			LastActivityAt:    now,
+			ClientIP:          "192.0.2.10",
+		}
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockSession.EXPECT().
+			ListSessions(gomock.Any()).
+			Return([]*domain.Session{stored}, nil)
+
+		sessions, err := uc.ListSessions(ctx, "postgres")
+
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		require.Equal(t, "alice", sessions[0].Username)
+		require.Equal(t, "192.0.2.10", sessions[0].ClientIP)
+		require.Empty(t, sessions[0].EncryptedPassword)
+		require.Equal(t, "ciphertext", stored.EncryptedPassword)
+	})
+
+	t.Run("ListSessions rejects non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.ListSessions(ctx, "alice")
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("RevokeSession deletes the session, its pool and its transaction buffer", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockSession.EXPECT().
+			GetSession(gomock.Any(), "session_1").
+			Return(&domain.Session{ID: "session_1", Username: "alice"}, nil)
+		mockSession.EXPECT().
+			DeleteSession(gomock.Any(), "session_1").
+			Return(nil)
+		mockDatabase.EXPECT().
+			CloseSessionPool("session_1").
+			Return(nil)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "alice").
+			Return(&domain.TransactionState{ID: "txn_1", Username: "alice"}, nil)
+		mockTransaction.EXPECT().
+			DeleteTransaction(gomock.Any(), "txn_1").
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "postgres", entry.Username)
+				require.Equal(t, domain.AuditActionRevokeSession, entry.Action)
+				require.Equal(t, "session_1", entry.Target)
+				require.Equal(t, "alice", entry.Before["username"])
+				return nil
+			})
+
+		err := uc.RevokeSession(ctx, "postgres", "session_1")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("RevokeSession succeeds when the user has no transaction", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockSession.EXPECT().
+			GetSession(gomock.Any(), "session_2").
+			Return(&domain.Session{ID: "session_2", Username: "bob"}, nil)
+		mockSession.EXPECT().
+			DeleteSession(gomock.Any(), "session_2").
+			Return(nil)
+		mockDatabase.EXPECT().
+			CloseSessionPool("session_2").
+			Return(nil)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bob").
+			Return(nil, domain.ErrNoActiveTransaction)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		err := uc.RevokeSession(ctx, "postgres", "session_2")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("RevokeSession rejects non-superusers before touching the session", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		err := uc.RevokeSession(ctx, "alice", "session_2")
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("SetSessionSettings updates another user's session and audits the change", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockSession.EXPECT().
+			GetSession(gomock.Any(), "session_3").
+			Return(&domain.Session{ID: "session_3", Username: "carol", Settings: domain.SessionSettings{WorkMem: "4MB"}}, nil)
+		mockSession.EXPECT().
+			UpdateSession(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, session *domain.Session) error {
+				require.Equal(t, "1GB", session.Settings.WorkMem)
+				require.Equal(t, 5*time.Minute, session.Settings.StatementTimeout)
+				return nil
+			})
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "postgres", entry.Username)
+				require.Equal(t, domain.AuditActionSessionSettings, entry.Action)
+				require.Equal(t, "session_3", entry.Target)
+				require.Equal(t, "4MB", entry.Before["work_mem"])
+				require.Equal(t, "1GB", entry.After["work_mem"])
+				return nil
+			})
+
+		session, err := uc.SetSessionSettings(ctx, "postgres", "session_3", domain.SessionSettings{
+			WorkMem:          "1GB",
+			StatementTimeout: 5 * time.Minute,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "1GB", session.Settings.WorkMem)
+	})
+
+	t.Run("SetSessionSettings rejects invalid presets before saving", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockSession.EXPECT().
+			GetSession(gomock.Any(), "session_3").
+			Return(&domain.Session{ID: "session_3", Username: "carol"}, nil)
+
+		_, err := uc.SetSessionSettings(ctx, "postgres", "session_3", domain.SessionSettings{WorkMem: "8 bytes"})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "work_mem", validationErr.Field)
+	})
+
+	t.Run("SetSessionSettings reports a missing session", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockSession.EXPECT().
+			GetSession(gomock.Any(), "session_gone").
+			Return(nil, nil)
+
+		_, err := uc.SetSessionSettings(ctx, "postgres", "session_gone", domain.SessionSettings{})
+
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+
+	t.Run("SetSessionSettings rejects non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.SetSessionSettings(ctx, "alice", "session_3", domain.SessionSettings{WorkMem: "1GB"})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("SaveConnectionProfile keeps the built-in server as the default", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{RequireChangeReason: true}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{
+				RequireChangeReason: true,
+				ConnectionProfiles: []domain.ConnectionProfile{
+					domain.DefaultConnectionProfile,
+					{Name: "replica", Host: "db2.internal", Port: 6543, SSLMode: "require"},
+				},
+			}).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		err := uc.SaveConnectionProfile(ctx, "postgres", domain.ConnectionProfile{Name: " replica ", Host: "db2.internal", Port: 6543, SSLMode: "require"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("SaveConnectionProfile replaces a profile with the same name", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ConnectionProfiles: []domain.ConnectionProfile{
+				{Name: "primary", Host: "db1.internal"},
+				{Name: "replica", Host: "db2.internal"},
+			}}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{ConnectionProfiles: []domain.ConnectionProfile{
+				{Name: "primary", Host: "db1.internal"},
+				{Name: "replica", Host: "db3.internal", Port: 5433},
+			}}).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		err := uc.SaveConnectionProfile(ctx, "postgres", domain.ConnectionProfile{Name: "replica", Host: "db3.internal", Port: 5433})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("SaveConnectionProfile rejects an unsupported sslmode", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		err := uc.SaveConnectionProfile(ctx, "postgres", domain.ConnectionProfile{Name: "replica", Host: "db2.internal", SSLMode: "always"})
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "sslmode", validationErr.Field)
+	})
+
+	t.Run("SaveConnectionProfile rejects non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		err := uc.SaveConnectionProfile(ctx, "alice", domain.ConnectionProfile{Name: "replica", Host: "db2.internal"})
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("DeleteConnectionProfile removes the named profile", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ConnectionProfiles: []domain.ConnectionProfile{
+				{Name: "primary", Host: "db1.internal"},
+				{Name: "replica", Host: "db2.internal"},
+			}}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{ConnectionProfiles: []domain.ConnectionProfile{
+				{Name: "replica", Host: "db2.internal"},
+			}}).
+			Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		require.NoError(t, uc.DeleteConnectionProfile(ctx, "postgres", "primary"))
+	})
+
+	t.Run("DeleteConnectionProfile keeps the last profile", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
+		err := uc.DeleteConnectionProfile(ctx, "postgres", domain.DefaultConnectionProfile.Name)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "last connection profile")
+	})
+
+	t.Run("SetMaintenanceMode records when maintenance started", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, config *domain.AppConfig) error {
+				require.True(t, config.MaintenanceMode)
+				require.Equal(t, "Upgrading to PG 17", config.MaintenanceMessage)
+				require.False(t, config.MaintenanceSince.IsZero())
+				return nil
+			})
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionMaintenance, entry.Action)
+				require.Equal(t, false, entry.Before["enabled"])
+				require.Equal(t, true, entry.After["enabled"])
+				require.Equal(t, "Upgrading to PG 17", entry.After["message"])
+				return nil
+			})
+
+		status, err := uc.SetMaintenanceMode(ctx, "postgres", true, " Upgrading to PG 17 ")
+
+		require.NoError(t, err)
+		require.True(t, status.Enabled)
+		require.Equal(t, "Upgrading to PG 17", status.Message)
+		require.Equal(t, time.Duration(domain.MaintenanceGracePeriod)*time.Second, status.GraceEndsAt.Sub(status.Since))
+	})
+
+	t.Run("SetMaintenanceMode keeps the original start when only the message changes", func(t *testing.T) {
+		since := time.Now().Add(-5 * time.Minute)
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceSince: since}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{MaintenanceMode: true, MaintenanceSince: since}).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		status, err := uc.SetMaintenanceMode(ctx, "postgres", true, "")
+
+		require.NoError(t, err)
+		require.Equal(t, since, status.Since)
+		require.Equal(t, domain.DefaultMaintenanceMessage, status.Message)
+	})
+
+	t.Run("SetMaintenanceMode turns maintenance off", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceMessage: "Upgrading", MaintenanceSince: time.Now()}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{}).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		status, err := uc.SetMaintenanceMode(ctx, "postgres", false, "ignored")
+
+		require.NoError(t, err)
+		require.False(t, status.Enabled)
+	})
+
+	t.Run("SetMaintenanceMode rejects non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.SetMaintenanceMode(ctx, "alice", true, "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("SaveConnectionProfile refuses a service password that is not a secret reference", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		err := uc.SaveConnectionProfile(ctx, "postgres", domain.ConnectionProfile{Name: "replica", Host: "db2.internal", ServicePasswordRef: "hunter2"})
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "service_password_ref", validationErr.Field)
+		require.NotContains(t, validationErr.Message, "hunter2")
+	})
+
+	t.Run("ExportConnectionProfiles exports the profiles with their secret references", func(t *testing.T) {
+		profiles := []domain.ConnectionProfile{
+			{Name: "primary", Host: "db1.internal", ServicePasswordRef: "env:PRIMARY_SERVICE_PASSWORD"},
+			{Name: "replica", Host: "db2.internal", Port: 6543},
+		}
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ConnectionProfiles: profiles}, nil)
+
+		content, err := uc.ExportConnectionProfiles(ctx, "postgres")
+
+		require.NoError(t, err)
+		var document map[string]interface{}
+		require.NoError(t, json.Unmarshal(content, &document))
+		require.EqualValues(t, domain.ConnectionProfileExportVersion, document["version"])
+		require.Equal(t, "postgres", document["exported_by"])
+		require.NotEmpty(t, document["exported_at"])
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"name": "primary", "host": "db1.internal", "service_password_ref": "env:PRIMARY_SERVICE_PASSWORD"},
+			map[string]interface{}{"name": "replica", "host": "db2.internal", "port": float64(6543)},
+		}, document["profiles"])
+	})
+
+	t.Run("ExportConnectionProfiles rejects non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.ExportConnectionProfiles(ctx, "alice")
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ImportConnectionProfiles adds and replaces profiles, reporting unresolved secrets", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{ConnectionProfiles: []domain.ConnectionProfile{
+				{Name: "primary", Host: "db1.dev.internal"},
+			}}, nil)
+		mockSecret.EXPECT().
+			ResolveSecret(gomock.Any(), "env:PRIMARY_SERVICE_PASSWORD").
+			Return("s3cret", nil)
+		mockSecret.EXPECT().
+			ResolveSecret(gomock.Any(), "file:/run/secrets/replica").
+			Return("", errors.New("no such file"))
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{ConnectionProfiles: []domain.ConnectionProfile{
+				{Name: "primary", Host: "db1.prod.internal", ServicePasswordRef: "env:PRIMARY_SERVICE_PASSWORD"},
+				{Name: "replica", Host: "db2.prod.internal", SSLMode: "require", ServicePasswordRef: "file:/run/secrets/replica"},
+			}}).
+			Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionImportProfiles, entry.Action)
+				require.Contains(t, entry.Before, "primary")
+				require.Contains(t, entry.After, "replica")
+				return nil
+			})
+
+		result, err := uc.ImportConnectionProfiles(ctx, "postgres", []byte(`{
+			"version": 1,
+			"profiles": [
+				{"name": "primary", "host": " db1.prod.internal ", "service_password_ref": "env:PRIMARY_SERVICE_PASSWORD"},
+				{"name": "replica", "host": "db2.prod.internal", "sslmode": "require", "service_password_ref": "file:/run/secrets/replica"}
+			]
+		}`))
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"replica"}, result.Added)
+		require.Equal(t, []string{"primary"}, result.Replaced)
+		require.Equal(t, []string{"file:/run/secrets/replica"}, result.UnresolvedSecrets)
+	})
+
+	t.Run("ImportConnectionProfiles changes nothing when a profile is invalid", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		_, err := uc.ImportConnectionProfiles(ctx, "postgres", []byte(`{
+			"version": 1,
+			"profiles": [
+				{"name": "primary", "host": "db1.internal"},
+				{"name": "replica", "host": "db2.internal", "service_password_ref": "plaintext"}
+			]
+		}`))
+
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "file", validationErr.Field)
+		require.Contains(t, validationErr.Message, "replica")
+		require.Contains(t, validationErr.Message, "service_password_ref")
+		require.NotContains(t, validationErr.Message, "plaintext")
+	})
+
+	t.Run("ImportConnectionProfiles rejects malformed files, duplicate names and unknown versions", func(t *testing.T) {
+		documents := map[string]string{
+			"malformed": `{"version": 1, "profiles": [`,
+			"duplicate": `{"version": 1, "profiles": [{"name": "primary", "host": "db1.internal"}, {"name": "primary", "host": "db2.internal"}]}`,
+			"version":   `{"version": 2, "profiles": [{"name": "primary", "host": "db1.internal"}]}`,
+			"empty":     `{"version": 1, "profiles": []}`,
+		}
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil).
+			Times(len(documents))
+
+		for name, document := range documents {
+			_, err := uc.ImportConnectionProfiles(ctx, "postgres", []byte(document))
+			validationErr, ok := err.(domain.ValidationError)
+			require.True(t, ok, name)
+			require.Equal(t, "file", validationErr.Field, name)
+		}
+	})
+
+	t.Run("GetEnvironmentBanner uses the well-known color of the environment", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EnvironmentName: "PRODUCTION"}, nil)
+
+		banner, err := uc.GetEnvironmentBanner(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, &domain.EnvironmentBanner{Name: "PRODUCTION", Color: domain.EnvironmentColors["PRODUCTION"]}, banner)
+	})
+
+	t.Run("GetEnvironmentBanner shows nothing when no environment is set", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EnvironmentColor: "#123456"}, nil)
+
+		banner, err := uc.GetEnvironmentBanner(ctx)
+
+		require.NoError(t, err)
+		require.Empty(t, banner.Name)
+	})
+
+	t.Run("SetEnvironmentBanner saves and audits the environment", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EnvironmentName: "STAGING"}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{EnvironmentName: "EU PRODUCTION", EnvironmentColor: "#aa0000"}).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionSetEnvironment, entry.Action)
+				require.Equal(t, "STAGING", entry.Before["name"])
+				require.Equal(t, "EU PRODUCTION", entry.After["name"])
+				return nil
+			})
+
+		banner, err := uc.SetEnvironmentBanner(ctx, "postgres", " eu production ", "#AA0000")
+
+		require.NoError(t, err)
+		require.Equal(t, &domain.EnvironmentBanner{Name: "EU PRODUCTION", Color: "#aa0000"}, banner)
+	})
+
+	t.Run("SetEnvironmentBanner rejects colors that are not hex", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		_, err := uc.SetEnvironmentBanner(ctx, "postgres", "PRODUCTION", "red;}body{display:none")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "color", validationErr.Field)
+	})
+
+	t.Run("SetEnvironmentBanner is restricted to superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.SetEnvironmentBanner(ctx, "alice", "PRODUCTION", "")
+
+		require.Error(t, err)
+	})
+
+	t.Run("CheckRolePassword reports every rule of the default policy a password breaks", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil).
+			Times(3)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil).
+			Times(3)
+
+		check, err := uc.CheckRolePassword(ctx, "postgres", "reporting", "Password123")
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"must be at least 12 characters long",
+			"is too common to resist guessing",
+		}, check.Violations)
+
+		check, err = uc.CheckRolePassword(ctx, "postgres", "reporting", "reportingreporting")
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"must mix at least 3 of lowercase letters, uppercase letters, digits and symbols",
+			"must not contain the role name",
+		}, check.Violations)
+
+		check, err = uc.CheckRolePassword(ctx, "postgres", "reporting", "Tide-Harbor-42-Lantern")
+		require.NoError(t, err)
+		require.Empty(t, check.Violations)
+	})
+
+	t.Run("CheckRolePassword applies the configured policy", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{PasswordPolicy: domain.PasswordPolicy{MinLength: 6, MinCharacterClasses: 1, AllowCommonPasswords: true}}, nil)
+
+		check, err := uc.CheckRolePassword(ctx, "postgres", "reporting", "password")
+		require.NoError(t, err)
+		require.Empty(t, check.Violations)
+	})
+
+	t.Run("CreateRole creates and audits a role whose password meets the policy", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+		mockRBAC.EXPECT().
+			CreateRole(gomock.Any(), domain.NewRole{Name: "reporting", Password: "Tide-Harbor-42-Lantern", CreateDB: true}).
+			Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionCreateRole, entry.Action)
+				require.Equal(t, "reporting", entry.Target)
+				require.NotContains(t, entry.After, "password")
+				return nil
+			})
+
+		err := uc.CreateRole(ctx, "postgres", domain.NewRole{Name: " reporting ", Password: "Tide-Harbor-42-Lantern", CreateDB: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("CreateRole refuses a weak password before creating the role", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
+		err := uc.CreateRole(ctx, "postgres", domain.NewRole{Name: "reporting", Password: "letmein"})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "password", validationErr.Field)
+		require.Contains(t, validationErr.Message, "is too common to resist guessing")
+	})
+
+	t.Run("CreateRole rejects reserved and unquoted-unsafe role names", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil).
+			Times(2)
+
+		var validationErr domain.ValidationError
+		err := uc.CreateRole(ctx, "postgres", domain.NewRole{Name: "pg_monitor2", Password: "Tide-Harbor-42-Lantern"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "name", validationErr.Field)
+		err = uc.CreateRole(ctx, "postgres", domain.NewRole{Name: "Report Users", Password: "Tide-Harbor-42-Lantern"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "name", validationErr.Field)
+	})
+
+	t.Run("CreateRole is restricted to superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		err := uc.CreateRole(ctx, "alice", domain.NewRole{Name: "reporting", Password: "Tide-Harbor-42-Lantern"})
+
+		require.Error(t, err)
+	})
+
+	t.Run("GetRejectionStats counts rejections per rule and route, most frequent first", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		rejected := func(rule, route string, at time.Time) domain.AuditEntry {
+			return domain.AuditEntry{
+				Action:    domain.AuditActionRequestRejected,
+				After:     map[string]interface{}{"source": "sql_validator", "rule": rule, "method": "POST", "route": route},
+				CreatedAt: at,
+			}
+		}
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Action: domain.AuditActionRequestRejected, Since: since}).
+			Return([]domain.AuditEntry{
+				rejected("line_comment", "/main/filter", since.Add(3*time.Hour)),
+				rejected("system_procedure", "/main/filter", since.Add(2*time.Hour)),
+				rejected("system_procedure", "/main/filter", since.Add(time.Hour)),
+			}, nil)
+
+		stats, err := uc.GetRejectionStats(ctx, "postgres", since)
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.RejectionStat{
+			{Source: "sql_validator", Rule: "system_procedure", Method: "POST", Route: "/main/filter", Count: 2, LastSeen: since.Add(2 * time.Hour)},
+			{Source: "sql_validator", Rule: "line_comment", Method: "POST", Route: "/main/filter", Count: 1, LastSeen: since.Add(3 * time.Hour)},
+		}, stats)
+	})
+
+	t.Run("GetRejectionStats is restricted to superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err := uc.GetRejectionStats(ctx, "alice", time.Time{})
+
+		require.Error(t, err)
+	})
+
+	t.Run("SetDisabledShortcuts saves and audits the disabled actions in listing order", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+		mockConfig.EXPECT().
+			UpdateConfig(gomock.Any(), &domain.AppConfig{DisabledShortcuts: []string{domain.ShortcutCommitTransaction, domain.ShortcutRollbackTransaction}}).
+			Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionDisableShortcuts, entry.Action)
+				require.Equal(t, []string{domain.ShortcutCommitTransaction, domain.ShortcutRollbackTransaction}, entry.After["actions"])
+				return nil
+			})
+
+		disabled, err := uc.SetDisabledShortcuts(ctx, "postgres", []string{" rollback_transaction", "commit_transaction", ""})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{domain.ShortcutCommitTransaction, domain.ShortcutRollbackTransaction}, disabled)
+	})
+
+	t.Run("SetDisabledShortcuts rejects unknown actions and non-superusers", func(t *testing.T) {
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		_, err := uc.SetDisabledShortcuts(ctx, "postgres", []string{"drop_database"})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "actions", validationErr.Field)
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "alice").
+			Return(false, nil)
+
+		_, err = uc.SetDisabledShortcuts(ctx, "alice", nil)
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// ShortcutUsecaseConstructor is a function type that creates a ShortcutUseCase
+type ShortcutUsecaseConstructor func(
+	preferenceRepo repository.PreferenceRepository,
+	configRepo repository.ConfigRepository,
+) usecase.ShortcutUseCase
+
+// ShortcutUsecaseRunner runs all keyboard shortcut usecase tests against an implementation
+func ShortcutUsecaseRunner(t *testing.T, constructor ShortcutUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPreference := mockRepository.NewMockPreferenceRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
+
+	uc := constructor(mockPreference, mockConfig)
+
+	ctx := context.Background()
+
+	findAction := func(shortcuts []domain.KeyboardShortcut, action string) domain.KeyboardShortcut {
+		for _, shortcut := range shortcuts {
+			if shortcut.Action == action {
+				return shortcut
+			}
+		}
+		t.Fatalf("shortcut %s not listed", action)
+		return domain.KeyboardShortcut{}
+	}
+
+	t.Run("ListShortcuts applies the user's remappings and the disabled actions", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{DisabledShortcuts: []string{domain.ShortcutCommitTransaction}}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			"shortcut.run_query":  "Ctrl+R",
+			"shortcut.save_query": "not a combination",
+			"theme":               "dark",
+		}, nil)
+
+		shortcuts, err := uc.ListShortcuts(ctx, "alice")
+
+		require.NoError(t, err)
+		require.Len(t, shortcuts, len(domain.DefaultShortcuts))
+		require.Equal(t, domain.ShortcutRunQuery, shortcuts[0].Action)
+
+		run := findAction(shortcuts, domain.ShortcutRunQuery)
+		require.Equal(t, "Ctrl+R", run.Keys)
+		require.Equal(t, "Ctrl+Enter", run.DefaultKeys)
+		require.True(t, run.Customized)
+
+		save := findAction(shortcuts, domain.ShortcutSaveQuery)
+		require.Equal(t, "Ctrl+S", save.Keys, "an unreadable remapping falls back to the default")
+		require.False(t, save.Customized)
+
+		commit := findAction(shortcuts, domain.ShortcutCommitTransaction)
+		require.True(t, commit.Disabled)
+		require.Empty(t, commit.Keys)
+		require.Equal(t, "Ctrl+Shift+Enter", commit.DefaultKeys)
+	})
+
+	t.Run("RemapShortcut normalizes and stores the combination", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{}, nil)
+		mockPreference.EXPECT().SetPreference(gomock.Any(), "alice", "shortcut.run_query", "Ctrl+Shift+R").Return(nil)
+
+		shortcut, err := uc.RemapShortcut(ctx, "alice", domain.ShortcutRunQuery, "shift+control+r")
+
+		require.NoError(t, err)
+		require.Equal(t, "Ctrl+Shift+R", shortcut.Keys)
+		require.True(t, shortcut.Customized)
+	})
+
+	t.Run("RemapShortcut back to the default drops the override", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").
+			Return(map[string]string{"shortcut.run_query": "Ctrl+R"}, nil)
+		mockPreference.EXPECT().DeletePreference(gomock.Any(), "alice", "shortcut.run_query").Return(nil)
+
+		shortcut, err := uc.RemapShortcut(ctx, "alice", domain.ShortcutRunQuery, "Enter+Ctrl")
+		require.Error(t, err, "the key comes last")
+		require.Nil(t, shortcut)
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").
+			Return(map[string]string{"shortcut.run_query": "Ctrl+R"}, nil)
+
+		shortcut, err = uc.RemapShortcut(ctx, "alice", domain.ShortcutRunQuery, "ctrl+enter")
+		require.NoError(t, err)
+		require.Equal(t, "Ctrl+Enter", shortcut.Keys)
+		require.False(t, shortcut.Customized)
+	})
+
+	t.Run("RemapShortcut refuses a combination another shortcut uses", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{}, nil)
+
+		shortcut, err := uc.RemapShortcut(ctx, "alice", domain.ShortcutRunQuery, "Ctrl+S")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "keys", validationErr.Field)
+		require.Contains(t, validationErr.Message, domain.ShortcutSaveQuery)
+		require.Nil(t, shortcut)
+	})
+
+	t.Run("RemapShortcut refuses bare letters, unknown actions and disabled actions", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{DisabledShortcuts: []string{domain.ShortcutCommitTransaction}}, nil).Times(3)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{}, nil).Times(3)
+
+		_, err := uc.RemapShortcut(ctx, "alice", domain.ShortcutRunQuery, "Shift+R")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "keys", validationErr.Field)
+
+		_, err = uc.RemapShortcut(ctx, "alice", "drop_database", "Ctrl+D")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "action", validationErr.Field)
+
+		_, err = uc.RemapShortcut(ctx, "alice", domain.ShortcutCommitTransaction, "Ctrl+K")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ResetShortcut restores the default unless another shortcut took it", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").
+			Return(map[string]string{"shortcut.save_query": "Ctrl+Shift+S"}, nil)
+		mockPreference.EXPECT().DeletePreference(gomock.Any(), "alice", "shortcut.save_query").Return(nil)
+
+		shortcut, err := uc.ResetShortcut(ctx, "alice", domain.ShortcutSaveQuery)
+		require.NoError(t, err)
+		require.Equal(t, "Ctrl+S", shortcut.Keys)
+		require.False(t, shortcut.Customized)
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			"shortcut.save_query": "Ctrl+Shift+S",
+			"shortcut.run_query":  "Ctrl+S",
+		}, nil)
+
+		_, err = uc.ResetShortcut(ctx, "alice", domain.ShortcutSaveQuery)
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "keys", validationErr.Field)
+	})
+}
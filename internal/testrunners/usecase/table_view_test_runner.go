@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/lumen-pg/internal/domain"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
+	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
+	mockRepository "github.com/kamil5b/lumen-pg/internal/testrunners/mocks/repository"
+	"github.com/stretchr/testify/require"
+)
+
+// TableViewUsecaseConstructor is a function type that creates a TableViewUseCase
+type TableViewUsecaseConstructor func(
+	preferenceRepo repository.PreferenceRepository,
+	rbacRepo repository.RBACRepository,
+) usecase.TableViewUseCase
+
+// TableViewUsecaseRunner runs all saved table view usecase tests against an implementation
+func TableViewUsecaseRunner(t *testing.T, constructor TableViewUsecaseConstructor) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPreference := mockRepository.NewMockPreferenceRepository(ctrl)
+	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+
+	uc := constructor(mockPreference, mockRBAC)
+
+	ctx := context.Background()
+	const ordersKey = "table_views.shop/public/orders"
+
+	storedViews := func(t *testing.T, value string) []map[string]interface{} {
+		var views []map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(value), &views))
+		return views
+	}
+
+	t.Run("SaveTableView stores a new default view and clears the previous default", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			ordersKey: `[{"name":"Open","where":"status = 'open'","default":true}]`,
+		}, nil)
+		mockPreference.EXPECT().
+			SetPreference(gomock.Any(), "alice", ordersKey, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, value string) error {
+				views := storedViews(t, value)
+				require.Len(t, views, 2)
+				require.Equal(t, "Open", views[0]["name"])
+				require.Nil(t, views[0]["default"])
+				require.Equal(t, "Recent", views[1]["name"])
+				require.Equal(t, "DESC", views[1]["order_dir"])
+				require.Equal(t, []interface{}{"notes"}, views[1]["hidden_columns"])
+				require.Equal(t, true, views[1]["default"])
+				return nil
+			})
+
+		view, err := uc.SaveTableView(ctx, "alice", domain.SavedTableView{
+			Name:          "  Recent ",
+			Database:      "shop",
+			Schema:        "public",
+			Table:         "orders",
+			OrderBy:       "created_at",
+			OrderDir:      "desc",
+			HiddenColumns: []string{"notes"},
+			IsDefault:     true,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "Recent", view.Name)
+		require.Equal(t, "DESC", view.OrderDir)
+	})
+
+	t.Run("SaveTableView refuses unnamed views and tables the user cannot read", func(t *testing.T) {
+		_, err := uc.SaveTableView(ctx, "alice", domain.SavedTableView{Name: " ", Database: "shop", Schema: "public", Table: "orders"})
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "name", validationErr.Field)
+
+		_, err = uc.SaveTableView(ctx, "alice", domain.SavedTableView{Name: "Mine", Database: "shop", Schema: "public", Table: "orders", OrderBy: "id", OrderDir: "sideways"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "order_dir", validationErr.Field)
+
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "hr", "salaries").Return(false, nil)
+		_, err = uc.SaveTableView(ctx, "alice", domain.SavedTableView{Name: "Mine", Database: "shop", Schema: "hr", Table: "salaries"})
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("ListTableViews returns the table's views ordered by name", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			ordersKey:                          `[{"name":"Recent","order_by":"created_at","order_dir":"DESC"},{"name":"Open","where":"status = 'open'"}]`,
+			"table_views.shop/public/invoices": `[{"name":"Unpaid"}]`,
+		}, nil)
+
+		views, err := uc.ListTableViews(ctx, "alice", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Equal(t, []domain.SavedTableView{
+			{Name: "Open", Database: "shop", Schema: "public", Table: "orders", WhereClause: "status = 'open'"},
+			{Name: "Recent", Database: "shop", Schema: "public", Table: "orders", OrderBy: "created_at", OrderDir: "DESC"},
+		}, views)
+	})
+
+	t.Run("SetDefaultTableView marks one view and GetDefaultTableView returns it", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil).Times(2)
+		stored := `[{"name":"Open"},{"name":"Recent","default":true}]`
+		mockPreference.EXPECT().
+			GetPreferences(gomock.Any(), "alice").
+			DoAndReturn(func(context.Context, string) (map[string]string, error) {
+				return map[string]string{ordersKey: stored}, nil
+			}).
+			Times(2)
+		mockPreference.EXPECT().
+			SetPreference(gomock.Any(), "alice", ordersKey, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, value string) error {
+				stored = value
+				return nil
+			})
+
+		require.NoError(t, uc.SetDefaultTableView(ctx, "alice", "shop", "public", "orders", "Open"))
+		view, err := uc.GetDefaultTableView(ctx, "alice", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Equal(t, "Open", view.Name)
+		require.Len(t, storedViews(t, stored), 2)
+	})
+
+	t.Run("SetDefaultTableView refuses an unknown view", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{}, nil)
+
+		err := uc.SetDefaultTableView(ctx, "alice", "shop", "public", "orders", "Missing")
+
+		require.ErrorIs(t, err, domain.ErrTableViewNotFound)
+	})
+
+	t.Run("GetDefaultTableView returns nil without a default", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			ordersKey: `[{"name":"Open"}]`,
+		}, nil)
+
+		view, err := uc.GetDefaultTableView(ctx, "alice", "shop", "public", "orders")
+
+		require.NoError(t, err)
+		require.Nil(t, view)
+	})
+
+	t.Run("DeleteTableView removes the preference with the last view", func(t *testing.T) {
+		mockRBAC.EXPECT().HasSelectPermission(gomock.Any(), "alice", "shop", "public", "orders").Return(true, nil)
+		mockPreference.EXPECT().GetPreferences(gomock.Any(), "alice").Return(map[string]string{
+			ordersKey: `[{"name":"Open","default":true}]`,
+		}, nil)
+		mockPreference.EXPECT().DeletePreference(gomock.Any(), "alice", ordersKey).Return(nil)
+
+		require.NoError(t, uc.DeleteTableView(ctx, "alice", "shop", "public", "orders", "Open"))
+	})
+}
@@ -2,10 +2,15 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/kamil5b/lumen-pg/internal/domain"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/repository"
 	"github.com/kamil5b/lumen-pg/internal/interfaces/usecase"
@@ -18,6 +23,8 @@ type TransactionUsecaseConstructor func(
 	transactionRepo repository.TransactionRepository,
 	databaseRepo repository.DatabaseRepository,
 	rbacRepo repository.RBACRepository,
+	auditRepo repository.AuditRepository,
+	configRepo repository.ConfigRepository,
 ) usecase.TransactionUseCase
 
 // TransactionUsecaseRunner runs all transaction usecase tests against an implementation
@@ -33,8 +40,10 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 	mockTransaction := mockRepository.NewMockTransactionRepository(ctrl)
 	mockDatabase := mockRepository.NewMockDatabaseRepository(ctrl)
 	mockRBAC := mockRepository.NewMockRBACRepository(ctrl)
+	mockAudit := mockRepository.NewMockAuditRepository(ctrl)
+	mockConfig := mockRepository.NewMockConfigRepository(ctrl)
 
-	uc := constructor(mockTransaction, mockDatabase, mockRBAC)
+	uc := constructor(mockTransaction, mockDatabase, mockRBAC, mockAudit, mockConfig)
 
 	ctx := context.Background()
 
@@ -45,6 +54,10 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 			GetUserTransaction(gomock.Any(), "testuser").
 			Return(nil, ErrNoActiveTransaction)
 
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
 		mockTransaction.EXPECT().
 			CreateTransaction(gomock.Any(), gomock.Any()).
 			Return(nil)
@@ -130,6 +143,14 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 				Username: "testuser",
 			}, nil)
 
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "users").
+			Return(&domain.TableMetadata{Name: "users", Columns: []domain.ColumnMetadata{{Name: "name", DataType: "text"}}}, nil)
+
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "users").
+			Return(nil, nil)
+
 		mockTransaction.EXPECT().
 			AddRowEdit(gomock.Any(), "testuser", gomock.Any()).
 			Return(nil)
@@ -217,6 +238,14 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 				Username: "testuser",
 			}, nil)
 
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "users").
+			Return(&domain.TableMetadata{Name: "users", Columns: []domain.ColumnMetadata{
+				{Name: "id", DataType: "integer", IsPrimary: true, HasDefault: true},
+				{Name: "name", DataType: "text"},
+				{Name: "email", DataType: "text"},
+			}}, nil)
+
 		mockTransaction.EXPECT().
 			AddRowInsert(gomock.Any(), "testuser", gomock.Any()).
 			Return(nil)
@@ -269,6 +298,10 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 	// IT-S5-04: Real Transaction Commit
 	// E2E-S5-09: Transaction Commit Button
 	t.Run("CommitTransaction commits all buffered changes", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
 		mockTransaction.EXPECT().
 			GetUserTransaction(gomock.Any(), "testuser").
 			Return(&domain.TransactionState{
@@ -289,7 +322,15 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 			Return([]int{}, nil)
 
 		mockTransaction.EXPECT().
-			UpdateTransaction(gomock.Any(), gomock.Any()).
+			GetRowKeyDeletes(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetBulkUpdates(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			DeleteTransaction(gomock.Any(), gomock.Any()).
 			Return(nil)
 
 		mockRBAC.EXPECT().
@@ -304,7 +345,7 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 			HasDeletePermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(true, nil).AnyTimes()
 
-		err := uc.CommitTransaction(ctx, "testuser")
+		err := uc.CommitTransaction(ctx, "testuser", "")
 
 		require.NoError(t, err)
 	})
@@ -382,6 +423,10 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 			GetUserTransaction(gomock.Any(), "user1").
 			Return(nil, ErrNoActiveTransaction)
 
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
 		mockTransaction.EXPECT().
 			CreateTransaction(gomock.Any(), gomock.Any()).
 			Return(nil).Times(1)
@@ -397,6 +442,10 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 			GetUserTransaction(gomock.Any(), "user2").
 			Return(nil, ErrNoActiveTransaction)
 
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
 		mockTransaction.EXPECT().
 			CreateTransaction(gomock.Any(), gomock.Any()).
 			Return(nil).Times(1)
@@ -455,8 +504,1883 @@ func TransactionUsecaseRunner(t *testing.T, constructor TransactionUsecaseConstr
 		require.NoError(t, err2)
 		require.NotEqual(t, result1, result2)
 	})
-}
 
-var (
-	ErrNoActiveTransaction = domain.ValidationError{Field: "transaction", Message: "no active transaction"}
-)
+	// Row-level change annotations on commit
+	t.Run("CommitTransaction records change reason in audit log", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{RequireChangeReason: true}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "testuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_123",
+				Username: "testuser",
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "users",
+			}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowEdits(gomock.Any(), "testuser").
+			Return(map[int]domain.RowEdit{2: {RowIndex: 2, ColumnName: "name", NewValue: "Alicia"}}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowInserts(gomock.Any(), "testuser").
+			Return([]domain.RowInsert{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowDeletes(gomock.Any(), "testuser").
+			Return([]int{5}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetBulkUpdates(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			DeleteTransaction(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionCommit, entry.Action)
+				require.Equal(t, "users", entry.Table)
+				require.Equal(t, "fix misspelled name", entry.Reason)
+				require.Len(t, entry.AffectedRows, 2)
+				require.Equal(t, 2, entry.AffectedRows[0]["row_index"])
+				require.Equal(t, "name", entry.AffectedRows[0]["column"])
+				require.Equal(t, "Alicia", entry.AffectedRows[0]["new_value"])
+				return nil
+			})
+
+		err := uc.CommitTransaction(ctx, "testuser", "  fix misspelled name ")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CommitTransaction requires change reason when configured", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{RequireChangeReason: true}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "testuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_123",
+				Username: "testuser",
+			}, nil)
+
+		err := uc.CommitTransaction(ctx, "testuser", "   ")
+
+		require.ErrorIs(t, err, domain.ErrChangeReasonRequired)
+	})
+
+	t.Run("CommitTransaction holds sensitive table changes for approval", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{SensitiveTables: []string{"public.payments"}, Approvers: []string{"lead"}}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "testuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_123",
+				Username: "testuser",
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "payments",
+			}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowEdits(gomock.Any(), "testuser").
+			Return(map[int]domain.RowEdit{0: {RowIndex: 0, ColumnName: "amount", NewValue: 10}}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowInserts(gomock.Any(), "testuser").
+			Return([]domain.RowInsert{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowDeletes(gomock.Any(), "testuser").
+			Return([]int{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetBulkUpdates(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			UpdateTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, txn *domain.TransactionState) error {
+				require.Equal(t, domain.TransactionStatusPendingApproval, txn.Status)
+				require.Equal(t, "refund", txn.CommitReason)
+				return nil
+			})
+
+		err := uc.CommitTransaction(ctx, "testuser", "refund")
+
+		require.ErrorIs(t, err, domain.ErrCommitPendingApproval)
+	})
+
+	t.Run("EditCell rejected while commit is pending approval", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "testuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_123",
+				Username: "testuser",
+				Status:   domain.TransactionStatusPendingApproval,
+			}, nil)
+
+		err := uc.EditCell(ctx, "testuser", "testdb", "public", "payments", 0, "amount", 20)
+
+		require.ErrorIs(t, err, domain.ErrCommitPendingApproval)
+	})
+
+	t.Run("ApproveTransaction commits held changes", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{SensitiveTables: []string{"public.payments"}, Approvers: []string{"lead"}}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "testuser").
+			Return(&domain.TransactionState{
+				ID:           "txn_123",
+				Username:     "testuser",
+				Database:     "testdb",
+				Schema:       "public",
+				Table:        "payments",
+				Status:       domain.TransactionStatusPendingApproval,
+				CommitReason: "refund",
+			}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowEdits(gomock.Any(), "testuser").
+			Return(map[int]domain.RowEdit{0: {RowIndex: 0, ColumnName: "amount", NewValue: 10}}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowInserts(gomock.Any(), "testuser").
+			Return([]domain.RowInsert{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowDeletes(gomock.Any(), "testuser").
+			Return([]int{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetBulkUpdates(gomock.Any(), "testuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			DeleteTransaction(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "testuser", entry.Username)
+				require.Equal(t, "lead", entry.ApprovedBy)
+				require.Equal(t, "refund", entry.Reason)
+				return nil
+			})
+
+		err := uc.ApproveTransaction(ctx, "lead", "testuser")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("ApproveTransaction rejects self approval", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{Approvers: []string{"testuser"}}, nil)
+
+		err := uc.ApproveTransaction(ctx, "testuser", "testuser")
+
+		require.ErrorIs(t, err, domain.ErrSelfApproval)
+	})
+
+	t.Run("ApproveTransaction rejects users outside the approver list", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{Approvers: []string{"lead"}}, nil)
+
+		err := uc.ApproveTransaction(ctx, "intern", "testuser")
+
+		require.ErrorIs(t, err, domain.ErrNotApprover)
+	})
+
+	t.Run("DeleteRows buffers selected primary keys without duplicates", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", PrimaryKeys: []string{"id"}}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "bulkuser").
+			Return([]map[string]interface{}{{"id": "1"}}, nil)
+
+		mockTransaction.EXPECT().
+			AddRowKeyDeletes(gomock.Any(), "bulkuser", []map[string]interface{}{{"id": "2"}, {"id": "3"}}).
+			Return(nil)
+
+		result, err := uc.DeleteRows(ctx, "bulkuser", domain.BulkDeleteParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "orders",
+			Keys:     []map[string]interface{}{{"id": "1"}, {"id": "2"}, {"id": "3", "status": "paid"}, {"id": "2"}},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &domain.BulkDeleteResult{Buffered: 2, PendingDeletes: 3}, result)
+	})
+
+	t.Run("DeleteRows buffers every row matching the filter", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", PrimaryKeys: []string{"tenant_id", "id"}}, nil)
+
+		mockDatabase.EXPECT().
+			GetRowCount(gomock.Any(), "testdb", "public", "orders", "status = 'cancelled'").
+			Return(int64(2), nil)
+
+		mockDatabase.EXPECT().
+			GetTableData(gomock.Any(), domain.TableDataParams{
+				Database:    "testdb",
+				Schema:      "public",
+				Table:       "orders",
+				WhereClause: "status = 'cancelled'",
+				Limit:       2,
+			}).
+			Return(&domain.QueryResult{
+				Columns: []string{"tenant_id", "id", "status"},
+				Rows: []map[string]interface{}{
+					{"tenant_id": int64(1), "id": int64(7), "status": "cancelled"},
+					{"tenant_id": int64(2), "id": int64(7), "status": "cancelled"},
+				},
+			}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "bulkuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			AddRowKeyDeletes(gomock.Any(), "bulkuser", []map[string]interface{}{
+				{"tenant_id": int64(1), "id": int64(7)},
+				{"tenant_id": int64(2), "id": int64(7)},
+			}).
+			Return(nil)
+
+		result, err := uc.DeleteRows(ctx, "bulkuser", domain.BulkDeleteParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			MatchFilter: true,
+			WhereClause: "status = 'cancelled'",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &domain.BulkDeleteResult{Buffered: 2, PendingDeletes: 2}, result)
+	})
+
+	t.Run("DeleteRows refuses filters matching too many rows", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", PrimaryKeys: []string{"id"}}, nil)
+
+		mockDatabase.EXPECT().
+			GetRowCount(gomock.Any(), "testdb", "public", "orders", "").
+			Return(int64(domain.BulkDeleteMaxRows+1), nil)
+
+		_, err := uc.DeleteRows(ctx, "bulkuser", domain.BulkDeleteParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			MatchFilter: true,
+		})
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bulk delete is limited to")
+	})
+
+	t.Run("DeleteRows refuses filters the SQL validator rejects and audits the rule", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", PrimaryKeys: []string{"id"}}, nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionRequestRejected, entry.Action)
+				require.Equal(t, "bulkuser", entry.Username)
+				require.Equal(t, domain.RejectionSourceSQLValidator+":union_select", entry.Target)
+				return nil
+			})
+
+		_, err := uc.DeleteRows(ctx, "bulkuser", domain.BulkDeleteParams{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			MatchFilter: true,
+			WhereClause: "id IN (1) UNION SELECT id FROM orders",
+		})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "whereClause", validationErr.Field)
+	})
+
+	t.Run("DeleteRows rejects tables outside the active transaction", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		_, err := uc.DeleteRows(ctx, "bulkuser", domain.BulkDeleteParams{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "customers",
+			Keys:     []map[string]interface{}{{"id": 1}},
+		})
+
+		require.Error(t, err)
+		validationErr, ok := err.(domain.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, "table", validationErr.Field)
+	})
+
+	t.Run("PreviewBulkUpdate counts rows matching the filter", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", Columns: []domain.ColumnMetadata{{Name: "id"}, {Name: "status"}}}, nil)
+
+		mockDatabase.EXPECT().
+			GetRowCount(gomock.Any(), "testdb", "public", "orders", "status = 'pending'").
+			Return(int64(12), nil)
+
+		result, err := uc.PreviewBulkUpdate(ctx, "bulkuser", domain.BulkUpdate{
+			Database:    "testdb",
+			Schema:      "public",
+			Table:       "orders",
+			Column:      "status",
+			Value:       "cancelled",
+			WhereClause: " status = 'pending' ",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &domain.BulkUpdateResult{MatchingRows: 12}, result)
+	})
+
+	t.Run("UpdateRowsByFilter rejects unknown columns", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", Columns: []domain.ColumnMetadata{{Name: "id"}}}, nil)
+
+		_, err := uc.UpdateRowsByFilter(ctx, "bulkuser", domain.BulkUpdate{
+			Database: "testdb",
+			Schema:   "public",
+			Table:    "orders",
+			Column:   "status; DROP TABLE orders",
+			Value:    "x",
+		})
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not exist")
+	})
+
+	t.Run("UpdateRowsByFilter requires the confirmed count to still match", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", Columns: []domain.ColumnMetadata{{Name: "status"}}}, nil)
+
+		mockDatabase.EXPECT().
+			GetRowCount(gomock.Any(), "testdb", "public", "orders", "status = 'pending'").
+			Return(int64(13), nil)
+
+		_, err := uc.UpdateRowsByFilter(ctx, "bulkuser", domain.BulkUpdate{
+			Database:      "testdb",
+			Schema:        "public",
+			Table:         "orders",
+			Column:        "status",
+			Value:         "cancelled",
+			WhereClause:   "status = 'pending'",
+			ExpectedCount: 12,
+		})
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "preview again")
+	})
+
+	t.Run("UpdateRowsByFilter buffers the confirmed update", func(t *testing.T) {
+		update := domain.BulkUpdate{
+			Database:      "testdb",
+			Schema:        "public",
+			Table:         "orders",
+			Column:        "status",
+			Value:         "cancelled",
+			WhereClause:   "status = 'pending'",
+			ExpectedCount: 12,
+		}
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(&domain.TableMetadata{Name: "orders", Columns: []domain.ColumnMetadata{{Name: "status"}}}, nil)
+
+		mockDatabase.EXPECT().
+			GetRowCount(gomock.Any(), "testdb", "public", "orders", "status = 'pending'").
+			Return(int64(12), nil)
+
+		mockTransaction.EXPECT().
+			AddBulkUpdate(gomock.Any(), "bulkuser", update).
+			Return(nil)
+
+		result, err := uc.UpdateRowsByFilter(ctx, "bulkuser", update)
+
+		require.NoError(t, err)
+		require.Equal(t, &domain.BulkUpdateResult{MatchingRows: 12, Buffered: true}, result)
+	})
+
+	t.Run("CommitTransaction applies buffered bulk updates", func(t *testing.T) {
+		update := domain.BulkUpdate{
+			Database:      "testdb",
+			Schema:        "public",
+			Table:         "orders",
+			Column:        "status",
+			Value:         "cancelled",
+			WhereClause:   "status = 'pending'",
+			ExpectedCount: 12,
+		}
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "bulkuser").
+			Return(&domain.TransactionState{ID: "txn_bulk", Username: "bulkuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowEdits(gomock.Any(), "bulkuser").
+			Return(map[int]domain.RowEdit{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowInserts(gomock.Any(), "bulkuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetRowDeletes(gomock.Any(), "bulkuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "bulkuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetBulkUpdates(gomock.Any(), "bulkuser").
+			Return([]domain.BulkUpdate{update}, nil)
+
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().
+			BeginTransaction(gomock.Any()).
+			Return(tx, nil)
+
+		mockDatabase.EXPECT().
+			UpdateRowsByFilter(gomock.Any(), tx, update).
+			Return(int64(12), nil)
+
+		mockDatabase.EXPECT().
+			CommitTransaction(gomock.Any(), tx).
+			Return(nil)
+
+		mockTransaction.EXPECT().
+			DeleteTransaction(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Len(t, entry.AffectedRows, 1)
+				require.Equal(t, "bulk_update", entry.AffectedRows[0]["operation"])
+				require.Equal(t, int64(12), entry.AffectedRows[0]["rows"])
+				return nil
+			})
+
+		err := uc.CommitTransaction(ctx, "bulkuser", "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CommitTransaction ends the transaction so a second commit writes nothing", func(t *testing.T) {
+		update := domain.BulkUpdate{Database: "testdb", Schema: "public", Table: "orders", Column: "status", Value: "shipped", WhereClause: "id = 7", ExpectedCount: 1}
+		expectStoredTransaction(mockTransaction, &domain.TransactionState{
+			ID: "txn_twice", Username: "twiceuser", Database: "testdb", Schema: "public", Table: "orders",
+			BulkUpdates: []domain.BulkUpdate{update},
+		})
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		mockDatabase.EXPECT().UpdateRowsByFilter(gomock.Any(), tx, update).Return(int64(1), nil).Times(1)
+		mockDatabase.EXPECT().CommitTransaction(gomock.Any(), tx).Return(nil)
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, uc.CommitTransaction(ctx, "twiceuser", ""))
+
+		err := uc.CommitTransaction(ctx, "twiceuser", "")
+		require.ErrorIs(t, err, domain.ErrNoActiveTransaction)
+	})
+
+	t.Run("CommitTransaction rolls back every change when a later one fails", func(t *testing.T) {
+		update := domain.BulkUpdate{Database: "testdb", Schema: "public", Table: "orders", Column: "status", Value: "cancelled", ExpectedCount: 3}
+		insert := domain.RowInsert{Values: map[string]interface{}{"id": "9"}}
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "atomicuser").
+			Return(&domain.TransactionState{ID: "txn_atomic", Username: "atomicuser", Database: "testdb", Schema: "public", Table: "orders"}, nil)
+		mockTransaction.EXPECT().GetRowEdits(gomock.Any(), "atomicuser").Return(map[int]domain.RowEdit{}, nil)
+		mockTransaction.EXPECT().GetRowInserts(gomock.Any(), "atomicuser").Return([]domain.RowInsert{insert}, nil)
+		mockTransaction.EXPECT().GetRowDeletes(gomock.Any(), "atomicuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetRowKeyDeletes(gomock.Any(), "atomicuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetBulkUpdates(gomock.Any(), "atomicuser").Return([]domain.BulkUpdate{update}, nil)
+
+		tx := &sql.Tx{}
+		gomock.InOrder(
+			mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil),
+			mockDatabase.EXPECT().UpdateRowsByFilter(gomock.Any(), tx, update).Return(int64(3), nil),
+			mockDatabase.EXPECT().UpsertRow(gomock.Any(), tx, "testdb", "public", "orders", insert).Return(false, errors.New("duplicate key")),
+			mockDatabase.EXPECT().RollbackTransaction(gomock.Any(), tx).Return(nil),
+		)
+
+		// Neither the transaction nor the audit log records a commit that did not happen
+		err := uc.CommitTransaction(ctx, "atomicuser", "")
+
+		require.EqualError(t, err, "duplicate key")
+	})
+
+	t.Run("UpsertRow buffers an insert that updates listed columns on conflict", func(t *testing.T) {
+		values := map[string]interface{}{"sku": "A-1", "stock": "5"}
+		onConflict := domain.ConflictAction{
+			Mode:          domain.ConflictModeUpdate,
+			Target:        []string{"sku"},
+			UpdateColumns: []string{"stock"},
+		}
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "upsertuser").
+			Return(&domain.TransactionState{ID: "txn_upsert", Username: "upsertuser", Database: "testdb", Schema: "public", Table: "products"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "products").
+			Return(&domain.TableMetadata{Name: "products", Columns: []domain.ColumnMetadata{{Name: "sku"}, {Name: "stock"}}}, nil)
+
+		mockTransaction.EXPECT().
+			AddRowInsert(gomock.Any(), "upsertuser", domain.RowInsert{Values: values, OnConflict: onConflict}).
+			Return(nil)
+
+		err := uc.UpsertRow(ctx, "upsertuser", "testdb", "public", "products", values, onConflict)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("UpsertRow rejects update columns missing from the new row", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "upsertuser").
+			Return(&domain.TransactionState{ID: "txn_upsert", Username: "upsertuser", Database: "testdb", Schema: "public", Table: "products"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "products").
+			Return(&domain.TableMetadata{Name: "products", Columns: []domain.ColumnMetadata{{Name: "sku"}, {Name: "stock"}}}, nil)
+
+		err := uc.UpsertRow(ctx, "upsertuser", "testdb", "public", "products", map[string]interface{}{"sku": "A-1"}, domain.ConflictAction{
+			Mode:          domain.ConflictModeUpdate,
+			Target:        []string{"sku"},
+			UpdateColumns: []string{"stock"},
+		})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "on_conflict", validationErr.Field)
+	})
+
+	t.Run("UpsertRow rejects an unknown conflict mode", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "upsertuser").
+			Return(&domain.TransactionState{ID: "txn_upsert", Username: "upsertuser", Database: "testdb", Schema: "public", Table: "products"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "products").
+			Return(&domain.TableMetadata{Name: "products", Columns: []domain.ColumnMetadata{{Name: "sku"}, {Name: "stock"}}}, nil)
+
+		err := uc.UpsertRow(ctx, "upsertuser", "testdb", "public", "products", map[string]interface{}{"sku": "A-1"}, domain.ConflictAction{Mode: "replace"})
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "on_conflict", validationErr.Field)
+	})
+
+	t.Run("CommitTransaction inserts buffered rows with their conflict clause", func(t *testing.T) {
+		insert := domain.RowInsert{
+			Values:     map[string]interface{}{"sku": "A-1", "stock": "5"},
+			OnConflict: domain.ConflictAction{Mode: domain.ConflictModeSkip, Target: []string{"sku"}},
+		}
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "upsertuser").
+			Return(&domain.TransactionState{ID: "txn_upsert", Username: "upsertuser", Database: "testdb", Schema: "public", Table: "products"}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowEdits(gomock.Any(), "upsertuser").
+			Return(map[int]domain.RowEdit{}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowInserts(gomock.Any(), "upsertuser").
+			Return([]domain.RowInsert{insert}, nil)
+
+		mockTransaction.EXPECT().
+			GetRowDeletes(gomock.Any(), "upsertuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "upsertuser").
+			Return(nil, nil)
+
+		mockTransaction.EXPECT().
+			GetBulkUpdates(gomock.Any(), "upsertuser").
+			Return(nil, nil)
+
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().
+			BeginTransaction(gomock.Any()).
+			Return(tx, nil)
+
+		mockDatabase.EXPECT().
+			UpsertRow(gomock.Any(), tx, "testdb", "public", "products", insert).
+			Return(false, nil)
+
+		mockDatabase.EXPECT().
+			CommitTransaction(gomock.Any(), tx).
+			Return(nil)
+
+		mockTransaction.EXPECT().
+			DeleteTransaction(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Len(t, entry.AffectedRows, 1)
+				require.Equal(t, "insert", entry.AffectedRows[0]["operation"])
+				require.Equal(t, domain.ConflictModeSkip, entry.AffectedRows[0]["on_conflict"])
+				return nil
+			})
+
+		err := uc.CommitTransaction(ctx, "upsertuser", "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CommitTransaction does not upsert buffered rows again on a second commit", func(t *testing.T) {
+		insert := domain.RowInsert{
+			Values:     map[string]interface{}{"sku": "B-2", "stock": "9"},
+			OnConflict: domain.ConflictAction{Mode: domain.ConflictModeUpdate, Target: []string{"sku"}, UpdateColumns: []string{"stock"}},
+		}
+		expectStoredTransaction(mockTransaction, &domain.TransactionState{
+			ID: "txn_upsert_twice", Username: "upserttwice", Database: "testdb", Schema: "public", Table: "products",
+			Inserts: []domain.RowInsert{insert},
+		})
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		mockDatabase.EXPECT().UpsertRow(gomock.Any(), tx, "testdb", "public", "products", insert).Return(true, nil).Times(1)
+		mockDatabase.EXPECT().CommitTransaction(gomock.Any(), tx).Return(nil)
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, uc.CommitTransaction(ctx, "upserttwice", ""))
+
+		err := uc.CommitTransaction(ctx, "upserttwice", "")
+		require.ErrorIs(t, err, domain.ErrNoActiveTransaction)
+	})
+
+	t.Run("CommitTransaction encrypts values written to encrypted columns", func(t *testing.T) {
+		insert := domain.RowInsert{Values: map[string]interface{}{"id": "3", "name": "Cy", "ssn": "123-45-6789"}}
+		update := domain.BulkUpdate{Database: "testdb", Schema: "public", Table: "customers", Column: "ssn", Value: "000-00-0000", WhereClause: "id = 1", ExpectedCount: 1}
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EncryptedColumns: []string{"public.customers.ssn"}, ColumnEncryptionKey: "deploy-key"}, nil)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "cryptuser").
+			Return(&domain.TransactionState{ID: "txn_crypt", Username: "cryptuser", Database: "testdb", Schema: "public", Table: "customers"}, nil)
+		mockTransaction.EXPECT().GetRowEdits(gomock.Any(), "cryptuser").Return(map[int]domain.RowEdit{}, nil)
+		mockTransaction.EXPECT().GetRowInserts(gomock.Any(), "cryptuser").Return([]domain.RowInsert{insert}, nil)
+		mockTransaction.EXPECT().GetRowDeletes(gomock.Any(), "cryptuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetRowKeyDeletes(gomock.Any(), "cryptuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetBulkUpdates(gomock.Any(), "cryptuser").Return([]domain.BulkUpdate{update}, nil)
+
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		encryptedUpdate := update
+		encryptedUpdate.Value = domain.EncryptedValue{Value: "000-00-0000", Key: "deploy-key"}
+		mockDatabase.EXPECT().UpdateRowsByFilter(gomock.Any(), tx, encryptedUpdate).Return(int64(1), nil)
+		mockDatabase.EXPECT().
+			UpsertRow(gomock.Any(), tx, "testdb", "public", "customers", domain.RowInsert{Values: map[string]interface{}{
+				"id":   "3",
+				"name": "Cy",
+				"ssn":  domain.EncryptedValue{Value: "123-45-6789", Key: "deploy-key"},
+			}}).
+			Return(true, nil)
+		mockDatabase.EXPECT().CommitTransaction(gomock.Any(), tx).Return(nil)
+		mockTransaction.EXPECT().DeleteTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+		// The audit log keeps the values as the user entered them, never the key
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, "000-00-0000", entry.AffectedRows[0]["value"])
+				require.Equal(t, "123-45-6789", entry.AffectedRows[1]["values"].(map[string]interface{})["ssn"])
+				return nil
+			})
+
+		err := uc.CommitTransaction(ctx, "cryptuser", "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CommitTransaction refuses plaintext writes to encrypted columns without a key", func(t *testing.T) {
+		insert := domain.RowInsert{Values: map[string]interface{}{"id": "3", "ssn": "123-45-6789"}}
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{EncryptedColumns: []string{"public.customers.ssn"}}, nil)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "cryptuser").
+			Return(&domain.TransactionState{ID: "txn_crypt", Username: "cryptuser", Database: "testdb", Schema: "public", Table: "customers"}, nil)
+		mockTransaction.EXPECT().GetRowEdits(gomock.Any(), "cryptuser").Return(map[int]domain.RowEdit{}, nil)
+		mockTransaction.EXPECT().GetRowInserts(gomock.Any(), "cryptuser").Return([]domain.RowInsert{insert}, nil)
+		mockTransaction.EXPECT().GetRowDeletes(gomock.Any(), "cryptuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetRowKeyDeletes(gomock.Any(), "cryptuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetBulkUpdates(gomock.Any(), "cryptuser").Return(nil, nil)
+
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		mockDatabase.EXPECT().RollbackTransaction(gomock.Any(), tx).Return(nil)
+
+		err := uc.CommitTransaction(ctx, "cryptuser", "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "ssn", validationErr.Field)
+	})
+
+	t.Run("NewRowDefaults offers generated uuid keys and default placeholders", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "uuiduser").
+			Return(&domain.TransactionState{ID: "txn_uuid", Username: "uuiduser", Database: "testdb", Schema: "public", Table: "devices"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "devices").
+			Return(&domain.TableMetadata{Name: "devices", Columns: []domain.ColumnMetadata{
+				{Name: "id", DataType: "uuid", IsPrimary: true},
+				{Name: "tenant_id", DataType: "uuid", IsPrimary: true, HasDefault: true},
+				{Name: "name", DataType: "text"},
+			}}, nil)
+
+		mockDatabase.EXPECT().
+			GenerateUUID(gomock.Any()).
+			Return("6f1c2a4e-0a4b-4f7e-9a51-3c2d1e0f9b8a", nil)
+
+		placeholders := map[string]domain.ColumnDefaultPreview{
+			"tenant_id":  {Expression: "current_tenant()", Value: "1f0e6a52-9a8d-4d1b-8f0e-2b7c1d3e4f50", Approximate: true},
+			"status":     {Expression: "'draft'::text", Value: "draft"},
+			"serial_num": {Expression: "nextval('devices_serial_num_seq'::regclass)", Value: "42", Approximate: true},
+		}
+		mockDatabase.EXPECT().
+			PreviewColumnDefaults(gomock.Any(), "testdb", "public", "devices").
+			Return(placeholders, nil)
+
+		defaults, err := uc.NewRowDefaults(ctx, "uuiduser", "testdb", "public", "devices")
+
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{"id": "6f1c2a4e-0a4b-4f7e-9a51-3c2d1e0f9b8a"}, defaults.Values)
+		require.Equal(t, placeholders, defaults.Placeholders)
+	})
+
+	t.Run("InsertRow fills a missing uuid key when the server cannot generate one", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "uuiduser").
+			Return(&domain.TransactionState{ID: "txn_uuid", Username: "uuiduser", Database: "testdb", Schema: "public", Table: "devices"}, nil)
+
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "devices").
+			Return(&domain.TableMetadata{Name: "devices", Columns: []domain.ColumnMetadata{
+				{Name: "id", DataType: "uuid", IsPrimary: true},
+				{Name: "name", DataType: "text"},
+			}}, nil)
+
+		mockDatabase.EXPECT().
+			GenerateUUID(gomock.Any()).
+			Return("", errors.New("function gen_random_uuid() does not exist"))
+
+		mockTransaction.EXPECT().
+			AddRowInsert(gomock.Any(), "uuiduser", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, transactionID string, insert domain.RowInsert) error {
+				id, ok := insert.Values["id"].(string)
+				require.True(t, ok)
+				_, err := uuid.Parse(id)
+				require.NoError(t, err)
+				require.Equal(t, "sensor", insert.Values["name"])
+				return nil
+			})
+
+		values := map[string]interface{}{"id": "", "name": "sensor"}
+		err := uc.InsertRow(ctx, "uuiduser", "testdb", "public", "devices", values)
+
+		require.NoError(t, err)
+		require.Equal(t, "", values["id"])
+	})
+
+	ordersMetadata := &domain.TableMetadata{Name: "orders", Columns: []domain.ColumnMetadata{
+		{Name: "id", DataType: "bigint", IsPrimary: true},
+		{Name: "quantity", DataType: "integer"},
+		{Name: "status", DataType: "character varying"},
+		{Name: "price", DataType: "numeric", NumericPrecision: 6, NumericScale: 2},
+		{Name: "paid", DataType: "boolean"},
+		{Name: "shipped_at", DataType: "timestamp with time zone"},
+		{Name: "ship_date", DataType: "date"},
+		{Name: "weight", DataType: "real"},
+	}}
+
+	t.Run("EditCell rejects values the column type cannot convert", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "typeuser").
+			Return(&domain.TransactionState{ID: "txn_type", Username: "typeuser"}, nil).
+			Times(7)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(ordersMetadata, nil).
+			Times(7)
+
+		var validationErr domain.ValidationError
+		err := uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "quantity", "12.5")
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "quantity", validationErr.Field)
+		require.Contains(t, validationErr.Message, "whole number")
+
+		err = uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "quantity", "3000000000")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "between -2147483648 and 2147483647")
+
+		err = uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "price", "9999.999")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "numeric(6,2) holds at most 4 digits before the decimal point")
+
+		err = uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "paid", "maybe")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "yes/no")
+
+		err = uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "shipped_at", "31/12/2024 10:00")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "YYYY-MM-DD[ HH:MM[:SS[.ffffff]]]")
+
+		err = uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "ship_date", "2024-02-30")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "not a valid date")
+
+		err = uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, "weight", "0x1p-2")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "NaN, Infinity")
+	})
+
+	t.Run("EditCell buffers values in the formats PostgreSQL accepts", func(t *testing.T) {
+		values := map[string]string{
+			"quantity":   " 42 ",
+			"price":      "9999.994",
+			"paid":       "Y",
+			"shipped_at": "2024-12-31T10:00:00.123+02:00",
+			"ship_date":  "Dec 31, 2024",
+			"weight":     "-Infinity",
+			"status":     "anything goes",
+		}
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "typeuser").
+			Return(&domain.TransactionState{ID: "txn_type", Username: "typeuser"}, nil).
+			Times(len(values))
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(ordersMetadata, nil).
+			Times(len(values))
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "orders").
+			Return(nil, nil).
+			Times(len(values))
+		mockTransaction.EXPECT().
+			AddRowEdit(gomock.Any(), "typeuser", gomock.Any()).
+			Return(nil).
+			Times(len(values))
+
+		for column, value := range values {
+			require.NoError(t, uc.EditCell(ctx, "typeuser", "testdb", "public", "orders", 0, column, value), column)
+		}
+	})
+
+	t.Run("EditCell rejects values outside a range or IN-list check constraint", func(t *testing.T) {
+		constraints := []domain.CheckConstraint{
+			{Name: "orders_quantity_check", Definition: "CHECK (((quantity >= 1) AND (quantity <= 100)))"},
+			{Name: "orders_status_check", Definition: "CHECK (((status)::text = ANY ((ARRAY['draft'::character varying, 'paid'::character varying])::text[])))"},
+			{Name: "orders_price_check", Definition: "CHECK ((price > (0)::numeric))"},
+			{Name: "orders_window_check", Definition: "CHECK (((shipped_at IS NULL) OR (shipped_at > created_at)))"},
+		}
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "checkuser").
+			Return(&domain.TransactionState{ID: "txn_check", Username: "checkuser"}, nil).
+			Times(4)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(ordersMetadata, nil).
+			Times(4)
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "orders").
+			Return(constraints, nil).
+			Times(4)
+
+		err := uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "quantity", "150")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "quantity", validationErr.Field)
+		require.Contains(t, validationErr.Message, "orders_quantity_check")
+
+		err = uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "status", "shipped")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "orders_status_check")
+
+		err = uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "price", "0")
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "orders_price_check")
+
+		// Conditions spanning columns or joined by OR are left for the commit to enforce
+		mockTransaction.EXPECT().
+			AddRowEdit(gomock.Any(), "checkuser", domain.RowEdit{RowIndex: 0, ColumnName: "shipped_at", NewValue: "2000-01-01"}).
+			Return(nil)
+		err = uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "shipped_at", "2000-01-01")
+		require.NoError(t, err)
+	})
+
+	t.Run("EditCell rejects values outside an enum type's labels", func(t *testing.T) {
+		ticketsMetadata := &domain.TableMetadata{
+			Name: "tickets",
+			Columns: []domain.ColumnMetadata{
+				{Name: "id", DataType: "integer", IsPrimary: true},
+				{Name: "priority", DataType: "USER-DEFINED", EnumValues: []string{"low", "normal", "urgent"}},
+			},
+		}
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "enumuser").
+			Return(&domain.TransactionState{ID: "txn_enum", Username: "enumuser"}, nil).
+			Times(2)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "tickets").
+			Return(ticketsMetadata, nil).
+			Times(2)
+
+		err := uc.EditCell(ctx, "enumuser", "testdb", "public", "tickets", 0, "priority", "Urgent")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "priority", validationErr.Field)
+		require.Contains(t, validationErr.Message, "expected one of low, normal, urgent")
+
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "tickets").
+			Return(nil, nil)
+		mockTransaction.EXPECT().
+			AddRowEdit(gomock.Any(), "enumuser", domain.RowEdit{RowIndex: 0, ColumnName: "priority", NewValue: "urgent"}).
+			Return(nil)
+		require.NoError(t, uc.EditCell(ctx, "enumuser", "testdb", "public", "tickets", 0, "priority", "urgent"))
+	})
+
+	t.Run("CellValueOptions lists enum labels, or the values every IN-list check allows", func(t *testing.T) {
+		metadata := &domain.TableMetadata{
+			Name: "tickets",
+			Columns: []domain.ColumnMetadata{
+				{Name: "priority", DataType: "USER-DEFINED", EnumValues: []string{"low", "normal", "urgent"}},
+				{Name: "status", DataType: "text"},
+				{Name: "note", DataType: "text"},
+			},
+		}
+		constraints := []domain.CheckConstraint{
+			{Name: "tickets_status_check", Definition: "CHECK ((status = ANY (ARRAY['open'::text, 'held'::text, 'closed'::text])))"},
+			{Name: "tickets_status_live_check", Definition: "CHECK ((status <> 'held'::text))"},
+			{Name: "tickets_status_known_check", Definition: "CHECK ((status = ANY (ARRAY['open'::text, 'held'::text, 'closed'::text, 'archived'::text])))"},
+		}
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "optionsuser").
+			Return(&domain.TransactionState{ID: "txn_options", Username: "optionsuser"}, nil).
+			Times(4)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "tickets").
+			Return(metadata, nil).
+			Times(4)
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "tickets").
+			Return(constraints, nil).
+			Times(2)
+
+		options, err := uc.CellValueOptions(ctx, "optionsuser", "testdb", "public", "tickets", "priority")
+		require.NoError(t, err)
+		require.Equal(t, domain.CellValueSourceEnum, options.Source)
+		require.Equal(t, []string{"low", "normal", "urgent"}, options.AllowedValues)
+
+		// Only IN lists are offered; the <> check is left for EditCell to enforce
+		options, err = uc.CellValueOptions(ctx, "optionsuser", "testdb", "public", "tickets", "status")
+		require.NoError(t, err)
+		require.Equal(t, domain.CellValueSourceCheck, options.Source)
+		require.Equal(t, []string{"open", "held", "closed"}, options.AllowedValues)
+
+		options, err = uc.CellValueOptions(ctx, "optionsuser", "testdb", "public", "tickets", "note")
+		require.NoError(t, err)
+		require.Empty(t, options.AllowedValues)
+
+		_, err = uc.CellValueOptions(ctx, "optionsuser", "testdb", "public", "tickets", "missing")
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("EditCell buffers values that satisfy check constraints", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "checkuser").
+			Return(&domain.TransactionState{ID: "txn_check", Username: "checkuser"}, nil).
+			Times(3)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(ordersMetadata, nil).
+			Times(2)
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "orders").
+			Return([]domain.CheckConstraint{
+				{Name: "orders_quantity_check", Definition: "CHECK (((quantity >= 1) AND (quantity <= 100)))"},
+				{Name: "orders_status_check", Definition: "CHECK ((status = ANY (ARRAY['draft'::text, 'paid'::text])))"},
+			}, nil).
+			Times(2)
+		mockTransaction.EXPECT().
+			AddRowEdit(gomock.Any(), "checkuser", gomock.Any()).
+			Return(nil).
+			Times(3)
+
+		require.NoError(t, uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "quantity", "100"))
+		require.NoError(t, uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "status", "paid"))
+		// NULL passes every type and CHECK constraint, so neither the columns nor the constraints are fetched
+		require.NoError(t, uc.EditCell(ctx, "checkuser", "testdb", "public", "orders", 0, "quantity", nil))
+	})
+
+	t.Run("EditCell keeps earlier values of the same cell as its history", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "historyuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_history",
+				Username: "historyuser",
+				Edits: map[int]domain.RowEdit{
+					3: {RowIndex: 3, ColumnName: "status", NewValue: "paid", History: []interface{}{"open"}},
+				},
+			}, nil)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "orders").
+			Return(ordersMetadata, nil)
+		mockDatabase.EXPECT().
+			GetCheckConstraints(gomock.Any(), "testdb", "public", "orders").
+			Return(nil, nil)
+		mockTransaction.EXPECT().
+			AddRowEdit(gomock.Any(), "historyuser", domain.RowEdit{
+				RowIndex:   3,
+				ColumnName: "status",
+				NewValue:   "shipped",
+				History:    []interface{}{"open", "paid"},
+			}).
+			Return(nil)
+
+		err := uc.EditCell(ctx, "historyuser", "testdb", "public", "orders", 3, "status", "shipped")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("RestoreCellValue steps one cell back and leaves other edits alone", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "historyuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_history",
+				Username: "historyuser",
+				Edits: map[int]domain.RowEdit{
+					3: {RowIndex: 3, ColumnName: "status", NewValue: "shipped", History: []interface{}{"open", "paid"}},
+					4: {RowIndex: 4, ColumnName: "total", NewValue: "12.50"},
+				},
+			}, nil)
+		mockTransaction.EXPECT().
+			UpdateTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, txn *domain.TransactionState) error {
+				require.Equal(t, map[int]domain.RowEdit{
+					3: {RowIndex: 3, ColumnName: "status", NewValue: "paid", History: []interface{}{"open"}},
+					4: {RowIndex: 4, ColumnName: "total", NewValue: "12.50"},
+				}, txn.Edits)
+				return nil
+			})
+
+		edit, err := uc.RestoreCellValue(ctx, "historyuser", 3, "status")
+
+		require.NoError(t, err)
+		require.Equal(t, "paid", edit.NewValue)
+	})
+
+	t.Run("RestoreCellValue drops the edit once the cell is back to its original value", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "historyuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_history",
+				Username: "historyuser",
+				Edits: map[int]domain.RowEdit{
+					4: {RowIndex: 4, ColumnName: "total", NewValue: "12.50"},
+				},
+			}, nil)
+		mockTransaction.EXPECT().
+			UpdateTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, txn *domain.TransactionState) error {
+				require.Empty(t, txn.Edits)
+				return nil
+			})
+
+		edit, err := uc.RestoreCellValue(ctx, "historyuser", 4, "total")
+
+		require.NoError(t, err)
+		require.Nil(t, edit)
+	})
+
+	t.Run("RestoreCellValue rejects a cell without a buffered edit", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "historyuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_history",
+				Username: "historyuser",
+				Edits: map[int]domain.RowEdit{
+					4: {RowIndex: 4, ColumnName: "total", NewValue: "12.50"},
+				},
+			}, nil)
+
+		_, err := uc.RestoreCellValue(ctx, "historyuser", 4, "status")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "column", validationErr.Field)
+	})
+
+	t.Run("ExportTransaction writes the buffered changes as a SQL script", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "exportuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_export",
+				Username: "exportuser",
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "orders",
+				Edits: map[int]domain.RowEdit{
+					3: {RowIndex: 3, ColumnName: "status", OldValue: "open", NewValue: "O'Brien"},
+				},
+				Deletes:    []int{7},
+				KeyDeletes: []map[string]interface{}{{"id": int64(42)}},
+				BulkUpdates: []domain.BulkUpdate{
+					{Column: "archived", Value: true, WhereClause: "total < 10", ExpectedCount: 5},
+				},
+				Inserts: []domain.RowInsert{
+					{
+						Values:     map[string]interface{}{"id": 9, "note": nil},
+						OnConflict: domain.ConflictAction{Mode: domain.ConflictModeSkip, Target: []string{"id"}},
+					},
+				},
+			}, nil)
+
+		export, err := uc.ExportTransaction(ctx, "exportuser", domain.TransactionExportSQL)
+
+		require.NoError(t, err)
+		require.Equal(t, "orders_changes.sql", export.Filename)
+		script := string(export.Content)
+		require.Contains(t, script, "BEGIN;\n")
+		require.Contains(t, script, `-- UPDATE "public"."orders" SET "status" = 'O''Brien' WHERE "status" IS NOT DISTINCT FROM 'open' AND <key of row 3>;`)
+		require.Contains(t, script, `-- DELETE FROM "public"."orders" WHERE <key of row 7>;`)
+		require.Contains(t, script, "\nDELETE FROM \"public\".\"orders\" WHERE \"id\" = 42;\n")
+		require.Contains(t, script, "\nUPDATE \"public\".\"orders\" SET \"archived\" = TRUE WHERE total < 10;\n")
+		require.Contains(t, script, "\nINSERT INTO \"public\".\"orders\" (\"id\", \"note\") VALUES (9, NULL) ON CONFLICT (\"id\") DO NOTHING;\n")
+		require.True(t, strings.HasSuffix(script, "COMMIT;\n"))
+	})
+
+	t.Run("EditJSONCell applies set and remove operations on top of the buffered value", func(t *testing.T) {
+		metadata := &domain.TableMetadata{
+			Name:    "profiles",
+			Columns: []domain.ColumnMetadata{{Name: "settings", DataType: "jsonb"}},
+		}
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "profiles").
+			Return(metadata, nil).
+			Times(2)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "jsonuser").
+			Return(&domain.TransactionState{ID: "txn_json", Username: "jsonuser", Edits: map[int]domain.RowEdit{}}, nil)
+		mockTransaction.EXPECT().
+			AddRowEdit(gomock.Any(), "jsonuser", gomock.Any()).
+			Return(nil).
+			Times(2)
+
+		first := []domain.JSONPatchOp{
+			{Op: domain.JSONPatchOpSet, Path: []string{"theme", "color"}, Value: `"dark"`},
+			{Op: domain.JSONPatchOpRemove, Path: []string{"tags", "0"}},
+		}
+		edit, err := uc.EditJSONCell(ctx, "jsonuser", "testdb", "public", "profiles", 2, "settings",
+			`{"theme":{"color":"light","size":1.50},"tags":["a","b"]}`, first)
+		require.NoError(t, err)
+		require.Equal(t, `{"tags":["b"],"theme":{"color":"dark","size":1.50}}`, edit.NewValue)
+		require.Equal(t, first, edit.JSONPatches)
+
+		// A second edit starts from the buffered document and keeps the earlier patches
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "jsonuser").
+			Return(&domain.TransactionState{ID: "txn_json", Username: "jsonuser", Edits: map[int]domain.RowEdit{2: *edit}}, nil)
+		second := []domain.JSONPatchOp{{Op: domain.JSONPatchOpSet, Path: []string{"tags", "-9"}, Value: `"z"`}}
+		next, err := uc.EditJSONCell(ctx, "jsonuser", "testdb", "public", "profiles", 2, "settings", "ignored", second)
+		require.NoError(t, err)
+		require.Equal(t, `{"tags":["z","b"],"theme":{"color":"dark","size":1.50}}`, next.NewValue)
+		require.Equal(t, edit.OldValue, next.OldValue)
+		require.Equal(t, []interface{}{edit.NewValue}, next.History)
+		require.Len(t, next.JSONPatches, 3)
+	})
+
+	t.Run("EditJSONCell rejects invalid values, missing paths and non-jsonb columns", func(t *testing.T) {
+		metadata := &domain.TableMetadata{
+			Name: "profiles",
+			Columns: []domain.ColumnMetadata{
+				{Name: "settings", DataType: "jsonb"},
+				{Name: "bio", DataType: "text"},
+			},
+		}
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "badjsonuser").
+			Return(&domain.TransactionState{ID: "txn_badjson", Username: "badjsonuser"}, nil).
+			Times(4)
+		mockDatabase.EXPECT().
+			GetTableMetadata(gomock.Any(), "testdb", "public", "profiles").
+			Return(metadata, nil).
+			Times(4)
+
+		var validationErr domain.ValidationError
+		_, err := uc.EditJSONCell(ctx, "badjsonuser", "testdb", "public", "profiles", 0, "settings", `{"a":1}`,
+			[]domain.JSONPatchOp{{Op: domain.JSONPatchOpSet, Path: []string{"a"}, Value: `{not json`}})
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "not valid JSON")
+
+		_, err = uc.EditJSONCell(ctx, "badjsonuser", "testdb", "public", "profiles", 0, "settings", `{"a":1}`,
+			[]domain.JSONPatchOp{{Op: domain.JSONPatchOpSet, Path: []string{"b", "c"}, Value: `1`}})
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, `path {"b"} does not exist`)
+
+		_, err = uc.EditJSONCell(ctx, "badjsonuser", "testdb", "public", "profiles", 0, "settings", `{"a":1}`,
+			[]domain.JSONPatchOp{{Op: domain.JSONPatchOpSet, Path: []string{"a", "b"}, Value: `1`}})
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "passes through a scalar")
+
+		_, err = uc.EditJSONCell(ctx, "badjsonuser", "testdb", "public", "profiles", 0, "bio", `{}`,
+			[]domain.JSONPatchOp{{Op: domain.JSONPatchOpRemove, Path: []string{"a"}}})
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, validationErr.Message, "not a jsonb column")
+	})
+
+	t.Run("ExportTransaction compiles structured JSONB edits to jsonb_set", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "jsonexportuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_jsonexport",
+				Username: "jsonexportuser",
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "profiles",
+				Edits: map[int]domain.RowEdit{
+					1: {
+						RowIndex:   1,
+						ColumnName: "settings",
+						OldValue:   `{"theme":{}}`,
+						NewValue:   `{"theme":{"color":"it's"}}`,
+						JSONPatches: []domain.JSONPatchOp{
+							{Op: domain.JSONPatchOpSet, Path: []string{"theme", "color"}, Value: `"it's"`},
+							{Op: domain.JSONPatchOpRemove, Path: []string{"old"}},
+						},
+					},
+				},
+			}, nil)
+
+		export, err := uc.ExportTransaction(ctx, "jsonexportuser", domain.TransactionExportSQL)
+
+		require.NoError(t, err)
+		require.Contains(t, string(export.Content),
+			`SET "settings" = (jsonb_set("settings", '{"theme","color"}', '"it''s"'::jsonb) #- '{"old"}') WHERE`)
+	})
+
+	t.Run("ExportTransaction writes the buffered changes as a JSON patch document", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "exportuser").
+			Return(&domain.TransactionState{
+				ID:       "txn_export",
+				Username: "exportuser",
+				Database: "testdb",
+				Schema:   "public",
+				Table:    "orders",
+				Edits: map[int]domain.RowEdit{
+					3: {RowIndex: 3, ColumnName: "a/b", OldValue: "open", NewValue: "paid"},
+				},
+				KeyDeletes: []map[string]interface{}{{"id": 42}},
+				Inserts:    []domain.RowInsert{{Values: map[string]interface{}{"id": 9}}},
+			}, nil)
+
+		export, err := uc.ExportTransaction(ctx, "exportuser", domain.TransactionExportJSONPatch)
+
+		require.NoError(t, err)
+		require.Equal(t, "orders_changes.json", export.Filename)
+		var document struct {
+			Table      string                   `json:"table"`
+			Operations []map[string]interface{} `json:"operations"`
+		}
+		require.NoError(t, json.Unmarshal(export.Content, &document))
+		require.Equal(t, "orders", document.Table)
+		require.Equal(t, []map[string]interface{}{
+			{"op": "test", "path": "/rows/3/a~1b", "value": "open"},
+			{"op": "replace", "path": "/rows/3/a~1b", "value": "paid"},
+			{"op": "remove", "path": "/rows", "key": map[string]interface{}{"id": float64(42)}},
+			{"op": "add", "path": "/rows/-", "value": map[string]interface{}{"id": float64(9)}},
+		}, document.Operations)
+	})
+
+	t.Run("ExportTransaction rejects unknown formats", func(t *testing.T) {
+		_, err := uc.ExportTransaction(ctx, "exportuser", "xml")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "format", validationErr.Field)
+	})
+
+	t.Run("CheckTableLocks keeps only locks a commit may block behind", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			GetTableLocks(gomock.Any(), "testdb", "public", "orders").
+			Return([]domain.TableLock{
+				{PID: 101, Username: "migrator", Mode: "AccessExclusiveLock", Granted: true, HeldSince: time.Now(), Query: "ALTER TABLE orders ADD COLUMN note text", ClientAddr: "10.0.0.5"},
+				{PID: 102, Username: "reporter", Mode: "AccessShareLock", Granted: true, HeldSince: time.Now()},
+				{PID: 103, Username: "lockuser", Mode: "RowExclusiveLock", Granted: true, HeldSince: time.Now().Add(-time.Hour), Query: "UPDATE orders SET total = 0", ClientAddr: "10.0.0.6"},
+			}, nil)
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "lockuser").
+			Return(false, nil)
+
+		locks, err := uc.CheckTableLocks(ctx, "lockuser", "testdb", "public", "orders")
+
+		require.NoError(t, err)
+		require.Len(t, locks, 2)
+		require.Equal(t, 101, locks[0].PID)
+		require.Equal(t, "migrator", locks[0].Username)
+		require.Empty(t, locks[0].Query)
+		require.Empty(t, locks[0].ClientAddr)
+		require.Equal(t, 103, locks[1].PID)
+		require.Equal(t, "UPDATE orders SET total = 0", locks[1].Query)
+	})
+
+	t.Run("CheckTableLocks shows superusers every session's activity", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			GetTableLocks(gomock.Any(), "testdb", "public", "orders").
+			Return([]domain.TableLock{
+				{PID: 101, Username: "migrator", Mode: "AccessExclusiveLock", Granted: true, Query: "ALTER TABLE orders ADD COLUMN note text", ClientAddr: "10.0.0.5"},
+			}, nil)
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		locks, err := uc.CheckTableLocks(ctx, "postgres", "testdb", "public", "orders")
+
+		require.NoError(t, err)
+		require.Len(t, locks, 1)
+		require.Equal(t, "ALTER TABLE orders ADD COLUMN note text", locks[0].Query)
+		require.Equal(t, "10.0.0.5", locks[0].ClientAddr)
+	})
+
+	t.Run("CheckTableLocks reports nothing for short-lived non-conflicting locks", func(t *testing.T) {
+		mockDatabase.EXPECT().
+			GetTableLocks(gomock.Any(), "testdb", "public", "orders").
+			Return([]domain.TableLock{
+				{PID: 102, Username: "reporter", Mode: "AccessShareLock", Granted: true, HeldSince: time.Now()},
+			}, nil)
+
+		locks, err := uc.CheckTableLocks(ctx, "lockuser", "testdb", "public", "orders")
+
+		require.NoError(t, err)
+		require.Empty(t, locks)
+	})
+
+	t.Run("StartTransaction is refused in maintenance mode", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "testuser").
+			Return(nil, ErrNoActiveTransaction)
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceMessage: "Upgrading to PG 17", MaintenanceSince: time.Now()}, nil)
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "testuser").
+			Return(false, nil)
+
+		_, err := uc.StartTransaction(ctx, "testuser", "testdb", "public", "users")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "maintenance", validationErr.Field)
+		require.Equal(t, "Upgrading to PG 17", validationErr.Message)
+	})
+
+	t.Run("StartTransaction lets superusers in during maintenance mode", func(t *testing.T) {
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "postgres").
+			Return(nil, ErrNoActiveTransaction)
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceSince: time.Now()}, nil)
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "postgres").
+			Return(true, nil)
+
+		mockTransaction.EXPECT().
+			CreateTransaction(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		mockTransaction.EXPECT().
+			GetTransaction(gomock.Any(), gomock.Any()).
+			Return(&domain.TransactionState{ID: "txn_admin", Username: "postgres"}, nil)
+
+		txn, err := uc.StartTransaction(ctx, "postgres", "testdb", "public", "users")
+
+		require.NoError(t, err)
+		require.Equal(t, "postgres", txn.Username)
+	})
+
+	t.Run("CommitTransaction allows transactions started before maintenance within the grace period", func(t *testing.T) {
+		since := time.Now().Add(-time.Minute)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "graceuser").
+			Return(&domain.TransactionState{ID: "txn_grace", Username: "graceuser", Schema: "public", Table: "users", StartedAt: since.Add(-time.Minute)}, nil)
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceSince: since}, nil)
+
+		mockTransaction.EXPECT().GetRowEdits(gomock.Any(), "graceuser").Return(map[int]domain.RowEdit{}, nil)
+		mockTransaction.EXPECT().GetRowInserts(gomock.Any(), "graceuser").Return([]domain.RowInsert{}, nil)
+		mockTransaction.EXPECT().GetRowDeletes(gomock.Any(), "graceuser").Return([]int{}, nil)
+		mockTransaction.EXPECT().GetRowKeyDeletes(gomock.Any(), "graceuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetBulkUpdates(gomock.Any(), "graceuser").Return(nil, nil)
+		mockTransaction.EXPECT().DeleteTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := uc.CommitTransaction(ctx, "graceuser", "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CommitTransaction is refused once the maintenance grace period is over", func(t *testing.T) {
+		since := time.Now().Add(-time.Duration(domain.MaintenanceGracePeriod+60) * time.Second)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "lateuser").
+			Return(&domain.TransactionState{ID: "txn_late", Username: "lateuser", StartedAt: since.Add(-time.Minute)}, nil)
+
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{MaintenanceMode: true, MaintenanceSince: since}, nil)
+
+		mockRBAC.EXPECT().
+			IsSuperuser(gomock.Any(), "lateuser").
+			Return(false, nil)
+
+		err := uc.CommitTransaction(ctx, "lateuser", "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "maintenance", validationErr.Field)
+		require.Equal(t, domain.DefaultMaintenanceMessage, validationErr.Message)
+	})
+
+	t.Run("CommitTransaction keeps the rows it deletes for the retention window", func(t *testing.T) {
+		mockConfig.EXPECT().
+			GetConfig(gomock.Any()).
+			Return(&domain.AppConfig{DeletedRowRetention: time.Hour}, nil)
+
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "keepuser").
+			Return(&domain.TransactionState{ID: "txn_keep", Username: "keepuser", Database: "testdb", Schema: "public", Table: "users"}, nil)
+
+		mockTransaction.EXPECT().GetRowEdits(gomock.Any(), "keepuser").Return(map[int]domain.RowEdit{}, nil)
+		mockTransaction.EXPECT().GetRowInserts(gomock.Any(), "keepuser").Return([]domain.RowInsert{}, nil)
+		mockTransaction.EXPECT().GetRowDeletes(gomock.Any(), "keepuser").Return([]int{}, nil)
+		mockTransaction.EXPECT().
+			GetRowKeyDeletes(gomock.Any(), "keepuser").
+			Return([]map[string]interface{}{{"id": 7}, {"id": 8}}, nil)
+		mockTransaction.EXPECT().GetBulkUpdates(gomock.Any(), "keepuser").Return(nil, nil)
+
+		// The kept copies are the rows the DELETE returned, not a read made before it
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		mockDatabase.EXPECT().
+			DeleteRowsByKey(gomock.Any(), tx, "testdb", "public", "users", []map[string]interface{}{{"id": 7}, {"id": 8}}).
+			Return([]map[string]interface{}{{"id": int64(7), "name": "Ann"}, {"id": int64(8), "name": "Bob"}}, nil)
+		mockDatabase.EXPECT().CommitTransaction(gomock.Any(), tx).Return(nil)
+
+		mockTransaction.EXPECT().DeleteTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				entry.ID = "audit_keep"
+				return nil
+			})
+
+		mockAudit.EXPECT().
+			RecordDeletedRows(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, rows *domain.DeletedRows) error {
+				require.Equal(t, "audit_keep", rows.EntryID)
+				require.Equal(t, "users", rows.Table)
+				require.Len(t, rows.Rows, 2)
+				require.Equal(t, "Bob", rows.Rows[1]["name"])
+				require.WithinDuration(t, time.Now().Add(time.Hour), rows.ExpiresAt, time.Minute)
+				return nil
+			})
+
+		err := uc.CommitTransaction(ctx, "keepuser", "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("CommitTransaction does not replay key deletes and lets the user start again", func(t *testing.T) {
+		keys := []map[string]interface{}{{"id": 9}}
+		expectStoredTransaction(mockTransaction, &domain.TransactionState{
+			ID: "txn_delete_twice", Username: "deletetwice", Database: "testdb", Schema: "public", Table: "users",
+			KeyDeletes: keys,
+		})
+
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil).Times(2)
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		mockDatabase.EXPECT().
+			DeleteRowsByKey(gomock.Any(), tx, "testdb", "public", "users", keys).
+			Return([]map[string]interface{}{{"id": int64(9)}}, nil).
+			Times(1)
+		mockDatabase.EXPECT().CommitTransaction(gomock.Any(), tx).Return(nil)
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+		mockAudit.EXPECT().RecordDeletedRows(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, uc.CommitTransaction(ctx, "deletetwice", ""))
+		require.ErrorIs(t, uc.CommitTransaction(ctx, "deletetwice", ""), domain.ErrNoActiveTransaction)
+
+		mockTransaction.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).Return(nil)
+		mockTransaction.EXPECT().
+			GetTransaction(gomock.Any(), gomock.Any()).
+			Return(&domain.TransactionState{ID: "txn_delete_next", Username: "deletetwice"}, nil)
+
+		next, err := uc.StartTransaction(ctx, "deletetwice", "testdb", "public", "users")
+		require.NoError(t, err)
+		require.Equal(t, "txn_delete_next", next.ID)
+	})
+
+	t.Run("CommitTransaction keeps no copies of rows a failed DELETE left in place", func(t *testing.T) {
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockTransaction.EXPECT().
+			GetUserTransaction(gomock.Any(), "goneuser").
+			Return(&domain.TransactionState{ID: "txn_gone", Username: "goneuser", Database: "testdb", Schema: "public", Table: "users"}, nil)
+		mockTransaction.EXPECT().GetRowEdits(gomock.Any(), "goneuser").Return(map[int]domain.RowEdit{}, nil)
+		mockTransaction.EXPECT().GetRowInserts(gomock.Any(), "goneuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetRowDeletes(gomock.Any(), "goneuser").Return(nil, nil)
+		mockTransaction.EXPECT().GetRowKeyDeletes(gomock.Any(), "goneuser").Return([]map[string]interface{}{{"id": 7}}, nil)
+		mockTransaction.EXPECT().GetBulkUpdates(gomock.Any(), "goneuser").Return(nil, nil)
+
+		tx := &sql.Tx{}
+		mockDatabase.EXPECT().BeginTransaction(gomock.Any()).Return(tx, nil)
+		mockDatabase.EXPECT().
+			DeleteRowsByKey(gomock.Any(), tx, "testdb", "public", "users", gomock.Any()).
+			Return(nil, domain.ValidationError{Field: "keys", Message: "primary key (id=7) matches 0 rows instead of 1; nothing was deleted"})
+		mockDatabase.EXPECT().RollbackTransaction(gomock.Any(), tx).Return(nil)
+
+		err := uc.CommitTransaction(ctx, "goneuser", "")
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "keys", validationErr.Field)
+	})
+
+	deletedUsers := &domain.DeletedRows{
+		EntryID:  "audit_keep",
+		Database: "testdb",
+		Schema:   "public",
+		Table:    "users",
+		Rows: []map[string]interface{}{
+			{"id": json.Number("7"), "name": "Ann"},
+			{"id": json.Number("8"), "name": "O'Brien"},
+		},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	restoreStatement := `INSERT INTO "public"."users" ("id", "name") OVERRIDING SYSTEM VALUE VALUES (7, 'Ann'), (8, 'O''Brien')`
+
+	t.Run("RestoreDeletedRows previews the compensating insert", func(t *testing.T) {
+		mockAudit.EXPECT().GetDeletedRows(gomock.Any(), "audit_keep").Return(deletedUsers, nil)
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), domain.AuditFilter{Action: domain.AuditActionRestoreRows, Database: "testdb", Schema: "public", Table: "users"}).
+			Return([]domain.AuditEntry{}, nil)
+
+		restore, err := uc.RestoreDeletedRows(ctx, "restoreuser", "audit_keep", "")
+
+		require.NoError(t, err)
+		require.Equal(t, restoreStatement, restore.Statement)
+		require.Equal(t, 2, restore.Rows)
+		require.False(t, restore.Applied)
+	})
+
+	t.Run("RestoreDeletedRows runs the confirmed insert and audits it", func(t *testing.T) {
+		mockAudit.EXPECT().GetDeletedRows(gomock.Any(), "audit_keep").Return(deletedUsers, nil)
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockAudit.EXPECT().GetEntries(gomock.Any(), gomock.Any()).Return([]domain.AuditEntry{}, nil)
+		mockDatabase.EXPECT().ExecuteQuery(gomock.Any(), restoreStatement).Return(&domain.QueryResult{}, nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionRestoreRows, entry.Action)
+				require.Equal(t, "audit_keep", entry.Target)
+				require.Len(t, entry.AffectedRows, 2)
+				return nil
+			})
+
+		restore, err := uc.RestoreDeletedRows(ctx, "restoreuser", "audit_keep", restoreStatement)
+
+		require.NoError(t, err)
+		require.True(t, restore.Applied)
+	})
+
+	t.Run("RestoreDeletedRows requires INSERT privilege", func(t *testing.T) {
+		// The shared RBAC mock grants every privilege, so this denial needs its own
+		denyCtrl := gomock.NewController(t)
+		denyRBAC := mockRepository.NewMockRBACRepository(denyCtrl)
+		denyAudit := mockRepository.NewMockAuditRepository(denyCtrl)
+		denyConfig := mockRepository.NewMockConfigRepository(denyCtrl)
+		denyUC := constructor(mockRepository.NewMockTransactionRepository(denyCtrl), mockRepository.NewMockDatabaseRepository(denyCtrl), denyRBAC, denyAudit, denyConfig)
+
+		denyAudit.EXPECT().GetDeletedRows(gomock.Any(), "audit_keep").Return(deletedUsers, nil)
+		denyConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		denyRBAC.EXPECT().HasInsertPermission(gomock.Any(), "readonly", "testdb", "public", "users").Return(false, nil)
+
+		_, err := denyUC.RestoreDeletedRows(ctx, "readonly", "audit_keep", restoreStatement)
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "permission", validationErr.Field)
+	})
+
+	t.Run("RestoreDeletedRows refuses rows already restored", func(t *testing.T) {
+		mockAudit.EXPECT().GetDeletedRows(gomock.Any(), "audit_keep").Return(deletedUsers, nil)
+		mockConfig.EXPECT().GetConfig(gomock.Any()).Return(&domain.AppConfig{}, nil)
+		mockAudit.EXPECT().
+			GetEntries(gomock.Any(), gomock.Any()).
+			Return([]domain.AuditEntry{{Username: "otheruser", Action: domain.AuditActionRestoreRows, Target: "audit_keep"}}, nil)
+
+		_, err := uc.RestoreDeletedRows(ctx, "restoreuser", "audit_keep", restoreStatement)
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "entry_id", validationErr.Field)
+	})
+
+	t.Run("RestoreDeletedRows reports rows no longer kept", func(t *testing.T) {
+		mockAudit.EXPECT().GetDeletedRows(gomock.Any(), "audit_gone").Return(nil, domain.ErrDeletedRowsNotFound)
+
+		_, err := uc.RestoreDeletedRows(ctx, "restoreuser", "audit_gone", "")
+
+		require.ErrorIs(t, err, domain.ErrDeletedRowsNotFound)
+	})
+
+	t.Run("Heartbeat records activity and withdraws the idle warning", func(t *testing.T) {
+		txn := &domain.TransactionState{ID: "txn_beat", Username: "beatuser", StartedAt: time.Now().Add(-20 * time.Minute), IdleWarnedAt: time.Now()}
+		mockTransaction.EXPECT().GetUserTransaction(gomock.Any(), "beatuser").Return(txn, nil)
+		mockTransaction.EXPECT().
+			UpdateTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, updated *domain.TransactionState) error {
+				require.WithinDuration(t, time.Now(), updated.LastHeartbeatAt, time.Second)
+				require.True(t, updated.IdleWarnedAt.IsZero())
+				return nil
+			})
+
+		require.NoError(t, uc.Heartbeat(ctx, "beatuser"))
+	})
+
+	t.Run("Heartbeat without a transaction reports none is active", func(t *testing.T) {
+		mockTransaction.EXPECT().GetUserTransaction(gomock.Any(), "idleuser").Return(nil, domain.ErrNoActiveTransaction)
+
+		require.ErrorIs(t, uc.Heartbeat(ctx, "idleuser"), domain.ErrNoActiveTransaction)
+	})
+
+	t.Run("ReapIdleTransactions warns, then rolls back and audits idle transactions", func(t *testing.T) {
+		now := time.Now()
+		active := &domain.TransactionState{ID: "txn_active", Username: "active", StartedAt: now.Add(-time.Hour), LastHeartbeatAt: now.Add(-time.Minute)}
+		nearlyIdle := &domain.TransactionState{ID: "txn_nearly", Username: "nearly", StartedAt: now.Add(-domain.TransactionIdleTimeout + time.Minute)}
+		warned := &domain.TransactionState{ID: "txn_warned", Username: "warned", StartedAt: now.Add(-time.Hour),
+			LastHeartbeatAt: now.Add(-domain.TransactionIdleTimeout + time.Minute), IdleWarnedAt: now.Add(-time.Minute)}
+		idle := &domain.TransactionState{ID: "txn_idle", Username: "idle", Database: "shop", Schema: "public", Table: "orders",
+			StartedAt: now.Add(-time.Hour), LastHeartbeatAt: now.Add(-domain.TransactionIdleTimeout - time.Minute),
+			Edits: map[int]domain.RowEdit{0: {}, 3: {}}, Deletes: []int{5}, Inserts: []domain.RowInsert{{}}}
+
+		mockTransaction.EXPECT().ListTransactions(gomock.Any()).Return([]*domain.TransactionState{active, nearlyIdle, warned, idle}, nil)
+		mockTransaction.EXPECT().
+			UpdateTransaction(gomock.Any(), nearlyIdle).
+			DoAndReturn(func(ctx context.Context, updated *domain.TransactionState) error {
+				require.False(t, updated.IdleWarnedAt.IsZero())
+				return nil
+			})
+		mockTransaction.EXPECT().DeleteTransaction(gomock.Any(), "txn_idle").Return(nil)
+		mockAudit.EXPECT().
+			RecordEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *domain.AuditEntry) error {
+				require.Equal(t, domain.AuditActionTransactionTimeout, entry.Action)
+				require.Equal(t, "idle", entry.Username)
+				require.Equal(t, "orders", entry.Table)
+				require.Equal(t, "txn_idle", entry.Before["transaction_id"])
+				require.Equal(t, 2, entry.Before["edits"])
+				require.Equal(t, 1, entry.Before["deletes"])
+				require.Equal(t, 1, entry.Before["inserts"])
+				return nil
+			})
+
+		reaped, err := uc.ReapIdleTransactions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, reaped)
+	})
+
+	t.Run("WatchTransaction pushes the idle warning, then the rollback", func(t *testing.T) {
+		now := time.Now()
+		txn := &domain.TransactionState{ID: "txn_watched", Username: "watcher", StartedAt: now.Add(-time.Hour),
+			LastHeartbeatAt: now.Add(-domain.TransactionIdleTimeout + time.Minute), IdleWarnedAt: now}
+		gomock.InOrder(
+			mockTransaction.EXPECT().GetUserTransaction(gomock.Any(), "watcher").Return(txn, nil),
+			mockTransaction.EXPECT().GetUserTransaction(gomock.Any(), "watcher").Return(nil, domain.ErrNoActiveTransaction),
+		)
+
+		// The reaper rolls the transaction back between the two checks
+		stale := *txn
+		stale.LastHeartbeatAt = now.Add(-domain.TransactionIdleTimeout - time.Second)
+		mockTransaction.EXPECT().ListTransactions(gomock.Any()).Return([]*domain.TransactionState{&stale}, nil)
+		mockTransaction.EXPECT().DeleteTransaction(gomock.Any(), "txn_watched").Return(nil)
+		mockAudit.EXPECT().RecordEntry(gomock.Any(), gomock.Any()).Return(nil)
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var events []domain.TransactionEvent
+		err := uc.WatchTransaction(watchCtx, "watcher", func(event *domain.TransactionEvent) error {
+			events = append(events, *event)
+			if event.Type == domain.TransactionEventIdleWarning {
+				_, err := uc.ReapIdleTransactions(ctx)
+				return err
+			}
+			cancel()
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, domain.TransactionEventIdleWarning, events[0].Type)
+		require.InDelta(t, 60, events[0].RemainingSeconds, 2)
+		require.Equal(t, domain.TransactionEventTimeout, events[1].Type)
+		require.Equal(t, "txn_watched", events[1].TransactionID)
+	})
+}
+
+var (
+	ErrNoActiveTransaction = domain.ValidationError{Field: "transaction", Message: "no active transaction"}
+)
+
+// expectStoredTransaction backs the transaction repository mock for txn's user with a buffer that
+// DeleteTransaction drops, the way the stored repository removes a transaction with its changes
+func expectStoredTransaction(mockTransaction *mockRepository.MockTransactionRepository, txn *domain.TransactionState) {
+	stored := txn
+	buffer := func() *domain.TransactionState {
+		if stored == nil {
+			return &domain.TransactionState{}
+		}
+		return stored
+	}
+
+	mockTransaction.EXPECT().
+		GetUserTransaction(gomock.Any(), txn.Username).
+		DoAndReturn(func(context.Context, string) (*domain.TransactionState, error) {
+			if stored == nil {
+				return nil, domain.ErrNoActiveTransaction
+			}
+			return stored, nil
+		}).AnyTimes()
+	mockTransaction.EXPECT().
+		GetRowEdits(gomock.Any(), txn.Username).
+		DoAndReturn(func(context.Context, string) (map[int]domain.RowEdit, error) { return buffer().Edits, nil }).AnyTimes()
+	mockTransaction.EXPECT().
+		GetRowInserts(gomock.Any(), txn.Username).
+		DoAndReturn(func(context.Context, string) ([]domain.RowInsert, error) { return buffer().Inserts, nil }).AnyTimes()
+	mockTransaction.EXPECT().
+		GetRowDeletes(gomock.Any(), txn.Username).
+		DoAndReturn(func(context.Context, string) ([]int, error) { return buffer().Deletes, nil }).AnyTimes()
+	mockTransaction.EXPECT().
+		GetRowKeyDeletes(gomock.Any(), txn.Username).
+		DoAndReturn(func(context.Context, string) ([]map[string]interface{}, error) { return buffer().KeyDeletes, nil }).AnyTimes()
+	mockTransaction.EXPECT().
+		GetBulkUpdates(gomock.Any(), txn.Username).
+		DoAndReturn(func(context.Context, string) ([]domain.BulkUpdate, error) { return buffer().BulkUpdates, nil }).AnyTimes()
+	mockTransaction.EXPECT().
+		DeleteTransaction(gomock.Any(), txn.ID).
+		DoAndReturn(func(context.Context, string) error {
+			stored = nil
+			return nil
+		}).AnyTimes()
+}